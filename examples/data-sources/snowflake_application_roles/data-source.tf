@@ -0,0 +1,3 @@
+data "snowflake_application_roles" "current" {
+  application = "application"
+}
@@ -0,0 +1,3 @@
+data "snowflake_applications" "current" {
+  pattern = "app%"
+}
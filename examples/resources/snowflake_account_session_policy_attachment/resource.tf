@@ -0,0 +1,10 @@
+resource "snowflake_session_policy" "default" {
+  database                  = "prod"
+  schema                    = "security"
+  name                      = "default_policy"
+  session_idle_timeout_mins = 60
+}
+
+resource "snowflake_account_session_policy_attachment" "attachment" {
+  session_policy = snowflake_session_policy.default.qualified_name
+}
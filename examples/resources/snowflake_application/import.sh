@@ -0,0 +1,2 @@
+# format is application name
+terraform import snowflake_application.example 'applicationName'
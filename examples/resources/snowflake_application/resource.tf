@@ -0,0 +1,16 @@
+# application created from a version of the application package
+resource "snowflake_application" "application" {
+  name                = "application"
+  application_package = "package"
+  version             = "v1"
+  patch               = 0
+  debug_mode          = true
+  comment             = "comment"
+}
+
+# application created in development mode from the files on a stage
+resource "snowflake_application" "dev_application" {
+  name                = "dev_application"
+  application_package = "package"
+  version_directory   = "@\"database\".\"schema\".\"stage\"/dev"
+}
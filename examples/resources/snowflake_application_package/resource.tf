@@ -0,0 +1,6 @@
+resource "snowflake_application_package" "package" {
+  name                        = "package"
+  distribution                = "INTERNAL"
+  data_retention_time_in_days = 1
+  comment                     = "comment"
+}
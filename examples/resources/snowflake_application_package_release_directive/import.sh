@@ -0,0 +1,2 @@
+# format is application package name | release directive name
+terraform import snowflake_application_package_release_directive.example 'packageName|releaseDirectiveName'
@@ -0,0 +1,14 @@
+resource "snowflake_application_package_release_directive" "default" {
+  application_package = "package"
+  name                = "DEFAULT"
+  version             = "v1"
+  patch               = 0
+}
+
+resource "snowflake_application_package_release_directive" "early_access" {
+  application_package = "package"
+  name                = "early_access"
+  accounts            = ["org.account"]
+  version             = "v2"
+  patch               = 1
+}
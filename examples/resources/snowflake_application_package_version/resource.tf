@@ -0,0 +1,6 @@
+resource "snowflake_application_package_version" "version" {
+  application_package = "package"
+  version             = "v1"
+  using               = "@\"database\".\"schema\".\"stage\"/v1"
+  label               = "label"
+}
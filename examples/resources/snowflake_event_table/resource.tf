@@ -0,0 +1,9 @@
+resource "snowflake_event_table" "event_table" {
+  database                    = "database"
+  schema                      = "schema"
+  name                        = "event_table"
+  comment                     = "Event table for the account."
+  cluster_by                  = ["TIMESTAMP"]
+  data_retention_time_in_days = 1
+  change_tracking             = true
+}
@@ -0,0 +1,46 @@
+resource "snowflake_network_rule" "rule" {
+  name       = "rule"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com"]
+}
+
+resource "snowflake_secret_with_generic_string" "secret" {
+  name          = "api_key"
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  secret_string = var.api_key
+}
+
+resource "snowflake_external_access_integration" "integration" {
+  name                           = "external_api"
+  allowed_network_rules          = [snowflake_network_rule.rule.qualified_name]
+  allowed_authentication_secrets = [snowflake_secret_with_generic_string.secret.qualified_name]
+  enabled                        = true
+  comment                        = "Access to the external API."
+}
+
+resource "snowflake_function" "function" {
+  name                         = "call_api"
+  database                     = "EXAMPLE_DB"
+  schema                       = "EXAMPLE_SCHEMA"
+  language                     = "python"
+  runtime_version              = "3.10"
+  return_type                  = "VARCHAR"
+  handler                      = "call_api"
+  packages                     = ["requests"]
+  external_access_integrations = [snowflake_external_access_integration.integration.name]
+  secrets = {
+    "api_key" = snowflake_secret_with_generic_string.secret.qualified_name
+  }
+  statement = <<EOT
+import _snowflake
+import requests
+
+def call_api():
+    api_key = _snowflake.get_generic_secret_string('api_key')
+    return requests.get('https://api.example.com', headers={'Authorization': api_key}).text
+EOT
+}
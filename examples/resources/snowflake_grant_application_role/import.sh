@@ -0,0 +1,2 @@
+# format is application_role_name (string) | grant_kind (ToAccountRole|ToApplicationRole) | parent_role_name (string)
+terraform import snowflake_grant_application_role.example '"my_application"."app_role_1"|ToAccountRole|"my_role"'
@@ -0,0 +1,21 @@
+##################################
+### grant application role to account role
+##################################
+
+resource "snowflake_role" "role" {
+  name = "ROLE"
+}
+
+resource "snowflake_grant_application_role" "g" {
+  application_role_name    = "\"my_application\".\"app_role_1\""
+  parent_account_role_name = snowflake_role.role.name
+}
+
+##################################
+### grant application role to application role
+##################################
+
+resource "snowflake_grant_application_role" "g" {
+  application_role_name        = "\"my_application\".\"app_role_1\""
+  parent_application_role_name = "\"my_application\".\"app_role_2\""
+}
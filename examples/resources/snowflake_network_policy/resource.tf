@@ -1,7 +1,17 @@
+resource "snowflake_network_rule" "allowed" {
+  name       = "allowed_rule"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24"]
+}
+
 resource "snowflake_network_policy" "policy" {
   name    = "policy"
   comment = "A policy."
 
-  allowed_ip_list = ["192.168.0.100/24"]
-  blocked_ip_list = ["192.168.0.101"]
+  allowed_network_rule_list = [snowflake_network_rule.allowed.qualified_name]
+  allowed_ip_list           = ["192.168.0.100/24"]
+  blocked_ip_list           = ["192.168.0.101"]
 }
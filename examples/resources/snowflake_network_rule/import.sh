@@ -0,0 +1 @@
+terraform import snowflake_network_rule.example 'dbName|schemaName|ruleName'
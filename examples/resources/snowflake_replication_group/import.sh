@@ -0,0 +1,2 @@
+# format is replication group name
+terraform import snowflake_replication_group.example 'rg1'
@@ -0,0 +1,34 @@
+resource "snowflake_database" "db" {
+  name = "db1"
+}
+
+resource "snowflake_replication_group" "source_replication_group" {
+  name                 = "RG1"
+  object_types         = ["DATABASES", "ROLES"]
+  allowed_accounts     = ["<org_name>.<target_account_name1>", "<org_name>.<target_account_name2>"]
+  allowed_databases    = [snowflake_database.db.name]
+  ignore_edition_check = true
+  replication_schedule {
+    cron {
+      expression = "0 0 10-20 * TUE,THU"
+      time_zone  = "UTC"
+    }
+
+    // replication_schedule could also be specified with interval instead of cron
+    // interval = 10
+  }
+}
+
+provider "snowflake" {
+  alias = "account2"
+}
+
+resource "snowflake_replication_group" "target_replication_group" {
+  provider = snowflake.account2
+  name     = "RG1"
+  from_replica {
+    organization_name   = "..."
+    source_account_name = "..."
+    name                = snowflake_replication_group.source_replication_group.name
+  }
+}
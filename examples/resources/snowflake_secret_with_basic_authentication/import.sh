@@ -0,0 +1,2 @@
+# format is database name | schema name | secret name
+terraform import snowflake_secret_with_basic_authentication.example 'dbName|schemaName|secretName'
@@ -0,0 +1,8 @@
+resource "snowflake_secret_with_basic_authentication" "secret" {
+  name     = "credentials"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  username = "service_user"
+  password = var.service_password
+  comment  = "Credentials of the external service."
+}
@@ -0,0 +1,7 @@
+resource "snowflake_secret_with_generic_string" "secret" {
+  name          = "api_key"
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  secret_string = var.api_key
+  comment       = "API key of the external service."
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | secret name
+terraform import snowflake_secret_with_oauth_authorization_code.example 'dbName|schemaName|secretName'
@@ -0,0 +1,9 @@
+resource "snowflake_secret_with_oauth_authorization_code" "secret" {
+  name                            = "oauth_authorization_code"
+  database                        = "EXAMPLE_DB"
+  schema                          = "EXAMPLE_SCHEMA"
+  api_authentication              = "EXAMPLE_SECURITY_INTEGRATION"
+  oauth_refresh_token             = var.refresh_token
+  oauth_refresh_token_expiry_time = "2025-01-31 23:59:59"
+  comment                         = "OAuth secret using the authorization code grant flow."
+}
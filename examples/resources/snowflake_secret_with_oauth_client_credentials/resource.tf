@@ -0,0 +1,8 @@
+resource "snowflake_secret_with_oauth_client_credentials" "secret" {
+  name               = "oauth_client_credentials"
+  database           = "EXAMPLE_DB"
+  schema             = "EXAMPLE_SCHEMA"
+  api_authentication = "EXAMPLE_SECURITY_INTEGRATION"
+  oauth_scopes       = ["useraccount"]
+  comment            = "OAuth secret using the client credentials flow."
+}
@@ -0,0 +1,8 @@
+resource "snowflake_session_policy" "example" {
+  database                     = "database_name"
+  schema                       = "schema_name"
+  name                         = "session_policy_name"
+  session_idle_timeout_mins    = 30
+  session_ui_idle_timeout_mins = 30
+  comment                      = "session policy for the security team"
+}
@@ -0,0 +1,11 @@
+resource "snowflake_streamlit" "streamlit" {
+  database           = "database"
+  schema             = "schema"
+  name               = "streamlit"
+  stage              = "\"database\".\"schema\".\"stage\""
+  directory_location = "src"
+  main_file          = "streamlit_main.py"
+  query_warehouse    = "warehouse"
+  title              = "title"
+  comment            = "comment"
+}
@@ -0,0 +1,2 @@
+# format is user name | session policy fully qualified name
+terraform import snowflake_user_session_policy_attachment.example '"USER_NAME"|"dbName"."schemaName"."sessionPolicyName"'
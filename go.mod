@@ -27,13 +27,13 @@ require (
 	github.com/youmark/pkcs8 v0.0.0-20201027041543-1326539a0a0a
 	golang.org/x/crypto v0.17.0
 	golang.org/x/text v0.14.0
+	golang.org/x/tools v0.15.0
 )
 
 require (
 	github.com/go-sql-driver/mysql v1.7.1 // indirect
 	github.com/lib/pq v1.10.9 // indirect
 	github.com/rogpeppe/go-internal v1.10.0 // indirect
-	golang.org/x/tools v0.15.0 // indirect
 )
 
 require (
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -84,6 +85,27 @@ func TestAccPreCheck(t *testing.T) {
 	})
 }
 
+// SecondaryClient connects to the secondary test account. With the fake backend (SF_TF_FAKE_BACKEND) it's a separate fake account.
+func SecondaryClient(t *testing.T) *sdk.Client {
+	t.Helper()
+	if sdk.UsesFakeBackend() {
+		client, err := sdk.NewFakeClient(testprofiles.Secondary)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+	config, err := sdk.ProfileConfig(testprofiles.Secondary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := sdk.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
 // ConfigurationSameAsStepN should be used to obtain configuration for one of the previous steps to avoid duplication of configuration and var files.
 // Based on config.TestStepDirectory.
 func ConfigurationSameAsStepN(step int) func(config.TestStepConfigRequest) string {
@@ -313,6 +313,29 @@ func Test_Assertions(t *testing.T) {
 			assert.Equal(t, !tt.correct, tut.Failed())
 		})
 	}
+
+	tests6 := []struct {
+		filePath     string
+		packageName  string
+		functionName string
+		calls        bool
+	}{
+		{filePath: "testdata/dir5/sample1.go", packageName: "strings", functionName: "ToUpper", calls: true},
+		{filePath: "testdata/dir5/sample1.go", packageName: "strings", functionName: "ToLower", calls: false},
+		{filePath: "testdata/dir5/sample1.go", packageName: "fmt", functionName: "ToUpper", calls: false},
+		{filePath: "testdata/dir5/sample2.go", packageName: "strings", functionName: "ToUpper", calls: false},
+	}
+	for _, tt := range tests6 {
+		t.Run(fmt.Sprintf("function call assertions for %s.%s in %s", tt.packageName, tt.functionName, tt.filePath), func(t *testing.T) {
+			file := architest.NewFileFromPath(tt.filePath)
+			tut := &testing.T{}
+
+			file.AssertDoesNotCallFunction(tut, tt.packageName, tt.functionName)
+
+			assert.Equal(t, tt.calls, file.CallsFunction(tt.packageName, tt.functionName))
+			assert.Equal(t, tt.calls, tut.Failed())
+		})
+	}
 }
 
 func Test_SampleArchiTestUsage(t *testing.T) {
@@ -12,6 +12,11 @@ func (f *File) AssertHasPackage(t *testing.T, expectedPackage string) {
 	assert.Equalf(t, expectedPackage, f.packageName, "file %s has package %s, expected package %s", f.Name(), f.PackageName(), expectedPackage)
 }
 
+func (f *File) AssertDoesNotCallFunction(t *testing.T, packageName string, functionName string) {
+	t.Helper()
+	assert.Falsef(t, f.CallsFunction(packageName, functionName), "file %s calls %s.%s which is not allowed", f.Name(), packageName, functionName)
+}
+
 func (method *Method) AssertAcceptanceTestNamedCorrectly(t *testing.T) {
 	t.Helper()
 	method.AssertNameMatches(t, AcceptanceTestNameRegex)
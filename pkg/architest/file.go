@@ -49,3 +49,20 @@ func (f *File) ExportedMethods() Methods {
 	}
 	return allExportedMethods
 }
+
+// CallsFunction checks if the file contains a call to the given function from the given package (e.g. sdk.NewClientFromDB).
+// The packageName should be the name under which the package is imported in the file.
+func (f *File) CallsFunction(packageName string, functionName string) bool {
+	found := false
+	ast.Inspect(f.fileSrc, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			if selector, ok := call.Fun.(*ast.SelectorExpr); ok {
+				if ident, ok := selector.X.(*ast.Ident); ok && ident.Name == packageName && selector.Sel.Name == functionName {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
@@ -3,6 +3,7 @@ package architest
 import "regexp"
 
 var (
+	AllFilesRegex            = regexp.MustCompile("^.*\\.go$")
 	AcceptanceTestFileRegex  = regexp.MustCompile("^.*_acceptance_test.go$")
 	AcceptanceTestNameRegex  = regexp.MustCompile("^TestAcc_.+$")
 	IntegrationTestFileRegex = regexp.MustCompile("^.*_integration_test.go$")
@@ -0,0 +1,7 @@
+package dir5
+
+import "strings"
+
+func A() string {
+	return strings.ToUpper("a")
+}
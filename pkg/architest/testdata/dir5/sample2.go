@@ -0,0 +1,5 @@
+package dir5
+
+func B() string {
+	return "b"
+}
@@ -0,0 +1,25 @@
+package architests
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/architest"
+)
+
+func TestArchCheck_ProviderContext(t *testing.T) {
+	t.Run("resources use the client from the provider context", func(t *testing.T) {
+		resourcesFiles := architest.Directory("../resources/").Files(architest.FileNameFilterWithExclusionsProvider(architest.AllFilesRegex, architest.TestFileRegex))
+
+		resourcesFiles.All(func(file *architest.File) {
+			file.AssertDoesNotCallFunction(t, "sdk", "NewClientFromDB")
+		})
+	})
+
+	t.Run("data sources use the client from the provider context", func(t *testing.T) {
+		datasourcesFiles := architest.Directory("../datasources/").Files(architest.FileNameFilterWithExclusionsProvider(architest.AllFilesRegex, architest.TestFileRegex))
+
+		datasourcesFiles.All(func(file *architest.File) {
+			file.AssertDoesNotCallFunction(t, "sdk", "NewClientFromDB")
+		})
+	})
+}
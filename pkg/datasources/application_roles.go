@@ -0,0 +1,86 @@
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationRolesSchema = map[string]*schema.Schema{
+	"application": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The application from which to return the application roles from.",
+	},
+	"application_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists application roles defined in the application.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the application role.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment for the application role.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The owner of the application role.",
+				},
+				"owner_role_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of role that owns the application role.",
+				},
+			},
+		},
+	},
+}
+
+// ApplicationRoles Snowflake Application Roles resource.
+func ApplicationRoles() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadApplicationRoles,
+		Schema: applicationRolesSchema,
+	}
+}
+
+// ReadApplicationRoles Reads the application roles metadata information.
+func ReadApplicationRoles(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	applicationName := d.Get("application").(string)
+	d.SetId(applicationName)
+
+	req := sdk.NewShowApplicationRoleRequest().WithApplicationName(sdk.NewAccountObjectIdentifier(applicationName))
+
+	listApplicationRoles, err := client.ApplicationRoles.Show(ctx, req)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list application roles (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	applicationRoles := make([]map[string]any, 0, len(listApplicationRoles))
+	for _, applicationRole := range listApplicationRoles {
+		applicationRoleMap := map[string]any{}
+		applicationRoleMap["name"] = applicationRole.Name
+		applicationRoleMap["comment"] = applicationRole.Comment
+		applicationRoleMap["owner"] = applicationRole.Owner
+		applicationRoleMap["owner_role_type"] = applicationRole.OwnerRoleType
+		applicationRoles = append(applicationRoles, applicationRoleMap)
+	}
+
+	return d.Set("application_roles", applicationRoles)
+}
@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"applications": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists applications across the entire account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the application.",
+				},
+				"source_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the source of the application.",
+				},
+				"source": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The source (e.g. application package) from which the application was created.",
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The installed version of the application.",
+				},
+				"label": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The label of the installed version of the application.",
+				},
+				"patch": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The installed patch of the application.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment for the application.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the application (i.e. has the OWNERSHIP privilege on the application).",
+				},
+			},
+		},
+	},
+}
+
+// Applications Snowflake Applications resource.
+func Applications() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadApplications,
+		Schema: applicationsSchema,
+	}
+}
+
+// ReadApplications Reads the applications metadata information.
+func ReadApplications(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	d.SetId("applications_read")
+
+	req := sdk.NewShowApplicationRequest()
+
+	if v, ok := d.GetOk("pattern"); ok {
+		req.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	listApplications, err := client.Applications.Show(ctx, req)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list applications (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	applications := make([]map[string]any, 0, len(listApplications))
+	for _, application := range listApplications {
+		applicationMap := map[string]any{}
+		applicationMap["name"] = application.Name
+		applicationMap["source_type"] = application.SourceType
+		applicationMap["source"] = application.Source
+		applicationMap["version"] = application.Version
+		applicationMap["label"] = application.Label
+		applicationMap["patch"] = application.Patch
+		applicationMap["comment"] = application.Comment
+		applicationMap["owner"] = application.Owner
+		applications = append(applications, applicationMap)
+	}
+
+	return d.Set("applications", applications)
+}
@@ -2,9 +2,9 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,8 +51,7 @@ func DatabaseRoles() *schema.Resource {
 
 // ReadDatabaseRoles Reads the database metadata information.
 func ReadDatabaseRoles(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	d.SetId("database_roles_read")
 
 	databaseName := d.Get("database").(string)
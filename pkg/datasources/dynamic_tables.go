@@ -2,9 +2,9 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -200,8 +200,7 @@ func DynamicTables() *schema.Resource {
 
 // ReadDynamicTables Reads the dynamic tables metadata information.
 func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	request := sdk.NewShowDynamicTableRequest()
 	if v, ok := d.GetOk("like"); ok {
 		like := v.([]interface{})[0].(map[string]interface{})
@@ -226,7 +225,11 @@ func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := in["schema"]; ok {
 			schema := v.(string)
 			if schema != "" {
-				request.WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schema)})
+				schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](schema)
+				if err != nil {
+					return err
+				}
+				request.WithIn(&sdk.In{Schema: schemaId})
 			}
 		}
 	}
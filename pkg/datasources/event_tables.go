@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database from which to return the event tables from.",
+	},
+	"schema": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"database"},
+		Description:  "The schema from which to return the event tables from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"event_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists event tables for the current/specified database or schema, or across the entire account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the event table.",
+				},
+				"database_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Database in which the event table is stored.",
+				},
+				"schema_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Schema in which the event table is stored.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment for the event table.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the event table (i.e. has the OWNERSHIP privilege on the event table).",
+				},
+				"owner_role_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of role that owns the event table.",
+				},
+			},
+		},
+	},
+}
+
+// EventTables Snowflake Event Tables resource.
+func EventTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadEventTables,
+		Schema: eventTablesSchema,
+	}
+}
+
+// ReadEventTables Reads the event tables metadata information.
+func ReadEventTables(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	d.SetId("event_tables_read")
+
+	req := sdk.NewShowEventTableRequest()
+
+	if v, ok := d.GetOk("pattern"); ok {
+		req.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		databaseName := v.(string)
+
+		if v, ok := d.GetOk("schema"); ok {
+			req.WithIn(&sdk.In{
+				Schema: sdk.NewDatabaseObjectIdentifier(databaseName, v.(string)),
+			})
+		} else {
+			req.WithIn(&sdk.In{
+				Database: sdk.NewAccountObjectIdentifier(databaseName),
+			})
+		}
+	}
+
+	listEventTables, err := client.EventTables.Show(ctx, req)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list event tables (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	eventTables := make([]map[string]any, 0, len(listEventTables))
+	for _, eventTable := range listEventTables {
+		eventTableMap := map[string]any{}
+		eventTableMap["name"] = eventTable.Name
+		eventTableMap["database_name"] = eventTable.DatabaseName
+		eventTableMap["schema_name"] = eventTable.SchemaName
+		eventTableMap["comment"] = eventTable.Comment
+		eventTableMap["owner"] = eventTable.Owner
+		eventTableMap["owner_role_type"] = eventTable.OwnerRoleType
+		eventTables = append(eventTables, eventTableMap)
+	}
+
+	return d.Set("event_tables", eventTables)
+}
@@ -0,0 +1,76 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTables(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dataSourceName := "data.snowflake_event_tables.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTablesResourceConfig(name) + eventTablesDatasourceConfigDbOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "event_tables.#"),
+				),
+			},
+			{
+				Config: eventTablesResourceConfig(name) + eventTablesDatasourceConfigAllOptionals(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "event_tables.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_tables.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "event_tables.0.database_name", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(dataSourceName, "event_tables.0.schema_name", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(dataSourceName, "event_tables.0.comment", "some comment"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "event_tables.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func eventTablesResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_event_table" "test" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+	comment  = "some comment"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName)
+}
+
+func eventTablesDatasourceConfigDbOnly() string {
+	return fmt.Sprintf(`
+data "snowflake_event_tables" "test" {
+	database   = "%s"
+	depends_on = [snowflake_event_table.test]
+}
+`, acc.TestDatabaseName)
+}
+
+func eventTablesDatasourceConfigAllOptionals(name string) string {
+	return fmt.Sprintf(`
+data "snowflake_event_tables" "test" {
+	database   = "%s"
+	schema     = "%s"
+	pattern    = "%s"
+	depends_on = [snowflake_event_table.test]
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name)
+}
@@ -2,8 +2,8 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -123,8 +123,7 @@ func FailoverGroups() *schema.Resource {
 
 // ReadFailoverGroups lists failover groups.
 func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	inAccount := d.Get("in_account").(string)
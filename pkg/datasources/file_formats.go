@@ -2,9 +2,9 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -61,8 +61,7 @@ func FileFormats() *schema.Resource {
 }
 
 func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	databaseName := d.Get("database").(string)
@@ -0,0 +1,138 @@
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkRulesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database from which to return the network rules from.",
+	},
+	"schema": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"database"},
+		Description:  "The schema from which to return the network rules from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"network_rules": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists network rules for the current/specified database or schema, or across the entire account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the network rule.",
+				},
+				"database_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Database in which the network rule is stored.",
+				},
+				"schema_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Schema in which the network rule is stored.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of network identifiers in the value list of the network rule.",
+				},
+				"mode": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Specifies what is restricted by the network rule.",
+				},
+				"entries_in_value_list": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of entries in the value list of the network rule.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment for the network rule.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the network rule (i.e. has the OWNERSHIP privilege on the network rule).",
+				},
+			},
+		},
+	},
+}
+
+// NetworkRules Snowflake Network Rules resource.
+func NetworkRules() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadNetworkRules,
+		Schema: networkRulesSchema,
+	}
+}
+
+// ReadNetworkRules Reads the network rules metadata information.
+func ReadNetworkRules(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	d.SetId("network_rules_read")
+
+	req := sdk.NewShowNetworkRuleRequest()
+
+	if v, ok := d.GetOk("pattern"); ok {
+		req.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		databaseName := v.(string)
+
+		if v, ok := d.GetOk("schema"); ok {
+			req.WithIn(&sdk.In{
+				Schema: sdk.NewDatabaseObjectIdentifier(databaseName, v.(string)),
+			})
+		} else {
+			req.WithIn(&sdk.In{
+				Database: sdk.NewAccountObjectIdentifier(databaseName),
+			})
+		}
+	}
+
+	listNetworkRules, err := client.NetworkRules.Show(ctx, req)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list network rules (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	networkRules := make([]map[string]any, 0, len(listNetworkRules))
+	for _, networkRule := range listNetworkRules {
+		networkRuleMap := map[string]any{}
+		networkRuleMap["name"] = networkRule.Name
+		networkRuleMap["database_name"] = networkRule.DatabaseName
+		networkRuleMap["schema_name"] = networkRule.SchemaName
+		networkRuleMap["type"] = string(networkRule.Type)
+		networkRuleMap["mode"] = string(networkRule.Mode)
+		networkRuleMap["entries_in_value_list"] = networkRule.EntriesInValueList
+		networkRuleMap["comment"] = networkRule.Comment
+		networkRuleMap["owner"] = networkRule.Owner
+		networkRules = append(networkRules, networkRuleMap)
+	}
+
+	return d.Set("network_rules", networkRules)
+}
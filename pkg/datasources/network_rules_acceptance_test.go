@@ -0,0 +1,82 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NetworkRules(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dataSourceName := "data.snowflake_network_rules.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkRulesResourceConfig(name) + networkRulesDatasourceConfigDbOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_rules.#"),
+				),
+			},
+			{
+				Config: networkRulesResourceConfig(name) + networkRulesDatasourceConfigAllOptionals(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.database_name", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.schema_name", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.type", "HOST_PORT"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.mode", "EGRESS"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.entries_in_value_list", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_rules.0.comment", "some comment"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_rules.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func networkRulesResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "test" {
+	name       = "%s"
+	database   = "%s"
+	schema     = "%s"
+	type       = "HOST_PORT"
+	mode       = "EGRESS"
+	value_list = ["example.com"]
+	comment    = "some comment"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName)
+}
+
+func networkRulesDatasourceConfigDbOnly() string {
+	return fmt.Sprintf(`
+data "snowflake_network_rules" "test" {
+	database   = "%s"
+	depends_on = [snowflake_network_rule.test]
+}
+`, acc.TestDatabaseName)
+}
+
+func networkRulesDatasourceConfigAllOptionals(name string) string {
+	return fmt.Sprintf(`
+data "snowflake_network_rules" "test" {
+	database   = "%s"
+	schema     = "%s"
+	pattern    = "%s"
+	depends_on = [snowflake_network_rule.test]
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name)
+}
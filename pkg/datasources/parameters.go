@@ -2,10 +2,10 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -84,8 +84,7 @@ func Parameters() *schema.Resource {
 }
 
 func ReadParameters(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	p, ok := d.GetOk("pattern")
 	pattern := ""
@@ -119,14 +118,17 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 		case sdk.ObjectTypeDatabase:
 			opts.In.Database = sdk.NewAccountObjectIdentifier(objectName)
 		case sdk.ObjectTypeSchema:
-			opts.In.Schema = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(objectName)
+			opts.In.Schema, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](objectName)
 		case sdk.ObjectTypeTask:
-			opts.In.Task = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName)
+			opts.In.Task, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](objectName)
 		case sdk.ObjectTypeTable:
-			opts.In.Table = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName)
+			opts.In.Table, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](objectName)
 		default:
 			return fmt.Errorf("object_type %s is not supported", objectType)
 		}
+		if err != nil {
+			return err
+		}
 	}
 	parameters, err = client.Parameters.ShowParameters(ctx, &opts)
 
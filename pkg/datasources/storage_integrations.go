@@ -2,9 +2,9 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,11 +47,11 @@ func StorageIntegrations() *schema.Resource {
 }
 
 func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
-	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	account, err := providerContext.CurrentAccount(ctx)
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("[DEBUG] unable to retrieve current account")
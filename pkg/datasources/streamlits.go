@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database from which to return the streamlits from.",
+	},
+	"schema": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"database"},
+		Description:  "The schema from which to return the streamlits from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	"streamlits": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists streamlits for the current/specified database or schema, or across the entire account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the streamlit.",
+				},
+				"database_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Database in which the streamlit is stored.",
+				},
+				"schema_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Schema in which the streamlit is stored.",
+				},
+				"title": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Title of the streamlit app displayed in Snowsight.",
+				},
+				"query_warehouse": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Warehouse where SQL queries issued by the streamlit app are run.",
+				},
+				"url_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Unique identifier of the streamlit app used in its URL.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment for the streamlit.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the streamlit (i.e. has the OWNERSHIP privilege on the streamlit).",
+				},
+				"owner_role_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of role that owns the streamlit.",
+				},
+			},
+		},
+	},
+}
+
+// Streamlits Snowflake Streamlits resource.
+func Streamlits() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadStreamlits,
+		Schema: streamlitsSchema,
+	}
+}
+
+// ReadStreamlits Reads the streamlits metadata information.
+func ReadStreamlits(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	d.SetId("streamlits_read")
+
+	req := sdk.NewShowStreamlitRequest()
+
+	if v, ok := d.GetOk("pattern"); ok {
+		req.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		databaseName := v.(string)
+
+		if v, ok := d.GetOk("schema"); ok {
+			req.WithIn(&sdk.In{
+				Schema: sdk.NewDatabaseObjectIdentifier(databaseName, v.(string)),
+			})
+		} else {
+			req.WithIn(&sdk.In{
+				Database: sdk.NewAccountObjectIdentifier(databaseName),
+			})
+		}
+	}
+
+	listStreamlits, err := client.Streamlits.Show(ctx, req)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list streamlits (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	streamlits := make([]map[string]any, 0, len(listStreamlits))
+	for _, streamlit := range listStreamlits {
+		streamlitMap := map[string]any{}
+		streamlitMap["name"] = streamlit.Name
+		streamlitMap["database_name"] = streamlit.DatabaseName
+		streamlitMap["schema_name"] = streamlit.SchemaName
+		streamlitMap["title"] = streamlit.Title
+		streamlitMap["query_warehouse"] = streamlit.QueryWarehouse
+		streamlitMap["url_id"] = streamlit.UrlId
+		streamlitMap["comment"] = streamlit.Comment
+		streamlitMap["owner"] = streamlit.Owner
+		streamlitMap["owner_role_type"] = streamlit.OwnerRoleType
+		streamlits = append(streamlits, streamlitMap)
+	}
+
+	return d.Set("streamlits", streamlits)
+}
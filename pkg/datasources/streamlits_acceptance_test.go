@@ -0,0 +1,87 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Streamlits(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dataSourceName := "data.snowflake_streamlits.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: streamlitsResourceConfig(name) + streamlitsDatasourceConfigDbOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "streamlits.#"),
+				),
+			},
+			{
+				Config: streamlitsResourceConfig(name) + streamlitsDatasourceConfigAllOptionals(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.0.database_name", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.0.schema_name", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.0.title", "some title"),
+					resource.TestCheckResourceAttr(dataSourceName, "streamlits.0.comment", "some comment"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "streamlits.0.url_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "streamlits.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func streamlitsResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage" "test" {
+	name     = "%[1]s"
+	database = "%[2]s"
+	schema   = "%[3]s"
+}
+
+resource "snowflake_streamlit" "test" {
+	name      = "%[1]s"
+	database  = "%[2]s"
+	schema    = "%[3]s"
+	stage     = "\"%[2]s\".\"%[3]s\".\"${snowflake_stage.test.name}\""
+	main_file = "streamlit_app.py"
+	title     = "some title"
+	comment   = "some comment"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName)
+}
+
+func streamlitsDatasourceConfigDbOnly() string {
+	return fmt.Sprintf(`
+data "snowflake_streamlits" "test" {
+	database   = "%s"
+	depends_on = [snowflake_streamlit.test]
+}
+`, acc.TestDatabaseName)
+}
+
+func streamlitsDatasourceConfigAllOptionals(name string) string {
+	return fmt.Sprintf(`
+data "snowflake_streamlits" "test" {
+	database   = "%s"
+	schema     = "%s"
+	pattern    = "%s"
+	depends_on = [snowflake_streamlit.test]
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,7 +32,7 @@ func SystemGenerateSCIMAccessToken() *schema.Resource {
 
 // ReadSystemGetAWSSNSIAMPolicy implements schema.ReadFunc.
 func ReadSystemGenerateSCIMAccessToken(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	integrationName := d.Get("integration_name").(string)
 
 	sel := snowflake.NewSystemGenerateSCIMAccessTokenBuilder(integrationName).Select()
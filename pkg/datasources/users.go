@@ -2,12 +2,12 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -105,13 +105,13 @@ func Users() *schema.Resource {
 }
 
 func ReadUsers(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	userPattern := d.Get("pattern").(string)
 
-	account, err1 := client.ContextFunctions.CurrentAccount(ctx)
+	account, err1 := providerContext.CurrentAccount(ctx)
 	region, err2 := client.ContextFunctions.CurrentRegion(ctx)
 	if err1 != nil || err2 != nil {
 		log.Print("[DEBUG] unable to retrieve current account")
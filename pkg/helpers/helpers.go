@@ -1,7 +1,6 @@
 package helpers
 
 import (
-	"encoding/csv"
 	"fmt"
 	"log"
 	"reflect"
@@ -80,6 +79,9 @@ func EncodeSnowflakeID(attributes ...interface{}) string {
 			default:
 				log.Panicf("Unsupported object identifier: %v", id)
 			}
+			for i, part := range parts {
+				parts[i] = sdk.QuoteIdentifierPart(part, rune(IDDelimiter[0]))
+			}
 			return strings.Join(parts, IDDelimiter)
 		}
 	}
@@ -100,8 +102,12 @@ func EncodeSnowflakeID(attributes ...interface{}) string {
 	return strings.Join(parts, "|")
 }
 
+// DecodeSnowflakeID decodes the id generated by EncodeSnowflakeID from sdk.ObjectIdentifier. Parts containing the delimiter are quoted.
 func DecodeSnowflakeID(id string) sdk.ObjectIdentifier {
-	parts := strings.Split(id, IDDelimiter)
+	parts, err := sdk.SplitIdentifier(id, rune(IDDelimiter[0]))
+	if err != nil {
+		parts = strings.Split(id, IDDelimiter)
+	}
 	switch len(parts) {
 	case 1:
 		return sdk.NewAccountObjectIdentifier(parts[0])
@@ -116,6 +122,39 @@ func DecodeSnowflakeID(id string) sdk.ObjectIdentifier {
 	}
 }
 
+// ParseSnowflakeID parses the id generated by EncodeSnowflakeID from sdk.ObjectIdentifier and returns the identifier of the expected type.
+// In contrast to DecodeSnowflakeID it fails (instead of returning an identifier of other type) for malformed ids, e.g. passed to the import.
+func ParseSnowflakeID[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | sdk.SchemaObjectIdentifier | sdk.TableColumnIdentifier](id string) (T, error) {
+	var zero T
+	parts, err := sdk.SplitIdentifier(id, rune(IDDelimiter[0]))
+	if err != nil {
+		return zero, fmt.Errorf("unable to read resource id: %s, err = %w", id, err)
+	}
+	var objectIdentifier sdk.ObjectIdentifier
+	switch any(zero).(type) {
+	case sdk.AccountObjectIdentifier:
+		if len(parts) == 1 {
+			objectIdentifier = sdk.NewAccountObjectIdentifier(parts[0])
+		}
+	case sdk.DatabaseObjectIdentifier:
+		if len(parts) == 2 {
+			objectIdentifier = sdk.NewDatabaseObjectIdentifier(parts[0], parts[1])
+		}
+	case sdk.SchemaObjectIdentifier:
+		if len(parts) == 3 {
+			objectIdentifier = sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2])
+		}
+	case sdk.TableColumnIdentifier:
+		if len(parts) == 4 {
+			objectIdentifier = sdk.NewTableColumnIdentifier(parts[0], parts[1], parts[2], parts[3])
+		}
+	}
+	if objectIdentifier == nil {
+		return zero, fmt.Errorf("unexpected number of parts %d in resource id: %s, expected the id of %T", len(parts), id, zero)
+	}
+	return objectIdentifier.(T), nil
+}
+
 // DecodeSnowflakeParameterID decodes identifier (usually passed as one of the parameter in tf configuration) into sdk.ObjectIdentifier.
 // identifier can be specified in two ways: quoted and unquoted, e.g.
 //
@@ -128,16 +167,13 @@ func DecodeSnowflakeID(id string) sdk.ObjectIdentifier {
 // The following configuration { "some_identifier": "db.name" } will be parsed as an object called "name" that lives
 // inside database called "db", not a database called "db.name". In this case quotes should be used.
 func DecodeSnowflakeParameterID(identifier string) (sdk.ObjectIdentifier, error) {
-	reader := csv.NewReader(strings.NewReader(identifier))
-	reader.Comma = ParameterIDDelimiter
-	lines, err := reader.ReadAll()
+	if strings.ContainsAny(identifier, "\r\n") {
+		return nil, fmt.Errorf("incompatible identifier: %s", identifier)
+	}
+	parts, err := sdk.SplitIdentifier(identifier, ParameterIDDelimiter)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read identifier: %s, err = %w", identifier, err)
 	}
-	if len(lines) != 1 {
-		return nil, fmt.Errorf("incompatible identifier: %s", identifier)
-	}
-	parts := lines[0]
 	switch len(parts) {
 	case 1:
 		return sdk.NewAccountObjectIdentifier(parts[0]), nil
@@ -144,6 +144,10 @@ func TestEncodeSnowflakeID(t *testing.T) {
 			identifier:        sdk.Pointer(sdk.NewTableColumnIdentifier("database", "schema", "table", "column")),
 			expectedEncodedID: `database|schema|table|column`,
 		},
+		"encodes schema object identifier with pipes and quotes": {
+			identifier:        sdk.NewSchemaObjectIdentifier("data|base", "sche\"ma", "ta|b\"le"),
+			expectedEncodedID: `"data|base"|sche"ma|"ta|b""le"`,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -176,6 +180,76 @@ func TestEncodeSnowflakeID(t *testing.T) {
 	}
 }
 
+func TestDecodeSnowflakeID(t *testing.T) {
+	testCases := map[string]struct {
+		id       string
+		expected sdk.ObjectIdentifier
+	}{
+		"decodes account object identifier":             {id: `database`, expected: sdk.NewAccountObjectIdentifier("database")},
+		"decodes database object identifier with dots":  {id: `data.base|sche.ma`, expected: sdk.NewDatabaseObjectIdentifier("data.base", "sche.ma")},
+		"decodes schema object identifier":              {id: `database|schema|table`, expected: sdk.NewSchemaObjectIdentifier("database", "schema", "table")},
+		"decodes quoted parts with pipes":               {id: `"data|base"|schema|"ta|b""le"`, expected: sdk.NewSchemaObjectIdentifier("data|base", "schema", `ta|b"le`)},
+		"decodes table column identifier":               {id: `database|schema|table|column`, expected: sdk.NewTableColumnIdentifier("database", "schema", "table", "column")},
+		"falls back to splitting for malformed quoting": {id: `"database|schema`, expected: sdk.NewDatabaseObjectIdentifier("database", "schema")},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, DecodeSnowflakeID(tc.id))
+		})
+	}
+
+	t.Run("too many parts", func(t *testing.T) {
+		require.Nil(t, DecodeSnowflakeID("a|b|c|d|e"))
+	})
+}
+
+func TestParseSnowflakeID(t *testing.T) {
+	t.Run("parses identifiers of the expected type", func(t *testing.T) {
+		accountObjectId, err := ParseSnowflakeID[sdk.AccountObjectIdentifier](`database`)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewAccountObjectIdentifier("database"), accountObjectId)
+
+		databaseObjectId, err := ParseSnowflakeID[sdk.DatabaseObjectIdentifier](`data.base|sche.ma`)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDatabaseObjectIdentifier("data.base", "sche.ma"), databaseObjectId)
+
+		schemaObjectId, err := ParseSnowflakeID[sdk.SchemaObjectIdentifier](`"data|base"|schema|"ta|b""le"`)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewSchemaObjectIdentifier("data|base", "schema", `ta|b"le`), schemaObjectId)
+
+		tableColumnId, err := ParseSnowflakeID[sdk.TableColumnIdentifier](`database|schema|table|column`)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewTableColumnIdentifier("database", "schema", "table", "column"), tableColumnId)
+	})
+
+	t.Run("unexpected number of parts", func(t *testing.T) {
+		_, err := ParseSnowflakeID[sdk.SchemaObjectIdentifier](`database|schema`)
+		require.ErrorContains(t, err, "unexpected number of parts 2 in resource id: database|schema, expected the id of sdk.SchemaObjectIdentifier")
+
+		_, err = ParseSnowflakeID[sdk.AccountObjectIdentifier](`database.schema|table`)
+		require.ErrorContains(t, err, "unexpected number of parts 2")
+	})
+
+	t.Run("malformed quoting", func(t *testing.T) {
+		_, err := ParseSnowflakeID[sdk.DatabaseObjectIdentifier](`"database|schema`)
+		require.ErrorContains(t, err, "unable to read resource id")
+	})
+}
+
+func FuzzEncodeDecodeSnowflakeID(f *testing.F) {
+	f.Add("database", "schema", "table")
+	f.Add("data|base", `sche"ma`, `"table"`)
+	f.Add("data.base", "|", `""`)
+	f.Fuzz(func(t *testing.T, database string, schema string, name string) {
+		id := sdk.NewSchemaObjectIdentifier(database, schema, name)
+		if id.DatabaseName() == "" || id.SchemaName() == "" || id.Name() == "" {
+			return
+		}
+		require.Equal(t, id, DecodeSnowflakeID(EncodeSnowflakeID(id)))
+	})
+}
+
 type unsupportedObjectIdentifier struct{}
 
 func (i unsupportedObjectIdentifier) Name() string {
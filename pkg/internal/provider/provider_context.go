@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+const currentAccountCacheKey = "current_account"
+
+// Context is the provider data handed to every resource and data source as meta.
+// It is created once per provider run in ConfigureProvider, so anything stored here is shared between all CRUD operations.
+type Context struct {
+	// Client is the initialized client shared by all resources and data sources
+	Client *sdk.Client
+	// Cache holds the values computed once per provider run (e.g. current account details) instead of querying them in every CRUD operation
+	Cache *Cache
+}
+
+// NewContext creates the provider data for the client, with empty cache
+func NewContext(client *sdk.Client) *Context {
+	return &Context{
+		Client: client,
+		Cache:  NewCache(),
+	}
+}
+
+// CurrentAccount returns the current account locator, it is queried only once per provider run
+func (c *Context) CurrentAccount(ctx context.Context) (string, error) {
+	account, err := c.Cache.GetOrLoad(currentAccountCacheKey, func() (any, error) {
+		return c.Client.ContextFunctions.CurrentAccount(ctx)
+	})
+	if err != nil {
+		return "", err
+	}
+	return account.(string), nil
+}
+
+// Cache is a concurrency-safe store of values loaded once per provider run, CRUD operations of many resources run in parallel.
+type Cache struct {
+	mu     sync.Mutex
+	values map[string]any
+}
+
+func NewCache() *Cache {
+	return &Cache{values: make(map[string]any)}
+}
+
+// GetOrLoad returns the value stored under the key, loading and storing it first if it is not present.
+// Errors are not cached, so the next call loads the value again.
+func (c *Cache) GetOrLoad(key string, load func() (any, error)) (any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.values[key]; ok {
+		return v, nil
+	}
+	v, err := load()
+	if err != nil {
+		return nil, err
+	}
+	c.values[key] = v
+	return v, nil
+}
+
+// Invalidate removes the value stored under the key, e.g. after a change which makes it stale
+func (c *Cache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+}
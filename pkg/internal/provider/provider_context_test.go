@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_GetOrLoad(t *testing.T) {
+	t.Run("loads value once", func(t *testing.T) {
+		cache := NewCache()
+		loads := 0
+		load := func() (any, error) {
+			loads++
+			return "value", nil
+		}
+
+		first, err := cache.GetOrLoad("key", load)
+		require.NoError(t, err)
+		second, err := cache.GetOrLoad("key", load)
+		require.NoError(t, err)
+
+		require.Equal(t, "value", first)
+		require.Equal(t, "value", second)
+		require.Equal(t, 1, loads)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		cache := NewCache()
+		_, err := cache.GetOrLoad("key", func() (any, error) { return nil, errors.New("failed") })
+		require.Error(t, err)
+
+		value, err := cache.GetOrLoad("key", func() (any, error) { return "value", nil })
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+	})
+
+	t.Run("loads value again after invalidation", func(t *testing.T) {
+		cache := NewCache()
+		_, err := cache.GetOrLoad("key", func() (any, error) { return "old", nil })
+		require.NoError(t, err)
+
+		cache.Invalidate("key")
+		value, err := cache.GetOrLoad("key", func() (any, error) { return "new", nil })
+		require.NoError(t, err)
+		require.Equal(t, "new", value)
+	})
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/snowflakedb/gosnowflake"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
@@ -298,6 +300,30 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROFILE", "default"),
 			},
+			"enable_show_cache": {
+				Type:        schema.TypeBool,
+				Description: "When enabled, reading databases, schemas, tables and warehouses runs one SHOW command per container (account, database or schema) and serves the following reads from memory, instead of running one SHOW ... LIKE command per object. Recommended for configurations with many objects of these types. Can also be sourced from the `SNOWFLAKE_ENABLE_SHOW_CACHE` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ENABLE_SHOW_CACHE", nil),
+			},
+			"max_retry_attempts": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of attempts (including the first one) of idempotent statements (SHOW, DESCRIBE, SELECT, CREATE ... IF NOT EXISTS and DROP ... IF EXISTS) failing because of transient errors, like unavailable service, concurrent operations, lock wait timeouts or expired sessions. Retries are disabled by default. Can also be sourced from the `SNOWFLAKE_MAX_RETRY_ATTEMPTS` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_MAX_RETRY_ATTEMPTS", nil),
+			},
+			"retry_backoff": {
+				Type:        schema.TypeInt,
+				Description: "The time in seconds to wait before the first retry of a statement. It's doubled before every next retry. Default is 1 second. Can also be sourced from the `SNOWFLAKE_RETRY_BACKOFF` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_RETRY_BACKOFF", nil),
+			},
+			"retry_max_backoff": {
+				Type:        schema.TypeInt,
+				Description: "The maximum time in seconds to wait between retries of a statement. Default is 30 seconds. Can also be sourced from the `SNOWFLAKE_RETRY_MAX_BACKOFF` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_RETRY_MAX_BACKOFF", nil),
+			},
 			// Deprecated attributes
 			"region": {
 				Type:        schema.TypeString,
@@ -430,13 +456,20 @@ func getResources() map[string]*schema.Resource {
 	others := map[string]*schema.Resource{
 		"snowflake_account":                                 resources.Account(),
 		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_session_policy_attachment":       resources.AccountSessionPolicyAttachment(),
 		"snowflake_account_parameter":                       resources.AccountParameter(),
 		"snowflake_alert":                                   resources.Alert(),
+		"snowflake_application":                             resources.Application(),
+		"snowflake_application_package":                     resources.ApplicationPackage(),
+		"snowflake_application_package_release_directive":   resources.ApplicationPackageReleaseDirective(),
+		"snowflake_application_package_version":             resources.ApplicationPackageVersion(),
 		"snowflake_api_integration":                         resources.APIIntegration(),
 		"snowflake_database":                                resources.Database(),
 		"snowflake_database_role":                           resources.DatabaseRole(),
 		"snowflake_dynamic_table":                           resources.DynamicTable(),
 		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                             resources.EventTable(),
+		"snowflake_external_access_integration":             resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                       resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                          resources.ExternalTable(),
@@ -444,7 +477,9 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_file_format":                             resources.FileFormat(),
 		"snowflake_function":                                resources.Function(),
 		"snowflake_grant_account_role":                      resources.GrantAccountRole(),
+		"snowflake_grant_application_role":                  resources.GrantApplicationRole(),
 		"snowflake_grant_database_role":                     resources.GrantDatabaseRole(),
+		"snowflake_grant_ownership":                         resources.GrantOwnership(),
 		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
 		"snowflake_grant_privileges_to_account_role":        resources.GrantPrivilegesToAccountRole(),
 		"snowflake_grant_privileges_to_database_role":       resources.GrantPrivilegesToDatabaseRole(),
@@ -454,12 +489,14 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_materialized_view":                       resources.MaterializedView(),
 		"snowflake_network_policy":                          resources.NetworkPolicy(),
 		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                            resources.NetworkRule(),
 		"snowflake_notification_integration":                resources.NotificationIntegration(),
 		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
 		"snowflake_object_parameter":                        resources.ObjectParameter(),
 		"snowflake_password_policy":                         resources.PasswordPolicy(),
 		"snowflake_pipe":                                    resources.Pipe(),
 		"snowflake_procedure":                               resources.Procedure(),
+		"snowflake_replication_group":                       resources.ReplicationGroup(),
 		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
 		"snowflake_role":                                    resources.Role(),
 		"snowflake_role_grants":                             resources.RoleGrants(),
@@ -468,12 +505,18 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_saml_integration":                        resources.SAMLIntegration(),
 		"snowflake_schema":                                  resources.Schema(),
 		"snowflake_scim_integration":                        resources.SCIMIntegration(),
+		"snowflake_secret_with_basic_authentication":        resources.SecretWithBasicAuthentication(),
+		"snowflake_secret_with_generic_string":              resources.SecretWithGenericString(),
+		"snowflake_secret_with_oauth_authorization_code":    resources.SecretWithOAuthAuthorizationCode(),
+		"snowflake_secret_with_oauth_client_credentials":    resources.SecretWithOAuthClientCredentials(),
 		"snowflake_sequence":                                resources.Sequence(),
 		"snowflake_session_parameter":                       resources.SessionParameter(),
+		"snowflake_session_policy":                          resources.SessionPolicy(),
 		"snowflake_share":                                   resources.Share(),
 		"snowflake_stage":                                   resources.Stage(),
 		"snowflake_storage_integration":                     resources.StorageIntegration(),
 		"snowflake_stream":                                  resources.Stream(),
+		"snowflake_streamlit":                               resources.Streamlit(),
 		"snowflake_table":                                   resources.Table(),
 		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
 		"snowflake_table_constraint":                        resources.TableConstraint(),
@@ -485,27 +528,52 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_user":                                    resources.User(),
 		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
 		"snowflake_user_password_policy_attachment":         resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_session_policy_attachment":          resources.UserSessionPolicyAttachment(),
 		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
 		"snowflake_view":                                    resources.View(),
 		"snowflake_warehouse":                               resources.Warehouse(),
 	}
 
+	for name, resource := range others {
+		if !slices.Contains(resourcesUsingRawConnection, name) {
+			others[name] = resources.WithExecutionContext(resource)
+		}
+	}
+
 	return mergeSchemas(
 		others,
 		GetGrantResources().GetTfSchemas(),
 	)
 }
 
+// resourcesUsingRawConnection run (some of) their statements on the connection pool directly instead of using the SDK client,
+// so they can't run them in the session with the overridden execution context (see resources.WithExecutionContext).
+var resourcesUsingRawConnection = []string{
+	"snowflake_role_grants",
+	"snowflake_role_ownership_grant",
+	"snowflake_stage",
+	"snowflake_table_column_masking_policy_application",
+	"snowflake_table_constraint",
+	"snowflake_tag",
+	"snowflake_tag_association",
+	"snowflake_tag_masking_policy_association",
+	"snowflake_user_ownership_grant",
+	"snowflake_user_public_keys",
+}
+
 func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_application_roles":                  datasources.ApplicationRoles(),
+		"snowflake_applications":                       datasources.Applications(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
@@ -514,6 +582,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
+		"snowflake_network_rules":                      datasources.NetworkRules(),
 		"snowflake_parameters":                         datasources.Parameters(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
@@ -526,6 +595,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_shares":                             datasources.Shares(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
+		"snowflake_streamlits":                         datasources.Streamlits(),
 		"snowflake_streams":                            datasources.Streams(),
 		"snowflake_system_generate_scim_access_token":  datasources.SystemGenerateSCIMAccessToken(),
 		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
@@ -747,5 +817,19 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return client.GetConn().DB, nil
+	if v, ok := s.GetOk("enable_show_cache"); ok && v.(bool) {
+		client.EnableShowCache()
+	}
+	if v, ok := s.GetOk("max_retry_attempts"); ok && v.(int) > 1 {
+		retryPolicy := sdk.DefaultRetryPolicy()
+		retryPolicy.MaxAttempts = v.(int)
+		if v, ok := s.GetOk("retry_backoff"); ok && v.(int) > 0 {
+			retryPolicy.InitialBackoff = time.Second * time.Duration(int64(v.(int)))
+		}
+		if v, ok := s.GetOk("retry_max_backoff"); ok && v.(int) > 0 {
+			retryPolicy.MaxBackoff = time.Second * time.Duration(int64(v.(int)))
+		}
+		client.SetRetryPolicy(retryPolicy)
+	}
+	return provider.NewContext(client), nil
 }
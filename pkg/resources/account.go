@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -216,15 +216,14 @@ func Account() *schema.Resource {
 
 		Schema: accountSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
 		},
 	}
 }
 
 // CreateAccount implements schema.CreateFunc.
 func CreateAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	name := d.Get("name").(string)
@@ -309,14 +308,15 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAccount implements schema.ReadFunc.
 func ReadAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	var acc *sdk.Account
-	var err error
 	err = helpers.Retry(5, 3*time.Second, func() (error, bool) {
 		acc, err = client.Accounts.ShowByID(ctx, id)
 		if err != nil {
@@ -359,11 +359,13 @@ func ReadAccount(d *schema.ResourceData, meta interface{}) error {
 func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
 	/*
 		todo: comments may eventually work again for accounts, so this can be uncommented when that happens
-		db := meta.(*sql.DB)
-		client := sdk.NewClientFromDB(db)
+		client := meta.(*provider.Context).Client
 		ctx := context.Background()
 
-		id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+		id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+		if err != nil {
+			return err
+		}
 
 		// Change comment
 		if d.HasChange("comment") {
@@ -383,12 +385,14 @@ func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAccount implements schema.DeleteFunc.
 func DeleteAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	gracePeriodInDays := d.Get("grace_period_in_days").(int)
-	err := client.Accounts.Drop(ctx, helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier), gracePeriodInDays, &sdk.DropAccountOptions{
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	return client.Accounts.Drop(ctx, id, gracePeriodInDays, &sdk.DropAccountOptions{
 		IfExists: sdk.Bool(true),
 	})
-	return err
 }
@@ -36,7 +36,7 @@ func TestAccountGrantCreate(t *testing.T) { // lintignore:AT003
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadAccountGrant(mock)
-		err := resources.CreateAccountGrant(d, db)
+		err := resources.CreateAccountGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -55,7 +55,7 @@ func TestAccountGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -73,7 +73,7 @@ func TestMonitorExecution(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -91,7 +91,7 @@ func TestExecuteTask(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -120,7 +120,7 @@ func TestApplyMaskingPolicy(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
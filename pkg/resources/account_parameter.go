@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,12 +17,30 @@ var accountParameterSchema = map[string]*schema.Schema{
 		Description: "Name of account parameter. Valid values are those in [account parameters](https://docs.snowflake.com/en/sql-reference/parameters.html#account-parameters).",
 	},
 	"value": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Value of account parameter, as a string. Constraints are the same as those for the parameters in Snowflake documentation.",
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Value of account parameter, as a string. Constraints are the same as those for the parameters in Snowflake documentation. For the EVENT_TABLE parameter, the value is the fully qualified name of the event table (e.g. `snowflake_event_table.example.qualified_name`).",
+		DiffSuppressFunc: suppressAccountParameterValueDiff,
 	},
 }
 
+// suppressAccountParameterValueDiff suppresses differences in quoting of the event table identifier,
+// which is returned by Snowflake without the quotes when they are not needed.
+func suppressAccountParameterValueDiff(_, oldValue, newValue string, d *schema.ResourceData) bool {
+	if sdk.AccountParameter(d.Get("key").(string)) != sdk.AccountParameterEventTable {
+		return false
+	}
+	oldId, err := sdk.ParseSchemaObjectIdentifier(oldValue)
+	if err != nil {
+		return false
+	}
+	newId, err := sdk.ParseSchemaObjectIdentifier(newValue)
+	if err != nil {
+		return false
+	}
+	return oldId.FullyQualifiedName() == newId.FullyQualifiedName()
+}
+
 func AccountParameter() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateAccountParameter,
@@ -39,10 +57,9 @@ func AccountParameter() *schema.Resource {
 
 // CreateAccountParameter implements schema.CreateFunc.
 func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
-	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 	parameter := sdk.AccountParameter(key)
 	err := client.Parameters.SetAccountParameter(ctx, parameter, value)
@@ -55,8 +72,7 @@ func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAccountParameter implements schema.ReadFunc.
 func ReadAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	parameterName := d.Id()
 	parameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(parameterName))
@@ -77,9 +93,8 @@ func UpdateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAccountParameter implements schema.DeleteFunc.
 func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	key := d.Get("key").(string)
-	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 	parameter := sdk.AccountParameter(key)
 	defaultParameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(key))
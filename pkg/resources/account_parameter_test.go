@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressAccountParameterValueDiff(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Key      string
+		OldValue string
+		NewValue string
+		Expected bool
+	}{
+		{
+			Name:     "event table - same identifier with different quoting",
+			Key:      "EVENT_TABLE",
+			OldValue: `DB.SCHEMA.EVENTS`,
+			NewValue: `"DB"."SCHEMA"."EVENTS"`,
+			Expected: true,
+		},
+		{
+			Name:     "event table - case-sensitive identifier",
+			Key:      "EVENT_TABLE",
+			OldValue: `DB.SCHEMA.EVENTS`,
+			NewValue: `"db"."schema"."events"`,
+			Expected: false,
+		},
+		{
+			Name:     "event table - unset",
+			Key:      "EVENT_TABLE",
+			OldValue: ``,
+			NewValue: `"DB"."SCHEMA"."EVENTS"`,
+			Expected: false,
+		},
+		{
+			Name:     "other parameter",
+			Key:      "NETWORK_POLICY",
+			OldValue: `POLICY`,
+			NewValue: `"POLICY"`,
+			Expected: false,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, accountParameterSchema, map[string]interface{}{"key": tt.Key, "value": tt.NewValue})
+			assert.Equal(t, tt.Expected, suppressAccountParameterValueDiff("value", tt.OldValue, tt.NewValue, d))
+		})
+	}
+}
@@ -2,10 +2,10 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -30,24 +30,23 @@ func AccountPasswordPolicyAttachment() *schema.Resource {
 
 		Schema: accountPasswordPolicyAttachmentSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // CreateAccountPasswordPolicyAttachment implements schema.CreateFunc.
 func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
-	passwordPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("password_policy").(string)).(sdk.SchemaObjectIdentifier)
-	if !ok {
-		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy"))
+	passwordPolicy, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](d.Get("password_policy").(string))
+	if err != nil {
+		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`, err = %w", d.Get("password_policy"), err)
 	}
 	// passwordPolicy := sdk.NewAccountObjectIdentifier(d.Get("password_policy").(string))
 
-	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+	err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
 		Set: &sdk.AccountSet{
 			PasswordPolicy: passwordPolicy,
 		},
@@ -62,7 +61,10 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 }
 
 func ReadAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	passwordPolicy := helpers.DecodeSnowflakeID(d.Id())
+	passwordPolicy, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	if err := d.Set("password_policy", passwordPolicy.FullyQualifiedName()); err != nil {
 		return err
 	}
@@ -72,8 +74,7 @@ func ReadAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{
 
 // DeleteAccountPasswordPolicyAttachment implements schema.DeleteFunc.
 func DeleteAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
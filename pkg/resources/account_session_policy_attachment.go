@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountSessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"session_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the session policy to apply to the current account.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+// AccountSessionPolicyAttachment returns a pointer to the resource representing an account session policy attachment.
+func AccountSessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the session policy to use for the current account. To set the session policy of a different account, use a provider alias.",
+
+		Create: CreateAccountSessionPolicyAttachment,
+		Read:   ReadAccountSessionPolicyAttachment,
+		Delete: DeleteAccountSessionPolicyAttachment,
+
+		Schema: accountSessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountSessionPolicyAttachment implements schema.CreateFunc.
+func CreateAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	sessionPolicy, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](d.Get("session_policy").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			SessionPolicy: sessionPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(sessionPolicy))
+
+	return ReadAccountSessionPolicyAttachment(d, meta)
+}
+
+// ReadAccountSessionPolicyAttachment implements schema.ReadFunc.
+func ReadAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
+	ctx := context.Background()
+
+	currentAccount, err := providerContext.CurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the policies attached to the current account.
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(sdk.NewAccountObjectIdentifier(currentAccount), sdk.PolicyEntityDomainAccount))
+	if err != nil {
+		return err
+	}
+
+	sessionPolicyReferences := filterSessionPolicyReferences(policyReferences)
+
+	// Note: this means the resource has been deleted outside of Terraform.
+	if len(sessionPolicyReferences) == 0 {
+		log.Printf("[DEBUG] session policy attachment for account (%s) not found", currentAccount)
+		d.SetId("")
+		return nil
+	}
+
+	sessionPolicy := sdk.NewSchemaObjectIdentifier(
+		*sessionPolicyReferences[0].PolicyDb,
+		*sessionPolicyReferences[0].PolicySchema,
+		sessionPolicyReferences[0].PolicyName,
+	)
+	if err := d.Set("session_policy", sessionPolicy.FullyQualifiedName()); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(sessionPolicy))
+
+	return nil
+}
+
+// DeleteAccountSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			SessionPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// filterSessionPolicyReferences returns only the references of session policies; the other policy kinds
+// (e.g. password policies) can be attached to the same entity at the same time.
+func filterSessionPolicyReferences(policyReferences []sdk.PolicyReference) []sdk.PolicyReference {
+	sessionPolicyReferences := make([]sdk.PolicyReference, 0, len(policyReferences))
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind != "SESSION_POLICY" || policyReference.PolicyDb == nil || policyReference.PolicySchema == nil {
+			continue
+		}
+		sessionPolicyReferences = append(sessionPolicyReferences, policyReference)
+	}
+	return sessionPolicyReferences
+}
@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountSessionPolicyAttachment(t *testing.T) {
+	sessionPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_account_session_policy_attachment.test"
+	sessionPolicyId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, sessionPolicyName)
+
+	configVariables := config.Variables{
+		"session_policy_name": config.StringVariable(sessionPolicyName),
+		"database":            config.StringVariable(acc.TestDatabaseName),
+		"schema":              config.StringVariable(acc.TestSchemaName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckAccountSessionPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_AccountSessionPolicyAttachment/basic"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_policy", sessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", helpers.EncodeSnowflakeID(sessionPolicyId)),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_AccountSessionPolicyAttachment/basic"),
+				ConfigVariables:   configVariables,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSessionPolicyAttachmentDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	ctx := context.Background()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_account_session_policy_attachment" {
+			continue
+		}
+		currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+		if err != nil {
+			return err
+		}
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(
+			sdk.NewAccountObjectIdentifier(currentAccount),
+			sdk.PolicyEntityDomainAccount,
+		))
+		if err != nil {
+			return err
+		}
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == "SESSION_POLICY" {
+				return fmt.Errorf("account session policy attachment %v still exists", policyReference.PolicyName)
+			}
+		}
+	}
+	return nil
+}
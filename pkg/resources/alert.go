@@ -2,7 +2,6 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strconv"
@@ -10,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -113,16 +113,18 @@ func Alert() *schema.Resource {
 
 		Schema: alertSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // ReadAlert implements schema.ReadFunc.
 func ReadAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	ctx := context.Background()
 	alert, err := client.Alerts.ShowByID(ctx, objectIdentifier)
@@ -204,8 +206,7 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 
 // CreateAlert implements schema.CreateFunc.
 func CreateAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -272,9 +273,11 @@ func getAlertSchedule(v interface{}) string {
 
 // UpdateAlert implements schema.UpdateFunc.
 func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
 
 	enabled := d.Get("enabled").(bool)
@@ -354,12 +357,14 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAlert implements schema.DeleteFunc.
 func DeleteAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.Alerts.Drop(ctx, objectIdentifier)
+	err = client.Alerts.Drop(ctx, objectIdentifier)
 	if err != nil {
 		return err
 	}
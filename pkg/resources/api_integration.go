@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -125,7 +125,7 @@ func APIIntegration() *schema.Resource {
 
 		Schema: apiIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
 		},
 	}
 }
@@ -140,9 +140,8 @@ func toApiIntegrationEndpointPrefix(paths []string) []sdk.ApiIntegrationEndpoint
 
 // CreateAPIIntegration implements schema.CreateFunc.
 func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -211,10 +210,12 @@ func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAPIIntegration implements schema.ReadFunc.
 func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	integration, err := client.ApiIntegrations.ShowByID(ctx, id)
 	if err != nil {
@@ -316,10 +317,12 @@ func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateAPIIntegration implements schema.UpdateFunc.
 func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	var runSetStatement bool
 	setRequest := sdk.NewApiIntegrationSetRequest()
@@ -403,12 +406,14 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAPIIntegration implements schema.DeleteFunc.
 func DeleteAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.ApiIntegrations.Drop(ctx, sdk.NewDropApiIntegrationRequest(id))
+	err = client.ApiIntegrations.Drop(ctx, sdk.NewDropApiIntegrationRequest(id))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the application.",
+		ForceNew:    true,
+	},
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the name of the application package used to create the application.",
+		ForceNew:    true,
+	},
+	"version": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the version of the application package used to create the application. If neither `version` nor `version_directory` is set, the release directive of the application package is used.",
+		ConflictsWith: []string{"version_directory"},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// unquoted version identifiers are stored in upper case
+			return strings.EqualFold(old, new)
+		},
+	},
+	"patch": {
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Default:       -1,
+		Description:   "Specifies the patch of the version used to create the application. Default value for this field is set to -1, which means the patch is chosen by Snowflake.",
+		RequiredWith:  []string{"version"},
+		ConflictsWith: []string{"version_directory"},
+		ValidateFunc:  validation.IntAtLeast(-1),
+	},
+	"version_directory": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the path to the stage containing the application files (e.g. `@\"db\".\"schema\".\"stage\"/dev`), which creates the application in development mode.",
+		ConflictsWith: []string{"version"},
+	},
+	"debug_mode": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Enables debug mode for the application. Debug mode is only allowed for applications created in the same account as the application package.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application.",
+	},
+	"tag": tagReferenceSchema,
+	"source_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the source of the application.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The label of the installed version of the application.",
+	},
+}
+
+// Application returns a pointer to the resource representing an application.
+func Application() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplication,
+		Read:   ReadApplication,
+		Update: UpdateApplication,
+		Delete: DeleteApplication,
+
+		Schema: applicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+	}
+}
+
+// CreateApplication implements schema.CreateFunc.
+func CreateApplication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	request := sdk.NewCreateApplicationRequest(id, sdk.NewAccountObjectIdentifier(d.Get("application_package").(string)))
+
+	if version := getApplicationVersion(d); version != nil {
+		request.WithVersion(version)
+	}
+	if v, ok := d.GetOk("debug_mode"); ok {
+		request.WithDebugMode(sdk.Bool(v.(bool)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	ctx := context.Background()
+	if err := client.Applications.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating application %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadApplication(d, meta)
+}
+
+// ReadApplication implements schema.ReadFunc.
+func ReadApplication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	application, err := client.Applications.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] application (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	properties, err := client.Applications.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":                application.Name,
+		"application_package": application.Source,
+		"comment":             application.Comment,
+		"source_type":         application.SourceType,
+		"label":               application.Label,
+		"debug_mode":          false,
+	}
+
+	// the installed version is only tracked when it's set in the configuration, otherwise it's chosen by the release directive
+	if d.Get("version").(string) != "" {
+		values["version"] = application.Version
+		if d.Get("patch").(int) != -1 {
+			values["patch"] = application.Patch
+		}
+	}
+
+	for _, property := range properties {
+		if strings.EqualFold(property.Property, "debug_mode") && property.Value != "" {
+			debugMode, err := strconv.ParseBool(property.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse debug_mode value %s for application %v err = %w", property.Value, id.Name(), err)
+			}
+			values["debug_mode"] = debugMode
+		}
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateApplication implements schema.UpdateFunc.
+func UpdateApplication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if d.HasChanges("version", "patch", "version_directory") {
+		request := sdk.NewAlterApplicationRequest(id)
+		if version := getApplicationVersion(d); version != nil {
+			request.WithUpgradeVersion(version)
+		} else {
+			// upgrades the application to the version in the release directive
+			request.WithUpgrade(sdk.Bool(true))
+		}
+		if err := client.Applications.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error upgrading application %v err = %w", id.Name(), err)
+		}
+	}
+
+	set, unset := sdk.NewApplicationSetRequest(), sdk.NewApplicationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("debug_mode") {
+		if d.Get("debug_mode").(bool) {
+			set.WithDebugMode(sdk.Bool(true))
+			runSet = true
+		} else {
+			unset.WithDebugMode(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating application %v err = %w", id.Name(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating application %v err = %w", id.Name(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+
+		if len(unsetTags) > 0 {
+			if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+
+		if len(setTags) > 0 {
+			if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadApplication(d, meta)
+}
+
+// DeleteApplication implements schema.DeleteFunc.
+func DeleteApplication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Applications.Drop(ctx, sdk.NewDropApplicationRequest(id)); err != nil {
+		return fmt.Errorf("error deleting application %v err = %w", id.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getApplicationVersion(d *schema.ResourceData) *sdk.ApplicationVersionRequest {
+	if v, ok := d.GetOk("version_directory"); ok {
+		return sdk.NewApplicationVersionRequest().WithVersionDirectory(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("version"); ok {
+		var patch *int
+		if p := d.Get("patch").(int); p != -1 {
+			patch = sdk.Int(p)
+		}
+		return sdk.NewApplicationVersionRequest().WithVersionAndPatch(sdk.NewVersionAndPatchRequest(v.(string), patch))
+	}
+	return nil
+}
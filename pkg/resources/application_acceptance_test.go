@@ -0,0 +1,153 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Application_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	applicationPackageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, stageName)
+	resourceName := "snowflake_application.test"
+	versionResourceName := "snowflake_application_package_version.test"
+
+	packageVariables := config.Variables{
+		"application_package_name": config.StringVariable(applicationPackageName),
+		"stage_name":               config.StringVariable(stageName),
+		"database":                 config.StringVariable(acc.TestDatabaseName),
+		"schema":                   config.StringVariable(acc.TestSchemaName),
+	}
+	m := func(label string, debugMode bool, comment string) config.Variables {
+		return config.Variables{
+			"name":                     config.StringVariable(name),
+			"application_package_name": config.StringVariable(applicationPackageName),
+			"stage_name":               config.StringVariable(stageName),
+			"database":                 config.StringVariable(acc.TestDatabaseName),
+			"schema":                   config.StringVariable(acc.TestSchemaName),
+			"version_name":             config.StringVariable("V1"),
+			"label":                    config.StringVariable(label),
+			"debug_mode":               config.BoolVariable(debugMode),
+			"comment":                  config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			// the stage has to exist before the application files are uploaded
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Application/package"),
+				ConfigVariables: packageVariables,
+			},
+			{
+				PreConfig: func() {
+					putApplicationFilesOnStage(t, stageId, "manifest.yml", "setup.sql")
+				},
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Application/application"),
+				ConfigVariables: m("first label", false, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(versionResourceName, "application_package", applicationPackageName),
+					resource.TestCheckResourceAttr(versionResourceName, "version", "V1"),
+					resource.TestCheckResourceAttr(versionResourceName, "label", "first label"),
+					resource.TestCheckResourceAttr(versionResourceName, "patch", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "application_package", applicationPackageName),
+					resource.TestCheckResourceAttr(resourceName, "version", "V1"),
+					resource.TestCheckResourceAttr(resourceName, "patch", "0"),
+					resource.TestCheckResourceAttr(resourceName, "label", "first label"),
+					resource.TestCheckResourceAttr(resourceName, "debug_mode", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+				),
+			},
+			// ADD PATCH AND UPGRADE IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Application/application"),
+				ConfigVariables: m("second label", true, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(versionResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(versionResourceName, "label", "second label"),
+					resource.TestCheckResourceAttr(versionResourceName, "patch", "1"),
+					resource.TestCheckResourceAttr(resourceName, "patch", "1"),
+					resource.TestCheckResourceAttr(resourceName, "label", "second label"),
+					resource.TestCheckResourceAttr(resourceName, "debug_mode", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_Application/application"),
+				ConfigVariables:   m("second label", true, ""),
+				ResourceName:      versionResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the location of the files isn't returned by SHOW VERSIONS
+				ImportStateVerifyIgnore: []string{"using"},
+			},
+		},
+	})
+}
+
+func putApplicationFilesOnStage(t *testing.T, stageId sdk.SchemaObjectIdentifier, filenames ...string) {
+	t.Helper()
+	client, err := sdk.NewDefaultClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, filename := range filenames {
+		path, err := filepath.Abs(filepath.Join("testdata", "TestAcc_Application", "files", filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.ExecForTests(ctx, fmt.Sprintf(`PUT 'file://%s' @%s AUTO_COMPRESS = FALSE OVERWRITE = TRUE`, path, stageId.FullyQualifiedName())); err != nil {
+			t.Fatal(fmt.Errorf("error putting %s on stage: %w", filename, err))
+		}
+	}
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		ctx := context.Background()
+		switch rs.Type {
+		case "snowflake_application":
+			id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.AccountObjectIdentifier)
+			if existingApplication, err := client.Applications.ShowByID(ctx, id); err == nil {
+				return fmt.Errorf("application %v still exists", existingApplication.Name)
+			}
+		case "snowflake_application_package":
+			id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.AccountObjectIdentifier)
+			if existingApplicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id); err == nil {
+				return fmt.Errorf("application package %v still exists", existingApplicationPackage.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the application package.",
+		ForceNew:    true,
+	},
+	"distribution": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(sdk.DistributionInternal),
+		Description:  fmt.Sprintf("Specifies the type of Snowflake accounts that can install an application from the application package. Allowed values are %s and %s.", sdk.DistributionInternal, sdk.DistributionExternal),
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.DistributionInternal), string(sdk.DistributionExternal)}, false),
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the application package.",
+		ValidateFunc: validation.IntBetween(0, 90),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// ApplicationPackage returns a pointer to the resource representing an application package.
+func ApplicationPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplicationPackage,
+		Read:   ReadApplicationPackage,
+		Update: UpdateApplicationPackage,
+		Delete: DeleteApplicationPackage,
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+	}
+}
+
+// CreateApplicationPackage implements schema.CreateFunc.
+func CreateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	request := sdk.NewCreateApplicationPackageRequest(id).
+		WithDistribution(sdk.DistributionPointer(sdk.Distribution(d.Get("distribution").(string))))
+
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		request.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating application package %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// ReadApplicationPackage implements schema.ReadFunc.
+func ReadApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] application package (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("name", applicationPackage.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("distribution", applicationPackage.Distribution); err != nil {
+		return err
+	}
+
+	if err := d.Set("data_retention_time_in_days", applicationPackage.RetentionTime); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", applicationPackage.Comment); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateApplicationPackage implements schema.UpdateFunc.
+func UpdateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	set, unset := sdk.NewApplicationPackageSetRequest(), sdk.NewApplicationPackageUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("distribution") {
+		set.WithDistribution(sdk.DistributionPointer(sdk.Distribution(d.Get("distribution").(string))))
+		runSet = true
+	}
+
+	if d.HasChange("data_retention_time_in_days") {
+		set.WithDataRetentionTimeInDays(sdk.Int(d.Get("data_retention_time_in_days").(int)))
+		runSet = true
+	}
+
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating application package %v err = %w", id.Name(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating application package %v err = %w", id.Name(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+
+		if len(unsetTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+
+		if len(setTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// DeleteApplicationPackage implements schema.DeleteFunc.
+func DeleteApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Drop(ctx, sdk.NewDropApplicationPackageRequest(id)); err != nil {
+		return fmt.Errorf("error deleting application package %v err = %w", id.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// encodeApplicationPackageObjectID encodes the id of an object managed inside the application package (e.g. a version
+// or a release directive) as <application_package>|<object_name>.
+func encodeApplicationPackageObjectID(applicationPackage sdk.AccountObjectIdentifier, objectName string) string {
+	delimiter := rune(helpers.IDDelimiter[0])
+	return sdk.QuoteIdentifierPart(applicationPackage.Name(), delimiter) + helpers.IDDelimiter + sdk.QuoteIdentifierPart(objectName, delimiter)
+}
+
+// decodeApplicationPackageObjectID decodes the id generated by encodeApplicationPackageObjectID.
+func decodeApplicationPackageObjectID(id string) (sdk.AccountObjectIdentifier, string, error) {
+	parts, err := sdk.SplitIdentifier(id, rune(helpers.IDDelimiter[0]))
+	if err != nil {
+		return sdk.AccountObjectIdentifier{}, "", err
+	}
+	if len(parts) != 2 {
+		return sdk.AccountObjectIdentifier{}, "", fmt.Errorf("unexpected id %s, expected <application_package>%s<name>", id, helpers.IDDelimiter)
+	}
+	return sdk.NewAccountObjectIdentifier(parts[0]), parts[1], nil
+}
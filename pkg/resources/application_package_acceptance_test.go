@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackage_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_application_package.test"
+	m := func(distribution string, dataRetentionTimeInDays int, comment string) config.Variables {
+		return config.Variables{
+			"name":                        config.StringVariable(name),
+			"distribution":                config.StringVariable(distribution),
+			"data_retention_time_in_days": config.IntegerVariable(dataRetentionTimeInDays),
+			"comment":                     config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckApplicationPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ApplicationPackage/basic"),
+				ConfigVariables: m("INTERNAL", 1, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "INTERNAL"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+				),
+			},
+			// CHANGE PROPERTIES IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ApplicationPackage/basic"),
+				ConfigVariables: m("INTERNAL", 5, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_ApplicationPackage/basic"),
+				ConfigVariables:   m("INTERNAL", 5, ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationPackageDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_application_package" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.AccountObjectIdentifier)
+		existingApplicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("application package %v still exists", existingApplicationPackage.Name)
+		}
+	}
+	return nil
+}
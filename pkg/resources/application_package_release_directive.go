@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultReleaseDirectiveName is the name of the release directive applied to all consumers without a custom release directive.
+const defaultReleaseDirectiveName = "DEFAULT"
+
+var applicationPackageReleaseDirectiveSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The application package in which the release directive is set.",
+		ForceNew:    true,
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: fmt.Sprintf("Specifies the name of the release directive. Use %s to manage the default release directive of the application package.", defaultReleaseDirectiveName),
+		ForceNew:    true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// unquoted release directive names are stored in upper case
+			return strings.EqualFold(old, new)
+		},
+	},
+	"accounts": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: fmt.Sprintf("Specifies the consumer accounts (in the <organization_name>.<account_name> format) to which the release directive applies. Required for all release directives except %s.", defaultReleaseDirectiveName),
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the version of the application package to which the release directive points.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// unquoted version identifiers are stored in upper case
+			return strings.EqualFold(old, new)
+		},
+	},
+	"patch": {
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Specifies the patch of the version to which the release directive points.",
+	},
+}
+
+// ApplicationPackageReleaseDirective returns a pointer to the resource representing a release directive of an application package.
+func ApplicationPackageReleaseDirective() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplicationPackageReleaseDirective,
+		Read:   ReadApplicationPackageReleaseDirective,
+		Update: UpdateApplicationPackageReleaseDirective,
+		Delete: DeleteApplicationPackageReleaseDirective,
+
+		Schema: applicationPackageReleaseDirectiveSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func isDefaultReleaseDirective(name string) bool {
+	return strings.EqualFold(name, defaultReleaseDirectiveName)
+}
+
+// CreateApplicationPackageReleaseDirective implements schema.CreateFunc.
+func CreateApplicationPackageReleaseDirective(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	applicationPackageId := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	patch := d.Get("patch").(int)
+	accounts := expandStringList(d.Get("accounts").(*schema.Set).List())
+
+	request := sdk.NewAlterApplicationPackageRequest(applicationPackageId)
+	if isDefaultReleaseDirective(name) {
+		if len(accounts) > 0 {
+			return fmt.Errorf("accounts can't be set for the %s release directive", defaultReleaseDirectiveName)
+		}
+		request.WithSetDefaultReleaseDirective(sdk.NewSetDefaultReleaseDirectiveRequest(version, patch))
+	} else {
+		if len(accounts) == 0 {
+			return fmt.Errorf("accounts are required for the release directive %s", name)
+		}
+		request.WithSetReleaseDirective(sdk.NewSetReleaseDirectiveRequest(name, accounts, version, patch))
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Alter(ctx, request); err != nil {
+		return fmt.Errorf("error setting release directive %v of application package %v err = %w", name, applicationPackageId.Name(), err)
+	}
+
+	d.SetId(encodeApplicationPackageObjectID(applicationPackageId, name))
+
+	return ReadApplicationPackageReleaseDirective(d, meta)
+}
+
+// ReadApplicationPackageReleaseDirective implements schema.ReadFunc.
+func ReadApplicationPackageReleaseDirective(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, name, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	releaseDirectives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, sdk.NewShowReleaseDirectivesApplicationPackageRequest(applicationPackageId))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If the application package is not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] release directives of application package (%s) not found", applicationPackageId.Name())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// a release directive applied to multiple accounts is listed once per account
+	var releaseDirective *sdk.ApplicationPackageReleaseDirective
+	accounts := make([]string, 0)
+	for i, r := range releaseDirectives {
+		if !strings.EqualFold(r.Name, name) {
+			continue
+		}
+		releaseDirective = &releaseDirectives[i]
+		if r.TargetName != "" && !isDefaultReleaseDirective(name) {
+			accounts = append(accounts, r.TargetName)
+		}
+	}
+	if releaseDirective == nil {
+		log.Printf("[DEBUG] release directive (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("application_package", applicationPackageId.Name()); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", releaseDirective.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("accounts", accounts); err != nil {
+		return err
+	}
+
+	if err := d.Set("version", releaseDirective.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("patch", releaseDirective.Patch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateApplicationPackageReleaseDirective implements schema.UpdateFunc.
+func UpdateApplicationPackageReleaseDirective(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, name, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("version", "patch") {
+		version := d.Get("version").(string)
+		patch := d.Get("patch").(int)
+
+		request := sdk.NewAlterApplicationPackageRequest(applicationPackageId)
+		if isDefaultReleaseDirective(name) {
+			request.WithSetDefaultReleaseDirective(sdk.NewSetDefaultReleaseDirectiveRequest(version, patch))
+		} else {
+			request.WithModifyReleaseDirective(sdk.NewModifyReleaseDirectiveRequest(name, version, patch))
+		}
+
+		ctx := context.Background()
+		if err := client.ApplicationPackages.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error updating release directive %v of application package %v err = %w", name, applicationPackageId.Name(), err)
+		}
+	}
+
+	return ReadApplicationPackageReleaseDirective(d, meta)
+}
+
+// DeleteApplicationPackageReleaseDirective implements schema.DeleteFunc.
+func DeleteApplicationPackageReleaseDirective(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, name, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the default release directive can't be unset, it's only removed from the state
+	if isDefaultReleaseDirective(name) {
+		log.Printf("[DEBUG] default release directive of application package (%s) can't be unset, removing it from the state", applicationPackageId.Name())
+		d.SetId("")
+		return nil
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackageId).WithUnsetReleaseDirective(sdk.NewUnsetReleaseDirectiveRequest(name))); err != nil {
+		return fmt.Errorf("error unsetting release directive %v of application package %v err = %w", name, applicationPackageId.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
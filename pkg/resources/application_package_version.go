@@ -0,0 +1,186 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackageVersionSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The application package to which the version is added.",
+		ForceNew:    true,
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the version. The version identifier must be unique within the application package.",
+		ForceNew:    true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// unquoted version identifiers are stored in upper case
+			return strings.EqualFold(old, new)
+		},
+	},
+	"using": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the path to the stage containing the application code files and manifest (e.g. `@\"db\".\"schema\".\"stage\"/v1`). Changing it adds a new patch for the version.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the version label that is displayed to consumers. Changing it adds a new patch for the version.",
+	},
+	"patch": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The latest patch of the version.",
+	},
+}
+
+// ApplicationPackageVersion returns a pointer to the resource representing a version of an application package.
+// The resource adds the version on creation, a new patch on every change of the source files location or label, and
+// drops the version on deletion.
+func ApplicationPackageVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateApplicationPackageVersion,
+		Read:   ReadApplicationPackageVersion,
+		Update: UpdateApplicationPackageVersion,
+		Delete: DeleteApplicationPackageVersion,
+
+		Schema: applicationPackageVersionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			// a new patch is added, so resources referencing the patch are updated in the same apply
+			if diff.Id() != "" && diff.HasChanges("using", "label") {
+				return diff.SetNewComputed("patch")
+			}
+			return nil
+		},
+	}
+}
+
+// CreateApplicationPackageVersion implements schema.CreateFunc.
+func CreateApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	applicationPackageId := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	version := d.Get("version").(string)
+
+	request := sdk.NewAddVersionRequest(d.Get("using").(string)).WithVersionIdentifier(sdk.String(version))
+	if v, ok := d.GetOk("label"); ok {
+		request.WithLabel(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackageId).WithAddVersion(request)); err != nil {
+		return fmt.Errorf("error adding version %v to application package %v err = %w", version, applicationPackageId.Name(), err)
+	}
+
+	d.SetId(encodeApplicationPackageObjectID(applicationPackageId, version))
+
+	return ReadApplicationPackageVersion(d, meta)
+}
+
+// ReadApplicationPackageVersion implements schema.ReadFunc.
+func ReadApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, version, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	versions, err := client.ApplicationPackages.ShowVersions(ctx, sdk.NewShowVersionsApplicationPackageRequest(applicationPackageId))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If the application package is not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] versions of application package (%s) not found", applicationPackageId.Name())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// every patch of the version is listed as a separate row, the latest one is kept in the state
+	var latestPatch *sdk.ApplicationPackageVersion
+	for i, v := range versions {
+		if strings.EqualFold(v.Version, version) && (latestPatch == nil || v.Patch > latestPatch.Patch) {
+			latestPatch = &versions[i]
+		}
+	}
+	if latestPatch == nil {
+		log.Printf("[DEBUG] version (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("application_package", applicationPackageId.Name()); err != nil {
+		return err
+	}
+
+	if err := d.Set("version", latestPatch.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("label", latestPatch.Label); err != nil {
+		return err
+	}
+
+	if err := d.Set("patch", latestPatch.Patch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateApplicationPackageVersion implements schema.UpdateFunc.
+func UpdateApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, version, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("using", "label") {
+		request := sdk.NewAddPatchForVersionRequest(sdk.String(version), d.Get("using").(string))
+		if v, ok := d.GetOk("label"); ok {
+			request.WithLabel(sdk.String(v.(string)))
+		}
+
+		ctx := context.Background()
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackageId).WithAddPatchForVersion(request)); err != nil {
+			return fmt.Errorf("error adding patch for version %v of application package %v err = %w", version, applicationPackageId.Name(), err)
+		}
+	}
+
+	return ReadApplicationPackageVersion(d, meta)
+}
+
+// DeleteApplicationPackageVersion implements schema.DeleteFunc.
+func DeleteApplicationPackageVersion(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	applicationPackageId, version, err := decodeApplicationPackageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackageId).WithDropVersion(sdk.NewDropVersionRequest(version))); err != nil {
+		return fmt.Errorf("error dropping version %v of application package %v err = %w", version, applicationPackageId.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
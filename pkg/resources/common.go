@@ -1,8 +1,12 @@
 package resources
 
 import (
+	"context"
+	"fmt"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,3 +27,20 @@ func DiffSuppressStatement(_, old, new string, _ *schema.ResourceData) bool {
 func normalizeQuery(str string) string {
 	return strings.TrimSpace(space.ReplaceAllString(str, " "))
 }
+
+// ImportName is the importer for resources identified by a single object identifier (see helpers.EncodeSnowflakeID).
+// Contrary to schema.ImportStatePassthroughContext, it fails early for ids that cannot be parsed into the expected identifier.
+func ImportName[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | sdk.SchemaObjectIdentifier | sdk.TableColumnIdentifier](_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if _, err := helpers.ParseSnowflakeID[T](d.Id()); err != nil {
+		return nil, fmt.Errorf("invalid import id %s, err = %w", d.Id(), err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// ImportSchemaObjectIdentifierWithArguments is the importer for functions and procedures identified by their fully qualified name with argument types.
+func ImportSchemaObjectIdentifierWithArguments(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if _, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id()); err != nil {
+		return nil, fmt.Errorf("invalid import id %s, err = %w", d.Id(), err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportName(t *testing.T) {
+	resourceData := func(id string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+		d.SetId(id)
+		return d
+	}
+
+	t.Run("valid id", func(t *testing.T) {
+		d := resourceData(`database|"sche|ma"|table`)
+		result, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), d, nil)
+		require.NoError(t, err)
+		require.Equal(t, []*schema.ResourceData{d}, result)
+	})
+
+	t.Run("id of other identifier type", func(t *testing.T) {
+		_, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), resourceData(`database|schema`), nil)
+		require.ErrorContains(t, err, "invalid import id database|schema")
+	})
+
+	t.Run("malformed id", func(t *testing.T) {
+		_, err := ImportName[sdk.AccountObjectIdentifier](context.Background(), resourceData(`"database`), nil)
+		require.ErrorContains(t, err, `invalid import id "database`)
+	})
+}
+
+func TestImportSchemaObjectIdentifierWithArguments(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+
+	d.SetId(`"database"."schema"."function"(VARCHAR, NUMBER)`)
+	_, err := ImportSchemaObjectIdentifierWithArguments(context.Background(), d, nil)
+	require.NoError(t, err)
+
+	d.SetId(`database.function`)
+	_, err = ImportSchemaObjectIdentifierWithArguments(context.Background(), d, nil)
+	require.ErrorContains(t, err, "invalid import id database.function")
+}
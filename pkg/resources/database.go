@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -95,8 +97,7 @@ func Database() *schema.Resource {
 
 // CreateDatabase implements schema.CreateFunc.
 func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -183,16 +184,19 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 
 	database, err := client.Databases.ShowByID(ctx, id)
 	if err != nil {
-		d.SetId("")
-		return nil
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] database (%s) not found or we are not authorized. Err: %s", d.Id(), err)
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
 	if err := d.Set("name", database.Name); err != nil {
@@ -216,8 +220,7 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	if d.HasChange("name") {
@@ -331,8 +334,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
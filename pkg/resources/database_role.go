@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,17 +41,19 @@ func DatabaseRole() *schema.Resource {
 
 		Schema: databaseRoleSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.DatabaseObjectIdentifier],
 		},
 	}
 }
 
 // ReadDatabaseRole implements schema.ReadFunc.
 func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	ctx := context.Background()
 	databaseRole, err := client.DatabaseRoles.ShowByID(ctx, objectIdentifier)
@@ -78,8 +80,7 @@ func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // CreateDatabaseRole implements schema.CreateFunc.
 func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	databaseName := d.Get("database").(string)
 	roleName := d.Get("name").(string)
@@ -104,10 +105,12 @@ func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateDatabaseRole implements schema.UpdateFunc.
 func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("comment") {
 		_, newVal := d.GetChange("comment")
@@ -125,14 +128,16 @@ func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteDatabaseRole implements schema.DeleteFunc.
 func DeleteDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	ctx := context.Background()
 	dropRequest := sdk.NewDropDatabaseRoleRequest(objectIdentifier)
-	err := client.DatabaseRoles.Drop(ctx, dropRequest)
+	err = client.DatabaseRoles.Drop(ctx, dropRequest)
 	if err != nil {
 		return err
 	}
@@ -2,13 +2,13 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"log"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -167,17 +167,19 @@ func DynamicTable() *schema.Resource {
 
 		Schema: dynamicTableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // ReadDynamicTable implements schema.ReadFunc.
 func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	dynamicTable, err := client.DynamicTables.ShowByID(context.Background(), id)
 	if err != nil {
 		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
@@ -305,8 +307,7 @@ func parseTargetLag(v interface{}) sdk.TargetLag {
 
 // CreateDynamicTable implements schema.CreateFunc.
 func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -340,10 +341,12 @@ func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateDynamicTable implements schema.UpdateFunc.
 func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	request := sdk.NewAlterDynamicTableRequest(id)
 
 	runSet := false
@@ -382,10 +385,12 @@ func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteDynamicTable implements schema.DeleteFunc.
 func DeleteDynamicTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	if err := client.DynamicTables.Drop(context.Background(), sdk.NewDropDynamicTableRequest(id)); err != nil {
 		return err
 	}
@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -46,7 +46,7 @@ func EmailNotificationIntegration() *schema.Resource {
 
 		Schema: emailNotificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
 		},
 	}
 }
@@ -61,9 +61,8 @@ func toAllowedRecipients(emails []string) []sdk.NotificationIntegrationAllowedRe
 
 // CreateEmailNotificationIntegration implements schema.CreateFunc.
 func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -93,10 +92,12 @@ func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}
 
 // ReadEmailNotificationIntegration implements schema.ReadFunc.
 func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	integration, err := client.NotificationIntegrations.ShowByID(ctx, id)
 	if err != nil {
@@ -147,10 +148,12 @@ func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{})
 
 // UpdateEmailNotificationIntegration implements schema.UpdateFunc.
 func UpdateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	var runSetStatement bool
 	var runUnsetStatement bool
@@ -202,12 +205,14 @@ func UpdateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}
 
 // DeleteEmailNotificationIntegration implements schema.DeleteFunc.
 func DeleteEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id))
+	err = client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,403 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the event table; must be unique for the database and schema in which the event table is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the event table.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the event table.",
+		ForceNew:    true,
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the event table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		Description:  "Specifies the retention period for the event table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the event table. Default value for this field is set to -1, which is a fallback to use Snowflake default.",
+		ValidateFunc: validation.IntBetween(-1, 90),
+	},
+	"max_data_extension_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		Description:  "Specifies the maximum number of days for which Snowflake can extend the data retention period for the event table to prevent streams on the event table from becoming stale. Default value for this field is set to -1, which is a fallback to use Snowflake default.",
+		ValidateFunc: validation.IntBetween(-1, 90),
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table.",
+	},
+	"default_ddl_collation": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a default collation specification for the columns in the event table, including columns added to the event table in the future.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	"row_access_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies a row access policy to set on the event table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Fully qualified name of the row access policy.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+				},
+				"on": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					Description: "Defines which columns will be passed to the row access policy.",
+				},
+			},
+		},
+	},
+	"tag": tagReferenceSchema,
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the event table.",
+	},
+}
+
+// EventTable returns a pointer to the resource representing an event table.
+func EventTable() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateEventTable,
+		Read:   ReadEventTable,
+		Update: UpdateEventTable,
+		Delete: DeleteEventTable,
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateEventTable implements schema.CreateFunc.
+func CreateEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateEventTableRequest(id)
+
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]interface{})))
+	}
+	if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+		request.WithDataRetentionTimeInDays(sdk.Int(v))
+	}
+	if v := d.Get("max_data_extension_time_in_days").(int); v != -1 {
+		request.WithMaxDataExtensionTimeInDays(sdk.Int(v))
+	}
+	if v, ok := d.GetOk("change_tracking"); ok {
+		request.WithChangeTracking(sdk.Bool(v.(bool)))
+	}
+	if v, ok := d.GetOk("default_ddl_collation"); ok {
+		request.WithDefaultDdlCollation(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	policy, err := getEventTableRowAccessPolicy(d.Get("row_access_policy").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if policy != nil {
+		request.WithRowAccessPolicy(&sdk.TableRowAccessPolicy{
+			Name: policy.RowAccessPolicy,
+			On:   policy.On,
+		})
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	ctx := context.Background()
+	if err := client.EventTables.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating event table %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadEventTable(d, meta)
+}
+
+// ReadEventTable implements schema.ReadFunc.
+func ReadEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	eventTable, err := client.EventTables.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] event table (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// SHOW EVENT TABLES doesn't return the table properties, but the event tables are listed by SHOW TABLES as well
+	table, err := client.Tables.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":            eventTable.Name,
+		"database":        eventTable.DatabaseName,
+		"schema":          eventTable.SchemaName,
+		"cluster_by":      table.GetClusterByKeys(),
+		"change_tracking": table.ChangeTracking,
+		"comment":         eventTable.Comment,
+		"qualified_name":  id.FullyQualifiedName(),
+	}
+
+	// the parameters are only read when they are set on the event table itself, otherwise they are inherited (-1)
+	for key, parameter := range map[string]sdk.ObjectParameter{
+		"data_retention_time_in_days":     sdk.ObjectParameterDataRetentionTimeInDays,
+		"max_data_extension_time_in_days": sdk.ObjectParameterMaxDataExtensionTimeInDays,
+	} {
+		p, err := client.Parameters.ShowObjectParameter(ctx, parameter, sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: id})
+		if err != nil {
+			return err
+		}
+		values[key] = -1
+		if p.Level == sdk.ParameterTypeTable {
+			v, err := strconv.Atoi(p.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse %s value %s for event table %v err = %w", parameter, p.Value, id.FullyQualifiedName(), err)
+			}
+			values[key] = v
+		}
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return err
+	}
+	rowAccessPolicy := make([]any, 0, 1)
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind != "ROW_ACCESS_POLICY" || policyReference.PolicyDb == nil || policyReference.PolicySchema == nil {
+			continue
+		}
+		// the columns passed to the policy are kept from the configuration
+		var on []interface{}
+		if current := d.Get("row_access_policy").([]interface{}); len(current) > 0 && current[0] != nil {
+			on = current[0].(map[string]interface{})["on"].([]interface{})
+		}
+		rowAccessPolicy = append(rowAccessPolicy, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*policyReference.PolicyDb, *policyReference.PolicySchema, policyReference.PolicyName).FullyQualifiedName(),
+			"on":          on,
+		})
+	}
+	values["row_access_policy"] = rowAccessPolicy
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateEventTable implements schema.UpdateFunc.
+func UpdateEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	set, unset := sdk.NewEventTableSetRequest(), sdk.NewEventTableUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("data_retention_time_in_days") {
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			set.WithDataRetentionTimeInDays(sdk.Int(v))
+			runSet = true
+		} else {
+			unset.WithDataRetentionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("max_data_extension_time_in_days") {
+		if v := d.Get("max_data_extension_time_in_days").(int); v != -1 {
+			set.WithMaxDataExtensionTimeInDays(sdk.Int(v))
+			runSet = true
+		} else {
+			unset.WithMaxDataExtensionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+		runSet = true
+	}
+
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating event table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating event table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewEventTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(&clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(sdk.Bool(true))
+		}
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+			return fmt.Errorf("error updating clustering key of event table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		oldPolicy, newPolicy := d.GetChange("row_access_policy")
+		request := sdk.NewAlterEventTableRequest(id)
+		drop, err := getEventTableRowAccessPolicy(oldPolicy.([]interface{}))
+		if err != nil {
+			return err
+		}
+		add, err := getEventTableRowAccessPolicy(newPolicy.([]interface{}))
+		if err != nil {
+			return err
+		}
+		switch {
+		case drop != nil && add != nil:
+			request.WithDropAndAddRowAccessPolicy(sdk.NewEventTableDropAndAddRowAccessPolicyRequest(
+				*sdk.NewEventTableDropRowAccessPolicyRequest(drop.RowAccessPolicy),
+				*add,
+			))
+		case drop != nil:
+			request.WithDropRowAccessPolicy(sdk.NewEventTableDropRowAccessPolicyRequest(drop.RowAccessPolicy))
+		case add != nil:
+			request.WithAddRowAccessPolicy(add)
+		}
+		if err := client.EventTables.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error updating row access policy of event table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+
+		if len(unsetTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error unsetting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+
+		if len(setTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error setting tags on %v, err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadEventTable(d, meta)
+}
+
+// DeleteEventTable implements schema.DeleteFunc.
+func DeleteEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.EventTables.Drop(ctx, sdk.NewDropEventTableRequest(id)); err != nil {
+		return fmt.Errorf("error deleting event table %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getEventTableRowAccessPolicy(rowAccessPolicy []interface{}) (*sdk.EventTableAddRowAccessPolicyRequest, error) {
+	if len(rowAccessPolicy) == 0 || rowAccessPolicy[0] == nil {
+		return nil, nil
+	}
+	policy := rowAccessPolicy[0].(map[string]interface{})
+	policyId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](policy["policy_name"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewEventTableAddRowAccessPolicyRequest(
+		policyId,
+		expandStringList(policy["on"].([]interface{})),
+	), nil
+}
@@ -0,0 +1,149 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTable_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_event_table.test"
+	m := func(clusterBy []string, dataRetentionTimeInDays int, maxDataExtensionTimeInDays int, changeTracking bool, comment string) config.Variables {
+		clusterByVariables := make([]config.Variable, len(clusterBy))
+		for i, key := range clusterBy {
+			clusterByVariables[i] = config.StringVariable(key)
+		}
+		return config.Variables{
+			"name":                            config.StringVariable(name),
+			"database":                        config.StringVariable(acc.TestDatabaseName),
+			"schema":                          config.StringVariable(acc.TestSchemaName),
+			"cluster_by":                      config.ListVariable(clusterByVariables...),
+			"data_retention_time_in_days":     config.IntegerVariable(dataRetentionTimeInDays),
+			"max_data_extension_time_in_days": config.IntegerVariable(maxDataExtensionTimeInDays),
+			"change_tracking":                 config.BoolVariable(changeTracking),
+			"comment":                         config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckEventTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_EventTable/basic"),
+				ConfigVariables: m([]string{"TIMESTAMP"}, 1, 10, false, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.0", "TIMESTAMP"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+			// CHANGE PROPERTIES IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_EventTable/basic"),
+				ConfigVariables: m([]string{"TIMESTAMP", "START_TIMESTAMP"}, 5, 20, true, "other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "20"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			// UNSET PROPERTIES
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_EventTable/basic"),
+				ConfigVariables: m([]string{}, -1, -1, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_by.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "change_tracking", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_EventTable/basic"),
+				ConfigVariables:   m([]string{}, -1, -1, false, ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_EventTable_AccountParameter(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	configVariables := config.Variables{
+		"name":     config.StringVariable(name),
+		"database": config.StringVariable(acc.TestDatabaseName),
+		"schema":   config.StringVariable(acc.TestSchemaName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckEventTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_EventTable/AccountParameter"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_parameter.event_table", "key", "EVENT_TABLE"),
+					resource.TestCheckResourceAttrPair("snowflake_account_parameter.event_table", "value", "snowflake_event_table.test", "qualified_name"),
+				),
+			},
+			// NO CHANGES AFTER REFRESH
+			{
+				ConfigDirectory:    acc.ConfigurationDirectory("TestAcc_EventTable/AccountParameter"),
+				ConfigVariables:    configVariables,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccCheckEventTableDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_event_table" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.SchemaObjectIdentifier)
+		existingEventTable, err := client.EventTables.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("event table %v still exists", existingEventTable.Name)
+		}
+	}
+	return nil
+}
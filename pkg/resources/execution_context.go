@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const executionContextKey = "execution_context"
+
+var executionContextSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	MaxItems:    1,
+	Description: "Overrides the session context (role, warehouse and secondary roles) used to run the statements managing this resource. The previous context is restored afterward, so the overridden context does not affect other resources. By default, the context of the provider connection is used.",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Role used to run the statements (USE ROLE).",
+				ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			},
+			"warehouse": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Warehouse used to run the statements (USE WAREHOUSE).",
+				ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			},
+			"secondary_roles": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  fmt.Sprintf("Secondary roles used to run the statements (USE SECONDARY ROLES); valid options are: %s | %s.", sdk.SecondaryRolesAll, sdk.SecondaryRolesNone),
+				ValidateFunc: validation.StringInSlice([]string{string(sdk.SecondaryRolesAll), string(sdk.SecondaryRolesNone)}, true),
+			},
+		},
+	},
+}
+
+// WithExecutionContext adds the optional execution_context block to the resource. When it's set, every CRUD operation
+// of the resource runs in a separate session (see sdk.Client.InSessionWithRestoredContext) with the given role, warehouse and
+// secondary roles, so the resource can be managed with a different role than the one of the provider connection. The previous
+// context of the session is restored afterward, so the connection can be reused by all the other statements.
+//
+// It can only be used for resources running all their statements with the SDK client (not the raw connection returned by sdk.Client.GetConn).
+func WithExecutionContext(resource *schema.Resource) *schema.Resource {
+	resource.Schema[executionContextKey] = executionContextSchema
+
+	// all the other fields of the resources without update are ForceNew, so the update can only change the execution context,
+	// which doesn't change the object itself
+	if resource.Update == nil && resource.UpdateContext == nil {
+		resource.Update = schema.UpdateFunc(resource.Read) //nolint:staticcheck
+		if resource.ReadContext != nil {
+			resource.UpdateContext = schema.UpdateContextFunc(resource.ReadContext)
+		}
+	}
+	if resource.Create != nil {
+		resource.Create = withExecutionContext(resource.Create)
+	}
+	if resource.Read != nil {
+		resource.Read = withExecutionContext(resource.Read)
+	}
+	if resource.Update != nil {
+		resource.Update = withExecutionContext(resource.Update)
+	}
+	if resource.Delete != nil {
+		resource.Delete = withExecutionContext(resource.Delete)
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = withExecutionContextDiag(resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withExecutionContextDiag(resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withExecutionContextDiag(resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = withExecutionContextDiag(resource.DeleteContext)
+	}
+	return resource
+}
+
+func withExecutionContext(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return runInExecutionContext(context.Background(), d, meta, func(meta interface{}) error {
+			return f(d, meta)
+		})
+	}
+}
+
+func withExecutionContextDiag(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		err := runInExecutionContext(ctx, d, meta, func(meta interface{}) error {
+			diags = f(ctx, d, meta)
+			return nil
+		})
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// runInExecutionContext runs f with the provider context bound to the session switched to the execution context of the resource.
+// When the execution context is not set, f is run with the given provider context.
+func runInExecutionContext(ctx context.Context, d *schema.ResourceData, meta interface{}, f func(meta interface{}) error) error {
+	executionContext, ok := d.Get(executionContextKey).([]interface{})
+	if !ok || len(executionContext) == 0 || executionContext[0] == nil {
+		return f(meta)
+	}
+	config := executionContext[0].(map[string]interface{})
+	role, warehouse, secondaryRoles := config["role"].(string), config["warehouse"].(string), config["secondary_roles"].(string)
+	if role == "" && warehouse == "" && secondaryRoles == "" {
+		return f(meta)
+	}
+
+	providerContext := *meta.(*provider.Context)
+	return providerContext.Client.InSessionWithRestoredContext(ctx, func(session *sdk.Client) error {
+		if role != "" {
+			if err := session.Sessions.UseRole(ctx, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(role)); err != nil {
+				return fmt.Errorf("error switching to execution context role %s: %w", role, err)
+			}
+		}
+		if warehouse != "" {
+			if err := session.Sessions.UseWarehouse(ctx, sdk.NewAccountObjectIdentifierFromFullyQualifiedName(warehouse)); err != nil {
+				return fmt.Errorf("error switching to execution context warehouse %s: %w", warehouse, err)
+			}
+		}
+		if secondaryRoles != "" {
+			if err := session.Sessions.UseSecondaryRoles(ctx, sdk.SecondaryRoleOption(strings.ToUpper(secondaryRoles))); err != nil {
+				return fmt.Errorf("error switching to execution context secondary roles %s: %w", secondaryRoles, err)
+			}
+		}
+		providerContext.Client = session
+		return f(&providerContext)
+	})
+}
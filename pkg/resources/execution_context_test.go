@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func currentRoleResource(currentRole *string) *schema.Resource {
+	return resources.WithExecutionContext(&schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			role, err := meta.(*provider.Context).Client.ContextFunctions.CurrentRole(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			*currentRole = role
+			return nil
+		},
+	})
+}
+
+func TestWithExecutionContext(t *testing.T) {
+	expectCurrentRole := func(mock sqlmock.Sqlmock, role string) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_ROLE() as CURRENT_ROLE`)).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow(role))
+	}
+
+	t.Run("without execution context", func(t *testing.T) {
+		var currentRole string
+		resource := currentRoleResource(&currentRole)
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectCurrentRole(mock, "SYSADMIN")
+
+			diags := resource.ReadContext(context.Background(), d, MockProviderContext(db))
+			require.Empty(t, diags)
+			require.Equal(t, "SYSADMIN", currentRole)
+		})
+	})
+
+	t.Run("with execution context", func(t *testing.T) {
+		var currentRole string
+		resource := currentRoleResource(&currentRole)
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"execution_context": []interface{}{
+				map[string]interface{}{
+					"role":            "SECURITYADMIN",
+					"warehouse":       "WH",
+					"secondary_roles": "none",
+				},
+			},
+		})
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.MatchExpectationsInOrder(true)
+			// the previous context is captured
+			expectCurrentRole(mock, "SYSADMIN")
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_WAREHOUSE() as CURRENT_WAREHOUSE`)).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_WAREHOUSE"}).AddRow("PREVIOUS_WH"))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_SECONDARY_ROLES() as CURRENT_ROLES`)).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLES"}).AddRow(`{"roles":"","value":"ALL"}`))
+			mock.ExpectExec(regexp.QuoteMeta(`USE ROLE "SECURITYADMIN"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`USE WAREHOUSE "WH"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`USE SECONDARY ROLES NONE`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			expectCurrentRole(mock, "SECURITYADMIN")
+			// and restored afterward, so the connection is returned to the pool
+			mock.ExpectExec(regexp.QuoteMeta(`USE ROLE "SYSADMIN"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`USE WAREHOUSE "PREVIOUS_WH"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`USE SECONDARY ROLES ALL`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+			diags := resource.ReadContext(context.Background(), d, MockProviderContext(db))
+			require.Empty(t, diags)
+			require.Equal(t, "SECURITYADMIN", currentRole)
+		})
+	})
+
+	t.Run("resource without update can change execution context", func(t *testing.T) {
+		var currentRole string
+		resource := currentRoleResource(&currentRole)
+
+		require.NotNil(t, resource.UpdateContext)
+	})
+}
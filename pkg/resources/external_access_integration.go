@@ -0,0 +1,308 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the external access integration; must be unique in the account.",
+		ForceNew:    true,
+	},
+	"allowed_network_rules": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies the fully qualified names of the network rules (in the `\"<database>\".\"<schema>\".\"<network_rule>\"` format) specifying the external network locations and ports that handler code is allowed to reach. Only EGRESS network rules can be used.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the security integrations whose OAuth authorization server issued the secret used by the UDF or procedure.",
+	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the secrets (in the `\"<database>\".\"<schema>\".\"<secret>\"` format) that UDF or procedure handler code can use when accessing the external network locations.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether this integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the external access integration was created.",
+	},
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration.
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateExternalAccessIntegration,
+		Read:   ReadExternalAccessIntegration,
+		Update: UpdateExternalAccessIntegration,
+		Delete: DeleteExternalAccessIntegration,
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+	}
+}
+
+// CreateExternalAccessIntegration implements schema.CreateFunc.
+func CreateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	allowedNetworkRules, err := expandSchemaObjectIdentifiers(d.Get("allowed_network_rules").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	createRequest := sdk.NewCreateExternalAccessIntegrationRequest(
+		id,
+		allowedNetworkRules,
+		d.Get("enabled").(bool),
+	)
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		createRequest.WithAllowedApiAuthenticationIntegrations(expandAccountObjectIdentifiers(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		secrets, err := expandSchemaObjectIdentifiers(v.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		createRequest.WithAllowedAuthenticationSecrets(secrets)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.ExternalAccessIntegrations.Create(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating external access integration %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// ReadExternalAccessIntegration implements schema.ReadFunc.
+func ReadExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] external access integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not describe external access integration (%s), err = %w", d.Id(), err)
+	}
+
+	if err := d.Set("name", integration.Name); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return err
+	}
+	if err := d.Set("comment", integration.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	for _, property := range properties {
+		switch property.Name {
+		case "ALLOWED_NETWORK_RULES":
+			names, err := parseExternalAccessIntegrationSchemaObjects(property.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("allowed_network_rules", names); err != nil {
+				return err
+			}
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			if err := d.Set("allowed_api_authentication_integrations", parseExternalAccessIntegrationList(property.Value)); err != nil {
+				return err
+			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			names, err := parseExternalAccessIntegrationSchemaObjects(property.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("allowed_authentication_secrets", names); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateExternalAccessIntegration implements schema.UpdateFunc.
+func UpdateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	set, runSet := sdk.NewExternalAccessIntegrationSetRequest(), false
+	unset, runUnset := sdk.NewExternalAccessIntegrationUnsetRequest(), false
+
+	if d.HasChange("allowed_network_rules") {
+		allowedNetworkRules, err := expandSchemaObjectIdentifiers(d.Get("allowed_network_rules").(*schema.Set))
+		if err != nil {
+			return err
+		}
+		runSet = true
+		set.WithAllowedNetworkRules(allowedNetworkRules)
+	}
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if v := d.Get("allowed_api_authentication_integrations").(*schema.Set); v.Len() > 0 {
+			runSet = true
+			set.WithAllowedApiAuthenticationIntegrations(expandAccountObjectIdentifiers(v))
+		} else {
+			runUnset = true
+			unset.WithAllowedApiAuthenticationIntegrations(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("allowed_authentication_secrets") {
+		if v := d.Get("allowed_authentication_secrets").(*schema.Set); v.Len() > 0 {
+			secrets, err := expandSchemaObjectIdentifiers(v)
+			if err != nil {
+				return err
+			}
+			runSet = true
+			set.WithAllowedAuthenticationSecrets(secrets)
+		} else {
+			runUnset = true
+			unset.WithAllowedAuthenticationSecrets(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.WithComment(sdk.String(comment))
+		} else {
+			runUnset = true
+			unset.WithComment(sdk.Bool(true))
+		}
+	}
+
+	if runSet {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating external access integration %v err = %w", id.Name(), err)
+		}
+	}
+	if runUnset {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating external access integration %v err = %w", id.Name(), err)
+		}
+	}
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// DeleteExternalAccessIntegration implements schema.DeleteFunc.
+func DeleteExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id)); err != nil {
+		return fmt.Errorf("error deleting external access integration %v err = %w", id.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandSchemaObjectIdentifiers(set *schema.Set) ([]sdk.SchemaObjectIdentifier, error) {
+	values := expandStringList(set.List())
+	ids := make([]sdk.SchemaObjectIdentifier, len(values))
+	for i, v := range values {
+		id, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](v)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// parseExternalAccessIntegrationList parses list properties returned by DESCRIBE, e.g. [A, B]; an empty list is described as an empty string.
+// It is also used for the external_access_integrations property of functions and procedures.
+func parseExternalAccessIntegrationList(value string) []string {
+	value = strings.TrimSpace(strings.Trim(value, "[]"))
+	if value == "" {
+		return []string{}
+	}
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.Trim(strings.TrimSpace(part), `"`); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// parseExternalAccessIntegrationSchemaObjects parses the schema object names returned by DESCRIBE (DATABASE.SCHEMA.NAME) into fully qualified names.
+func parseExternalAccessIntegrationSchemaObjects(value string) ([]string, error) {
+	names := parseExternalAccessIntegrationList(value)
+	result := make([]string, len(names))
+	for i, name := range names {
+		id, err := sdk.ParseSchemaObjectIdentifier(name)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = id.FullyQualifiedName()
+	}
+	return result, nil
+}
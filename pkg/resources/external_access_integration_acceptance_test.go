@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	networkRuleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	secretName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_external_access_integration.test"
+	networkRuleId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, networkRuleName)
+	secretId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, secretName)
+	m := func(withSecret bool, enabled bool, comment string) config.Variables {
+		return config.Variables{
+			"name":              config.StringVariable(name),
+			"network_rule_name": config.StringVariable(networkRuleName),
+			"secret_name":       config.StringVariable(secretName),
+			"database":          config.StringVariable(acc.TestDatabaseName),
+			"schema":            config.StringVariable(acc.TestSchemaName),
+			"with_secret":       config.BoolVariable(withSecret),
+			"enabled":           config.BoolVariable(enabled),
+			"comment":           config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckExternalAccessIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ExternalAccessIntegration/basic"),
+				ConfigVariables: m(true, true, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network_rules.0", networkRuleId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.0", secretId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+				),
+			},
+			// UNSET SECRETS, DISABLE AND CHANGE COMMENT IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ExternalAccessIntegration/basic"),
+				ConfigVariables: m(false, false, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_ExternalAccessIntegration/basic"),
+				ConfigVariables:   m(false, false, ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExternalAccessIntegrationDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_external_access_integration" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.AccountObjectIdentifier)
+		existingIntegration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("external access integration %v still exists", existingIntegration.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExternalAccessIntegrationList(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"empty string":    {value: "", expected: []string{}},
+		"empty list":      {value: "[]", expected: []string{}},
+		"single value":    {value: "[INTEGRATION]", expected: []string{"INTEGRATION"}},
+		"multiple values": {value: "[A, B,C]", expected: []string{"A", "B", "C"}},
+		"quoted values":   {value: `["A", "B"]`, expected: []string{"A", "B"}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, parseExternalAccessIntegrationList(tc.value))
+		})
+	}
+}
+
+func TestParseExternalAccessIntegrationSchemaObjects(t *testing.T) {
+	names, err := parseExternalAccessIntegrationSchemaObjects("[DB.SCHEMA.RULE_1, DB.SCHEMA.RULE_2]")
+	require.NoError(t, err)
+	require.Equal(t, []string{`"DB"."SCHEMA"."RULE_1"`, `"DB"."SCHEMA"."RULE_2"`}, names)
+
+	_, err = parseExternalAccessIntegrationSchemaObjects("[SCHEMA.RULE_1]")
+	require.Error(t, err)
+}
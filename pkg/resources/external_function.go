@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,13 +51,10 @@ var externalFunctionSchema = map[string]*schema.Schema{
 					Description: "Argument name",
 				},
 				"type": {
-					Type:     schema.TypeString,
-					Required: true,
-					// Suppress the diff shown if the values are equal when both compared in lower case.
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						return strings.EqualFold(strings.ToLower(old), strings.ToLower(new))
-					},
-					Description: "Argument type, e.g. VARCHAR",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+					Description:      "Argument type, e.g. VARCHAR",
 				},
 			},
 		},
@@ -71,14 +68,11 @@ var externalFunctionSchema = map[string]*schema.Schema{
 		Description:  "Specifies the behavior of the external function when called with null inputs.",
 	},
 	"return_type": {
-		Type:     schema.TypeString,
-		Required: true,
-		ForceNew: true,
-		// Suppress the diff shown if the values are equal when both compared in lower case.
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			return strings.EqualFold(strings.ToLower(old), strings.ToLower(new))
-		},
-		Description: "Specifies the data type returned by the external function.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+		Description:      "Specifies the data type returned by the external function.",
 	},
 	"return_null_allowed": {
 		Type:        schema.TypeBool,
@@ -190,7 +184,7 @@ func ExternalFunction() *schema.Resource {
 
 		Schema: externalFunctionSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportSchemaObjectIdentifierWithArguments,
 		},
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -205,8 +199,7 @@ func ExternalFunction() *schema.Resource {
 }
 
 func CreateContextExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	database := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -303,11 +296,19 @@ func CreateContextExternalFunction(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("request_translator"); ok {
-		req.WithRequestTranslator(sdk.Pointer(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string))))
+		translatorId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithRequestTranslator(&translatorId)
 	}
 
 	if v, ok := d.GetOk("response_translator"); ok {
-		req.WithResponseTranslator(sdk.Pointer(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string))))
+		translatorId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithResponseTranslator(&translatorId)
 	}
 
 	if err := client.ExternalFunctions.Create(ctx, req); err != nil {
@@ -323,11 +324,13 @@ func CreateContextExternalFunction(ctx context.Context, d *schema.ResourceData,
 }
 
 func ReadContextExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
-	externalFunction, err := client.ExternalFunctions.ShowByID(ctx, id.WithoutArguments(), id.Arguments())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	externalFunction, err := client.ExternalFunctions.ShowByID(ctx, id)
 	if err != nil {
 		d.SetId("")
 		return nil
@@ -355,7 +358,7 @@ func ReadContextExternalFunction(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Some properties come from the DESCRIBE FUNCTION call
-	externalFunctionPropertyRows, err := client.ExternalFunctions.Describe(ctx, sdk.NewDescribeExternalFunctionRequest(id.WithoutArguments(), id.Arguments()))
+	externalFunctionPropertyRows, err := client.ExternalFunctions.Describe(ctx, id)
 	if err != nil {
 		d.SetId("")
 		return nil
@@ -471,11 +474,13 @@ func ReadContextExternalFunction(ctx context.Context, d *schema.ResourceData, me
 }
 
 func UpdateContextExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
-	req := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes())
 	if d.HasChange("comment") {
 		_, new := d.GetChange("comment")
 		if new == "" {
@@ -492,11 +497,13 @@ func UpdateContextExternalFunction(ctx context.Context, d *schema.ResourceData,
 }
 
 func DeleteContextExternalFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
-	req := sdk.NewDropFunctionRequest(id.WithoutArguments(), id.Arguments())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewDropFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes())
 	if err := client.Functions.Drop(ctx, req); err != nil {
 		return diag.FromErr(err)
 	}
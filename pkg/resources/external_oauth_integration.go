@@ -1,11 +1,16 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,10 +27,10 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "Specifies the OAuth 2.0 authorization server to be Okta, Microsoft Azure AD, Ping Identity PingFederate, or a Custom OAuth 2.0 authorization server.",
 		ValidateFunc: validation.StringInSlice([]string{
-			string(snowflake.Okta),
-			string(snowflake.Azure),
-			string(snowflake.PingFederate),
-			string(snowflake.Custom),
+			string(sdk.ExternalOauthSecurityIntegrationTypeOkta),
+			string(sdk.ExternalOauthSecurityIntegrationTypeAzure),
+			string(sdk.ExternalOauthSecurityIntegrationTypePingFederate),
+			string(sdk.ExternalOauthSecurityIntegrationTypeCustom),
 		}, true),
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			normalize := func(s string) string {
@@ -60,8 +65,8 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "Indicates which Snowflake user record attribute should be used to map the access token to a Snowflake user record.",
 		ValidateFunc: validation.StringInSlice([]string{
-			string(snowflake.LoginName),
-			string(snowflake.EmailAddress),
+			string(sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeLoginName),
+			string(sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeEmailAddress),
 		}, true),
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			normalize := func(s string) string {
@@ -108,12 +113,12 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 	"any_role_mode": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Default:     string(snowflake.Disable),
+		Default:     string(sdk.ExternalOauthSecurityIntegrationAnyRoleModeDisable),
 		Description: "Specifies whether the OAuth client or user can use a role that is not defined in the OAuth access token.",
 		ValidateFunc: validation.StringInSlice([]string{
-			string(snowflake.Disable),
-			string(snowflake.Enable),
-			string(snowflake.EnableForPrivilege),
+			string(sdk.ExternalOauthSecurityIntegrationAnyRoleModeDisable),
+			string(sdk.ExternalOauthSecurityIntegrationAnyRoleModeEnable),
+			string(sdk.ExternalOauthSecurityIntegrationAnyRoleModeEnableForPrivilege),
 		}, true),
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			normalize := func(s string) string {
@@ -139,9 +144,11 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 	},
 }
 
-// ExternalOauthIntegration returns a pointer to the resource representing a network policy.
+// ExternalOauthIntegration returns a pointer to the resource representing an External OAuth security integration.
 func ExternalOauthIntegration() *schema.Resource {
 	return &schema.Resource{
+		SchemaVersion: 1,
+
 		Description: "An External OAuth security integration allows a client to use a third-party authorization server to obtain the access tokens needed to interact with Snowflake.",
 		Create:      CreateExternalOauthIntegration,
 		Read:        ReadExternalOauthIntegration,
@@ -150,396 +157,272 @@ func ExternalOauthIntegration() *schema.Resource {
 
 		Schema: oauthExternalIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v0SecurityIntegrationStateUpgrader([]string{"type", "snowflake_user_mapping_attribute", "any_role_mode"}, []string{"blocked_roles"}),
+			},
 		},
 	}
 }
 
 // CreateExternalOauthIntegration implements schema.CreateFunc.
 func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
-	manager, err := snowflake.NewExternalOauthIntegration3Manager()
-	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration manager: %w", err)
-	}
-
-	input := &snowflake.ExternalOauthIntegration3CreateInput{
-		ExternalOauthIntegration3: snowflake.ExternalOauthIntegration3{
-			TopLevelIdentifier: snowflake.TopLevelIdentifier{
-				Name: d.Get("name").(string),
-			},
-
-			Type:                                 "EXTERNAL_OAUTH",
-			TypeOk:                               true,
-			Enabled:                              d.Get("enabled").(bool),
-			EnabledOk:                            isOk(d.GetOk("enabled")),
-			ExternalOauthType:                    snowflake.ExternalOauthType(d.Get("type").(string)),
-			ExternalOauthTypeOk:                  isOk(d.GetOk("type")),
-			ExternalOauthIssuer:                  d.Get("issuer").(string),
-			ExternalOauthIssuerOk:                isOk(d.GetOk("issuer")),
-			ExternalOauthTokenUserMappingClaim:   expandStringList(d.Get("token_user_mapping_claims").(*schema.Set).List()),
-			ExternalOauthTokenUserMappingClaimOk: isOk(d.GetOk("token_user_mapping_claims")),
-			ExternalOauthSnowflakeUserMappingAttribute:   snowflake.SFUserMappingAttribute(d.Get("snowflake_user_mapping_attribute").(string)),
-			ExternalOauthSnowflakeUserMappingAttributeOk: isOk(d.GetOk("snowflake_user_mapping_attribute")),
-			ExternalOauthJwsKeysURL:                      expandStringList(d.Get("jws_keys_urls").(*schema.Set).List()),
-			ExternalOauthJwsKeysURLOk:                    isOk(d.GetOk("jws_keys_urls")),
-			ExternalOauthBlockedRolesList:                expandStringList(d.Get("blocked_roles").(*schema.Set).List()),
-			ExternalOauthBlockedRolesListOk:              isOk(d.GetOk("blocked_roles")),
-			ExternalOauthAllowedRolesList:                expandStringList(d.Get("allowed_roles").(*schema.Set).List()),
-			ExternalOauthAllowedRolesListOk:              isOk(d.GetOk("allowed_roles")),
-			ExternalOauthRsaPublicKey:                    d.Get("rsa_public_key").(string),
-			ExternalOauthRsaPublicKeyOk:                  isOk(d.GetOk("rsa_public_key")),
-			ExternalOauthRsaPublicKey2:                   d.Get("rsa_public_key_2").(string),
-			ExternalOauthRsaPublicKey2Ok:                 isOk(d.GetOk("rsa_public_key_2")),
-			ExternalOauthAudienceList:                    expandStringList(d.Get("audience_urls").(*schema.Set).List()),
-			ExternalOauthAudienceListOk:                  isOk(d.GetOk("audience_urls")),
-			ExternalOauthAnyRoleMode:                     snowflake.AnyRoleMode(d.Get("any_role_mode").(string)),
-			ExternalOauthAnyRoleModeOk:                   isOk(d.GetOk("any_role_mode")),
-			ExternalOauthScopeDelimiter:                  d.Get("scope_delimiter").(string),
-			ExternalOauthScopeDelimiterOk:                isOk(d.GetOk("scope_delimiter")),
-			ExternalOauthScopeMappingAttribute:           d.Get("scope_mapping_attribute").(string),
-			ExternalOauthScopeMappingAttributeOk:         isOk(d.GetOk("scope_mapping_attribute")),
+	client := meta.(*provider.Context).Client
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
 
-			Comment:   sql.NullString{String: d.Get("comment").(string)},
-			CommentOk: isOk(d.GetOk("comment")),
-		},
+	createRequest := sdk.NewCreateExternalOauthSecurityIntegrationRequest(
+		id,
+		d.Get("enabled").(bool),
+		sdk.ExternalOauthSecurityIntegrationTypeOption(strings.ToUpper(d.Get("type").(string))),
+		d.Get("issuer").(string),
+		expandTokenUserMappingClaims(d.Get("token_user_mapping_claims").(*schema.Set)),
+		sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeOption(strings.ToUpper(d.Get("snowflake_user_mapping_attribute").(string))),
+	)
+	if v, ok := d.GetOk("jws_keys_urls"); ok {
+		createRequest.WithExternalOauthJwsKeysUrl(expandJwsKeysUrls(v.(*schema.Set)))
 	}
-
-	stmt, err := manager.Create(input)
-	if err != nil {
-		return fmt.Errorf("couldn't generate create statement: %w", err)
-	}
-
-	db := meta.(*sql.DB)
-	err = snowflake.Exec(db, stmt)
-	if err != nil {
-		return fmt.Errorf("error executing create statement: %w", err)
+	if v, ok := d.GetOk("blocked_roles"); ok {
+		createRequest.WithExternalOauthBlockedRolesList(&sdk.BlockedRolesList{BlockedRolesList: expandAccountObjectIdentifiers(v.(*schema.Set))})
 	}
-
-	d.SetId(ExternalOauthIntegrationID(&input.ExternalOauthIntegration3))
-
-	return ReadExternalOauthIntegration(d, meta)
-}
-
-// ReadExternalOauthIntegration implements schema.ReadFunc.
-func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
-	manager, err := snowflake.NewExternalOauthIntegration3Manager()
-	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
+	if v, ok := d.GetOk("allowed_roles"); ok {
+		createRequest.WithExternalOauthAllowedRolesList(&sdk.AllowedRolesList{AllowedRolesList: expandAccountObjectIdentifiers(v.(*schema.Set))})
 	}
-
-	input := ExternalOauthIntegrationIdentifier(d.Id())
-
-	db := meta.(*sql.DB)
-
-	// This resource needs a SHOW and a DESCRIBE
-
-	// SHOW
-	stmt, err := manager.ReadShow(input)
-	if err != nil {
-		return fmt.Errorf("couldn't generate show statement: %w", err)
+	if v, ok := d.GetOk("rsa_public_key"); ok {
+		createRequest.WithExternalOauthRsaPublicKey(sdk.String(v.(string)))
 	}
-
-	row := snowflake.QueryRow(db, stmt)
-	if err != nil {
-		return fmt.Errorf("error querying external oauth integration: %w", err)
+	if v, ok := d.GetOk("rsa_public_key_2"); ok {
+		createRequest.WithExternalOauthRsaPublicKey2(sdk.String(v.(string)))
 	}
-
-	showOutput, err := manager.ParseShow(row)
-	if err != nil {
-		return fmt.Errorf("error parsing show result: %w", err)
+	if v, ok := d.GetOk("audience_urls"); ok {
+		createRequest.WithExternalOauthAudienceList(expandAudienceList(v.(*schema.Set)))
 	}
-
-	if err := d.Set("type", strings.TrimPrefix(showOutput.Type, "EXTERNAL_OAUTH - ")); err != nil {
-		return fmt.Errorf("error setting type: %w", err)
+	if v, ok := d.GetOk("any_role_mode"); ok {
+		createRequest.WithExternalOauthAnyRoleMode(sdk.Pointer(sdk.ExternalOauthSecurityIntegrationAnyRoleModeOption(strings.ToUpper(v.(string)))))
 	}
-	if err := d.Set("name", showOutput.Name); err != nil {
-		return fmt.Errorf("error setting name: %w", err)
+	if v, ok := d.GetOk("scope_delimiter"); ok {
+		createRequest.WithExternalOauthScopeDelimiter(sdk.String(v.(string)))
 	}
-	if err := d.Set("enabled", showOutput.Enabled); err != nil {
-		return fmt.Errorf("error setting enabled: %w", err)
+	if v, ok := d.GetOk("scope_mapping_attribute"); ok {
+		createRequest.WithExternalOauthScopeMappingAttribute(sdk.String(v.(string)))
 	}
-	if err := d.Set("comment", showOutput.Comment.String); err != nil {
-		return fmt.Errorf("error setting comment: %w", err)
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
 	}
-	// if err := d.Set("created_on", showOutput.CreatedOn.String); err != nil {
-	// 	return fmt.Errorf("error setting created_on: %w", err)
-	// }
 
-	// DESCRIBE
-	stmt, err = manager.ReadDescribe(input)
-	if err != nil {
-		return fmt.Errorf("couldn't generate describe statement: %w", err)
+	ctx := context.Background()
+	if err := client.SecurityIntegrations.CreateExternalOauth(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating external oauth integration %v err = %w", name, err)
 	}
 
-	rows, err := snowflake.Query(db, stmt)
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadExternalOauthIntegration(d, meta)
+}
+
+// ReadExternalOauthIntegration implements schema.ReadFunc.
+func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
 	if err != nil {
-		return fmt.Errorf("error querying external oauth integration: %w", err)
+		return err
 	}
 
-	defer rows.Close()
-	describeOutput, err := manager.ParseDescribe(rows.Rows)
+	ctx := context.Background()
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to parse result of describe: %w", err)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] external oauth integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
-	if err := d.Set("issuer", describeOutput.ExternalOauthIssuer); err != nil {
-		return fmt.Errorf("error setting issuer: %w", err)
-	}
-	if err := d.Set("jws_keys_urls", describeOutput.ExternalOauthJwsKeysURL); err != nil {
-		return fmt.Errorf("error setting jws_keys_urls: %w", err)
+	if c := integration.Category; c != "SECURITY" {
+		return fmt.Errorf("expected %v to be a SECURITY integration, got %v", d.Id(), c)
 	}
-	if err := d.Set("any_role_mode", describeOutput.ExternalOauthAnyRoleMode); err != nil {
-		return fmt.Errorf("error setting any_role_mode: %w", err)
+	if !strings.HasPrefix(integration.IntegrationType, "EXTERNAL_OAUTH - ") {
+		return fmt.Errorf("expected %v to be an External OAuth integration, got %v", d.Id(), integration.IntegrationType)
 	}
-	if err := d.Set("rsa_public_key", describeOutput.ExternalOauthRsaPublicKey); err != nil {
-		return fmt.Errorf("error setting rsa_public_key: %w", err)
-	}
-	if err := d.Set("rsa_public_key_2", describeOutput.ExternalOauthRsaPublicKey2); err != nil {
-		return fmt.Errorf("error setting rsa_public_key_2: %w", err)
-	}
-	// Filter out default roles
-	blockedRoles := []string{}
-	for i := range describeOutput.ExternalOauthBlockedRolesList {
-		role := describeOutput.ExternalOauthBlockedRolesList[i]
-		if role != "ACCOUNTADMIN" && role != "SECURITYADMIN" {
-			blockedRoles = append(blockedRoles, role)
+
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not describe external oauth integration (%s), err = %w", d.Id(), err)
+	}
+
+	if err := d.Set("name", integration.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", strings.TrimPrefix(integration.IntegrationType, "EXTERNAL_OAUTH - ")); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return err
+	}
+	if err := d.Set("comment", integration.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	for _, property := range properties {
+		switch property.Name {
+		case "EXTERNAL_OAUTH_ISSUER":
+			err = d.Set("issuer", securityIntegrationStringProperty(property))
+		case "EXTERNAL_OAUTH_JWS_KEYS_URL":
+			err = d.Set("jws_keys_urls", parseSecurityIntegrationList(securityIntegrationStringProperty(property)))
+		case "EXTERNAL_OAUTH_ANY_ROLE_MODE":
+			err = d.Set("any_role_mode", securityIntegrationStringProperty(property))
+		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY":
+			err = d.Set("rsa_public_key", securityIntegrationStringProperty(property))
+		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2":
+			err = d.Set("rsa_public_key_2", securityIntegrationStringProperty(property))
+		case "EXTERNAL_OAUTH_BLOCKED_ROLES_LIST":
+			err = d.Set("blocked_roles", withoutSecurityIntegrationBuiltInRoles(parseSecurityIntegrationList(property.Value)))
+		case "EXTERNAL_OAUTH_ALLOWED_ROLES_LIST":
+			err = d.Set("allowed_roles", parseSecurityIntegrationList(property.Value))
+		case "EXTERNAL_OAUTH_AUDIENCE_LIST":
+			err = d.Set("audience_urls", parseSecurityIntegrationList(securityIntegrationStringProperty(property)))
+		case "EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM":
+			err = d.Set("token_user_mapping_claims", parseSecurityIntegrationList(property.Value))
+		case "EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE":
+			err = d.Set("snowflake_user_mapping_attribute", property.Value)
+		case "EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE":
+			err = d.Set("scope_mapping_attribute", securityIntegrationStringProperty(property))
+		case "EXTERNAL_OAUTH_SCOPE_DELIMITER":
+			err = d.Set("scope_delimiter", securityIntegrationStringProperty(property))
+		}
+		if err != nil {
+			return fmt.Errorf("unable to set %v for external oauth integration (%s), err = %w", strings.ToLower(property.Name), d.Id(), err)
 		}
-	}
-	if err := d.Set("blocked_roles", blockedRoles); err != nil {
-		return fmt.Errorf("error setting blocked_roles: %w", err)
-	}
-	if err := d.Set("allowed_roles", describeOutput.ExternalOauthAllowedRolesList); err != nil {
-		return fmt.Errorf("error setting allowed_roles: %w", err)
-	}
-	if err := d.Set("audience_urls", describeOutput.ExternalOauthAudienceList); err != nil {
-		return fmt.Errorf("error setting audience_urls: %w", err)
-	}
-	if err := d.Set("token_user_mapping_claims", describeOutput.ExternalOauthTokenUserMappingClaim); err != nil {
-		return fmt.Errorf("error setting token_user_mapping_claims: %w", err)
-	}
-	if err := d.Set("snowflake_user_mapping_attribute", describeOutput.ExternalOauthSnowflakeUserMappingAttribute); err != nil {
-		return fmt.Errorf("error setting snowflake_user_mapping_attribute: %w", err)
-	}
-	if err := d.Set("scope_mapping_attribute", describeOutput.ExternalOauthScopeMappingAttribute); err != nil {
-		return fmt.Errorf("error setting scope_mapping_attribute: %w", err)
 	}
 
-	return err
+	return nil
 }
 
 // UpdateExternalOauthIntegration implements schema.UpdateFunc.
 func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
-	manager, err := snowflake.NewExternalOauthIntegration3Manager()
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
 	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
+		return err
 	}
+	ctx := context.Background()
 
-	runAlter := false
-	alterInput := &snowflake.ExternalOauthIntegration3UpdateInput{
-		ExternalOauthIntegration3: snowflake.ExternalOauthIntegration3{
-			TopLevelIdentifier: snowflake.TopLevelIdentifier{
-				Name: d.Get("name").(string),
-			},
-		},
-	}
-	runUnset := false
-	unsetInput := &snowflake.ExternalOauthIntegration3UpdateInput{
-		ExternalOauthIntegration3: snowflake.ExternalOauthIntegration3{
-			TopLevelIdentifier: snowflake.TopLevelIdentifier{
-				Name: d.Get("name").(string),
-			},
-		},
-	}
+	set, runSet := sdk.NewExternalOauthIntegrationSetRequest(), false
+	unset, runUnset := sdk.NewExternalOauthIntegrationUnsetRequest(), false
 
 	if d.HasChange("enabled") {
-		val, ok := d.GetOk("enabled")
-		if ok {
-			alterInput.Enabled = val.(bool)
-			alterInput.EnabledOk = true
-			runAlter = true
-		} else {
-			unsetInput.EnabledOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
 	}
 	if d.HasChange("type") {
-		val, ok := d.GetOk("type")
-		if ok {
-			alterInput.Type = val.(string)
-			alterInput.TypeOk = true
-			runAlter = true
-		} else {
-			unsetInput.TypeOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthType(sdk.Pointer(sdk.ExternalOauthSecurityIntegrationTypeOption(strings.ToUpper(d.Get("type").(string)))))
 	}
 	if d.HasChange("issuer") {
-		val, ok := d.GetOk("issuer")
-		if ok {
-			alterInput.ExternalOauthIssuer = val.(string)
-			alterInput.ExternalOauthIssuerOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthIssuerOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthIssuer(sdk.String(d.Get("issuer").(string)))
 	}
 	if d.HasChange("token_user_mapping_claims") {
-		val, ok := d.GetOk("token_user_mapping_claims")
-		if ok {
-			alterInput.ExternalOauthTokenUserMappingClaim = expandStringList(val.(*schema.Set).List())
-			alterInput.ExternalOauthTokenUserMappingClaimOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthTokenUserMappingClaimOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthTokenUserMappingClaim(expandTokenUserMappingClaims(d.Get("token_user_mapping_claims").(*schema.Set)))
 	}
 	if d.HasChange("snowflake_user_mapping_attribute") {
-		val, ok := d.GetOk("snowflake_user_mapping_attribute")
-		if ok {
-			alterInput.ExternalOauthSnowflakeUserMappingAttribute = val.(snowflake.SFUserMappingAttribute)
-			alterInput.ExternalOauthSnowflakeUserMappingAttributeOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthSnowflakeUserMappingAttributeOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthSnowflakeUserMappingAttribute(sdk.Pointer(sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeOption(strings.ToUpper(d.Get("snowflake_user_mapping_attribute").(string)))))
 	}
 	if d.HasChange("jws_keys_urls") {
-		val, ok := d.GetOk("jws_keys_urls")
-		if ok {
-			alterInput.ExternalOauthJwsKeysURL = expandStringList(val.(*schema.Set).List())
-			alterInput.ExternalOauthJwsKeysURLOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthJwsKeysURLOk = true
-			runUnset = true
+		// the urls cannot be unset; they are replaced by rsa_public_key instead
+		if v := d.Get("jws_keys_urls").(*schema.Set); v.Len() > 0 {
+			runSet = true
+			set.WithExternalOauthJwsKeysUrl(expandJwsKeysUrls(v))
 		}
 	}
 	if d.HasChange("rsa_public_key") {
-		val, ok := d.GetOk("rsa_public_key")
-		if ok {
-			alterInput.ExternalOauthRsaPublicKey = val.(string)
-			alterInput.ExternalOauthRsaPublicKeyOk = true
-			runAlter = true
+		if v := d.Get("rsa_public_key").(string); v != "" {
+			runSet = true
+			set.WithExternalOauthRsaPublicKey(sdk.String(v))
 		} else {
-			unsetInput.ExternalOauthRsaPublicKeyOk = true
 			runUnset = true
+			unset.WithExternalOauthRsaPublicKey(sdk.Bool(true))
 		}
 	}
 	if d.HasChange("rsa_public_key_2") {
-		val, ok := d.GetOk("rsa_public_key_2")
-		if ok {
-			alterInput.ExternalOauthRsaPublicKey2 = val.(string)
-			alterInput.ExternalOauthRsaPublicKey2Ok = true
-			runAlter = true
+		if v := d.Get("rsa_public_key_2").(string); v != "" {
+			runSet = true
+			set.WithExternalOauthRsaPublicKey2(sdk.String(v))
 		} else {
-			unsetInput.ExternalOauthRsaPublicKey2Ok = true
 			runUnset = true
+			unset.WithExternalOauthRsaPublicKey2(sdk.Bool(true))
 		}
 	}
 	if d.HasChange("blocked_roles") {
-		val, ok := d.GetOk("blocked_roles")
-		if ok {
-			alterInput.ExternalOauthBlockedRolesList = expandStringList(val.(*schema.Set).List())
-			alterInput.ExternalOauthBlockedRolesListOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthBlockedRolesListOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthBlockedRolesList(&sdk.BlockedRolesList{BlockedRolesList: expandAccountObjectIdentifiers(d.Get("blocked_roles").(*schema.Set))})
 	}
 	if d.HasChange("allowed_roles") {
-		val, ok := d.GetOk("allowed_roles")
-		if ok {
-			alterInput.ExternalOauthAllowedRolesList = expandStringList(val.(*schema.Set).List())
-			alterInput.ExternalOauthAllowedRolesListOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthAllowedRolesListOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthAllowedRolesList(&sdk.AllowedRolesList{AllowedRolesList: expandAccountObjectIdentifiers(d.Get("allowed_roles").(*schema.Set))})
 	}
 	if d.HasChange("audience_urls") {
-		val, ok := d.GetOk("audience_urls")
-		if ok {
-			alterInput.ExternalOauthAudienceList = expandStringList(val.(*schema.Set).List())
-			alterInput.ExternalOauthAudienceListOk = true
-			runAlter = true
+		if v := d.Get("audience_urls").(*schema.Set); v.Len() > 0 {
+			runSet = true
+			set.WithExternalOauthAudienceList(expandAudienceList(v))
 		} else {
-			unsetInput.ExternalOauthAudienceListOk = true
 			runUnset = true
+			unset.WithExternalOauthAudienceList(sdk.Bool(true))
 		}
 	}
 	if d.HasChange("any_role_mode") {
-		val, ok := d.GetOk("any_role_mode")
-		if ok {
-			alterInput.ExternalOauthAnyRoleMode = val.(snowflake.AnyRoleMode)
-			alterInput.ExternalOauthAnyRoleModeOk = true
-			runAlter = true
-		} else {
-			unsetInput.ExternalOauthAnyRoleModeOk = true
-			runUnset = true
-		}
+		runSet = true
+		set.WithExternalOauthAnyRoleMode(sdk.Pointer(sdk.ExternalOauthSecurityIntegrationAnyRoleModeOption(strings.ToUpper(d.Get("any_role_mode").(string)))))
 	}
 	if d.HasChange("scope_delimiter") {
-		val, ok := d.GetOk("scope_delimiter")
-		if ok {
-			alterInput.ExternalOauthScopeDelimiter = val.(string)
-			alterInput.ExternalOauthScopeDelimiterOk = true
-			runAlter = true
+		if v := d.Get("scope_delimiter").(string); v != "" {
+			runSet = true
+			set.WithExternalOauthScopeDelimiter(sdk.String(v))
 		} else {
-			unsetInput.ExternalOauthScopeDelimiterOk = true
 			runUnset = true
+			unset.WithExternalOauthScopeDelimiter(sdk.Bool(true))
 		}
 	}
 	if d.HasChange("scope_mapping_attribute") {
-		val, ok := d.GetOk("scope_mapping_attribute")
-		if ok {
-			alterInput.ExternalOauthScopeMappingAttribute = val.(string)
-			alterInput.ExternalOauthScopeMappingAttributeOk = true
-			runAlter = true
+		if v := d.Get("scope_mapping_attribute").(string); v != "" {
+			runSet = true
+			set.WithExternalOauthScopeMappingAttribute(sdk.String(v))
 		} else {
-			unsetInput.ExternalOauthScopeMappingAttributeOk = true
 			runUnset = true
+			unset.WithExternalOauthScopeMappingAttribute(sdk.Bool(true))
 		}
 	}
 	if d.HasChange("comment") {
-		val, ok := d.GetOk("comment")
-		if ok {
-			alterInput.Comment.String = val.(string)
-			alterInput.CommentOk = true
-			runAlter = true
+		if v := d.Get("comment").(string); v != "" {
+			runSet = true
+			set.WithComment(sdk.String(v))
 		} else {
-			unsetInput.CommentOk = true
 			runUnset = true
+			unset.WithComment(sdk.Bool(true))
 		}
 	}
 
-	db := meta.(*sql.DB)
-
-	if runAlter {
-		stmt, err := manager.Update(alterInput)
-		if err != nil {
-			return fmt.Errorf("couldn't generate alter statement for external oauth integration: %w", err)
-		}
-
-		err = snowflake.Exec(db, stmt)
-		if err != nil {
-			return fmt.Errorf("error executing alter statement: %w", err)
+	if runSet {
+		if err := client.SecurityIntegrations.AlterExternalOauth(ctx, sdk.NewAlterExternalOauthSecurityIntegrationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating external oauth integration %v err = %w", id.Name(), err)
 		}
 	}
-
 	if runUnset {
-		stmt, err := manager.Unset(unsetInput)
-		if err != nil {
-			return fmt.Errorf("couldn't generate unset statement for external oauth integration: %w", err)
-		}
-
-		err = snowflake.Exec(db, stmt)
-		if err != nil {
-			return fmt.Errorf("error executing unset statement: %w", err)
+		if err := client.SecurityIntegrations.AlterExternalOauth(ctx, sdk.NewAlterExternalOauthSecurityIntegrationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating external oauth integration %v err = %w", id.Name(), err)
 		}
 	}
 
@@ -548,35 +431,32 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 
 // DeleteExternalOauthIntegration implements schema.DeleteFunc.
 func DeleteExternalOauthIntegration(d *schema.ResourceData, meta interface{}) error {
-	manager, err := snowflake.NewExternalOauthIntegration3Manager()
-	if err != nil {
-		return fmt.Errorf("couldn't create external oauth integration builder: %w", err)
-	}
-
-	input := &snowflake.ExternalOauthIntegration3DeleteInput{
-		TopLevelIdentifier: snowflake.TopLevelIdentifier{
-			Name: d.Get("name").(string),
-		},
-	}
-
-	stmt, err := manager.Delete(input)
-	if err != nil {
-		return fmt.Errorf("couldn't generate drop statement: %w", err)
-	}
+	return deleteSecurityIntegration(d, meta)
+}
 
-	db := meta.(*sql.DB)
-	err = snowflake.Exec(db, stmt)
-	if err != nil {
-		return fmt.Errorf("error executing drop statement: %w", err)
+func expandTokenUserMappingClaims(set *schema.Set) []sdk.TokenUserMappingClaim {
+	values := expandStringList(set.List())
+	claims := make([]sdk.TokenUserMappingClaim, len(values))
+	for i, v := range values {
+		claims[i] = sdk.TokenUserMappingClaim{Claim: v}
 	}
-
-	return nil
+	return claims
 }
 
-func ExternalOauthIntegrationID(eoi *snowflake.ExternalOauthIntegration3) string {
-	return eoi.QualifiedName()
+func expandJwsKeysUrls(set *schema.Set) []sdk.JwsKeysUrl {
+	values := expandStringList(set.List())
+	urls := make([]sdk.JwsKeysUrl, len(values))
+	for i, v := range values {
+		urls[i] = sdk.JwsKeysUrl{JwsKeyUrl: v}
+	}
+	return urls
 }
 
-func ExternalOauthIntegrationIdentifier(id string) *snowflake.TopLevelIdentifier {
-	return snowflake.TopLevelIdentifierFromQualifiedName(id)
+func expandAudienceList(set *schema.Set) []sdk.AudienceListItem {
+	values := expandStringList(set.List())
+	items := make([]sdk.AudienceListItem, len(values))
+	for i, v := range values {
+		items[i] = sdk.AudienceListItem{Item: v}
+	}
+	return items
 }
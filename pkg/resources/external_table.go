@@ -2,13 +2,14 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -144,16 +145,15 @@ func ExternalTable() *schema.Resource {
 
 		Schema: externalTableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // CreateExternalTable implements schema.CreateFunc.
 func CreateExternalTable(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -252,15 +252,20 @@ func CreateExternalTable(d *schema.ResourceData, meta any) error {
 
 // ReadExternalTable implements schema.ReadFunc.
 func ReadExternalTable(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	externalTable, err := client.ExternalTables.ShowByID(ctx, sdk.NewShowExternalTableByIDRequest(id))
 	if err != nil {
-		log.Printf("[DEBUG] external table (%s) not found", d.Id())
-		d.SetId("")
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] external table (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
@@ -277,10 +282,12 @@ func ReadExternalTable(d *schema.ResourceData, meta any) error {
 
 // UpdateExternalTable implements schema.UpdateFunc.
 func UpdateExternalTable(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("tag") {
 		unsetTags, setTags := GetTagsDiff(d, "tag")
@@ -309,12 +316,14 @@ func UpdateExternalTable(d *schema.ResourceData, meta any) error {
 
 // DeleteExternalTable implements schema.DeleteFunc.
 func DeleteExternalTable(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.ExternalTables.Drop(ctx, sdk.NewDropExternalTableRequest(id))
+	err = client.ExternalTables.Drop(ctx, sdk.NewDropExternalTableRequest(id))
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,6 @@ package resources_test
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -306,7 +306,7 @@ func TestAcc_ExternalTable_DeltaLake(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "column.1.type", "string"),
 					resource.TestCheckResourceAttr(resourceName, "column.1.as", "value:name::string"),
 					func(state *terraform.State) error {
-						client := sdk.NewClientFromDB(acc.TestAccProvider.Meta().(*sql.DB))
+						client := acc.TestAccProvider.Meta().(*provider.Context).Client
 						ctx := context.Background()
 						id := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name)
 						result, err := client.ExternalTables.ShowByID(ctx, sdk.NewShowExternalTableByIDRequest(id))
@@ -333,7 +333,7 @@ func externalTableTestEnvs() (bool, string, string, string) {
 
 func externalTableContainsData(name string, contains func(rows []map[string]*any) bool) func(state *terraform.State) error {
 	return func(state *terraform.State) error {
-		client := sdk.NewClientFromDB(acc.TestAccProvider.Meta().(*sql.DB))
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 		ctx := context.Background()
 		id := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name)
 		rows, err := client.QueryUnsafe(ctx, fmt.Sprintf("select * from %s", id.FullyQualifiedName()))
@@ -370,7 +370,7 @@ func publishExternalTablesTestData(stageName sdk.SchemaObjectIdentifier, data []
 
 func expectTableToHaveColumnDataTypes(tableName string, expectedDataTypes []sdk.DataType) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
-		client := sdk.NewClientFromDB(acc.TestAccProvider.Meta().(*sql.DB))
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 		ctx := context.Background()
 		id := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, tableName)
 		columnsDesc, err := client.ExternalTables.DescribeColumns(ctx, sdk.NewDescribeExternalTableColumnsRequest(id))
@@ -400,7 +400,7 @@ func expectTableToHaveColumnDataTypes(tableName string, expectedDataTypes []sdk.
 
 func expectTableDDLContains(tableName string, substr string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
-		client := sdk.NewClientFromDB(acc.TestAccProvider.Meta().(*sql.DB))
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 		ctx := context.Background()
 		id := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, tableName)
 
@@ -440,8 +440,7 @@ func expectTableDDLContains(tableName string, substr string) func(s *terraform.S
 }
 
 func testAccCheckExternalTableDestroy(s *terraform.State) error {
-	db := acc.TestAccProvider.Meta().(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "snowflake_external_table" {
 			continue
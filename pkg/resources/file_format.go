@@ -3,7 +3,6 @@ package resources
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
@@ -11,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
@@ -322,8 +322,7 @@ func FileFormat() *schema.Resource {
 
 // CreateFileFormat implements schema.CreateFunc.
 func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	dbName := d.Get("database").(string)
@@ -529,8 +528,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 
 // ReadFileFormat implements schema.ReadFunc.
 func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	fileFormatID, err := fileFormatIDFromString(d.Id())
@@ -757,8 +755,7 @@ func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateFileFormat implements schema.UpdateFunc.
 func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	fileFormatID, err := fileFormatIDFromString(d.Id())
@@ -1110,8 +1107,7 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteFileFormat implements schema.DeleteFunc.
 func DeleteFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	fileFormatID, err := fileFormatIDFromString(d.Id())
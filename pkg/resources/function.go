@@ -2,11 +2,14 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/go-cty/cty"
@@ -49,13 +52,10 @@ var functionSchema = map[string]*schema.Schema{
 					Description: "The argument name",
 				},
 				"type": {
-					Type:     schema.TypeString,
-					Required: true,
-					// Suppress the diff shown if the values are equal when both compared in lower case.
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						return strings.EqualFold(old, new)
-					},
-					Description: "The argument type",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+					Description:      "The argument type",
 				},
 			},
 		},
@@ -64,14 +64,11 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"return_type": {
-		Type:        schema.TypeString,
-		Description: "The return type of the function",
-		// Suppress the diff shown if the values are equal when both compared in lower case.
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			return strings.EqualFold(old, new)
-		},
-		Required: true,
-		ForceNew: true,
+		Type:             schema.TypeString,
+		Description:      "The return type of the function",
+		DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+		Required:         true,
+		ForceNew:         true,
 	},
 	"statement": {
 		Type:             schema.TypeString,
@@ -155,6 +152,24 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of external access integrations needed in order for this function's handler code to access external networks. Only valid for Java / Python functions.",
+	},
+	"secrets": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Assigns the names of secrets to variables so that you can use the variables to reference the secrets when retrieving information from secrets in handler code. Keys are the variable names and values are the fully qualified names of the secrets (in the `\"<database>\".\"<schema>\".\"<secret>\"` format). Secrets must be allowed by one of the `external_access_integrations`. Only valid for Java / Python functions.",
+	},
 }
 
 // Function returns a pointer to the resource representing a stored function.
@@ -169,7 +184,7 @@ func Function() *schema.Resource {
 
 		Schema: functionSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportSchemaObjectIdentifierWithArguments,
 		},
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -208,8 +223,7 @@ func CreateContextFunction(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func createJavaFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -267,6 +281,17 @@ func createJavaFunction(ctx context.Context, d *schema.ResourceData, meta interf
 		request.WithTargetPath(sdk.String(v.(string)))
 	}
 
+	if _, ok := d.GetOk("external_access_integrations"); ok {
+		request.WithExternalAccessIntegrations(expandExternalAccessIntegrations(d))
+	}
+	if _, ok := d.GetOk("secrets"); ok {
+		secrets, err := expandSecretReferences(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithSecrets(secrets)
+	}
+
 	if err := client.Functions.CreateForJava(ctx, request); err != nil {
 		return diag.FromErr(err)
 	}
@@ -280,8 +305,7 @@ func createJavaFunction(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func createScalaFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -353,8 +377,7 @@ func createScalaFunction(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func createSQLFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -400,8 +423,7 @@ func createSQLFunction(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func createPythonFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -455,6 +477,17 @@ func createPythonFunction(ctx context.Context, d *schema.ResourceData, meta inte
 		request.WithPackages(packages)
 	}
 
+	if _, ok := d.GetOk("external_access_integrations"); ok {
+		request.WithExternalAccessIntegrations(expandExternalAccessIntegrations(d))
+	}
+	if _, ok := d.GetOk("secrets"); ok {
+		secrets, err := expandSecretReferences(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithSecrets(secrets)
+	}
+
 	if err := client.Functions.CreateForPython(ctx, request); err != nil {
 		return diag.FromErr(err)
 	}
@@ -468,8 +501,7 @@ func createPythonFunction(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func createJavascriptFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -519,10 +551,12 @@ func createJavascriptFunction(ctx context.Context, d *schema.ResourceData, meta
 
 func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("name", id.Name()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -538,17 +572,20 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 	for i, arg := range arguments {
 		argumentTypes[i] = arg.(map[string]interface{})["type"].(string)
 	}
-	functionDetails, err := client.Functions.Describe(ctx, sdk.NewDescribeFunctionRequest(id.WithoutArguments(), id.Arguments()))
+	functionDetails, err := client.Functions.Describe(ctx, id)
 	if err != nil {
-		// if function is not found then mark resource to be removed from state file during apply or refresh
-		d.SetId("")
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Describe function failed.",
-				Detail:   "See our document on design decisions for functions: <LINK (coming soon)>",
-			},
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// if function is not found then mark resource to be removed from state file during apply or refresh
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Describe function failed.",
+					Detail:   "See our document on design decisions for functions: <LINK (coming soon)>",
+				},
+			}
 		}
+		return diag.FromErr(err)
 	}
 	for _, desc := range functionDetails {
 		switch desc.Property {
@@ -567,20 +604,20 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 					arguments = append(arguments, argument)
 				}
 				if err := d.Set("arguments", arguments); err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		case "null handling":
 			if err := d.Set("null_input_behavior", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "volatility":
 			if err := d.Set("return_behavior", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "body":
 			if err := d.Set("statement", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "returns":
 			// Format in Snowflake DB is returnType(<some number>)
@@ -591,12 +628,12 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 				rt = match[1]
 			}
 			if err := d.Set("return_type", rt); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "language":
 			if snowflake.Contains(languages, desc.Value) {
 				if err := d.Set("language", desc.Value); err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		case "packages":
@@ -604,7 +641,7 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 			if value != "" { // Do nothing for Java / Python functions without packages
 				packages := strings.Split(value, ",")
 				if err := d.Set("packages", packages); err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		case "imports":
@@ -612,20 +649,32 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 			if value != "" { // Do nothing for Java functions without imports
 				imports := strings.Split(value, ",")
 				if err := d.Set("imports", imports); err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		case "handler":
 			if err := d.Set("handler", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "target_path":
 			if err := d.Set("target_path", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
 			}
 		case "runtime_version":
 			if err := d.Set("runtime_version", desc.Value); err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
+			}
+		case "external_access_integrations":
+			if err := d.Set("external_access_integrations", parseExternalAccessIntegrationList(desc.Value)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "secrets":
+			secrets, err := parseSecretReferences(desc.Value)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return diag.FromErr(err)
 			}
 		default:
 			diags = append(diags, diag.Diagnostic{
@@ -637,38 +686,32 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// Show functions to set is_secure and comment
-	request := sdk.NewShowFunctionRequest().WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&sdk.Like{Pattern: sdk.String(id.Name())})
-	functions, err := client.Functions.Show(ctx, request)
+	function, err := client.Functions.ShowByID(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	for _, function := range functions {
-		signature := strings.Split(function.Arguments, " RETURN ")[0]
-		signature = strings.ReplaceAll(signature, " ", "")
-		id.FullyQualifiedName()
-		if signature == id.ArgumentsSignature() {
-			if err := d.Set("is_secure", function.IsSecure); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set("comment", function.Description); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+	if err := d.Set("is_secure", function.IsSecure); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("comment", function.Description); err != nil {
+		return diag.FromErr(err)
 	}
 	return diags
 }
 
 func UpdateContextFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("name") {
 		name := d.Get("name")
-		if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithRenameTo(sdk.Pointer(sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), name.(string))))); err != nil {
+		if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithRenameTo(sdk.Pointer(sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), name.(string))))); err != nil {
 			return diag.FromErr(err)
 		}
-		id = sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), name.(string), id.Arguments())
+		id = sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), name.(string), id.ArgumentDataTypes())
 		if err := d.Set("name", name); err != nil {
 			return diag.FromErr(err)
 		}
@@ -676,11 +719,11 @@ func UpdateContextFunction(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChange("is_secure") {
 		secure := d.Get("is_secure")
 		if secure.(bool) {
-			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithSetSecure(sdk.Bool(true))); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithSetSecure(sdk.Bool(true))); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithUnsetSecure(sdk.Bool(true))); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithUnsetSecure(sdk.Bool(true))); err != nil {
 				return diag.FromErr(err)
 			}
 		}
@@ -688,11 +731,11 @@ func UpdateContextFunction(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		if comment != "" {
-			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithSetComment(sdk.String(comment.(string)))); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithSetComment(sdk.String(comment.(string)))); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments()).WithUnsetComment(sdk.Bool(true))); err != nil {
+			if err := client.Functions.Alter(ctx, sdk.NewAlterFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithUnsetComment(sdk.Bool(true))); err != nil {
 				return diag.FromErr(err)
 			}
 		}
@@ -701,11 +744,13 @@ func UpdateContextFunction(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func DeleteContextFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
-	if err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id.WithoutArguments(), id.Arguments())); err != nil {
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.Functions.Drop(ctx, sdk.NewDropFunctionRequest(id.SchemaObjectId(), id.ArgumentDataTypes())); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
@@ -728,6 +773,52 @@ func parseFunctionArguments(d *schema.ResourceData) ([]sdk.FunctionArgumentReque
 	return args, nil
 }
 
+func expandExternalAccessIntegrations(d *schema.ResourceData) []sdk.AccountObjectIdentifier {
+	integrations := make([]sdk.AccountObjectIdentifier, 0)
+	for _, item := range d.Get("external_access_integrations").(*schema.Set).List() {
+		integrations = append(integrations, sdk.NewAccountObjectIdentifier(item.(string)))
+	}
+	return integrations
+}
+
+func expandSecretReferences(d *schema.ResourceData) ([]sdk.SecretReference, error) {
+	secrets := make([]sdk.SecretReference, 0)
+	for variableName, secretName := range d.Get("secrets").(map[string]interface{}) {
+		secretId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](secretName.(string))
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, sdk.SecretReference{
+			VariableName: variableName,
+			Name:         secretId.FullyQualifiedName(),
+		})
+	}
+	// map iteration order is random, keep the generated SQL stable
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].VariableName < secrets[j].VariableName })
+	return secrets, nil
+}
+
+// parseSecretReferences parses the secrets property returned by DESCRIBE FUNCTION and DESCRIBE PROCEDURE,
+// a JSON object mapping the variable names to the secret names, e.g. {"cred":"\"DB\".\"SCHEMA\".\"SECRET\""}.
+func parseSecretReferences(value string) (map[string]any, error) {
+	secrets := make(map[string]any)
+	if strings.TrimSpace(value) == "" {
+		return secrets, nil
+	}
+	var described map[string]string
+	if err := json.Unmarshal([]byte(value), &described); err != nil {
+		return nil, fmt.Errorf("unable to parse secrets %s: %w", value, err)
+	}
+	for variableName, secretName := range described {
+		secretId, err := sdk.ParseSchemaObjectIdentifier(secretName)
+		if err != nil {
+			return nil, err
+		}
+		secrets[variableName] = secretId.FullyQualifiedName()
+	}
+	return secrets, nil
+}
+
 func convertFunctionDataType(s string) (sdk.DataType, diag.Diagnostics) {
 	dataType, err := sdk.ToDataType(s)
 	if err != nil {
@@ -109,6 +109,41 @@ func TestAcc_Function_Python(t *testing.T) {
 }
 */
 
+func TestAcc_Function_Java_externalAccess(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_function.f"
+	m := func() map[string]config.Variable {
+		return map[string]config.Variable{
+			"name":     config.StringVariable(name),
+			"database": config.StringVariable(acc.TestDatabaseName),
+			"schema":   config.StringVariable(acc.TestSchemaName),
+			"comment":  config.StringVariable("Terraform acceptance test"),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Function/java_external_access"),
+				ConfigVariables: m(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "external_access_integrations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_access_integrations.0", name),
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.cred", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Function_complex(t *testing.T) {
 	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resourceName := "snowflake_function.f"
@@ -258,7 +293,10 @@ func testAccCheckFunctionDestroy(s *terraform.State) error {
 			continue
 		}
 		ctx := context.Background()
-		id := sdk.NewSchemaObjectIdentifier(rs.Primary.Attributes["database"], rs.Primary.Attributes["schema"], rs.Primary.Attributes["name"])
+		id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 		function, err := client.Functions.ShowByID(ctx, id)
 		if err == nil {
 			return fmt.Errorf("function %v still exists", function.Name)
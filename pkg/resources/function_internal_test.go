@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretReferences(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected map[string]any
+	}{
+		"empty":        {value: "", expected: map[string]any{}},
+		"empty object": {value: "{}", expected: map[string]any{}},
+		"quoted names": {
+			value:    `{"cred":"\"DB\".\"SCHEMA\".\"secret\""}`,
+			expected: map[string]any{"cred": `"DB"."SCHEMA"."secret"`},
+		},
+		"unquoted names": {
+			value:    `{"cred":"DB.SCHEMA.SECRET","other":"DB.SCHEMA.OTHER"}`,
+			expected: map[string]any{"cred": `"DB"."SCHEMA"."SECRET"`, "other": `"DB"."SCHEMA"."OTHER"`},
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			secrets, err := parseSecretReferences(tc.value)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, secrets)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseSecretReferences("[cred]")
+		require.Error(t, err)
+
+		_, err = parseSecretReferences(`{"cred":"SECRET"}`)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantApplicationRoleSchema = map[string]*schema.Schema{
+	"application_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application role (in the `\"<application_name>\".\"<application_role_name>\"` format) which will be granted to the parent role.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+	},
+	"parent_account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the account role to which the application role will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"parent_account_role_name",
+			"parent_application_role_name",
+		},
+	},
+	"parent_application_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application role to which the application role will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"parent_account_role_name",
+			"parent_application_role_name",
+		},
+	},
+}
+
+func GrantApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateGrantApplicationRole,
+		DeleteContext: DeleteGrantApplicationRole,
+		ReadContext:   ReadGrantApplicationRole,
+
+		Schema: grantApplicationRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportGrantApplicationRole(),
+		},
+	}
+}
+
+func ImportGrantApplicationRole() func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		logging.DebugLogger.Printf("[DEBUG] Entering import grant application role")
+		id, err := ParseGrantApplicationRoleId(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		logging.DebugLogger.Printf("[DEBUG] Imported identifier: %s", id.String())
+
+		if err := d.Set("application_role_name", id.ApplicationRoleName.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+
+		switch id.Kind {
+		case ToAccountRoleApplicationRoleGrantKind:
+			if err := d.Set("parent_account_role_name", id.AccountRoleName.FullyQualifiedName()); err != nil {
+				return nil, err
+			}
+		case ToApplicationRoleApplicationRoleGrantKind:
+			if err := d.Set("parent_application_role_name", id.ParentApplicationRoleName.FullyQualifiedName()); err != nil {
+				return nil, err
+			}
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func CreateGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	logging.DebugLogger.Printf("[DEBUG] Entering create grant application role")
+	client := meta.(*provider.Context).Client
+
+	id, err := createGrantApplicationRoleIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logging.DebugLogger.Printf("[DEBUG] created identifier from schema: %s", id.String())
+
+	err = client.ApplicationRoles.Grant(ctx, sdk.NewGrantApplicationRoleRequest(id.ApplicationRoleName, getApplicationRoleKindOfRole(id)))
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred during grant application role",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", id.String(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantApplicationRole(ctx, d, meta)
+}
+
+func DeleteGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	err = client.ApplicationRoles.Revoke(ctx, sdk.NewRevokeApplicationRoleRequest(id.ApplicationRoleName, getApplicationRoleKindOfRole(id)))
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking application role",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ReadGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	client := meta.(*provider.Context).Client
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			ApplicationRole: id.ApplicationRoleName,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If the application (or the application role) is not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] application role (%s) not found", id.ApplicationRoleName.FullyQualifiedName())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	grantFound := false
+	for _, grant := range grants {
+		switch {
+		case id.Kind == ToAccountRoleApplicationRoleGrantKind && grant.GrantedTo == sdk.ObjectTypeRole:
+			grantFound = grant.GranteeName.Name() == id.AccountRoleName.Name()
+		case id.Kind == ToApplicationRoleApplicationRoleGrantKind && grant.GrantedTo == sdk.ObjectTypeApplicationRole:
+			grantFound = applicationRoleGranteeId(grant.GranteeName.Name(), id.ApplicationRoleName) == id.ParentApplicationRoleName
+		}
+		if grantFound {
+			break
+		}
+	}
+
+	if !grantFound {
+		log.Printf("[DEBUG] Application role grant %s not found in Snowflake, removing it from the state", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// applicationRoleGranteeId returns the identifier of the application role in the grantee_name column of SHOW GRANTS OF APPLICATION ROLE.
+// The column may hold only the name of the role; application roles can only be granted to the roles of the same application,
+// so the application of the granted role is used then.
+func applicationRoleGranteeId(granteeName string, grantedApplicationRole sdk.DatabaseObjectIdentifier) sdk.DatabaseObjectIdentifier {
+	parts, err := sdk.SplitIdentifier(granteeName, '.')
+	if err != nil || len(parts) > 2 {
+		return sdk.NewDatabaseObjectIdentifier("", granteeName)
+	}
+	if len(parts) == 1 {
+		return sdk.NewDatabaseObjectIdentifier(grantedApplicationRole.DatabaseName(), parts[0])
+	}
+	return sdk.NewDatabaseObjectIdentifier(parts[0], parts[1])
+}
+
+func createGrantApplicationRoleIdFromSchema(d *schema.ResourceData) (GrantApplicationRoleId, error) {
+	applicationRoleName, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](d.Get("application_role_name").(string))
+	if err != nil {
+		return GrantApplicationRoleId{}, err
+	}
+	id := GrantApplicationRoleId{
+		ApplicationRoleName: applicationRoleName,
+	}
+
+	if parentAccountRoleName, ok := d.GetOk("parent_account_role_name"); ok && parentAccountRoleName.(string) != "" {
+		id.Kind = ToAccountRoleApplicationRoleGrantKind
+		id.AccountRoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parentAccountRoleName.(string))
+	}
+
+	if parentApplicationRoleName, ok := d.GetOk("parent_application_role_name"); ok && parentApplicationRoleName.(string) != "" {
+		id.Kind = ToApplicationRoleApplicationRoleGrantKind
+		id.ParentApplicationRoleName, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parentApplicationRoleName.(string))
+		if err != nil {
+			return id, err
+		}
+	}
+
+	return id, nil
+}
+
+func getApplicationRoleKindOfRole(id GrantApplicationRoleId) sdk.KindOfRoleRequest {
+	kindOfRole := sdk.NewKindOfRoleRequest()
+	switch id.Kind {
+	case ToAccountRoleApplicationRoleGrantKind:
+		kindOfRole.WithRoleName(sdk.Pointer(id.AccountRoleName))
+	case ToApplicationRoleApplicationRoleGrantKind:
+		kindOfRole.WithApplicationRoleName(sdk.Pointer(id.ParentApplicationRoleName))
+	}
+	return *kindOfRole
+}
@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantApplicationRole_ToAccountRole(t *testing.T) {
+	applicationName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	applicationPackageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	accountRoleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, stageName)
+	resourceName := "snowflake_grant_application_role.test"
+
+	packageVariables := config.Variables{
+		"application_package_name": config.StringVariable(applicationPackageName),
+		"stage_name":               config.StringVariable(stageName),
+		"database":                 config.StringVariable(acc.TestDatabaseName),
+		"schema":                   config.StringVariable(acc.TestSchemaName),
+	}
+	configVariables := config.Variables{
+		"application_name":         config.StringVariable(applicationName),
+		"application_package_name": config.StringVariable(applicationPackageName),
+		"stage_name":               config.StringVariable(stageName),
+		"database":                 config.StringVariable(acc.TestDatabaseName),
+		"schema":                   config.StringVariable(acc.TestSchemaName),
+		"account_role_name":        config.StringVariable(accountRoleName),
+	}
+
+	applicationRoleFullyQualifiedName := sdk.NewDatabaseObjectIdentifier(applicationName, "APP_PUBLIC").FullyQualifiedName()
+	accountRoleFullyQualifiedName := sdk.NewAccountObjectIdentifier(accountRoleName).FullyQualifiedName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			// the stage has to exist before the application files are uploaded
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Application/package"),
+				ConfigVariables: packageVariables,
+			},
+			{
+				PreConfig: func() {
+					putApplicationFilesOnStage(t, stageId, "manifest.yml", "setup.sql")
+				},
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantApplicationRole/ToAccountRole"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "application_role_name", applicationRoleFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "parent_account_role_name", accountRoleFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|ToAccountRole|%s", applicationRoleFullyQualifiedName, accountRoleFullyQualifiedName)),
+				),
+			},
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_GrantApplicationRole/ToAccountRole"),
+				ConfigVariables:   configVariables,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type ApplicationRoleGrantKind string
+
+const (
+	ToAccountRoleApplicationRoleGrantKind     ApplicationRoleGrantKind = "ToAccountRole"
+	ToApplicationRoleApplicationRoleGrantKind ApplicationRoleGrantKind = "ToApplicationRole"
+)
+
+type GrantApplicationRoleId struct {
+	ApplicationRoleName       sdk.DatabaseObjectIdentifier
+	Kind                      ApplicationRoleGrantKind
+	AccountRoleName           sdk.AccountObjectIdentifier
+	ParentApplicationRoleName sdk.DatabaseObjectIdentifier
+}
+
+func (g *GrantApplicationRoleId) String() string {
+	var parts []string
+	parts = append(parts, g.ApplicationRoleName.FullyQualifiedName())
+	parts = append(parts, string(g.Kind))
+	switch g.Kind {
+	case ToAccountRoleApplicationRoleGrantKind:
+		parts = append(parts, g.AccountRoleName.FullyQualifiedName())
+	case ToApplicationRoleApplicationRoleGrantKind:
+		parts = append(parts, g.ParentApplicationRoleName.FullyQualifiedName())
+	}
+	return strings.Join(parts, helpers.IDDelimiter)
+}
+
+func ParseGrantApplicationRoleId(id string) (GrantApplicationRoleId, error) {
+	var grantApplicationRoleId GrantApplicationRoleId
+
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return grantApplicationRoleId, sdk.NewError(fmt.Sprintf(`grant application role identifier should consist of 3 parts "<application_role_name>|<grant_kind>|<parent_role_name>", but got %d parts: %v`, len(parts), parts))
+	}
+
+	applicationRoleName, err := parseApplicationRoleName(parts[0])
+	if err != nil {
+		return grantApplicationRoleId, err
+	}
+	grantApplicationRoleId.ApplicationRoleName = applicationRoleName
+
+	grantApplicationRoleId.Kind = ApplicationRoleGrantKind(parts[1])
+	switch grantApplicationRoleId.Kind {
+	case ToAccountRoleApplicationRoleGrantKind:
+		grantApplicationRoleId.AccountRoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[2])
+	case ToApplicationRoleApplicationRoleGrantKind:
+		parentApplicationRoleName, err := parseApplicationRoleName(parts[2])
+		if err != nil {
+			return grantApplicationRoleId, err
+		}
+		grantApplicationRoleId.ParentApplicationRoleName = parentApplicationRoleName
+	default:
+		return grantApplicationRoleId, sdk.NewError(fmt.Sprintf("unknown ApplicationRoleGrantKind: %s, valid options are %v", parts[1], []ApplicationRoleGrantKind{ToAccountRoleApplicationRoleGrantKind, ToApplicationRoleApplicationRoleGrantKind}))
+	}
+
+	return grantApplicationRoleId, nil
+}
+
+func parseApplicationRoleName(name string) (sdk.DatabaseObjectIdentifier, error) {
+	applicationRoleNameParts, err := sdk.SplitIdentifier(name, helpers.ParameterIDDelimiter)
+	if err != nil || len(applicationRoleNameParts) != 2 {
+		return sdk.DatabaseObjectIdentifier{}, sdk.NewError(fmt.Sprintf(`invalid ApplicationRoleName value: %s, should be a fully qualified name of application role <application_name>.<name>`, name))
+	}
+	return sdk.NewDatabaseObjectIdentifier(applicationRoleNameParts[0], applicationRoleNameParts[1]), nil
+}
@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrantApplicationRoleId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantApplicationRoleId
+		Error      string
+	}{
+		{
+			Name:       "grant application role to account role",
+			Identifier: `"application-name"."application-role"|ToAccountRole|"account-role"`,
+			Expected: GrantApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("application-name", "application-role"),
+				Kind:                ToAccountRoleApplicationRoleGrantKind,
+				AccountRoleName:     sdk.NewAccountObjectIdentifier("account-role"),
+			},
+		},
+		{
+			Name:       "grant application role to application role",
+			Identifier: `"application-name"."application-role"|ToApplicationRole|"application-name"."parent-application-role"`,
+			Expected: GrantApplicationRoleId{
+				ApplicationRoleName:       sdk.NewDatabaseObjectIdentifier("application-name", "application-role"),
+				Kind:                      ToApplicationRoleApplicationRoleGrantKind,
+				ParentApplicationRoleName: sdk.NewDatabaseObjectIdentifier("application-name", "parent-application-role"),
+			},
+		},
+		{
+			Name:       "validation: grant application role not enough parts",
+			Identifier: `"application-name"."application-role"|ToAccountRole`,
+			Error:      `grant application role identifier should consist of 3 parts "<application_role_name>|<grant_kind>|<parent_role_name>", but got 2 parts`,
+		},
+		{
+			Name:       "validation: grant application role too many parts",
+			Identifier: `"application-name"."application-role"|ToAccountRole|"account-role"|something`,
+			Error:      `grant application role identifier should consist of 3 parts "<application_role_name>|<grant_kind>|<parent_role_name>", but got 4 parts`,
+		},
+		{
+			Name:       "validation: grant application role invalid application role name",
+			Identifier: `"application-role"|ToAccountRole|"account-role"`,
+			Error:      `invalid ApplicationRoleName value: "application-role", should be a fully qualified name of application role <application_name>.<name>`,
+		},
+		{
+			Name:       "validation: grant application role invalid parent application role name",
+			Identifier: `"application-name"."application-role"|ToApplicationRole|"parent-application-role"`,
+			Error:      `invalid ApplicationRoleName value: "parent-application-role", should be a fully qualified name of application role <application_name>.<name>`,
+		},
+		{
+			Name:       "validation: grant application role unknown kind",
+			Identifier: `"application-name"."application-role"|ToShare|"share"`,
+			Error:      "unknown ApplicationRoleGrantKind: ToShare, valid options are [ToAccountRole ToApplicationRole]",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantApplicationRoleId(tt.Identifier)
+			if tt.Error == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantApplicationRoleIdString(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier GrantApplicationRoleId
+		Expected   string
+	}{
+		{
+			Name: "grant application role to account role",
+			Identifier: GrantApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("application-name", "application-role"),
+				Kind:                ToAccountRoleApplicationRoleGrantKind,
+				AccountRoleName:     sdk.NewAccountObjectIdentifier("account-role"),
+			},
+			Expected: `"application-name"."application-role"|ToAccountRole|"account-role"`,
+		},
+		{
+			Name: "grant application role to application role",
+			Identifier: GrantApplicationRoleId{
+				ApplicationRoleName:       sdk.NewDatabaseObjectIdentifier("application-name", "application-role"),
+				Kind:                      ToApplicationRoleApplicationRoleGrantKind,
+				ParentApplicationRoleName: sdk.NewDatabaseObjectIdentifier("application-name", "parent-application-role"),
+			},
+			Expected: `"application-name"."application-role"|ToApplicationRole|"application-name"."parent-application-role"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Identifier.String())
+		})
+	}
+}
+
+func TestApplicationRoleGranteeId(t *testing.T) {
+	grantedApplicationRole := sdk.NewDatabaseObjectIdentifier("app", "granted_role")
+
+	testCases := []struct {
+		Name        string
+		GranteeName string
+		Expected    sdk.DatabaseObjectIdentifier
+	}{
+		{Name: "name only", GranteeName: "parent_role", Expected: sdk.NewDatabaseObjectIdentifier("app", "parent_role")},
+		{Name: "fully qualified name", GranteeName: "other_app.parent_role", Expected: sdk.NewDatabaseObjectIdentifier("other_app", "parent_role")},
+		{Name: "quoted fully qualified name", GranteeName: `"other.app"."parent_role"`, Expected: sdk.NewDatabaseObjectIdentifier("other.app", "parent_role")},
+		{Name: "partially quoted fully qualified name", GranteeName: `APP."parent_role"`, Expected: sdk.NewDatabaseObjectIdentifier("APP", "parent_role")},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, applicationRoleGranteeId(tt.GranteeName, grantedApplicationRole))
+		})
+	}
+}
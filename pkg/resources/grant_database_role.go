@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -98,11 +98,13 @@ func GrantDatabaseRole() *schema.Resource {
 
 // CreateGrantDatabaseRole implements schema.CreateFunc.
 func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	databaseRoleName := d.Get("database_role_name").(string)
-	databaseRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(databaseRoleName)
+	databaseRoleIdentifier, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](databaseRoleName)
+	if err != nil {
+		return err
+	}
 	// format of snowflakeResourceID is <database_role_identifier>|<object type>|<parent_role_name>
 	var snowflakeResourceID string
 	if parentRoleName, ok := d.GetOk("parent_role_name"); ok && parentRoleName.(string) != "" {
@@ -113,7 +115,10 @@ func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	} else if parentDatabaseRoleName, ok := d.GetOk("parent_database_role_name"); ok && parentDatabaseRoleName.(string) != "" {
-		parentRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parentDatabaseRoleName.(string))
+		parentRoleIdentifier, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parentDatabaseRoleName.(string))
+		if err != nil {
+			return err
+		}
 		snowflakeResourceID = helpers.EncodeSnowflakeID(databaseRoleIdentifier.FullyQualifiedName(), sdk.ObjectTypeDatabaseRole.String(), parentRoleIdentifier.FullyQualifiedName())
 		req := sdk.NewGrantDatabaseRoleRequest(databaseRoleIdentifier).WithDatabaseRole(parentRoleIdentifier)
 		if err := client.DatabaseRoles.Grant(ctx, req); err != nil {
@@ -133,13 +138,11 @@ func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // ReadGrantDatabaseRole implements schema.ReadFunc.
 func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	parts := strings.Split(d.Id(), helpers.IDDelimiter)
-	databaseRoleName := parts[0]
-	databaseRoleIdentifier := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(databaseRoleName)
-	objectType := parts[1]
-	targetIdentifier := parts[2]
+	client := meta.(*provider.Context).Client
+	databaseRoleIdentifier, objectType, targetIdentifier, err := parseGrantDatabaseRoleId(d.Id())
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
 	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
 		Of: &sdk.ShowGrantsOf{
@@ -204,13 +207,12 @@ func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteGrantDatabaseRole implements schema.DeleteFunc.
 func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	parts := strings.Split(d.Id(), "|")
-	id := sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[0])
-	objectType := parts[1]
-	granteeName := parts[2]
+	id, objectType, granteeName, err := parseGrantDatabaseRoleId(d.Id())
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
 	switch objectType {
 	case "ROLE":
@@ -218,7 +220,11 @@ func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	case "DATABASE ROLE":
-		if err := client.DatabaseRoles.Revoke(ctx, sdk.NewRevokeDatabaseRoleRequest(id).WithDatabaseRole(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(granteeName))); err != nil {
+		granteeId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](granteeName)
+		if err != nil {
+			return err
+		}
+		if err := client.DatabaseRoles.Revoke(ctx, sdk.NewRevokeDatabaseRoleRequest(id).WithDatabaseRole(granteeId)); err != nil {
 			return err
 		}
 	case "SHARE":
@@ -229,3 +235,16 @@ func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// parseGrantDatabaseRoleId parses the id in the format of <database_role_identifier>|<object type>|<parent_role_name>
+func parseGrantDatabaseRoleId(id string) (sdk.DatabaseObjectIdentifier, string, string, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return sdk.DatabaseObjectIdentifier{}, "", "", fmt.Errorf("invalid grant database role id %s, expected <database_role_identifier>|<object type>|<parent_role_name>", id)
+	}
+	databaseRoleIdentifier, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[0])
+	if err != nil {
+		return sdk.DatabaseObjectIdentifier{}, "", "", err
+	}
+	return databaseRoleIdentifier, parts[1], parts[2], nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -79,7 +80,7 @@ func createGenericGrantRolesAndShares(
 	roles []string,
 	shares []string,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	for _, role := range roles {
 		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
 			return err
@@ -118,7 +119,7 @@ func readGenericGrant(
 	allObjects bool,
 	_ PrivilegeSet,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	var grants []*grant
 	var err error
 
@@ -330,7 +331,7 @@ func deleteGenericGrantRolesAndShares(
 	roles []string,
 	shares []string,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 
 	for _, role := range roles {
 		executable := builder.Role(role).Revoke(priv)
@@ -396,10 +397,9 @@ func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
 		if privilege, ok := value.(string); ok && strings.ToUpper(privilege) == "OWNERSHIP" {
 			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unsupported privilege 'OWNERSHIP'",
-				// TODO: Change when a new resource for granting ownership will be available (SNOW-991423)
-				Detail:        "Granting ownership is only allowed in dedicated resources (snowflake_user_ownership_grant, snowflake_role_ownership_grant)",
+				Severity:      diag.Error,
+				Summary:       "Unsupported privilege 'OWNERSHIP'",
+				Detail:        "Granting ownership is only allowed in dedicated resources (snowflake_grant_ownership, snowflake_user_ownership_grant, snowflake_role_ownership_grant)",
 				AttributePath: nil,
 			})
 		}
@@ -407,6 +407,17 @@ func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
 	}
 }
 
+// getGrantedSchemaObjectIdentifier returns the identifier of the schema object privileges are granted on. Functions and procedures
+// can be overloaded, so their identifiers include the argument data types, e.g. "db"."schema"."fn"(NUMBER, VARCHAR).
+func getGrantedSchemaObjectIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	switch sdk.ObjectType(strings.ToUpper(objectType.String())) {
+	case sdk.ObjectTypeFunction, sdk.ObjectTypeExternalFunction, sdk.ObjectTypeProcedure:
+		return sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(objectName)
+	default:
+		return sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](objectName)
+	}
+}
+
 func ValidGrantedObjectType() schema.SchemaValidateDiagFunc {
 	return StringInSlice([]string{
 		sdk.ObjectTypeAlert.String(),
@@ -436,6 +447,47 @@ func ValidGrantedObjectType() schema.SchemaValidateDiagFunc {
 	}, true)
 }
 
+func ValidGrantOwnershipObjectType() schema.SchemaValidateDiagFunc {
+	return StringInSlice([]string{
+		sdk.ObjectTypeAlert.String(),
+		sdk.ObjectTypeComputePool.String(),
+		sdk.ObjectTypeDatabase.String(),
+		sdk.ObjectTypeDatabaseRole.String(),
+		sdk.ObjectTypeDynamicTable.String(),
+		sdk.ObjectTypeEventTable.String(),
+		sdk.ObjectTypeExternalTable.String(),
+		sdk.ObjectTypeExternalVolume.String(),
+		sdk.ObjectTypeFailoverGroup.String(),
+		sdk.ObjectTypeFileFormat.String(),
+		sdk.ObjectTypeFunction.String(),
+		sdk.ObjectTypeIcebergTable.String(),
+		sdk.ObjectTypeIntegration.String(),
+		sdk.ObjectTypeMaskingPolicy.String(),
+		sdk.ObjectTypeMaterializedView.String(),
+		sdk.ObjectTypeNetworkPolicy.String(),
+		sdk.ObjectTypeNetworkRule.String(),
+		sdk.ObjectTypePackagesPolicy.String(),
+		sdk.ObjectTypePasswordPolicy.String(),
+		sdk.ObjectTypePipe.String(),
+		sdk.ObjectTypeProcedure.String(),
+		sdk.ObjectTypeReplicationGroup.String(),
+		sdk.ObjectTypeRole.String(),
+		sdk.ObjectTypeRowAccessPolicy.String(),
+		sdk.ObjectTypeSchema.String(),
+		sdk.ObjectTypeSecret.String(),
+		sdk.ObjectTypeSequence.String(),
+		sdk.ObjectTypeSessionPolicy.String(),
+		sdk.ObjectTypeStage.String(),
+		sdk.ObjectTypeStream.String(),
+		sdk.ObjectTypeTable.String(),
+		sdk.ObjectTypeTag.String(),
+		sdk.ObjectTypeTask.String(),
+		sdk.ObjectTypeUser.String(),
+		sdk.ObjectTypeView.String(),
+		sdk.ObjectTypeWarehouse.String(),
+	}, true)
+}
+
 func ValidGrantedPluralObjectType() schema.SchemaValidateDiagFunc {
 	return StringInSlice(
 		[]string{
@@ -0,0 +1,563 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantOwnershipSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the account role to which ownership will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"account_role_name",
+			"database_role_name",
+		},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the database role to which ownership will be granted.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf: []string{
+			"account_role_name",
+			"database_role_name",
+		},
+	},
+	"outbound_privileges": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies whether to remove or transfer all existing outbound privileges on the object when ownership is transferred to a new role. Available options are: REVOKE for removing existing privileges and COPY to transfer them with ownership. For more information head over to [Snowflake documentation](https://docs.snowflake.com/en/sql-reference/sql/grant-ownership#optional-parameters).",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.Copy), string(sdk.Revoke)}, true),
+	},
+	"revert_ownership_to_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The fully qualified name of the account role to which ownership of the object(s) will be granted back when the resource is destroyed. If not set, the ownership is left as is. It has no effect on future grants, which are always revoked on destroy.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"on": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Configures which object(s) should transfer their ownership to the specified role.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "Specifies the type of object on which you are transferring ownership. Available values are: ALERT | COMPUTE POOL | DATABASE | DATABASE ROLE | DYNAMIC TABLE | EVENT TABLE | EXTERNAL TABLE | EXTERNAL VOLUME | FAILOVER GROUP | FILE FORMAT | FUNCTION | ICEBERG TABLE | INTEGRATION | MASKING POLICY | MATERIALIZED VIEW | NETWORK POLICY | NETWORK RULE | PACKAGES POLICY | PASSWORD POLICY | PIPE | PROCEDURE | REPLICATION GROUP | ROLE | ROW ACCESS POLICY | SCHEMA | SECRET | SEQUENCE | SESSION POLICY | STAGE | STREAM | TABLE | TAG | TASK | USER | VIEW | WAREHOUSE",
+					ValidateDiagFunc: ValidGrantOwnershipObjectType(),
+					RequiredWith: []string{
+						"on.0.object_name",
+					},
+					ConflictsWith: []string{
+						"on.0.all",
+						"on.0.future",
+					},
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Specifies the identifier for the object on which you are transferring ownership. Names of functions and procedures should be followed by the argument data types, e.g. <database_name>.<schema_name>.<name>(NUMBER, VARCHAR).",
+					RequiredWith: []string{
+						"on.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on.0.object_name",
+						"on.0.all",
+						"on.0.future",
+					},
+				},
+				"all": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					MaxItems:    1,
+					Description: "Configures the ownership to be transferred on all objects in either a database or schema.",
+					Elem: &schema.Resource{
+						Schema: grantOwnershipBulkOperationSchema("all"),
+					},
+					ExactlyOneOf: []string{
+						"on.0.object_name",
+						"on.0.all",
+						"on.0.future",
+					},
+				},
+				"future": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					MaxItems:    1,
+					Description: "Configures the ownership to be granted on future objects in either a database or schema.",
+					Elem: &schema.Resource{
+						Schema: grantOwnershipBulkOperationSchema("future"),
+					},
+					ExactlyOneOf: []string{
+						"on.0.object_name",
+						"on.0.all",
+						"on.0.future",
+					},
+				},
+			},
+		},
+	},
+}
+
+func grantOwnershipBulkOperationSchema(branchName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"object_type_plural": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "Specifies the type of object in plural form on which you are transferring ownership. Available values are: ALERTS | DYNAMIC TABLES | EVENT TABLES | FILE FORMATS | FUNCTIONS | PROCEDURES | SECRETS | SEQUENCES | PIPES | MASKING POLICIES | PASSWORD POLICIES | ROW ACCESS POLICIES | SESSION POLICIES | TAGS | STAGES | STREAMS | TABLES | EXTERNAL TABLES | TASKS | VIEWS | MATERIALIZED VIEWS | NETWORK RULES | PACKAGES POLICIES | ICEBERG TABLES",
+			ValidateDiagFunc: ValidGrantedPluralObjectType(),
+		},
+		"in_database": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "The fully qualified name of the database.",
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			ExactlyOneOf: []string{
+				fmt.Sprintf("on.0.%s.0.in_database", branchName),
+				fmt.Sprintf("on.0.%s.0.in_schema", branchName),
+			},
+		},
+		"in_schema": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "The fully qualified name of the schema.",
+			ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+			ExactlyOneOf: []string{
+				fmt.Sprintf("on.0.%s.0.in_database", branchName),
+				fmt.Sprintf("on.0.%s.0.in_schema", branchName),
+			},
+		},
+	}
+}
+
+func GrantOwnership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateGrantOwnership,
+		UpdateContext: UpdateGrantOwnership,
+		DeleteContext: DeleteGrantOwnership,
+		ReadContext:   ReadGrantOwnership,
+
+		Schema: grantOwnershipSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportGrantOwnership(),
+		},
+	}
+}
+
+func ImportGrantOwnership() func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		logging.DebugLogger.Printf("[DEBUG] Entering import grant ownership")
+		id, err := ParseGrantOwnershipId(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		logging.DebugLogger.Printf("[DEBUG] Imported identifier: %s", id.String())
+
+		switch id.GrantOwnershipTargetRoleKind {
+		case ToAccountGrantOwnershipTargetRoleKind:
+			if err := d.Set("account_role_name", id.AccountRoleName.FullyQualifiedName()); err != nil {
+				return nil, err
+			}
+		case ToDatabaseGrantOwnershipTargetRoleKind:
+			if err := d.Set("database_role_name", id.DatabaseRoleName.FullyQualifiedName()); err != nil {
+				return nil, err
+			}
+		}
+
+		if id.CurrentGrants != nil {
+			if err := d.Set("outbound_privileges", string(id.CurrentGrants.OutboundPrivileges)); err != nil {
+				return nil, err
+			}
+		}
+
+		on := make(map[string]any)
+		switch id.Data.Kind {
+		case OnObjectSchemaObjectGrantKind:
+			on["object_type"] = id.Data.Object.ObjectType.String()
+			on["object_name"] = id.Data.Object.Name.FullyQualifiedName()
+		case OnAllSchemaObjectGrantKind:
+			on["all"] = []any{getGrantOwnershipBulkOperationMap(id.Data.OnAllOrFuture)}
+		case OnFutureSchemaObjectGrantKind:
+			on["future"] = []any{getGrantOwnershipBulkOperationMap(id.Data.OnAllOrFuture)}
+		}
+		if err := d.Set("on", []any{on}); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func getGrantOwnershipBulkOperationMap(data *BulkOperationGrantData) map[string]any {
+	bulkOperation := make(map[string]any)
+	bulkOperation["object_type_plural"] = data.ObjectNamePlural.String()
+	switch data.Kind {
+	case InDatabaseBulkOperationGrantKind:
+		bulkOperation["in_database"] = data.Database.FullyQualifiedName()
+	case InSchemaBulkOperationGrantKind:
+		bulkOperation["in_schema"] = data.Schema.FullyQualifiedName()
+	}
+	return bulkOperation
+}
+
+func CreateGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	logging.DebugLogger.Printf("[DEBUG] Entering create grant ownership")
+	client := meta.(*provider.Context).Client
+
+	id, err := createGrantOwnershipIdFromSchema(d)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to build grant ownership identifier from the configuration",
+				Detail:   fmt.Sprintf("Error: %s", err.Error()),
+			},
+		}
+	}
+	logging.DebugLogger.Printf("[DEBUG] created identifier from schema: %s", id.String())
+
+	err = client.Grants.GrantOwnership(
+		ctx,
+		getOwnershipGrantOn(id),
+		getOwnershipGrantTo(id),
+		&sdk.GrantOwnershipOptions{
+			CurrentGrants: id.CurrentGrants,
+		},
+	)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred during grant ownership",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", id.String(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantOwnership(ctx, d, meta)
+}
+
+// UpdateGrantOwnership only reads the grant, because all the fields changing the granted ownership are ForceNew;
+// revert_ownership_to_role_name is only used during destroy.
+func UpdateGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return ReadGrantOwnership(ctx, d, meta)
+}
+
+func DeleteGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantOwnershipId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	switch id.Data.Kind {
+	case OnFutureSchemaObjectGrantKind:
+		err = revokeFutureOwnership(ctx, client, id)
+	default:
+		revertOwnershipToRoleName, ok := d.GetOk("revert_ownership_to_role_name")
+		if !ok {
+			log.Printf("[INFO] revert_ownership_to_role_name is not set. The ownership of the object(s) is left as is.")
+			break
+		}
+		err = client.Grants.GrantOwnership(
+			ctx,
+			getOwnershipGrantOn(id),
+			sdk.OwnershipGrantTo{
+				AccountRoleName: sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(revertOwnershipToRoleName.(string))),
+			},
+			&sdk.GrantOwnershipOptions{
+				CurrentGrants: id.CurrentGrants,
+			},
+		)
+	}
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when transferring ownership back",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func revokeFutureOwnership(ctx context.Context, client *sdk.Client, id GrantOwnershipId) error {
+	in := getGrantOwnershipOnSchemaObjectIn(id.Data.OnAllOrFuture)
+	switch id.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		return client.Grants.RevokePrivilegesFromAccountRole(
+			ctx,
+			&sdk.AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectOwnership},
+			},
+			&sdk.AccountRoleGrantOn{
+				SchemaObject: &sdk.GrantOnSchemaObject{
+					Future: in,
+				},
+			},
+			id.AccountRoleName,
+			&sdk.RevokePrivilegesFromAccountRoleOptions{},
+		)
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		return client.Grants.RevokePrivilegesFromDatabaseRole(
+			ctx,
+			&sdk.DatabaseRoleGrantPrivileges{
+				SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectOwnership},
+			},
+			&sdk.DatabaseRoleGrantOn{
+				SchemaObject: &sdk.GrantOnSchemaObject{
+					Future: in,
+				},
+			},
+			id.DatabaseRoleName,
+			&sdk.RevokePrivilegesFromDatabaseRoleOptions{},
+		)
+	default:
+		return fmt.Errorf("unsupported grant ownership target role kind: %s", id.GrantOwnershipTargetRoleKind)
+	}
+}
+
+func ReadGrantOwnership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantOwnershipId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	opts, grantedOn := prepareShowGrantsRequestForGrantOwnership(id)
+	if opts == nil {
+		return nil
+	}
+
+	client := meta.(*provider.Context).Client
+	grants, err := client.Grants.Show(ctx, opts)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] Object of ownership %s not found in Snowflake, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	var expectedGrantedTo sdk.ObjectType
+	var isExpectedGrantee func(granteeName string) bool
+	switch id.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		expectedGrantedTo = sdk.ObjectTypeRole
+		isExpectedGrantee = func(granteeName string) bool {
+			return granteeName == id.AccountRoleName.Name()
+		}
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		expectedGrantedTo = sdk.ObjectTypeDatabaseRole
+		isExpectedGrantee = func(granteeName string) bool {
+			granteeId, ok := databaseRoleGranteeId(granteeName)
+			return ok && granteeId == id.DatabaseRoleName
+		}
+	}
+
+	ownershipFound := false
+	for _, grant := range grants {
+		if grant.Privilege != string(sdk.SchemaObjectOwnership) {
+			continue
+		}
+		// grant_on is for future grants, granted_on is for current grants.
+		// They function the same way though in a test for matching the object type
+		if grantedOn != grant.GrantedOn && grantedOn != grant.GrantOn {
+			continue
+		}
+		// granted_to is for current grants, grant_to is for future grants.
+		if (grant.GrantedTo == expectedGrantedTo || grant.GrantTo == expectedGrantedTo) && isExpectedGrantee(grant.GranteeName.Name()) {
+			ownershipFound = true
+			break
+		}
+	}
+
+	if !ownershipFound {
+		log.Printf("[DEBUG] Ownership %s not found in Snowflake, removing it from the state", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// databaseRoleGranteeId returns the identifier of the database role in the grantee_name column of SHOW GRANTS,
+// which holds the role name qualified with its database (quoted only when needed, e.g. DB."role").
+func databaseRoleGranteeId(granteeName string) (sdk.DatabaseObjectIdentifier, bool) {
+	parts, err := sdk.SplitIdentifier(granteeName, '.')
+	if err != nil || len(parts) != 2 {
+		return sdk.DatabaseObjectIdentifier{}, false
+	}
+	return sdk.NewDatabaseObjectIdentifier(parts[0], parts[1]), true
+}
+
+func prepareShowGrantsRequestForGrantOwnership(id GrantOwnershipId) (*sdk.ShowGrantOptions, sdk.ObjectType) {
+	opts := new(sdk.ShowGrantOptions)
+	var grantedOn sdk.ObjectType
+
+	switch id.Data.Kind {
+	case OnObjectSchemaObjectGrantKind:
+		grantedOn = id.Data.Object.ObjectType
+		opts.On = &sdk.ShowGrantsOn{
+			Object: id.Data.Object,
+		}
+	case OnAllSchemaObjectGrantKind:
+		log.Printf("[INFO] Show with on.all option is skipped. No changes in ownership in Snowflake will be detected.")
+		return nil, ""
+	case OnFutureSchemaObjectGrantKind:
+		grantedOn = id.Data.OnAllOrFuture.ObjectNamePlural.Singular()
+		opts.Future = sdk.Bool(true)
+
+		switch id.Data.OnAllOrFuture.Kind {
+		case InDatabaseBulkOperationGrantKind:
+			opts.In = &sdk.ShowGrantsIn{
+				Database: id.Data.OnAllOrFuture.Database,
+			}
+		case InSchemaBulkOperationGrantKind:
+			opts.In = &sdk.ShowGrantsIn{
+				Schema: id.Data.OnAllOrFuture.Schema,
+			}
+		}
+	}
+
+	return opts, grantedOn
+}
+
+func createGrantOwnershipIdFromSchema(d *schema.ResourceData) (GrantOwnershipId, error) {
+	var id GrantOwnershipId
+
+	if accountRoleName, ok := d.GetOk("account_role_name"); ok {
+		id.GrantOwnershipTargetRoleKind = ToAccountGrantOwnershipTargetRoleKind
+		id.AccountRoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(accountRoleName.(string))
+	}
+	if databaseRoleName, ok := d.GetOk("database_role_name"); ok {
+		id.GrantOwnershipTargetRoleKind = ToDatabaseGrantOwnershipTargetRoleKind
+		databaseRoleId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](databaseRoleName.(string))
+		if err != nil {
+			return id, err
+		}
+		id.DatabaseRoleName = databaseRoleId
+	}
+
+	if outboundPrivileges, ok := d.GetOk("outbound_privileges"); ok {
+		id.CurrentGrants = &sdk.OwnershipCurrentGrants{
+			OutboundPrivileges: sdk.OwnershipCurrentGrantsOutboundPrivileges(strings.ToUpper(outboundPrivileges.(string))),
+		}
+	}
+
+	on := d.Get("on").([]any)[0].(map[string]any)
+	data := new(OnSchemaObjectGrantData)
+
+	if objectType, ok := on["object_type"].(string); ok && len(objectType) > 0 {
+		objectName := on["object_name"].(string)
+		objectIdentifier, err := getOwnershipGrantedObjectIdentifier(sdk.ObjectType(objectType), objectName)
+		if err != nil {
+			return id, err
+		}
+		data.Kind = OnObjectSchemaObjectGrantKind
+		data.Object = &sdk.Object{
+			ObjectType: sdk.ObjectType(strings.ToUpper(objectType)),
+			Name:       objectIdentifier,
+		}
+	}
+	if all, ok := on["all"].([]any); ok && len(all) > 0 {
+		grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(all[0].(map[string]any))
+		if err != nil {
+			return id, err
+		}
+		data.Kind = OnAllSchemaObjectGrantKind
+		data.OnAllOrFuture = getBulkOperationGrantData(grantOnSchemaObjectIn)
+	}
+	if future, ok := on["future"].([]any); ok && len(future) > 0 {
+		grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(future[0].(map[string]any))
+		if err != nil {
+			return id, err
+		}
+		data.Kind = OnFutureSchemaObjectGrantKind
+		data.OnAllOrFuture = getBulkOperationGrantData(grantOnSchemaObjectIn)
+	}
+	id.Data = data
+
+	return id, nil
+}
+
+func getOwnershipGrantOn(id GrantOwnershipId) sdk.OwnershipGrantOn {
+	var on sdk.OwnershipGrantOn
+	switch id.Data.Kind {
+	case OnObjectSchemaObjectGrantKind:
+		on.Object = id.Data.Object
+	case OnAllSchemaObjectGrantKind:
+		on.All = getGrantOwnershipOnSchemaObjectIn(id.Data.OnAllOrFuture)
+	case OnFutureSchemaObjectGrantKind:
+		on.Future = getGrantOwnershipOnSchemaObjectIn(id.Data.OnAllOrFuture)
+	}
+	return on
+}
+
+func getOwnershipGrantTo(id GrantOwnershipId) sdk.OwnershipGrantTo {
+	var to sdk.OwnershipGrantTo
+	switch id.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		to.AccountRoleName = sdk.Pointer(id.AccountRoleName)
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		to.DatabaseRoleName = sdk.Pointer(id.DatabaseRoleName)
+	}
+	return to
+}
+
+func getGrantOwnershipOnSchemaObjectIn(data *BulkOperationGrantData) *sdk.GrantOnSchemaObjectIn {
+	return &sdk.GrantOnSchemaObjectIn{
+		PluralObjectType: data.ObjectNamePlural,
+		InDatabase:       data.Database,
+		InSchema:         data.Schema,
+	}
+}
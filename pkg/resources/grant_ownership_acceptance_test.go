@@ -0,0 +1,153 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantOwnership_OnObject_Table_ToAccountRole(t *testing.T) {
+	accountRoleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	configVariables := config.Variables{
+		"account_role_name": config.StringVariable(accountRoleName),
+		"table_name":        config.StringVariable(tableName),
+		"database":          config.StringVariable(acc.TestDatabaseName),
+		"schema":            config.StringVariable(acc.TestSchemaName),
+	}
+	resourceName := "snowflake_grant_ownership.test"
+
+	accountRoleFullyQualifiedName := sdk.NewAccountObjectIdentifier(accountRoleName).FullyQualifiedName()
+	tableFullyQualifiedName := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, tableName).FullyQualifiedName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			acc.TestAccPreCheck(t)
+			configVariables["revert_role_name"] = config.StringVariable(currentRoleName(t))
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckGrantOwnershipReverted,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantOwnership/OnObject_Table_ToAccountRole"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_role_name", accountRoleFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_privileges", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on.0.object_type", "TABLE"),
+					resource.TestCheckResourceAttr(resourceName, "on.0.object_name", tableFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("ToAccountRole|%s|COPY|OnObject|TABLE|%s", accountRoleFullyQualifiedName, tableFullyQualifiedName)),
+				),
+			},
+			{
+				ConfigDirectory:         acc.ConfigurationDirectory("TestAcc_GrantOwnership/OnObject_Table_ToAccountRole"),
+				ConfigVariables:         configVariables,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"revert_ownership_to_role_name"},
+			},
+		},
+	})
+}
+
+func TestAcc_GrantOwnership_OnFuture_InSchema_ToDatabaseRole(t *testing.T) {
+	databaseRoleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	configVariables := config.Variables{
+		"database_role_name": config.StringVariable(databaseRoleName),
+		"database":           config.StringVariable(acc.TestDatabaseName),
+		"schema":             config.StringVariable(acc.TestSchemaName),
+	}
+	resourceName := "snowflake_grant_ownership.test"
+
+	databaseRoleFullyQualifiedName := sdk.NewDatabaseObjectIdentifier(acc.TestDatabaseName, databaseRoleName).FullyQualifiedName()
+	schemaFullyQualifiedName := sdk.NewDatabaseObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName).FullyQualifiedName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckGrantOwnershipReverted,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_GrantOwnership/OnFuture_InSchema_ToDatabaseRole"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "database_role_name", databaseRoleFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on.0.future.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on.0.future.0.object_type_plural", "TABLES"),
+					resource.TestCheckResourceAttr(resourceName, "on.0.future.0.in_schema", schemaFullyQualifiedName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("ToDatabaseRole|%s||OnFuture|TABLES|InSchema|%s", databaseRoleFullyQualifiedName, schemaFullyQualifiedName)),
+				),
+			},
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_GrantOwnership/OnFuture_InSchema_ToDatabaseRole"),
+				ConfigVariables:   configVariables,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func currentRoleName(t *testing.T) string {
+	t.Helper()
+	client, err := sdk.NewDefaultClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, err := client.ContextFunctions.CurrentRole(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return role
+}
+
+func testAccCheckGrantOwnershipReverted(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_grant_ownership" {
+			continue
+		}
+		ctx := context.Background()
+
+		accountRoleName := rs.Primary.Attributes["account_role_name"]
+		if accountRoleName == "" {
+			continue
+		}
+		id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(accountRoleName)
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{
+				Role: id,
+			},
+		})
+		if err != nil {
+			// the role itself may already be dropped
+			continue
+		}
+		for _, grant := range grants {
+			if grant.Privilege == string(sdk.SchemaObjectOwnership) {
+				return fmt.Errorf("account role (%s) still owns %s %s", id.FullyQualifiedName(), grant.GrantedOn, grant.Name.Name())
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type OwnershipGrantTargetRoleKind string
+
+const (
+	ToAccountGrantOwnershipTargetRoleKind  OwnershipGrantTargetRoleKind = "ToAccountRole"
+	ToDatabaseGrantOwnershipTargetRoleKind OwnershipGrantTargetRoleKind = "ToDatabaseRole"
+)
+
+type GrantOwnershipId struct {
+	GrantOwnershipTargetRoleKind OwnershipGrantTargetRoleKind
+	AccountRoleName              sdk.AccountObjectIdentifier
+	DatabaseRoleName             sdk.DatabaseObjectIdentifier
+	CurrentGrants                *sdk.OwnershipCurrentGrants
+	Data                         *OnSchemaObjectGrantData
+}
+
+func (g *GrantOwnershipId) String() string {
+	var parts []string
+	parts = append(parts, string(g.GrantOwnershipTargetRoleKind))
+	switch g.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		parts = append(parts, g.AccountRoleName.FullyQualifiedName())
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		parts = append(parts, g.DatabaseRoleName.FullyQualifiedName())
+	}
+	if g.CurrentGrants != nil {
+		parts = append(parts, string(g.CurrentGrants.OutboundPrivileges))
+	} else {
+		parts = append(parts, "")
+	}
+	parts = append(parts, g.Data.String())
+	return strings.Join(parts, helpers.IDDelimiter)
+}
+
+func ParseGrantOwnershipId(id string) (GrantOwnershipId, error) {
+	var grantOwnershipId GrantOwnershipId
+
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) < 5 {
+		return grantOwnershipId, sdk.NewError(`grant ownership identifier should hold at least 5 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|<grant_type>|<grant_data>"`)
+	}
+
+	grantOwnershipId.GrantOwnershipTargetRoleKind = OwnershipGrantTargetRoleKind(parts[0])
+	switch grantOwnershipId.GrantOwnershipTargetRoleKind {
+	case ToAccountGrantOwnershipTargetRoleKind:
+		grantOwnershipId.AccountRoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[1])
+	case ToDatabaseGrantOwnershipTargetRoleKind:
+		databaseRoleNameParts, err := sdk.SplitIdentifier(parts[1], helpers.ParameterIDDelimiter)
+		if err != nil || len(databaseRoleNameParts) != 2 {
+			return grantOwnershipId, sdk.NewError(fmt.Sprintf(`invalid DatabaseRoleName value: %s, should be a fully qualified name of database object <database_name>.<name>`, parts[1]))
+		}
+		grantOwnershipId.DatabaseRoleName = sdk.NewDatabaseObjectIdentifier(databaseRoleNameParts[0], databaseRoleNameParts[1])
+	default:
+		return grantOwnershipId, sdk.NewError(fmt.Sprintf("unknown GrantOwnershipTargetRoleKind: %s, valid options are %v", parts[0], []OwnershipGrantTargetRoleKind{ToAccountGrantOwnershipTargetRoleKind, ToDatabaseGrantOwnershipTargetRoleKind}))
+	}
+
+	if len(parts[2]) > 0 {
+		switch outboundPrivileges := sdk.OwnershipCurrentGrantsOutboundPrivileges(parts[2]); outboundPrivileges {
+		case sdk.Copy, sdk.Revoke:
+			grantOwnershipId.CurrentGrants = &sdk.OwnershipCurrentGrants{
+				OutboundPrivileges: outboundPrivileges,
+			}
+		default:
+			return grantOwnershipId, sdk.NewError(fmt.Sprintf("unknown outbound privileges behavior: %s, valid options are %v", parts[2], []sdk.OwnershipCurrentGrantsOutboundPrivileges{sdk.Copy, sdk.Revoke}))
+		}
+	}
+
+	data := &OnSchemaObjectGrantData{
+		Kind: OnSchemaObjectGrantKind(parts[3]),
+	}
+	switch data.Kind {
+	case OnObjectSchemaObjectGrantKind:
+		if len(parts) != 6 {
+			return grantOwnershipId, sdk.NewError(`grant ownership identifier should consist of 6 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|OnObject|<object_type>|<object_name>"`)
+		}
+		objectType := sdk.ObjectType(parts[4])
+		objectIdentifier, err := getOwnershipGrantedObjectIdentifier(objectType, parts[5])
+		if err != nil {
+			return grantOwnershipId, err
+		}
+		data.Object = &sdk.Object{
+			ObjectType: objectType,
+			Name:       objectIdentifier,
+		}
+	case OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind:
+		if len(parts) != 7 {
+			return grantOwnershipId, sdk.NewError(`grant ownership identifier should consist of 7 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|On[All or Future]|<object_type_plural>|In[Database or Schema]|<identifier>"`)
+		}
+		bulkOperationGrantData := &BulkOperationGrantData{
+			ObjectNamePlural: sdk.PluralObjectType(parts[4]),
+			Kind:             BulkOperationGrantKind(parts[5]),
+		}
+		switch bulkOperationGrantData.Kind {
+		case InDatabaseBulkOperationGrantKind:
+			bulkOperationGrantData.Database = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[6]))
+		case InSchemaBulkOperationGrantKind:
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[6])
+			if err != nil {
+				return grantOwnershipId, err
+			}
+			bulkOperationGrantData.Schema = &schemaId
+		default:
+			return grantOwnershipId, sdk.NewError(fmt.Sprintf("invalid BulkOperationGrantKind: %s, valid options are %v", parts[5], []BulkOperationGrantKind{InDatabaseBulkOperationGrantKind, InSchemaBulkOperationGrantKind}))
+		}
+		data.OnAllOrFuture = bulkOperationGrantData
+	default:
+		return grantOwnershipId, sdk.NewError(fmt.Sprintf("unknown OnSchemaObjectGrantKind: %s, valid options are %v", parts[3], []OnSchemaObjectGrantKind{OnObjectSchemaObjectGrantKind, OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind}))
+	}
+	grantOwnershipId.Data = data
+
+	return grantOwnershipId, nil
+}
+
+// getOwnershipGrantedObjectIdentifier returns the identifier of the object ownership is granted on. Unlike privileges,
+// ownership can also be granted on account objects (e.g. warehouses), schemas and database roles.
+func getOwnershipGrantedObjectIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	switch sdk.ObjectType(strings.ToUpper(objectType.String())) {
+	case sdk.ObjectTypeComputePool,
+		sdk.ObjectTypeDatabase,
+		sdk.ObjectTypeExternalVolume,
+		sdk.ObjectTypeFailoverGroup,
+		sdk.ObjectTypeIntegration,
+		sdk.ObjectTypeNetworkPolicy,
+		sdk.ObjectTypeReplicationGroup,
+		sdk.ObjectTypeRole,
+		sdk.ObjectTypeUser,
+		sdk.ObjectTypeWarehouse:
+		return sdk.NewAccountObjectIdentifierFromFullyQualifiedName(objectName), nil
+	case sdk.ObjectTypeDatabaseRole, sdk.ObjectTypeSchema:
+		return sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](objectName)
+	default:
+		return getGrantedSchemaObjectIdentifier(objectType, objectName)
+	}
+}
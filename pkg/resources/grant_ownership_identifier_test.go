@@ -0,0 +1,245 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrantOwnershipId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantOwnershipId
+		Error      string
+	}{
+		{
+			Name:       "grant ownership on table to account role",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+				AccountRoleName:              sdk.NewAccountObjectIdentifier("account-role"),
+				CurrentGrants: &sdk.OwnershipCurrentGrants{
+					OutboundPrivileges: sdk.Copy,
+				},
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeTable,
+						Name:       sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant ownership on warehouse to database role - without outbound privileges behavior",
+			Identifier: `ToDatabaseRole|"database-name"."database-role"||OnObject|WAREHOUSE|"warehouse-name"`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToDatabaseGrantOwnershipTargetRoleKind,
+				DatabaseRoleName:             sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeWarehouse,
+						Name:       sdk.NewAccountObjectIdentifier("warehouse-name"),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant ownership on schema to account role",
+			Identifier: `ToAccountRole|"account-role"|REVOKE|OnObject|SCHEMA|"database-name"."schema-name"`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+				AccountRoleName:              sdk.NewAccountObjectIdentifier("account-role"),
+				CurrentGrants: &sdk.OwnershipCurrentGrants{
+					OutboundPrivileges: sdk.Revoke,
+				},
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeSchema,
+						Name:       sdk.NewDatabaseObjectIdentifier("database-name", "schema-name"),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant ownership on function to account role",
+			Identifier: `ToAccountRole|"account-role"||OnObject|FUNCTION|"database-name"."schema-name"."function-name"(NUMBER, VARCHAR)`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+				AccountRoleName:              sdk.NewAccountObjectIdentifier("account-role"),
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeFunction,
+						Name:       sdk.NewSchemaObjectIdentifierWithArguments("database-name", "schema-name", "function-name", []sdk.DataType{sdk.DataTypeNumber, sdk.DataTypeVARCHAR}),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant ownership on all tables in database to account role",
+			Identifier: `ToAccountRole|"account-role"|REVOKE|OnAll|TABLES|InDatabase|"database-name"`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+				AccountRoleName:              sdk.NewAccountObjectIdentifier("account-role"),
+				CurrentGrants: &sdk.OwnershipCurrentGrants{
+					OutboundPrivileges: sdk.Revoke,
+				},
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnAllSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InDatabaseBulkOperationGrantKind,
+						Database:         sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant ownership on future views in schema to database role",
+			Identifier: `ToDatabaseRole|"database-name"."database-role"||OnFuture|VIEWS|InSchema|"database-name"."schema-name"`,
+			Expected: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToDatabaseGrantOwnershipTargetRoleKind,
+				DatabaseRoleName:             sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnFutureSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeViews,
+						Kind:             InSchemaBulkOperationGrantKind,
+						Schema:           sdk.Pointer(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+					},
+				},
+			},
+		},
+		{
+			Name:       "validation: not enough parts",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnObject`,
+			Error:      `grant ownership identifier should hold at least 5 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|<grant_type>|<grant_data>"`,
+		},
+		{
+			Name:       "validation: invalid target role kind",
+			Identifier: `ToRole|"account-role"|COPY|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      "unknown GrantOwnershipTargetRoleKind: ToRole, valid options are [ToAccountRole ToDatabaseRole]",
+		},
+		{
+			Name:       "validation: invalid database role name",
+			Identifier: `ToDatabaseRole|"database-role"|COPY|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      `invalid DatabaseRoleName value: "database-role", should be a fully qualified name of database object <database_name>.<name>`,
+		},
+		{
+			Name:       "validation: invalid outbound privileges behavior",
+			Identifier: `ToAccountRole|"account-role"|MOVE|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      "unknown outbound privileges behavior: MOVE, valid options are [COPY REVOKE]",
+		},
+		{
+			Name:       "validation: invalid grant kind",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnSomething|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      "unknown OnSchemaObjectGrantKind: OnSomething, valid options are [OnObject OnAll OnFuture]",
+		},
+		{
+			Name:       "validation: on object with invalid number of parts",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnObject|TABLE`,
+			Error:      `grant ownership identifier should consist of 6 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|OnObject|<object_type>|<object_name>"`,
+		},
+		{
+			Name:       "validation: on all with invalid number of parts",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnAll|TABLES|InDatabase`,
+			Error:      `grant ownership identifier should consist of 7 parts "<target_role_kind>|<role_name>|<outbound_privileges_behavior>|On[All or Future]|<object_type_plural>|In[Database or Schema]|<identifier>"`,
+		},
+		{
+			Name:       "validation: on future with invalid bulk operation kind",
+			Identifier: `ToAccountRole|"account-role"|COPY|OnFuture|TABLES|InAccount|"database-name"`,
+			Error:      "invalid BulkOperationGrantKind: InAccount, valid options are [InDatabase InSchema]",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantOwnershipId(tt.Identifier)
+			if tt.Error == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantOwnershipIdString(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier GrantOwnershipId
+		Expected   string
+	}{
+		{
+			Name: "grant ownership on object to account role",
+			Identifier: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToAccountGrantOwnershipTargetRoleKind,
+				AccountRoleName:              sdk.NewAccountObjectIdentifier("account-role"),
+				CurrentGrants: &sdk.OwnershipCurrentGrants{
+					OutboundPrivileges: sdk.Copy,
+				},
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeTable,
+						Name:       sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+					},
+				},
+			},
+			Expected: `ToAccountRole|"account-role"|COPY|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+		},
+		{
+			Name: "grant ownership on future objects to database role",
+			Identifier: GrantOwnershipId{
+				GrantOwnershipTargetRoleKind: ToDatabaseGrantOwnershipTargetRoleKind,
+				DatabaseRoleName:             sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnFutureSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InDatabaseBulkOperationGrantKind,
+						Database:         sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+					},
+				},
+			},
+			Expected: `ToDatabaseRole|"database-name"."database-role"||OnFuture|TABLES|InDatabase|"database-name"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Identifier.String())
+		})
+	}
+}
+
+func TestDatabaseRoleGranteeId(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		GranteeName string
+		Expected    sdk.DatabaseObjectIdentifier
+		ExpectedOk  bool
+	}{
+		{Name: "fully qualified name", GranteeName: "DATABASE.ROLE", Expected: sdk.NewDatabaseObjectIdentifier("DATABASE", "ROLE"), ExpectedOk: true},
+		{Name: "partially quoted fully qualified name", GranteeName: `DATABASE."database-role"`, Expected: sdk.NewDatabaseObjectIdentifier("DATABASE", "database-role"), ExpectedOk: true},
+		{Name: "quoted fully qualified name", GranteeName: `"database.name"."database-role"`, Expected: sdk.NewDatabaseObjectIdentifier("database.name", "database-role"), ExpectedOk: true},
+		{Name: "name only", GranteeName: "ROLE", ExpectedOk: false},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, ok := databaseRoleGranteeId(tt.GranteeName)
+			assert.Equal(t, tt.ExpectedOk, ok)
+			assert.Equal(t, tt.Expected, id)
+		})
+	}
+}
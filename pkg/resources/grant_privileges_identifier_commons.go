@@ -99,7 +99,7 @@ func getBulkOperationGrantData(in *sdk.GrantOnSchemaObjectIn) *BulkOperationGran
 	return bulkOperationGrantData
 }
 
-func getGrantOnSchemaObjectIn(allOrFuture map[string]any) *sdk.GrantOnSchemaObjectIn {
+func getGrantOnSchemaObjectIn(allOrFuture map[string]any) (*sdk.GrantOnSchemaObjectIn, error) {
 	pluralObjectType := sdk.PluralObjectType(allOrFuture["object_type_plural"].(string))
 	grantOnSchemaObjectIn := &sdk.GrantOnSchemaObjectIn{
 		PluralObjectType: pluralObjectType,
@@ -110,8 +110,12 @@ func getGrantOnSchemaObjectIn(allOrFuture map[string]any) *sdk.GrantOnSchemaObje
 	}
 
 	if inSchema, ok := allOrFuture["in_schema"].(string); ok && len(inSchema) > 0 {
-		grantOnSchemaObjectIn.InSchema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(inSchema))
+		schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](inSchema)
+		if err != nil {
+			return nil, err
+		}
+		grantOnSchemaObjectIn.InSchema = &schemaId
 	}
 
-	return grantOnSchemaObjectIn
+	return grantOnSchemaObjectIn, nil
 }
@@ -2,13 +2,13 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -209,7 +209,7 @@ var grantPrivilegesToAccountRoleSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 					ForceNew:    true,
-					Description: "The fully qualified name of the object on which privileges will be granted.",
+					Description: "The fully qualified name of the object on which privileges will be granted. Names of functions and procedures should be followed by the argument data types, e.g. <database_name>.<schema_name>.<name>(NUMBER, VARCHAR).",
 					RequiredWith: []string{
 						"on_schema_object.0.object_type",
 					},
@@ -218,7 +218,7 @@ var grantPrivilegesToAccountRoleSchema = map[string]*schema.Schema{
 						"on_schema_object.0.all",
 						"on_schema_object.0.future",
 					},
-					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					ValidateDiagFunc: IsValidSchemaObjectName(),
 				},
 				"all": {
 					Type:        schema.TypeList,
@@ -399,18 +399,22 @@ func ImportGrantPrivilegesToAccountRole() func(ctx context.Context, d *schema.Re
 
 func CreateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering create grant privileges to account role")
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
-
-	id := createGrantPrivilegesToAccountRoleIdFromSchema(d)
+	id, err := createGrantPrivilegesToAccountRoleIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	logging.DebugLogger.Printf("[DEBUG] created identifier from schema: %s", id.String())
 
-	err := client.Grants.GrantPrivilegesToAccountRole(
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.Grants.GrantPrivilegesToAccountRole(
 		ctx,
 		getAccountRolePrivilegesFromSchema(d),
-		getAccountRoleGrantOn(d),
+		grantOn,
 		sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("account_role_name").(string)),
 		&sdk.GrantPrivilegesToAccountRoleOptions{
 			WithGrantOption: sdk.Bool(d.Get("with_grant_option").(bool)),
@@ -434,10 +438,7 @@ func CreateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 
 func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering update grant privileges to account role")
-	db := meta.(*sql.DB)
-
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	id, err := ParseGrantPrivilegesToAccountRoleId(d.Id())
 	if err != nil {
@@ -451,6 +452,11 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 	}
 	logging.DebugLogger.Printf("[DEBUG] Parsed identifier to %s", id.String())
 
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// handle all_privileges -> privileges change (revoke all privileges)
 	if d.HasChange("all_privileges") {
 		_, allPrivileges := d.GetChange("all_privileges")
@@ -460,7 +466,7 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 			err = client.Grants.RevokePrivilegesFromAccountRole(ctx, &sdk.AccountRoleGrantPrivileges{
 				AllPrivileges: sdk.Bool(true),
 			},
-				getAccountRoleGrantOn(d),
+				grantOn,
 				id.RoleName,
 				new(sdk.RevokePrivilegesFromAccountRoleOptions),
 			)
@@ -511,8 +517,6 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 				}
 			}
 
-			grantOn := getAccountRoleGrantOn(d)
-
 			if len(privilegesToAdd) > 0 {
 				logging.DebugLogger.Printf("[DEBUG] Granting privileges: %v", privilegesToAdd)
 				err = client.Grants.GrantPrivilegesToAccountRole(
@@ -580,7 +584,7 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 			err = client.Grants.GrantPrivilegesToAccountRole(ctx, &sdk.AccountRoleGrantPrivileges{
 				AllPrivileges: sdk.Bool(true),
 			},
-				getAccountRoleGrantOn(d),
+				grantOn,
 				id.RoleName,
 				new(sdk.GrantPrivilegesToAccountRoleOptions),
 			)
@@ -608,7 +612,7 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 		err := client.Grants.GrantPrivilegesToAccountRole(
 			ctx,
 			getAccountRolePrivilegesFromSchema(d),
-			getAccountRoleGrantOn(d),
+			grantOn,
 			id.RoleName,
 			&sdk.GrantPrivilegesToAccountRoleOptions{
 				WithGrantOption: &id.WithGrantOption,
@@ -633,10 +637,7 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 
 func DeleteGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	logging.DebugLogger.Printf("[DEBUG] Entering delete grant privileges to account role")
-	db := meta.(*sql.DB)
-
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	id, err := ParseGrantPrivilegesToAccountRoleId(d.Id())
 	if err != nil {
@@ -650,10 +651,14 @@ func DeleteGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 	}
 	logging.DebugLogger.Printf("[DEBUG] Parsed identifier: %s", id.String())
 
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	err = client.Grants.RevokePrivilegesFromAccountRole(
 		ctx,
 		getAccountRolePrivilegesFromSchema(d),
-		getAccountRoleGrantOn(d),
+		grantOn,
 		id.RoleName,
 		&sdk.RevokePrivilegesFromAccountRoleOptions{},
 	)
@@ -730,9 +735,7 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
-	db := meta.(*sql.DB)
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	logging.DebugLogger.Printf("[DEBUG] About to show grants")
 	grants, err := client.Grants.Show(ctx, opts)
@@ -928,7 +931,7 @@ func getAccountRolePrivileges(allPrivileges bool, privileges []string, onAccount
 	return accountRoleGrantPrivileges
 }
 
-func getAccountRoleGrantOn(d *schema.ResourceData) *sdk.AccountRoleGrantOn {
+func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, error) {
 	_, onAccountOk := d.GetOk("on_account")
 	onAccountObjectBlock, onAccountObjectOk := d.GetOk("on_account_object")
 	onSchemaBlock, onSchemaOk := d.GetOk("on_schema")
@@ -985,7 +988,11 @@ func getAccountRoleGrantOn(d *schema.ResourceData) *sdk.AccountRoleGrantOn {
 
 		switch {
 		case schemaNameOk:
-			grantOnSchema.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName))
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](schemaName)
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchema.Schema = &schemaId
 		case allSchemasInDatabaseOk:
 			grantOnSchema.AllSchemasInDatabase = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(allSchemasInDatabase))
 		case futureSchemasInDatabaseOk:
@@ -1012,23 +1019,35 @@ func getAccountRoleGrantOn(d *schema.ResourceData) *sdk.AccountRoleGrantOn {
 
 		switch {
 		case objectTypeOk && objectNameOk:
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(sdk.ObjectType(objectType), objectName)
+			if err != nil {
+				return nil, err
+			}
 			grantOnSchemaObject.SchemaObject = &sdk.Object{
 				ObjectType: sdk.ObjectType(objectType),
-				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName),
+				Name:       objectIdentifier,
 			}
 		case allOk:
-			grantOnSchemaObject.All = getGrantOnSchemaObjectIn(all[0].(map[string]any))
+			grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(all[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchemaObject.All = grantOnSchemaObjectIn
 		case futureOk:
-			grantOnSchemaObject.Future = getGrantOnSchemaObjectIn(future[0].(map[string]any))
+			grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(future[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchemaObject.Future = grantOnSchemaObjectIn
 		}
 
 		on.SchemaObject = grantOnSchemaObject
 	}
 
-	return on
+	return on, nil
 }
 
-func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) *GrantPrivilegesToAccountRoleId {
+func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (*GrantPrivilegesToAccountRoleId, error) {
 	id := new(GrantPrivilegesToAccountRoleId)
 	id.RoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("account_role_name").(string))
 	id.AllPrivileges = d.Get("all_privileges").(bool)
@@ -1037,7 +1056,10 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) *Gra
 	}
 	id.WithGrantOption = d.Get("with_grant_option").(bool)
 
-	on := getAccountRoleGrantOn(d)
+	on, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return nil, err
+	}
 	switch {
 	case on.Account != nil:
 		id.Kind = OnAccountAccountRoleGrantKind
@@ -1110,5 +1132,5 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) *Gra
 		id.Data = onSchemaObjectGrantData
 	}
 
-	return id
+	return id, nil
 }
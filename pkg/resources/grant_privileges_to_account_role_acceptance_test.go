@@ -2,7 +2,6 @@ package resources_test
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"log"
@@ -11,8 +10,8 @@ import (
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testprofiles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -931,14 +930,7 @@ func TestAcc_GrantPrivilegesToAccountRole_ImportedPrivileges(t *testing.T) {
 
 func getSecondaryAccountName(t *testing.T) (string, error) {
 	t.Helper()
-	config, err := sdk.ProfileConfig(testprofiles.Secondary)
-	if err != nil {
-		t.Fatal(err)
-	}
-	client, err := sdk.NewClient(config)
-	if err != nil {
-		t.Fatal(err)
-	}
+	client := acc.SecondaryClient(t)
 	return client.ContextFunctions.CurrentAccount(context.Background())
 }
 
@@ -953,14 +945,7 @@ func getAccountName(t *testing.T) (string, error) {
 
 func createSharedDatabaseOnSecondaryAccount(t *testing.T, databaseName string, shareName string) error {
 	t.Helper()
-	config, err := sdk.ProfileConfig(testprofiles.Secondary)
-	if err != nil {
-		t.Fatal(err)
-	}
-	client, err := sdk.NewClient(config)
-	if err != nil {
-		t.Fatal(err)
-	}
+	client := acc.SecondaryClient(t)
 	ctx := context.Background()
 	accountName, err := getAccountName(t)
 	return errors.Join(
@@ -976,14 +961,7 @@ func createSharedDatabaseOnSecondaryAccount(t *testing.T, databaseName string, s
 
 func dropSharedDatabaseOnSecondaryAccount(t *testing.T, databaseName string, shareName string) error {
 	t.Helper()
-	config, err := sdk.ProfileConfig(testprofiles.Secondary)
-	if err != nil {
-		t.Fatal(err)
-	}
-	client, err := sdk.NewClient(config)
-	if err != nil {
-		t.Fatal(err)
-	}
+	client := acc.SecondaryClient(t)
 	ctx := context.Background()
 	return errors.Join(
 		client.Shares.Drop(ctx, sdk.NewAccountObjectIdentifier(shareName)),
@@ -1006,8 +984,7 @@ func createAccountRoleOutsideTerraform(t *testing.T, name string) {
 
 func testAccCheckAccountRolePrivilegesRevoked(name string) func(*terraform.State) error {
 	return func(state *terraform.State) error {
-		db := acc.TestAccProvider.Meta().(*sql.DB)
-		client := sdk.NewClientFromDB(db)
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 
 		defer func() {
 			err := client.Roles.Drop(context.Background(), sdk.NewDropRoleRequest(sdk.NewAccountObjectIdentifier(name)).WithIfExists(true))
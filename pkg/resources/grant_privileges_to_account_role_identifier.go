@@ -72,13 +72,10 @@ func ParseGrantPrivilegesToAccountRoleId(id string) (GrantPrivilegesToAccountRol
 		return accountRoleId, sdk.NewError(`account role identifier should hold at least 5 parts "<role_name>|<with_grant_option>|<always_apply>|<privileges>|<grant_type>"`)
 	}
 
-	// TODO: Identifier parsing should be replaced with better version introduced in SNOW-999049.
-	// Right now, it's same as sdk.NewAccountObjectIdentifierFromFullyQualifiedName, but with error handling.
-	name := strings.Trim(parts[0], `"`)
-	if len(name) == 0 {
+	if len(strings.Trim(parts[0], `"`)) == 0 {
 		return accountRoleId, sdk.NewError(fmt.Sprintf(`invalid (empty) AccountRoleName value: %s, should be a fully qualified name of account object <name>`, parts[0]))
 	}
-	accountRoleId.RoleName = sdk.NewAccountObjectIdentifier(name)
+	accountRoleId.RoleName = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
 
 	if parts[1] != "false" && parts[1] != "true" {
 		return accountRoleId, sdk.NewError(fmt.Sprintf(`invalid WithGrantOption value: %s, should be either "true" or "false"`, parts[1]))
@@ -121,7 +118,11 @@ func ParseGrantPrivilegesToAccountRoleId(id string) (GrantPrivilegesToAccountRol
 		}
 		switch onSchemaGrantData.Kind {
 		case OnSchemaSchemaGrantKind:
-			onSchemaGrantData.SchemaName = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[6]))
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[6])
+			if err != nil {
+				return accountRoleId, err
+			}
+			onSchemaGrantData.SchemaName = &schemaId
 		case OnAllSchemasInDatabaseSchemaGrantKind, OnFutureSchemasInDatabaseSchemaGrantKind:
 			onSchemaGrantData.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifier(parts[6]))
 		default:
@@ -140,9 +141,13 @@ func ParseGrantPrivilegesToAccountRoleId(id string) (GrantPrivilegesToAccountRol
 			if len(parts) != 8 {
 				return accountRoleId, sdk.NewError(`account role identifier should hold 8 parts "<role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchemaObject|OnObject|<object_type>|<object_name>"`)
 			}
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(sdk.ObjectType(parts[6]), parts[7])
+			if err != nil {
+				return accountRoleId, err
+			}
 			onSchemaObjectGrantData.Object = &sdk.Object{
 				ObjectType: sdk.ObjectType(parts[6]),
-				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parts[7]),
+				Name:       objectIdentifier,
 			}
 		case OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind:
 			bulkOperationGrantData := &BulkOperationGrantData{
@@ -157,7 +162,11 @@ func ParseGrantPrivilegesToAccountRoleId(id string) (GrantPrivilegesToAccountRol
 				case InDatabaseBulkOperationGrantKind:
 					bulkOperationGrantData.Database = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[8]))
 				case InSchemaBulkOperationGrantKind:
-					bulkOperationGrantData.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[8]))
+					schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[8])
+					if err != nil {
+						return accountRoleId, err
+					}
+					bulkOperationGrantData.Schema = &schemaId
 				default:
 					return accountRoleId, sdk.NewError(fmt.Sprintf("invalid BulkOperationGrantKind: %s", bulkOperationGrantData.Kind))
 				}
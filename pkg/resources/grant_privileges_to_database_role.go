@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -160,7 +160,7 @@ var grantPrivilegesToDatabaseRoleSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 					ForceNew:    true,
-					Description: "The fully qualified name of the object on which privileges will be granted.",
+					Description: "The fully qualified name of the object on which privileges will be granted. Names of functions and procedures should be followed by the argument data types, e.g. <database_name>.<schema_name>.<name>(NUMBER, VARCHAR).",
 					RequiredWith: []string{
 						"on_schema_object.0.object_type",
 					},
@@ -169,7 +169,7 @@ var grantPrivilegesToDatabaseRoleSchema = map[string]*schema.Schema{
 						"on_schema_object.0.all",
 						"on_schema_object.0.future",
 					},
-					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					ValidateDiagFunc: IsValidSchemaObjectName(),
 				},
 				"all": {
 					Type:        schema.TypeList,
@@ -333,15 +333,21 @@ func ImportGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 }
 
 func CreateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := createGrantPrivilegesToDatabaseRoleIdFromSchema(d)
-	err := client.Grants.GrantPrivilegesToDatabaseRole(
+	id, err := createGrantPrivilegesToDatabaseRoleIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	grantOn, err := getDatabaseRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.Grants.GrantPrivilegesToDatabaseRole(
 		ctx,
 		getDatabaseRolePrivilegesFromSchema(d),
-		getDatabaseRoleGrantOn(d),
-		sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("database_role_name").(string)),
+		grantOn,
+		id.DatabaseRoleName,
 		&sdk.GrantPrivilegesToDatabaseRoleOptions{
 			WithGrantOption: sdk.Bool(d.Get("with_grant_option").(bool)),
 		},
@@ -362,8 +368,7 @@ func CreateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 }
 
 func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	id, err := ParseGrantPrivilegesToDatabaseRoleId(d.Id())
 	if err != nil {
 		return diag.Diagnostics{
@@ -375,6 +380,11 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	grantOn, err := getDatabaseRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// handle all_privileges -> privileges change (revoke all privileges)
 	if d.HasChange("all_privileges") {
 		_, allPrivileges := d.GetChange("all_privileges")
@@ -383,7 +393,7 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 			err = client.Grants.RevokePrivilegesFromDatabaseRole(ctx, &sdk.DatabaseRoleGrantPrivileges{
 				AllPrivileges: sdk.Bool(true),
 			},
-				getDatabaseRoleGrantOn(d),
+				grantOn,
 				id.DatabaseRoleName,
 				new(sdk.RevokePrivilegesFromDatabaseRoleOptions),
 			)
@@ -432,8 +442,6 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 				}
 			}
 
-			grantOn := getDatabaseRoleGrantOn(d)
-
 			if len(privilegesToAdd) > 0 {
 				err = client.Grants.GrantPrivilegesToDatabaseRole(
 					ctx,
@@ -496,7 +504,7 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 			err = client.Grants.GrantPrivilegesToDatabaseRole(ctx, &sdk.DatabaseRoleGrantPrivileges{
 				AllPrivileges: sdk.Bool(true),
 			},
-				getDatabaseRoleGrantOn(d),
+				grantOn,
 				id.DatabaseRoleName,
 				new(sdk.GrantPrivilegesToDatabaseRoleOptions),
 			)
@@ -523,7 +531,7 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 		err := client.Grants.GrantPrivilegesToDatabaseRole(
 			ctx,
 			getDatabaseRolePrivilegesFromSchema(d),
-			getDatabaseRoleGrantOn(d),
+			grantOn,
 			id.DatabaseRoleName,
 			&sdk.GrantPrivilegesToDatabaseRoleOptions{
 				WithGrantOption: &id.WithGrantOption,
@@ -546,8 +554,7 @@ func UpdateGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 }
 
 func DeleteGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	id, err := ParseGrantPrivilegesToDatabaseRoleId(d.Id())
 	if err != nil {
 		return diag.Diagnostics{
@@ -559,10 +566,14 @@ func DeleteGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	grantOn, err := getDatabaseRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	err = client.Grants.RevokePrivilegesFromDatabaseRole(
 		ctx,
 		getDatabaseRolePrivilegesFromSchema(d),
-		getDatabaseRoleGrantOn(d),
+		grantOn,
 		id.DatabaseRoleName,
 		&sdk.RevokePrivilegesFromDatabaseRoleOptions{},
 	)
@@ -637,8 +648,7 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 		return nil
 	}
 
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		return diag.Diagnostics{
@@ -802,7 +812,7 @@ func getDatabaseRolePrivileges(allPrivileges bool, privileges []string, onDataba
 	return databaseRoleGrantPrivileges
 }
 
-func getDatabaseRoleGrantOn(d *schema.ResourceData) *sdk.DatabaseRoleGrantOn {
+func getDatabaseRoleGrantOn(d *schema.ResourceData) (*sdk.DatabaseRoleGrantOn, error) {
 	onDatabase, onDatabaseOk := d.GetOk("on_database")
 	onSchemaBlock, onSchemaOk := d.GetOk("on_schema")
 	onSchemaObjectBlock, onSchemaObjectOk := d.GetOk("on_schema_object")
@@ -827,7 +837,11 @@ func getDatabaseRoleGrantOn(d *schema.ResourceData) *sdk.DatabaseRoleGrantOn {
 
 		switch {
 		case schemaNameOk:
-			grantOnSchema.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName))
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](schemaName)
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchema.Schema = &schemaId
 		case allSchemasInDatabaseOk:
 			grantOnSchema.AllSchemasInDatabase = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(allSchemasInDatabase))
 		case futureSchemasInDatabaseOk:
@@ -854,32 +868,51 @@ func getDatabaseRoleGrantOn(d *schema.ResourceData) *sdk.DatabaseRoleGrantOn {
 
 		switch {
 		case objectTypeOk && objectNameOk:
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(sdk.ObjectType(objectType), objectName)
+			if err != nil {
+				return nil, err
+			}
 			grantOnSchemaObject.SchemaObject = &sdk.Object{
 				ObjectType: sdk.ObjectType(objectType),
-				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(objectName),
+				Name:       objectIdentifier,
 			}
 		case allOk:
-			grantOnSchemaObject.All = getGrantOnSchemaObjectIn(all[0].(map[string]any))
+			grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(all[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchemaObject.All = grantOnSchemaObjectIn
 		case futureOk:
-			grantOnSchemaObject.Future = getGrantOnSchemaObjectIn(future[0].(map[string]any))
+			grantOnSchemaObjectIn, err := getGrantOnSchemaObjectIn(future[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
+			grantOnSchemaObject.Future = grantOnSchemaObjectIn
 		}
 
 		on.SchemaObject = grantOnSchemaObject
 	}
 
-	return on
+	return on, nil
 }
 
-func createGrantPrivilegesToDatabaseRoleIdFromSchema(d *schema.ResourceData) *GrantPrivilegesToDatabaseRoleId {
+func createGrantPrivilegesToDatabaseRoleIdFromSchema(d *schema.ResourceData) (*GrantPrivilegesToDatabaseRoleId, error) {
+	databaseRoleName, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](d.Get("database_role_name").(string))
+	if err != nil {
+		return nil, err
+	}
 	id := new(GrantPrivilegesToDatabaseRoleId)
-	id.DatabaseRoleName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("database_role_name").(string))
+	id.DatabaseRoleName = databaseRoleName
 	id.AllPrivileges = d.Get("all_privileges").(bool)
 	if p, ok := d.GetOk("privileges"); ok {
 		id.Privileges = expandStringList(p.(*schema.Set).List())
 	}
 	id.WithGrantOption = d.Get("with_grant_option").(bool)
 
-	on := getDatabaseRoleGrantOn(d)
+	on, err := getDatabaseRoleGrantOn(d)
+	if err != nil {
+		return nil, err
+	}
 	switch {
 	case on.Database != nil:
 		id.Kind = OnDatabaseDatabaseRoleGrantKind
@@ -922,5 +955,5 @@ func createGrantPrivilegesToDatabaseRoleIdFromSchema(d *schema.ResourceData) *Gr
 		id.Data = onSchemaObjectGrantData
 	}
 
-	return id
+	return id, nil
 }
@@ -58,19 +58,11 @@ func ParseGrantPrivilegesToDatabaseRoleId(id string) (GrantPrivilegesToDatabaseR
 		return databaseRoleId, sdk.NewError(`database role identifier should hold at least 6 parts "<database_role_name>|<with_grant_option>|<always_apply>|<privileges>|<grant_type>|<grant_data>"`)
 	}
 
-	// TODO: Identifier parsing should be replaced with better version introduced in SNOW-999049.
-	// Right now, it's same as sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName, but with error handling.
-	databaseRoleNameParts := strings.Split(parts[0], ".")
-	if len(databaseRoleNameParts) == 0 ||
-		(len(databaseRoleNameParts) == 1 && strings.Trim(databaseRoleNameParts[0], `"`) == "") ||
-		(len(databaseRoleNameParts) == 2 && strings.Trim(databaseRoleNameParts[1], `"`) == "") ||
-		len(databaseRoleNameParts) > 2 {
+	databaseRoleNameParts, err := sdk.SplitIdentifier(parts[0], helpers.ParameterIDDelimiter)
+	if err != nil || len(databaseRoleNameParts) != 2 {
 		return databaseRoleId, sdk.NewError(fmt.Sprintf(`invalid DatabaseRoleName value: %s, should be a fully qualified name of database object <database_name>.<name>`, parts[0]))
 	}
-	databaseRoleId.DatabaseRoleName = sdk.NewDatabaseObjectIdentifier(
-		strings.Trim(databaseRoleNameParts[0], `"`),
-		strings.Trim(databaseRoleNameParts[1], `"`),
-	)
+	databaseRoleId.DatabaseRoleName = sdk.NewDatabaseObjectIdentifier(databaseRoleNameParts[0], databaseRoleNameParts[1])
 
 	if parts[1] != "false" && parts[1] != "true" {
 		return databaseRoleId, sdk.NewError(fmt.Sprintf(`invalid WithGrantOption value: %s, should be either "true" or "false"`, parts[1]))
@@ -108,7 +100,11 @@ func ParseGrantPrivilegesToDatabaseRoleId(id string) (GrantPrivilegesToDatabaseR
 		}
 		switch onSchemaGrantData.Kind {
 		case OnSchemaSchemaGrantKind:
-			onSchemaGrantData.SchemaName = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[6]))
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[6])
+			if err != nil {
+				return databaseRoleId, err
+			}
+			onSchemaGrantData.SchemaName = &schemaId
 		case OnAllSchemasInDatabaseSchemaGrantKind, OnFutureSchemasInDatabaseSchemaGrantKind:
 			onSchemaGrantData.DatabaseName = sdk.Pointer(sdk.NewAccountObjectIdentifier(parts[6]))
 		default:
@@ -127,9 +123,13 @@ func ParseGrantPrivilegesToDatabaseRoleId(id string) (GrantPrivilegesToDatabaseR
 			if len(parts) != 8 {
 				return databaseRoleId, sdk.NewError(`database role identifier should hold 8 parts "<database_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchemaObject|OnObject|<object_type>|<object_name>"`)
 			}
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(sdk.ObjectType(parts[6]), parts[7])
+			if err != nil {
+				return databaseRoleId, err
+			}
 			onSchemaObjectGrantData.Object = &sdk.Object{
 				ObjectType: sdk.ObjectType(parts[6]),
-				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parts[7]),
+				Name:       objectIdentifier,
 			}
 		case OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind:
 			bulkOperationGrantData := &BulkOperationGrantData{
@@ -144,7 +144,11 @@ func ParseGrantPrivilegesToDatabaseRoleId(id string) (GrantPrivilegesToDatabaseR
 				case InDatabaseBulkOperationGrantKind:
 					bulkOperationGrantData.Database = sdk.Pointer(sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[8]))
 				case InSchemaBulkOperationGrantKind:
-					bulkOperationGrantData.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[8]))
+					schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](parts[8])
+					if err != nil {
+						return databaseRoleId, err
+					}
+					bulkOperationGrantData.Schema = &schemaId
 				default:
 					return databaseRoleId, sdk.NewError(fmt.Sprintf("invalid BulkOperationGrantKind: %s", bulkOperationGrantData.Kind))
 				}
@@ -2,7 +2,6 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"slices"
@@ -10,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/logging"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -394,9 +394,7 @@ func (v GrantPrivilegesToRoleID) String() string {
 
 func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 	logging.DebugLogger.Printf("[DEBUG] Entering create grant privileges to role")
-	db := meta.(*sql.DB)
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	resourceID := &GrantPrivilegesToRoleID{}
 	var privileges []string
@@ -433,9 +431,7 @@ func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 
 func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 	logging.DebugLogger.Printf("[DEBUG] Entering read grant privileges to role")
-	db := meta.(*sql.DB)
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	resourceID := NewGrantPrivilegesToRoleID(d.Id())
 	roleName := resourceID.RoleName
@@ -474,11 +470,15 @@ func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 		logging.DebugLogger.Printf("[DEBUG] Preparing to read privileges: on schema")
 		grantOn = sdk.ObjectTypeSchema
 		if resourceID.SchemaName != "" {
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](resourceID.SchemaName)
+			if err != nil {
+				return err
+			}
 			opts = sdk.ShowGrantOptions{
 				On: &sdk.ShowGrantsOn{
 					Object: &sdk.Object{
 						ObjectType: sdk.ObjectTypeSchema,
-						Name:       sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(resourceID.SchemaName),
+						Name:       schemaId,
 					},
 				},
 			}
@@ -501,12 +501,16 @@ func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 		logging.DebugLogger.Printf("[DEBUG] Preparing to read privileges: on schema object")
 		if resourceID.ObjectName != "" {
 			objectType := sdk.ObjectType(resourceID.ObjectType)
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(objectType, resourceID.ObjectName)
+			if err != nil {
+				return err
+			}
 			grantOn = objectType
 			opts = sdk.ShowGrantOptions{
 				On: &sdk.ShowGrantsOn{
 					Object: &sdk.Object{
 						ObjectType: objectType,
-						Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(resourceID.ObjectName),
+						Name:       objectIdentifier,
 					},
 				},
 			}
@@ -519,10 +523,14 @@ func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 		if resourceID.Future {
 			grantOn = sdk.PluralObjectType(resourceID.ObjectTypePlural).Singular()
 			if resourceID.InSchema {
+				schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](resourceID.SchemaName)
+				if err != nil {
+					return err
+				}
 				opts = sdk.ShowGrantOptions{
 					Future: sdk.Bool(true),
 					In: &sdk.ShowGrantsIn{
-						Schema: sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(resourceID.SchemaName)),
+						Schema: &schemaId,
 					},
 				}
 			}
@@ -546,9 +554,7 @@ func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 
 func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 	logging.DebugLogger.Printf("[DEBUG] Entering update grant privileges to role")
-	db := meta.(*sql.DB)
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	// the only thing that can change is "privileges"
@@ -615,9 +621,7 @@ func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error
 
 func DeleteGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
 	logging.DebugLogger.Printf("[DEBUG] Entering delete grant privileges to role")
-	db := meta.(*sql.DB)
-	logging.DebugLogger.Printf("[DEBUG] Creating new client from db")
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	roleName := d.Get("role_name").(string)
@@ -697,7 +701,11 @@ func configureRoleGrantPrivilegeOptions(d *schema.ResourceData, privileges []str
 		if v, ok := onSchema["schema_name"]; ok && len(v.(string)) > 0 {
 			logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting schema name")
 			resourceID.SchemaName = v.(string)
-			on.Schema.Schema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(v.(string)))
+			schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](v.(string))
+			if err != nil {
+				return nil, nil, err
+			}
+			on.Schema.Schema = &schemaId
 		}
 		if v, ok := onSchema["all_schemas_in_database"]; ok && len(v.(string)) > 0 {
 			logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting all schemas in database")
@@ -733,7 +741,11 @@ func configureRoleGrantPrivilegeOptions(d *schema.ResourceData, privileges []str
 		if v, ok := onSchemaObject["object_name"]; ok && len(v.(string)) > 0 {
 			logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting schema object name")
 			resourceID.ObjectName = v.(string)
-			on.SchemaObject.SchemaObject.Name = sdk.Pointer(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string)))
+			objectIdentifier, err := getGrantedSchemaObjectIdentifier(sdk.ObjectType(resourceID.ObjectType), v.(string))
+			if err != nil {
+				return nil, nil, err
+			}
+			on.SchemaObject.SchemaObject.Name = objectIdentifier
 		}
 		if v, ok := onSchemaObject["all"]; ok && len(v.([]interface{})) > 0 {
 			logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting all")
@@ -753,7 +765,11 @@ func configureRoleGrantPrivilegeOptions(d *schema.ResourceData, privileges []str
 				logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting all in schema")
 				resourceID.InSchema = true
 				resourceID.SchemaName = v.(string)
-				on.SchemaObject.All.InSchema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(v.(string)))
+				schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](v.(string))
+				if err != nil {
+					return nil, nil, err
+				}
+				on.SchemaObject.All.InSchema = &schemaId
 			}
 		}
 
@@ -775,7 +791,11 @@ func configureRoleGrantPrivilegeOptions(d *schema.ResourceData, privileges []str
 				logging.DebugLogger.Printf("[DEBUG] Configuring account role grant privileges options: setting future in schema")
 				resourceID.InSchema = true
 				resourceID.SchemaName = v.(string)
-				on.SchemaObject.Future.InSchema = sdk.Pointer(sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(v.(string)))
+				schemaId, err := sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](v.(string))
+				if err != nil {
+					return nil, nil, err
+				}
+				on.SchemaObject.Future.InSchema = &schemaId
 			}
 		}
 
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -158,12 +158,18 @@ func ImportGrantPrivilegesToShare() func(ctx context.Context, d *schema.Resource
 }
 
 func CreateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	id := createGrantPrivilegesToShareIdFromSchema(d)
+	client := meta.(*provider.Context).Client
+	id, err := createGrantPrivilegesToShareIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("[DEBUG] created identifier from schema: %s", id.String())
 
-	err := client.Grants.GrantPrivilegeToShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(d), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
+	grantOn, err := getShareGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.Grants.GrantPrivilegeToShare(ctx, getObjectPrivilegesFromSchema(d), grantOn, sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -180,8 +186,7 @@ func CreateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 }
 
 func UpdateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	id, err := ParseGrantPrivilegesToShareId(d.Id())
 	if err != nil {
@@ -213,7 +218,10 @@ func UpdateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 
-		grantOn := getShareGrantOn(d)
+		grantOn, err := getShareGrantOn(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		if len(privilegesToAdd) > 0 {
 			err = client.Grants.GrantPrivilegeToShare(
@@ -259,8 +267,7 @@ func UpdateGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 }
 
 func DeleteGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	id, err := ParseGrantPrivilegesToShareId(d.Id())
 	if err != nil {
@@ -273,7 +280,11 @@ func DeleteGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	err = client.Grants.RevokePrivilegeFromShare(ctx, getObjectPrivilegesFromSchema(d), getShareGrantOn(d), sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
+	grantOn, err := getShareGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.Grants.RevokePrivilegeFromShare(ctx, getObjectPrivilegesFromSchema(d), grantOn, sdk.NewAccountObjectIdentifier(id.ShareName.Name()))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -306,8 +317,7 @@ func ReadGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, met
 		return nil
 	}
 
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		return diag.Diagnostics{
@@ -356,7 +366,7 @@ func ReadGrantPrivilegesToShare(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func createGrantPrivilegesToShareIdFromSchema(d *schema.ResourceData) *GrantPrivilegesToShareId {
+func createGrantPrivilegesToShareIdFromSchema(d *schema.ResourceData) (*GrantPrivilegesToShareId, error) {
 	id := new(GrantPrivilegesToShareId)
 	id.ShareName = sdk.NewAccountObjectIdentifier(d.Get("to_share").(string))
 	id.Privileges = expandStringList(d.Get("privileges").(*schema.Set).List())
@@ -369,31 +379,35 @@ func createGrantPrivilegesToShareIdFromSchema(d *schema.ResourceData) *GrantPriv
 	tagName, tagNameOk := d.GetOk("on_tag")
 	viewName, viewNameOk := d.GetOk("on_view")
 
+	var err error
 	switch {
 	case databaseNameOk:
 		id.Kind = OnDatabaseShareGrantKind
 		id.Identifier = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(databaseName.(string))
 	case schemaNameOk:
 		id.Kind = OnSchemaShareGrantKind
-		id.Identifier = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName.(string))
+		id.Identifier, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](schemaName.(string))
 	// TODO(SNOW-990811) case functionNameOk:
 	//	id.Kind = OnFunctionShareGrantKind
 	//	id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(functionName.(string))
 	case tableNameOk:
 		id.Kind = OnTableShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tableName.(string))
+		id.Identifier, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](tableName.(string))
 	case allTablesInSchemaOk:
 		id.Kind = OnAllTablesInSchemaShareGrantKind
-		id.Identifier = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(allTablesInSchema.(string))
+		id.Identifier, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](allTablesInSchema.(string))
 	case tagNameOk:
 		id.Kind = OnTagShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tagName.(string))
+		id.Identifier, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](tagName.(string))
 	case viewNameOk:
 		id.Kind = OnViewShareGrantKind
-		id.Identifier = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(viewName.(string))
+		id.Identifier, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](viewName.(string))
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return id
+	return id, nil
 }
 
 func getObjectPrivilegesFromSchema(d *schema.ResourceData) []sdk.ObjectPrivilege {
@@ -405,7 +419,7 @@ func getObjectPrivilegesFromSchema(d *schema.ResourceData) []sdk.ObjectPrivilege
 	return objectPrivileges
 }
 
-func getShareGrantOn(d *schema.ResourceData) *sdk.ShareGrantOn {
+func getShareGrantOn(d *schema.ResourceData) (*sdk.ShareGrantOn, error) {
 	grantOn := new(sdk.ShareGrantOn)
 
 	databaseName, databaseNameOk := d.GetOk("on_database")
@@ -416,28 +430,30 @@ func getShareGrantOn(d *schema.ResourceData) *sdk.ShareGrantOn {
 	tagName, tagNameOk := d.GetOk("on_tag")
 	viewName, viewNameOk := d.GetOk("on_view")
 
+	var err error
 	switch {
 	case len(databaseName.(string)) > 0 && databaseNameOk:
 		grantOn.Database = sdk.NewAccountObjectIdentifierFromFullyQualifiedName(databaseName.(string))
 	case len(schemaName.(string)) > 0 && schemaNameOk:
-		grantOn.Schema = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(schemaName.(string))
+		grantOn.Schema, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](schemaName.(string))
 	// TODO(SNOW-990811) case len(functionName.(string)) > 0 && functionNameOk:
 	//	grantOn.Function = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(functionName.(string))
 	case len(tableName.(string)) > 0 && tableNameOk:
-		grantOn.Table = &sdk.OnTable{
-			Name: sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tableName.(string)),
-		}
+		grantOn.Table = new(sdk.OnTable)
+		grantOn.Table.Name, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](tableName.(string))
 	case len(allTablesInSchema.(string)) > 0 && allTablesInSchemaOk:
-		grantOn.Table = &sdk.OnTable{
-			AllInSchema: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(allTablesInSchema.(string)),
-		}
+		grantOn.Table = new(sdk.OnTable)
+		grantOn.Table.AllInSchema, err = sdk.ParseFullyQualifiedName[sdk.DatabaseObjectIdentifier](allTablesInSchema.(string))
 	case len(tagName.(string)) > 0 && tagNameOk:
-		grantOn.Tag = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(tagName.(string))
+		grantOn.Tag, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](tagName.(string))
 	case len(viewName.(string)) > 0 && viewNameOk:
-		grantOn.View = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(viewName.(string))
+		grantOn.View, err = sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](viewName.(string))
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return grantOn
+	return grantOn, nil
 }
 
 func prepareShowGrantsRequestForShare(id GrantPrivilegesToShareId) (*sdk.ShowGrantOptions, sdk.ObjectType) {
@@ -2,13 +2,13 @@ package resources_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"regexp"
 	"strings"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -526,8 +526,7 @@ func testAccCheckSharePrivilegesRevoked() func(*terraform.State) error {
 			if rs.Type != "snowflake_grant_privileges_to_share" {
 				continue
 			}
-			db := acc.TestAccProvider.Meta().(*sql.DB)
-			client := sdk.NewClientFromDB(db)
+			client := acc.TestAccProvider.Meta().(*provider.Context).Client
 			ctx := context.Background()
 
 			id := sdk.NewExternalObjectIdentifierFromFullyQualifiedName(rs.Primary.Attributes["to_share"])
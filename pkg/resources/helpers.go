@@ -8,27 +8,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func isOk(_ interface{}, ok bool) bool {
-	return ok
-}
-
 func dataTypeValidateFunc(val interface{}, _ string) (warns []string, errs []error) {
-	if ok := sdk.IsValidDataType(val.(string)); !ok {
-		errs = append(errs, fmt.Errorf("%v is not a valid data type", val))
+	if _, err := sdk.ParseDataType(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%v is not a valid data type: %w", val, err))
 	}
 	return
 }
 
+// dataTypeDiffSuppressFunc suppresses the diff between equivalent data types, e.g. NUMBER and NUMBER(38,0) or STRING and VARCHAR(16777216).
+// Values which are not data types (e.g. TABLE(...) returned by tabular functions) are compared case-insensitively.
 func dataTypeDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
-	oldDT, err := sdk.ToDataType(old)
-	if err != nil {
-		return false
-	}
-	newDT, err := sdk.ToDataType(new)
-	if err != nil {
-		return false
-	}
-	return oldDT == newDT
+	return strings.EqualFold(old, new) || sdk.AreDataTypesEqual(old, new)
+}
+
+// routineDataTypeDiffSuppressFunc suppresses the diff between argument and return types of functions and procedures with the same base type,
+// e.g. NUMBER(10,2) and NUMBER or VARCHAR(100) and VARCHAR. Snowflake drops the parameters of these types, so they can't be compared after read.
+func routineDataTypeDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new) || sdk.AreBaseDataTypesEqual(old, new)
 }
 
 func ignoreTrimSpaceSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataTypeDiffSuppressFunc(t *testing.T) {
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{old: "NUMBER(38,0)", new: "NUMBER", suppress: true},
+		{old: "NUMBER(38,0)", new: "int", suppress: true},
+		{old: "NUMBER(38,2)", new: "NUMBER", suppress: false},
+		{old: "VARCHAR(16777216)", new: "string", suppress: true},
+		{old: "VARCHAR(16777216)", new: "VARCHAR(100)", suppress: false},
+		{old: "TIMESTAMP_NTZ(9)", new: "timestamp", suppress: true},
+		{old: "TABLE (A NUMBER)", new: "table (a number)", suppress: true},
+		{old: "TABLE (A NUMBER)", new: "TABLE (B NUMBER)", suppress: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.old+" "+tc.new, func(t *testing.T) {
+			assert.Equal(t, tc.suppress, dataTypeDiffSuppressFunc("", tc.old, tc.new, nil))
+		})
+	}
+}
+
+func TestRoutineDataTypeDiffSuppressFunc(t *testing.T) {
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{old: "NUMBER", new: "NUMBER(10,2)", suppress: true},
+		{old: "VARCHAR", new: "VARCHAR(100)", suppress: true},
+		{old: "VARCHAR", new: "string", suppress: true},
+		{old: "NUMBER", new: "FLOAT", suppress: false},
+		{old: "TABLE (A NUMBER)", new: "table (a number)", suppress: true},
+		{old: "TABLE (A NUMBER)", new: "TABLE (B NUMBER)", suppress: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.old+" "+tc.new, func(t *testing.T) {
+			assert.Equal(t, tc.suppress, routineDataTypeDiffSuppressFunc("", tc.old, tc.new, nil))
+		})
+	}
+}
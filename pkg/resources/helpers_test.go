@@ -2,13 +2,13 @@ package resources_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"os"
 	"slices"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -284,33 +284,6 @@ func apiIntegration(t *testing.T, id string, params map[string]interface{}) *sch
 	return d
 }
 
-func samlIntegration(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
-	t.Helper()
-	r := require.New(t)
-	d := schema.TestResourceDataRaw(t, resources.SAMLIntegration().Schema, params)
-	r.NotNil(d)
-	d.SetId(id)
-	return d
-}
-
-func scimIntegration(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
-	t.Helper()
-	r := require.New(t)
-	d := schema.TestResourceDataRaw(t, resources.SCIMIntegration().Schema, params)
-	r.NotNil(d)
-	d.SetId(id)
-	return d
-}
-
-func oauthIntegration(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
-	t.Helper()
-	r := require.New(t)
-	d := schema.TestResourceDataRaw(t, resources.OAuthIntegration().Schema, params)
-	r.NotNil(d)
-	d.SetId(id)
-	return d
-}
-
 func externalFunction(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -503,9 +476,8 @@ func tagGrant(t *testing.T, id string, params map[string]interface{}) *schema.Re
 // queriedAccountRolePrivilegesEqualTo will check if all the privileges specified in the argument are granted in Snowflake.
 func queriedPrivilegesEqualTo(query func(client *sdk.Client, ctx context.Context) ([]sdk.Grant, error), privileges ...string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
-		db := acc.TestAccProvider.Meta().(*sql.DB)
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 		ctx := context.Background()
-		client := sdk.NewClientFromDB(db)
 		grants, err := query(client, ctx)
 		if err != nil {
 			return err
@@ -527,9 +499,8 @@ func queriedPrivilegesEqualTo(query func(client *sdk.Client, ctx context.Context
 // Any additional grants will be ignored.
 func queriedPrivilegesContainAtLeast(query func(client *sdk.Client, ctx context.Context) ([]sdk.Grant, error), roleName sdk.ObjectIdentifier, privileges ...string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
-		db := acc.TestAccProvider.Meta().(*sql.DB)
+		client := acc.TestAccProvider.Meta().(*provider.Context).Client
 		ctx := context.Background()
-		client := sdk.NewClientFromDB(db)
 		grants, err := query(client, ctx)
 		if err != nil {
 			return err
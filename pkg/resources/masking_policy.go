@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -125,15 +125,14 @@ func MaskingPolicy() *schema.Resource {
 
 		Schema: maskingPolicySchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // CreateMaskingPolicy implements schema.CreateFunc.
 func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
 	name := d.Get("name").(string)
 	databaseName := d.Get("database").(string)
@@ -152,18 +151,18 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		columns := m["column"].([]interface{})
 		for _, c := range columns {
 			cm := c.(map[string]interface{})
-			dt, err := sdk.ToDataType(cm["type"].(string))
+			dt, err := sdk.ParseDataType(cm["type"].(string))
 			if err != nil {
 				return err
 			}
 			signature = append(signature, sdk.TableColumnSignature{
 				Name: cm["name"].(string),
-				Type: dt,
+				Type: sdk.DataType(dt.String()),
 			})
 		}
 	}
 
-	returns, err := sdk.ToDataType(returnDataType)
+	returns, err := sdk.ParseDataType(returnDataType)
 	if err != nil {
 		return err
 	}
@@ -175,7 +174,7 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		opts.ExemptOtherPolicies = sdk.Bool(exemptOtherPolicies)
 	}
 
-	err = client.MaskingPolicies.Create(ctx, objectIdentifier, signature, returns, expression, opts)
+	err = client.MaskingPolicies.Create(ctx, objectIdentifier, signature, sdk.DataType(returns.String()), expression, opts)
 	if err != nil {
 		return err
 	}
@@ -186,9 +185,11 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // ReadMaskingPolicy implements schema.ReadFunc.
 func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	ctx := context.Background()
 	maskingPolicy, err := client.MaskingPolicies.ShowByID(ctx, objectIdentifier)
@@ -251,9 +252,11 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateMaskingPolicy implements schema.UpdateFunc.
 func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
 
 	if d.HasChange("masking_expression") {
@@ -304,12 +307,14 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteMaskingPolicy implements schema.DeleteFunc.
 func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.MaskingPolicies.Drop(ctx, objectIdentifier)
+	err = client.MaskingPolicies.Drop(ctx, objectIdentifier)
 	if err != nil {
 		return err
 	}
@@ -36,7 +36,7 @@ func TestMaskingPolicyGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON MASKING POLICY "test-db"."PUBLIC"."test-masking-policy" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON MASKING POLICY "test-db"."PUBLIC"."test-masking-policy" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadMaskingPolicyGrant(mock)
-		err := resources.CreateMaskingPolicyGrant(d, db)
+		err := resources.CreateMaskingPolicyGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -57,7 +57,7 @@ func TestMaskingPolicyGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadMaskingPolicyGrant(mock)
-		err := resources.ReadMaskingPolicyGrant(d, db)
+		err := resources.ReadMaskingPolicyGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,16 +73,15 @@ func MaterializedView() *schema.Resource {
 
 		Schema: materializedViewSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // CreateMaterializedView implements schema.CreateFunc.
 func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -106,14 +105,18 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 	warehouseName := d.Get("warehouse").(string)
 	// TODO [SNOW-867235]: this was the old implementation, it's left for now, we will address this with resources rework discussions
-	err := client.Sessions.UseWarehouse(ctx, sdk.NewAccountObjectIdentifier(warehouseName))
-	if err != nil {
-		return fmt.Errorf("error setting warehouse %s while creating materialized view %v err = %w", warehouseName, name, err)
-	}
-
-	err = client.MaterializedViews.Create(ctx, createRequest)
+	// the warehouse is set in a separate session, so the statement creating the view runs with it and the other statements are not affected
+	err := client.InSession(ctx, func(session *sdk.Client) error {
+		if err := session.Sessions.UseWarehouse(ctx, sdk.NewAccountObjectIdentifier(warehouseName)); err != nil {
+			return fmt.Errorf("error setting warehouse %s while creating materialized view %v err = %w", warehouseName, name, err)
+		}
+		if err := session.MaterializedViews.Create(ctx, createRequest); err != nil {
+			return fmt.Errorf("error creating materialized view %v err = %w", name, err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("error creating materialized view %v err = %w", name, err)
+		return err
 	}
 
 	// TODO [SNOW-867235]: we have to set tags after creation because existing materialized view extractor is not aware of TAG during CREATE
@@ -132,10 +135,12 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 // ReadMaterializedView implements schema.ReadFunc.
 func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	materializedView, err := client.MaterializedViews.ShowByID(ctx, id)
 	if err != nil {
@@ -181,10 +186,12 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateMaterializedView implements schema.UpdateFunc.
 func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("name") {
 		newName := d.Get("name").(string)
@@ -264,12 +271,14 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteMaterializedView implements schema.DeleteFunc.
 func DeleteMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.MaterializedViews.Drop(ctx, sdk.NewDropMaterializedViewRequest(id))
+	err = client.MaterializedViews.Drop(ctx, sdk.NewDropMaterializedViewRequest(id))
 	if err != nil {
 		return err
 	}
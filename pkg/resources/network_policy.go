@@ -2,11 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,10 +20,22 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Description: "Specifies the identifier for the network policy; must be unique for the account in which the network policy is created.",
 		ForceNew:    true,
 	},
+	"allowed_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules (e.g. `snowflake_network_rule.rule.qualified_name`) that contain the network identifiers that are allowed access to Snowflake.",
+	},
+	"blocked_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules (e.g. `snowflake_network_rule.rule.qualified_name`) that contain the network identifiers that are denied access to Snowflake.",
+	},
 	"allowed_ip_list": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
-		Required:    true,
+		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
 	},
 	// TODO: Add a ValidationFunc to ensure 0.0.0.0/0 is not in blocked_ip_list
@@ -65,27 +78,32 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		req = req.WithComment(sdk.String(v.(string)))
 	}
 
-	if v, ok := d.GetOk("allowed_ip_list"); ok {
-		ipList := expandStringList(v.(*schema.Set).List())
-		ipRequests := make([]sdk.IPRequest, len(ipList))
-		for i, v := range ipList {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+	if v, ok := d.GetOk("allowed_network_rule_list"); ok {
+		ruleList, err := networkRuleListParser(v.(*schema.Set).List())
+		if err != nil {
+			return err
 		}
-		req = req.WithAllowedIpList(ipRequests)
+		req = req.WithAllowedNetworkRuleList(ruleList)
 	}
 
-	if v, ok := d.GetOk("blocked_ip_list"); ok {
-		ipList := expandStringList(v.(*schema.Set).List())
-		ipRequests := make([]sdk.IPRequest, len(ipList))
-		for i, v := range ipList {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+	if v, ok := d.GetOk("blocked_network_rule_list"); ok {
+		ruleList, err := networkRuleListParser(v.(*schema.Set).List())
+		if err != nil {
+			return err
 		}
-		req = req.WithAllowedIpList(ipRequests)
+		req = req.WithBlockedNetworkRuleList(ruleList)
+	}
+
+	if v, ok := d.GetOk("allowed_ip_list"); ok {
+		req = req.WithAllowedIpList(ipRequestsParser(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("blocked_ip_list"); ok {
+		req = req.WithBlockedIpList(ipRequestsParser(v.(*schema.Set).List()))
 	}
 
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 	err := client.NetworkPolicies.Create(ctx, req)
 	if err != nil {
 		return fmt.Errorf("error creating network policy %v err = %w", name, err)
@@ -98,16 +116,18 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 // ReadNetworkPolicy implements schema.ReadFunc.
 func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	policyName := d.Id()
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	networkPolicy, err := client.NetworkPolicies.ShowByID(ctx, sdk.NewAccountObjectIdentifier(policyName))
-	if networkPolicy == nil || err != nil {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] network policy (%s) not found", d.Id())
-		d.SetId("")
-		return nil
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] network policy (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
 	policyDescriptions, err := client.NetworkPolicies.Describe(ctx, sdk.NewAccountObjectIdentifier(policyName))
@@ -123,68 +143,106 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// empty lists are not described at all
+	allowedNetworkRuleList, blockedNetworkRuleList := []string{}, []string{}
+	allowedIpList, blockedIpList := []string{}, []string{}
 	for _, desc := range policyDescriptions {
 		switch desc.Name {
-		case "ALLOWED_IP_LIST":
-			if err = d.Set("allowed_ip_list", strings.Split(desc.Value, ",")); err != nil {
+		case "ALLOWED_NETWORK_RULE_LIST":
+			if allowedNetworkRuleList, err = networkRuleListToStrings(desc.Value); err != nil {
 				return err
 			}
-		case "BLOCKED_IP_LIST":
-			if err = d.Set("blocked_ip_list", strings.Split(desc.Value, ",")); err != nil {
+		case "BLOCKED_NETWORK_RULE_LIST":
+			if blockedNetworkRuleList, err = networkRuleListToStrings(desc.Value); err != nil {
 				return err
 			}
+		case "ALLOWED_IP_LIST":
+			allowedIpList = strings.Split(desc.Value, ",")
+		case "BLOCKED_IP_LIST":
+			blockedIpList = strings.Split(desc.Value, ",")
 		}
 	}
 
-	return err
+	if err = d.Set("allowed_network_rule_list", allowedNetworkRuleList); err != nil {
+		return err
+	}
+
+	if err = d.Set("blocked_network_rule_list", blockedNetworkRuleList); err != nil {
+		return err
+	}
+
+	if err = d.Set("allowed_ip_list", allowedIpList); err != nil {
+		return err
+	}
+
+	if err = d.Set("blocked_ip_list", blockedIpList); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // UpdateNetworkPolicy implements schema.UpdateFunc.
 func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	baseReq := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
+	id := sdk.NewAccountObjectIdentifier(name)
 
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 
 		if c := comment.(string); c == "" {
-			err := client.NetworkPolicies.Alter(ctx, baseReq.WithUnsetComment(sdk.Bool(true)))
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
 				return fmt.Errorf("error unsetting comment for network policy %v err = %w", name, err)
 			}
 		} else {
 			setReq := sdk.NewNetworkPolicySetRequest().WithComment(sdk.String(comment.(string)))
-			err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
 			if err != nil {
 				return fmt.Errorf("error updating comment for network policy %v err = %w", name, err)
 			}
 		}
 	}
 
-	if d.HasChange("allowed_ip_list") {
-		newIps := ipChangeParser(d, "allowed_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+	if d.HasChange("allowed_network_rule_list") {
+		ruleList, err := networkRuleListParser(d.Get("allowed_network_rule_list").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		setReq := sdk.NewNetworkPolicySetRequest().WithAllowedNetworkRuleList(sdk.NewAllowedNetworkRuleListRequest().WithAllowedNetworkRuleList(ruleList))
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
+		if err != nil {
+			return fmt.Errorf("error updating ALLOWED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("blocked_network_rule_list") {
+		ruleList, err := networkRuleListParser(d.Get("blocked_network_rule_list").(*schema.Set).List())
+		if err != nil {
+			return err
 		}
+		setReq := sdk.NewNetworkPolicySetRequest().WithBlockedNetworkRuleList(sdk.NewBlockedNetworkRuleListRequest().WithBlockedNetworkRuleList(ruleList))
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
+		if err != nil {
+			return fmt.Errorf("error updating BLOCKED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("allowed_ip_list") {
+		ipRequests := ipRequestsParser(d.Get("allowed_ip_list").(*schema.Set).List())
 		setReq := sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+		err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
 		if err != nil {
 			return fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err)
 		}
 	}
 
 	if d.HasChange("blocked_ip_list") {
-		newIps := ipChangeParser(d, "blocked_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
-		}
+		ipRequests := ipRequestsParser(d.Get("blocked_ip_list").(*schema.Set).List())
 		setReq := sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+		err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(id).WithSet(setReq))
 		if err != nil {
 			return fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err)
 		}
@@ -196,9 +254,8 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 // DeleteNetworkPolicy implements schema.DeleteFunc.
 func DeleteNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	err := client.NetworkPolicies.Drop(ctx, sdk.NewDropNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)))
 	if err != nil {
@@ -209,13 +266,37 @@ func DeleteNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// ipChangeParser is a helper function to parse a given ip list change from ResourceData.
-func ipChangeParser(data *schema.ResourceData, key string) []string {
-	ipChangeSet := data.Get(key)
-	ipList := ipChangeSet.(*schema.Set).List()
-	newIps := make([]string, len(ipList))
-	for idx, value := range ipList {
-		newIps[idx] = fmt.Sprintf("%v", value)
+// ipRequestsParser is a helper function to convert a given ip list from ResourceData to ip requests.
+func ipRequestsParser(ipList []interface{}) []sdk.IPRequest {
+	ipRequests := make([]sdk.IPRequest, len(ipList))
+	for i, value := range ipList {
+		ipRequests[i] = *sdk.NewIPRequest(fmt.Sprintf("%v", value))
+	}
+	return ipRequests
+}
+
+// networkRuleListParser is a helper function to convert a given list of fully qualified network rule names from ResourceData to identifiers.
+func networkRuleListParser(ruleList []interface{}) ([]sdk.SchemaObjectIdentifier, error) {
+	ids := make([]sdk.SchemaObjectIdentifier, len(ruleList))
+	for i, value := range ruleList {
+		id, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](value.(string))
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// networkRuleListToStrings is a helper function to convert a described network rule list to fully qualified names.
+func networkRuleListToStrings(value string) ([]string, error) {
+	ids, err := sdk.ParseNetworkRuleList(value)
+	if err != nil {
+		return nil, err
+	}
+	ruleList := make([]string, len(ids))
+	for i, id := range ids {
+		ruleList[i] = id.FullyQualifiedName()
 	}
-	return newIps
+	return ruleList, nil
 }
@@ -7,8 +7,11 @@ import (
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 const (
@@ -56,6 +59,68 @@ func TestAcc_NetworkPolicy(t *testing.T) {
 	})
 }
 
+func TestAcc_NetworkPolicy_networkRules(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_NETWORK_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAcc_NetworkPolicy_networkRules")
+	}
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	allowedRuleName := name + "_ALLOWED"
+	blockedRuleName := name + "_BLOCKED"
+	m := func(withBlockedRules bool) config.Variables {
+		return config.Variables{
+			"name":               config.StringVariable(name),
+			"database":           config.StringVariable(acc.TestDatabaseName),
+			"schema":             config.StringVariable(acc.TestSchemaName),
+			"allowed_rule_name":  config.StringVariable(allowedRuleName),
+			"blocked_rule_name":  config.StringVariable(blockedRuleName),
+			"with_blocked_rules": config.BoolVariable(withBlockedRules),
+		}
+	}
+	allowedRuleQualifiedName := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, allowedRuleName).FullyQualifiedName()
+	blockedRuleQualifiedName := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, blockedRuleName).FullyQualifiedName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckNetworkRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkPolicy_networkRules"),
+				ConfigVariables: m(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_network_policy.test", "allowed_network_rule_list.*", allowedRuleQualifiedName),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_network_rule_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_network_policy.test", "blocked_network_rule_list.*", blockedRuleQualifiedName),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_ip_list.#", "0"),
+				),
+			},
+			// CLEAR BLOCKED RULES
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkPolicy_networkRules"),
+				ConfigVariables: m(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_network_rule_list.#", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NetworkPolicy_networkRules"),
+				ConfigVariables:   m(false),
+				ResourceName:      "snowflake_network_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func networkPolicyConfig(name string) string {
 	return fmt.Sprintf(`
 resource "snowflake_network_policy" "test" {
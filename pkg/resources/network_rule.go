@@ -0,0 +1,249 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the network rule.",
+		ForceNew:    true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		Description: fmt.Sprintf("Specifies the type of network identifiers being allowed or blocked. A network rule can have only one type. Allowed values are %s, %s, %s and %s.",
+			sdk.NetworkRuleTypeIpv4, sdk.NetworkRuleTypeAwsVpcEndpointId, sdk.NetworkRuleTypeAzureLinkId, sdk.NetworkRuleTypeHostPort),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleTypeIpv4),
+			string(sdk.NetworkRuleTypeAwsVpcEndpointId),
+			string(sdk.NetworkRuleTypeAzureLinkId),
+			string(sdk.NetworkRuleTypeHostPort),
+		}, false),
+	},
+	"mode": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		Description: fmt.Sprintf("Specifies what is restricted by the network rule. Allowed values are %s, %s and %s.",
+			sdk.NetworkRuleModeIngress, sdk.NetworkRuleModeInternalStage, sdk.NetworkRuleModeEgress),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleModeIngress),
+			string(sdk.NetworkRuleModeInternalStage),
+			string(sdk.NetworkRuleModeEgress),
+		}, false),
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the network identifiers that will be allowed or blocked. Valid values in the list are determined by the type of network rule (e.g. IPv4 addresses in CIDR notation for IPV4, hostnames with optional ports for HOST_PORT).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the network rule.",
+	},
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule.
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateNetworkRule,
+		Read:   ReadNetworkRule,
+		Update: UpdateNetworkRule,
+		Delete: DeleteNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateNetworkRule implements schema.CreateFunc.
+func CreateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	createRequest := sdk.NewCreateNetworkRuleRequest(
+		objectIdentifier,
+		sdk.NetworkRuleType(d.Get("type").(string)),
+		expandNetworkRuleValues(d.Get("value_list").(*schema.Set).List()),
+		sdk.NetworkRuleMode(d.Get("mode").(string)),
+	)
+
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.NetworkRules.Create(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating network rule %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadNetworkRule(d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadFunc.
+func ReadNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	networkRule, err := client.NetworkRules.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] network rule (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	networkRuleDetails, err := client.NetworkRules.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", networkRule.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("database", networkRule.DatabaseName); err != nil {
+		return err
+	}
+
+	if err := d.Set("schema", networkRule.SchemaName); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", string(networkRule.Type)); err != nil {
+		return err
+	}
+
+	if err := d.Set("mode", string(networkRule.Mode)); err != nil {
+		return err
+	}
+
+	// an empty value list is described as an empty string
+	valueList := make([]string, 0, len(networkRuleDetails.ValueList))
+	for _, value := range networkRuleDetails.ValueList {
+		if value != "" {
+			valueList = append(valueList, value)
+		}
+	}
+	if err := d.Set("value_list", valueList); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", networkRule.Comment); err != nil {
+		return err
+	}
+
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateFunc.
+func UpdateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if d.HasChange("value_list") {
+		alterRequest := sdk.NewAlterNetworkRuleRequest(objectIdentifier)
+		if valueList := d.Get("value_list").(*schema.Set).List(); len(valueList) > 0 {
+			alterRequest.WithSet(sdk.NewNetworkRuleSetRequest(expandNetworkRuleValues(valueList)))
+		} else {
+			alterRequest.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithValueList(sdk.Bool(true)))
+		}
+		if err := client.NetworkRules.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating VALUE_LIST for network rule %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		alterRequest := sdk.NewAlterNetworkRuleRequest(objectIdentifier)
+		if comment := d.Get("comment").(string); comment != "" {
+			alterRequest.WithSet(sdk.NewNetworkRuleSetRequest(nil).WithComment(sdk.String(comment)))
+		} else {
+			alterRequest.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithComment(sdk.Bool(true)))
+		}
+		if err := client.NetworkRules.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating comment for network rule %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadNetworkRule(d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteFunc.
+func DeleteNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(objectIdentifier)); err != nil {
+		return fmt.Errorf("error deleting network rule %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandNetworkRuleValues(values []interface{}) []sdk.NetworkRuleValue {
+	networkRuleValues := make([]sdk.NetworkRuleValue, len(values))
+	for i, value := range values {
+		networkRuleValues[i] = sdk.NetworkRuleValue{Value: value.(string)}
+	}
+	return networkRuleValues
+}
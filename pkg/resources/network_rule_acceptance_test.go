@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NetworkRule_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_network_rule.test"
+	m := func(comment string, values ...string) config.Variables {
+		valueList := make([]config.Variable, len(values))
+		for i, value := range values {
+			valueList[i] = config.StringVariable(value)
+		}
+		return config.Variables{
+			"name":       config.StringVariable(name),
+			"database":   config.StringVariable(acc.TestDatabaseName),
+			"schema":     config.StringVariable(acc.TestSchemaName),
+			"value_list": config.SetVariable(valueList...),
+			"comment":    config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckNetworkRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkRule/basic"),
+				ConfigVariables: m("some comment", "example.com", "example.com:443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "type", "HOST_PORT"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "EGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+			// CHANGE VALUE LIST AND COMMENT IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkRule/basic"),
+				ConfigVariables: m("", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_list.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// CLEAR VALUE LIST
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_NetworkRule/basic"),
+				ConfigVariables: m("other comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_NetworkRule/basic"),
+				ConfigVariables:   m("other comment"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkRuleDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_network_rule" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.SchemaObjectIdentifier)
+		existingNetworkRule, err := client.NetworkRules.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("network rule %v still exists", existingNetworkRule.Name)
+		}
+	}
+	return nil
+}
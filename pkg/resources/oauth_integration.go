@@ -1,13 +1,17 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,6 +26,7 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 	"oauth_client": {
 		Type:        schema.TypeString,
 		Required:    true,
+		ForceNew:    true,
 		Description: "Specifies the OAuth client type.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"TABLEAU_DESKTOP", "TABLEAU_SERVER", "LOOKER", "CUSTOM",
@@ -35,7 +40,8 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 	"oauth_client_type": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the type of client being registered. Snowflake supports both confidential and public clients.",
+		ForceNew:    true,
+		Description: "Specifies the type of client being registered. Snowflake supports both confidential and public clients. Required when `oauth_client` is CUSTOM.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"CONFIDENTIAL", "PUBLIC",
 		}, false),
@@ -85,6 +91,8 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 // OAuthIntegration returns a pointer to the resource representing an OAuth integration.
 func OAuthIntegration() *schema.Resource {
 	return &schema.Resource{
+		SchemaVersion: 1,
+
 		Create: CreateOAuthIntegration,
 		Read:   ReadOAuthIntegration,
 		Update: UpdateOAuthIntegration,
@@ -92,240 +100,293 @@ func OAuthIntegration() *schema.Resource {
 
 		Schema: oauthIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v0SecurityIntegrationStateUpgrader([]string{"oauth_client", "oauth_client_type", "oauth_use_secondary_roles"}, []string{"blocked_roles_list"}),
+			},
 		},
 	}
 }
 
 // CreateOAuthIntegration implements schema.CreateFunc.
 func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
-
-	stmt := snowflake.NewOAuthIntegrationBuilder(name).Create()
-
-	// Set required fields
-	stmt.SetRaw(`TYPE=OAUTH`)
-	stmt.SetString(`OAUTH_CLIENT`, d.Get("oauth_client").(string))
-	// Set optional fields
-	if _, ok := d.GetOk("oauth_redirect_uri"); ok {
-		stmt.SetString(`OAUTH_REDIRECT_URI`, d.Get("oauth_redirect_uri").(string))
-	}
-	if _, ok := d.GetOk("oauth_client_type"); ok {
-		stmt.SetString(`OAUTH_CLIENT_TYPE`, d.Get("oauth_client_type").(string))
-	}
-	if _, ok := d.GetOk("oauth_issue_refresh_tokens"); ok {
-		stmt.SetBool(`OAUTH_ISSUE_REFRESH_TOKENS`, d.Get("oauth_issue_refresh_tokens").(bool))
-	}
-	if _, ok := d.GetOk("oauth_refresh_token_validity"); ok {
-		stmt.SetInt(`OAUTH_REFRESH_TOKEN_VALIDITY`, d.Get("oauth_refresh_token_validity").(int))
-	}
-	if _, ok := d.GetOk("oauth_use_secondary_roles"); ok {
-		stmt.SetString(`OAUTH_USE_SECONDARY_ROLES`, d.Get("oauth_use_secondary_roles").(string))
-	}
-	if _, ok := d.GetOk("blocked_roles_list"); ok {
-		stmt.SetStringList(`BLOCKED_ROLES_LIST`, expandStringList(d.Get("blocked_roles_list").(*schema.Set).List()))
-	}
-	if _, ok := d.GetOk("enabled"); ok {
-		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
-	}
-	if _, ok := d.GetOk("comment"); ok {
-		stmt.SetString(`COMMENT`, d.Get("comment").(string))
+	id := sdk.NewAccountObjectIdentifier(name)
+	ctx := context.Background()
+
+	var err error
+	if oauthClient := d.Get("oauth_client").(string); oauthClient == "CUSTOM" {
+		oauthClientType, ok := d.GetOk("oauth_client_type")
+		if !ok {
+			return fmt.Errorf("oauth_client_type is required when oauth_client is CUSTOM")
+		}
+		oauthRedirectUri, ok := d.GetOk("oauth_redirect_uri")
+		if !ok {
+			return fmt.Errorf("oauth_redirect_uri is required when oauth_client is CUSTOM")
+		}
+		createRequest := sdk.NewCreateOauthForCustomClientsSecurityIntegrationRequest(id, sdk.OauthSecurityIntegrationClientTypeOption(oauthClientType.(string)), oauthRedirectUri.(string))
+		if v, ok := d.GetOk("oauth_issue_refresh_tokens"); ok {
+			createRequest.WithOauthIssueRefreshTokens(sdk.Bool(v.(bool)))
+		}
+		if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+			createRequest.WithOauthRefreshTokenValidity(sdk.Int(v.(int)))
+		}
+		if v, ok := d.GetOk("oauth_use_secondary_roles"); ok {
+			createRequest.WithOauthUseSecondaryRoles(sdk.Pointer(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(v.(string))))
+		}
+		if v, ok := d.GetOk("blocked_roles_list"); ok {
+			createRequest.WithBlockedRolesList(&sdk.BlockedRolesList{BlockedRolesList: expandAccountObjectIdentifiers(v.(*schema.Set))})
+		}
+		if v, ok := d.GetOk("enabled"); ok {
+			createRequest.WithEnabled(sdk.Bool(v.(bool)))
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			createRequest.WithComment(sdk.String(v.(string)))
+		}
+		err = client.SecurityIntegrations.CreateOauthForCustomClients(ctx, createRequest)
+	} else {
+		createRequest := sdk.NewCreateOauthForPartnerApplicationsSecurityIntegrationRequest(id, sdk.OauthSecurityIntegrationClientOption(oauthClient))
+		if v, ok := d.GetOk("oauth_redirect_uri"); ok {
+			createRequest.WithOauthRedirectUri(sdk.String(v.(string)))
+		}
+		if v, ok := d.GetOk("oauth_issue_refresh_tokens"); ok {
+			createRequest.WithOauthIssueRefreshTokens(sdk.Bool(v.(bool)))
+		}
+		if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+			createRequest.WithOauthRefreshTokenValidity(sdk.Int(v.(int)))
+		}
+		if v, ok := d.GetOk("oauth_use_secondary_roles"); ok {
+			createRequest.WithOauthUseSecondaryRoles(sdk.Pointer(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(v.(string))))
+		}
+		if v, ok := d.GetOk("blocked_roles_list"); ok {
+			createRequest.WithBlockedRolesList(&sdk.BlockedRolesList{BlockedRolesList: expandAccountObjectIdentifiers(v.(*schema.Set))})
+		}
+		if v, ok := d.GetOk("enabled"); ok {
+			createRequest.WithEnabled(sdk.Bool(v.(bool)))
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			createRequest.WithComment(sdk.String(v.(string)))
+		}
+		err = client.SecurityIntegrations.CreateOauthForPartnerApplications(ctx, createRequest)
 	}
-
-	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-		return fmt.Errorf("error creating security integration err = %w", err)
+	if err != nil {
+		return fmt.Errorf("error creating OAuth integration %v err = %w", name, err)
 	}
 
-	d.SetId(name)
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
 	return ReadOAuthIntegration(d, meta)
 }
 
 // ReadOAuthIntegration implements schema.ReadFunc.
 func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewOAuthIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
-
-	// Some properties can come from the SHOW INTEGRATION call
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	s, err := snowflake.ScanOAuthIntegration(row)
+	ctx := context.Background()
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not show security integration err = %w", err)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] OAuth integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
-	// Note: category must be Security or something is broken
-	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v err = %w", id, c, err)
+	if c := integration.Category; c != "SECURITY" {
+		return fmt.Errorf("expected %v to be a SECURITY integration, got %v", d.Id(), c)
+	}
+	if !strings.HasPrefix(integration.IntegrationType, "OAUTH - ") {
+		return fmt.Errorf("expected %v to be an OAuth integration, got %v", d.Id(), integration.IntegrationType)
 	}
+	oauthClient := strings.TrimPrefix(integration.IntegrationType, "OAUTH - ")
 
-	if err := d.Set("oauth_client", strings.TrimPrefix(s.IntegrationType.String, "OAUTH - ")); err != nil {
-		return err
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not describe OAuth integration (%s), err = %w", d.Id(), err)
 	}
 
-	if err := d.Set("name", s.Name.String); err != nil {
+	if err := d.Set("name", integration.Name); err != nil {
 		return err
 	}
-
-	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+	if err := d.Set("oauth_client", oauthClient); err != nil {
 		return err
 	}
-
-	if err := d.Set("comment", s.Comment.String); err != nil {
+	if err := d.Set("enabled", integration.Enabled); err != nil {
 		return err
 	}
-
-	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+	if err := d.Set("comment", integration.Comment); err != nil {
 		return err
 	}
-
-	// Some properties come from the DESCRIBE INTEGRATION call
-	// We need to grab them in a loop
-	var k, pType string
-	var v, unused interface{}
-	stmt = snowflake.NewOAuthIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return fmt.Errorf("could not describe security integration err = %w", err)
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return err
 	}
-	defer rows.Close()
-	for rows.Next() {
-		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows err = %w", err)
-		}
-		switch k {
-		case "ENABLED":
-			// We set this using the SHOW INTEGRATION call so let's ignore it here
-		case "COMMENT":
-			// We set this using the SHOW INTEGRATION call so let's ignore it here
-		case "OAUTH_ISSUE_REFRESH_TOKENS":
-			b, err := strconv.ParseBool(v.(string))
-			if err != nil {
-				return fmt.Errorf("returned OAuth issue refresh tokens that is not boolean err = %w", err)
+
+	// the redirect URI is not described for the clients that do not use it, so it is cleared unless returned
+	oauthRedirectUri := ""
+	for _, property := range properties {
+		switch property.Name {
+		case "OAUTH_REDIRECT_URI":
+			oauthRedirectUri = securityIntegrationStringProperty(property)
+		case "OAUTH_CLIENT_TYPE":
+			// the client type is predefined for partner applications
+			if oauthClient == "CUSTOM" {
+				if err := d.Set("oauth_client_type", property.Value); err != nil {
+					return err
+				}
 			}
-			if err := d.Set("oauth_issue_refresh_tokens", b); err != nil {
-				return fmt.Errorf("unable to set OAuth issue refresh tokens for security integration err = %w", err)
+		case "OAUTH_ISSUE_REFRESH_TOKENS":
+			if err := d.Set("oauth_issue_refresh_tokens", securityIntegrationBoolProperty(property)); err != nil {
+				return err
 			}
 		case "OAUTH_REFRESH_TOKEN_VALIDITY":
-			i, err := strconv.Atoi(v.(string))
+			if property.Value == "" {
+				continue
+			}
+			validity, err := strconv.Atoi(property.Value)
 			if err != nil {
 				return fmt.Errorf("returned OAuth refresh token validity that is not integer err = %w", err)
 			}
-			if err := d.Set("oauth_refresh_token_validity", i); err != nil {
-				return fmt.Errorf("unable to set OAuth refresh token validity for security integration err = %w", err)
+			if err := d.Set("oauth_refresh_token_validity", validity); err != nil {
+				return err
 			}
 		case "OAUTH_USE_SECONDARY_ROLES":
-			if err := d.Set("oauth_use_secondary_roles", v.(string)); err != nil {
-				return fmt.Errorf("unable to set OAuth use secondary roles for security integration err = %w", err)
+			if err := d.Set("oauth_use_secondary_roles", property.Value); err != nil {
+				return err
 			}
 		case "BLOCKED_ROLES_LIST":
-			blockedRolesAll := strings.Split(v.(string), ",")
-
-			// Only roles other than ACCOUNTADMIN, ORGADMIN and SECURITYADMIN can be specified custom,
-			// those three are enforced with no option to remove them
-			blockedRolesCustom := []string{}
-			for _, role := range blockedRolesAll {
-				if role != "ACCOUNTADMIN" && role != "ORGADMIN" && role != "SECURITYADMIN" {
-					blockedRolesCustom = append(blockedRolesCustom, role)
-				}
-			}
-
-			if err := d.Set("blocked_roles_list", blockedRolesCustom); err != nil {
-				return fmt.Errorf("unable to set blocked roles list for security integration err = %w", err)
-			}
-		case "OAUTH_REDIRECT_URI":
-			if err := d.Set("oauth_redirect_uri", v.(string)); err != nil {
-				return fmt.Errorf("unable to set OAuth redirect URI for security integration err = %w", err)
-			}
-		case "OAUTH_CLIENT_TYPE":
-			isTableau := strings.HasSuffix(s.IntegrationType.String, "TABLEAU_DESKTOP") ||
-				strings.HasSuffix(s.IntegrationType.String, "TABLEAU_SERVER")
-			if !isTableau {
-				if err = d.Set("oauth_client_type", v.(string)); err != nil {
-					return fmt.Errorf("unable to set OAuth client type for security integration err = %w", err)
-				}
+			if err := d.Set("blocked_roles_list", withoutSecurityIntegrationBuiltInRoles(parseSecurityIntegrationList(property.Value))); err != nil {
+				return err
 			}
-		case "OAUTH_ENFORCE_PKCE":
-			// Only used for custom OAuth clients (not supported yet)
-		case "OAUTH_AUTHORIZATION_ENDPOINT":
-			// Only used for custom OAuth clients (not supported yet)
-		case "OAUTH_TOKEN_ENDPOINT":
-			// Only used for custom OAuth clients (not supported yet)
-		case "OAUTH_ALLOWED_AUTHORIZATION_ENDPOINTS":
-			// Only used for custom OAuth clients (not supported yet)
-		case "OAUTH_ALLOWED_TOKEN_ENDPOINTS":
-			// Only used for custom OAuth clients (not supported yet)
-		case "PRE_AUTHORIZED_ROLES_LIST":
-			// Only used for custom OAuth clients (not supported yet)
-
-		default:
-			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
 	}
+	if err := d.Set("oauth_redirect_uri", oauthRedirectUri); err != nil {
+		return err
+	}
 
-	return err
+	return nil
 }
 
 // UpdateOAuthIntegration implements schema.UpdateFunc.
 func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewOAuthIntegrationBuilder(id).Alter()
-
-	var runSetStatement bool
-
-	if d.HasChange("oauth_client") {
-		runSetStatement = true
-		stmt.SetString(`OAUTH_CLIENT`, d.Get("oauth_client").(string))
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
 	}
+	ctx := context.Background()
+
+	// both OAuth integration kinds share the alterable properties, so the changes are collected once
+	var (
+		enabled, oauthIssueRefreshTokens          *bool
+		oauthRedirectUri, comment                 *string
+		oauthRefreshTokenValidity                 *int
+		oauthUseSecondaryRoles                    *sdk.OauthSecurityIntegrationUseSecondaryRolesOption
+		blockedRolesList                          *sdk.BlockedRolesList
+		runSet, runUnset                          bool
+		unsetOauthUseSecondaryRoles, unsetComment bool
+	)
 
 	if d.HasChange("oauth_redirect_uri") {
-		runSetStatement = true
-		stmt.SetString(`OAUTH_REDIRECT_URI`, d.Get("oauth_redirect_uri").(string))
-	}
-
-	if d.HasChange("oauth_client_type") {
-		runSetStatement = true
-		stmt.SetString(`OAUTH_CLIENT_TYPE`, d.Get("oauth_client_type").(string))
+		runSet = true
+		oauthRedirectUri = sdk.String(d.Get("oauth_redirect_uri").(string))
 	}
-
 	if d.HasChange("oauth_issue_refresh_tokens") {
-		runSetStatement = true
-		stmt.SetBool(`OAUTH_ISSUE_REFRESH_TOKENS`, d.Get("oauth_issue_refresh_tokens").(bool))
+		runSet = true
+		oauthIssueRefreshTokens = sdk.Bool(d.Get("oauth_issue_refresh_tokens").(bool))
 	}
-
 	if d.HasChange("oauth_refresh_token_validity") {
-		runSetStatement = true
-		stmt.SetInt(`OAUTH_REFRESH_TOKEN_VALIDITY`, d.Get("oauth_refresh_token_validity").(int))
+		runSet = true
+		oauthRefreshTokenValidity = sdk.Int(d.Get("oauth_refresh_token_validity").(int))
 	}
-
 	if d.HasChange("oauth_use_secondary_roles") {
-		runSetStatement = true
-		stmt.SetString(`OAUTH_USE_SECONDARY_ROLES`, d.Get("oauth_use_secondary_roles").(string))
+		if v, ok := d.GetOk("oauth_use_secondary_roles"); ok {
+			runSet = true
+			oauthUseSecondaryRoles = sdk.Pointer(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(v.(string)))
+		} else {
+			runUnset, unsetOauthUseSecondaryRoles = true, true
+		}
 	}
-
 	if d.HasChange("blocked_roles_list") {
-		runSetStatement = true
-		stmt.SetStringList(`BLOCKED_ROLES_LIST`, expandStringList(d.Get("blocked_roles_list").(*schema.Set).List()))
+		runSet = true
+		blockedRolesList = &sdk.BlockedRolesList{BlockedRolesList: expandAccountObjectIdentifiers(d.Get("blocked_roles_list").(*schema.Set))}
 	}
-
 	if d.HasChange("enabled") {
-		runSetStatement = true
-		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+		runSet = true
+		enabled = sdk.Bool(d.Get("enabled").(bool))
 	}
-
 	if d.HasChange("comment") {
-		runSetStatement = true
-		stmt.SetString(`COMMENT`, d.Get("comment").(string))
+		if v := d.Get("comment").(string); v != "" {
+			runSet = true
+			comment = sdk.String(v)
+		} else {
+			runUnset, unsetComment = true, true
+		}
 	}
 
-	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration err = %w", err)
+	if d.Get("oauth_client").(string) == "CUSTOM" {
+		if runSet {
+			set := sdk.NewOauthForCustomClientsIntegrationSetRequest().
+				WithEnabled(enabled).
+				WithOauthRedirectUri(oauthRedirectUri).
+				WithOauthIssueRefreshTokens(oauthIssueRefreshTokens).
+				WithOauthRefreshTokenValidity(oauthRefreshTokenValidity).
+				WithOauthUseSecondaryRoles(oauthUseSecondaryRoles).
+				WithBlockedRolesList(blockedRolesList).
+				WithComment(comment)
+			if err := client.SecurityIntegrations.AlterOauthForCustomClients(ctx, sdk.NewAlterOauthForCustomClientsSecurityIntegrationRequest(id).WithSet(set)); err != nil {
+				return fmt.Errorf("error updating OAuth integration %v err = %w", id.Name(), err)
+			}
+		}
+		if runUnset {
+			unset := sdk.NewOauthForCustomClientsIntegrationUnsetRequest()
+			if unsetOauthUseSecondaryRoles {
+				unset.WithOauthUseSecondaryRoles(sdk.Bool(true))
+			}
+			if unsetComment {
+				unset.WithComment(sdk.Bool(true))
+			}
+			if err := client.SecurityIntegrations.AlterOauthForCustomClients(ctx, sdk.NewAlterOauthForCustomClientsSecurityIntegrationRequest(id).WithUnset(unset)); err != nil {
+				return fmt.Errorf("error updating OAuth integration %v err = %w", id.Name(), err)
+			}
+		}
+	} else {
+		if runSet {
+			set := sdk.NewOauthForPartnerApplicationsIntegrationSetRequest().
+				WithEnabled(enabled).
+				WithOauthRedirectUri(oauthRedirectUri).
+				WithOauthIssueRefreshTokens(oauthIssueRefreshTokens).
+				WithOauthRefreshTokenValidity(oauthRefreshTokenValidity).
+				WithOauthUseSecondaryRoles(oauthUseSecondaryRoles).
+				WithBlockedRolesList(blockedRolesList).
+				WithComment(comment)
+			if err := client.SecurityIntegrations.AlterOauthForPartnerApplications(ctx, sdk.NewAlterOauthForPartnerApplicationsSecurityIntegrationRequest(id).WithSet(set)); err != nil {
+				return fmt.Errorf("error updating OAuth integration %v err = %w", id.Name(), err)
+			}
+		}
+		if runUnset {
+			unset := sdk.NewOauthForPartnerApplicationsIntegrationUnsetRequest()
+			if unsetOauthUseSecondaryRoles {
+				unset.WithOauthUseSecondaryRoles(sdk.Bool(true))
+			}
+			if unsetComment {
+				unset.WithComment(sdk.Bool(true))
+			}
+			if err := client.SecurityIntegrations.AlterOauthForPartnerApplications(ctx, sdk.NewAlterOauthForPartnerApplicationsSecurityIntegrationRequest(id).WithUnset(unset)); err != nil {
+				return fmt.Errorf("error updating OAuth integration %v err = %w", id.Name(), err)
+			}
 		}
 	}
 
@@ -334,5 +395,5 @@ func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteOAuthIntegration implements schema.DeleteFunc.
 func DeleteOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewOAuthIntegrationBuilder)(d, meta)
+	return deleteSecurityIntegration(d, meta)
 }
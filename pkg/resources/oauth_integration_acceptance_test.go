@@ -1,13 +1,18 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAcc_OAuthIntegration(t *testing.T) {
@@ -105,3 +110,87 @@ func oauthIntegrationConfigTableau(name, oauthClient, clientType string) string
 	}
 	`, name, oauthClient, clientType)
 }
+
+func TestAcc_OAuthIntegration_detectRedirectUriDrift(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: oauthIntegrationConfig(name, "CUSTOM", "PUBLIC", "SYSADMIN"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "oauth_redirect_uri", "https://www.example.com/oauth2/callback"),
+				),
+			},
+			{
+				PreConfig: func() {
+					alterOAuthIntegrationRedirectUriOutsideTerraform(t, name, "https://www.example.com/changed")
+				},
+				Config: oauthIntegrationConfig(name, "CUSTOM", "PUBLIC", "SYSADMIN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_oauth_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "oauth_redirect_uri", "https://www.example.com/oauth2/callback"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_OAuthIntegration_migrateFromVersion085(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"snowflake": {
+						VersionConstraint: "=0.85.0",
+						Source:            "Snowflake-Labs/snowflake",
+					},
+				},
+				Config: oauthIntegrationConfig(name, "CUSTOM", "PUBLIC", "SYSADMIN"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "id", name),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				Config:                   oauthIntegrationConfig(name, "CUSTOM", "PUBLIC", "SYSADMIN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "id", name),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "blocked_roles_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "blocked_roles_list.0", "SYSADMIN"),
+				),
+			},
+		},
+	})
+}
+
+func alterOAuthIntegrationRedirectUriOutsideTerraform(t *testing.T, name string, redirectUri string) {
+	t.Helper()
+
+	client, err := sdk.NewDefaultClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = client.SecurityIntegrations.AlterOauthForCustomClients(ctx, sdk.NewAlterOauthForCustomClientsSecurityIntegrationRequest(sdk.NewAccountObjectIdentifier(name)).
+		WithSet(sdk.NewOauthForCustomClientsIntegrationSetRequest().WithOauthRedirectUri(sdk.String(redirectUri))))
+	require.NoError(t, err)
+}
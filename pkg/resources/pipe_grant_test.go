@@ -37,7 +37,7 @@ func TestPipeGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT OPERATE ON PIPE "test-db"."PUBLIC"."test-pipe" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT OPERATE ON PIPE "test-db"."PUBLIC"."test-pipe" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadPipeGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
+		err := resources.CreatePipeGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -58,7 +58,7 @@ func TestPipeGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadPipeGrant(mock)
-		err := resources.ReadPipeGrant(d, db)
+		err := resources.ReadPipeGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -101,7 +101,7 @@ func TestFuturePipeGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE PIPES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFuturePipeGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
+		err := resources.CreatePipeGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -125,7 +125,7 @@ func TestFuturePipeGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE PIPES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFuturePipeDatabaseGrant(mock)
-		err := resources.CreatePipeGrant(d, db)
+		err := resources.CreatePipeGrant(d, MockProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"regexp"
-	"slices"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,14 +53,11 @@ var procedureSchema = map[string]*schema.Schema{
 					Description: "The argument name",
 				},
 				"type": {
-					Type:     schema.TypeString,
-					Required: true,
-					// Suppress the diff shown if the values are equal when both compared in lower case.
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						return strings.EqualFold(old, new)
-					},
-					ValidateFunc: IsDataType(),
-					Description:  "The argument type",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+					ValidateFunc:     IsDataType(),
+					Description:      "The argument type",
 				},
 			},
 		},
@@ -69,28 +66,11 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"return_type": {
-		Type:        schema.TypeString,
-		Description: "The return type of the procedure",
-		// Suppress the diff shown if the values are equal when both compared in lower case.
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			if strings.EqualFold(old, new) {
-				return true
-			}
-
-			varcharType := []string{"VARCHAR(16777216)", "VARCHAR", "text", "string", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"}
-			if slices.Contains(varcharType, strings.ToUpper(old)) && slices.Contains(varcharType, strings.ToUpper(new)) {
-				return true
-			}
-
-			// all these types are equivalent https://docs.snowflake.com/en/sql-reference/data-types-numeric.html#int-integer-bigint-smallint-tinyint-byteint
-			integerTypes := []string{"INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT", "NUMBER(38,0)"}
-			if slices.Contains(integerTypes, strings.ToUpper(old)) && slices.Contains(integerTypes, strings.ToUpper(new)) {
-				return true
-			}
-			return false
-		},
-		Required: true,
-		ForceNew: true,
+		Type:             schema.TypeString,
+		Description:      "The return type of the procedure",
+		DiffSuppressFunc: routineDataTypeDiffSuppressFunc,
+		Required:         true,
+		ForceNew:         true,
 	},
 	"statement": {
 		Type:             schema.TypeString,
@@ -169,6 +149,24 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The handler method for Java / Python procedures.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of external access integrations needed in order for this procedure's handler code to access external networks. Only valid for Java / Python procedures.",
+	},
+	"secrets": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Assigns the names of secrets to variables so that you can use the variables to reference the secrets when retrieving information from secrets in handler code. Keys are the variable names and values are the fully qualified names of the secrets (in the `\"<database>\".\"<schema>\".\"<secret>\"` format). Secrets must be allowed by one of the `external_access_integrations`. Only valid for Java / Python procedures.",
+	},
 }
 
 // Procedure returns a pointer to the resource representing a stored procedure.
@@ -183,7 +181,7 @@ func Procedure() *schema.Resource {
 
 		Schema: procedureSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportSchemaObjectIdentifierWithArguments,
 		},
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -222,8 +220,7 @@ func CreateContextProcedure(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func createJavaProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -272,6 +269,17 @@ func createJavaProcedure(ctx context.Context, d *schema.ResourceData, meta inter
 		req.WithImports(imports)
 	}
 
+	if _, ok := d.GetOk("external_access_integrations"); ok {
+		req.WithExternalAccessIntegrations(expandExternalAccessIntegrations(d))
+	}
+	if _, ok := d.GetOk("secrets"); ok {
+		secrets, err := expandSecretReferences(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithSecrets(secrets)
+	}
+
 	if err := client.Procedures.CreateForJava(ctx, req); err != nil {
 		return diag.FromErr(err)
 	}
@@ -285,8 +293,7 @@ func createJavaProcedure(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func createJavaScriptProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -338,8 +345,7 @@ func createJavaScriptProcedure(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func createScalaProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -401,8 +407,7 @@ func createScalaProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func createSQLProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -453,8 +458,7 @@ func createSQLProcedure(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func createPythonProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -511,6 +515,17 @@ func createPythonProcedure(ctx context.Context, d *schema.ResourceData, meta int
 		req.WithImports(imports)
 	}
 
+	if _, ok := d.GetOk("external_access_integrations"); ok {
+		req.WithExternalAccessIntegrations(expandExternalAccessIntegrations(d))
+	}
+	if _, ok := d.GetOk("secrets"); ok {
+		secrets, err := expandSecretReferences(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithSecrets(secrets)
+	}
+
 	if err := client.Procedures.CreateForPython(ctx, req); err != nil {
 		return diag.FromErr(err)
 	}
@@ -525,10 +540,12 @@ func createPythonProcedure(ctx context.Context, d *schema.ResourceData, meta int
 
 func ReadContextProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("name", id.Name()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -543,17 +560,20 @@ func ReadContextProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 	for i, arg := range args {
 		argTypes[i] = arg.(map[string]interface{})["type"].(string)
 	}
-	procedureDetails, err := client.Procedures.Describe(ctx, sdk.NewDescribeProcedureRequest(id.WithoutArguments(), id.Arguments()))
+	procedureDetails, err := client.Procedures.Describe(ctx, id)
 	if err != nil {
-		// if procedure is not found then mark resource to be removed from state file during apply or refresh
-		d.SetId("")
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Describe procedure failed.",
-				Detail:   fmt.Sprintf("Describe procedure failed: %v", err),
-			},
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// if procedure is not found then mark resource to be removed from state file during apply or refresh
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Describe procedure failed.",
+					Detail:   fmt.Sprintf("Describe procedure failed: %v", err),
+				},
+			}
 		}
+		return diag.FromErr(err)
 	}
 	for _, desc := range procedureDetails {
 		switch desc.Property {
@@ -622,6 +642,18 @@ func ReadContextProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 			if err := d.Set("handler", desc.Value); err != nil {
 				return diag.FromErr(err)
 			}
+		case "external_access_integrations":
+			if err := d.Set("external_access_integrations", parseExternalAccessIntegrationList(desc.Value)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "secrets":
+			secrets, err := parseSecretReferences(desc.Value)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return diag.FromErr(err)
+			}
 		default:
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
@@ -631,42 +663,35 @@ func ReadContextProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	request := sdk.NewShowProcedureRequest().WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&sdk.Like{Pattern: sdk.String(id.Name())})
-
-	procedures, err := client.Procedures.Show(ctx, request)
+	// procedure names can be overloaded with different argument types, ShowByID finds the one matching the identifier
+	procedure, err := client.Procedures.ShowByID(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	// procedure names can be overloaded with different argument types so we iterate over and find the correct one
-	// the ShowByID function should probably be updated to also require the list of arg types, like describe procedure
-	for _, procedure := range procedures {
-		argumentSignature := strings.Split(procedure.Arguments, " RETURN ")[0]
-		argumentSignature = strings.ReplaceAll(argumentSignature, " ", "")
-		if argumentSignature == id.ArgumentsSignature() {
-			if err := d.Set("secure", procedure.IsSecure); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set("comment", procedure.Description); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+	if err := d.Set("secure", procedure.IsSecure); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("comment", procedure.Description); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
 func UpdateContextProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if d.HasChange("name") {
 		name := d.Get("name")
-		err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithRenameTo(sdk.Pointer(sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), name.(string)))))
+		err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithRenameTo(sdk.Pointer(sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), name.(string)))))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		id = sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), name.(string), id.Arguments())
+		id = sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), name.(string), id.ArgumentDataTypes())
 		if err := d.Set("name", name); err != nil {
 			return diag.FromErr(err)
 		}
@@ -674,18 +699,18 @@ func UpdateContextProcedure(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		if comment != "" {
-			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithSetComment(sdk.String(comment.(string)))); err != nil {
+			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithSetComment(sdk.String(comment.(string)))); err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithUnsetComment(sdk.Bool(true))); err != nil {
+			if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithUnsetComment(sdk.Bool(true))); err != nil {
 				return diag.FromErr(err)
 			}
 		}
 	}
 	if d.HasChange("execute_as") {
 		executeAs := d.Get("execute_as")
-		if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments()).WithExecuteAs(sdk.Pointer(sdk.ExecuteAs(executeAs.(string))))); err != nil {
+		if err := client.Procedures.Alter(ctx, sdk.NewAlterProcedureRequest(id.SchemaObjectId(), id.ArgumentDataTypes()).WithExecuteAs(sdk.Pointer(sdk.ExecuteAs(executeAs.(string))))); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -693,11 +718,13 @@ func UpdateContextProcedure(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func DeleteContextProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 
-	id := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Id())
-	if err := client.Procedures.Drop(ctx, sdk.NewDropProcedureRequest(id.WithoutArguments(), id.Arguments())); err != nil {
+	id, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.Procedures.Drop(ctx, sdk.NewDropProcedureRequest(id.SchemaObjectId(), id.ArgumentDataTypes())); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
@@ -0,0 +1,529 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var replicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the replication group. The identifier must start with an alphabetic character and cannot contain spaces or special characters unless the identifier string is enclosed in double quotes (e.g. \"My object\"). Identifiers enclosed in double quotes are also case-sensitive.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"object_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of objects for which you are enabling replication from the source account to the target account. The following object types are supported: \"ACCOUNT PARAMETERS\", \"DATABASES\", \"INTEGRATIONS\", \"NETWORK POLICIES\", \"RESOURCE MONITORS\", \"ROLES\", \"SHARES\", \"USERS\", \"WAREHOUSES\"",
+	},
+	"allowed_databases": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the database or list of databases for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include DATABASES to set this parameter.",
+	},
+	"allowed_shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the share or list of shares for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include SHARES to set this parameter.",
+	},
+	"allowed_integration_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of integrations for which you are enabling replication from the source account to the target account. This property requires that the OBJECT_TYPES list include INTEGRATIONS to set this parameter. The following integration types are supported: \"SECURITY INTEGRATIONS\", \"API INTEGRATIONS\", \"NOTIFICATION INTEGRATIONS\"",
+	},
+	"allowed_accounts": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(allowedAccountRegexp, "allowed account must be of the format <org_name>.<target_account_name>"),
+		},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form <org_name>.<target_account_name>. The source account is always allowed implicitly and should not be listed.",
+	},
+	"ignore_edition_check": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Allows replicating objects to accounts on lower editions.",
+	},
+	"from_replica": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"object_types", "allowed_accounts", "allowed_databases", "allowed_shares", "allowed_integration_types", "ignore_edition_check", "replication_schedule"},
+		Description:   "Specifies the name of the primary replication group to use as the source for the secondary replication group.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of your Snowflake organization.",
+				},
+				"source_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Source account from which you are enabling replication of the specified objects.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Identifier for the primary replication group in the source account.",
+				},
+			},
+		},
+	},
+	"replication_schedule": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Specifies the schedule for refreshing secondary replication groups.",
+		ConflictsWith: []string{"from_replica"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"replication_schedule.0.interval"},
+					Description:   "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
+							},
+							"time_zone": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the time zone for secondary group refresh.",
+							},
+						},
+					},
+				},
+				"interval": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ConflictsWith: []string{"replication_schedule.0.cron"},
+					Description:   "Specifies the interval in minutes for the replication schedule. The interval must be greater than 0 and less than 1440 (24 hours).",
+				},
+			},
+		},
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether the replication group is the primary group.",
+	},
+	"secondary_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current state of scheduled refresh operations for a secondary replication group. Empty for the primary group.",
+	},
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group.
+func ReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateReplicationGroup,
+		Read:   ReadReplicationGroup,
+		Update: UpdateReplicationGroup,
+		Delete: DeleteReplicationGroup,
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateReplicationGroup implements schema.CreateFunc.
+func CreateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	// if from_replica is set, then we are creating a secondary replication group
+	if v, ok := d.GetOk("from_replica"); ok {
+		fromReplica := v.([]interface{})[0].(map[string]interface{})
+		primaryId := sdk.NewExternalObjectIdentifier(
+			sdk.NewAccountIdentifier(fromReplica["organization_name"].(string), fromReplica["source_account_name"].(string)),
+			sdk.NewAccountObjectIdentifier(fromReplica["name"].(string)),
+		)
+		if err := client.ReplicationGroups.CreateSecondary(ctx, sdk.NewCreateSecondaryReplicationGroupRequest(id, primaryId)); err != nil {
+			return fmt.Errorf("error creating secondary replication group %v err = %w", name, err)
+		}
+		d.SetId(name)
+		return ReadReplicationGroup(d, meta)
+	}
+
+	// these two are required attributes if from_replica is not set
+	if _, ok := d.GetOk("object_types"); !ok {
+		return errors.New("object_types is required when not creating from a replica")
+	}
+	if _, ok := d.GetOk("allowed_accounts"); !ok {
+		return errors.New("allowed_accounts is required when not creating from a replica")
+	}
+	objectTypes := expandPluralObjectTypes(d.Get("object_types").(*schema.Set))
+	allowedAccounts := accountIdentifiersFromSlice(expandStringList(d.Get("allowed_accounts").(*schema.Set).List()))
+
+	request := sdk.NewCreateReplicationGroupRequest(id, objectTypes, allowedAccounts)
+	if v, ok := d.GetOk("allowed_databases"); ok {
+		request.WithAllowedDatabases(expandAccountObjectIdentifiers(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("allowed_shares"); ok {
+		request.WithAllowedShares(expandAccountObjectIdentifiers(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("allowed_integration_types"); ok {
+		request.WithAllowedIntegrationTypes(expandIntegrationTypes(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("ignore_edition_check"); ok {
+		request.WithIgnoreEditionCheck(sdk.Bool(v.(bool)))
+	}
+	if v, ok := d.GetOk("replication_schedule"); ok {
+		request.WithReplicationSchedule(expandReplicationSchedule(v.([]interface{})))
+	}
+
+	if err := client.ReplicationGroups.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating replication group %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+	return ReadReplicationGroup(d, meta)
+}
+
+// ReadReplicationGroup implements schema.ReadFunc.
+func ReadReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] replication group (%s) not found", name)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("name", replicationGroup.Name); err != nil {
+		return err
+	}
+	if err := d.Set("is_primary", replicationGroup.IsPrimary); err != nil {
+		return err
+	}
+	secondaryState := ""
+	if replicationGroup.SecondaryState != sdk.ReplicationGroupSecondaryStateNull {
+		secondaryState = string(replicationGroup.SecondaryState)
+	}
+	if err := d.Set("secondary_state", secondaryState); err != nil {
+		return err
+	}
+
+	// the configuration of a secondary replication group is inherited from the primary group
+	if !replicationGroup.IsPrimary {
+		return nil
+	}
+
+	replicationSchedule, err := flattenReplicationSchedule(replicationGroup.ReplicationSchedule)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("replication_schedule", replicationSchedule); err != nil {
+		return err
+	}
+
+	objectTypes := make([]interface{}, len(replicationGroup.ObjectTypes))
+	for i, v := range replicationGroup.ObjectTypes {
+		objectTypes[i] = string(v)
+	}
+	if err := d.Set("object_types", schema.NewSet(schema.HashString, objectTypes)); err != nil {
+		return err
+	}
+
+	allowedIntegrationTypes := make([]interface{}, len(replicationGroup.AllowedIntegrationTypes))
+	for i, v := range replicationGroup.AllowedIntegrationTypes {
+		allowedIntegrationTypes[i] = string(v)
+	}
+	if err := d.Set("allowed_integration_types", schema.NewSet(schema.HashString, allowedIntegrationTypes)); err != nil {
+		return err
+	}
+
+	// the source account is always listed in the allowed accounts, so it is skipped unless it was configured explicitly
+	sourceAccount := sdk.NewAccountIdentifier(replicationGroup.OrganizationName, replicationGroup.AccountName).Name()
+	configuredAccounts := expandStringList(d.Get("allowed_accounts").(*schema.Set).List())
+	allowedAccounts := make([]interface{}, 0, len(replicationGroup.AllowedAccounts))
+	for _, v := range replicationGroup.AllowedAccounts {
+		if strings.EqualFold(v.Name(), sourceAccount) && !slices.ContainsFunc(configuredAccounts, func(account string) bool {
+			return strings.EqualFold(account, sourceAccount)
+		}) {
+			continue
+		}
+		allowedAccounts = append(allowedAccounts, v.Name())
+	}
+	if err := d.Set("allowed_accounts", schema.NewSet(schema.HashString, allowedAccounts)); err != nil {
+		return err
+	}
+
+	databases, err := client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(id))
+	if err != nil {
+		return err
+	}
+	allowedDatabases := make([]interface{}, len(databases))
+	for i, database := range databases {
+		allowedDatabases[i] = database.Name()
+	}
+	if err := d.Set("allowed_databases", schema.NewSet(schema.HashString, allowedDatabases)); err != nil {
+		return err
+	}
+
+	shares, err := client.ReplicationGroups.ShowShares(ctx, sdk.NewShowSharesReplicationGroupRequest(id))
+	if err != nil {
+		return err
+	}
+	allowedShares := make([]interface{}, len(shares))
+	for i, share := range shares {
+		allowedShares[i] = share.Name()
+	}
+	if err := d.Set("allowed_shares", schema.NewSet(schema.HashString, allowedShares)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateReplicationGroup implements schema.UpdateFunc.
+func UpdateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	set := sdk.NewReplicationGroupSetRequest()
+	runSet := false
+
+	if d.HasChange("object_types") {
+		objectTypes := expandPluralObjectTypes(d.Get("object_types").(*schema.Set))
+		set.WithObjectTypes(objectTypes)
+		// allowed integration types have to be passed together with the INTEGRATIONS object type
+		if slices.Contains(objectTypes, sdk.PluralObjectTypeIntegrations) {
+			set.WithAllowedIntegrationTypes(expandIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set)))
+		}
+		runSet = true
+	}
+
+	if d.HasChange("allowed_integration_types") {
+		set.WithAllowedIntegrationTypes(expandIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set)))
+		runSet = true
+	}
+
+	if d.HasChange("replication_schedule") {
+		set.WithReplicationSchedule(expandReplicationSchedule(d.Get("replication_schedule").([]interface{})))
+		runSet = true
+	}
+
+	if runSet {
+		if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating replication group %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("allowed_databases") {
+		o, n := d.GetChange("allowed_databases")
+		removed, added := diffAccountObjectIdentifiers(expandAccountObjectIdentifiers(o.(*schema.Set)), expandAccountObjectIdentifiers(n.(*schema.Set)))
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedDatabases(removed))); err != nil {
+				return fmt.Errorf("error removing allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(sdk.NewReplicationGroupAddRequest().WithAllowedDatabases(added))); err != nil {
+				return fmt.Errorf("error adding allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_shares") {
+		o, n := d.GetChange("allowed_shares")
+		removed, added := diffAccountObjectIdentifiers(expandAccountObjectIdentifiers(o.(*schema.Set)), expandAccountObjectIdentifiers(n.(*schema.Set)))
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedShares(removed))); err != nil {
+				return fmt.Errorf("error removing allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(sdk.NewReplicationGroupAddRequest().WithAllowedShares(added))); err != nil {
+				return fmt.Errorf("error adding allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_accounts") {
+		o, n := d.GetChange("allowed_accounts")
+		oldAccounts := accountIdentifiersFromSlice(expandStringList(o.(*schema.Set).List()))
+		newAccounts := accountIdentifiersFromSlice(expandStringList(n.(*schema.Set).List()))
+
+		var removed []sdk.AccountIdentifier
+		for _, v := range oldAccounts {
+			if !slices.Contains(newAccounts, v) {
+				removed = append(removed, v)
+			}
+		}
+		var added []sdk.AccountIdentifier
+		for _, v := range newAccounts {
+			if !slices.Contains(oldAccounts, v) {
+				added = append(added, v)
+			}
+		}
+
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRemove(sdk.NewReplicationGroupRemoveRequest().WithAllowedAccounts(removed))); err != nil {
+				return fmt.Errorf("error removing allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			add := sdk.NewReplicationGroupAddRequest().WithAllowedAccounts(added)
+			if d.Get("ignore_edition_check").(bool) {
+				add.WithIgnoreEditionCheck(sdk.Bool(true))
+			}
+			if err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(add)); err != nil {
+				return fmt.Errorf("error adding allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	return ReadReplicationGroup(d, meta)
+}
+
+// DeleteReplicationGroup implements schema.DeleteFunc.
+func DeleteReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return fmt.Errorf("error deleting replication group %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+var allowedAccountRegexp = regexp.MustCompile(`^[^.]+\.[^.]+$`)
+
+func expandPluralObjectTypes(set *schema.Set) []sdk.PluralObjectType {
+	values := expandStringList(set.List())
+	objectTypes := make([]sdk.PluralObjectType, len(values))
+	for i, v := range values {
+		objectTypes[i] = sdk.PluralObjectType(v)
+	}
+	return objectTypes
+}
+
+func expandIntegrationTypes(set *schema.Set) []sdk.IntegrationType {
+	values := expandStringList(set.List())
+	integrationTypes := make([]sdk.IntegrationType, len(values))
+	for i, v := range values {
+		integrationTypes[i] = sdk.IntegrationType(v)
+	}
+	return integrationTypes
+}
+
+func expandAccountObjectIdentifiers(set *schema.Set) []sdk.AccountObjectIdentifier {
+	values := expandStringList(set.List())
+	ids := make([]sdk.AccountObjectIdentifier, len(values))
+	for i, v := range values {
+		ids[i] = sdk.NewAccountObjectIdentifier(v)
+	}
+	return ids
+}
+
+func diffAccountObjectIdentifiers(oldIds, newIds []sdk.AccountObjectIdentifier) (removed, added []sdk.AccountObjectIdentifier) {
+	for _, v := range oldIds {
+		if !slices.Contains(newIds, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range newIds {
+		if !slices.Contains(oldIds, v) {
+			added = append(added, v)
+		}
+	}
+	return removed, added
+}
+
+func expandReplicationSchedule(v []interface{}) *string {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	replicationSchedule := v[0].(map[string]interface{})
+	if c, ok := replicationSchedule["cron"].([]interface{}); ok && len(c) > 0 {
+		cron := c[0].(map[string]interface{})
+		return sdk.String(fmt.Sprintf("USING CRON %s %s", cron["expression"].(string), cron["time_zone"].(string)))
+	}
+	if interval, ok := replicationSchedule["interval"].(int); ok && interval > 0 {
+		return sdk.String(fmt.Sprintf("%d MINUTE", interval))
+	}
+	return nil
+}
+
+func flattenReplicationSchedule(replicationSchedule string) ([]interface{}, error) {
+	if replicationSchedule == "" {
+		return nil, nil
+	}
+	if strings.HasSuffix(replicationSchedule, " MINUTE") {
+		interval, err := strconv.Atoi(strings.TrimSuffix(replicationSchedule, " MINUTE"))
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{
+			map[string]interface{}{
+				"interval": interval,
+			},
+		}, nil
+	}
+	parts := strings.Split(replicationSchedule, " ")
+	timeZone := parts[len(parts)-1]
+	expression := strings.TrimSuffix(strings.TrimPrefix(replicationSchedule, "USING CRON "), " "+timeZone)
+	return []interface{}{
+		map[string]interface{}{
+			"cron": []interface{}{
+				map[string]interface{}{
+					"expression": expression,
+					"time_zone":  timeZone,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,110 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ReplicationGroup_basic(t *testing.T) {
+	accountName, ok := os.LookupEnv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT")
+	if !ok {
+		t.Skip("Skipping TestAcc_ReplicationGroup_basic since no target account is set")
+	}
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_replication_group.test"
+	m := func(objectTypes []string, allowedDatabases []string, interval int) config.Variables {
+		objectTypeVariables := make([]config.Variable, len(objectTypes))
+		for i, v := range objectTypes {
+			objectTypeVariables[i] = config.StringVariable(v)
+		}
+		allowedDatabaseVariables := make([]config.Variable, len(allowedDatabases))
+		for i, v := range allowedDatabases {
+			allowedDatabaseVariables[i] = config.StringVariable(v)
+		}
+		return config.Variables{
+			"name":              config.StringVariable(name),
+			"object_types":      config.SetVariable(objectTypeVariables...),
+			"allowed_accounts":  config.SetVariable(config.StringVariable(accountName)),
+			"allowed_databases": config.SetVariable(allowedDatabaseVariables...),
+			"interval":          config.IntegerVariable(interval),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ReplicationGroup/basic"),
+				ConfigVariables: m([]string{"DATABASES"}, []string{acc.TestDatabaseName}, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "object_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_accounts.*", accountName),
+					resource.TestCheckResourceAttr(resourceName, "allowed_databases.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_schedule.0.interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "is_primary", "true"),
+				),
+			},
+			// CHANGE PROPERTIES IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_ReplicationGroup/basic"),
+				ConfigVariables: m([]string{"DATABASES", "ROLES"}, []string{}, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_databases.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_schedule.0.interval", "20"),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:         acc.ConfigurationDirectory("TestAcc_ReplicationGroup/basic"),
+				ConfigVariables:         m([]string{"DATABASES", "ROLES"}, []string{}, 20),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationGroupDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_replication_group" {
+			continue
+		}
+		ctx := context.Background()
+		id := sdk.NewAccountObjectIdentifier(rs.Primary.ID)
+		existingReplicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("replication group %v still exists", existingReplicationGroup.Name)
+		}
+	}
+	return nil
+}
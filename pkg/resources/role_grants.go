@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,7 +78,7 @@ func RoleGrants() *schema.Resource {
 }
 
 func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
@@ -90,13 +91,13 @@ func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(grantID)
 
 	for _, role := range roles {
-		if err := grantRoleToRole(db, roleName, role); err != nil {
+		if err := grantRoleToRole(client, roleName, role); err != nil {
 			return err
 		}
 	}
 
 	for _, user := range users {
-		if err := grantRoleToUser(db, roleName, user); err != nil {
+		if err := grantRoleToUser(client, roleName, user); err != nil {
 			return err
 		}
 	}
@@ -104,15 +105,15 @@ func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return ReadRoleGrants(d, meta)
 }
 
-func grantRoleToRole(db *sql.DB, role1, role2 string) error {
+func grantRoleToRole(client *sdk.Client, role1, role2 string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.Role(role2).Grant())
+	err := snowflake.Exec(client.GetConn().DB, g.Role(role2).Grant())
 	return err
 }
 
-func grantRoleToUser(db *sql.DB, role1, user string) error {
+func grantRoleToUser(client *sdk.Client, role1, user string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.User(user).Grant())
+	err := snowflake.Exec(client.GetConn().DB, g.User(user).Grant())
 	return err
 }
 
@@ -125,7 +126,7 @@ type roleGrant struct {
 }
 
 func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	roleName := d.Get("role_name").(string)
 
 	roles := make([]string, 0)
@@ -210,20 +211,20 @@ func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
 }
 
 func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	for _, role := range roles {
-		if err := revokeRoleFromRole(db, roleName, role); err != nil {
+		if err := revokeRoleFromRole(client, roleName, role); err != nil {
 			return err
 		}
 	}
 
 	for _, user := range users {
-		if err := revokeRoleFromUser(db, roleName, user); err != nil {
+		if err := revokeRoleFromUser(client, roleName, user); err != nil {
 			return err
 		}
 	}
@@ -232,7 +233,8 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
+func revokeRoleFromRole(client *sdk.Client, role1, role2 string) error {
+	db := client.GetConn().DB
 	rg := snowflake.RoleGrant(role1).Role(role2)
 	err := snowflake.Exec(db, rg.Revoke())
 	log.Printf("revokeRoleFromRole %v", err)
@@ -255,12 +257,11 @@ func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
 	return err
 }
 
-func revokeRoleFromUser(db *sql.DB, role1, user string) error {
+func revokeRoleFromUser(client *sdk.Client, role1, user string) error {
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	rg := snowflake.RoleGrant(role1).User(user)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.Exec(client.GetConn().DB, rg.Revoke())
 	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
 		// handling error if a user has been deleted prior to revoking a role
 		// 002003 (02000): SQL compilation error:
@@ -283,10 +284,10 @@ func revokeRoleFromUser(db *sql.DB, role1, user string) error {
 }
 
 func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 
-	x := func(resource string, grant func(db *sql.DB, role string, target string) error, revoke func(db *sql.DB, role string, target string) error) error {
+	x := func(resource string, grant func(client *sdk.Client, role string, target string) error, revoke func(client *sdk.Client, role string, target string) error) error {
 		o, n := d.GetChange(resource)
 
 		if o == nil {
@@ -302,12 +303,12 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		add := expandStringList(ns.Difference(os).List())
 
 		for _, user := range remove {
-			if err := revoke(db, roleName, user); err != nil {
+			if err := revoke(client, roleName, user); err != nil {
 				return err
 			}
 		}
 		for _, user := range add {
-			if err := grant(db, roleName, user); err != nil {
+			if err := grant(client, roleName, user); err != nil {
 				return err
 			}
 		}
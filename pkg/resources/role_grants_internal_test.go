@@ -14,7 +14,7 @@ func Test_grantToRole(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToRole(db, "foo", "bar")
+		err := grantRoleToRole(MockProviderContext(db).Client, "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -24,7 +24,7 @@ func Test_grantToUser(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToUser(db, "foo", "bar")
+		err := grantRoleToUser(MockProviderContext(db).Client, "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -48,7 +48,7 @@ func Test_revokeRoleFromRole(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromRole(db, "foo", "bar")
+		err := revokeRoleFromRole(MockProviderContext(db).Client, "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -57,7 +57,7 @@ func Test_revokeRoleFromUser(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromUser(db, "foo", "bar")
+		err := revokeRoleFromUser(MockProviderContext(db).Client, "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -33,7 +33,7 @@ func TestRoleGrantsCreate(t *testing.T) {
 		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRoleGrants(mock)
-		err := resources.CreateRoleGrants(d, db)
+		err := resources.CreateRoleGrants(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -65,7 +65,7 @@ func TestRoleGrantsRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		r.NotEmpty(d.State())
 		expectReadRoleGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, MockProviderContext(db))
 		r.NotEmpty(d.State())
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
@@ -87,7 +87,7 @@ func TestRoleGrantsDelete(t *testing.T) {
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM ROLE "role2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRoleGrants(d, db)
+		err := resources.DeleteRoleGrants(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -121,7 +121,7 @@ func TestIgnoreUnknownRoleGrants(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Make sure that extraneous grants are ignored.
 		expectReadUnhandledRoleGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, MockProviderContext(db))
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
 		r.Len(d.Get("roles").(*schema.Set).List(), 2)
@@ -29,7 +29,7 @@ func TestRoleOwnershipGrantCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON ROLE "good_name" TO ROLE "other_good_name" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRoleOwnershipGrant(mock)
-		err := resources.CreateRoleOwnershipGrant(d, db)
+		err := resources.CreateRoleOwnershipGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -45,7 +45,7 @@ func TestRoleOwnershipGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRoleOwnershipGrant(mock)
-		err := resources.ReadRoleOwnershipGrant(d, db)
+		err := resources.ReadRoleOwnershipGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -77,7 +77,7 @@ func TestRoleOwnershipGrantDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON ROLE "good_name" TO ROLE "ACCOUNTADMIN" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRoleOwnershipGrant(d, db)
+		err := resources.DeleteRoleOwnershipGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
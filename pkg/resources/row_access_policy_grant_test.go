@@ -36,7 +36,7 @@ func TestRowAccessPolicyGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON ROW ACCESS POLICY "test-db"."PUBLIC"."test-row-access-policy" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON ROW ACCESS POLICY "test-db"."PUBLIC"."test-row-access-policy" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRowAccessPolicyGrant(mock)
-		err := resources.CreateRowAccessPolicyGrant(d, db)
+		err := resources.CreateRowAccessPolicyGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -57,7 +57,7 @@ func TestRowAccessPolicyGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadRowAccessPolicyGrant(mock)
-		err := resources.ReadRowAccessPolicyGrant(d, db)
+		err := resources.ReadRowAccessPolicyGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
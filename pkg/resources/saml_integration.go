@@ -1,13 +1,16 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -140,6 +143,8 @@ var samlIntegrationSchema = map[string]*schema.Schema{
 // SAMLIntegration returns a pointer to the resource representing a SAML2 security integration.
 func SAMLIntegration() *schema.Resource {
 	return &schema.Resource{
+		SchemaVersion: 1,
+
 		Create: CreateSAMLIntegration,
 		Read:   ReadSAMLIntegration,
 		Update: UpdateSAMLIntegration,
@@ -147,322 +152,250 @@ func SAMLIntegration() *schema.Resource {
 
 		Schema: samlIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v0SecurityIntegrationStateUpgrader([]string{"saml2_provider"}, nil),
+			},
 		},
 	}
 }
 
 // CreateSAMLIntegration implements schema.CreateFunc.
 func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
 
-	stmt := snowflake.NewSamlIntegrationBuilder(name).Create()
-
-	// Set required fields
-	stmt.SetRaw(`TYPE=SAML2`)
-	stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
-	stmt.SetString(`SAML2_ISSUER`, d.Get("saml2_issuer").(string))
-	stmt.SetString(`SAML2_SSO_URL`, d.Get("saml2_sso_url").(string))
-	stmt.SetString(`SAML2_PROVIDER`, d.Get("saml2_provider").(string))
-
-	// Set optional fields
-	if _, ok := d.GetOk("saml2_x509_cert"); ok {
-		stmt.SetString(`SAML2_X509_CERT`, d.Get("saml2_x509_cert").(string))
+	createRequest := sdk.NewCreateSaml2SecurityIntegrationRequest(
+		id,
+		d.Get("enabled").(bool),
+		d.Get("saml2_issuer").(string),
+		d.Get("saml2_sso_url").(string),
+		sdk.Saml2SecurityIntegrationSaml2ProviderOption(strings.ToUpper(d.Get("saml2_provider").(string))),
+		d.Get("saml2_x509_cert").(string),
+	)
+	if v, ok := d.GetOk("saml2_sp_initiated_login_page_label"); ok {
+		createRequest.WithSaml2SpInitiatedLoginPageLabel(sdk.String(v.(string)))
 	}
-
-	if _, ok := d.GetOk("saml2_sp_initiated_login_page_label"); ok {
-		stmt.SetString(`SAML2_SP_INITIATED_LOGIN_PAGE_LABEL`, d.Get("saml2_sp_initiated_login_page_label").(string))
+	if v, ok := d.GetOk("saml2_enable_sp_initiated"); ok {
+		createRequest.WithSaml2EnableSpInitiated(sdk.Bool(v.(bool)))
 	}
-
-	if _, ok := d.GetOk("saml2_enable_sp_initiated"); ok {
-		stmt.SetBool(`SAML2_ENABLE_SP_INITIATED`, d.Get("saml2_enable_sp_initiated").(bool))
+	if v, ok := d.GetOk("saml2_snowflake_x509_cert"); ok {
+		createRequest.WithSaml2SnowflakeX509Cert(sdk.String(v.(string)))
 	}
-
-	if _, ok := d.GetOk("saml2_snowflake_x509_cert"); ok {
-		stmt.SetString(`SAML2_SNOWFLAKE_X509_CERT`, d.Get("saml2_snowflake_x509_cert").(string))
+	if v, ok := d.GetOk("saml2_sign_request"); ok {
+		createRequest.WithSaml2SignRequest(sdk.Bool(v.(bool)))
 	}
-
-	if _, ok := d.GetOk("saml2_sign_request"); ok {
-		stmt.SetBool(`SAML2_SIGN_REQUEST`, d.Get("saml2_sign_request").(bool))
+	if v, ok := d.GetOk("saml2_requested_nameid_format"); ok {
+		createRequest.WithSaml2RequestedNameidFormat(sdk.String(v.(string)))
 	}
-
-	if _, ok := d.GetOk("saml2_requested_nameid_format"); ok {
-		stmt.SetString(`SAML2_REQUESTED_NAMEID_FORMAT`, d.Get("saml2_requested_nameid_format").(string))
+	if v, ok := d.GetOk("saml2_post_logout_redirect_url"); ok {
+		createRequest.WithSaml2PostLogoutRedirectUrl(sdk.String(v.(string)))
 	}
-
-	if _, ok := d.GetOk("saml2_post_logout_redirect_url"); ok {
-		stmt.SetString(`SAML2_POST_LOGOUT_REDIRECT_URL`, d.Get("saml2_post_logout_redirect_url").(string))
+	if v, ok := d.GetOk("saml2_force_authn"); ok {
+		createRequest.WithSaml2ForceAuthn(sdk.Bool(v.(bool)))
 	}
-
-	if _, ok := d.GetOk("saml2_force_authn"); ok {
-		stmt.SetBool(`SAML2_FORCE_AUTHN`, d.Get("saml2_force_authn").(bool))
+	if v, ok := d.GetOk("saml2_snowflake_issuer_url"); ok {
+		createRequest.WithSaml2SnowflakeIssuerUrl(sdk.String(v.(string)))
 	}
-
-	if _, ok := d.GetOk("saml2_snowflake_issuer_url"); ok {
-		stmt.SetString(`SAML2_SNOWFLAKE_ISSUER_URL`, d.Get("saml2_snowflake_issuer_url").(string))
+	if v, ok := d.GetOk("saml2_snowflake_acs_url"); ok {
+		createRequest.WithSaml2SnowflakeAcsUrl(sdk.String(v.(string)))
 	}
 
-	if _, ok := d.GetOk("saml2_snowflake_acs_url"); ok {
-		stmt.SetString(`SAML2_SNOWFLAKE_ACS_URL`, d.Get("saml2_snowflake_acs_url").(string))
+	ctx := context.Background()
+	if err := client.SecurityIntegrations.CreateSaml2(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating SAML2 integration %v err = %w", name, err)
 	}
 
-	err := snowflake.Exec(db, stmt.Statement())
-	if err != nil {
-		return fmt.Errorf("error creating security integration err = %w", err)
-	}
-
-	d.SetId(name)
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
 	return ReadSAMLIntegration(d, meta)
 }
 
 // ReadSAMLIntegration implements schema.ReadFunc.
 func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewSamlIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
-
-	// Some properties can come from the SHOW INTEGRATION call
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	s, err := snowflake.ScanSamlIntegration(row)
+	ctx := context.Background()
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not show security integration err = %w", err)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] SAML2 integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
-	// Note: category must be Security or something is broken
-	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+	if c := integration.Category; c != "SECURITY" {
+		return fmt.Errorf("expected %v to be a SECURITY integration, got %v", d.Id(), c)
+	}
+	if t := integration.IntegrationType; t != "SAML2" {
+		return fmt.Errorf("expected %v to be a SAML2 integration, got %v", d.Id(), t)
 	}
 
-	// Note: type must be SAML2 or something is broken
-	if c := s.IntegrationType.String; c != "SAML2" {
-		return fmt.Errorf("expected %v to be a SAML2 integration type, got %v", id, c)
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not describe SAML2 integration (%s), err = %w", d.Id(), err)
 	}
 
-	if err := d.Set("name", s.Name.String); err != nil {
+	if err := d.Set("name", integration.Name); err != nil {
 		return err
 	}
-
-	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+	if err := d.Set("enabled", integration.Enabled); err != nil {
 		return err
 	}
-
-	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
 		return err
 	}
 
-	// Some properties come from the DESCRIBE INTEGRATION call
-	// We need to grab them in a loop
-	var k, pType string
-	var v, unused interface{}
-	stmt = snowflake.NewSamlIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return fmt.Errorf("could not describe security integration err = %w", err)
-	}
-	defer rows.Close()
-	for rows.Next() {
-		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows err = %w", err)
-		}
-		switch k {
-		case "ENABLED":
-			// set using the SHOW INTEGRATION, ignoring here
+	for _, property := range properties {
+		switch property.Name {
 		case "SAML2_ISSUER":
-			if err := d.Set("saml2_issuer", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_issuer for security integration err = %w", err)
-			}
+			err = d.Set("saml2_issuer", property.Value)
 		case "SAML2_SSO_URL":
-			if err := d.Set("saml2_sso_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_sso_url for security integration err = %w", err)
-			}
+			err = d.Set("saml2_sso_url", property.Value)
 		case "SAML2_PROVIDER":
-			if err := d.Set("saml2_provider", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_provider for security integration err = %w", err)
-			}
+			err = d.Set("saml2_provider", strings.ToUpper(property.Value))
 		case "SAML2_X509_CERT":
-			if err := d.Set("saml2_x509_cert", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_x509_cert for security integration err = %w", err)
-			}
+			err = d.Set("saml2_x509_cert", property.Value)
 		case "SAML2_SP_INITIATED_LOGIN_PAGE_LABEL":
-			if err := d.Set("saml2_sp_initiated_login_page_label", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_sp_initiated_login_page_label for security integration")
-			}
+			err = d.Set("saml2_sp_initiated_login_page_label", property.Value)
 		case "SAML2_ENABLE_SP_INITIATED":
-			var b bool
-			switch v2 := v.(type) {
-			case bool:
-				b = v2
-			case string:
-				b, err = strconv.ParseBool(v.(string))
-				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
-				}
-			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean")
-			}
-			if err := d.Set("saml2_enable_sp_initiated", b); err != nil {
-				return fmt.Errorf("unable to set saml2_enable_sp_initiated for security integration err = %w", err)
-			}
+			err = d.Set("saml2_enable_sp_initiated", securityIntegrationBoolProperty(property))
 		case "SAML2_SNOWFLAKE_X509_CERT":
-			if err := d.Set("saml2_snowflake_x509_cert", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_x509_cert for security integration err = %w", err)
-			}
+			err = d.Set("saml2_snowflake_x509_cert", property.Value)
 		case "SAML2_SIGN_REQUEST":
-			var b bool
-			switch v2 := v.(type) {
-			case bool:
-				b = v2
-			case string:
-				b, err = strconv.ParseBool(v.(string))
-				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
-				}
-			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
-			}
-			if err := d.Set("saml2_sign_request", b); err != nil {
-				return fmt.Errorf("unable to set saml2_sign_request for security integration err = %w", err)
-			}
+			err = d.Set("saml2_sign_request", securityIntegrationBoolProperty(property))
 		case "SAML2_REQUESTED_NAMEID_FORMAT":
-			if err := d.Set("saml2_requested_nameid_format", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_requested_nameid_format for security integration err = %w", err)
-			}
+			err = d.Set("saml2_requested_nameid_format", property.Value)
 		case "SAML2_POST_LOGOUT_REDIRECT_URL":
-			if err := d.Set("saml2_post_logout_redirect_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_post_logout_redirect_url for security integration err = %w", err)
-			}
+			err = d.Set("saml2_post_logout_redirect_url", property.Value)
 		case "SAML2_FORCE_AUTHN":
-			var b bool
-			switch v2 := v.(type) {
-			case bool:
-				b = v2
-			case string:
-				b, err = strconv.ParseBool(v.(string))
-				if err != nil {
-					return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
-				}
-			default:
-				return fmt.Errorf("returned saml2_force_authn that is not boolean err = %w", err)
-			}
-			if err := d.Set("saml2_force_authn", b); err != nil {
-				return fmt.Errorf("unable to set saml2_force_authn for security integration err = %w", err)
-			}
+			err = d.Set("saml2_force_authn", securityIntegrationBoolProperty(property))
 		case "SAML2_SNOWFLAKE_ISSUER_URL":
-			if err := d.Set("saml2_snowflake_issuer_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_issuer_url for security integration err = %w", err)
-			}
+			err = d.Set("saml2_snowflake_issuer_url", property.Value)
 		case "SAML2_SNOWFLAKE_ACS_URL":
-			if err := d.Set("saml2_snowflake_acs_url", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_acs_url for security integration err = %w", err)
-			}
+			err = d.Set("saml2_snowflake_acs_url", property.Value)
 		case "SAML2_SNOWFLAKE_METADATA":
-			if err := d.Set("saml2_snowflake_metadata", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_snowflake_metadata for security integration err = %w", err)
-			}
+			err = d.Set("saml2_snowflake_metadata", property.Value)
 		case "SAML2_DIGEST_METHODS_USED":
-			if err := d.Set("saml2_digest_methods_used", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_digest_methods_used for security integration err = %w", err)
-			}
+			err = d.Set("saml2_digest_methods_used", property.Value)
 		case "SAML2_SIGNATURE_METHODS_USED":
-			if err := d.Set("saml2_signature_methods_used", v.(string)); err != nil {
-				return fmt.Errorf("unable to set saml2_signature_methods_used for security integration err = %w", err)
-			}
-		case "COMMENT":
-			// COMMENT cannot be set according to snowflake docs, so ignoring
-		default:
-			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
+			err = d.Set("saml2_signature_methods_used", property.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to set %v for SAML2 integration (%s), err = %w", strings.ToLower(property.Name), d.Id(), err)
 		}
 	}
 
-	return err
+	return nil
 }
 
 // UpdateSAMLIntegration implements schema.UpdateFunc.
 func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewSamlIntegrationBuilder(id).Alter()
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
 
-	var runSetStatement bool
+	set, runSet := sdk.NewSaml2IntegrationSetRequest(), false
+	unset, runUnset := sdk.NewSaml2IntegrationUnsetRequest(), false
 
 	if d.HasChange("enabled") {
-		runSetStatement = true
-		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+		runSet = true
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
 	}
-
 	if d.HasChange("saml2_issuer") {
-		runSetStatement = true
-		stmt.SetString(`SAML2_ISSUER`, d.Get("saml2_issuer").(string))
+		runSet = true
+		set.WithSaml2Issuer(sdk.String(d.Get("saml2_issuer").(string)))
 	}
-
 	if d.HasChange("saml2_sso_url") {
-		runSetStatement = true
-		stmt.SetString(`saml2_sso_url`, d.Get("saml2_sso_url").(string))
+		runSet = true
+		set.WithSaml2SsoUrl(sdk.String(d.Get("saml2_sso_url").(string)))
 	}
-
 	if d.HasChange("saml2_provider") {
-		runSetStatement = true
-		stmt.SetString(`saml2_provider`, d.Get("saml2_provider").(string))
+		runSet = true
+		set.WithSaml2Provider(sdk.Pointer(sdk.Saml2SecurityIntegrationSaml2ProviderOption(strings.ToUpper(d.Get("saml2_provider").(string)))))
 	}
-
 	if d.HasChange("saml2_x509_cert") {
-		runSetStatement = true
-		stmt.SetString(`saml2_x509_cert`, d.Get("saml2_x509_cert").(string))
+		runSet = true
+		set.WithSaml2X509Cert(sdk.String(d.Get("saml2_x509_cert").(string)))
 	}
-
 	if d.HasChange("saml2_sp_initiated_login_page_label") {
-		runSetStatement = true
-		stmt.SetString(`saml2_sp_initiated_login_page_label`, d.Get("saml2_sp_initiated_login_page_label").(string))
+		runSet = true
+		set.WithSaml2SpInitiatedLoginPageLabel(sdk.String(d.Get("saml2_sp_initiated_login_page_label").(string)))
 	}
-
 	if d.HasChange("saml2_enable_sp_initiated") {
-		runSetStatement = true
-		stmt.SetBool(`saml2_enable_sp_initiated`, d.Get("saml2_enable_sp_initiated").(bool))
+		runSet = true
+		set.WithSaml2EnableSpInitiated(sdk.Bool(d.Get("saml2_enable_sp_initiated").(bool)))
 	}
-
 	if d.HasChange("saml2_snowflake_x509_cert") {
-		runSetStatement = true
-		stmt.SetString(`saml2_snowflake_x509_cert`, d.Get("saml2_snowflake_x509_cert").(string))
+		if v, ok := d.GetOk("saml2_snowflake_x509_cert"); ok {
+			runSet = true
+			set.WithSaml2SnowflakeX509Cert(sdk.String(v.(string)))
+		}
 	}
-
 	if d.HasChange("saml2_sign_request") {
-		runSetStatement = true
-		stmt.SetBool(`saml2_sign_request`, d.Get("saml2_sign_request").(bool))
+		runSet = true
+		set.WithSaml2SignRequest(sdk.Bool(d.Get("saml2_sign_request").(bool)))
 	}
-
 	if d.HasChange("saml2_requested_nameid_format") {
-		runSetStatement = true
-		stmt.SetString(`saml2_requested_nameid_format`, d.Get("saml2_requested_nameid_format").(string))
+		if v, ok := d.GetOk("saml2_requested_nameid_format"); ok {
+			runSet = true
+			set.WithSaml2RequestedNameidFormat(sdk.String(v.(string)))
+		} else {
+			runUnset = true
+			unset.WithSaml2RequestedNameidFormat(sdk.Bool(true))
+		}
 	}
-
 	if d.HasChange("saml2_post_logout_redirect_url") {
-		runSetStatement = true
-		stmt.SetString(`saml2_post_logout_redirect_url`, d.Get("saml2_post_logout_redirect_url").(string))
+		if v, ok := d.GetOk("saml2_post_logout_redirect_url"); ok {
+			runSet = true
+			set.WithSaml2PostLogoutRedirectUrl(sdk.String(v.(string)))
+		} else {
+			runUnset = true
+			unset.WithSaml2PostLogoutRedirectUrl(sdk.Bool(true))
+		}
 	}
-
 	if d.HasChange("saml2_force_authn") {
-		runSetStatement = true
-		stmt.SetBool(`saml2_force_authn`, d.Get("saml2_force_authn").(bool))
+		runSet = true
+		set.WithSaml2ForceAuthn(sdk.Bool(d.Get("saml2_force_authn").(bool)))
 	}
-
 	if d.HasChange("saml2_snowflake_issuer_url") {
-		runSetStatement = true
-		stmt.SetString(`saml2_snowflake_issuer_url`, d.Get("saml2_snowflake_issuer_url").(string))
+		if v, ok := d.GetOk("saml2_snowflake_issuer_url"); ok {
+			runSet = true
+			set.WithSaml2SnowflakeIssuerUrl(sdk.String(v.(string)))
+		}
 	}
-
 	if d.HasChange("saml2_snowflake_acs_url") {
-		runSetStatement = true
-		stmt.SetString(`saml2_snowflake_acs_url`, d.Get("saml2_snowflake_acs_url").(string))
+		if v, ok := d.GetOk("saml2_snowflake_acs_url"); ok {
+			runSet = true
+			set.WithSaml2SnowflakeAcsUrl(sdk.String(v.(string)))
+		}
 	}
 
-	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration err = %w", err)
+	if runSet {
+		if err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating SAML2 integration %v err = %w", id.Name(), err)
+		}
+	}
+	if runUnset {
+		if err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating SAML2 integration %v err = %w", id.Name(), err)
 		}
 	}
 
@@ -471,5 +404,5 @@ func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteSAMLIntegration implements schema.DeleteFunc.
 func DeleteSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewSamlIntegrationBuilder)(d, meta)
+	return deleteSecurityIntegration(d, meta)
 }
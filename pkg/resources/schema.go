@@ -2,13 +2,14 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,18 +70,16 @@ func Schema() *schema.Resource {
 		Delete: DeleteSchema,
 		Schema: schemaSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.DatabaseObjectIdentifier],
 		},
 	}
 }
 
 // CreateSchema implements schema.CreateFunc.
 func CreateSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
-
-	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 
 	createReq := &sdk.CreateSchemaOptions{
@@ -107,21 +106,31 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSchema implements schema.ReadFunc.
 func ReadSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	database, err := client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier(id.DatabaseName()))
 	if err != nil {
-		d.SetId("")
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] database of schema (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
 	s, err := client.Schemas.ShowByID(ctx, id)
 	if err != nil {
-		log.Printf("[DEBUG] schema (%s) not found", d.Id())
-		d.SetId("")
-		return nil
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] schema (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
 	var retentionTime int64
@@ -181,9 +190,11 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateSchema implements schema.UpdateFunc.
 func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	id, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	if d.HasChange("name") {
@@ -275,12 +286,14 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteSchema implements schema.DeleteFunc.
 func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.DatabaseObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.Schemas.Drop(ctx, id, new(sdk.DropSchemaOptions))
+	err = client.Schemas.Drop(ctx, id, new(sdk.DropSchemaOptions))
 	if err != nil {
 		return fmt.Errorf("error deleting schema %v err = %w", d.Id(), err)
 	}
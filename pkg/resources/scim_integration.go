@@ -1,12 +1,16 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -21,6 +25,7 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 	"scim_client": {
 		Type:        schema.TypeString,
 		Required:    true,
+		ForceNew:    true,
 		Description: "Specifies the client type for the scim integration",
 		ValidateFunc: validation.StringInSlice([]string{
 			"OKTA", "AZURE", "GENERIC",
@@ -35,6 +40,7 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 	"provisioner_role": {
 		Type:        schema.TypeString,
 		Required:    true,
+		ForceNew:    true,
 		Description: "Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"OKTA_PROVISIONER", "AAD_PROVISIONER", "GENERIC_SCIM_PROVISIONER",
@@ -58,9 +64,11 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 	},
 }
 
-// SCIMIntegration returns a pointer to the resource representing a network policy.
+// SCIMIntegration returns a pointer to the resource representing a SCIM security integration.
 func SCIMIntegration() *schema.Resource {
 	return &schema.Resource{
+		SchemaVersion: 1,
+
 		Create: CreateSCIMIntegration,
 		Read:   ReadSCIMIntegration,
 		Update: UpdateSCIMIntegration,
@@ -68,135 +76,121 @@ func SCIMIntegration() *schema.Resource {
 
 		Schema: scimIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
+		},
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting type to cty.EmptyObject is a bit hacky here but following https://developer.hashicorp.com/terraform/plugin/framework/migrating/resources/state-upgrade#sdkv2-1 would require lots of repetitive code; this should work with cty.EmptyObject
+				Type:    cty.EmptyObject,
+				Upgrade: v0SecurityIntegrationStateUpgrader([]string{"scim_client", "provisioner_role"}, nil),
+			},
 		},
 	}
 }
 
 // CreateSCIMIntegration implements schema.CreateFunc.
 func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	name := d.Get("name").(string)
-
-	stmt := snowflake.NewSCIMIntegrationBuilder(name).Create()
-
-	// Set required fields
-	stmt.SetRaw(`TYPE=SCIM`)
-	stmt.SetString(`SCIM_CLIENT`, d.Get("scim_client").(string))
-	stmt.SetString(`RUN_AS_ROLE`, d.Get("provisioner_role").(string))
-
-	// Set optional fields
-	if _, ok := d.GetOk("network_policy"); ok {
-		stmt.SetString(`NETWORK_POLICY`, d.Get("network_policy").(string))
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	createRequest := sdk.NewCreateScimSecurityIntegrationRequest(
+		id,
+		sdk.ScimSecurityIntegrationScimClientOption(strings.ToUpper(d.Get("scim_client").(string))),
+		sdk.ScimSecurityIntegrationRunAsRoleOption(strings.ToUpper(d.Get("provisioner_role").(string))),
+	)
+	if v, ok := d.GetOk("network_policy"); ok {
+		createRequest.WithNetworkPolicy(sdk.String(v.(string)))
 	}
 
-	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-		return fmt.Errorf("error creating security integration")
+	ctx := context.Background()
+	if err := client.SecurityIntegrations.CreateScim(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating SCIM integration %v err = %w", name, err)
 	}
 
-	d.SetId(name)
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
 	return ReadSCIMIntegration(d, meta)
 }
 
 // ReadSCIMIntegration implements schema.ReadFunc.
 func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewSCIMIntegrationBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
-
-	// Some properties can come from the SHOW INTEGRATION call
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	s, err := snowflake.ScanScimIntegration(row)
+	ctx := context.Background()
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("could not show security integration")
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] SCIM integration (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
-	// Note: category must be Security or something is broken
-	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+	if c := integration.Category; c != "SECURITY" {
+		return fmt.Errorf("expected %v to be a SECURITY integration, got %v", d.Id(), c)
+	}
+	if !strings.HasPrefix(integration.IntegrationType, "SCIM - ") {
+		return fmt.Errorf("expected %v to be a SCIM integration, got %v", d.Id(), integration.IntegrationType)
 	}
 
-	if err := d.Set("scim_client", strings.TrimPrefix(s.IntegrationType.String, "SCIM - ")); err != nil {
-		return err
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not describe SCIM integration (%s), err = %w", d.Id(), err)
 	}
 
-	if err := d.Set("name", s.Name.String); err != nil {
+	if err := d.Set("name", integration.Name); err != nil {
 		return err
 	}
-
-	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+	if err := d.Set("scim_client", strings.TrimPrefix(integration.IntegrationType, "SCIM - ")); err != nil {
 		return err
 	}
-
-	// Some properties come from the DESCRIBE INTEGRATION call
-	// We need to grab them in a loop
-	var k, pType string
-	var v, unused interface{}
-	stmt = snowflake.NewSCIMIntegrationBuilder(id).Describe()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return fmt.Errorf("could not describe security integration")
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return err
 	}
-	defer rows.Close()
-	for rows.Next() {
-		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return fmt.Errorf("unable to parse security integration rows")
-		}
-		switch k {
+
+	for _, property := range properties {
+		switch property.Name {
 		case "NETWORK_POLICY":
-			if err := d.Set("network_policy", v.(string)); err != nil {
-				return fmt.Errorf("unable to set network policy for security integration")
+			if err := d.Set("network_policy", securityIntegrationStringProperty(property)); err != nil {
+				return err
 			}
 		case "RUN_AS_ROLE":
-			if err := d.Set("provisioner_role", v.(string)); err != nil {
-				return fmt.Errorf("unable to set provisioner role for security integration")
+			if err := d.Set("provisioner_role", property.Value); err != nil {
+				return err
 			}
-		default:
-			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	return nil
 }
 
 // UpdateSCIMIntegration implements schema.UpdateFunc.
 func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewSCIMIntegrationBuilder(id).Alter()
-
-	var runSetStatement bool
-
-	if d.HasChange("scim_client") {
-		runSetStatement = true
-		stmt.SetString(`SCIM_CLIENT`, d.Get("scim_client").(string))
-	}
-
-	if d.HasChange("provisioner_role") {
-		runSetStatement = true
-		stmt.SetString(`RUN_AS_ROLE`, d.Get("provisioner_role").(string))
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
 	}
+	ctx := context.Background()
 
-	// We need to UNSET this if we remove all api blocked prefixes.
 	if d.HasChange("network_policy") {
-		v := d.Get("network_policy").(string)
-		if len(v) == 0 {
-			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET NETWORK_POLICY`, id)); err != nil {
-				return fmt.Errorf("error unsetting network_policy")
+		if v := d.Get("network_policy").(string); v != "" {
+			if err := client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithSet(sdk.NewScimIntegrationSetRequest().WithNetworkPolicy(sdk.String(v)))); err != nil {
+				return fmt.Errorf("error updating SCIM integration %v err = %w", id.Name(), err)
 			}
 		} else {
-			runSetStatement = true
-			stmt.SetString(`NETWORK_POLICY`, d.Get("network_policy").(string))
-		}
-	}
-
-	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration")
+			if err := client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithUnset(sdk.NewScimIntegrationUnsetRequest().WithNetworkPolicy(sdk.Bool(true)))); err != nil {
+				return fmt.Errorf("error updating SCIM integration %v err = %w", id.Name(), err)
+			}
 		}
 	}
 
@@ -205,5 +199,5 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteSCIMIntegration implements schema.DeleteFunc.
 func DeleteSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NewSCIMIntegrationBuilder)(d, meta)
+	return deleteSecurityIntegration(d, meta)
 }
@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secretCommonSchema contains the fields shared by all snowflake_secret_with_* resources.
+var secretCommonSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the database and schema in which the secret is created.",
+		ForceNew:    true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the secret.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the secret.",
+		ForceNew:    true,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"secret_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the type of the secret, as returned by Snowflake.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the secret.",
+	},
+}
+
+// secretSchema merges the common secret fields with the fields specific to the given secret type.
+func secretSchema(specific map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(secretCommonSchema)+len(specific))
+	for k, v := range secretCommonSchema {
+		result[k] = v
+	}
+	for k, v := range specific {
+		result[k] = v
+	}
+	return result
+}
+
+func secretIdFromSchema(d *schema.ResourceData) sdk.SchemaObjectIdentifier {
+	return sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+}
+
+// readSecretCommon sets the common fields of the secret and returns its details. A nil result means the secret does not exist anymore.
+func readSecretCommon(d *schema.ResourceData, meta interface{}) (*sdk.Secret, *sdk.SecretDetails, error) {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := context.Background()
+	secret, err := client.Secrets.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] secret (%s) not found", d.Id())
+			d.SetId("")
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	secretDetails, err := client.Secrets.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := d.Set("name", secret.Name); err != nil {
+		return nil, nil, err
+	}
+	if err := d.Set("database", secret.DatabaseName); err != nil {
+		return nil, nil, err
+	}
+	if err := d.Set("schema", secret.SchemaName); err != nil {
+		return nil, nil, err
+	}
+	comment := ""
+	if secret.Comment != nil {
+		comment = *secret.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return nil, nil, err
+	}
+	if err := d.Set("secret_type", secret.SecretType); err != nil {
+		return nil, nil, err
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return nil, nil, err
+	}
+
+	return secret, secretDetails, nil
+}
+
+// updateSecretComment sets or unsets the comment of the secret if it has changed.
+func updateSecretComment(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("comment") {
+		return nil
+	}
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	alterRequest := sdk.NewAlterSecretRequest(objectIdentifier)
+	if comment := d.Get("comment").(string); comment != "" {
+		alterRequest.WithSet(sdk.NewSecretSetRequest().WithComment(sdk.String(comment)))
+	} else {
+		alterRequest.WithUnset(sdk.NewSecretUnsetRequest().WithComment(sdk.Bool(true)))
+	}
+	if err := client.Secrets.Alter(context.Background(), alterRequest); err != nil {
+		return fmt.Errorf("error updating comment for secret %v err = %w", objectIdentifier.Name(), err)
+	}
+	return nil
+}
+
+// DeleteSecret implements schema.DeleteFunc for all snowflake_secret_with_* resources.
+func DeleteSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(objectIdentifier)); err != nil {
+		return fmt.Errorf("error deleting secret %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
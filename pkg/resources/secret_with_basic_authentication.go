@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithBasicAuthenticationSchema = secretSchema(map[string]*schema.Schema{
+	"username": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the username value to store in the secret.",
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the password value to store in the secret. The value is never returned by Snowflake, so changes made outside of Terraform are not detected.",
+	},
+})
+
+// SecretWithBasicAuthentication returns a pointer to the resource representing a PASSWORD secret.
+func SecretWithBasicAuthentication() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSecretWithBasicAuthentication,
+		Read:   ReadSecretWithBasicAuthentication,
+		Update: UpdateSecretWithBasicAuthentication,
+		Delete: DeleteSecret,
+
+		Schema: secretWithBasicAuthenticationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateSecretWithBasicAuthentication implements schema.CreateFunc.
+func CreateSecretWithBasicAuthentication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier := secretIdFromSchema(d)
+
+	createRequest := sdk.NewCreateWithBasicAuthenticationSecretRequest(objectIdentifier, d.Get("username").(string), d.Get("password").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.Secrets.CreateWithBasicAuthentication(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadSecretWithBasicAuthentication(d, meta)
+}
+
+// ReadSecretWithBasicAuthentication implements schema.ReadFunc.
+func ReadSecretWithBasicAuthentication(d *schema.ResourceData, meta interface{}) error {
+	secret, secretDetails, err := readSecretCommon(d, meta)
+	if err != nil || secret == nil {
+		return err
+	}
+
+	if secretDetails.Username != nil {
+		if err := d.Set("username", *secretDetails.Username); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateSecretWithBasicAuthentication implements schema.UpdateFunc.
+func UpdateSecretWithBasicAuthentication(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("username", "password") {
+		set := sdk.NewSecretSetRequest()
+		if d.HasChange("username") {
+			set.WithUsername(sdk.String(d.Get("username").(string)))
+		}
+		if d.HasChange("password") {
+			set.WithPassword(sdk.String(d.Get("password").(string)))
+		}
+		if err := client.Secrets.Alter(context.Background(), sdk.NewAlterSecretRequest(objectIdentifier).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating credentials for secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if err := updateSecretComment(d, meta); err != nil {
+		return err
+	}
+
+	return ReadSecretWithBasicAuthentication(d, meta)
+}
@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithBasicAuthentication_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_secret_with_basic_authentication.test"
+	m := func(username string, password string, comment string) config.Variables {
+		return config.Variables{
+			"name":     config.StringVariable(name),
+			"database": config.StringVariable(acc.TestDatabaseName),
+			"schema":   config.StringVariable(acc.TestSchemaName),
+			"username": config.StringVariable(username),
+			"password": config.StringVariable(password),
+			"comment":  config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SecretWithBasicAuthentication/basic"),
+				ConfigVariables: m("user", "pass", "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "password", "pass"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", string(sdk.SecretTypePassword)),
+				),
+			},
+			// CHANGE CREDENTIALS AND COMMENT IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SecretWithBasicAuthentication/basic"),
+				ConfigVariables: m("other_user", "other_pass", "other comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", "other_user"),
+					resource.TestCheckResourceAttr(resourceName, "password", "other_pass"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_SecretWithBasicAuthentication/basic"),
+				ConfigVariables:   m("other_user", "other_pass", "other comment"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the password is never returned by Snowflake
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
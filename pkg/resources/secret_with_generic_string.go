@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithGenericStringSchema = secretSchema(map[string]*schema.Schema{
+	"secret_string": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the string to store in the secret. The value is never returned by Snowflake, so changes made outside of Terraform are not detected.",
+	},
+})
+
+// SecretWithGenericString returns a pointer to the resource representing a GENERIC_STRING secret.
+func SecretWithGenericString() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSecretWithGenericString,
+		Read:   ReadSecretWithGenericString,
+		Update: UpdateSecretWithGenericString,
+		Delete: DeleteSecret,
+
+		Schema: secretWithGenericStringSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateSecretWithGenericString implements schema.CreateFunc.
+func CreateSecretWithGenericString(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier := secretIdFromSchema(d)
+
+	createRequest := sdk.NewCreateWithGenericStringSecretRequest(objectIdentifier, d.Get("secret_string").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.Secrets.CreateWithGenericString(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadSecretWithGenericString(d, meta)
+}
+
+// ReadSecretWithGenericString implements schema.ReadFunc.
+func ReadSecretWithGenericString(d *schema.ResourceData, meta interface{}) error {
+	_, _, err := readSecretCommon(d, meta)
+	return err
+}
+
+// UpdateSecretWithGenericString implements schema.UpdateFunc.
+func UpdateSecretWithGenericString(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("secret_string") {
+		alterRequest := sdk.NewAlterSecretRequest(objectIdentifier).
+			WithSet(sdk.NewSecretSetRequest().WithSecretString(sdk.String(d.Get("secret_string").(string))))
+		if err := client.Secrets.Alter(context.Background(), alterRequest); err != nil {
+			return fmt.Errorf("error updating SECRET_STRING for secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if err := updateSecretComment(d, meta); err != nil {
+		return err
+	}
+
+	return ReadSecretWithGenericString(d, meta)
+}
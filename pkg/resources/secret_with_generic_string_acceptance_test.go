@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithGenericString_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_secret_with_generic_string.test"
+	m := func(secretString string, comment string) config.Variables {
+		return config.Variables{
+			"name":          config.StringVariable(name),
+			"database":      config.StringVariable(acc.TestDatabaseName),
+			"schema":        config.StringVariable(acc.TestSchemaName),
+			"secret_string": config.StringVariable(secretString),
+			"comment":       config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SecretWithGenericString/basic"),
+				ConfigVariables: m("secret", "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "secret_string", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", string(sdk.SecretTypeGenericString)),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+			// CHANGE SECRET STRING AND UNSET COMMENT IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SecretWithGenericString/basic"),
+				ConfigVariables: m("other secret", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_string", "other secret"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_SecretWithGenericString/basic"),
+				ConfigVariables:   m("other secret", ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the secret value is never returned by Snowflake
+				ImportStateVerifyIgnore: []string{"secret_string"},
+			},
+		},
+	})
+}
+
+func testAccCheckSecretDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "snowflake_secret_with_") {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.SchemaObjectIdentifier)
+		existingSecret, err := client.Secrets.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("secret %v still exists", existingSecret.Name)
+		}
+	}
+	return nil
+}
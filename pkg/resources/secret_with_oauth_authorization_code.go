@@ -0,0 +1,118 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithOAuthAuthorizationCodeSchema = secretSchema(map[string]*schema.Schema{
+	"api_authentication": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the name of the security integration that connects Snowflake to the external service.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"oauth_refresh_token": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires. The value is never returned by Snowflake, so changes made outside of Terraform are not detected.",
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the timestamp as a string when the OAuth refresh token expires. Accepted string formats: YYYY-MM-DD, YYYY-MM-DD HH:MI, YYYY-MM-DD HH:MI:SS, YYYY-MM-DD HH:MI <timezone>.",
+	},
+})
+
+// SecretWithOAuthAuthorizationCode returns a pointer to the resource representing an OAUTH2 secret using the authorization code grant flow.
+func SecretWithOAuthAuthorizationCode() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSecretWithOAuthAuthorizationCode,
+		Read:   ReadSecretWithOAuthAuthorizationCode,
+		Update: UpdateSecretWithOAuthAuthorizationCode,
+		Delete: DeleteSecret,
+
+		Schema: secretWithOAuthAuthorizationCodeSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateSecretWithOAuthAuthorizationCode implements schema.CreateFunc.
+func CreateSecretWithOAuthAuthorizationCode(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier := secretIdFromSchema(d)
+	apiAuthentication := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("api_authentication").(string))
+
+	createRequest := sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(
+		objectIdentifier,
+		d.Get("oauth_refresh_token").(string),
+		d.Get("oauth_refresh_token_expiry_time").(string),
+		apiAuthentication,
+	)
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.Secrets.CreateWithOAuthAuthorizationCodeFlow(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadSecretWithOAuthAuthorizationCode(d, meta)
+}
+
+// ReadSecretWithOAuthAuthorizationCode implements schema.ReadFunc.
+// The refresh token expiry time is returned in a normalized timestamp format, so the configured value is kept in the state.
+func ReadSecretWithOAuthAuthorizationCode(d *schema.ResourceData, meta interface{}) error {
+	secret, secretDetails, err := readSecretCommon(d, meta)
+	if err != nil || secret == nil {
+		return err
+	}
+
+	if secretDetails.IntegrationName != nil {
+		if err := d.Set("api_authentication", *secretDetails.IntegrationName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateSecretWithOAuthAuthorizationCode implements schema.UpdateFunc.
+func UpdateSecretWithOAuthAuthorizationCode(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("oauth_refresh_token", "oauth_refresh_token_expiry_time") {
+		set := sdk.NewSecretSetRequest()
+		if d.HasChange("oauth_refresh_token") {
+			set.WithOauthRefreshToken(sdk.String(d.Get("oauth_refresh_token").(string)))
+		}
+		if d.HasChange("oauth_refresh_token_expiry_time") {
+			set.WithOauthRefreshTokenExpiryTime(sdk.String(d.Get("oauth_refresh_token_expiry_time").(string)))
+		}
+		if err := client.Secrets.Alter(context.Background(), sdk.NewAlterSecretRequest(objectIdentifier).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating refresh token for secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if err := updateSecretComment(d, meta); err != nil {
+		return err
+	}
+
+	return ReadSecretWithOAuthAuthorizationCode(d, meta)
+}
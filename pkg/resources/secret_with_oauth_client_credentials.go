@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithOAuthClientCredentialsSchema = secretSchema(map[string]*schema.Schema{
+	"api_authentication": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the name of the security integration that connects Snowflake to the external service.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"oauth_scopes": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of scopes to use when making a request from the OAuth server by a role with USAGE on the integration during the OAuth client credentials flow. When not set, the scopes of the security integration are used.",
+	},
+})
+
+// SecretWithOAuthClientCredentials returns a pointer to the resource representing an OAUTH2 secret using the client credentials flow.
+func SecretWithOAuthClientCredentials() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateSecretWithOAuthClientCredentials,
+		Read:   ReadSecretWithOAuthClientCredentials,
+		Update: UpdateSecretWithOAuthClientCredentials,
+		Delete: DeleteSecret,
+		// scopes cannot be unset, the secret has to be recreated to fall back to the scopes of the integration
+		CustomizeDiff: customdiff.ForceNewIfChange("oauth_scopes", func(ctx context.Context, old, new, meta any) bool {
+			return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+		}),
+
+		Schema: secretWithOAuthClientCredentialsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateSecretWithOAuthClientCredentials implements schema.CreateFunc.
+func CreateSecretWithOAuthClientCredentials(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier := secretIdFromSchema(d)
+	apiAuthentication := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("api_authentication").(string))
+
+	createRequest := sdk.NewCreateWithOAuthClientCredentialsFlowSecretRequest(objectIdentifier, apiAuthentication)
+	if v, ok := d.GetOk("oauth_scopes"); ok {
+		createRequest.WithOauthScopes(expandSecretScopes(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.Secrets.CreateWithOAuthClientCredentialsFlow(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadSecretWithOAuthClientCredentials(d, meta)
+}
+
+// ReadSecretWithOAuthClientCredentials implements schema.ReadFunc.
+func ReadSecretWithOAuthClientCredentials(d *schema.ResourceData, meta interface{}) error {
+	secret, secretDetails, err := readSecretCommon(d, meta)
+	if err != nil || secret == nil {
+		return err
+	}
+
+	if secretDetails.IntegrationName != nil {
+		if err := d.Set("api_authentication", *secretDetails.IntegrationName); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("oauth_scopes", secret.OauthScopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSecretWithOAuthClientCredentials implements schema.UpdateFunc.
+func UpdateSecretWithOAuthClientCredentials(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("oauth_scopes") {
+		set := sdk.NewSecretSetRequest().WithOauthScopes(expandSecretScopes(d.Get("oauth_scopes").(*schema.Set).List()))
+		if err := client.Secrets.Alter(context.Background(), sdk.NewAlterSecretRequest(objectIdentifier).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating OAUTH_SCOPES for secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if err := updateSecretComment(d, meta); err != nil {
+		return err
+	}
+
+	return ReadSecretWithOAuthClientCredentials(d, meta)
+}
+
+func expandSecretScopes(scopes []interface{}) []sdk.SecretScope {
+	secretScopes := make([]sdk.SecretScope, len(scopes))
+	for i, scope := range scopes {
+		secretScopes[i] = sdk.SecretScope{Scope: scope.(string)}
+	}
+	return secretScopes
+}
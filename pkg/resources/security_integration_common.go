@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// securityIntegrationBoolProperty parses a boolean property returned by DESCRIBE SECURITY INTEGRATION; an empty value is treated as false.
+func securityIntegrationBoolProperty(property sdk.SecurityIntegrationProperty) bool {
+	return strings.EqualFold(property.Value, "true")
+}
+
+// securityIntegrationStringProperty returns the value of a string property returned by DESCRIBE SECURITY INTEGRATION; unset properties are described as null.
+func securityIntegrationStringProperty(property sdk.SecurityIntegrationProperty) string {
+	if property.Value == "null" {
+		return ""
+	}
+	return property.Value
+}
+
+// parseSecurityIntegrationList parses list properties returned by DESCRIBE SECURITY INTEGRATION, e.g. A,B or ['a', 'b'];
+// an empty list is described as an empty string or [].
+func parseSecurityIntegrationList(value string) []string {
+	value = strings.TrimSpace(strings.Trim(value, "[]"))
+	if value == "" {
+		return []string{}
+	}
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.Trim(strings.TrimSpace(part), `'"`); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// withoutSecurityIntegrationBuiltInRoles removes the roles that Snowflake blocks implicitly from the given list, so they do not cause in-place updates.
+func withoutSecurityIntegrationBuiltInRoles(roles []string) []string {
+	result := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role != "ACCOUNTADMIN" && role != "ORGADMIN" && role != "SECURITYADMIN" {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
+// deleteSecurityIntegration drops the security integration of any type; it is shared by all security integration resources.
+func deleteSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.SecurityIntegrations.Drop(ctx, sdk.NewDropSecurityIntegrationRequest(id)); err != nil {
+		return fmt.Errorf("error deleting security integration %v err = %w", id.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// v0SecurityIntegrationStateUpgrader migrates the state written by the security integration resources built on the legacy
+// pkg/snowflake builders. The id is re-encoded from the integration name, enum attributes are upper-cased to match the SDK
+// constants that are read back, and role lists drop the empty entries and the implicitly blocked roles stored by the old parser.
+func v0SecurityIntegrationStateUpgrader(enumAttributes []string, roleListAttributes []string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		if name, ok := rawState["name"].(string); ok && name != "" {
+			rawState["id"] = helpers.EncodeSnowflakeID(sdk.NewAccountObjectIdentifier(name))
+		}
+
+		for _, attribute := range enumAttributes {
+			if v, ok := rawState[attribute].(string); ok {
+				rawState[attribute] = strings.ToUpper(v)
+			}
+		}
+
+		for _, attribute := range roleListAttributes {
+			v, ok := rawState[attribute].([]interface{})
+			if !ok {
+				continue
+			}
+			roles := make([]string, 0, len(v))
+			for _, role := range v {
+				if role, ok := role.(string); ok && role != "" {
+					roles = append(roles, role)
+				}
+			}
+			roles = withoutSecurityIntegrationBuiltInRoles(roles)
+			upgraded := make([]interface{}, len(roles))
+			for i, role := range roles {
+				upgraded[i] = role
+			}
+			rawState[attribute] = upgraded
+		}
+
+		return rawState, nil
+	}
+}
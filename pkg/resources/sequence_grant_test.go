@@ -37,7 +37,7 @@ func TestSequenceGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSequenceGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
+		err := resources.CreateSequenceGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -58,7 +58,7 @@ func TestSequenceGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSequenceGrant(mock)
-		err := resources.ReadSequenceGrant(d, db)
+		err := resources.ReadSequenceGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -101,7 +101,7 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE SEQUENCES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureSequenceGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
+		err := resources.CreateSequenceGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -125,7 +125,7 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE SEQUENCES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureSequenceDatabaseGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
+		err := resources.CreateSequenceGrant(d, MockProviderContext(db))
 		b.NoError(err)
 	})
 }
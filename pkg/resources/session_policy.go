@@ -0,0 +1,232 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var sessionPolicySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database this session policy belongs to.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema this session policy belongs to.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Identifier for the session policy; must be unique for the database and schema in which the session policy is created.",
+	},
+	"session_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a session can be idle before users must authenticate to Snowflake again. Applies to Snowflake clients and programmatic clients. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a Snowsight or Classic Console session can be idle before users must authenticate to Snowflake again. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the session policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the session policy.",
+	},
+}
+
+// SessionPolicy returns a pointer to the resource representing a session policy.
+func SessionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "A session policy defines the idle session timeout period in minutes for Snowflake clients and the web interface.",
+		Create:      CreateSessionPolicy,
+		Read:        ReadSessionPolicy,
+		Update:      UpdateSessionPolicy,
+		Delete:      DeleteSessionPolicy,
+
+		Schema: sessionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateSessionPolicy implements schema.CreateFunc.
+func CreateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	createRequest := sdk.NewCreateSessionPolicyRequest(objectIdentifier).
+		WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int))).
+		WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+
+	if v, ok := d.GetOk("comment"); ok {
+		createRequest.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.SessionPolicies.Create(ctx, createRequest); err != nil {
+		return fmt.Errorf("error creating session policy %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	return ReadSessionPolicy(d, meta)
+}
+
+// ReadSessionPolicy implements schema.ReadFunc.
+func ReadSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	sessionPolicy, err := client.SessionPolicies.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] session policy (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	sessionPolicyDetails, err := client.SessionPolicies.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", sessionPolicy.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("database", sessionPolicy.DatabaseName); err != nil {
+		return err
+	}
+
+	if err := d.Set("schema", sessionPolicy.SchemaName); err != nil {
+		return err
+	}
+
+	if err := d.Set("session_idle_timeout_mins", sessionPolicyDetails.SessionIdleTimeoutMins); err != nil {
+		return err
+	}
+
+	if err := d.Set("session_ui_idle_timeout_mins", sessionPolicyDetails.SessionUIIdleTimeoutMins); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", sessionPolicy.Comment); err != nil {
+		return err
+	}
+
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSessionPolicy implements schema.UpdateFunc.
+func UpdateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(objectIdentifier.DatabaseName(), objectIdentifier.SchemaName(), d.Get("name").(string))
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithRenameTo(&newId)); err != nil {
+			return fmt.Errorf("error renaming session policy %v err = %w", objectIdentifier.Name(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		objectIdentifier = newId
+	}
+
+	runSet := false
+	set := sdk.NewSessionPolicySetRequest()
+	runUnset := false
+	unset := sdk.NewSessionPolicyUnsetRequest()
+
+	if d.HasChange("session_idle_timeout_mins") {
+		runSet = true
+		set.WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int)))
+	}
+
+	if d.HasChange("session_ui_idle_timeout_mins") {
+		runSet = true
+		set.WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.WithComment(sdk.String(comment))
+		} else {
+			runUnset = true
+			unset.WithComment(sdk.Bool(true))
+		}
+	}
+
+	if runSet {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating session policy %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(objectIdentifier).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating session policy %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadSessionPolicy(d, meta)
+}
+
+// DeleteSessionPolicy implements schema.DeleteFunc.
+func DeleteSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	objectIdentifier, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.SessionPolicies.Drop(ctx, sdk.NewDropSessionPolicyRequest(objectIdentifier)); err != nil {
+		return fmt.Errorf("error deleting session policy %v err = %w", objectIdentifier.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
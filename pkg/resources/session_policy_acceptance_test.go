@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SessionPolicy_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_session_policy.test"
+	m := func(sessionIdleTimeoutMins int, sessionUiIdleTimeoutMins int, comment string) config.Variables {
+		return config.Variables{
+			"name":                         config.StringVariable(name),
+			"database":                     config.StringVariable(acc.TestDatabaseName),
+			"schema":                       config.StringVariable(acc.TestSchemaName),
+			"session_idle_timeout_mins":    config.IntegerVariable(sessionIdleTimeoutMins),
+			"session_ui_idle_timeout_mins": config.IntegerVariable(sessionUiIdleTimeoutMins),
+			"comment":                      config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckSessionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SessionPolicy/basic"),
+				ConfigVariables: m(10, 20, "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "session_idle_timeout_mins", "10"),
+					resource.TestCheckResourceAttr(resourceName, "session_ui_idle_timeout_mins", "20"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+			// CHANGE PROPERTIES IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_SessionPolicy/basic"),
+				ConfigVariables: m(30, 40, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr(resourceName, "session_ui_idle_timeout_mins", "40"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_SessionPolicy/basic"),
+				ConfigVariables:   m(30, 40, ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSessionPolicyDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_session_policy" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.SchemaObjectIdentifier)
+		existingSessionPolicy, err := client.SessionPolicies.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("session policy %v still exists", existingSessionPolicy.Name)
+		}
+	}
+	return nil
+}
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -97,13 +97,13 @@ func Stage() *schema.Resource {
 
 		Schema: stageSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 func CreateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -159,9 +159,11 @@ func CreateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 }
 
 func ReadStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	properties, err := client.Stages.Describe(ctx, id)
 	if err != nil {
@@ -253,12 +255,15 @@ func ReadStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 }
 
 func UpdateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	builder := snowflake.NewStageBuilder(id.Name(), id.DatabaseName(), id.SchemaName())
 
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
+	db := client.GetConn().DB
 
 	if d.HasChange("credentials") {
 		credentials := d.Get("credentials")
@@ -347,11 +352,13 @@ func UpdateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 }
 
 func DeleteStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	db := meta.(*sql.DB)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := client.Stages.Drop(ctx, sdk.NewDropStageRequest(id))
+	err = client.Stages.Drop(ctx, sdk.NewDropStageRequest(id))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -40,7 +40,7 @@ func TestStageGrantCreate(t *testing.T) {
 			mock.ExpectExec(fmt.Sprintf(`^GRANT %s ON STAGE "test-db"."test-schema"."test-stage" TO ROLE "test-role-1" WITH GRANT OPTION$`, testPriv)).WillReturnResult(sqlmock.NewResult(1, 1))
 			mock.ExpectExec(fmt.Sprintf(`^GRANT %s ON STAGE "test-db"."test-schema"."test-stage" TO ROLE "test-role-2" WITH GRANT OPTION$`, testPriv)).WillReturnResult(sqlmock.NewResult(1, 1))
 			expectReadStageGrant(mock, testPriv)
-			err := resources.CreateStageGrant(d, db)
+			err := resources.CreateStageGrant(d, MockProviderContext(db))
 			r.NoError(err)
 		})
 	}
@@ -62,7 +62,7 @@ func TestStageGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStageGrant(mock, "USAGE")
-		err := resources.ReadStageGrant(d, db)
+		err := resources.ReadStageGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -105,7 +105,7 @@ func TestFutureStageGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE STAGES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStageGrant(mock)
-		err := resources.CreateStageGrant(d, db)
+		err := resources.CreateStageGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -129,7 +129,7 @@ func TestFutureStageGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE STAGES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStageDatabaseGrant(mock)
-		err := resources.CreateStageGrant(d, db)
+		err := resources.CreateStageGrant(d, MockProviderContext(db))
 		b.NoError(err)
 	})
 }
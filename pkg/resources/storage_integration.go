@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -115,15 +115,14 @@ func StorageIntegration() *schema.Resource {
 
 		Schema: storageIntegrationSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.AccountObjectIdentifier],
 		},
 	}
 }
 
 func CreateStorageIntegration(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	name := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("name").(string))
 	enabled := d.Get("enabled").(bool)
@@ -186,12 +185,11 @@ func CreateStorageIntegration(d *schema.ResourceData, meta any) error {
 }
 
 func ReadStorageIntegration(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id, ok := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-	if !ok {
-		return fmt.Errorf("storage integration read, error decoding id: %s as sdk.AccountObjectIdentifier, got: %T", d.Id(), id)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return fmt.Errorf("storage integration read, error decoding id: %w", err)
 	}
 
 	s, err := client.StorageIntegrations.ShowByID(ctx, id)
@@ -278,12 +276,11 @@ func ReadStorageIntegration(d *schema.ResourceData, meta any) error {
 }
 
 func UpdateStorageIntegration(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id, ok := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-	if !ok {
-		return fmt.Errorf("storage integration update, error decoding id: %s as sdk.AccountObjectIdentifier, got: %T", d.Id(), id)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return fmt.Errorf("storage integration update, error decoding id: %w", err)
 	}
 
 	var runSetStatement bool
@@ -365,12 +362,11 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta any) error {
 }
 
 func DeleteStorageIntegration(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id, ok := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
-	if !ok {
-		return fmt.Errorf("storage integration delete, error decoding id: %s as sdk.AccountObjectIdentifier, got: %T", d.Id(), id)
+	id, err := helpers.ParseSnowflakeID[sdk.AccountObjectIdentifier](d.Id())
+	if err != nil {
+		return fmt.Errorf("storage integration delete, error decoding id: %w", err)
 	}
 	if err := client.StorageIntegrations.Drop(ctx, sdk.NewDropStorageIntegrationRequest(id)); err != nil {
 		return fmt.Errorf("error dropping storage integration (%s), err = %w", d.Id(), err)
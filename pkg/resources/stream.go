@@ -2,12 +2,13 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -98,14 +99,14 @@ func Stream() *schema.Resource {
 
 		Schema: streamSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
 
 // CreateStream implements schema.CreateFunc.
 func CreateStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -113,8 +114,6 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	insertOnly := d.Get("insert_only").(bool)
 	showInitialRows := d.Get("show_initial_rows").(bool)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
-
-	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 
 	onTable, onTableSet := d.GetOk("on_table")
@@ -218,15 +217,20 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 
 // ReadStream implements schema.ReadFunc.
 func ReadStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	stream, err := client.Streams.ShowByID(ctx, sdk.NewShowByIdStreamRequest(id))
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	stream, err := client.Streams.ShowByID(ctx, id)
 	if err != nil {
-		log.Printf("[DEBUG] stream (%s) not found", d.Id())
-		d.SetId("")
-		return nil
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] stream (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 	if err := d.Set("name", stream.Name); err != nil {
 		return err
@@ -274,10 +278,12 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateStream implements schema.UpdateFunc.
 func UpdateStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("comment") {
 		comment := d.Get("comment").(string)
@@ -299,12 +305,14 @@ func UpdateStream(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteStream implements schema.DeleteFunc.
 func DeleteStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	streamId := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	streamId, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.Streams.Drop(ctx, sdk.NewDropStreamRequest(streamId))
+	err = client.Streams.Drop(ctx, sdk.NewDropStreamRequest(streamId))
 	if err != nil {
 		return fmt.Errorf("error deleting stream %v err = %w", d.Id(), err)
 	}
@@ -0,0 +1,313 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the streamlit; must be unique for the database and schema in which the streamlit is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which to create the streamlit.",
+		ForceNew:    true,
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which to create the streamlit.",
+		ForceNew:    true,
+	},
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The fully qualified name of the stage where the source files for the streamlit app are located.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressStreamlitStageDiff,
+	},
+	"directory_location": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the directory in the stage where the source files for the streamlit app are located. If not set, the root of the stage is used.",
+	},
+	"main_file": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the filename of the streamlit Python application. This filename is relative to the value of `directory_location`.",
+	},
+	"query_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the warehouse where SQL queries issued by the streamlit application are run.",
+	},
+	"title": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a title for the streamlit app to display in Snowsight.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the streamlit.",
+	},
+	"url_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the streamlit app used in its URL.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the streamlit.",
+	},
+}
+
+// Streamlit returns a pointer to the resource representing a streamlit.
+func Streamlit() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateStreamlit,
+		Read:   ReadStreamlit,
+		Update: UpdateStreamlit,
+		Delete: DeleteStreamlit,
+
+		Schema: streamlitSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
+		},
+	}
+}
+
+// CreateStreamlit implements schema.CreateFunc.
+func CreateStreamlit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	rootLocation, err := getStreamlitRootLocation(d)
+	if err != nil {
+		return err
+	}
+	request := sdk.NewCreateStreamlitRequest(id, rootLocation, d.Get("main_file").(string))
+
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		request.WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string))))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		request.WithTitle(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	ctx := context.Background()
+	if err := client.Streamlits.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating streamlit %v err = %w", name, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadStreamlit(d, meta)
+}
+
+// ReadStreamlit implements schema.ReadFunc.
+func ReadStreamlit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	streamlit, err := client.Streamlits.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// If not found, mark resource to be removed from state file during apply or refresh
+			log.Printf("[DEBUG] streamlit (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// root location, main file and query warehouse are read from DESCRIBE, so changes made outside of Terraform are detected
+	streamlitDetails, err := client.Streamlits.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	stageId, directoryLocation, err := parseStreamlitRootLocation(streamlitDetails.RootLocation)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]any{
+		"name":               streamlit.Name,
+		"database":           streamlit.DatabaseName,
+		"schema":             streamlit.SchemaName,
+		"stage":              stageId.FullyQualifiedName(),
+		"directory_location": directoryLocation,
+		"main_file":          streamlitDetails.MainFile,
+		"query_warehouse":    streamlitDetails.QueryWarehouse,
+		"title":              streamlitDetails.Title,
+		"comment":            streamlit.Comment,
+		"url_id":             streamlitDetails.UrlId,
+		"qualified_name":     id.FullyQualifiedName(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateStreamlit implements schema.UpdateFunc.
+func UpdateStreamlit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithRenameTo(&newId)); err != nil {
+			return fmt.Errorf("error renaming streamlit %v to %v err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	// ROOT_LOCATION and MAIN_FILE are always passed together with the other properties being set
+	rootLocation, err := getStreamlitRootLocation(d)
+	if err != nil {
+		return err
+	}
+	set := sdk.NewStreamlitSetRequest(sdk.String(rootLocation), sdk.String(d.Get("main_file").(string)))
+	unset := sdk.NewStreamlitUnsetRequest()
+	runSet := d.HasChanges("stage", "directory_location", "main_file")
+	var runUnset bool
+
+	if d.HasChange("query_warehouse") {
+		if v := d.Get("query_warehouse").(string); v != "" {
+			set.WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(v)))
+			runSet = true
+		} else {
+			unset.WithQueryWarehouse(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("title") {
+		if v := d.Get("title").(string); v != "" {
+			set.WithTitle(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithTitle(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			set.WithComment(sdk.String(v))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating streamlit %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating streamlit %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadStreamlit(d, meta)
+}
+
+// DeleteStreamlit implements schema.DeleteFunc.
+func DeleteStreamlit(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Streamlits.Drop(ctx, sdk.NewDropStreamlitRequest(id)); err != nil {
+		return fmt.Errorf("error deleting streamlit %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getStreamlitRootLocation(d *schema.ResourceData) (string, error) {
+	stageId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](d.Get("stage").(string))
+	if err != nil {
+		return "", err
+	}
+	rootLocation := "@" + stageId.FullyQualifiedName()
+	if directoryLocation := d.Get("directory_location").(string); directoryLocation != "" {
+		rootLocation += "/" + strings.Trim(directoryLocation, "/")
+	}
+	return rootLocation, nil
+}
+
+// parseStreamlitRootLocation splits the root location returned by DESCRIBE STREAMLIT (e.g. @"db"."schema"."stage"/dir)
+// into the stage identifier and the directory in the stage.
+func parseStreamlitRootLocation(rootLocation string) (sdk.SchemaObjectIdentifier, string, error) {
+	location := strings.TrimPrefix(rootLocation, "@")
+	var quoted bool
+	for i, r := range location {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == '/' && !quoted:
+			stageId, err := sdk.ParseSchemaObjectIdentifier(location[:i])
+			return stageId, strings.Trim(location[i+1:], "/"), err
+		}
+	}
+	stageId, err := sdk.ParseSchemaObjectIdentifier(location)
+	return stageId, "", err
+}
+
+// suppressStreamlitStageDiff suppresses differences in quoting of the stage identifier.
+func suppressStreamlitStageDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	oldId, err := sdk.ParseSchemaObjectIdentifier(oldValue)
+	if err != nil {
+		return false
+	}
+	newId, err := sdk.ParseSchemaObjectIdentifier(newValue)
+	if err != nil {
+		return false
+	}
+	return oldId.FullyQualifiedName() == newId.FullyQualifiedName()
+}
@@ -0,0 +1,119 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Streamlit_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	stageName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_streamlit.test"
+	m := func(name string, directoryLocation string, mainFile string, queryWarehouse string, title string, comment string) config.Variables {
+		return config.Variables{
+			"name":               config.StringVariable(name),
+			"stage_name":         config.StringVariable(stageName),
+			"database":           config.StringVariable(acc.TestDatabaseName),
+			"schema":             config.StringVariable(acc.TestSchemaName),
+			"directory_location": config.StringVariable(directoryLocation),
+			"main_file":          config.StringVariable(mainFile),
+			"query_warehouse":    config.StringVariable(queryWarehouse),
+			"title":              config.StringVariable(title),
+			"comment":            config.StringVariable(comment),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckStreamlitDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Streamlit/basic"),
+				ConfigVariables: m(name, "", "streamlit_app.py", acc.TestWarehouseName, "some title", "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "stage", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, stageName).FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "directory_location", ""),
+					resource.TestCheckResourceAttr(resourceName, "main_file", "streamlit_app.py"),
+					resource.TestCheckResourceAttr(resourceName, "query_warehouse", acc.TestWarehouseName),
+					resource.TestCheckResourceAttr(resourceName, "title", "some title"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name).FullyQualifiedName()),
+				),
+			},
+			// RENAME AND CHANGE PROPERTIES IN PLACE
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Streamlit/basic"),
+				ConfigVariables: m(newName, "app", "main.py", acc.TestWarehouseName, "other title", "other comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "directory_location", "app"),
+					resource.TestCheckResourceAttr(resourceName, "main_file", "main.py"),
+					resource.TestCheckResourceAttr(resourceName, "title", "other title"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "other comment"),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, newName).FullyQualifiedName()),
+				),
+			},
+			// UNSET PROPERTIES
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_Streamlit/basic"),
+				ConfigVariables: m(newName, "app", "main.py", "", "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "query_warehouse", ""),
+					resource.TestCheckResourceAttr(resourceName, "title", ""),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_Streamlit/basic"),
+				ConfigVariables:   m(newName, "app", "main.py", "", "", ""),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStreamlitDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_streamlit" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.SchemaObjectIdentifier)
+		existingStreamlit, err := client.Streamlits.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("streamlit %v still exists", existingStreamlit.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStreamlitRootLocation(t *testing.T) {
+	testCases := map[string]struct {
+		rootLocation      string
+		stageId           sdk.SchemaObjectIdentifier
+		directoryLocation string
+	}{
+		"stage root": {
+			rootLocation:      `@"DB"."SCHEMA"."STAGE"`,
+			stageId:           sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "STAGE"),
+			directoryLocation: "",
+		},
+		"unquoted stage with directory": {
+			rootLocation:      `@DB.SCHEMA.STAGE/app/src`,
+			stageId:           sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "STAGE"),
+			directoryLocation: "app/src",
+		},
+		"quoted stage containing slash with directory": {
+			rootLocation:      `@"db"."schema"."st/age"/app/`,
+			stageId:           sdk.NewSchemaObjectIdentifier("db", "schema", "st/age"),
+			directoryLocation: "app",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			stageId, directoryLocation, err := parseStreamlitRootLocation(tc.rootLocation)
+			require.NoError(t, err)
+			require.Equal(t, tc.stageId.FullyQualifiedName(), stageId.FullyQualifiedName())
+			require.Equal(t, tc.directoryLocation, directoryLocation)
+		})
+	}
+
+	t.Run("invalid stage identifier", func(t *testing.T) {
+		_, _, err := parseStreamlitRootLocation(`@STAGE/app`)
+		require.Error(t, err)
+	})
+}
@@ -2,14 +2,14 @@ package resources
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"log"
-	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -56,14 +56,10 @@ var tableSchema = map[string]*schema.Schema{
 					Description: "Column name",
 				},
 				"type": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Column type, e.g. VARIANT",
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// these are all equivalent as per https://docs.snowflake.com/en/sql-reference/data-types-text.html
-						varcharType := []string{"VARCHAR(16777216)", "VARCHAR", "text", "string", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"}
-						return slices.Contains(varcharType, new) && slices.Contains(varcharType, old)
-					},
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Column type, e.g. VARIANT",
+					DiffSuppressFunc: dataTypeDiffSuppressFunc,
 				},
 				"nullable": {
 					Type:        schema.TypeBool,
@@ -220,7 +216,7 @@ func Table() *schema.Resource {
 
 		Schema: tableSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportName[sdk.SchemaObjectIdentifier],
 		},
 	}
 }
@@ -388,7 +384,7 @@ func getColumns(from interface{}) (to columns) {
 	return to
 }
 
-func getTableColumnRequest(from interface{}) *sdk.TableColumnRequest {
+func getTableColumnRequest(from interface{}) (*sdk.TableColumnRequest, error) {
 	c := from.(map[string]interface{})
 	_type := c["type"].(string)
 
@@ -432,7 +428,11 @@ func getTableColumnRequest(from interface{}) *sdk.TableColumnRequest {
 
 	maskingPolicy := c["masking_policy"].(string)
 	if maskingPolicy != "" {
-		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(maskingPolicy)))
+		maskingPolicyId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](maskingPolicy)
+		if err != nil {
+			return nil, err
+		}
+		request.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(maskingPolicyId))
 	}
 
 	if sdk.IsStringType(_type) {
@@ -441,16 +441,20 @@ func getTableColumnRequest(from interface{}) *sdk.TableColumnRequest {
 
 	return request.
 		WithNotNull(sdk.Bool(!c["nullable"].(bool))).
-		WithComment(sdk.String(c["comment"].(string)))
+		WithComment(sdk.String(c["comment"].(string))), nil
 }
 
-func getTableColumnRequests(from interface{}) []sdk.TableColumnRequest {
+func getTableColumnRequests(from interface{}) ([]sdk.TableColumnRequest, error) {
 	cols := from.([]interface{})
 	to := make([]sdk.TableColumnRequest, len(cols))
 	for i, c := range cols {
-		to[i] = *getTableColumnRequest(c)
+		request, err := getTableColumnRequest(c)
+		if err != nil {
+			return nil, err
+		}
+		to[i] = *request
 	}
-	return to
+	return to, nil
 }
 
 type primarykey struct {
@@ -565,16 +569,18 @@ func toColumnIdentityConfig(td sdk.TableColumnDetails) map[string]any {
 
 // CreateTable implements schema.CreateFunc.
 func CreateTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	tableColumnRequests := getTableColumnRequests(d.Get("column").([]interface{}))
+	tableColumnRequests, err := getTableColumnRequests(d.Get("column").([]interface{}))
+	if err != nil {
+		return err
+	}
 
 	createRequest := sdk.NewCreateTableRequest(id, tableColumnRequests)
 
@@ -619,7 +625,7 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithTags(tagAssociationRequests)
 	}
 
-	err := client.Tables.Create(ctx, createRequest)
+	err = client.Tables.Create(ctx, createRequest)
 	if err != nil {
 		return fmt.Errorf("error creating table %v err = %w", name, err)
 	}
@@ -631,16 +637,21 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 
 // ReadTable implements schema.ReadFunc.
 func ReadTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	table, err := client.Tables.ShowByID(ctx, id)
 	if err != nil {
-		log.Printf("[DEBUG] table (%s) not found", d.Id())
-		d.SetId("")
-		return nil
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] table (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
 	}
 
 	tableDescription, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
@@ -680,10 +691,12 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateTable implements schema.UpdateFunc.
 func UpdateTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("name") {
 		newName := d.Get("name").(string)
@@ -798,7 +811,11 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if cA.maskingPolicy != "" {
-				addRequest.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.maskingPolicy)))
+				maskingPolicyId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](cA.maskingPolicy)
+				if err != nil {
+					return err
+				}
+				addRequest.WithMaskingPolicy(sdk.NewColumnMaskingPolicyRequest(maskingPolicyId))
 			}
 
 			if cA.comment != "" {
@@ -857,7 +874,11 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 				if strings.TrimSpace(cA.newColumn.maskingPolicy) == "" {
 					columnAction.WithUnsetMaskingPolicy(sdk.NewTableColumnAlterUnsetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name)))
 				} else {
-					columnAction.WithSetMaskingPolicy(sdk.NewTableColumnAlterSetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.maskingPolicy), []string{}).WithForce(sdk.Bool(true)))
+					maskingPolicyId, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](cA.newColumn.maskingPolicy)
+					if err != nil {
+						return err
+					}
+					columnAction.WithSetMaskingPolicy(sdk.NewTableColumnAlterSetMaskingPolicyActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), maskingPolicyId, []string{}).WithForce(sdk.Bool(true)))
 				}
 				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(columnAction))
 				if err != nil {
@@ -925,12 +946,14 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTable implements schema.DeleteFunc.
 func DeleteTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](d.Id())
+	if err != nil {
+		return err
+	}
 
-	err := client.Tables.Drop(ctx, sdk.NewDropTableRequest(id))
+	err = client.Tables.Drop(ctx, sdk.NewDropTableRequest(id))
 	if err != nil {
 		return err
 	}
@@ -42,7 +42,7 @@ func TestTableGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -68,7 +68,7 @@ func TestTableGrantUpdate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
 
-		err := resources.UpdateTableGrant(d, db)
+		err := resources.UpdateTableGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -90,7 +90,7 @@ func TestTableGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTableGrant(mock)
-		err := resources.ReadTableGrant(d, db)
+		err := resources.ReadTableGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -142,7 +142,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, MockProviderContext(db))
 		roles := d.Get("roles").(*schema.Set)
 		// After the CreateTableGrant has been created a ReadTableGrant reads the current grants
 		// and this read should ignore test-role-3 what is returned by SHOW FUTURE GRANTS ON SCHEMA PUBLIC because
@@ -173,7 +173,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableDatabaseGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, MockProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
@@ -152,7 +153,7 @@ func Tag() *schema.Resource {
 
 // CreateSchema implements schema.CreateFunc.
 func CreateTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -190,7 +191,7 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSchema implements schema.ReadFunc.
 func ReadTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -250,7 +251,7 @@ func UpdateTag(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	if d.HasChange("comment") {
 		comment, ok := d.GetOk("comment")
 		var q string
@@ -305,7 +306,7 @@ func expandAllowedValues(avChangeSet interface{}) []string {
 
 // DeleteTag implements schema.DeleteFunc.
 func DeleteTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
 		return err
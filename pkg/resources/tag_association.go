@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 )
@@ -103,7 +104,7 @@ func TagAssociation() *schema.Resource {
 
 // CreateTagAssociation implements schema.CreateFunc.
 func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	tagID := d.Get("tag_id").(string)
 	objectType := d.Get("object_type").(string)
 	tagValue := d.Get("tag_value").(string)
@@ -152,7 +153,7 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 
 // ReadTagAssociation implements schema.ReadFunc.
 func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 
 	tagID := d.Get("tag_id").(string)
 	objectType := d.Get("object_type").(string)
@@ -181,7 +182,7 @@ func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 
 	tagID := d.Get("tag_id").(string)
 	objectType := d.Get("object_type").(string)
@@ -214,7 +215,7 @@ func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTagAssociation implements schema.DeleteFunc.
 func DeleteTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 
 	tagID := d.Get("tag_id").(string)
 	objectType := d.Get("object_type").(string)
@@ -36,7 +36,7 @@ func TestTagGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT APPLY ON TAG "test-db"."PUBLIC"."test_tag" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT APPLY ON TAG "test-db"."PUBLIC"."test_tag" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTagGrant(mock)
-		err := resources.CreateTagGrant(d, db)
+		err := resources.CreateTagGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -57,7 +57,7 @@ func TestTagGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTagGrant(mock)
-		err := resources.ReadTagGrant(d, db)
+		err := resources.ReadTagGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 
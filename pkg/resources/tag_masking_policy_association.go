@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
@@ -104,7 +105,7 @@ func TagMaskingPolicyAssociation() *schema.Resource {
 
 // CreateTagMaskingPolicyAssociation implements schema.CreateFunc.
 func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	tagID := d.Get("tag_id").(string)
 	tagIDStruct, idErr := tagIDFromString(tagID)
 	if idErr != nil {
@@ -115,7 +116,10 @@ func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 	tagName := tagIDStruct.TagName
 
 	mpID := d.Get("masking_policy_id").(string)
-	mpIDStruct := helpers.DecodeSnowflakeID(mpID).(sdk.SchemaObjectIdentifier)
+	mpIDStruct, err := helpers.ParseSnowflakeID[sdk.SchemaObjectIdentifier](mpID)
+	if err != nil {
+		return fmt.Errorf("masking_policy_id is incorrect: %w", err)
+	}
 	mpDB := mpIDStruct.DatabaseName()
 	mpSchema := mpIDStruct.SchemaName()
 	mpName := mpIDStruct.Name()
@@ -148,7 +152,8 @@ func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 
 // ReadTagTagMaskingPolicyAssociation implements schema.ReadFunc.
 func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	db := client.GetConn().DB
 	attachementID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -165,7 +170,6 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 	builder := snowflake.NewTagBuilder(tagName).WithDB(tagDBName).WithSchema(tagSchemaName).WithMaskingPolicy(mP)
 
 	// create temp warehouse to query the tag, and make sure to clean it up
-	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 	originalWarehouse, err := client.ContextFunctions.CurrentWarehouse(ctx)
 	if err != nil {
@@ -217,7 +221,7 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 
 // DeleteTagMaskingPolicyAssociation implements schema.DeleteFunc.
 func DeleteTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	attachmentID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
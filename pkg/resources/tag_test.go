@@ -38,7 +38,7 @@ func TestTagCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.CreateTag(d, db)
+		err := resources.CreateTag(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -63,7 +63,7 @@ func TestTagUpdate(t *testing.T) {
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" ADD ALLOWED_VALUES 'marketing', 'finance'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.UpdateTag(d, db)
+		err := resources.UpdateTag(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -86,7 +86,7 @@ func TestTagDelete(t *testing.T) {
 			`^DROP TAG "test_db"."test_schema"."good_name"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteTag(d, db)
+		err := resources.DeleteTag(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -108,7 +108,7 @@ func TestTagRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewTagBuilder("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTag(d, db)
+		err := resources.ReadTag(d, MockProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -0,0 +1,9 @@
+resource "snowflake_session_policy" "test" {
+  name     = var.session_policy_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_account_session_policy_attachment" "test" {
+  session_policy = snowflake_session_policy.test.qualified_name
+}
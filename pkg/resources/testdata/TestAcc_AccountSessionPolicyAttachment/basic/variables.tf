@@ -0,0 +1,11 @@
+variable "session_policy_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
@@ -0,0 +1,25 @@
+resource "snowflake_stage" "test" {
+  name     = var.stage_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_application_package" "test" {
+  name = var.application_package_name
+}
+
+resource "snowflake_application_package_version" "test" {
+  application_package = snowflake_application_package.test.name
+  version             = var.version_name
+  using               = "@\"${snowflake_stage.test.database}\".\"${snowflake_stage.test.schema}\".\"${snowflake_stage.test.name}\""
+  label               = var.label
+}
+
+resource "snowflake_application" "test" {
+  name                = var.name
+  application_package = snowflake_application_package.test.name
+  version             = snowflake_application_package_version.test.version
+  patch               = snowflake_application_package_version.test.patch
+  debug_mode          = var.debug_mode
+  comment             = var.comment
+}
@@ -0,0 +1,35 @@
+variable "name" {
+  type = string
+}
+
+variable "application_package_name" {
+  type = string
+}
+
+variable "stage_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "version_name" {
+  type = string
+}
+
+variable "label" {
+  type = string
+}
+
+variable "debug_mode" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
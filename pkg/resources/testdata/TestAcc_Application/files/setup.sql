@@ -0,0 +1 @@
+create application role if not exists app_public comment = 'Terraform acceptance test';
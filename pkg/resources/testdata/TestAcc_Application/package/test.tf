@@ -0,0 +1,9 @@
+resource "snowflake_stage" "test" {
+  name     = var.stage_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_application_package" "test" {
+  name = var.application_package_name
+}
@@ -0,0 +1,15 @@
+variable "application_package_name" {
+  type = string
+}
+
+variable "stage_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
@@ -0,0 +1,6 @@
+resource "snowflake_application_package" "test" {
+  name                        = var.name
+  distribution                = var.distribution
+  data_retention_time_in_days = var.data_retention_time_in_days
+  comment                     = var.comment
+}
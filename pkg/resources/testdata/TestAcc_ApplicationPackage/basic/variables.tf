@@ -0,0 +1,15 @@
+variable "name" {
+  type = string
+}
+
+variable "distribution" {
+  type = string
+}
+
+variable "data_retention_time_in_days" {
+  type = number
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,10 @@
+resource "snowflake_event_table" "test" {
+  name     = var.name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_account_parameter" "event_table" {
+  key   = "EVENT_TABLE"
+  value = snowflake_event_table.test.qualified_name
+}
@@ -0,0 +1,10 @@
+resource "snowflake_event_table" "test" {
+  name                            = var.name
+  database                        = var.database
+  schema                          = var.schema
+  cluster_by                      = var.cluster_by
+  data_retention_time_in_days     = var.data_retention_time_in_days
+  max_data_extension_time_in_days = var.max_data_extension_time_in_days
+  change_tracking                 = var.change_tracking
+  comment                         = var.comment
+}
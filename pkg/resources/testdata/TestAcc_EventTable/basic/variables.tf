@@ -0,0 +1,31 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "cluster_by" {
+  type = list(string)
+}
+
+variable "data_retention_time_in_days" {
+  type = number
+}
+
+variable "max_data_extension_time_in_days" {
+  type = number
+}
+
+variable "change_tracking" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,23 @@
+resource "snowflake_network_rule" "test" {
+  name       = var.network_rule_name
+  database   = var.database
+  schema     = var.schema
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["example.com"]
+}
+
+resource "snowflake_secret_with_generic_string" "test" {
+  name          = var.secret_name
+  database      = var.database
+  schema        = var.schema
+  secret_string = "secret"
+}
+
+resource "snowflake_external_access_integration" "test" {
+  name                           = var.name
+  allowed_network_rules          = [snowflake_network_rule.test.qualified_name]
+  allowed_authentication_secrets = var.with_secret ? [snowflake_secret_with_generic_string.test.qualified_name] : []
+  enabled                        = var.enabled
+  comment                        = var.comment
+}
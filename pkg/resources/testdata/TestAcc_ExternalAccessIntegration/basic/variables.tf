@@ -0,0 +1,31 @@
+variable "name" {
+  type = string
+}
+
+variable "network_rule_name" {
+  type = string
+}
+
+variable "secret_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "with_secret" {
+  type = bool
+}
+
+variable "enabled" {
+  type = bool
+}
+
+variable "comment" {
+  type = string
+}
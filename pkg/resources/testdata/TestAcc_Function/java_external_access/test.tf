@@ -0,0 +1,48 @@
+resource "snowflake_network_rule" "rule" {
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["example.com"]
+}
+
+resource "snowflake_secret_with_generic_string" "secret" {
+  name          = var.name
+  database      = var.database
+  schema        = var.schema
+  secret_string = "secret"
+}
+
+resource "snowflake_external_access_integration" "integration" {
+  name                           = var.name
+  allowed_network_rules          = [snowflake_network_rule.rule.qualified_name]
+  allowed_authentication_secrets = [snowflake_secret_with_generic_string.secret.qualified_name]
+}
+
+resource "snowflake_function" "f" {
+  database = var.database
+  schema   = var.schema
+  name     = var.name
+  arguments {
+    name = "x"
+    type = "VARCHAR"
+  }
+  language                     = "java"
+  return_type                  = "VARCHAR"
+  return_behavior              = "VOLATILE"
+  null_input_behavior          = "CALLED ON NULL INPUT"
+  handler                      = "TestFunc.echoVarchar"
+  comment                      = var.comment
+  external_access_integrations = [snowflake_external_access_integration.integration.name]
+  secrets = {
+    "cred" = snowflake_secret_with_generic_string.secret.qualified_name
+  }
+  statement = <<EOT
+		class TestFunc {
+			public static String echoVarchar(String x) {
+				return x;
+			}
+		}
+  EOT
+}
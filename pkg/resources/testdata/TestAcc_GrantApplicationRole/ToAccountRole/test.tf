@@ -0,0 +1,30 @@
+resource "snowflake_stage" "test" {
+  name     = var.stage_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_application_package" "test" {
+  name = var.application_package_name
+}
+
+resource "snowflake_application_package_version" "test" {
+  application_package = snowflake_application_package.test.name
+  version             = "V1"
+  using               = "@\"${snowflake_stage.test.database}\".\"${snowflake_stage.test.schema}\".\"${snowflake_stage.test.name}\""
+}
+
+resource "snowflake_application" "test" {
+  name                = var.application_name
+  application_package = snowflake_application_package.test.name
+  version             = snowflake_application_package_version.test.version
+}
+
+resource "snowflake_role" "test" {
+  name = var.account_role_name
+}
+
+resource "snowflake_grant_application_role" "test" {
+  application_role_name    = "\"${snowflake_application.test.name}\".\"APP_PUBLIC\""
+  parent_account_role_name = "\"${snowflake_role.test.name}\""
+}
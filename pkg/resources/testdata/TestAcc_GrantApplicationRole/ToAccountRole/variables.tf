@@ -0,0 +1,23 @@
+variable "application_name" {
+  type = string
+}
+
+variable "application_package_name" {
+  type = string
+}
+
+variable "stage_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "account_role_name" {
+  type = string
+}
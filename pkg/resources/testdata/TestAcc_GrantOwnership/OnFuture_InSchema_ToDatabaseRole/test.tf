@@ -0,0 +1,15 @@
+resource "snowflake_database_role" "test" {
+  name     = var.database_role_name
+  database = var.database
+}
+
+resource "snowflake_grant_ownership" "test" {
+  database_role_name = "\"${var.database}\".\"${snowflake_database_role.test.name}\""
+
+  on {
+    future {
+      object_type_plural = "TABLES"
+      in_schema          = "\"${var.database}\".\"${var.schema}\""
+    }
+  }
+}
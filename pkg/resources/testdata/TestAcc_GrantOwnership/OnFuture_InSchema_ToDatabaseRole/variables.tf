@@ -0,0 +1,11 @@
+variable "database_role_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
@@ -0,0 +1,32 @@
+resource "snowflake_role" "test" {
+  name = var.account_role_name
+}
+
+# the role is granted to the role running the tests, so the table stays visible after the ownership transfer
+resource "snowflake_grant_account_role" "test" {
+  role_name        = snowflake_role.test.name
+  parent_role_name = var.revert_role_name
+}
+
+resource "snowflake_table" "test" {
+  database = var.database
+  schema   = var.schema
+  name     = var.table_name
+
+  column {
+    name = "id"
+    type = "NUMBER(38,0)"
+  }
+}
+
+resource "snowflake_grant_ownership" "test" {
+  depends_on                    = [snowflake_grant_account_role.test]
+  account_role_name             = "\"${snowflake_role.test.name}\""
+  outbound_privileges           = "COPY"
+  revert_ownership_to_role_name = var.revert_role_name
+
+  on {
+    object_type = "TABLE"
+    object_name = "\"${var.database}\".\"${var.schema}\".\"${snowflake_table.test.name}\""
+  }
+}
@@ -0,0 +1,19 @@
+variable "account_role_name" {
+  type = string
+}
+
+variable "revert_role_name" {
+  type = string
+}
+
+variable "table_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
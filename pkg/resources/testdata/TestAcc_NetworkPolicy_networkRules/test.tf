@@ -0,0 +1,23 @@
+resource "snowflake_network_rule" "allowed" {
+  name       = var.allowed_rule_name
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_rule" "blocked" {
+  name       = var.blocked_rule_name
+  database   = var.database
+  schema     = var.schema
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.101"]
+}
+
+resource "snowflake_network_policy" "test" {
+  name                      = var.name
+  allowed_network_rule_list = [snowflake_network_rule.allowed.qualified_name]
+  blocked_network_rule_list = var.with_blocked_rules ? [snowflake_network_rule.blocked.qualified_name] : []
+}
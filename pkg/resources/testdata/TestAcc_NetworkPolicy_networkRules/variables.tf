@@ -0,0 +1,23 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "allowed_rule_name" {
+  type = string
+}
+
+variable "blocked_rule_name" {
+  type = string
+}
+
+variable "with_blocked_rules" {
+  type = bool
+}
@@ -0,0 +1,9 @@
+resource "snowflake_network_rule" "test" {
+  name       = var.name
+  database   = var.database
+  schema     = var.schema
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = var.value_list
+  comment    = var.comment
+}
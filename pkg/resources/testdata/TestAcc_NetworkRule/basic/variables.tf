@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "value_list" {
+  type = set(string)
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,11 @@
+resource "snowflake_replication_group" "test" {
+  name                 = var.name
+  object_types         = var.object_types
+  allowed_accounts     = var.allowed_accounts
+  allowed_databases    = var.allowed_databases
+  ignore_edition_check = true
+
+  replication_schedule {
+    interval = var.interval
+  }
+}
@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "object_types" {
+  type = set(string)
+}
+
+variable "allowed_accounts" {
+  type = set(string)
+}
+
+variable "allowed_databases" {
+  type = set(string)
+}
+
+variable "interval" {
+  type = number
+}
@@ -0,0 +1,8 @@
+resource "snowflake_secret_with_basic_authentication" "test" {
+  name     = var.name
+  database = var.database
+  schema   = var.schema
+  username = var.username
+  password = var.password
+  comment  = var.comment
+}
@@ -0,0 +1,7 @@
+resource "snowflake_secret_with_generic_string" "test" {
+  name          = var.name
+  database      = var.database
+  schema        = var.schema
+  secret_string = var.secret_string
+  comment       = var.comment
+}
@@ -0,0 +1,20 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "secret_string" {
+  type      = string
+  sensitive = true
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,8 @@
+resource "snowflake_session_policy" "test" {
+  name                         = var.name
+  database                     = var.database
+  schema                       = var.schema
+  session_idle_timeout_mins    = var.session_idle_timeout_mins
+  session_ui_idle_timeout_mins = var.session_ui_idle_timeout_mins
+  comment                      = var.comment
+}
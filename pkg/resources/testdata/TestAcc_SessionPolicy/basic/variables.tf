@@ -0,0 +1,23 @@
+variable "name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "session_idle_timeout_mins" {
+  type = number
+}
+
+variable "session_ui_idle_timeout_mins" {
+  type = number
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,17 @@
+resource "snowflake_stage" "test" {
+  name     = var.stage_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_streamlit" "test" {
+  name               = var.name
+  database           = var.database
+  schema             = var.schema
+  stage              = "\"${snowflake_stage.test.database}\".\"${snowflake_stage.test.schema}\".\"${snowflake_stage.test.name}\""
+  directory_location = var.directory_location
+  main_file          = var.main_file
+  query_warehouse    = var.query_warehouse
+  title              = var.title
+  comment            = var.comment
+}
@@ -0,0 +1,35 @@
+variable "name" {
+  type = string
+}
+
+variable "stage_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
+
+variable "directory_location" {
+  type = string
+}
+
+variable "main_file" {
+  type = string
+}
+
+variable "query_warehouse" {
+  type = string
+}
+
+variable "title" {
+  type = string
+}
+
+variable "comment" {
+  type = string
+}
@@ -0,0 +1,14 @@
+resource "snowflake_user" "test" {
+  name = var.user_name
+}
+
+resource "snowflake_session_policy" "test" {
+  name     = var.session_policy_name
+  database = var.database
+  schema   = var.schema
+}
+
+resource "snowflake_user_session_policy_attachment" "test" {
+  user_name           = snowflake_user.test.name
+  session_policy_name = snowflake_session_policy.test.qualified_name
+}
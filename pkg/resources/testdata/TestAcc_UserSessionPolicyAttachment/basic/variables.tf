@@ -0,0 +1,15 @@
+variable "user_name" {
+  type = string
+}
+
+variable "session_policy_name" {
+  type = string
+}
+
+variable "database" {
+  type = string
+}
+
+variable "schema" {
+  type = string
+}
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,14 +41,16 @@ func UserPasswordPolicyAttachment() *schema.Resource {
 }
 
 func CreateUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
-	passwordPolicy := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("password_policy_name").(string))
+	passwordPolicy, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](d.Get("password_policy_name").(string))
+	if err != nil {
+		return err
+	}
 
-	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+	err = client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
 		Set: &sdk.UserSet{
 			PasswordPolicy: &passwordPolicy,
 		},
@@ -63,8 +65,7 @@ func CreateUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error
 }
 
 func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	parts := strings.Split(d.Id(), helpers.IDDelimiter)
@@ -107,8 +108,7 @@ func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
 }
 
 func DeleteUserPasswordPolicyAttachment(d *schema.ResourceData, meta any) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
@@ -2,11 +2,11 @@ package resources_test
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -56,8 +56,7 @@ func TestAcc_UserPasswordPolicyAttachment(t *testing.T) {
 }
 
 func testAccCheckUserPasswordPolicyAttachmentDestroy(s *terraform.State) error {
-	db := acc.TestAccProvider.Meta().(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
 	ctx := context.Background()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "snowflake_user_password_policy_attachment" {
@@ -8,6 +8,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,9 +62,8 @@ func UserPublicKeys() *schema.Resource {
 	}
 }
 
-func checkUserExists(db *sql.DB, name string) (bool, error) {
+func checkUserExists(client *sdk.Client, name string) (bool, error) {
 	ctx := context.Background()
-	client := sdk.NewClientFromDB(db)
 
 	// First check if user exists
 	_, err := client.Users.Describe(ctx, sdk.NewAccountObjectIdentifier(name))
@@ -79,10 +79,10 @@ func checkUserExists(db *sql.DB, name string) (bool, error) {
 }
 
 func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	id := d.Id()
 
-	exists, err := checkUserExists(db, id)
+	exists, err := checkUserExists(client, id)
 	if err != nil {
 		return err
 	}
@@ -96,7 +96,7 @@ func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	name := d.Get("name").(string)
 
 	for _, prop := range userPublicKeyProperties {
@@ -115,7 +115,7 @@ func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	name := d.Id()
 
 	propsToSet := map[string]string{}
@@ -155,7 +155,7 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).Client.GetConn().DB
 	name := d.Id()
 
 	for _, prop := range userPublicKeyProperties {
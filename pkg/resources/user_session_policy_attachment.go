@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userSessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the session policy to",
+	},
+	"session_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name of the session policy",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+func UserSessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the session policy to use for a certain user.",
+		Create:      CreateUserSessionPolicyAttachment,
+		Read:        ReadUserSessionPolicyAttachment,
+		Delete:      DeleteUserSessionPolicyAttachment,
+		Schema:      userSessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateUserSessionPolicyAttachment(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
+	sessionPolicy, err := sdk.ParseFullyQualifiedName[sdk.SchemaObjectIdentifier](d.Get("session_policy_name").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			SessionPolicy: sdk.String(sessionPolicy.FullyQualifiedName()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName.FullyQualifiedName(), sessionPolicy.FullyQualifiedName()))
+
+	return ReadUserSessionPolicyAttachment(d, meta)
+}
+
+func ReadUserSessionPolicyAttachment(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return fmt.Errorf("required id format 'user_name|session_policy_name', but got: '%s'", d.Id())
+	}
+
+	// Note: there is no alphanumeric id for an attachment, so we retrieve the session policies attached to a certain user.
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(parts[0])
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(userName, sdk.PolicyEntityDomainUser))
+	if err != nil {
+		return err
+	}
+
+	sessionPolicyReferences := filterSessionPolicyReferences(policyReferences)
+
+	// Note: this should never happen, but just in case: so far, Snowflake only allows one Session Policy per user.
+	if len(sessionPolicyReferences) > 1 {
+		return fmt.Errorf("internal error: multiple session policy references attached to a user. This should never happen")
+	}
+
+	// Note: this means the resource has been deleted outside of Terraform.
+	if len(sessionPolicyReferences) == 0 {
+		log.Printf("[DEBUG] session policy attachment for user (%s) not found", userName.Name())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("user_name", userName.Name()); err != nil {
+		return err
+	}
+	if err := d.Set(
+		"session_policy_name",
+		sdk.NewSchemaObjectIdentifier(
+			*sessionPolicyReferences[0].PolicyDb,
+			*sessionPolicyReferences[0].PolicySchema,
+			sessionPolicyReferences[0].PolicyName,
+		).FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteUserSessionPolicyAttachment(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("user_name").(string))
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			SessionPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserSessionPolicyAttachment(t *testing.T) {
+	userName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	sessionPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "snowflake_user_session_policy_attachment.test"
+	sessionPolicyId := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, sessionPolicyName)
+
+	configVariables := config.Variables{
+		"user_name":           config.StringVariable(userName),
+		"session_policy_name": config.StringVariable(sessionPolicyName),
+		"database":            config.StringVariable(acc.TestDatabaseName),
+		"schema":              config.StringVariable(acc.TestSchemaName),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckUserSessionPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: acc.ConfigurationDirectory("TestAcc_UserSessionPolicyAttachment/basic"),
+				ConfigVariables: configVariables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "session_policy_name", sessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|%s", sdk.NewAccountObjectIdentifier(userName).FullyQualifiedName(), sessionPolicyId.FullyQualifiedName())),
+				),
+			},
+			// IMPORT
+			{
+				ConfigDirectory:   acc.ConfigurationDirectory("TestAcc_UserSessionPolicyAttachment/basic"),
+				ConfigVariables:   configVariables,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserSessionPolicyAttachmentDestroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	ctx := context.Background()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "snowflake_user_session_policy_attachment" {
+			continue
+		}
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(
+			sdk.NewAccountObjectIdentifierFromFullyQualifiedName(rs.Primary.Attributes["user_name"]),
+			sdk.PolicyEntityDomainUser,
+		))
+		if err != nil {
+			if strings.Contains(err.Error(), "does not exist or not authorized") {
+				// Note: this can happen if the Policy Reference or the User has been deleted as well; in this case, ignore the error
+				continue
+			}
+			return err
+		}
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == "SESSION_POLICY" {
+				return fmt.Errorf("user session policy attachment %v still exists", policyReference.PolicyName)
+			}
+		}
+	}
+	return nil
+}
@@ -127,3 +127,18 @@ func StringInSlice(valid []string, ignoreCase bool) schema.SchemaValidateDiagFun
 		return diag.Errorf("expected %v to be one of %q, got %s", path, valid, v)
 	}
 }
+
+// IsValidSchemaObjectName accepts fully qualified names of schema objects (<database_name>.<schema_name>.<name>).
+// Names of functions and procedures can be followed by the argument data types, e.g. <database_name>.<schema_name>.<name>(NUMBER, VARCHAR).
+func IsValidSchemaObjectName() schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		v, ok := i.(string)
+		if !ok {
+			return diag.Errorf("expected type of %v to be string", path)
+		}
+		if _, err := sdk.NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(v); err != nil {
+			return diag.Errorf("expected %v to be a fully qualified name of the schema object (<database_name>.<schema_name>.<name>, optionally followed by the argument data types of functions and procedures), got %s: %v", path, v, err)
+		}
+		return nil
+	}
+}
@@ -34,7 +34,7 @@ func TestWarehouseGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadWarehouseGrant(mock)
-		err := resources.CreateWarehouseGrant(d, db)
+		err := resources.CreateWarehouseGrant(d, MockProviderContext(db))
 		r.NoError(err)
 	})
 }
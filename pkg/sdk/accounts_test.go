@@ -72,6 +72,19 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET CLIENT_ENCRYPTION_KEY_SIZE = 128, PREVENT_UNLOAD_TO_INTERNAL_STAGES = true, JSON_INDENT = 16, MAX_DATA_EXTENSION_TIME_IN_DAYS = 30`)
 	})
 
+	t.Run("with set event table", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					AccountParameters: &AccountParameters{
+						EventTable: String(NewSchemaObjectIdentifier("db", "schema", "events").FullyQualifiedName()),
+					},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET EVENT_TABLE = "db"."schema"."events"`)
+	})
+
 	t.Run("with unset params", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Unset: &AccountUnset{
@@ -4,14 +4,11 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
-type ApiIntegrationAwsApiProviderType string
-
-var (
-	ApiIntegrationAwsApiGateway           ApiIntegrationAwsApiProviderType = "aws_api_gateway"
-	ApiIntegrationAwsPrivateApiGateway    ApiIntegrationAwsApiProviderType = "aws_private_api_gateway"
-	ApiIntegrationAwsGovApiGateway        ApiIntegrationAwsApiProviderType = "aws_gov_api_gateway"
-	ApiIntegrationAwsGovPrivateApiGateway ApiIntegrationAwsApiProviderType = "aws_gov_private_api_gateway"
-)
+var apiIntegrationAwsApiProviderTypeEnum = g.NewEnum("ApiIntegrationAwsApiProviderType").
+	WithValue("ApiIntegrationAwsApiGateway", "aws_api_gateway").
+	WithValue("ApiIntegrationAwsPrivateApiGateway", "aws_private_api_gateway").
+	WithValue("ApiIntegrationAwsGovApiGateway", "aws_gov_api_gateway").
+	WithValue("ApiIntegrationAwsGovPrivateApiGateway", "aws_gov_private_api_gateway")
 
 var ApiIntegrationEndpointPrefixDef = g.NewQueryStruct("ApiIntegrationEndpointPrefix").Text("Path", g.KeywordOptions().SingleQuotes().Required())
 
@@ -21,6 +18,7 @@ var ApiIntegrationsDef = g.NewInterface(
 	"ApiIntegration",
 	g.KindOfT[AccountObjectIdentifier](),
 ).
+	WithEnums(apiIntegrationAwsApiProviderTypeEnum).
 	CreateOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/create-api-integration",
 		g.NewQueryStruct("CreateApiIntegration").
@@ -32,7 +30,7 @@ var ApiIntegrationsDef = g.NewInterface(
 			OptionalQueryStructField(
 				"AwsApiProviderParams",
 				g.NewQueryStruct("AwsApiParams").
-					Assignment("API_PROVIDER", g.KindOfT[ApiIntegrationAwsApiProviderType](), g.ParameterOptions().NoQuotes().Required()).
+					Assignment("API_PROVIDER", apiIntegrationAwsApiProviderTypeEnum.Kind(), g.ParameterOptions().NoQuotes().Required()).
 					TextAssignment("API_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
 					OptionalTextAssignment("API_KEY", g.ParameterOptions().SingleQuotes()),
 				g.KeywordOptions(),
@@ -151,7 +149,7 @@ var ApiIntegrationsDef = g.NewInterface(
 			SQL("API INTEGRATIONS").
 			OptionalLike(),
 	).
-	ShowByIdOperation().
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering).
 	DescribeOperation(
 		g.DescriptionMappingKindSlice,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
@@ -23,10 +23,6 @@ type CreateApiIntegrationRequest struct {
 	Comment                 *string
 }
 
-func (r *CreateApiIntegrationRequest) GetName() AccountObjectIdentifier {
-	return r.name
-}
-
 type AwsApiParamsRequest struct {
 	ApiProvider   ApiIntegrationAwsApiProviderType // required
 	ApiAwsRoleArn string                           // required
@@ -96,3 +92,10 @@ type ShowApiIntegrationRequest struct {
 type DescribeApiIntegrationRequest struct {
 	name AccountObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+func (r *CreateApiIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
@@ -3,6 +3,8 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -13,6 +15,39 @@ type ApiIntegrations interface {
 	Show(ctx context.Context, request *ShowApiIntegrationRequest) ([]ApiIntegration, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApiIntegration, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApiIntegrationProperty, error)
+	// generator:protected-begin ApiIntegrations methods
+	// generator:protected-end
+}
+
+type ApiIntegrationAwsApiProviderType string
+
+const (
+	ApiIntegrationAwsApiGateway           ApiIntegrationAwsApiProviderType = "aws_api_gateway"
+	ApiIntegrationAwsPrivateApiGateway    ApiIntegrationAwsApiProviderType = "aws_private_api_gateway"
+	ApiIntegrationAwsGovApiGateway        ApiIntegrationAwsApiProviderType = "aws_gov_api_gateway"
+	ApiIntegrationAwsGovPrivateApiGateway ApiIntegrationAwsApiProviderType = "aws_gov_private_api_gateway"
+)
+
+var AllApiIntegrationAwsApiProviderTypes = []ApiIntegrationAwsApiProviderType{
+	ApiIntegrationAwsApiGateway,
+	ApiIntegrationAwsPrivateApiGateway,
+	ApiIntegrationAwsGovApiGateway,
+	ApiIntegrationAwsGovPrivateApiGateway,
+}
+
+func ToApiIntegrationAwsApiProviderType(s string) (ApiIntegrationAwsApiProviderType, error) {
+	switch strings.ToUpper(s) {
+	case "AWS_API_GATEWAY":
+		return ApiIntegrationAwsApiGateway, nil
+	case "AWS_PRIVATE_API_GATEWAY":
+		return ApiIntegrationAwsPrivateApiGateway, nil
+	case "AWS_GOV_API_GATEWAY":
+		return ApiIntegrationAwsGovApiGateway, nil
+	case "AWS_GOV_PRIVATE_API_GATEWAY":
+		return ApiIntegrationAwsGovPrivateApiGateway, nil
+	default:
+		return "", fmt.Errorf("invalid ApiIntegrationAwsApiProviderType: %s", s)
+	}
 }
 
 // CreateApiIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-api-integration.
@@ -130,10 +165,6 @@ type ApiIntegration struct {
 	CreatedOn time.Time
 }
 
-func (v *ApiIntegration) ID() AccountObjectIdentifier {
-	return NewAccountObjectIdentifier(v.Name)
-}
-
 // DescribeApiIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-integration.
 type DescribeApiIntegrationOptions struct {
 	describe       bool                    `ddl:"static" sql:"DESCRIBE"`
@@ -154,3 +185,10 @@ type ApiIntegrationProperty struct {
 	Value   string
 	Default string
 }
+
+// generator:protected-begin declarations
+func (v *ApiIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// generator:protected-end
@@ -0,0 +1,101 @@
+package sdk
+
+import "testing"
+
+func TestApiIntegrations_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "AwsApiProviderParams.ApiProvider")
+			fillSQLFixtureField(opts, "AwsApiProviderParams.ApiAwsRoleArn")
+			fillSQLFixtureField(opts, "ApiAllowedPrefixes")
+			fillSQLFixtureField(opts, "Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "OrReplace")
+			clearSQLFixtureField(opts, "AzureApiProviderParams")
+			clearSQLFixtureField(opts, "GoogleApiProviderParams")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			clearSQLFixtureField(opts, "Set.AzureParams")
+			clearSQLFixtureField(opts, "Set.GoogleParams")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowApiIntegrationOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowApiIntegrationOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeApiIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
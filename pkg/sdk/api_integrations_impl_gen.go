@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ ApiIntegrations = (*apiIntegrations)(nil)
 
@@ -38,13 +34,14 @@ func (v *apiIntegrations) Show(ctx context.Context, request *ShowApiIntegrationR
 }
 
 func (v *apiIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApiIntegration, error) {
-	apiIntegrations, err := v.Show(ctx, NewShowApiIntegrationRequest().WithLike(&Like{
-		Pattern: String(id.Name()),
-	}))
+	// generator:protected-begin apiIntegrations.ShowByID
+	request := NewShowApiIntegrationRequest().WithLike(&Like{Pattern: String(id.Name())})
+	apiIntegrations, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(apiIntegrations, func(r ApiIntegration) bool { return r.Name == id.Name() })
+	return findOne(apiIntegrations, func(r ApiIntegration) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *apiIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApiIntegrationProperty, error) {
@@ -60,10 +57,9 @@ func (v *apiIntegrations) Describe(ctx context.Context, id AccountObjectIdentifi
 
 func (r *CreateApiIntegrationRequest) toOpts() *CreateApiIntegrationOptions {
 	opts := &CreateApiIntegrationOptions{
-		OrReplace:   r.OrReplace,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
+		OrReplace:          r.OrReplace,
+		IfNotExists:        r.IfNotExists,
+		name:               r.name,
 		ApiAllowedPrefixes: r.ApiAllowedPrefixes,
 		ApiBlockedPrefixes: r.ApiBlockedPrefixes,
 		Enabled:            r.Enabled,
@@ -93,9 +89,8 @@ func (r *CreateApiIntegrationRequest) toOpts() *CreateApiIntegrationOptions {
 
 func (r *AlterApiIntegrationRequest) toOpts() *AlterApiIntegrationOptions {
 	opts := &AlterApiIntegrationOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
@@ -152,6 +147,7 @@ func (r *ShowApiIntegrationRequest) toOpts() *ShowApiIntegrationOptions {
 }
 
 func (r showApiIntegrationsDbRow) convert() *ApiIntegration {
+	// generator:protected-begin showApiIntegrationsDbRow.convert
 	s := &ApiIntegration{
 		Name:      r.Name,
 		ApiType:   r.Type,
@@ -163,6 +159,7 @@ func (r showApiIntegrationsDbRow) convert() *ApiIntegration {
 		s.Comment = r.Comment.String
 	}
 	return s
+	// generator:protected-end
 }
 
 func (r *DescribeApiIntegrationRequest) toOpts() *DescribeApiIntegrationOptions {
@@ -173,10 +170,15 @@ func (r *DescribeApiIntegrationRequest) toOpts() *DescribeApiIntegrationOptions
 }
 
 func (r descApiIntegrationsDbRow) convert() *ApiIntegrationProperty {
+	// generator:protected-begin descApiIntegrationsDbRow.convert
 	return &ApiIntegrationProperty{
 		Name:    r.Property,
 		Type:    r.PropertyType,
 		Value:   r.PropertyValue,
 		Default: r.PropertyDefault,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
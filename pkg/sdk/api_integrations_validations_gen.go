@@ -22,6 +22,8 @@ func (opts *CreateApiIntegrationOptions) validate() error {
 	if !exactlyOneValueSet(opts.AwsApiProviderParams, opts.AzureApiProviderParams, opts.GoogleApiProviderParams) {
 		errs = append(errs, errExactlyOneOf("CreateApiIntegrationOptions", "AwsApiProviderParams", "AzureApiProviderParams", "GoogleApiProviderParams"))
 	}
+	// generator:protected-begin CreateApiIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -65,6 +67,8 @@ func (opts *AlterApiIntegrationOptions) validate() error {
 			errs = append(errs, errAtLeastOneOf("AlterApiIntegrationOptions.Unset", "ApiKey", "Enabled", "ApiBlockedPrefixes", "Comment"))
 		}
 	}
+	// generator:protected-begin AlterApiIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -76,6 +80,8 @@ func (opts *DropApiIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropApiIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -84,6 +90,8 @@ func (opts *ShowApiIntegrationOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowApiIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -95,5 +103,10 @@ func (opts *DescribeApiIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeApiIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -163,4 +163,7 @@ var ApplicationPackagesDef = g.NewInterface(
 		OptionalLike().
 		OptionalStartsWith().
 		OptionalLimit(),
-).ShowByIdOperation()
+).ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering)
+
+// ShowVersions (SHOW VERSIONS IN APPLICATION PACKAGE) and ShowReleaseDirectives (SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE)
+// are added manually to the generated files, because the generator supports only one show operation per interface.
@@ -284,3 +284,29 @@ func (s *ShowApplicationPackageRequest) WithLimit(Limit *LimitFrom) *ShowApplica
 	s.Limit = Limit
 	return s
 }
+
+func NewShowVersionsApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *ShowVersionsApplicationPackageRequest {
+	s := ShowVersionsApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *ShowVersionsApplicationPackageRequest) WithLike(Like *Like) *ShowVersionsApplicationPackageRequest {
+	s.Like = Like
+	return s
+}
+
+func NewShowReleaseDirectivesApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *ShowReleaseDirectivesApplicationPackageRequest {
+	s := ShowReleaseDirectivesApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *ShowReleaseDirectivesApplicationPackageRequest) WithLike(Like *Like) *ShowReleaseDirectivesApplicationPackageRequest {
+	s.Like = Like
+	return s
+}
@@ -99,3 +99,16 @@ type ShowApplicationPackageRequest struct {
 	StartsWith *string
 	Limit      *LimitFrom
 }
+
+// generator:protected-begin declarations
+type ShowVersionsApplicationPackageRequest struct {
+	Like *Like
+	name AccountObjectIdentifier // required
+}
+
+type ShowReleaseDirectivesApplicationPackageRequest struct {
+	Like *Like
+	name AccountObjectIdentifier // required
+}
+
+// generator:protected-end
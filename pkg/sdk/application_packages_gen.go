@@ -11,6 +11,10 @@ type ApplicationPackages interface {
 	Drop(ctx context.Context, request *DropApplicationPackageRequest) error
 	Show(ctx context.Context, request *ShowApplicationPackageRequest) ([]ApplicationPackage, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	// generator:protected-begin ApplicationPackages methods
+	ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error)
+	ShowReleaseDirectives(ctx context.Context, request *ShowReleaseDirectivesApplicationPackageRequest) ([]ApplicationPackageReleaseDirective, error)
+	// generator:protected-end
 }
 
 // CreateApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application-package.
@@ -143,3 +147,70 @@ type ApplicationPackage struct {
 	DroppedOn        string
 	ApplicationClass string
 }
+
+// generator:protected-begin declarations
+// ShowVersionsApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-versions.
+type ShowVersionsApplicationPackageOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	versions             bool                    `ddl:"static" sql:"VERSIONS"`
+	Like                 *Like                   `ddl:"keyword" sql:"LIKE"`
+	inApplicationPackage bool                    `ddl:"static" sql:"IN APPLICATION PACKAGE"`
+	name                 AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackageVersionRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    string         `db:"created_on"`
+	DroppedOn    sql.NullString `db:"dropped_on"`
+	LogLevel     sql.NullString `db:"log_level"`
+	TraceLevel   sql.NullString `db:"trace_level"`
+	State        string         `db:"state"`
+	ReviewStatus string         `db:"review_status"`
+}
+
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        string
+	Comment      string
+	CreatedOn    string
+	DroppedOn    string
+	LogLevel     string
+	TraceLevel   string
+	State        string
+	ReviewStatus string
+}
+
+// ShowReleaseDirectivesApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-release-directives.
+type ShowReleaseDirectivesApplicationPackageOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	releaseDirectives    bool                    `ddl:"static" sql:"RELEASE DIRECTIVES"`
+	Like                 *Like                   `ddl:"keyword" sql:"LIKE"`
+	inApplicationPackage bool                    `ddl:"static" sql:"IN APPLICATION PACKAGE"`
+	name                 AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackageReleaseDirectiveRow struct {
+	Name       string         `db:"name"`
+	TargetType sql.NullString `db:"target_type"`
+	TargetName sql.NullString `db:"target_name"`
+	CreatedOn  string         `db:"created_on"`
+	Version    string         `db:"version"`
+	Patch      int            `db:"patch"`
+	ModifiedOn sql.NullString `db:"modified_on"`
+}
+
+type ApplicationPackageReleaseDirective struct {
+	Name       string
+	TargetType string
+	TargetName string
+	CreatedOn  string
+	Version    string
+	Patch      int
+	ModifiedOn string
+}
+
+// generator:protected-end
@@ -0,0 +1,126 @@
+package sdk
+
+import "testing"
+
+func TestApplicationPackages_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("ModifyReleaseDirective", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ModifyReleaseDirective")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetDefaultReleaseDirective", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetDefaultReleaseDirective")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetReleaseDirective", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetReleaseDirective")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetReleaseDirective", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetReleaseDirective")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("AddVersion", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "AddVersion")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DropVersion", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DropVersion")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("AddPatchForVersion", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "AddPatchForVersion")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropApplicationPackageOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowApplicationPackageOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowApplicationPackageOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
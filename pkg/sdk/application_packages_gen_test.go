@@ -263,3 +263,77 @@ func TestApplicationPackages_Show(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES LIKE 'pattern' STARTS WITH 'A' LIMIT 1 FROM 'B'`)
 	})
 }
+
+func TestApplicationPackages_ShowVersions(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *ShowVersionsApplicationPackageOptions {
+		return &ShowVersionsApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowVersionsApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: empty like", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrPatternRequiredForLikeKeyword)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("V1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS LIKE 'V1' IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestApplicationPackages_ShowReleaseDirectives(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	defaultOpts := func() *ShowReleaseDirectivesApplicationPackageOptions {
+		return &ShowReleaseDirectivesApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowReleaseDirectivesApplicationPackageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("DEFAULT"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW RELEASE DIRECTIVES LIKE 'DEFAULT' IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+}
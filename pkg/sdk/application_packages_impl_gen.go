@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ ApplicationPackages = (*applicationPackages)(nil)
 
@@ -38,12 +34,14 @@ func (v *applicationPackages) Show(ctx context.Context, request *ShowApplication
 }
 
 func (v *applicationPackages) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error) {
-	request := NewShowApplicationPackageRequest().WithLike(&Like{String(id.Name())})
+	// generator:protected-begin applicationPackages.ShowByID
+	request := NewShowApplicationPackageRequest().WithLike(&Like{Pattern: String(id.Name())})
 	applicationPackages, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(applicationPackages, func(r ApplicationPackage) bool { return r.Name == id.Name() })
+	return findOne(applicationPackages, func(r ApplicationPackage) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOptions {
@@ -62,9 +60,8 @@ func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOpti
 
 func (r *AlterApplicationPackageRequest) toOpts() *AlterApplicationPackageOptions {
 	opts := &AlterApplicationPackageOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
@@ -151,6 +148,7 @@ func (r *ShowApplicationPackageRequest) toOpts() *ShowApplicationPackageOptions
 }
 
 func (r applicationPackageRow) convert() *ApplicationPackage {
+	// generator:protected-begin applicationPackageRow.convert
 	e := &ApplicationPackage{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -169,4 +167,89 @@ func (r applicationPackageRow) convert() *ApplicationPackage {
 		e.ApplicationClass = r.ApplicationClass.String
 	}
 	return e
+	// generator:protected-end
+}
+
+// generator:protected-begin declarations
+func (v *applicationPackages) ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageVersionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageVersionRow, ApplicationPackageVersion](dbRows)
+	return resultList, nil
 }
+
+func (v *applicationPackages) ShowReleaseDirectives(ctx context.Context, request *ShowReleaseDirectivesApplicationPackageRequest) ([]ApplicationPackageReleaseDirective, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageReleaseDirectiveRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageReleaseDirectiveRow, ApplicationPackageReleaseDirective](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowVersionsApplicationPackageRequest) toOpts() *ShowVersionsApplicationPackageOptions {
+	opts := &ShowVersionsApplicationPackageOptions{
+		Like: r.Like,
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackageVersionRow) convert() *ApplicationPackageVersion {
+	e := &ApplicationPackageVersion{
+		Version:      r.Version,
+		Patch:        r.Patch,
+		CreatedOn:    r.CreatedOn,
+		State:        r.State,
+		ReviewStatus: r.ReviewStatus,
+	}
+	if r.Label.Valid {
+		e.Label = r.Label.String
+	}
+	if r.Comment.Valid {
+		e.Comment = r.Comment.String
+	}
+	if r.DroppedOn.Valid {
+		e.DroppedOn = r.DroppedOn.String
+	}
+	if r.LogLevel.Valid {
+		e.LogLevel = r.LogLevel.String
+	}
+	if r.TraceLevel.Valid {
+		e.TraceLevel = r.TraceLevel.String
+	}
+	return e
+}
+
+func (r *ShowReleaseDirectivesApplicationPackageRequest) toOpts() *ShowReleaseDirectivesApplicationPackageOptions {
+	opts := &ShowReleaseDirectivesApplicationPackageOptions{
+		Like: r.Like,
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackageReleaseDirectiveRow) convert() *ApplicationPackageReleaseDirective {
+	e := &ApplicationPackageReleaseDirective{
+		Name:      r.Name,
+		CreatedOn: r.CreatedOn,
+		Version:   r.Version,
+		Patch:     r.Patch,
+	}
+	if r.TargetType.Valid {
+		e.TargetType = r.TargetType.String
+	}
+	if r.TargetName.Valid {
+		e.TargetName = r.TargetName.String
+	}
+	if r.ModifiedOn.Valid {
+		e.ModifiedOn = r.ModifiedOn.String
+	}
+	return e
+}
+
+// generator:protected-end
@@ -15,6 +15,8 @@ func (opts *CreateApplicationPackageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CreateApplicationPackageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -34,6 +36,8 @@ func (opts *AlterApplicationPackageOptions) validate() error {
 			errs = append(errs, errAtLeastOneOf("AlterApplicationPackageOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment", "Distribution"))
 		}
 	}
+	// generator:protected-begin AlterApplicationPackageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -45,6 +49,8 @@ func (opts *DropApplicationPackageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropApplicationPackageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -53,5 +59,38 @@ func (opts *ShowApplicationPackageOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowApplicationPackageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+func (opts *ShowVersionsApplicationPackageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if valueSet(opts.Like) && !valueSet(opts.Like.Pattern) {
+		errs = append(errs, ErrPatternRequiredForLikeKeyword)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowReleaseDirectivesApplicationPackageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if valueSet(opts.Like) && !valueSet(opts.Like.Pattern) {
+		errs = append(errs, ErrPatternRequiredForLikeKeyword)
+	}
+	return JoinErrors(errs...)
+}
+
+// generator:protected-end
@@ -4,11 +4,42 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var applicationRoleKindOfRole = g.NewQueryStruct("KindOfRole").
+	OptionalIdentifier("RoleName", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("ROLE")).
+	OptionalIdentifier("ApplicationRoleName", g.KindOfTPointer[DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("APPLICATION ROLE")).
+	WithValidation(g.ExactlyOneValueSet, "RoleName", "ApplicationRoleName")
+
 var ApplicationRolesDef = g.NewInterface(
 	"ApplicationRoles",
 	"ApplicationRole",
 	g.KindOfT[DatabaseObjectIdentifier](),
 ).
+	GrantOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/grant-application-role",
+		g.NewQueryStruct("GrantApplicationRole").
+			Grant().
+			SQL("APPLICATION ROLE").
+			Name().
+			QueryStructField(
+				"GrantTo",
+				applicationRoleKindOfRole,
+				g.KeywordOptions().SQL("TO").Required(),
+			).
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	RevokeOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/revoke-application-role",
+		g.NewQueryStruct("RevokeApplicationRole").
+			Revoke().
+			SQL("APPLICATION ROLE").
+			Name().
+			QueryStructField(
+				"RevokeFrom",
+				applicationRoleKindOfRole,
+				g.KeywordOptions().SQL("FROM").Required(),
+			).
+			WithValidation(g.ValidIdentifier, "name"),
+	).
 	ShowOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/show-application-roles",
 		g.DbStruct("applicationRoleDbRow").
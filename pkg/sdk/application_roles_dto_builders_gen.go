@@ -4,6 +4,40 @@ package sdk
 
 import ()
 
+func NewGrantApplicationRoleRequest(
+	name DatabaseObjectIdentifier,
+	GrantTo KindOfRoleRequest,
+) *GrantApplicationRoleRequest {
+	s := GrantApplicationRoleRequest{}
+	s.name = name
+	s.GrantTo = GrantTo
+	return &s
+}
+
+func NewKindOfRoleRequest() *KindOfRoleRequest {
+	return &KindOfRoleRequest{}
+}
+
+func (s *KindOfRoleRequest) WithRoleName(RoleName *AccountObjectIdentifier) *KindOfRoleRequest {
+	s.RoleName = RoleName
+	return s
+}
+
+func (s *KindOfRoleRequest) WithApplicationRoleName(ApplicationRoleName *DatabaseObjectIdentifier) *KindOfRoleRequest {
+	s.ApplicationRoleName = ApplicationRoleName
+	return s
+}
+
+func NewRevokeApplicationRoleRequest(
+	name DatabaseObjectIdentifier,
+	RevokeFrom KindOfRoleRequest,
+) *RevokeApplicationRoleRequest {
+	s := RevokeApplicationRoleRequest{}
+	s.name = name
+	s.RevokeFrom = RevokeFrom
+	return &s
+}
+
 func NewShowApplicationRoleRequest() *ShowApplicationRoleRequest {
 	return &ShowApplicationRoleRequest{}
 }
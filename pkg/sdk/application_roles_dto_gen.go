@@ -2,14 +2,36 @@ package sdk
 
 //go:generate go run ./dto-builder-generator/main.go
 
-var _ optionsProvider[ShowApplicationRoleOptions] = new(ShowApplicationRoleRequest)
+var (
+	_ optionsProvider[GrantApplicationRoleOptions]  = new(GrantApplicationRoleRequest)
+	_ optionsProvider[RevokeApplicationRoleOptions] = new(RevokeApplicationRoleRequest)
+	_ optionsProvider[ShowApplicationRoleOptions]   = new(ShowApplicationRoleRequest)
+)
+
+type GrantApplicationRoleRequest struct {
+	name    DatabaseObjectIdentifier // required
+	GrantTo KindOfRoleRequest        // required
+}
+
+type KindOfRoleRequest struct {
+	RoleName            *AccountObjectIdentifier
+	ApplicationRoleName *DatabaseObjectIdentifier
+}
+
+type RevokeApplicationRoleRequest struct {
+	name       DatabaseObjectIdentifier // required
+	RevokeFrom KindOfRoleRequest        // required
+}
 
 type ShowApplicationRoleRequest struct {
 	ApplicationName AccountObjectIdentifier
 	Limit           *LimitFrom
 }
 
+// generator:protected-begin declarations
 type ShowByIDApplicationRoleRequest struct {
 	name            DatabaseObjectIdentifier // required
 	ApplicationName AccountObjectIdentifier  // required
 }
+
+// generator:protected-end
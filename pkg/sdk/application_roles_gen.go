@@ -5,15 +5,34 @@ import (
 	"time"
 )
 
-// ApplicationRoles is an interface that allows for querying application roles.
-// It does not allow for other DDL queries (CREATE, ALTER, DROP, ...) to be called, because they are not possible
-// to be called from the program level. Application roles are a special case where they're only usable
-// inside application context (e.g. setup.sql). Right now, they can be only manipulated from the program context
-// by applying debug_mode parameter to the application, but it's a hacky solution and even with that you're limited with GRANT and REVOKE options.
-// That's why we're only exposing SHOW operations, because only they are the only allowed operations to be called from the program context.
 type ApplicationRoles interface {
+	Grant(ctx context.Context, request *GrantApplicationRoleRequest) error
+	Revoke(ctx context.Context, request *RevokeApplicationRoleRequest) error
 	Show(ctx context.Context, request *ShowApplicationRoleRequest) ([]ApplicationRole, error)
+	// generator:protected-begin ApplicationRoles methods
 	ShowByID(ctx context.Context, request *ShowByIDApplicationRoleRequest) (*ApplicationRole, error)
+	// generator:protected-end
+}
+
+// GrantApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-application-role.
+type GrantApplicationRoleOptions struct {
+	grant           bool                     `ddl:"static" sql:"GRANT"`
+	applicationRole bool                     `ddl:"static" sql:"APPLICATION ROLE"`
+	name            DatabaseObjectIdentifier `ddl:"identifier"`
+	GrantTo         KindOfRole               `ddl:"keyword" sql:"TO"`
+}
+
+type KindOfRole struct {
+	RoleName            *AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	ApplicationRoleName *DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
+}
+
+// RevokeApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-application-role.
+type RevokeApplicationRoleOptions struct {
+	revoke          bool                     `ddl:"static" sql:"REVOKE"`
+	applicationRole bool                     `ddl:"static" sql:"APPLICATION ROLE"`
+	name            DatabaseObjectIdentifier `ddl:"identifier"`
+	RevokeFrom      KindOfRole               `ddl:"keyword" sql:"FROM"`
 }
 
 // ShowApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-application-roles.
@@ -39,3 +58,6 @@ type ApplicationRole struct {
 	Comment       string
 	OwnerRoleType string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
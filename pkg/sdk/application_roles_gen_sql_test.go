@@ -0,0 +1,52 @@
+package sdk
+
+import "testing"
+
+func TestApplicationRoles_GoldenSQL(t *testing.T) {
+	t.Run("Grant", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &GrantApplicationRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "GrantTo.RoleName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &GrantApplicationRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "GrantTo.ApplicationRoleName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &RevokeApplicationRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "RevokeFrom.RoleName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &RevokeApplicationRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "RevokeFrom.ApplicationRoleName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowApplicationRoleOptions{}
+			fillSQLFixtureField(opts, "ApplicationName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowApplicationRoleOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -20,7 +20,7 @@ func TestApplicationRoles_Show(t *testing.T) {
 	t.Run("validation: valid identifier for [opts.ApplicationName]", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.ApplicationName = NewAccountObjectIdentifier("")
-		assertOptsInvalid(t, opts, errInvalidIdentifier("ShowApplicationRoleOptions", "ApplicationName"))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("all options", func(t *testing.T) {
@@ -32,3 +32,102 @@ func TestApplicationRoles_Show(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION ROLES IN APPLICATION %s LIMIT 123 FROM 'some limit'`, appId.FullyQualifiedName())
 	})
 }
+
+func TestApplicationRoles_Grant(t *testing.T) {
+	id := RandomDatabaseObjectIdentifier()
+
+	// Minimal valid GrantApplicationRoleOptions
+	defaultOpts := func() *GrantApplicationRoleOptions {
+		roleId := RandomAccountObjectIdentifier()
+		return &GrantApplicationRoleOptions{
+			name: id,
+			GrantTo: KindOfRole{
+				RoleName: &roleId,
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *GrantApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.GrantTo.RoleName opts.GrantTo.ApplicationRoleName] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GrantTo.RoleName = nil
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("GrantApplicationRoleOptions.GrantTo", "RoleName", "ApplicationRoleName"))
+	})
+
+	t.Run("validation: exactly one field from [opts.GrantTo.RoleName opts.GrantTo.ApplicationRoleName] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		applicationRoleId := RandomDatabaseObjectIdentifier()
+		opts.GrantTo.ApplicationRoleName = &applicationRoleId
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("GrantApplicationRoleOptions.GrantTo", "RoleName", "ApplicationRoleName"))
+	})
+
+	t.Run("to role", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `GRANT APPLICATION ROLE %s TO ROLE %s`, id.FullyQualifiedName(), opts.GrantTo.RoleName.FullyQualifiedName())
+	})
+
+	t.Run("to application role", func(t *testing.T) {
+		opts := defaultOpts()
+		applicationRoleId := RandomDatabaseObjectIdentifier()
+		opts.GrantTo = KindOfRole{
+			ApplicationRoleName: &applicationRoleId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT APPLICATION ROLE %s TO APPLICATION ROLE %s`, id.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
+
+func TestApplicationRoles_Revoke(t *testing.T) {
+	id := RandomDatabaseObjectIdentifier()
+
+	// Minimal valid RevokeApplicationRoleOptions
+	defaultOpts := func() *RevokeApplicationRoleOptions {
+		roleId := RandomAccountObjectIdentifier()
+		return &RevokeApplicationRoleOptions{
+			name: id,
+			RevokeFrom: KindOfRole{
+				RoleName: &roleId,
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RevokeApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RevokeFrom.RoleName opts.RevokeFrom.ApplicationRoleName] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RevokeFrom.RoleName = nil
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("RevokeApplicationRoleOptions.RevokeFrom", "RoleName", "ApplicationRoleName"))
+	})
+
+	t.Run("from role", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE APPLICATION ROLE %s FROM ROLE %s`, id.FullyQualifiedName(), opts.RevokeFrom.RoleName.FullyQualifiedName())
+	})
+
+	t.Run("from application role", func(t *testing.T) {
+		opts := defaultOpts()
+		applicationRoleId := RandomDatabaseObjectIdentifier()
+		opts.RevokeFrom = KindOfRole{
+			ApplicationRoleName: &applicationRoleId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE APPLICATION ROLE %s FROM APPLICATION ROLE %s`, id.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
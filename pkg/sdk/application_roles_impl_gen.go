@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ ApplicationRoles = (*applicationRoles)(nil)
 
@@ -12,6 +8,16 @@ type applicationRoles struct {
 	client *Client
 }
 
+func (v *applicationRoles) Grant(ctx context.Context, request *GrantApplicationRoleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *applicationRoles) Revoke(ctx context.Context, request *RevokeApplicationRoleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *applicationRoles) Show(ctx context.Context, request *ShowApplicationRoleRequest) ([]ApplicationRole, error) {
 	opts := request.toOpts()
 	dbRows, err := validateAndQuery[applicationRoleDbRow](v.client, ctx, opts)
@@ -22,28 +28,38 @@ func (v *applicationRoles) Show(ctx context.Context, request *ShowApplicationRol
 	return resultList, nil
 }
 
-func (v *applicationRoles) ShowByID(ctx context.Context, request *ShowByIDApplicationRoleRequest) (*ApplicationRole, error) {
-	appRoles, err := v.client.ApplicationRoles.Show(ctx, NewShowApplicationRoleRequest().WithApplicationName(request.ApplicationName))
-	if err != nil {
-		return nil, err
+func (r *GrantApplicationRoleRequest) toOpts() *GrantApplicationRoleOptions {
+	opts := &GrantApplicationRoleOptions{
+		name: r.name,
 	}
-	return collections.FindOne(appRoles, func(role ApplicationRole) bool { return role.Name == request.name.Name() })
+	opts.GrantTo = KindOfRole{
+		RoleName:            r.GrantTo.RoleName,
+		ApplicationRoleName: r.GrantTo.ApplicationRoleName,
+	}
+	return opts
+}
+
+func (r *RevokeApplicationRoleRequest) toOpts() *RevokeApplicationRoleOptions {
+	opts := &RevokeApplicationRoleOptions{
+		name: r.name,
+	}
+	opts.RevokeFrom = KindOfRole{
+		RoleName:            r.RevokeFrom.RoleName,
+		ApplicationRoleName: r.RevokeFrom.ApplicationRoleName,
+	}
+	return opts
 }
 
 func (r *ShowApplicationRoleRequest) toOpts() *ShowApplicationRoleOptions {
 	opts := &ShowApplicationRoleOptions{
 		ApplicationName: r.ApplicationName,
-	}
-	if r.Limit != nil {
-		opts.Limit = &LimitFrom{
-			Rows: r.Limit.Rows,
-			From: r.Limit.From,
-		}
+		Limit:           r.Limit,
 	}
 	return opts
 }
 
 func (r applicationRoleDbRow) convert() *ApplicationRole {
+	// generator:protected-begin applicationRoleDbRow.convert
 	return &ApplicationRole{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -51,4 +67,16 @@ func (r applicationRoleDbRow) convert() *ApplicationRole {
 		Comment:       r.Comment,
 		OwnerRoleType: r.OwnerRoleType,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+func (v *applicationRoles) ShowByID(ctx context.Context, request *ShowByIDApplicationRoleRequest) (*ApplicationRole, error) {
+	appRoles, err := v.client.ApplicationRoles.Show(ctx, NewShowApplicationRoleRequest().WithApplicationName(request.ApplicationName))
+	if err != nil {
+		return nil, err
+	}
+	return findOne(appRoles, func(role ApplicationRole) bool { return role.Name == request.name.Name() })
+}
+
+// generator:protected-end
@@ -1,16 +1,55 @@
 package sdk
 
-import "errors"
+var (
+	_ validatable = new(GrantApplicationRoleOptions)
+	_ validatable = new(RevokeApplicationRoleOptions)
+	_ validatable = new(ShowApplicationRoleOptions)
+)
 
-var _ validatable = new(ShowApplicationRoleOptions)
+func (opts *GrantApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.GrantTo.RoleName, opts.GrantTo.ApplicationRoleName) {
+		errs = append(errs, errExactlyOneOf("GrantApplicationRoleOptions.GrantTo", "RoleName", "ApplicationRoleName"))
+	}
+	// generator:protected-begin GrantApplicationRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *RevokeApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RevokeFrom.RoleName, opts.RevokeFrom.ApplicationRoleName) {
+		errs = append(errs, errExactlyOneOf("RevokeApplicationRoleOptions.RevokeFrom", "RoleName", "ApplicationRoleName"))
+	}
+	// generator:protected-begin RevokeApplicationRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
 
 func (opts *ShowApplicationRoleOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.ApplicationName) {
-		errs = append(errs, errInvalidIdentifier("ShowApplicationRoleOptions", "ApplicationName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin ShowApplicationRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -76,3 +76,6 @@ type ShowApplicationRequest struct {
 type DescribeApplicationRequest struct {
 	name AccountObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
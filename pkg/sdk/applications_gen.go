@@ -12,6 +12,8 @@ type Applications interface {
 	Show(ctx context.Context, request *ShowApplicationRequest) ([]Application, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationProperty, error)
+	// generator:protected-begin Applications methods
+	// generator:protected-end
 }
 
 // CreateApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application.
@@ -138,3 +140,6 @@ type ApplicationProperty struct {
 	Property string
 	Value    string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,116 @@
+package sdk
+
+import "testing"
+
+func TestApplications_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "PackageName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Version.VersionAndPatch")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Upgrade", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Upgrade")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UpgradeVersion", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UpgradeVersion")
+			clearSQLFixtureField(opts, "UpgradeVersion.VersionAndPatch")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetReferences", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetReferences")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowApplicationOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowApplicationOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeApplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
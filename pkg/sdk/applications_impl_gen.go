@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Applications = (*applications)(nil)
 
@@ -38,12 +34,14 @@ func (v *applications) Show(ctx context.Context, request *ShowApplicationRequest
 }
 
 func (v *applications) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error) {
-	request := NewShowApplicationRequest().WithLike(&Like{String(id.Name())})
+	// generator:protected-begin applications.ShowByID
+	request := NewShowApplicationRequest().WithLike(&Like{Pattern: String(id.Name())})
 	applications, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(applications, func(r Application) bool { return r.Name == id.Name() })
+	return findOne(applications, func(r Application) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *applications) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationProperty, error) {
@@ -61,10 +59,9 @@ func (r *CreateApplicationRequest) toOpts() *CreateApplicationOptions {
 	opts := &CreateApplicationOptions{
 		name:        r.name,
 		PackageName: r.PackageName,
-
-		DebugMode: r.DebugMode,
-		Comment:   r.Comment,
-		Tag:       r.Tag,
+		DebugMode:   r.DebugMode,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.Version != nil {
 		opts.Version = &ApplicationVersion{
@@ -91,11 +88,9 @@ func (r *DropApplicationRequest) toOpts() *DropApplicationOptions {
 
 func (r *AlterApplicationRequest) toOpts() *AlterApplicationOptions {
 	opts := &AlterApplicationOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
-		Upgrade: r.Upgrade,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Upgrade:   r.Upgrade,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
@@ -129,7 +124,9 @@ func (r *AlterApplicationRequest) toOpts() *AlterApplicationOptions {
 		if r.UnsetReferences.References != nil {
 			s := make([]ApplicationReference, len(r.UnsetReferences.References))
 			for i, v := range r.UnsetReferences.References {
-				s[i] = ApplicationReference(v)
+				s[i] = ApplicationReference{
+					Reference: v.Reference,
+				}
 			}
 			opts.UnsetReferences.References = s
 		}
@@ -147,6 +144,7 @@ func (r *ShowApplicationRequest) toOpts() *ShowApplicationOptions {
 }
 
 func (r applicationRow) convert() *Application {
+	// generator:protected-begin applicationRow.convert
 	return &Application{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -162,6 +160,7 @@ func (r applicationRow) convert() *Application {
 		Options:       r.Options,
 		RetentionTime: r.RetentionTime,
 	}
+	// generator:protected-end
 }
 
 func (r *DescribeApplicationRequest) toOpts() *DescribeApplicationOptions {
@@ -172,6 +171,7 @@ func (r *DescribeApplicationRequest) toOpts() *DescribeApplicationOptions {
 }
 
 func (r applicationPropertyRow) convert() *ApplicationProperty {
+	// generator:protected-begin applicationPropertyRow.convert
 	e := &ApplicationProperty{
 		Property: r.Property,
 	}
@@ -179,4 +179,8 @@ func (r applicationPropertyRow) convert() *ApplicationProperty {
 		e.Value = r.Value.String
 	}
 	return e
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
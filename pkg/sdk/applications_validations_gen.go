@@ -24,9 +24,11 @@ func (opts *CreateApplicationOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("CreateApplicationOptions.Version", "VersionDirectory", "VersionAndPatch"))
 		}
 	}
+	// generator:protected-begin CreateApplicationOptions.validate
 	if valueSet(opts.DebugMode) && !valueSet(opts.Version) {
 		errs = append(errs, NewError("CreateApplicationOptions.DebugMode can be set only when CreateApplicationOptions.Version is set"))
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -38,6 +40,8 @@ func (opts *DropApplicationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropApplicationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -57,11 +61,13 @@ func (opts *AlterApplicationOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("AlterApplicationOptions.UpgradeVersion", "VersionDirectory", "VersionAndPatch"))
 		}
 	}
+	// generator:protected-begin AlterApplicationOptions.validate
 	if valueSet(opts.IfExists) {
 		if !valueSet(opts.Set) && !valueSet(opts.Unset) {
 			errs = append(errs, NewError("AlterApplicationOptions.IfExists can be set only when AlterApplicationOptions.Set or AlterApplicationOptions.Unset is set"))
 		}
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -70,6 +76,8 @@ func (opts *ShowApplicationOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowApplicationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -81,5 +89,10 @@ func (opts *DescribeApplicationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeApplicationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
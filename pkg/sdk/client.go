@@ -23,13 +23,27 @@ func init() {
 	gosnowflakeLoggingLevel = os.Getenv("SF_TF_GOSNOWFLAKE_LOG_LEVEL")
 }
 
+// sqlExecutor runs the statements. It's implemented by *sqlx.DB (connection pool), *sqlx.Tx (transaction)
+// and *sqlx.Conn (single connection), so the same SDK operations can run on any of them.
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
 type Client struct {
 	config         *gosnowflake.Config
 	db             *sqlx.DB
+	executor       sqlExecutor
+	conn           *sqlx.Conn
+	inTransaction  bool
 	sessionID      string
 	accountLocator string
 	dryRun         bool
 	traceLogs      []string
+	showCache      *showCache
+	retryPolicy    *RetryPolicy
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
@@ -38,52 +52,56 @@ type Client struct {
 	ReplicationFunctions ReplicationFunctions
 
 	// DDL Commands
-	Accounts                 Accounts
-	Alerts                   Alerts
-	ApiIntegrations          ApiIntegrations
-	ApplicationPackages      ApplicationPackages
-	ApplicationRoles         ApplicationRoles
-	Applications             Applications
-	Comments                 Comments
-	DatabaseRoles            DatabaseRoles
-	Databases                Databases
-	DynamicTables            DynamicTables
-	ExternalFunctions        ExternalFunctions
-	ExternalTables           ExternalTables
-	EventTables              EventTables
-	FailoverGroups           FailoverGroups
-	FileFormats              FileFormats
-	Functions                Functions
-	Grants                   Grants
-	ManagedAccounts          ManagedAccounts
-	MaskingPolicies          MaskingPolicies
-	MaterializedViews        MaterializedViews
-	NetworkPolicies          NetworkPolicies
-	NetworkRules             NetworkRules
-	NotificationIntegrations NotificationIntegrations
-	Parameters               Parameters
-	PasswordPolicies         PasswordPolicies
-	Pipes                    Pipes
-	PolicyReferences         PolicyReferences
-	Procedures               Procedures
-	ResourceMonitors         ResourceMonitors
-	Roles                    Roles
-	RowAccessPolicies        RowAccessPolicies
-	Schemas                  Schemas
-	Sequences                Sequences
-	SessionPolicies          SessionPolicies
-	Sessions                 Sessions
-	Shares                   Shares
-	Stages                   Stages
-	StorageIntegrations      StorageIntegrations
-	Streamlits               Streamlits
-	Streams                  Streams
-	Tables                   Tables
-	Tags                     Tags
-	Tasks                    Tasks
-	Users                    Users
-	Views                    Views
-	Warehouses               Warehouses
+	Accounts                   Accounts
+	Alerts                     Alerts
+	ApiIntegrations            ApiIntegrations
+	ApplicationPackages        ApplicationPackages
+	ApplicationRoles           ApplicationRoles
+	Applications               Applications
+	Comments                   Comments
+	DatabaseRoles              DatabaseRoles
+	Databases                  Databases
+	DynamicTables              DynamicTables
+	ExternalAccessIntegrations ExternalAccessIntegrations
+	ExternalFunctions          ExternalFunctions
+	ExternalTables             ExternalTables
+	EventTables                EventTables
+	FailoverGroups             FailoverGroups
+	FileFormats                FileFormats
+	Functions                  Functions
+	Grants                     Grants
+	ManagedAccounts            ManagedAccounts
+	MaskingPolicies            MaskingPolicies
+	MaterializedViews          MaterializedViews
+	NetworkPolicies            NetworkPolicies
+	NetworkRules               NetworkRules
+	NotificationIntegrations   NotificationIntegrations
+	Parameters                 Parameters
+	PasswordPolicies           PasswordPolicies
+	Pipes                      Pipes
+	PolicyReferences           PolicyReferences
+	Procedures                 Procedures
+	ReplicationGroups          ReplicationGroups
+	ResourceMonitors           ResourceMonitors
+	Roles                      Roles
+	RowAccessPolicies          RowAccessPolicies
+	Schemas                    Schemas
+	Secrets                    Secrets
+	SecurityIntegrations       SecurityIntegrations
+	Sequences                  Sequences
+	SessionPolicies            SessionPolicies
+	Sessions                   Sessions
+	Shares                     Shares
+	Stages                     Stages
+	StorageIntegrations        StorageIntegrations
+	Streamlits                 Streamlits
+	Streams                    Streams
+	Tables                     Tables
+	Tags                       Tags
+	Tasks                      Tasks
+	Users                      Users
+	Views                      Views
+	Warehouses                 Warehouses
 }
 
 func (c *Client) GetAccountLocator() string {
@@ -118,7 +136,11 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		cfg = DefaultConfig()
 	}
 
-	var client *Client
+	if UsesFakeBackend() {
+		log.Printf("[DEBUG] SF_TF_FAKE_BACKEND set, using the in-memory fake backend\n")
+		return newFakeBackendClient(cfg)
+	}
+
 	// register the snowflake driver if it hasn't been registered yet
 
 	driverName := "snowflake"
@@ -147,16 +169,21 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		return nil, err
 	}
 
+	return newClient(driverName, dsn, cfg)
+}
+
+func newClient(driverName string, dsn string, cfg *gosnowflake.Config) (*Client, error) {
 	db, err := sqlx.Connect(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
 
-	client = &Client{
+	client := &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
 		db:     db.Unsafe(),
 		config: cfg,
 	}
+	client.executor = client.db
 	client.initialize()
 
 	err = client.Ping()
@@ -185,6 +212,7 @@ func NewClientFromDB(db *sql.DB) *Client {
 	client := &Client{
 		db: dbx.Unsafe(),
 	}
+	client.executor = client.db
 	client.initialize()
 	return client
 }
@@ -202,6 +230,7 @@ func (c *Client) initialize() {
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalTables = &externalTables{client: c}
 	c.EventTables = &eventTables{client: c}
@@ -221,10 +250,13 @@ func (c *Client) initialize() {
 	c.PolicyReferences = &policyReference{client: c}
 	c.Procedures = &procedures{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
 	c.RowAccessPolicies = &rowAccessPolicies{client: c}
 	c.Schemas = &schemas{client: c}
+	c.Secrets = &secrets{client: c}
+	c.SecurityIntegrations = &securityIntegrations{client: c}
 	c.Sequences = &sequences{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
@@ -242,6 +274,19 @@ func (c *Client) initialize() {
 	c.Warehouses = &warehouses{client: c}
 }
 
+// derive returns a copy of the client running the statements with the given executor.
+// The derived client shares the connection pool with c, so it must not be closed.
+func (c *Client) derive(executor sqlExecutor) *Client {
+	derived := &Client{
+		config:         c.config,
+		db:             c.db,
+		executor:       executor,
+		accountLocator: c.accountLocator,
+	}
+	derived.initialize()
+	return derived
+}
+
 func (c *Client) TraceLogs() []string {
 	return c.traceLogs
 }
@@ -264,15 +309,19 @@ const (
 )
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
 	if c.dryRun {
 		c.traceLogs = append(c.traceLogs, sql)
 		log.Printf("[DEBUG] sql-conn-exec-dry: %v\n", sql)
 		return nil, nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	err = c.withRetries(ctx, sql, nil, func() error {
+		var execErr error
+		result, execErr = c.executor.ExecContext(ctx, sql)
+		return decodeDriverError(execErr)
+	})
+	return result, err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
@@ -283,7 +332,9 @@ func (c *Client) query(ctx context.Context, dest interface{}, sql string) error
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	return c.withRetries(ctx, sql, dest, func() error {
+		return decodeDriverError(c.executor.SelectContext(ctx, dest, sql))
+	})
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
@@ -294,5 +345,7 @@ func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) err
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return c.withRetries(ctx, sql, dest, func() error {
+		return decodeDriverError(c.executor.GetContext(ctx, dest, sql))
+	})
 }
@@ -3,23 +3,26 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
 )
 
 func (c *Client) ExecUnsafe(ctx context.Context, sql string) (sql.Result, error) {
+	// we don't know what the statement changed, so nothing cached can be trusted anymore
+	defer c.showCache.invalidateAll()
 	return c.exec(ctx, sql)
 }
 
-// QueryUnsafe for now only supports single query. For more queries we will have to adjust the behavior. From the gosnowflake driver docs:
-//
-//	 (...) while using the multi-statement feature, pass a Context that specifies the number of statements in the string.
-//		When multiple queries are executed by a single call to QueryContext(), multiple result sets are returned. After you process the first result set, get the next result set (for the next SQL statement) by calling NextResultSet().
-//
-// Therefore, only single resultSet is processed.
+// QueryUnsafe supports only a single statement (use QueryMultiStatementUnsafe to run more of them), so only the first result set is processed.
 func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any, error) {
-	rows, err := c.db.QueryContext(ctx, sql)
+	rows, err := c.executor.QueryContext(ctx, sql)
 	if err != nil {
-		return nil, err
+		return nil, decodeDriverError(err)
 	}
+	defer rows.Close()
 	allRows, err := unsafeExecuteProcessRows(rows)
 	if err != nil {
 		return nil, err
@@ -27,9 +30,55 @@ func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any
 	return allRows, nil
 }
 
-func unsafeExecuteProcessRows(rows *sql.Rows) ([]map[string]*any, error) {
+// QueryMultiStatementUnsafe runs all the statements in a single request using the multi-statement feature of the gosnowflake driver.
+// From the gosnowflake driver docs:
+//
+//	 (...) while using the multi-statement feature, pass a Context that specifies the number of statements in the string.
+//		When multiple queries are executed by a single call to QueryContext(), multiple result sets are returned. After you process the first result set, get the next result set (for the next SQL statement) by calling NextResultSet().
+//
+// It returns the rows of every result set in the order of the statements (statements not returning rows, e.g. CREATE,
+// return their status row). The statements mustn't end with a semicolon.
+func (c *Client) QueryMultiStatementUnsafe(ctx context.Context, statements ...string) ([][]map[string]*any, error) {
+	if len(statements) == 0 {
+		return nil, errors.New("at least one statement has to be specified")
+	}
+	sql := strings.Join(statements, ";\n")
+	if c.dryRun {
+		c.traceLogs = append(c.traceLogs, statements...)
+		log.Printf("[DEBUG] sql-conn-query-multi-statement-dry: %v\n", sql)
+		return nil, nil
+	}
+	// we don't know what the statements changed, so nothing cached can be trusted anymore
+	defer c.showCache.invalidateAll()
+
+	ctx, err := gosnowflake.WithMultiStatement(ctx, len(statements))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.executor.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, decodeDriverError(err)
+	}
 	defer rows.Close()
 
+	resultSets := make([][]map[string]*any, 0, len(statements))
+	for {
+		resultSet, err := unsafeExecuteProcessRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		resultSets = append(resultSets, resultSet)
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, decodeDriverError(err)
+	}
+	return resultSets, nil
+}
+
+func unsafeExecuteProcessRows(rows *sql.Rows) ([]map[string]*any, error) {
 	columnNames, err := rows.Columns()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,37 @@
+//go:build fake_backend
+
+package sdk
+
+import (
+	"os"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/fake"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// The in-memory fake backend is compiled only into the test binaries built with the fake_backend tag
+// (e.g. make test-acceptance-fake), so SF_TF_FAKE_BACKEND has no effect on the provider binary.
+var fakeBackend = os.Getenv("SF_TF_FAKE_BACKEND") != ""
+
+// FakeBackendAccount is the account shared by all the clients created with NewClient when SF_TF_FAKE_BACKEND is set.
+const FakeBackendAccount = "FAKE"
+
+// UsesFakeBackend returns true if NewClient connects to the in-memory fake backend instead of Snowflake (SF_TF_FAKE_BACKEND is set).
+func UsesFakeBackend() bool {
+	return fakeBackend
+}
+
+func newFakeBackendClient(cfg *gosnowflake.Config) (*Client, error) {
+	return newClient(fake.DriverName, fake.DSN(FakeBackendAccount, cfg.User, cfg.Role), cfg)
+}
+
+// NewFakeClient creates a client connected to the given account of the in-memory fake backend. Clients created
+// with the same account share its state. The fake supports only a subset of Snowflake (see the internal/fake package).
+func NewFakeClient(account string) (*Client, error) {
+	return newClient(fake.DriverName, fake.DSN(account, "", ""), &gosnowflake.Config{Account: account})
+}
+
+// ResetFakeAccount drops all the objects created in the given account of the in-memory fake backend.
+func ResetFakeAccount(account string) {
+	fake.Reset(account)
+}
@@ -0,0 +1,26 @@
+//go:build !fake_backend
+
+package sdk
+
+import (
+	"errors"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+var errFakeBackendNotCompiled = errors.New("the fake backend is available only in the binaries built with the fake_backend tag")
+
+// UsesFakeBackend returns true if NewClient connects to the in-memory fake backend instead of Snowflake.
+// It is always false without the fake_backend tag.
+func UsesFakeBackend() bool {
+	return false
+}
+
+func newFakeBackendClient(_ *gosnowflake.Config) (*Client, error) {
+	return nil, errFakeBackendNotCompiled
+}
+
+// NewFakeClient returns an error without the fake_backend tag.
+func NewFakeClient(_ string) (*Client, error) {
+	return nil, errFakeBackendNotCompiled
+}
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// InSession runs f with a client bound to a single connection (Snowflake session) taken from the pool. Changes of the session
+// context made with the client passed to f (USE ROLE, USE SECONDARY ROLES, USE WAREHOUSE, ALTER SESSION, ...) apply to
+// every following statement run by it, e.g. it can switch to the role owning an object to drop it:
+//
+//	err := client.InSession(ctx, func(session *Client) error {
+//		if err := session.Sessions.UseRole(ctx, ownerRole); err != nil {
+//			return err
+//		}
+//		return session.Tables.Drop(ctx, NewDropTableRequest(id))
+//	})
+//
+// The connection is discarded (not returned to the pool) after f returns, so the session changes never leak to the statements
+// run by c. Calling InSession on the client already bound to a session or a transaction runs f in that session.
+// The SHOW cache (see EnableShowCache) is disabled inside the session, as the visible objects depend on the session role.
+func (c *Client) InSession(ctx context.Context, f func(session *Client) error) error {
+	return c.inSession(ctx, func(session *Client) (bool, error) {
+		return false, f(session)
+	})
+}
+
+// InSessionWithRestoredContext runs f like InSession, but the role, warehouse and secondary roles of the session are captured
+// before f is run and restored (with USE statements) after it returns, so the connection is returned to the pool afterward.
+// The connection is discarded only when the session context cannot be restored. f must not change any other part
+// of the session context (e.g. with ALTER SESSION), because such changes are not restored.
+func (c *Client) InSessionWithRestoredContext(ctx context.Context, f func(session *Client) error) error {
+	return c.inSession(ctx, func(session *Client) (bool, error) {
+		if c.dryRun {
+			return false, f(session)
+		}
+		sessionContext, err := session.currentSessionContext(ctx)
+		if err != nil {
+			return false, fmt.Errorf("capture session context: %w", err)
+		}
+		err = f(session)
+		if restoreErr := session.restoreSessionContext(ctx, sessionContext); restoreErr != nil {
+			log.Printf("[DEBUG] restoring session context failed, the session connection is discarded: %v\n", restoreErr)
+			return false, err
+		}
+		return true, err
+	})
+}
+
+// inSession runs f with a client bound to a single connection. The connection is returned to the pool only when f reports
+// it can be reused; otherwise it's discarded.
+func (c *Client) inSession(ctx context.Context, f func(session *Client) (bool, error)) error {
+	if c.conn != nil || c.inTransaction || c.dryRun {
+		_, err := f(c)
+		return err
+	}
+
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", decodeDriverError(err))
+	}
+	reusable := false
+	defer func() {
+		if !reusable {
+			// returning driver.ErrBadConn from Raw makes database/sql close the connection instead of putting it back to the pool
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		if err := conn.Close(); err != nil && !errors.Is(err, sql.ErrConnDone) {
+			log.Printf("[DEBUG] closing session connection failed: %v\n", err)
+		}
+	}()
+
+	session := c.derive(conn)
+	session.conn = conn
+	session.retryPolicy = c.retryPolicy
+	reusable, err = f(session)
+	return err
+}
+
+type sessionContext struct {
+	role           string
+	warehouse      string
+	secondaryRoles SecondaryRoleOption
+}
+
+func (c *Client) currentSessionContext(ctx context.Context) (*sessionContext, error) {
+	role, err := c.ContextFunctions.CurrentRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	warehouse, err := c.ContextFunctions.CurrentWarehouse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secondaryRoles, err := c.ContextFunctions.CurrentSecondaryRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionContext{role: role, warehouse: warehouse, secondaryRoles: secondaryRoles.Value}, nil
+}
+
+func (c *Client) restoreSessionContext(ctx context.Context, sessionContext *sessionContext) error {
+	if err := c.Sessions.UseRole(ctx, NewAccountObjectIdentifier(sessionContext.role)); err != nil {
+		return err
+	}
+	if sessionContext.warehouse != "" {
+		if err := c.Sessions.UseWarehouse(ctx, NewAccountObjectIdentifier(sessionContext.warehouse)); err != nil {
+			return err
+		}
+	} else {
+		// there is no statement to unset the current warehouse, so a session which got one cannot be restored
+		warehouse, err := c.ContextFunctions.CurrentWarehouse(ctx)
+		if err != nil {
+			return err
+		}
+		if warehouse != "" {
+			return fmt.Errorf("the session had no warehouse, but it has been switched to %s", warehouse)
+		}
+	}
+	return c.Sessions.UseSecondaryRoles(ctx, sessionContext.secondaryRoles)
+}
+
+// IsInSession reports whether the client is bound to a single session (see Client.InSession).
+func (c *Client) IsInSession() bool {
+	return c.conn != nil
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_InSession(t *testing.T) {
+	ctx := context.Background()
+	role := NewAccountObjectIdentifier("OWNER_ROLE")
+
+	t.Run("runs statements in session", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(`USE ROLE "OWNER_ROLE"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT CURRENT_ROLE() as CURRENT_ROLE`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow("OWNER_ROLE"))
+		mock.ExpectClose()
+
+		err := client.InSession(ctx, func(session *Client) error {
+			assert.True(t, session.IsInSession())
+			if err := session.Roles.Use(ctx, NewUseRoleRequest(role)); err != nil {
+				return err
+			}
+			currentRole, err := session.ContextFunctions.CurrentRole(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "OWNER_ROLE", currentRole)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, client.IsInSession())
+	})
+
+	t.Run("nested session reuses the connection", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectClose()
+
+		err := client.InSession(ctx, func(session *Client) error {
+			return session.InSession(ctx, func(nested *Client) error {
+				assert.Same(t, session, nested)
+				return nil
+			})
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("transaction in session", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(`USE ROLE "OWNER_ROLE"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectBegin()
+		mock.ExpectExec(`DROP WAREHOUSE "wh1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+
+		err := client.InSession(ctx, func(session *Client) error {
+			if err := session.Sessions.UseRole(ctx, role); err != nil {
+				return err
+			}
+			return session.InTransaction(ctx, func(tx *Client) error {
+				assert.True(t, tx.IsInSession())
+				return tx.Warehouses.Drop(ctx, NewAccountObjectIdentifier("wh1"), nil)
+			})
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_InSessionWithRestoredContext(t *testing.T) {
+	ctx := context.Background()
+	role := NewAccountObjectIdentifier("OWNER_ROLE")
+
+	expectSessionContext := func(mock sqlmock.Sqlmock, role string, warehouse string, secondaryRoles string) {
+		mock.ExpectQuery(`SELECT CURRENT_ROLE() as CURRENT_ROLE`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow(role))
+		mock.ExpectQuery(`SELECT CURRENT_WAREHOUSE() as CURRENT_WAREHOUSE`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_WAREHOUSE"}).AddRow(warehouse))
+		mock.ExpectQuery(`SELECT CURRENT_SECONDARY_ROLES() as CURRENT_ROLES`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLES"}).AddRow(secondaryRoles))
+	}
+
+	t.Run("restores the session context and returns the connection to the pool", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		expectSessionContext(mock, "SYSADMIN", "WH", `{"roles":"","value":""}`)
+		mock.ExpectExec(`USE ROLE "OWNER_ROLE"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE ROLE "SYSADMIN"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE WAREHOUSE "WH"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE SECONDARY ROLES NONE`).WillReturnResult(sqlmock.NewResult(0, 0))
+		// the pooled connection is reused by the next statement
+		mock.ExpectQuery(`SELECT CURRENT_ROLE() as CURRENT_ROLE`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow("SYSADMIN"))
+
+		err := client.InSessionWithRestoredContext(ctx, func(session *Client) error {
+			return session.Sessions.UseRole(ctx, role)
+		})
+		require.NoError(t, err)
+
+		currentRole, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "SYSADMIN", currentRole)
+	})
+
+	t.Run("restores the session context after an error", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		expectSessionContext(mock, "SYSADMIN", "WH", `{"roles":"","value":"ALL"}`)
+		mock.ExpectExec(`USE ROLE "SYSADMIN"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE WAREHOUSE "WH"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE SECONDARY ROLES ALL`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.InSessionWithRestoredContext(ctx, func(session *Client) error {
+			return errors.New("f failed")
+		})
+		require.ErrorContains(t, err, "f failed")
+	})
+
+	t.Run("discards the connection when the session context cannot be restored", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		expectSessionContext(mock, "SYSADMIN", "", `{"roles":"","value":""}`)
+		mock.ExpectExec(`USE WAREHOUSE "WH"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE ROLE "SYSADMIN"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT CURRENT_WAREHOUSE() as CURRENT_WAREHOUSE`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_WAREHOUSE"}).AddRow("WH"))
+		mock.ExpectClose()
+
+		err := client.InSessionWithRestoredContext(ctx, func(session *Client) error {
+			return session.Sessions.UseWarehouse(ctx, NewAccountObjectIdentifier("WH"))
+		})
+		require.NoError(t, err)
+	})
+}
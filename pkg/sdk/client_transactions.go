@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// InTransaction runs f with a client bound to a single transaction (BEGIN is run before f, COMMIT after it succeeds
+// and ROLLBACK when it returns an error or panics). All the typed SDK operations called on the client passed to f
+// run on the same connection.
+//
+// Keep in mind that in Snowflake every DDL statement (CREATE, ALTER, DROP, ...) is run in its own transaction and commits
+// the open one implicitly, so only the DML statements and the statements run before the first DDL one are rolled back.
+// See https://docs.snowflake.com/en/sql-reference/transactions#ddl.
+//
+// Calling InTransaction on the client already bound to a transaction runs f in that transaction. Called on the client
+// bound to a session (see Client.InSession), it runs the transaction in that session.
+// Retries (see SetRetryPolicy) and the SHOW cache (see EnableShowCache) are disabled inside the transaction;
+// the SHOW cache of c is invalidated after the transaction ends.
+func (c *Client) InTransaction(ctx context.Context, f func(tx *Client) error) (err error) {
+	if c.inTransaction || c.dryRun {
+		return f(c)
+	}
+
+	var tx *sqlx.Tx
+	if c.conn != nil {
+		tx, err = c.conn.BeginTxx(ctx, nil)
+	} else {
+		tx, err = c.db.BeginTxx(ctx, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", decodeDriverError(err))
+	}
+	defer c.showCache.invalidateAll()
+
+	txClient := c.derive(tx)
+	txClient.conn = c.conn
+	txClient.inTransaction = true
+
+	defer func() {
+		if r := recover(); r != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("[DEBUG] rollback after panic failed: %v\n", rollbackErr)
+			}
+			panic(r)
+		}
+	}()
+
+	if err := f(txClient); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("rollback transaction: %w", decodeDriverError(rollbackErr)))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", decodeDriverError(err))
+	}
+	return nil
+}
+
+// IsInTransaction reports whether the client is bound to a transaction (see Client.InTransaction).
+func (c *Client) IsInTransaction() bool {
+	return c.inTransaction
+}
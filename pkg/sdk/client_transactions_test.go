@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_InTransaction(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("wh1")
+
+	t.Run("commits when function succeeds", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER WAREHOUSE "wh1" SET COMMENT = 'a'`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`ALTER WAREHOUSE "wh1" UNSET COMMENT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := client.InTransaction(ctx, func(tx *Client) error {
+			assert.True(t, tx.IsInTransaction())
+			if err := tx.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Set: &WarehouseSet{Comment: String("a")}}); err != nil {
+				return err
+			}
+			return tx.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Unset: &WarehouseUnset{Comment: Bool(true)}})
+		})
+		require.NoError(t, err)
+		assert.False(t, client.IsInTransaction())
+	})
+
+	t.Run("rolls back when function fails", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		failure := errors.New("failure")
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER WAREHOUSE "wh1" SET COMMENT = 'a'`).WillReturnError(failure)
+		mock.ExpectRollback()
+
+		err := client.InTransaction(ctx, func(tx *Client) error {
+			return tx.Warehouses.Alter(ctx, id, &AlterWarehouseOptions{Set: &WarehouseSet{Comment: String("a")}})
+		})
+		require.ErrorIs(t, err, failure)
+	})
+
+	t.Run("returns rollback error together with the function error", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		failure := errors.New("failure")
+		rollbackFailure := errors.New("rollback failure")
+		mock.ExpectBegin()
+		mock.ExpectRollback().WillReturnError(rollbackFailure)
+
+		err := client.InTransaction(ctx, func(tx *Client) error {
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+		require.ErrorIs(t, err, rollbackFailure)
+	})
+
+	t.Run("rolls back on panic", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		require.PanicsWithValue(t, "boom", func() {
+			_ = client.InTransaction(ctx, func(tx *Client) error {
+				panic("boom")
+			})
+		})
+	})
+
+	t.Run("nested transaction joins the outer one", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`DROP WAREHOUSE "wh1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := client.InTransaction(ctx, func(tx *Client) error {
+			return tx.InTransaction(ctx, func(nested *Client) error {
+				assert.Same(t, tx, nested)
+				return nested.Warehouses.Drop(ctx, id, nil)
+			})
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when transaction can't be started", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin().WillReturnError(errors.New("no connection"))
+
+		err := client.InTransaction(ctx, func(tx *Client) error {
+			t.Fatal("function should not be called")
+			return nil
+		})
+		require.ErrorContains(t, err, "begin transaction: no connection")
+	})
+}
+
+func TestClient_QueryMultiStatementUnsafe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns all result sets", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery("SELECT 1 AS A;\nSELECT 2 AS B").WillReturnRows(
+			sqlmock.NewRows([]string{"A"}).AddRow(1),
+			sqlmock.NewRows([]string{"B"}).AddRow(2).AddRow(3),
+		)
+
+		resultSets, err := client.QueryMultiStatementUnsafe(ctx, "SELECT 1 AS A", "SELECT 2 AS B")
+		require.NoError(t, err)
+		require.Len(t, resultSets, 2)
+		require.Len(t, resultSets[0], 1)
+		assert.EqualValues(t, 1, *resultSets[0][0]["A"])
+		require.Len(t, resultSets[1], 2)
+		assert.EqualValues(t, 2, *resultSets[1][0]["B"])
+		assert.EqualValues(t, 3, *resultSets[1][1]["B"])
+	})
+
+	t.Run("no statements", func(t *testing.T) {
+		client, _ := newMockClient(t)
+
+		_, err := client.QueryMultiStatementUnsafe(ctx)
+		require.ErrorContains(t, err, "at least one statement has to be specified")
+	})
+}
@@ -194,7 +194,9 @@ func ReturnNullValuesPointer(v ReturnNullValues) *ReturnNullValues {
 	return &v
 }
 
-type Secret struct {
+// SecretReference is a secret passed to the handler code of a function or procedure (SECRETS = ('<variable>' = <secret>)).
+// It was named Secret before; the name is now used by the secret object itself (see Secrets).
+type SecretReference struct {
 	VariableName string `ddl:"keyword,single_quotes"`
 	Name         string `ddl:"parameter,no_quotes"`
 }
@@ -27,8 +27,11 @@ const (
 	DataTypeArray        DataType = "ARRAY"
 	DataTypeGeography    DataType = "GEOGRAPHY"
 	DataTypeGeometry     DataType = "GEOMETRY"
+	DataTypeVector       DataType = "VECTOR"
 )
 
+// ToDataType returns the base data type, dropping all its parameters (e.g. NUMBER for NUMBER(38,2)).
+// Use ParseDataType to keep the parameters.
 func ToDataType(s string) (DataType, error) {
 	dType := strings.ToUpper(s)
 
@@ -47,6 +50,10 @@ func ToDataType(s string) (DataType, error) {
 		return DataTypeGeometry, nil
 	}
 
+	if strings.HasPrefix(dType, "VECTOR") {
+		return DataTypeVector, nil
+	}
+
 	numberSynonyms := []string{"NUMBER", "DECIMAL", "NUMERIC", "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT"}
 	if slices.ContainsFunc(numberSynonyms, func(s string) bool { return strings.HasPrefix(dType, s) }) {
 		return DataTypeNumber, nil
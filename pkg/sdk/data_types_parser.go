@@ -0,0 +1,446 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultNumberPrecision = 38
+	DefaultNumberScale     = 0
+	DefaultVarcharLength   = 16777216
+	DefaultCharLength      = 1
+	DefaultBinaryLength    = 8388608
+	DefaultTimePrecision   = 9
+	MaxVectorDimension     = 4096
+)
+
+// ParsedDataType is the structured representation of a data type returned by ParseDataType.
+// Parameters which were not specified are nil and have the Snowflake defaults when the data type is rendered
+// or compared, e.g. NUMBER is the same as NUMBER(38,0) and VARCHAR is the same as VARCHAR(16777216).
+type ParsedDataType struct {
+	// Base is the data type with synonyms resolved, e.g. NUMBER for INT and DECIMAL or VARCHAR for STRING and TEXT.
+	Base DataType
+	// Precision and Scale of NUMBER.
+	Precision *int
+	Scale     *int
+	// Length of VARCHAR and BINARY (in characters and bytes respectively).
+	Length *int
+	// TimePrecision is the precision of fractional seconds of TIME and TIMESTAMP_* types.
+	TimePrecision *int
+	// ElementType of structured ARRAY and VECTOR.
+	ElementType *ParsedDataType
+	// Fields of structured OBJECT.
+	Fields []ObjectField
+	// Dimension of VECTOR.
+	Dimension *int
+}
+
+// ObjectField is a field of structured OBJECT, e.g. city VARCHAR in OBJECT(city VARCHAR, state VARCHAR).
+type ObjectField struct {
+	Name string
+	Type ParsedDataType
+}
+
+type dataTypeSynonym struct {
+	base DataType
+	// withoutParameters is set for synonyms which can't be parametrized, e.g. INT (which is always NUMBER(38,0)).
+	withoutParameters bool
+	// defaultLength overrides the default length of VARCHAR synonyms (CHAR is VARCHAR(1)).
+	defaultLength int
+}
+
+// dataTypeSynonyms are based on https://docs.snowflake.com/en/sql-reference/intro-summary-data-types.
+var dataTypeSynonyms = map[string]dataTypeSynonym{
+	"NUMBER":                         {base: DataTypeNumber},
+	"DECIMAL":                        {base: DataTypeNumber},
+	"DEC":                            {base: DataTypeNumber},
+	"NUMERIC":                        {base: DataTypeNumber},
+	"INT":                            {base: DataTypeNumber, withoutParameters: true},
+	"INTEGER":                        {base: DataTypeNumber, withoutParameters: true},
+	"BIGINT":                         {base: DataTypeNumber, withoutParameters: true},
+	"SMALLINT":                       {base: DataTypeNumber, withoutParameters: true},
+	"TINYINT":                        {base: DataTypeNumber, withoutParameters: true},
+	"BYTEINT":                        {base: DataTypeNumber, withoutParameters: true},
+	"FLOAT":                          {base: DataTypeFloat},
+	"FLOAT4":                         {base: DataTypeFloat},
+	"FLOAT8":                         {base: DataTypeFloat},
+	"DOUBLE":                         {base: DataTypeFloat},
+	"DOUBLE PRECISION":               {base: DataTypeFloat},
+	"REAL":                           {base: DataTypeFloat},
+	"VARCHAR":                        {base: DataTypeVARCHAR},
+	"STRING":                         {base: DataTypeVARCHAR},
+	"TEXT":                           {base: DataTypeVARCHAR},
+	"NVARCHAR":                       {base: DataTypeVARCHAR},
+	"NVARCHAR2":                      {base: DataTypeVARCHAR},
+	"CHAR VARYING":                   {base: DataTypeVARCHAR},
+	"NCHAR VARYING":                  {base: DataTypeVARCHAR},
+	"CHAR":                           {base: DataTypeVARCHAR, defaultLength: DefaultCharLength},
+	"CHARACTER":                      {base: DataTypeVARCHAR, defaultLength: DefaultCharLength},
+	"NCHAR":                          {base: DataTypeVARCHAR, defaultLength: DefaultCharLength},
+	"BINARY":                         {base: DataTypeBinary},
+	"VARBINARY":                      {base: DataTypeBinary},
+	"BOOLEAN":                        {base: DataTypeBoolean},
+	"BOOL":                           {base: DataTypeBoolean},
+	"DATE":                           {base: DataTypeDate},
+	"TIME":                           {base: DataTypeTime},
+	"DATETIME":                       {base: DataTypeTimestampNTZ},
+	"TIMESTAMP":                      {base: DataTypeTimestampNTZ},
+	"TIMESTAMP_NTZ":                  {base: DataTypeTimestampNTZ},
+	"TIMESTAMPNTZ":                   {base: DataTypeTimestampNTZ},
+	"TIMESTAMP WITHOUT TIME ZONE":    {base: DataTypeTimestampNTZ},
+	"TIMESTAMP_LTZ":                  {base: DataTypeTimestampLTZ},
+	"TIMESTAMPLTZ":                   {base: DataTypeTimestampLTZ},
+	"TIMESTAMP WITH LOCAL TIME ZONE": {base: DataTypeTimestampLTZ},
+	"TIMESTAMP_TZ":                   {base: DataTypeTimestampTZ},
+	"TIMESTAMPTZ":                    {base: DataTypeTimestampTZ},
+	"TIMESTAMP WITH TIME ZONE":       {base: DataTypeTimestampTZ},
+	"VARIANT":                        {base: DataTypeVariant},
+	"OBJECT":                         {base: DataTypeObject},
+	"ARRAY":                          {base: DataTypeArray},
+	"GEOGRAPHY":                      {base: DataTypeGeography},
+	"GEOMETRY":                       {base: DataTypeGeometry},
+	"VECTOR":                         {base: DataTypeVector},
+}
+
+// maxDataTypeSynonymWords is the number of words in the longest synonym (TIMESTAMP WITH LOCAL TIME ZONE).
+const maxDataTypeSynonymWords = 5
+
+// ParseDataType parses the data type with all its parameters, e.g. NUMBER(38,2), VARCHAR(16), TIMESTAMP_TZ(3),
+// ARRAY(NUMBER), OBJECT(a VARCHAR, b NUMBER) or VECTOR(FLOAT, 256). Synonyms are resolved to their base types.
+func ParseDataType(dataType string) (ParsedDataType, error) {
+	p := &dataTypeParser{input: dataType}
+	if err := p.tokenize(); err != nil {
+		return ParsedDataType{}, p.error(err.Error())
+	}
+	parsed, err := p.parseDataType()
+	if err != nil {
+		return ParsedDataType{}, err
+	}
+	if !p.done() {
+		return ParsedDataType{}, p.error(fmt.Sprintf("unexpected %q at position %d", p.peek().text, p.peek().position))
+	}
+	return parsed, nil
+}
+
+// String returns the canonical form of the data type with all defaults filled in, e.g. NUMBER(38,0) for INT.
+func (d ParsedDataType) String() string {
+	switch d.Base {
+	case DataTypeNumber:
+		return fmt.Sprintf("%s(%d,%d)", d.Base, valueOrDefault(d.Precision, DefaultNumberPrecision), valueOrDefault(d.Scale, DefaultNumberScale))
+	case DataTypeVARCHAR:
+		return fmt.Sprintf("%s(%d)", d.Base, valueOrDefault(d.Length, DefaultVarcharLength))
+	case DataTypeBinary:
+		return fmt.Sprintf("%s(%d)", d.Base, valueOrDefault(d.Length, DefaultBinaryLength))
+	case DataTypeTime, DataTypeTimestampNTZ, DataTypeTimestampLTZ, DataTypeTimestampTZ:
+		return fmt.Sprintf("%s(%d)", d.Base, valueOrDefault(d.TimePrecision, DefaultTimePrecision))
+	case DataTypeArray:
+		if d.ElementType != nil {
+			return fmt.Sprintf("%s(%s)", d.Base, d.ElementType)
+		}
+	case DataTypeObject:
+		if len(d.Fields) > 0 {
+			fields := make([]string, len(d.Fields))
+			for i, field := range d.Fields {
+				fields[i] = fmt.Sprintf("%s %s", objectFieldName(field.Name), field.Type)
+			}
+			return fmt.Sprintf("%s(%s)", d.Base, strings.Join(fields, ", "))
+		}
+	case DataTypeVector:
+		elementType := "FLOAT"
+		if d.ElementType != nil && d.ElementType.Base == DataTypeNumber {
+			elementType = "INT"
+		}
+		return fmt.Sprintf("%s(%s, %d)", d.Base, elementType, valueOrDefault(d.Dimension, 0))
+	}
+	return string(d.Base)
+}
+
+// Equal checks if both data types are the same after filling in the defaults, e.g. NUMBER is equal to NUMBER(38,0),
+// but not to NUMBER(38,2).
+func (d ParsedDataType) Equal(other ParsedDataType) bool {
+	return d.String() == other.String()
+}
+
+// AreDataTypesEqual parses both data types and checks if they're equal (see ParsedDataType.Equal).
+// Data types which can't be parsed are not equal to anything.
+func AreDataTypesEqual(a, b string) bool {
+	aDataType, err := ParseDataType(a)
+	if err != nil {
+		return false
+	}
+	bDataType, err := ParseDataType(b)
+	if err != nil {
+		return false
+	}
+	return aDataType.Equal(bDataType)
+}
+
+// AreBaseDataTypesEqual parses both data types and checks if their base types are equal, ignoring the parameters,
+// e.g. NUMBER(10,2) is equal to NUMBER and VARCHAR(100) to STRING. Data types which can't be parsed are not equal to anything.
+func AreBaseDataTypesEqual(a, b string) bool {
+	aDataType, err := ParseDataType(a)
+	if err != nil {
+		return false
+	}
+	bDataType, err := ParseDataType(b)
+	if err != nil {
+		return false
+	}
+	return aDataType.Base == bDataType.Base
+}
+
+// toDataTypeWithParameters returns the data type as it is (with parameters) if it can be parsed,
+// otherwise it falls back to the base data type returned by ToDataType.
+func toDataTypeWithParameters(dataType string) (DataType, error) {
+	if _, err := ParseDataType(dataType); err == nil {
+		return DataType(strings.TrimSpace(dataType)), nil
+	}
+	return ToDataType(dataType)
+}
+
+func valueOrDefault(value *int, defaultValue int) int {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+type dataTypeTokenKind int
+
+const (
+	dataTypeTokenWord dataTypeTokenKind = iota
+	dataTypeTokenNumber
+	dataTypeTokenQuoted
+	dataTypeTokenPunctuation
+)
+
+type dataTypeToken struct {
+	kind     dataTypeTokenKind
+	text     string
+	position int
+}
+
+type dataTypeParser struct {
+	input  string
+	tokens []dataTypeToken
+	next   int
+}
+
+func (p *dataTypeParser) tokenize() error {
+	input := p.input
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == ',':
+			p.tokens = append(p.tokens, dataTypeToken{kind: dataTypeTokenPunctuation, text: string(c), position: i})
+			i++
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(input) && input[i] >= '0' && input[i] <= '9' {
+				i++
+			}
+			p.tokens = append(p.tokens, dataTypeToken{kind: dataTypeTokenNumber, text: input[start:i], position: start})
+		case c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c == '_':
+			start := i
+			for i < len(input) && isDataTypeWordCharacter(input[i]) {
+				i++
+			}
+			p.tokens = append(p.tokens, dataTypeToken{kind: dataTypeTokenWord, text: input[start:i], position: start})
+		case c == '"':
+			builder := new(strings.Builder)
+			start := i
+			for i++; ; i++ {
+				if i >= len(input) {
+					return fmt.Errorf("unterminated quoted name starting at position %d", start)
+				}
+				if input[i] == '"' {
+					if i+1 < len(input) && input[i+1] == '"' {
+						i++
+					} else {
+						i++
+						break
+					}
+				}
+				builder.WriteByte(input[i])
+			}
+			p.tokens = append(p.tokens, dataTypeToken{kind: dataTypeTokenQuoted, text: builder.String(), position: start})
+		default:
+			return fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return nil
+}
+
+func isDataTypeWordCharacter(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '$'
+}
+
+func (p *dataTypeParser) error(message string) error {
+	return NewError(fmt.Sprintf("invalid data type %s: %s", p.input, message))
+}
+
+func (p *dataTypeParser) done() bool {
+	return p.next >= len(p.tokens)
+}
+
+func (p *dataTypeParser) peek() dataTypeToken {
+	if p.done() {
+		return dataTypeToken{position: len(p.input)}
+	}
+	return p.tokens[p.next]
+}
+
+func (p *dataTypeParser) accept(punctuation string) bool {
+	if token := p.peek(); token.kind == dataTypeTokenPunctuation && token.text == punctuation {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *dataTypeParser) expect(punctuation string) error {
+	if p.accept(punctuation) {
+		return nil
+	}
+	if p.done() {
+		return p.error(fmt.Sprintf("expected %q at the end", punctuation))
+	}
+	return p.error(fmt.Sprintf("expected %q at position %d, got %q", punctuation, p.peek().position, p.peek().text))
+}
+
+func (p *dataTypeParser) expectInt(name string, min int, max int) (*int, error) {
+	token := p.peek()
+	if token.kind != dataTypeTokenNumber {
+		return nil, p.error(fmt.Sprintf("expected %s at position %d", name, token.position))
+	}
+	p.next++
+	value, err := strconv.Atoi(token.text)
+	if err != nil || value < min || value > max {
+		return nil, p.error(fmt.Sprintf("%s has to be between %d and %d, got %s", name, min, max, token.text))
+	}
+	return &value, nil
+}
+
+// parseName reads the longest synonym made of the following words, e.g. TIMESTAMP WITH TIME ZONE.
+func (p *dataTypeParser) parseName() (string, dataTypeSynonym, error) {
+	words := make([]string, 0, maxDataTypeSynonymWords)
+	for i := p.next; i < len(p.tokens) && len(words) < maxDataTypeSynonymWords && p.tokens[i].kind == dataTypeTokenWord; i++ {
+		words = append(words, strings.ToUpper(p.tokens[i].text))
+	}
+	for n := len(words); n > 0; n-- {
+		name := strings.Join(words[:n], " ")
+		if synonym, ok := dataTypeSynonyms[name]; ok {
+			p.next += n
+			return name, synonym, nil
+		}
+	}
+	if len(words) == 0 {
+		return "", dataTypeSynonym{}, p.error(fmt.Sprintf("expected data type at position %d", p.peek().position))
+	}
+	return "", dataTypeSynonym{}, p.error(fmt.Sprintf("unknown data type %s at position %d", words[0], p.peek().position))
+}
+
+func (p *dataTypeParser) parseDataType() (ParsedDataType, error) {
+	name, synonym, err := p.parseName()
+	if err != nil {
+		return ParsedDataType{}, err
+	}
+	dataType := ParsedDataType{Base: synonym.base}
+	if synonym.defaultLength > 0 {
+		dataType.Length = Int(synonym.defaultLength)
+	}
+	if !p.accept("(") {
+		if synonym.base == DataTypeVector {
+			return ParsedDataType{}, p.error("VECTOR requires the element type and the dimension, e.g. VECTOR(FLOAT, 256)")
+		}
+		return dataType, nil
+	}
+	if synonym.withoutParameters {
+		return ParsedDataType{}, p.error(fmt.Sprintf("%s can't have parameters", name))
+	}
+
+	switch synonym.base {
+	case DataTypeNumber:
+		if dataType.Precision, err = p.expectInt("precision", 1, DefaultNumberPrecision); err != nil {
+			return ParsedDataType{}, err
+		}
+		if p.accept(",") {
+			if dataType.Scale, err = p.expectInt("scale", 0, *dataType.Precision); err != nil {
+				return ParsedDataType{}, err
+			}
+		}
+	case DataTypeVARCHAR:
+		if dataType.Length, err = p.expectInt("length", 1, DefaultVarcharLength); err != nil {
+			return ParsedDataType{}, err
+		}
+	case DataTypeBinary:
+		if dataType.Length, err = p.expectInt("length", 1, DefaultBinaryLength); err != nil {
+			return ParsedDataType{}, err
+		}
+	case DataTypeTime, DataTypeTimestampNTZ, DataTypeTimestampLTZ, DataTypeTimestampTZ:
+		if dataType.TimePrecision, err = p.expectInt("precision", 0, DefaultTimePrecision); err != nil {
+			return ParsedDataType{}, err
+		}
+	case DataTypeArray:
+		elementType, err := p.parseDataType()
+		if err != nil {
+			return ParsedDataType{}, err
+		}
+		dataType.ElementType = &elementType
+	case DataTypeObject:
+		if dataType.Fields, err = p.parseObjectFields(); err != nil {
+			return ParsedDataType{}, err
+		}
+	case DataTypeVector:
+		elementType, err := p.parseDataType()
+		if err != nil {
+			return ParsedDataType{}, err
+		}
+		if !(elementType.Base == DataTypeNumber && elementType.Precision == nil || elementType.Base == DataTypeFloat) {
+			return ParsedDataType{}, p.error(fmt.Sprintf("VECTOR element type has to be INT or FLOAT, got %s", elementType))
+		}
+		dataType.ElementType = &elementType
+		if err := p.expect(","); err != nil {
+			return ParsedDataType{}, err
+		}
+		if dataType.Dimension, err = p.expectInt("dimension", 1, MaxVectorDimension); err != nil {
+			return ParsedDataType{}, err
+		}
+	default:
+		return ParsedDataType{}, p.error(fmt.Sprintf("%s can't have parameters", name))
+	}
+	if err := p.expect(")"); err != nil {
+		return ParsedDataType{}, err
+	}
+	return dataType, nil
+}
+
+func (p *dataTypeParser) parseObjectFields() ([]ObjectField, error) {
+	fields := make([]ObjectField, 0)
+	for {
+		token := p.peek()
+		if token.kind != dataTypeTokenWord && token.kind != dataTypeTokenQuoted {
+			return nil, p.error(fmt.Sprintf("expected OBJECT field name at position %d", token.position))
+		}
+		p.next++
+		fieldType, err := p.parseDataType()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, ObjectField{Name: token.text, Type: fieldType})
+		if !p.accept(",") {
+			return fields, nil
+		}
+	}
+}
+
+func objectFieldName(name string) string {
+	if unquotedIdentifierRegexp.MatchString(name) {
+		return name
+	}
+	return quoteIdentifier(name)
+}
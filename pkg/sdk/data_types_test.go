@@ -77,6 +77,7 @@ func TestToDataType(t *testing.T) {
 		{input: "array", want: DataTypeArray},
 		{input: "geography", want: DataTypeGeography},
 		{input: "geometry", want: DataTypeGeometry},
+		{input: "vector(int, 16)", want: DataTypeVector},
 	}
 
 	for _, tc := range tests {
@@ -136,3 +137,148 @@ func TestIsStringType(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDataType(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// defaults are filled in.
+		{input: "number", want: "NUMBER(38,0)"},
+		{input: "NUMBER(10)", want: "NUMBER(10,0)"},
+		{input: "NUMBER(38, 2)", want: "NUMBER(38,2)"},
+		{input: "decimal(12,4)", want: "NUMBER(12,4)"},
+		{input: "dec", want: "NUMBER(38,0)"},
+		{input: "int", want: "NUMBER(38,0)"},
+		{input: "double precision", want: "FLOAT"},
+		{input: "varchar", want: "VARCHAR(16777216)"},
+		{input: "string", want: "VARCHAR(16777216)"},
+		{input: "varchar(100)", want: "VARCHAR(100)"},
+		{input: "char", want: "VARCHAR(1)"},
+		{input: "character(10)", want: "VARCHAR(10)"},
+		{input: "char varying(10)", want: "VARCHAR(10)"},
+		{input: "binary", want: "BINARY(8388608)"},
+		{input: "varbinary(16)", want: "BINARY(16)"},
+		{input: "time", want: "TIME(9)"},
+		{input: "time(3)", want: "TIME(3)"},
+		{input: "datetime", want: "TIMESTAMP_NTZ(9)"},
+		{input: "timestamp(0)", want: "TIMESTAMP_NTZ(0)"},
+		{input: "timestamp without time zone", want: "TIMESTAMP_NTZ(9)"},
+		{input: "TIMESTAMP WITH LOCAL TIME ZONE(6)", want: "TIMESTAMP_LTZ(6)"},
+		{input: "timestamp with time zone", want: "TIMESTAMP_TZ(9)"},
+		{input: "timestamptz(3)", want: "TIMESTAMP_TZ(3)"},
+		{input: "variant", want: "VARIANT"},
+
+		// structured types.
+		{input: "array", want: "ARRAY"},
+		{input: "array(int)", want: "ARRAY(NUMBER(38,0))"},
+		{input: "ARRAY(ARRAY(VARCHAR(10)))", want: "ARRAY(ARRAY(VARCHAR(10)))"},
+		{input: "object", want: "OBJECT"},
+		{input: "object(city varchar, zip number(5))", want: "OBJECT(city VARCHAR(16777216), zip NUMBER(5,0))"},
+		{input: `OBJECT("first name" STRING, "a""b" ARRAY(INT))`, want: `OBJECT("first name" VARCHAR(16777216), "a""b" ARRAY(NUMBER(38,0)))`},
+		{input: "vector(int, 16)", want: "VECTOR(INT, 16)"},
+		{input: "VECTOR(FLOAT, 256)", want: "VECTOR(FLOAT, 256)"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDataType(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.String())
+		})
+	}
+
+	t.Run("keeps parameters", func(t *testing.T) {
+		got, err := ParseDataType("NUMBER(38,2)")
+		require.NoError(t, err)
+		require.Equal(t, DataTypeNumber, got.Base)
+		require.Equal(t, Int(38), got.Precision)
+		require.Equal(t, Int(2), got.Scale)
+		require.Nil(t, got.Length)
+	})
+
+	invalid := []struct {
+		input string
+		err   string
+	}{
+		{input: "", err: "expected data type at position 0"},
+		{input: "unknown", err: "unknown data type UNKNOWN"},
+		{input: "int(10)", err: "INT can't have parameters"},
+		{input: "boolean(1)", err: "BOOLEAN can't have parameters"},
+		{input: "number(39)", err: "precision has to be between 1 and 38, got 39"},
+		{input: "number(10,11)", err: "scale has to be between 0 and 10, got 11"},
+		{input: "varchar(0)", err: "length has to be between 1 and 16777216, got 0"},
+		{input: "time(10)", err: "precision has to be between 0 and 9, got 10"},
+		{input: "number(10", err: `expected ")" at the end`},
+		{input: "varchar(10) not null", err: `unexpected "not" at position 12`},
+		{input: "vector(int)", err: `expected "," at position 10, got ")"`},
+		{input: "vector(varchar, 10)", err: "VECTOR element type has to be INT or FLOAT"},
+		{input: "vector(float, 4097)", err: "dimension has to be between 1 and 4096, got 4097"},
+		{input: "vector", err: "VECTOR requires the element type and the dimension"},
+		{input: "object(a)", err: "expected data type at position 8"},
+		{input: `object("a varchar)`, err: "unterminated quoted name starting at position 7"},
+		{input: "varchar(10);", err: `unexpected character ';' at position 11`},
+	}
+	for _, tc := range invalid {
+		tc := tc
+		t.Run("invalid "+tc.input, func(t *testing.T) {
+			_, err := ParseDataType(tc.input)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestAreDataTypesEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "NUMBER", b: "NUMBER(38,0)", equal: true},
+		{a: "INT", b: "number(38, 0)", equal: true},
+		{a: "NUMBER", b: "NUMBER(38,2)", equal: false},
+		{a: "VARCHAR", b: "STRING", equal: true},
+		{a: "VARCHAR", b: "VARCHAR(16777216)", equal: true},
+		{a: "VARCHAR(10)", b: "TEXT(10)", equal: true},
+		{a: "VARCHAR(10)", b: "VARCHAR(20)", equal: false},
+		{a: "CHAR", b: "VARCHAR(1)", equal: true},
+		{a: "CHAR", b: "VARCHAR", equal: false},
+		{a: "TIMESTAMP", b: "TIMESTAMP_NTZ(9)", equal: true},
+		{a: "TIMESTAMP_TZ(3)", b: "TIMESTAMP_TZ", equal: false},
+		{a: "ARRAY(INT)", b: "ARRAY(NUMBER(38,0))", equal: true},
+		{a: "ARRAY(INT)", b: "ARRAY", equal: false},
+		{a: "VECTOR(INT, 3)", b: "vector(integer, 3)", equal: true},
+		{a: "TABLE(a NUMBER)", b: "TABLE(a NUMBER)", equal: false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			require.Equal(t, tc.equal, AreDataTypesEqual(tc.a, tc.b))
+			require.Equal(t, tc.equal, AreDataTypesEqual(tc.b, tc.a))
+		})
+	}
+}
+
+func TestAreBaseDataTypesEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "NUMBER(10,2)", b: "NUMBER", equal: true},
+		{a: "INT", b: "NUMBER(38,2)", equal: true},
+		{a: "VARCHAR(100)", b: "VARCHAR", equal: true},
+		{a: "CHAR", b: "STRING", equal: true},
+		{a: "TIMESTAMP_TZ(3)", b: "TIMESTAMP_TZ", equal: true},
+		{a: "ARRAY(INT)", b: "ARRAY", equal: true},
+		{a: "NUMBER", b: "FLOAT", equal: false},
+		{a: "VARCHAR", b: "BINARY", equal: false},
+		{a: "TIMESTAMP", b: "TIMESTAMP_TZ", equal: false},
+		{a: "TABLE(a NUMBER)", b: "TABLE(a NUMBER)", equal: false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			require.Equal(t, tc.equal, AreBaseDataTypesEqual(tc.a, tc.b))
+			require.Equal(t, tc.equal, AreBaseDataTypesEqual(tc.b, tc.a))
+		})
+	}
+}
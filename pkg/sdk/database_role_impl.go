@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ DatabaseRoles = (*databaseRoles)(nil)
 
@@ -46,7 +42,7 @@ func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifie
 		return nil, err
 	}
 
-	return collections.FindOne(databaseRoles, func(r DatabaseRole) bool { return r.Name == id.Name() })
+	return findOne(databaseRoles, func(r DatabaseRole) bool { return r.Name == id.Name() })
 }
 
 func (v *databaseRoles) Grant(ctx context.Context, request *GrantDatabaseRoleRequest) error {
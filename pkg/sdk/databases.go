@@ -179,6 +179,7 @@ func (v *databases) Create(ctx context.Context, id AccountObjectIdentifier, opts
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -221,6 +222,7 @@ func (v *databases) CreateShared(ctx context.Context, id AccountObjectIdentifier
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -261,6 +263,7 @@ func (v *databases) CreateSecondary(ctx context.Context, id AccountObjectIdentif
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -341,6 +344,7 @@ func (v *databases) Alter(ctx context.Context, id AccountObjectIdentifier, opts
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id, opts.NewName, opts.SwapWith)
 	return err
 }
 
@@ -408,6 +412,7 @@ func (v *databases) AlterReplication(ctx context.Context, id AccountObjectIdenti
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -474,6 +479,7 @@ func (v *databases) AlterFailover(ctx context.Context, id AccountObjectIdentifie
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -508,6 +514,7 @@ func (v *databases) Drop(ctx context.Context, id AccountObjectIdentifier, opts *
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -540,6 +547,7 @@ func (v *databases) Undrop(ctx context.Context, id AccountObjectIdentifier) erro
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeDatabase, id)
 	return err
 }
 
@@ -572,20 +580,15 @@ func (v *databases) Show(ctx context.Context, opts *ShowDatabasesOptions) ([]Dat
 }
 
 func (v *databases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
-	databases, err := v.client.Databases.Show(ctx, &ShowDatabasesOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+	return showByIDWithCache(v.client, ObjectTypeDatabase, id, func(database Database) bool { return database.ID() == id }, func() ([]Database, error) {
+		return v.client.Databases.Show(ctx, &ShowDatabasesOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+		})
+	}, func() ([]Database, error) {
+		return v.client.Databases.Show(ctx, nil)
 	})
-	if err != nil {
-		return nil, err
-	}
-	for _, database := range databases {
-		if database.ID() == id {
-			return &database, nil
-		}
-	}
-	return nil, ErrObjectNotExistOrAuthorized
 }
 
 type DatabaseDetails struct {
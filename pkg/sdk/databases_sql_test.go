@@ -0,0 +1,164 @@
+package sdk
+
+import "testing"
+
+func TestDatabases_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			// clone is rendered separately by Databases.Create
+			clearSQLFixtureField(opts, "Clone")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateShared", func(t *testing.T) {
+		opts := &CreateSharedDatabaseOptions{
+			name:      sqlFixtureIdentifier[AccountObjectIdentifier](),
+			fromShare: NewExternalObjectIdentifier(NewAccountIdentifier("organization", "account"), NewAccountObjectIdentifier("share")),
+		}
+		fillSQLFixture(opts)
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("CreateSecondary", func(t *testing.T) {
+		opts := &CreateSecondaryDatabaseOptions{
+			name:            sqlFixtureIdentifier[AccountObjectIdentifier](),
+			primaryDatabase: NewExternalObjectIdentifier(NewAccountIdentifier("organization", "account"), NewAccountObjectIdentifier("primary_database")),
+		}
+		fillSQLFixture(opts)
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("NewName", func(t *testing.T) {
+			opts := &AlterDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "IfExists")
+			fillSQLFixtureField(opts, "NewName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SwapWith", func(t *testing.T) {
+			opts := &AlterDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SwapWith")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			// tags are unset separately from the other properties
+			clearSQLFixtureField(opts, "Unset.Tag")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTag", func(t *testing.T) {
+			opts := &AlterDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset.Tag")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterReplication", func(t *testing.T) {
+		t.Run("EnableReplication", func(t *testing.T) {
+			opts := &AlterDatabaseReplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "EnableReplication")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DisableReplication", func(t *testing.T) {
+			opts := &AlterDatabaseReplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DisableReplication")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Refresh", func(t *testing.T) {
+			opts := &AlterDatabaseReplicationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Refresh")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterFailover", func(t *testing.T) {
+		t.Run("EnableFailover", func(t *testing.T) {
+			opts := &AlterDatabaseFailoverOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "EnableFailover")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DisableFailover", func(t *testing.T) {
+			opts := &AlterDatabaseFailoverOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DisableFailover")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Primary", func(t *testing.T) {
+			opts := &AlterDatabaseFailoverOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Primary")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropDatabaseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Undrop", func(t *testing.T) {
+		opts := &undropDatabaseOptions{
+			name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowDatabasesOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowDatabasesOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		opts := &describeDatabaseOptions{
+			name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+}
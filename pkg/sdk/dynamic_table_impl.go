@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ DynamicTables = (*dynamicTables)(nil)
 
@@ -52,7 +48,7 @@ func (v *dynamicTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(dynamicTables, func(r DynamicTable) bool { return r.Name == id.Name() })
+	return findOne(dynamicTables, func(r DynamicTable) bool { return r.Name == id.Name() })
 }
 
 func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
@@ -7,6 +7,9 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/snowflakedb/gosnowflake"
 )
 
 var (
@@ -16,12 +19,33 @@ var (
 	// go-snowflake errors.
 	ErrObjectNotExistOrAuthorized = NewError("object does not exist or not authorized")
 	ErrAccountIsEmpty             = NewError("account is empty")
+	ErrObjectAlreadyExists        = NewError("object already exists")
+	ErrInsufficientPrivileges     = NewError("insufficient privileges")
+	ErrWarehouseSuspended         = NewError("warehouse suspended or not available")
+	ErrStatementTimeout           = NewError("statement timeout")
+	ErrSessionExpired             = NewError("session expired")
+	ErrServiceUnavailable         = NewError("service unavailable")
+	ErrConcurrentOperation        = NewError("concurrent operation conflict")
+	ErrLockWaitTimeout            = NewError("lock wait timeout")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = NewError("invalid object identifier")
 	ErrDifferentDatabase       = NewError("database must be the same")
 )
 
+// errObjectNotFound is returned by ShowByID when the object is missing from the SHOW output. It matches ErrObjectNotExistOrAuthorized,
+// so callers handle it the same way as the error returned by Snowflake for a missing object, and collections.ErrObjectNotFound.
+var errObjectNotFound = fmt.Errorf("%w: %w", ErrObjectNotExistOrAuthorized, collections.ErrObjectNotFound)
+
+// findOne returns the first object meeting the condition, or errObjectNotFound if there is none
+func findOne[T any](objects []T, condition func(T) bool) (*T, error) {
+	object, err := collections.FindOne(objects, condition)
+	if err != nil {
+		return nil, errObjectNotFound
+	}
+	return object, nil
+}
+
 type IntErrType string
 
 const (
@@ -72,22 +96,109 @@ func errInvalidValue(structName string, fieldName string, invalidValue string) e
 	return newError(fmt.Sprintf("invalid value %s of struct %s field: %s", invalidValue, structName, fieldName), 2)
 }
 
+// DriverError is returned by the client for every error coming from the driver. It carries the details reported by Snowflake
+// (error number, SQL state and query id) and, if the error is a known one, it also matches one of the sentinel errors above,
+// so both errors.Is(err, ErrObjectNotExistOrAuthorized) and errors.As(err, &driverErr) can be used on the returned value.
+type DriverError struct {
+	Number   int
+	SQLState string
+	QueryID  string
+	Message  string
+
+	kind error
+	err  error
+}
+
+func (e *DriverError) Error() string {
+	if e.kind == nil {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.kind.Error(), e.err.Error())
+}
+
+func (e *DriverError) Unwrap() []error {
+	if e.kind == nil {
+		return []error{e.err}
+	}
+	return []error{e.kind, e.err}
+}
+
+// driverErrorsByNumber maps Snowflake error numbers (https://github.com/snowflakedb/gosnowflake/blob/master/errors.go
+// and SQL compilation errors returned by the server) to sentinel errors.
+var driverErrorsByNumber = map[int]error{
+	2002:   ErrObjectAlreadyExists,
+	2003:   ErrObjectNotExistOrAuthorized,
+	2043:   ErrObjectNotExistOrAuthorized,
+	390201: ErrObjectNotExistOrAuthorized,
+	3001:   ErrInsufficientPrivileges,
+	606:    ErrWarehouseSuspended,
+	630:    ErrStatementTimeout,
+	390111: ErrSessionExpired,
+	390112: ErrSessionExpired,
+	390114: ErrSessionExpired,
+	625:    ErrLockWaitTimeout,
+	260000: ErrAccountIsEmpty,
+	260007: ErrServiceUnavailable,
+	262000: ErrServiceUnavailable,
+}
+
+var driverErrorsBySQLState = map[string]error{
+	"42501": ErrInsufficientPrivileges,
+	"57P03": ErrWarehouseSuspended,
+}
+
+// driverErrorsByMessage is used for errors that do not carry a number (e.g. errors created by the driver before sending the request).
+// The first matching substring decides the kind, so more specific messages go first.
+var driverErrorsByMessage = []struct {
+	substring string
+	kind      error
+}{
+	{"does not exist or not authorized", ErrObjectNotExistOrAuthorized},
+	{"account is empty", ErrAccountIsEmpty},
+	{"reached its statement or warehouse timeout", ErrStatementTimeout},
+	{"reached its lock timeout", ErrLockWaitTimeout},
+	{"concurrent", ErrConcurrentOperation},
+}
+
 func decodeDriverError(err error) error {
 	if err == nil {
 		return nil
 	}
 	log.Printf("[DEBUG] err: %v\n", err)
-	m := map[string]error{
-		"does not exist or not authorized": ErrObjectNotExistOrAuthorized,
-		"account is empty":                 ErrAccountIsEmpty,
+
+	driverErr := &DriverError{err: err, Message: err.Error()}
+	var sfErr *gosnowflake.SnowflakeError
+	if errors.As(err, &sfErr) {
+		driverErr.Number = sfErr.Number
+		driverErr.SQLState = sfErr.SQLState
+		driverErr.QueryID = sfErr.QueryID
+		driverErr.Message = sfErr.Message
+		if kind, ok := driverErrorsByNumber[sfErr.Number]; ok {
+			driverErr.kind = kind
+		} else if isServerSideHTTPFailure(sfErr) {
+			driverErr.kind = ErrServiceUnavailable
+		} else if kind, ok := driverErrorsBySQLState[sfErr.SQLState]; ok {
+			driverErr.kind = kind
+		}
 	}
-	for k, v := range m {
-		if strings.Contains(err.Error(), k) {
-			return v
+	if driverErr.kind == nil {
+		for _, e := range driverErrorsByMessage {
+			if strings.Contains(err.Error(), e.substring) {
+				driverErr.kind = e.kind
+				break
+			}
 		}
 	}
+	return driverErr
+}
 
-	return err
+// isServerSideHTTPFailure checks if the driver failed to post the query because of the 5xx HTTP status returned by Snowflake.
+func isServerSideHTTPFailure(err *gosnowflake.SnowflakeError) bool {
+	if err.Number != gosnowflake.ErrFailedToPostQuery || len(err.MessageArgs) == 0 {
+		return false
+	}
+	status, ok := err.MessageArgs[0].(int)
+	return ok && status >= 500
 }
 
 const errorIndentRune = '›'
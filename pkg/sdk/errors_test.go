@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,3 +134,133 @@ func TestWriteTree(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeDriverError(t *testing.T) {
+	snowflakeError := func(number int, sqlState string) error {
+		return &gosnowflake.SnowflakeError{
+			Number:   number,
+			SQLState: sqlState,
+			QueryID:  "01b0a4f1-0000-0000-0000-000000000001",
+			Message:  "some message",
+		}
+	}
+
+	testCases := map[string]struct {
+		Error    error
+		Expected error
+	}{
+		"object does not exist": {
+			Error:    snowflakeError(2003, "02000"),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+		"object does not exist - operation cannot be performed": {
+			Error:    snowflakeError(2043, "02000"),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+		"object already exists": {
+			Error:    snowflakeError(2002, "42710"),
+			Expected: ErrObjectAlreadyExists,
+		},
+		"insufficient privileges": {
+			Error:    snowflakeError(3001, "42501"),
+			Expected: ErrInsufficientPrivileges,
+		},
+		"insufficient privileges - by sql state": {
+			Error:    snowflakeError(3003, "42501"),
+			Expected: ErrInsufficientPrivileges,
+		},
+		"warehouse suspended": {
+			Error:    snowflakeError(606, "57P03"),
+			Expected: ErrWarehouseSuspended,
+		},
+		"statement timeout": {
+			Error:    snowflakeError(630, "57014"),
+			Expected: ErrStatementTimeout,
+		},
+		"session expired": {
+			Error:    snowflakeError(390112, ""),
+			Expected: ErrSessionExpired,
+		},
+		"account is empty": {
+			Error:    snowflakeError(260000, ""),
+			Expected: ErrAccountIsEmpty,
+		},
+		"service unavailable - http 5xx": {
+			Error:    &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, SQLState: "08006", Message: "failed to POST", MessageArgs: []any{503, "https://example.com"}},
+			Expected: ErrServiceUnavailable,
+		},
+		"lock wait timeout": {
+			Error:    snowflakeError(625, "57014"),
+			Expected: ErrLockWaitTimeout,
+		},
+		"wrapped driver error": {
+			Error:    fmt.Errorf("wrapped: %w", snowflakeError(2003, "02000")),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+		"non driver error - by message": {
+			Error:    errors.New("SQL compilation error: Table 'X' does not exist or not authorized."),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+		"non driver error - first matching message wins": {
+			Error:    errors.New("Object 'X' does not exist or not authorized, possibly dropped by a concurrent operation."),
+			Expected: ErrObjectNotExistOrAuthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := decodeDriverError(tc.Error)
+
+			require.ErrorIs(t, err, tc.Expected)
+			require.ErrorIs(t, err, tc.Error)
+			require.ErrorContains(t, err, tc.Error.Error())
+
+			var driverErr *DriverError
+			require.ErrorAs(t, err, &driverErr)
+			var sfErr *gosnowflake.SnowflakeError
+			if errors.As(tc.Error, &sfErr) {
+				require.Equal(t, sfErr.Number, driverErr.Number)
+				require.Equal(t, sfErr.SQLState, driverErr.SQLState)
+				require.Equal(t, sfErr.QueryID, driverErr.QueryID)
+				require.Equal(t, sfErr.Message, driverErr.Message)
+			}
+		})
+	}
+
+	t.Run("unknown error", func(t *testing.T) {
+		sfErr := snowflakeError(1234, "12345")
+		err := decodeDriverError(sfErr)
+
+		require.Equal(t, sfErr.Error(), err.Error())
+		require.ErrorIs(t, err, sfErr)
+		require.NotErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		require.NotErrorIs(t, err, ErrInsufficientPrivileges)
+	})
+
+	t.Run("failed to post with client side http error", func(t *testing.T) {
+		err := decodeDriverError(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, Message: "failed to POST", MessageArgs: []any{404, "https://example.com"}})
+
+		require.NotErrorIs(t, err, ErrServiceUnavailable)
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		require.NoError(t, decodeDriverError(nil))
+	})
+}
+
+func TestFindOne(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		found, err := findOne([]string{"a", "b"}, func(s string) bool { return s == "b" })
+
+		require.NoError(t, err)
+		require.Equal(t, "b", *found)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := findOne([]string{"a", "b"}, func(s string) bool { return s == "c" })
+
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+}
@@ -102,3 +102,6 @@ type EventTableSearchOptimizationActionRequest struct {
 type SearchOptimizationRequest struct {
 	On []string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -13,6 +13,8 @@ type EventTables interface {
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*EventTableDetails, error)
 	Drop(ctx context.Context, request *DropEventTableRequest) error
 	Alter(ctx context.Context, request *AlterEventTableRequest) error
+	// generator:protected-begin EventTables methods
+	// generator:protected-end
 }
 
 // CreateEventTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-event-table.
@@ -157,3 +159,6 @@ type SearchOptimization struct {
 	searchOptimization bool     `ddl:"static" sql:"SEARCH OPTIMIZATION"`
 	On                 []string `ddl:"keyword" sql:"ON"`
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
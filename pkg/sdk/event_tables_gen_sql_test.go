@@ -0,0 +1,142 @@
+package sdk
+
+import "testing"
+
+func TestEventTables_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowEventTableOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowEventTableOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("AddRowAccessPolicy", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "AddRowAccessPolicy")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DropRowAccessPolicy", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DropRowAccessPolicy")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DropAndAddRowAccessPolicy", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DropAndAddRowAccessPolicy")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DropAllRowAccessPolicies", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DropAllRowAccessPolicies")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("ClusteringAction", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ClusteringAction")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SearchOptimizationAction", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SearchOptimizationAction")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("RenameTo", func(t *testing.T) {
+			opts := &AlterEventTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "RenameTo")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
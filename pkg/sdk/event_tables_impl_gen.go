@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ EventTables = (*eventTables)(nil)
 
@@ -28,12 +24,14 @@ func (v *eventTables) Show(ctx context.Context, request *ShowEventTableRequest)
 }
 
 func (v *eventTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error) {
-	request := NewShowEventTableRequest().WithIn(&In{Database: NewAccountObjectIdentifier(id.DatabaseName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin eventTables.ShowByID
+	request := NewShowEventTableRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	eventTables, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(eventTables, func(r EventTable) bool { return r.Name == id.Name() })
+	return findOne(eventTables, func(r EventTable) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *eventTables) Describe(ctx context.Context, id SchemaObjectIdentifier) (*EventTableDetails, error) {
@@ -87,6 +85,7 @@ func (r *ShowEventTableRequest) toOpts() *ShowEventTableOptions {
 }
 
 func (r eventTableRow) convert() *EventTable {
+	// generator:protected-begin eventTableRow.convert
 	t := &EventTable{
 		CreatedOn:    r.CreatedOn,
 		Name:         r.Name,
@@ -103,6 +102,7 @@ func (r eventTableRow) convert() *EventTable {
 		t.OwnerRoleType = r.OwnerRoleType.String
 	}
 	return t
+	// generator:protected-end
 }
 
 func (r *DescribeEventTableRequest) toOpts() *DescribeEventTableOptions {
@@ -113,11 +113,13 @@ func (r *DescribeEventTableRequest) toOpts() *DescribeEventTableOptions {
 }
 
 func (r eventTableDetailsRow) convert() *EventTableDetails {
+	// generator:protected-begin eventTableDetailsRow.convert
 	return &EventTableDetails{
 		Name:    r.Name,
 		Kind:    r.Kind,
 		Comment: r.Comment,
 	}
+	// generator:protected-end
 }
 
 func (r *DropEventTableRequest) toOpts() *DropEventTableOptions {
@@ -131,14 +133,12 @@ func (r *DropEventTableRequest) toOpts() *DropEventTableOptions {
 
 func (r *AlterEventTableRequest) toOpts() *AlterEventTableOptions {
 	opts := &AlterEventTableOptions{
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
+		IfNotExists:              r.IfNotExists,
+		name:                     r.name,
 		DropAllRowAccessPolicies: r.DropAllRowAccessPolicies,
-
-		SetTags:   r.SetTags,
-		UnsetTags: r.UnsetTags,
-		RenameTo:  r.RenameTo,
+		SetTags:                  r.SetTags,
+		UnsetTags:                r.UnsetTags,
+		RenameTo:                 r.RenameTo,
 	}
 	if r.Set != nil {
 		opts.Set = &EventTableSet{
@@ -200,3 +200,6 @@ func (r *AlterEventTableRequest) toOpts() *AlterEventTableOptions {
 	}
 	return opts
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
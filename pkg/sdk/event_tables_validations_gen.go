@@ -19,6 +19,8 @@ func (opts *CreateEventTableOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateEventTableOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateEventTableOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -27,6 +29,8 @@ func (opts *ShowEventTableOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowEventTableOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -38,6 +42,8 @@ func (opts *DescribeEventTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeEventTableOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -49,6 +55,8 @@ func (opts *DropEventTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropEventTableOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -74,16 +82,17 @@ func (opts *AlterEventTableOptions) validate() error {
 		}
 	}
 	if valueSet(opts.DropAndAddRowAccessPolicy) {
-		if valueSet(opts.DropAndAddRowAccessPolicy.Drop) {
-			if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Drop.RowAccessPolicy) {
-				errs = append(errs, ErrInvalidObjectIdentifier)
-			}
+		if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Drop.RowAccessPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
-		if valueSet(opts.DropAndAddRowAccessPolicy.Add) {
-			if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Add.RowAccessPolicy) {
-				errs = append(errs, ErrInvalidObjectIdentifier)
-			}
+		if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Add.RowAccessPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
 	}
+	// generator:protected-begin AlterEventTableOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,81 @@
+package sdk
+
+import "testing"
+
+func TestExternalAccessIntegrations_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "AllowedNetworkRules")
+			fillSQLFixtureField(opts, "Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowExternalAccessIntegrationOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowExternalAccessIntegrationOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeExternalAccessIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -0,0 +1,93 @@
+package sdk
+
+var (
+	_ validatable = new(CreateExternalAccessIntegrationOptions)
+	_ validatable = new(AlterExternalAccessIntegrationOptions)
+	_ validatable = new(DropExternalAccessIntegrationOptions)
+	_ validatable = new(ShowExternalAccessIntegrationOptions)
+	_ validatable = new(DescribeExternalAccessIntegrationOptions)
+)
+
+func (opts *CreateExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateExternalAccessIntegrationOptions", "OrReplace", "IfNotExists"))
+	}
+	if !valueSet(opts.AllowedNetworkRules) {
+		errs = append(errs, errNotSet("CreateExternalAccessIntegrationOptions", "AllowedNetworkRules"))
+	}
+	// generator:protected-begin CreateExternalAccessIntegrationOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterExternalAccessIntegrationOptions", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.AllowedNetworkRules, opts.Set.AllowedApiAuthenticationIntegrations, opts.Set.AllowedAuthenticationSecrets, opts.Set.Enabled, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Set", "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.AllowedApiAuthenticationIntegrations, opts.Unset.AllowedAuthenticationSecrets, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Unset", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"))
+		}
+	}
+	// generator:protected-begin AlterExternalAccessIntegrationOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *DropExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin DropExternalAccessIntegrationOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// generator:protected-begin ShowExternalAccessIntegrationOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin DescribeExternalAccessIntegrationOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
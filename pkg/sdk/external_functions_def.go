@@ -46,7 +46,7 @@ var externalFunctionUnset = g.NewQueryStruct("ExternalFunctionUnset").
 var ExternalFunctionsDef = g.NewInterface(
 	"ExternalFunctions",
 	"ExternalFunction",
-	g.KindOfT[SchemaObjectIdentifier](),
+	g.KindOfT[SchemaObjectIdentifierWithArguments](),
 ).CreateOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/create-external-function",
 	g.NewQueryStruct("CreateExternalFunction").
@@ -54,7 +54,7 @@ var ExternalFunctionsDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("EXTERNAL FUNCTION").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			externalFunctionArgument,
@@ -91,7 +91,7 @@ var ExternalFunctionsDef = g.NewInterface(
 		Alter().
 		SQL("FUNCTION").
 		IfExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("ArgumentDataTypes", g.KindOfTSlice[DataType](), g.KeywordOptions().MustParentheses().Required()).
 		OptionalQueryStructField(
 			"Set",
@@ -118,7 +118,7 @@ var ExternalFunctionsDef = g.NewInterface(
 		Field("max_num_arguments", "int").
 		Field("arguments", "string").
 		Field("description", "string").
-		Field("schema_name", "sql.NullString").
+		Field("catalog_name", "sql.NullString").
 		Field("is_table_function", "string").
 		Field("valid_for_clustering", "string").
 		Field("is_secure", "sql.NullString").
@@ -148,8 +148,13 @@ var ExternalFunctionsDef = g.NewInterface(
 	g.NewQueryStruct("ShowFunctions").
 		Show().
 		SQL("EXTERNAL FUNCTIONS").
-		OptionalLike(),
-).ShowByIdOperation().DescribeOperation(
+		OptionalLike().
+		OptionalIn(),
+).ShowByIdOperationWithCustomFilter(
+	"r.Name == id.Name() && strings.Trim(r.CatalogName, `\"`) == id.DatabaseName() && strings.Trim(r.SchemaName, `\"`) == id.SchemaName() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())",
+	g.ShowByIDInFiltering,
+	g.ShowByIDLikeFiltering,
+).DescribeOperation(
 	g.DescriptionMappingKindSlice,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-function",
 	g.DbStruct("externalFunctionPropertyRow").
@@ -162,6 +167,5 @@ var ExternalFunctionsDef = g.NewInterface(
 		Describe().
 		SQL("FUNCTION").
 		Name().
-		PredefinedQueryStructField("ArgumentDataTypes", g.KindOfTSlice[DataType](), g.KeywordOptions().MustParentheses().Required()).
 		WithValidation(g.ValidIdentifier, "name"),
 )
@@ -232,11 +232,9 @@ func (s *ShowExternalFunctionRequest) WithIn(In *In) *ShowExternalFunctionReques
 }
 
 func NewDescribeExternalFunctionRequest(
-	name SchemaObjectIdentifier,
-	ArgumentDataTypes []DataType,
+	name SchemaObjectIdentifierWithArguments,
 ) *DescribeExternalFunctionRequest {
 	s := DescribeExternalFunctionRequest{}
 	s.name = name
-	s.ArgumentDataTypes = ArgumentDataTypes
 	return &s
 }
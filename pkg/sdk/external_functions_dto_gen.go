@@ -78,6 +78,8 @@ type ShowExternalFunctionRequest struct {
 }
 
 type DescribeExternalFunctionRequest struct {
-	name              SchemaObjectIdentifier // required
-	ArgumentDataTypes []DataType             // required
+	name SchemaObjectIdentifierWithArguments // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -9,8 +9,10 @@ type ExternalFunctions interface {
 	Create(ctx context.Context, request *CreateExternalFunctionRequest) error
 	Alter(ctx context.Context, request *AlterExternalFunctionRequest) error
 	Show(ctx context.Context, request *ShowExternalFunctionRequest) ([]ExternalFunction, error)
-	ShowByID(ctx context.Context, id SchemaObjectIdentifier, arguments []DataType) (*ExternalFunction, error)
-	Describe(ctx context.Context, request *DescribeExternalFunctionRequest) ([]ExternalFunctionProperty, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*ExternalFunction, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]ExternalFunctionProperty, error)
+	// generator:protected-begin ExternalFunctions methods
+	// generator:protected-end
 }
 
 // CreateExternalFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-external-function.
@@ -134,10 +136,9 @@ type ExternalFunction struct {
 
 // DescribeExternalFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-function.
 type DescribeExternalFunctionOptions struct {
-	describe          bool                   `ddl:"static" sql:"DESCRIBE"`
-	function          bool                   `ddl:"static" sql:"FUNCTION"`
-	name              SchemaObjectIdentifier `ddl:"identifier"`
-	ArgumentDataTypes []DataType             `ddl:"keyword,must_parentheses"`
+	describe bool                                `ddl:"static" sql:"DESCRIBE"`
+	function bool                                `ddl:"static" sql:"FUNCTION"`
+	name     SchemaObjectIdentifierWithArguments `ddl:"identifier"`
 }
 
 type externalFunctionPropertyRow struct {
@@ -149,3 +150,6 @@ type ExternalFunctionProperty struct {
 	Property string
 	Value    string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
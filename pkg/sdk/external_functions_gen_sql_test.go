@@ -0,0 +1,74 @@
+package sdk
+
+import "testing"
+
+func TestExternalFunctions_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ResultDataType")
+			fillSQLFixtureField(opts, "ApiIntegration")
+			fillSQLFixtureField(opts, "As")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "Set")
+			clearSQLFixtureField(opts, "Set.Headers")
+			clearSQLFixtureField(opts, "Set.ContextHeaders")
+			clearSQLFixtureField(opts, "Set.MaxBatchRows")
+			clearSQLFixtureField(opts, "Set.Compression")
+			clearSQLFixtureField(opts, "Set.RequestTranslator")
+			clearSQLFixtureField(opts, "Set.ResponseTranslator")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowExternalFunctionOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowExternalFunctionOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeExternalFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -39,8 +39,7 @@ func TestExternalFunctions_Create(t *testing.T) {
 		st := NewSchemaObjectIdentifier("", "", "")
 		opts.ResponseTranslator = &st
 		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateExternalFunctionOptions", "ApiIntegration"))
-		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateExternalFunctionOptions", "RequestTranslator"))
-		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateExternalFunctionOptions", "ResponseTranslator"))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("all options", func(t *testing.T) {
@@ -292,7 +291,7 @@ func TestExternalFunctions_Show(t *testing.T) {
 }
 
 func TestExternalFunctions_Describe(t *testing.T) {
-	id := RandomSchemaObjectIdentifier()
+	id := RandomSchemaObjectIdentifierWithArguments()
 
 	defaultOpts := func() *DescribeExternalFunctionOptions {
 		return &DescribeExternalFunctionOptions{
@@ -307,18 +306,18 @@ func TestExternalFunctions_Describe(t *testing.T) {
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.name = NewSchemaObjectIdentifierWithArguments("", "", "", nil)
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("no arguments", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s ()`, id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s()`, id.SchemaObjectId().FullyQualifiedName())
 	})
 
 	t.Run("all options", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.ArgumentDataTypes = []DataType{DataTypeVARCHAR, DataTypeNumber}
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s (VARCHAR, NUMBER)`, id.FullyQualifiedName())
+		opts.name = NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []DataType{DataTypeVARCHAR, DataTypeNumber})
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s(VARCHAR, NUMBER)`, id.SchemaObjectId().FullyQualifiedName())
 	})
 }
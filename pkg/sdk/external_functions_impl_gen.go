@@ -3,8 +3,6 @@ package sdk
 import (
 	"context"
 	"strings"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 )
 
 var _ ExternalFunctions = (*externalFunctions)(nil)
@@ -33,34 +31,23 @@ func (v *externalFunctions) Show(ctx context.Context, request *ShowExternalFunct
 	return resultList, nil
 }
 
-func (v *externalFunctions) ShowByID(ctx context.Context, id SchemaObjectIdentifier, arguments []DataType) (*ExternalFunction, error) {
-	externalFunctions, err := v.Show(ctx, NewShowExternalFunctionRequest().
-		WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
-		WithLike(&Like{Pattern: String(id.Name())}))
+func (v *externalFunctions) ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*ExternalFunction, error) {
+	// generator:protected-begin externalFunctions.ShowByID
+	request := NewShowExternalFunctionRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
+	externalFunctions, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(externalFunctions, func(r ExternalFunction) bool {
-		database := strings.Trim(r.CatalogName, `"`)
-		schema := strings.Trim(r.SchemaName, `"`)
-		if r.Name != id.Name() || database != id.DatabaseName() || schema != id.SchemaName() {
-			return false
-		}
-		var sb strings.Builder
-		sb.WriteString("(")
-		for i, argument := range arguments {
-			sb.WriteString(string(argument))
-			if i < len(arguments)-1 {
-				sb.WriteString(", ")
-			}
-		}
-		sb.WriteString(")")
-		return strings.Contains(r.Arguments, sb.String())
+	return findOne(externalFunctions, func(r ExternalFunction) bool {
+		return r.Name == id.Name() && strings.Trim(r.CatalogName, `"`) == id.DatabaseName() && strings.Trim(r.SchemaName, `"`) == id.SchemaName() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())
 	})
+	// generator:protected-end
 }
 
-func (v *externalFunctions) Describe(ctx context.Context, request *DescribeExternalFunctionRequest) ([]ExternalFunctionProperty, error) {
-	opts := request.toOpts()
+func (v *externalFunctions) Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]ExternalFunctionProperty, error) {
+	opts := &DescribeExternalFunctionOptions{
+		name: id,
+	}
 	rows, err := validateAndQuery[externalFunctionPropertyRow](v.client, ctx, opts)
 	if err != nil {
 		return nil, err
@@ -70,41 +57,47 @@ func (v *externalFunctions) Describe(ctx context.Context, request *DescribeExter
 
 func (r *CreateExternalFunctionRequest) toOpts() *CreateExternalFunctionOptions {
 	opts := &CreateExternalFunctionOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
+		OrReplace:             r.OrReplace,
+		Secure:                r.Secure,
+		name:                  r.name,
 		ResultDataType:        r.ResultDataType,
 		ReturnNullValues:      r.ReturnNullValues,
 		NullInputBehavior:     r.NullInputBehavior,
 		ReturnResultsBehavior: r.ReturnResultsBehavior,
 		Comment:               r.Comment,
 		ApiIntegration:        r.ApiIntegration,
-
-		MaxBatchRows:       r.MaxBatchRows,
-		Compression:        r.Compression,
-		RequestTranslator:  r.RequestTranslator,
-		ResponseTranslator: r.ResponseTranslator,
-		As:                 r.As,
+		MaxBatchRows:          r.MaxBatchRows,
+		Compression:           r.Compression,
+		RequestTranslator:     r.RequestTranslator,
+		ResponseTranslator:    r.ResponseTranslator,
+		As:                    r.As,
 	}
 	if r.Arguments != nil {
 		s := make([]ExternalFunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ExternalFunctionArgument(v)
+			s[i] = ExternalFunctionArgument{
+				ArgName:     v.ArgName,
+				ArgDataType: v.ArgDataType,
+			}
 		}
 		opts.Arguments = s
 	}
 	if r.Headers != nil {
 		s := make([]ExternalFunctionHeader, len(r.Headers))
 		for i, v := range r.Headers {
-			s[i] = ExternalFunctionHeader(v)
+			s[i] = ExternalFunctionHeader{
+				Name:  v.Name,
+				Value: v.Value,
+			}
 		}
 		opts.Headers = s
 	}
 	if r.ContextHeaders != nil {
 		s := make([]ExternalFunctionContextHeader, len(r.ContextHeaders))
 		for i, v := range r.ContextHeaders {
-			s[i] = ExternalFunctionContextHeader(v)
+			s[i] = ExternalFunctionContextHeader{
+				ContextFunction: v.ContextFunction,
+			}
 		}
 		opts.ContextHeaders = s
 	}
@@ -119,8 +112,7 @@ func (r *AlterExternalFunctionRequest) toOpts() *AlterExternalFunctionOptions {
 	}
 	if r.Set != nil {
 		opts.Set = &ExternalFunctionSet{
-			ApiIntegration: r.Set.ApiIntegration,
-
+			ApiIntegration:     r.Set.ApiIntegration,
 			MaxBatchRows:       r.Set.MaxBatchRows,
 			Compression:        r.Set.Compression,
 			RequestTranslator:  r.Set.RequestTranslator,
@@ -129,14 +121,19 @@ func (r *AlterExternalFunctionRequest) toOpts() *AlterExternalFunctionOptions {
 		if r.Set.Headers != nil {
 			s := make([]ExternalFunctionHeader, len(r.Set.Headers))
 			for i, v := range r.Set.Headers {
-				s[i] = ExternalFunctionHeader(v)
+				s[i] = ExternalFunctionHeader{
+					Name:  v.Name,
+					Value: v.Value,
+				}
 			}
 			opts.Set.Headers = s
 		}
 		if r.Set.ContextHeaders != nil {
 			s := make([]ExternalFunctionContextHeader, len(r.Set.ContextHeaders))
 			for i, v := range r.Set.ContextHeaders {
-				s[i] = ExternalFunctionContextHeader(v)
+				s[i] = ExternalFunctionContextHeader{
+					ContextFunction: v.ContextFunction,
+				}
 			}
 			opts.Set.ContextHeaders = s
 		}
@@ -165,6 +162,7 @@ func (r *ShowExternalFunctionRequest) toOpts() *ShowExternalFunctionOptions {
 }
 
 func (r externalFunctionRow) convert() *ExternalFunction {
+	// generator:protected-begin externalFunctionRow.convert
 	e := &ExternalFunction{
 		CreatedOn:          r.CreatedOn,
 		Name:               r.Name,
@@ -196,19 +194,24 @@ func (r externalFunctionRow) convert() *ExternalFunction {
 		e.IsDataMetric = r.IsDataMetric.String == "Y"
 	}
 	return e
+	// generator:protected-end
 }
 
 func (r *DescribeExternalFunctionRequest) toOpts() *DescribeExternalFunctionOptions {
 	opts := &DescribeExternalFunctionOptions{
-		name:              r.name,
-		ArgumentDataTypes: r.ArgumentDataTypes,
+		name: r.name,
 	}
 	return opts
 }
 
 func (r externalFunctionPropertyRow) convert() *ExternalFunctionProperty {
+	// generator:protected-begin externalFunctionPropertyRow.convert
 	return &ExternalFunctionProperty{
 		Property: r.Property,
 		Value:    r.Value,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
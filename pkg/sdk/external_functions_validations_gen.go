@@ -19,14 +19,16 @@ func (opts *CreateExternalFunctionOptions) validate() error {
 		errs = append(errs, errNotSet("CreateExternalFunctionOptions", "ApiIntegration"))
 	}
 	if opts.RequestTranslator != nil && !ValidObjectIdentifier(opts.RequestTranslator) {
-		errs = append(errs, errInvalidIdentifier("CreateExternalFunctionOptions", "RequestTranslator"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !valueSet(opts.As) {
 		errs = append(errs, errNotSet("CreateExternalFunctionOptions", "As"))
 	}
 	if opts.ResponseTranslator != nil && !ValidObjectIdentifier(opts.ResponseTranslator) {
-		errs = append(errs, errInvalidIdentifier("CreateExternalFunctionOptions", "ResponseTranslator"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CreateExternalFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -51,6 +53,8 @@ func (opts *AlterExternalFunctionOptions) validate() error {
 			errs = append(errs, errAtLeastOneOf("AlterExternalFunctionOptions.Unset", "Comment", "Headers", "ContextHeaders", "MaxBatchRows", "Compression", "Secure", "RequestTranslator", "ResponseTranslator"))
 		}
 	}
+	// generator:protected-begin AlterExternalFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -59,6 +63,8 @@ func (opts *ShowExternalFunctionOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowExternalFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -70,5 +76,10 @@ func (opts *DescribeExternalFunctionOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeExternalFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -44,7 +44,7 @@ func TestFailoverGroupsCreate(t *testing.T) {
 }
 
 func TestCreateSecondaryReplicationGroup(t *testing.T) {
-	opts := &CreateSecondaryReplicationGroupOptions{
+	opts := &CreateSecondaryFailoverGroupOptions{
 		IfNotExists:          Bool(true),
 		name:                 NewAccountObjectIdentifier("fg1"),
 		primaryFailoverGroup: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.fg1"),
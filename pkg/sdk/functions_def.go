@@ -39,7 +39,7 @@ var (
 var FunctionsDef = g.NewInterface(
 	"Functions",
 	"Function",
-	g.KindOfT[SchemaObjectIdentifier](),
+	g.KindOfT[SchemaObjectIdentifierWithArguments](),
 ).CustomOperation(
 	"CreateForJava",
 	"https://docs.snowflake.com/en/sql-reference/sql/create-function#java-handler",
@@ -50,7 +50,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalSQL("SECURE").
 		SQL("FUNCTION").
 		IfNotExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			functionArgument,
@@ -79,12 +79,14 @@ var FunctionsDef = g.NewInterface(
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
-		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "SecretReference", g.ParameterOptions().Parentheses()).
 		OptionalTextAssignment("TARGET_PATH", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("FunctionDefinition", "*string", g.ParameterOptions().NoEquals().SingleQuotes().SQL("AS")).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidateValueSet, "Handler").
-		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+		// IMPORTS are required when the function definition is not set
+		WithSQLFixtureFields("FunctionDefinition"),
 ).CustomOperation(
 	"CreateForJavascript",
 	"https://docs.snowflake.com/en/sql-reference/sql/create-function#javascript-handler",
@@ -94,7 +96,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalSQL("TEMPORARY").
 		OptionalSQL("SECURE").
 		SQL("FUNCTION").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			functionArgument,
@@ -123,7 +125,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalSQL("SECURE").
 		SQL("FUNCTION").
 		IfNotExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			functionArgument,
@@ -152,12 +154,14 @@ var FunctionsDef = g.NewInterface(
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
-		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "SecretReference", g.ParameterOptions().Parentheses()).
 		PredefinedQueryStructField("FunctionDefinition", "*string", g.ParameterOptions().NoEquals().SingleQuotes().SQL("AS")).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidateValueSet, "RuntimeVersion").
 		WithValidation(g.ValidateValueSet, "Handler").
-		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+		// IMPORTS are required when the function definition is not set
+		WithSQLFixtureFields("FunctionDefinition"),
 ).CustomOperation(
 	"CreateForScala",
 	"https://docs.snowflake.com/en/sql-reference/sql/create-function#scala-handler",
@@ -168,7 +172,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalSQL("SECURE").
 		SQL("FUNCTION").
 		IfNotExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			functionArgument,
@@ -196,7 +200,9 @@ var FunctionsDef = g.NewInterface(
 		PredefinedQueryStructField("FunctionDefinition", "*string", g.ParameterOptions().NoEquals().SingleQuotes().SQL("AS")).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidateValueSet, "Handler").
-		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+		// IMPORTS are required when the function definition is not set
+		WithSQLFixtureFields("FunctionDefinition"),
 ).CustomOperation(
 	"CreateForSQL",
 	"https://docs.snowflake.com/en/sql-reference/sql/create-function#sql-handler",
@@ -206,7 +212,7 @@ var FunctionsDef = g.NewInterface(
 		OptionalSQL("TEMPORARY").
 		OptionalSQL("SECURE").
 		SQL("FUNCTION").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			functionArgument,
@@ -230,7 +236,7 @@ var FunctionsDef = g.NewInterface(
 		Alter().
 		SQL("FUNCTION").
 		IfExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -252,7 +258,7 @@ var FunctionsDef = g.NewInterface(
 		Drop().
 		SQL("FUNCTION").
 		IfExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		WithValidation(g.ValidIdentifier, "name"),
 ).ShowOperation(
@@ -298,7 +304,11 @@ var FunctionsDef = g.NewInterface(
 		SQL("USER FUNCTIONS").
 		OptionalLike().
 		OptionalIn(),
-).ShowByIdOperation().DescribeOperation(
+).ShowByIdOperationWithCustomFilter(
+	"r.Name == id.Name() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())",
+	g.ShowByIDInFiltering,
+	g.ShowByIDLikeFiltering,
+).DescribeOperation(
 	g.DescriptionMappingKindSlice,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-function",
 	g.DbStruct("functionDetailRow").
@@ -311,6 +321,5 @@ var FunctionsDef = g.NewInterface(
 		Describe().
 		SQL("FUNCTION").
 		Name().
-		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		WithValidation(g.ValidIdentifier, "name"),
 )
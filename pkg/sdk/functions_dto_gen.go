@@ -32,7 +32,7 @@ type CreateForJavaFunctionRequest struct {
 	Packages                   []FunctionPackageRequest
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
-	Secrets                    []Secret
+	Secrets                    []SecretReference
 	TargetPath                 *string
 	FunctionDefinition         *string
 }
@@ -102,7 +102,7 @@ type CreateForPythonFunctionRequest struct {
 	Packages                   []FunctionPackageRequest
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
-	Secrets                    []Secret
+	Secrets                    []SecretReference
 	FunctionDefinition         *string
 }
 
@@ -171,6 +171,8 @@ type ShowFunctionRequest struct {
 }
 
 type DescribeFunctionRequest struct {
-	name              SchemaObjectIdentifier // required
-	ArgumentDataTypes []DataType             // required
+	name SchemaObjectIdentifierWithArguments // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -14,8 +14,10 @@ type Functions interface {
 	Alter(ctx context.Context, request *AlterFunctionRequest) error
 	Drop(ctx context.Context, request *DropFunctionRequest) error
 	Show(ctx context.Context, request *ShowFunctionRequest) ([]Function, error)
-	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Function, error)
-	Describe(ctx context.Context, request *DescribeFunctionRequest) ([]FunctionDetail, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*Function, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]FunctionDetail, error)
+	// generator:protected-begin Functions methods
+	// generator:protected-end
 }
 
 // CreateForJavaFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-function#java-handler.
@@ -40,7 +42,7 @@ type CreateForJavaFunctionOptions struct {
 	Packages                   []FunctionPackage         `ddl:"parameter,parentheses" sql:"PACKAGES"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	TargetPath                 *string                   `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
 	FunctionDefinition         *string                   `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
 }
@@ -118,7 +120,7 @@ type CreateForPythonFunctionOptions struct {
 	Packages                   []FunctionPackage         `ddl:"parameter,parentheses" sql:"PACKAGES"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	FunctionDefinition         *string                   `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
 }
 
@@ -244,10 +246,9 @@ type Function struct {
 
 // DescribeFunctionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-function.
 type DescribeFunctionOptions struct {
-	describe          bool                   `ddl:"static" sql:"DESCRIBE"`
-	function          bool                   `ddl:"static" sql:"FUNCTION"`
-	name              SchemaObjectIdentifier `ddl:"identifier"`
-	ArgumentDataTypes []DataType             `ddl:"keyword,must_parentheses"`
+	describe bool                                `ddl:"static" sql:"DESCRIBE"`
+	function bool                                `ddl:"static" sql:"FUNCTION"`
+	name     SchemaObjectIdentifierWithArguments `ddl:"identifier"`
 }
 
 type functionDetailRow struct {
@@ -259,3 +260,6 @@ type FunctionDetail struct {
 	Property string
 	Value    string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
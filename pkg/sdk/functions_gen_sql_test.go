@@ -0,0 +1,234 @@
+package sdk
+
+import "testing"
+
+func TestFunctions_GoldenSQL(t *testing.T) {
+	t.Run("CreateForJava", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForJavaFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "FunctionDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForJavaFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForJavascript", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForJavascriptFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "FunctionDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForJavascriptFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForPython", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForPythonFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "FunctionDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForPythonFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForScala", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForScalaFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ResultDataType")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "FunctionDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForScalaFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForSQL", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForSQLFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "FunctionDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForSQLFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("RenameTo", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "RenameTo")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetComment", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetLogLevel", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetLogLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTraceLevel", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetTraceLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetSecure", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetSecure")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetSecure", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetSecure")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetLogLevel", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetLogLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTraceLevel", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetTraceLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetComment", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowFunctionOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowFunctionOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeFunctionOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
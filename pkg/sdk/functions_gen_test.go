@@ -1,9 +1,13 @@
 package sdk
 
 import (
+	"context"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFunctions_CreateForJava(t *testing.T) {
@@ -105,7 +109,7 @@ func TestFunctions_CreateForJava(t *testing.T) {
 		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
 			NewAccountObjectIdentifier("ext_integration"),
 		}
-		opts.Secrets = []Secret{
+		opts.Secrets = []SecretReference{
 			{
 				VariableName: "variable1",
 				Name:         "name1",
@@ -274,7 +278,7 @@ func TestFunctions_CreateForPython(t *testing.T) {
 		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
 			NewAccountObjectIdentifier("ext_integration"),
 		}
-		opts.Secrets = []Secret{
+		opts.Secrets = []SecretReference{
 			{
 				VariableName: "variable1",
 				Name:         "name1",
@@ -616,8 +620,42 @@ func TestFunctions_Show(t *testing.T) {
 	})
 }
 
+func TestFunctions_ShowByID(t *testing.T) {
+	ctx := context.Background()
+	functionRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"name", "schema_name", "catalog_name", "arguments", "description"}).
+			AddRow("FN", "SCHEMA", "DB", "FN(NUMBER, VARCHAR) RETURN NUMBER", "first").
+			AddRow("FN", "SCHEMA", "DB", "FN(NUMBER) RETURN NUMBER", "second").
+			AddRow("FN", "SCHEMA", "DB", "FN() RETURN NUMBER", "third")
+	}
+
+	t.Run("finds the exact overload", func(t *testing.T) {
+		testCases := map[string][]DataType{
+			"first":  {DataTypeNumber, DataTypeVARCHAR},
+			"second": {"INT"},
+			"third":  {},
+		}
+		for description, argumentDataTypes := range testCases {
+			client, mock := newMockClient(t)
+			mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'FN' IN SCHEMA "DB"."SCHEMA"`).WillReturnRows(functionRows())
+
+			function, err := client.Functions.ShowByID(ctx, NewSchemaObjectIdentifierWithArguments("DB", "SCHEMA", "FN", argumentDataTypes))
+			require.NoError(t, err)
+			require.Equal(t, description, function.Description)
+		}
+	})
+
+	t.Run("overload does not exist", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'FN' IN SCHEMA "DB"."SCHEMA"`).WillReturnRows(functionRows())
+
+		_, err := client.Functions.ShowByID(ctx, NewSchemaObjectIdentifierWithArguments("DB", "SCHEMA", "FN", []DataType{DataTypeVARCHAR}))
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+}
+
 func TestFunctions_Describe(t *testing.T) {
-	id := RandomSchemaObjectIdentifier()
+	id := RandomSchemaObjectIdentifierWithArguments()
 
 	defaultOpts := func() *DescribeFunctionOptions {
 		return &DescribeFunctionOptions{
@@ -632,18 +670,18 @@ func TestFunctions_Describe(t *testing.T) {
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.name = NewSchemaObjectIdentifierWithArguments("", "", "", nil)
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("no arguments", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s ()`, id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s()`, id.SchemaObjectId().FullyQualifiedName())
 	})
 
 	t.Run("all options", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.ArgumentDataTypes = []DataType{DataTypeVARCHAR, DataTypeNumber}
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s (VARCHAR, NUMBER)`, id.FullyQualifiedName())
+		opts.name = NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []DataType{DataTypeVARCHAR, DataTypeNumber})
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FUNCTION %s(VARCHAR, NUMBER)`, id.SchemaObjectId().FullyQualifiedName())
 	})
 }
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Functions = (*functions)(nil)
 
@@ -57,17 +53,23 @@ func (v *functions) Show(ctx context.Context, request *ShowFunctionRequest) ([]F
 	return resultList, nil
 }
 
-func (v *functions) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Function, error) {
-	request := NewShowFunctionRequest().WithIn(&In{Database: NewAccountObjectIdentifier(id.DatabaseName())}).WithLike(&Like{String(id.Name())})
+func (v *functions) ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*Function, error) {
+	// generator:protected-begin functions.ShowByID
+	request := NewShowFunctionRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	functions, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(functions, func(r Function) bool { return r.Name == id.Name() })
+	return findOne(functions, func(r Function) bool {
+		return r.Name == id.Name() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())
+	})
+	// generator:protected-end
 }
 
-func (v *functions) Describe(ctx context.Context, request *DescribeFunctionRequest) ([]FunctionDetail, error) {
-	opts := request.toOpts()
+func (v *functions) Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]FunctionDetail, error) {
+	opts := &DescribeFunctionOptions{
+		name: id,
+	}
 	rows, err := validateAndQuery[functionDetailRow](v.client, ctx, opts)
 	if err != nil {
 		return nil, err
@@ -77,20 +79,17 @@ func (v *functions) Describe(ctx context.Context, request *DescribeFunctionReque
 
 func (r *CreateForJavaFunctionRequest) toOpts() *CreateForJavaFunctionOptions {
 	opts := &CreateForJavaFunctionOptions{
-		OrReplace:   r.OrReplace,
-		Temporary:   r.Temporary,
-		Secure:      r.Secure,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
-		CopyGrants: r.CopyGrants,
-
-		ReturnNullValues:      r.ReturnNullValues,
-		NullInputBehavior:     r.NullInputBehavior,
-		ReturnResultsBehavior: r.ReturnResultsBehavior,
-		RuntimeVersion:        r.RuntimeVersion,
-		Comment:               r.Comment,
-
+		OrReplace:                  r.OrReplace,
+		Temporary:                  r.Temporary,
+		Secure:                     r.Secure,
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		CopyGrants:                 r.CopyGrants,
+		ReturnNullValues:           r.ReturnNullValues,
+		NullInputBehavior:          r.NullInputBehavior,
+		ReturnResultsBehavior:      r.ReturnResultsBehavior,
+		RuntimeVersion:             r.RuntimeVersion,
+		Comment:                    r.Comment,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -100,7 +99,11 @@ func (r *CreateForJavaFunctionRequest) toOpts() *CreateForJavaFunctionOptions {
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = FunctionArgument(v)
+			s[i] = FunctionArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -115,7 +118,10 @@ func (r *CreateForJavaFunctionRequest) toOpts() *CreateForJavaFunctionOptions {
 		if r.Returns.Table.Columns != nil {
 			s := make([]FunctionColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = FunctionColumn(v)
+				s[i] = FunctionColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -123,14 +129,18 @@ func (r *CreateForJavaFunctionRequest) toOpts() *CreateForJavaFunctionOptions {
 	if r.Imports != nil {
 		s := make([]FunctionImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = FunctionImport(v)
+			s[i] = FunctionImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
 	if r.Packages != nil {
 		s := make([]FunctionPackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = FunctionPackage(v)
+			s[i] = FunctionPackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
@@ -139,13 +149,11 @@ func (r *CreateForJavaFunctionRequest) toOpts() *CreateForJavaFunctionOptions {
 
 func (r *CreateForJavascriptFunctionRequest) toOpts() *CreateForJavascriptFunctionOptions {
 	opts := &CreateForJavascriptFunctionOptions{
-		OrReplace: r.OrReplace,
-		Temporary: r.Temporary,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
+		OrReplace:             r.OrReplace,
+		Temporary:             r.Temporary,
+		Secure:                r.Secure,
+		name:                  r.name,
+		CopyGrants:            r.CopyGrants,
 		ReturnNullValues:      r.ReturnNullValues,
 		NullInputBehavior:     r.NullInputBehavior,
 		ReturnResultsBehavior: r.ReturnResultsBehavior,
@@ -155,7 +163,11 @@ func (r *CreateForJavascriptFunctionRequest) toOpts() *CreateForJavascriptFuncti
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = FunctionArgument(v)
+			s[i] = FunctionArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -170,7 +182,10 @@ func (r *CreateForJavascriptFunctionRequest) toOpts() *CreateForJavascriptFuncti
 		if r.Returns.Table.Columns != nil {
 			s := make([]FunctionColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = FunctionColumn(v)
+				s[i] = FunctionColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -180,20 +195,17 @@ func (r *CreateForJavascriptFunctionRequest) toOpts() *CreateForJavascriptFuncti
 
 func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOptions {
 	opts := &CreateForPythonFunctionOptions{
-		OrReplace:   r.OrReplace,
-		Temporary:   r.Temporary,
-		Secure:      r.Secure,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
-		CopyGrants: r.CopyGrants,
-
-		ReturnNullValues:      r.ReturnNullValues,
-		NullInputBehavior:     r.NullInputBehavior,
-		ReturnResultsBehavior: r.ReturnResultsBehavior,
-		RuntimeVersion:        r.RuntimeVersion,
-		Comment:               r.Comment,
-
+		OrReplace:                  r.OrReplace,
+		Temporary:                  r.Temporary,
+		Secure:                     r.Secure,
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		CopyGrants:                 r.CopyGrants,
+		ReturnNullValues:           r.ReturnNullValues,
+		NullInputBehavior:          r.NullInputBehavior,
+		ReturnResultsBehavior:      r.ReturnResultsBehavior,
+		RuntimeVersion:             r.RuntimeVersion,
+		Comment:                    r.Comment,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -202,7 +214,11 @@ func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOption
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = FunctionArgument(v)
+			s[i] = FunctionArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -217,7 +233,10 @@ func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOption
 		if r.Returns.Table.Columns != nil {
 			s := make([]FunctionColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = FunctionColumn(v)
+				s[i] = FunctionColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -225,14 +244,18 @@ func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOption
 	if r.Imports != nil {
 		s := make([]FunctionImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = FunctionImport(v)
+			s[i] = FunctionImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
 	if r.Packages != nil {
 		s := make([]FunctionPackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = FunctionPackage(v)
+			s[i] = FunctionPackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
@@ -241,12 +264,11 @@ func (r *CreateForPythonFunctionRequest) toOpts() *CreateForPythonFunctionOption
 
 func (r *CreateForScalaFunctionRequest) toOpts() *CreateForScalaFunctionOptions {
 	opts := &CreateForScalaFunctionOptions{
-		OrReplace:   r.OrReplace,
-		Temporary:   r.Temporary,
-		Secure:      r.Secure,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
+		OrReplace:             r.OrReplace,
+		Temporary:             r.Temporary,
+		Secure:                r.Secure,
+		IfNotExists:           r.IfNotExists,
+		name:                  r.name,
 		CopyGrants:            r.CopyGrants,
 		ResultDataType:        r.ResultDataType,
 		ReturnNullValues:      r.ReturnNullValues,
@@ -254,29 +276,36 @@ func (r *CreateForScalaFunctionRequest) toOpts() *CreateForScalaFunctionOptions
 		ReturnResultsBehavior: r.ReturnResultsBehavior,
 		RuntimeVersion:        r.RuntimeVersion,
 		Comment:               r.Comment,
-
-		Handler:            r.Handler,
-		TargetPath:         r.TargetPath,
-		FunctionDefinition: r.FunctionDefinition,
+		Handler:               r.Handler,
+		TargetPath:            r.TargetPath,
+		FunctionDefinition:    r.FunctionDefinition,
 	}
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = FunctionArgument(v)
+			s[i] = FunctionArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
 	if r.Imports != nil {
 		s := make([]FunctionImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = FunctionImport(v)
+			s[i] = FunctionImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
 	if r.Packages != nil {
 		s := make([]FunctionPackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = FunctionPackage(v)
+			s[i] = FunctionPackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
@@ -285,13 +314,11 @@ func (r *CreateForScalaFunctionRequest) toOpts() *CreateForScalaFunctionOptions
 
 func (r *CreateForSQLFunctionRequest) toOpts() *CreateForSQLFunctionOptions {
 	opts := &CreateForSQLFunctionOptions{
-		OrReplace: r.OrReplace,
-		Temporary: r.Temporary,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
+		OrReplace:             r.OrReplace,
+		Temporary:             r.Temporary,
+		Secure:                r.Secure,
+		name:                  r.name,
+		CopyGrants:            r.CopyGrants,
 		ReturnNullValues:      r.ReturnNullValues,
 		ReturnResultsBehavior: r.ReturnResultsBehavior,
 		Memoizable:            r.Memoizable,
@@ -301,7 +328,11 @@ func (r *CreateForSQLFunctionRequest) toOpts() *CreateForSQLFunctionOptions {
 	if r.Arguments != nil {
 		s := make([]FunctionArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = FunctionArgument(v)
+			s[i] = FunctionArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -316,7 +347,10 @@ func (r *CreateForSQLFunctionRequest) toOpts() *CreateForSQLFunctionOptions {
 		if r.Returns.Table.Columns != nil {
 			s := make([]FunctionColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = FunctionColumn(v)
+				s[i] = FunctionColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -362,6 +396,7 @@ func (r *ShowFunctionRequest) toOpts() *ShowFunctionOptions {
 }
 
 func (r functionRow) convert() *Function {
+	// generator:protected-begin functionRow.convert
 	e := &Function{
 		CreatedOn:          r.CreatedOn,
 		Name:               r.Name,
@@ -386,17 +421,18 @@ func (r functionRow) convert() *Function {
 		e.IsMemoizable = r.IsMemoizable.String == "Y"
 	}
 	return e
+	// generator:protected-end
 }
 
 func (r *DescribeFunctionRequest) toOpts() *DescribeFunctionOptions {
 	opts := &DescribeFunctionOptions{
-		name:              r.name,
-		ArgumentDataTypes: r.ArgumentDataTypes,
+		name: r.name,
 	}
 	return opts
 }
 
 func (r functionDetailRow) convert() *FunctionDetail {
+	// generator:protected-begin functionDetailRow.convert
 	e := &FunctionDetail{
 		Property: r.Property,
 	}
@@ -404,4 +440,8 @@ func (r functionDetailRow) convert() *FunctionDetail {
 		e.Value = r.Value.String
 	}
 	return e
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
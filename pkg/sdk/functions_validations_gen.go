@@ -26,11 +26,10 @@ func (opts *CreateForJavaFunctionOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateForJavaFunctionOptions", "OrReplace", "IfNotExists"))
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForJavaFunctionOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForJavaFunctionOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForJavaFunctionOptions.validate
 	if opts.FunctionDefinition == nil {
 		if opts.TargetPath != nil {
 			errs = append(errs, NewError("TARGET_PATH must be nil when AS is nil"))
@@ -42,6 +41,7 @@ func (opts *CreateForJavaFunctionOptions) validate() error {
 			errs = append(errs, NewError("IMPORTS must not be empty when AS is nil"))
 		}
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -56,11 +56,11 @@ func (opts *CreateForJavascriptFunctionOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForJavascriptFunctionOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForJavascriptFunctionOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForJavascriptFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -81,16 +81,16 @@ func (opts *CreateForPythonFunctionOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateForPythonFunctionOptions", "OrReplace", "IfNotExists"))
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForPythonFunctionOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForPythonFunctionOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForPythonFunctionOptions.validate
 	if opts.FunctionDefinition == nil {
 		if len(opts.Imports) == 0 {
 			errs = append(errs, NewError("IMPORTS must not be empty when AS is nil"))
 		}
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -108,6 +108,7 @@ func (opts *CreateForScalaFunctionOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateForScalaFunctionOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateForScalaFunctionOptions.validate
 	if opts.FunctionDefinition == nil {
 		if opts.TargetPath != nil {
 			errs = append(errs, NewError("TARGET_PATH must be nil when AS is nil"))
@@ -119,6 +120,7 @@ func (opts *CreateForScalaFunctionOptions) validate() error {
 			errs = append(errs, NewError("IMPORTS must not be empty when AS is nil"))
 		}
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -133,11 +135,11 @@ func (opts *CreateForSQLFunctionOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForSQLFunctionOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForSQLFunctionOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForSQLFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -155,6 +157,8 @@ func (opts *AlterFunctionOptions) validate() error {
 	if !exactlyOneValueSet(opts.RenameTo, opts.SetComment, opts.SetLogLevel, opts.SetTraceLevel, opts.SetSecure, opts.UnsetLogLevel, opts.UnsetTraceLevel, opts.UnsetSecure, opts.UnsetComment, opts.SetTags, opts.UnsetTags) {
 		errs = append(errs, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
 	}
+	// generator:protected-begin AlterFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -166,6 +170,8 @@ func (opts *DropFunctionOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -174,6 +180,8 @@ func (opts *ShowFunctionOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -185,5 +193,10 @@ func (opts *DescribeFunctionOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeFunctionOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -14,6 +14,8 @@ type Grants interface {
 	RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error
 	GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error
 	RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
+	GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error
+	RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error
 	GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, from AccountObjectIdentifier) error
 	GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error
@@ -119,6 +121,28 @@ type RevokePrivilegesFromDatabaseRoleOptions struct {
 	Cascade        *bool                        `ddl:"keyword" sql:"CASCADE"`
 }
 
+// GrantPrivilegesToApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-application-role#syntax.
+// Application roles accept the same privileges as account roles, except for future grants. Snowflake allows running it only in the setup script
+// of an application, so it's meant for the SDK users building native apps; there is no resource using it.
+type GrantPrivilegesToApplicationRoleOptions struct {
+	grant           bool                        `ddl:"static" sql:"GRANT"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"TO APPLICATION ROLE"`
+	WithGrantOption *bool                       `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+// RevokePrivilegesFromApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege-application-role#syntax.
+type RevokePrivilegesFromApplicationRoleOptions struct {
+	revoke          bool                        `ddl:"static" sql:"REVOKE"`
+	GrantOptionFor  *bool                       `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"FROM APPLICATION ROLE"`
+	Restrict        *bool                       `ddl:"keyword" sql:"RESTRICT"`
+	Cascade         *bool                       `ddl:"keyword" sql:"CASCADE"`
+}
+
 // grantPrivilegeToShareOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-share.
 type grantPrivilegeToShareOptions struct {
 	grant      bool                    `ddl:"static" sql:"GRANT"`
@@ -176,16 +200,18 @@ type ShowGrantsOn struct {
 }
 
 type ShowGrantsTo struct {
-	Role         AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
-	User         AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
-	Share        AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
-	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	User            AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	DatabaseRole    DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
 }
 
 type ShowGrantsOf struct {
-	Role         AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
-	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
-	Share        AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole    DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
 }
 
 type grantRow struct {
@@ -181,6 +181,26 @@ func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privilege
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *grants) GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *grants) GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error {
 	opts := &grantPrivilegeToShareOptions{
 		privileges: privileges,
@@ -205,6 +225,8 @@ func (v *grants) GrantOwnership(ctx context.Context, on OwnershipGrantOn, to Own
 	}
 	opts.On = on
 	opts.To = to
+	// ownership transfer changes the owner of (possibly many) cached objects
+	defer v.client.showCache.invalidateAll()
 	return validateAndExec(v.client, ctx, opts)
 }
 
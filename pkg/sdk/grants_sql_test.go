@@ -0,0 +1,175 @@
+package sdk
+
+import "testing"
+
+func TestGrants_GoldenSQL(t *testing.T) {
+	table := &Object{ObjectType: ObjectTypeTable, Name: NewSchemaObjectIdentifier("database", "schema", "table")}
+	futureTablesInSchema := &GrantOnSchemaObjectIn{PluralObjectType: PluralObjectTypeTables, InSchema: Pointer(NewDatabaseObjectIdentifier("database", "schema"))}
+
+	t.Run("GrantPrivilegesToAccountRole", func(t *testing.T) {
+		t.Run("on account", func(t *testing.T) {
+			opts := &GrantPrivilegesToAccountRoleOptions{
+				privileges:      &AccountRoleGrantPrivileges{GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage, GlobalPrivilegeApplyTag}},
+				on:              &AccountRoleGrantOn{Account: Bool(true)},
+				accountRole:     sqlFixtureIdentifier[AccountObjectIdentifier](),
+				WithGrantOption: Bool(true),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on account object", func(t *testing.T) {
+			opts := &GrantPrivilegesToAccountRoleOptions{
+				privileges:  &AccountRoleGrantPrivileges{AllPrivileges: Bool(true)},
+				on:          &AccountRoleGrantOn{AccountObject: &GrantOnAccountObject{Database: Pointer(NewAccountObjectIdentifier("database"))}},
+				accountRole: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on schema", func(t *testing.T) {
+			opts := &GrantPrivilegesToAccountRoleOptions{
+				privileges:  &AccountRoleGrantPrivileges{SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeCreateAlert}},
+				on:          &AccountRoleGrantOn{Schema: &GrantOnSchema{FutureSchemasInDatabase: Pointer(NewAccountObjectIdentifier("database"))}},
+				accountRole: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on schema object", func(t *testing.T) {
+			opts := &GrantPrivilegesToAccountRoleOptions{
+				privileges:  &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeUpdate}},
+				on:          &AccountRoleGrantOn{SchemaObject: &GrantOnSchemaObject{SchemaObject: table}},
+				accountRole: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on future schema objects", func(t *testing.T) {
+			opts := &GrantPrivilegesToAccountRoleOptions{
+				privileges:  &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect}},
+				on:          &AccountRoleGrantOn{SchemaObject: &GrantOnSchemaObject{Future: futureTablesInSchema}},
+				accountRole: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("RevokePrivilegesFromAccountRole", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &RevokePrivilegesFromAccountRoleOptions{
+				privileges:  &AccountRoleGrantPrivileges{GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage}},
+				on:          &AccountRoleGrantOn{Account: Bool(true)},
+				accountRole: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &RevokePrivilegesFromAccountRoleOptions{
+				GrantOptionFor: Bool(true),
+				privileges:     &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect}},
+				on:             &AccountRoleGrantOn{SchemaObject: &GrantOnSchemaObject{All: futureTablesInSchema}},
+				accountRole:    sqlFixtureIdentifier[AccountObjectIdentifier](),
+				Cascade:        Bool(true),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("GrantPrivilegesToDatabaseRole", func(t *testing.T) {
+		t.Run("on database", func(t *testing.T) {
+			opts := &GrantPrivilegesToDatabaseRoleOptions{
+				privileges:      &DatabaseRoleGrantPrivileges{DatabasePrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeCreateSchema}},
+				on:              &DatabaseRoleGrantOn{Database: Pointer(NewAccountObjectIdentifier("database"))},
+				databaseRole:    sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+				WithGrantOption: Bool(true),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on schema object", func(t *testing.T) {
+			opts := &GrantPrivilegesToDatabaseRoleOptions{
+				privileges:   &DatabaseRoleGrantPrivileges{AllPrivileges: Bool(true)},
+				on:           &DatabaseRoleGrantOn{SchemaObject: &GrantOnSchemaObject{SchemaObject: table}},
+				databaseRole: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("RevokePrivilegesFromDatabaseRole", func(t *testing.T) {
+		opts := &RevokePrivilegesFromDatabaseRoleOptions{
+			GrantOptionFor: Bool(true),
+			privileges:     &DatabaseRoleGrantPrivileges{SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeCreateAlert}},
+			on:             &DatabaseRoleGrantOn{Schema: &GrantOnSchema{Schema: Pointer(NewDatabaseObjectIdentifier("database", "schema"))}},
+			databaseRole:   sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			Restrict:       Bool(true),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("GrantPrivilegesToApplicationRole", func(t *testing.T) {
+		opts := &GrantPrivilegesToApplicationRoleOptions{
+			privileges:      &AccountRoleGrantPrivileges{AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage}},
+			on:              &AccountRoleGrantOn{AccountObject: &GrantOnAccountObject{Database: Pointer(NewAccountObjectIdentifier("database"))}},
+			applicationRole: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			WithGrantOption: Bool(true),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("RevokePrivilegesFromApplicationRole", func(t *testing.T) {
+		opts := &RevokePrivilegesFromApplicationRoleOptions{
+			GrantOptionFor:  Bool(true),
+			privileges:      &AccountRoleGrantPrivileges{GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage}},
+			on:              &AccountRoleGrantOn{Account: Bool(true)},
+			applicationRole: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			Cascade:         Bool(true),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("GrantPrivilegeToShare", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeUsage},
+			On:         &ShareGrantOn{Database: NewAccountObjectIdentifier("database")},
+			to:         sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("RevokePrivilegeFromShare", func(t *testing.T) {
+		opts := &revokePrivilegeFromShareOptions{
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			On:         &ShareGrantOn{Table: &OnTable{AllInSchema: NewDatabaseObjectIdentifier("database", "schema")}},
+			from:       sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("GrantOwnership", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &GrantOwnershipOptions{
+				On: OwnershipGrantOn{Object: table},
+				To: OwnershipGrantTo{AccountRoleName: Pointer(NewAccountObjectIdentifier("role"))},
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &GrantOwnershipOptions{
+				On:            OwnershipGrantOn{Future: futureTablesInSchema},
+				To:            OwnershipGrantTo{DatabaseRoleName: Pointer(NewDatabaseObjectIdentifier("database", "database_role"))},
+				CurrentGrants: &OwnershipCurrentGrants{OutboundPrivileges: Copy},
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowGrantOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("on", func(t *testing.T) {
+			opts := &ShowGrantOptions{On: &ShowGrantsOn{Object: table}}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("to", func(t *testing.T) {
+			opts := &ShowGrantOptions{To: &ShowGrantsTo{Role: NewAccountObjectIdentifier("role")}}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("of", func(t *testing.T) {
+			opts := &ShowGrantOptions{Of: &ShowGrantsOf{DatabaseRole: NewDatabaseObjectIdentifier("database", "database_role")}}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("future in", func(t *testing.T) {
+			opts := &ShowGrantOptions{Future: Bool(true), In: &ShowGrantsIn{Schema: Pointer(NewDatabaseObjectIdentifier("database", "schema"))}}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
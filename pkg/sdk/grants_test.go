@@ -723,6 +723,130 @@ func TestGrants_RevokePrivilegesFromDatabaseRoleRole(t *testing.T) {
 	})
 }
 
+func TestGrants_GrantPrivilegesToApplicationRole(t *testing.T) {
+	defaultOpts := func() *GrantPrivilegesToApplicationRoleOptions {
+		return &GrantPrivilegesToApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				AccountObject: &GrantOnAccountObject{
+					Database: Pointer(NewAccountObjectIdentifier("db1")),
+				},
+			},
+			applicationRole: NewDatabaseObjectIdentifier("app1", "role1"),
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: nil on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	})
+
+	t.Run("validation: invalid application role identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.applicationRole = NewDatabaseObjectIdentifier("", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: future schemas", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage}}
+		opts.on = &AccountRoleGrantOn{
+			Schema: &GrantOnSchema{
+				FutureSchemasInDatabase: Pointer(NewAccountObjectIdentifier("db1")),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, NewError("future grants are not supported for application roles"))
+	})
+
+	t.Run("validation: future schema objects", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect}}
+		opts.on = &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				Future: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InDatabase:       Pointer(NewAccountObjectIdentifier("db1")),
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, NewError("future grants are not supported for application roles"))
+	})
+
+	t.Run("on account object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.WithGrantOption = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON DATABASE "db1" TO APPLICATION ROLE "app1"."role1" WITH GRANT OPTION`)
+	})
+
+	t.Run("on all schema objects", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect}}
+		opts.on = &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				All: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         Pointer(NewDatabaseObjectIdentifier("db1", "schema1")),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT ON ALL TABLES IN SCHEMA "db1"."schema1" TO APPLICATION ROLE "app1"."role1"`)
+	})
+}
+
+func TestGrants_RevokePrivilegesFromApplicationRole(t *testing.T) {
+	defaultOpts := func() *RevokePrivilegesFromApplicationRoleOptions {
+		return &RevokePrivilegesFromApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage},
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+			},
+			applicationRole: NewDatabaseObjectIdentifier("app1", "role1"),
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: nil on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "on"))
+	})
+
+	t.Run("validation: restrict and cascade set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Restrict = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE MONITOR USAGE ON ACCOUNT FROM APPLICATION ROLE "app1"."role1"`)
+	})
+
+	t.Run("grant option for with cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GrantOptionFor = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE GRANT OPTION FOR MONITOR USAGE ON ACCOUNT FROM APPLICATION ROLE "app1"."role1" CASCADE`)
+	})
+}
+
 func TestGrantPrivilegeToShare(t *testing.T) {
 	id := RandomAccountObjectIdentifier()
 	t.Run("on database", func(t *testing.T) {
@@ -1067,6 +1191,26 @@ func TestGrantShow(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF DATABASE ROLE %s", roleID.FullyQualifiedName())
 	})
 
+	t.Run("to application role", func(t *testing.T) {
+		roleID := RandomDatabaseObjectIdentifier()
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				ApplicationRole: roleID,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS TO APPLICATION ROLE %s", roleID.FullyQualifiedName())
+	})
+
+	t.Run("of application role", func(t *testing.T) {
+		roleID := RandomDatabaseObjectIdentifier()
+		opts := &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				ApplicationRole: roleID,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF APPLICATION ROLE %s", roleID.FullyQualifiedName())
+	})
+
 	t.Run("of share", func(t *testing.T) {
 		shareID := RandomAccountObjectIdentifier()
 		opts := &ShowGrantOptions{
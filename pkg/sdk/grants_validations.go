@@ -11,6 +11,8 @@ var (
 	_ validatable = new(RevokePrivilegesFromAccountRoleOptions)
 	_ validatable = new(GrantPrivilegesToDatabaseRoleOptions)
 	_ validatable = new(RevokePrivilegesFromDatabaseRoleOptions)
+	_ validatable = new(GrantPrivilegesToApplicationRoleOptions)
+	_ validatable = new(RevokePrivilegesFromApplicationRoleOptions)
 	_ validatable = new(grantPrivilegeToShareOptions)
 	_ validatable = new(revokePrivilegeFromShareOptions)
 	_ validatable = new(GrantOwnershipOptions)
@@ -225,6 +227,70 @@ func (opts *RevokePrivilegesFromDatabaseRoleOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *GrantPrivilegesToApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	} else {
+		if err := validateApplicationRoleGrantOn(opts.on); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *RevokePrivilegesFromApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "on"))
+	} else {
+		if err := validateApplicationRoleGrantOn(opts.on); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		errs = append(errs, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	}
+	return errors.Join(errs...)
+}
+
+// validateApplicationRoleGrantOn validates the objects the same way as for account roles, but future grants are not supported for application roles.
+func validateApplicationRoleGrantOn(on *AccountRoleGrantOn) error {
+	if err := on.validate(); err != nil {
+		return err
+	}
+	if (on.Schema != nil && valueSet(on.Schema.FutureSchemasInDatabase)) || (on.SchemaObject != nil && valueSet(on.SchemaObject.Future)) {
+		return NewError("future grants are not supported for application roles")
+	}
+	return nil
+}
+
 func (opts *grantPrivilegeToShareOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
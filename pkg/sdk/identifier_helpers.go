@@ -14,17 +14,12 @@ type ObjectIdentifier interface {
 	FullyQualifiedName() string
 }
 
+// NewObjectIdentifierFromFullyQualifiedName splits the name into parts with quoted parts allowed to contain dots.
+// Unquoted parts are not case-folded; use ParseObjectIdentifier to follow Snowflake rules for unquoted identifiers.
 func NewObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) ObjectIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
-	switch len(parts) {
-	case 1:
-		return NewAccountObjectIdentifier(fullyQualifiedName)
-	case 2:
-		return NewDatabaseObjectIdentifier(parts[0], parts[1])
-	case 3:
-		return NewSchemaObjectIdentifier(parts[0], parts[1], parts[2])
-	case 4:
-		return NewTableColumnIdentifier(parts[0], parts[1], parts[2], parts[3])
+	parts := splitFullyQualifiedName(fullyQualifiedName)
+	if id, err := objectIdentifierFromParts(fullyQualifiedName, parts); err == nil {
+		return id
 	}
 	return NewAccountObjectIdentifier(fullyQualifiedName)
 }
@@ -43,11 +38,11 @@ func NewExternalObjectIdentifier(accountIdentifier AccountIdentifier, objectIden
 }
 
 func NewExternalObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) ExternalObjectIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
+	parts := splitFullyQualifiedName(fullyQualifiedName)
 
 	if len(parts) == 1 {
 		return ExternalObjectIdentifier{
-			objectIdentifier:  NewAccountObjectIdentifier(fullyQualifiedName),
+			objectIdentifier:  AccountObjectIdentifier{name: parts[0]},
 			accountIdentifier: NewAccountIdentifier("", ""),
 		}
 	}
@@ -57,7 +52,7 @@ func NewExternalObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string
 		objectName := parts[1]
 
 		return ExternalObjectIdentifier{
-			objectIdentifier:  NewAccountObjectIdentifier(objectName),
+			objectIdentifier:  AccountObjectIdentifier{name: objectName},
 			accountIdentifier: NewAccountIdentifierFromAccountLocator(accountLocator),
 		}
 	}
@@ -67,8 +62,8 @@ func NewExternalObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string
 	objectName := strings.Join(parts[2:], ".")
 
 	return ExternalObjectIdentifier{
-		objectIdentifier:  NewAccountObjectIdentifier(objectName),
-		accountIdentifier: NewAccountIdentifier(orgName, accountName),
+		objectIdentifier:  AccountObjectIdentifier{name: objectName},
+		accountIdentifier: AccountIdentifier{organizationName: orgName, accountName: accountName},
 	}
 }
 
@@ -100,13 +95,11 @@ func NewAccountIdentifierFromAccountLocator(accountLocator string) AccountIdenti
 }
 
 func NewAccountIdentifierFromFullyQualifiedName(fullyQualifiedName string) AccountIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
+	parts := splitFullyQualifiedName(fullyQualifiedName)
 	if len(parts) == 1 {
-		return NewAccountIdentifierFromAccountLocator(fullyQualifiedName)
+		return NewAccountIdentifierFromAccountLocator(parts[0])
 	}
-	organizationName := strings.Trim(parts[0], `"`)
-	accountName := strings.Trim(parts[1], `"`)
-	return NewAccountIdentifier(organizationName, accountName)
+	return AccountIdentifier{organizationName: parts[0], accountName: parts[1]}
 }
 
 func (i AccountIdentifier) Name() string {
@@ -134,6 +127,10 @@ func NewAccountObjectIdentifier(name string) AccountObjectIdentifier {
 }
 
 func NewAccountObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) AccountObjectIdentifier {
+	if parts := splitFullyQualifiedName(fullyQualifiedName); len(parts) == 1 {
+		return AccountObjectIdentifier{name: parts[0]}
+	}
+	// unquoted names containing dots have always been treated as a single account object name
 	name := strings.Trim(fullyQualifiedName, `"`)
 	return AccountObjectIdentifier{name: name}
 }
@@ -162,10 +159,10 @@ func NewDatabaseObjectIdentifier(databaseName, name string) DatabaseObjectIdenti
 }
 
 func NewDatabaseObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) DatabaseObjectIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
+	parts := paddedParts(splitFullyQualifiedName(fullyQualifiedName), 2)
 	return DatabaseObjectIdentifier{
-		databaseName: strings.Trim(parts[0], `"`),
-		name:         strings.Trim(parts[1], `"`),
+		databaseName: parts[0],
+		name:         parts[1],
 	}
 }
 
@@ -188,7 +185,6 @@ type SchemaObjectIdentifier struct {
 	databaseName string
 	schemaName   string
 	name         string
-	arguments    []DataType
 }
 
 func NewSchemaObjectIdentifier(databaseName, schemaName, name string) SchemaObjectIdentifier {
@@ -199,39 +195,43 @@ func NewSchemaObjectIdentifier(databaseName, schemaName, name string) SchemaObje
 	}
 }
 
-func NewSchemaObjectIdentifierWithArguments(databaseName, schemaName, name string, arguments []DataType) SchemaObjectIdentifier {
-	return SchemaObjectIdentifier{
-		databaseName: strings.Trim(databaseName, `"`),
-		schemaName:   strings.Trim(schemaName, `"`),
-		name:         strings.Trim(name, `"`),
-		arguments:    arguments,
+// NewSchemaObjectIdentifierFromFullyQualifiedName returns the identifier of the schema object. The arguments list
+// of functions and procedures (if any) is dropped; use NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName to keep it.
+func NewSchemaObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaObjectIdentifier {
+	name, _, _ := cutIdentifierArguments(fullyQualifiedName)
+	parts := paddedParts(splitFullyQualifiedName(name), 3)
+	id := SchemaObjectIdentifier{}
+	id.databaseName = parts[0]
+	id.schemaName = parts[1]
+	id.name = parts[2]
+	return id
+}
+
+// paddedParts fills the missing parts with empty strings, so the constructors which don't return errors do not panic on
+// names with fewer parts than expected; use ParseFullyQualifiedName to get an error for such names instead.
+func paddedParts(parts []string, count int) []string {
+	for len(parts) < count {
+		parts = append(parts, "")
 	}
+	return parts
 }
 
-func NewSchemaObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaObjectIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
-	id := SchemaObjectIdentifier{}
-	id.databaseName = strings.Trim(parts[0], `"`)
-	id.schemaName = strings.Trim(parts[1], `"`)
-
-	// this is either a function or procedure
-	if strings.HasSuffix(parts[2], ")") {
-		idx := strings.LastIndex(parts[2], "(")
-		id.name = strings.Trim(parts[2][:idx], `"`)
-		strArgs := strings.Split(strings.Trim(parts[2][idx+1:], `)`), ",")
-		id.arguments = make([]DataType, 0)
-		for _, arg := range strArgs {
-			trimmedArg := strings.TrimSpace(strings.Trim(arg, `"`))
-			if trimmedArg == "" {
-				continue
-			}
-			dt, _ := ToDataType(trimmedArg)
-			id.arguments = append(id.arguments, dt)
+// cutIdentifierArguments cuts the arguments list (e.g. "(NUMBER, VARCHAR)") of functions and procedures
+// from the end of the fully qualified name. Parentheses inside quoted parts are ignored.
+func cutIdentifierArguments(fullyQualifiedName string) (name string, arguments string, found bool) {
+	if !strings.HasSuffix(fullyQualifiedName, ")") {
+		return fullyQualifiedName, "", false
+	}
+	quoted := false
+	for i, r := range fullyQualifiedName {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == '(' && !quoted:
+			return fullyQualifiedName[:i], strings.TrimSuffix(fullyQualifiedName[i+1:], ")"), true
 		}
-	} else { // this is every other kind of schema object
-		id.name = strings.Trim(parts[2], `"`)
 	}
-	return id
+	return fullyQualifiedName, "", false
 }
 
 func (i SchemaObjectIdentifier) DatabaseName() string {
@@ -246,10 +246,6 @@ func (i SchemaObjectIdentifier) Name() string {
 	return i.name
 }
 
-func (i SchemaObjectIdentifier) Arguments() []DataType {
-	return i.arguments
-}
-
 func (i SchemaObjectIdentifier) SchemaIdentifier() DatabaseObjectIdentifier {
 	return NewDatabaseObjectIdentifier(i.databaseName, i.schemaName)
 }
@@ -258,27 +254,69 @@ func (i SchemaObjectIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.name == "" {
 		return ""
 	}
-	if len(i.arguments) == 0 {
-		return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
-	}
-	// if this is a function or procedure, we need to include the arguments
-	args := make([]string, len(i.arguments))
-	for i, arg := range i.arguments {
-		args[i] = string(arg)
+	return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
+}
+
+// SchemaObjectIdentifierWithArguments identifies schema objects which can be overloaded (functions and procedures).
+// The argument data types are a part of the identifier, because objects with the same name can differ only by them.
+type SchemaObjectIdentifierWithArguments struct {
+	databaseName      string
+	schemaName        string
+	name              string
+	argumentDataTypes []DataType
+}
+
+func NewSchemaObjectIdentifierWithArguments(databaseName, schemaName, name string, argumentDataTypes []DataType) SchemaObjectIdentifierWithArguments {
+	return SchemaObjectIdentifierWithArguments{
+		databaseName:      strings.Trim(databaseName, `"`),
+		schemaName:        strings.Trim(schemaName, `"`),
+		name:              strings.Trim(name, `"`),
+		argumentDataTypes: argumentDataTypes,
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"(%v)`, i.databaseName, i.schemaName, i.name, strings.Join(args, ", "))
 }
 
-func (i SchemaObjectIdentifier) WithoutArguments() SchemaObjectIdentifier {
+// NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName parses names in the form of <database>.<schema>.<name>(<data types>)
+// the same way as NewSchemaObjectIdentifierFromFullyQualifiedName (without case folding). A missing arguments list means
+// no arguments. Unlike the other constructors it returns an error, because the argument data types may be invalid.
+func NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(fullyQualifiedName string) (SchemaObjectIdentifierWithArguments, error) {
+	return parseSchemaObjectIdentifierWithArguments(fullyQualifiedName, identifierParserOptions{delimiter: identifierPartsDelimiter})
+}
+
+func (i SchemaObjectIdentifierWithArguments) DatabaseName() string {
+	return i.databaseName
+}
+
+func (i SchemaObjectIdentifierWithArguments) SchemaName() string {
+	return i.schemaName
+}
+
+func (i SchemaObjectIdentifierWithArguments) Name() string {
+	return i.name
+}
+
+func (i SchemaObjectIdentifierWithArguments) ArgumentDataTypes() []DataType {
+	return i.argumentDataTypes
+}
+
+func (i SchemaObjectIdentifierWithArguments) SchemaIdentifier() DatabaseObjectIdentifier {
+	return NewDatabaseObjectIdentifier(i.databaseName, i.schemaName)
+}
+
+// SchemaObjectId returns the identifier without the argument data types, as expected by statements
+// which take them separately (e.g. DESCRIBE FUNCTION).
+func (i SchemaObjectIdentifierWithArguments) SchemaObjectId() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(i.databaseName, i.schemaName, i.name)
 }
 
-func (i SchemaObjectIdentifier) ArgumentsSignature() string {
-	arguments := make([]string, len(i.arguments))
-	for i, item := range i.arguments {
-		arguments[i] = string(item)
+func (i SchemaObjectIdentifierWithArguments) FullyQualifiedName() string {
+	if i.schemaName == "" && i.databaseName == "" && i.name == "" && len(i.argumentDataTypes) == 0 {
+		return ""
 	}
-	return fmt.Sprintf("%v(%v)", i.Name(), strings.Join(arguments, ","))
+	args := make([]string, len(i.argumentDataTypes))
+	for j, arg := range i.argumentDataTypes {
+		args[j] = string(arg)
+	}
+	return fmt.Sprintf(`"%v"."%v"."%v"(%v)`, i.databaseName, i.schemaName, i.name, strings.Join(args, ", "))
 }
 
 type TableColumnIdentifier struct {
@@ -298,12 +336,12 @@ func NewTableColumnIdentifier(databaseName, schemaName, tableName, columnName st
 }
 
 func NewTableColumnIdentifierFromFullyQualifiedName(fullyQualifiedName string) TableColumnIdentifier {
-	parts := strings.Split(fullyQualifiedName, ".")
+	parts := paddedParts(splitFullyQualifiedName(fullyQualifiedName), 4)
 	return TableColumnIdentifier{
-		databaseName: strings.Trim(parts[0], `"`),
-		schemaName:   strings.Trim(parts[1], `"`),
-		tableName:    strings.Trim(parts[2], `"`),
-		columnName:   strings.Trim(parts[3], `"`),
+		databaseName: parts[0],
+		schemaName:   parts[1],
+		tableName:    parts[2],
+		columnName:   parts[3],
 	}
 }
 
@@ -33,11 +33,9 @@ func TestNewSchemaObjectIdentifierFromFullyQualifiedName(t *testing.T) {
 	}
 
 	tests := []test{
-		{input: "\"MY_DB\".\"MY_SCHEMA\".\"multiply\"(number, number)", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "multiply", arguments: []DataType{DataTypeNumber, DataTypeNumber}}},
-		{input: "MY_DB.MY_SCHEMA.add(number, number)", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "add", arguments: []DataType{DataTypeNumber, DataTypeNumber}}},
-		{input: "\"MY_DB\".\"MY_SCHEMA\".\"MY_UDF\"()", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_UDF", arguments: []DataType{}}},
-		{input: "\"MY_DB\".\"MY_SCHEMA\".\"MY_PIPE\"", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_PIPE", arguments: nil}},
-		{input: "MY_DB.MY_SCHEMA.MY_STAGE", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_STAGE", arguments: nil}},
+		{input: "\"MY_DB\".\"MY_SCHEMA\".\"multiply\"(number, number)", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "multiply"}},
+		{input: "\"MY_DB\".\"MY_SCHEMA\".\"MY_PIPE\"", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_PIPE"}},
+		{input: "MY_DB.MY_SCHEMA.MY_STAGE", want: SchemaObjectIdentifier{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_STAGE"}},
 	}
 	for _, tc := range tests {
 		t.Run(tc.input, func(t *testing.T) {
@@ -47,6 +45,87 @@ func TestNewSchemaObjectIdentifierFromFullyQualifiedName(t *testing.T) {
 	}
 }
 
+func TestNewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(t *testing.T) {
+	type test struct {
+		input string
+		want  SchemaObjectIdentifierWithArguments
+	}
+
+	tests := []test{
+		{input: "\"MY_DB\".\"MY_SCHEMA\".\"multiply\"(number, number)", want: SchemaObjectIdentifierWithArguments{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "multiply", argumentDataTypes: []DataType{DataTypeNumber, DataTypeNumber}}},
+		{input: "MY_DB.MY_SCHEMA.add(number, number)", want: SchemaObjectIdentifierWithArguments{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "add", argumentDataTypes: []DataType{DataTypeNumber, DataTypeNumber}}},
+		{input: "MY_DB.MY_SCHEMA.add(NUMBER(38,0), VARCHAR(100))", want: SchemaObjectIdentifierWithArguments{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "add", argumentDataTypes: []DataType{DataTypeNumber, DataTypeVARCHAR}}},
+		{input: "\"MY_DB\".\"MY_SCHEMA\".\"MY_UDF\"()", want: SchemaObjectIdentifierWithArguments{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_UDF", argumentDataTypes: []DataType{}}},
+		{input: "\"MY_DB\".\"MY_SCHEMA\".\"MY_UDF\"", want: SchemaObjectIdentifierWithArguments{databaseName: "MY_DB", schemaName: "MY_SCHEMA", name: "MY_UDF", argumentDataTypes: []DataType{}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			id, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, id)
+		})
+	}
+
+	t.Run("invalid data type", func(t *testing.T) {
+		_, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName("MY_DB.MY_SCHEMA.add(NUMBER, NOT_A_TYPE)")
+		require.ErrorContains(t, err, "invalid data type of argument 2")
+	})
+
+	t.Run("empty argument", func(t *testing.T) {
+		_, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName("MY_DB.MY_SCHEMA.add(NUMBER,)")
+		require.ErrorContains(t, err, "empty data type of argument 2")
+	})
+
+	t.Run("unbalanced parentheses", func(t *testing.T) {
+		_, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName("MY_DB.MY_SCHEMA.add(NUMBER(38,0)")
+		require.ErrorContains(t, err, "unclosed parenthesis")
+	})
+
+	t.Run("missing parts", func(t *testing.T) {
+		_, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName("MY_SCHEMA.add(NUMBER)")
+		require.ErrorContains(t, err, "unexpected number of parts 2")
+	})
+}
+
+func TestSchemaObjectIdentifierWithArguments(t *testing.T) {
+	id := NewSchemaObjectIdentifierWithArguments(`"db"`, "schema", "fn", []DataType{DataTypeNumber, DataTypeVARCHAR})
+
+	assert.Equal(t, `"db"."schema"."fn"(NUMBER, VARCHAR)`, id.FullyQualifiedName())
+	assert.Equal(t, NewSchemaObjectIdentifier("db", "schema", "fn"), id.SchemaObjectId())
+	assert.Equal(t, NewDatabaseObjectIdentifier("db", "schema"), id.SchemaIdentifier())
+	assert.Equal(t, `"db"."schema"."fn"()`, NewSchemaObjectIdentifierWithArguments("db", "schema", "fn", nil).FullyQualifiedName())
+
+	parsed, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(id.FullyQualifiedName())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+}
+
+func TestParseShowArgumentDataTypes(t *testing.T) {
+	testCases := map[string][]DataType{
+		"FN() RETURN NUMBER":                                    {},
+		"FN(NUMBER, VARCHAR) RETURN NUMBER":                     {DataTypeNumber, DataTypeVARCHAR},
+		"FN(NUMBER(38,0), VARCHAR(10)) RETURN VARCHAR":          {DataTypeNumber, DataTypeVARCHAR},
+		"FN(ARRAY) RETURN TABLE (A NUMBER, B VARCHAR)":          {DataTypeArray},
+		"FN(WITH)PARENS(FLOAT) RETURN FLOAT":                    {DataTypeFloat},
+		"PROC(TIMESTAMP_NTZ, BOOLEAN) RETURN VARCHAR(16777216)": {DataTypeTimestampNTZ, DataTypeBoolean},
+	}
+	for arguments, expected := range testCases {
+		arguments, expected := arguments, expected
+		t.Run(arguments, func(t *testing.T) {
+			dataTypes, err := ParseShowArgumentDataTypes(arguments)
+			require.NoError(t, err)
+			assert.Equal(t, expected, dataTypes)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, arguments := range []string{"", "FN", "FN(NUMBER RETURN NUMBER", "FN(NOT_A_TYPE) RETURN NUMBER"} {
+			_, err := ParseShowArgumentDataTypes(arguments)
+			assert.Error(t, err, arguments)
+		}
+	})
+}
+
 func TestDatabaseObjectIdentifier(t *testing.T) {
 	t.Run("create from strings", func(t *testing.T) {
 		identifier := NewDatabaseObjectIdentifier("aaa", "bbb")
@@ -0,0 +1,399 @@
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const identifierPartsDelimiter = '.'
+
+var unquotedIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+type identifierParserOptions struct {
+	delimiter rune
+	// strict makes the parser follow Snowflake rules for unquoted parts: they may only contain letters, digits, underscores
+	// and dollar signs (but cannot start with a digit or dollar sign), and they are case-insensitive, so they're upper-cased.
+	// When strict is false, unquoted parts are returned as they are, which is how identifiers passed in the provider
+	// configuration and resource ids have always been treated.
+	strict bool
+}
+
+// parseIdentifierParts splits the identifier into parts separated by the delimiter. A part is quoted if it starts with
+// a double quote; quoted parts can contain the delimiter and double quotes escaped by doubling them ("").
+// Positions in the returned errors are byte offsets.
+func parseIdentifierParts(identifier string, opts identifierParserOptions) ([]string, error) {
+	if identifier == "" {
+		return nil, NewError("identifier cannot be empty")
+	}
+	delimiterLength := utf8.RuneLen(opts.delimiter)
+	parts := make([]string, 0)
+	for i := 0; ; i += delimiterLength {
+		var part string
+		var err error
+		if strings.HasPrefix(identifier[i:], `"`) {
+			part, i, err = parseQuotedIdentifierPart(identifier, i, opts.delimiter)
+		} else {
+			part, i, err = parseUnquotedIdentifierPart(identifier, i, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse identifier %s: %w", identifier, err)
+		}
+		parts = append(parts, part)
+		if i == len(identifier) {
+			return parts, nil
+		}
+		if i+delimiterLength == len(identifier) {
+			return nil, fmt.Errorf("unable to parse identifier %s: %w", identifier, NewError(fmt.Sprintf("identifier cannot end with the delimiter %q", opts.delimiter)))
+		}
+	}
+}
+
+// parseQuotedIdentifierPart reads the quoted part starting at the given position and returns it together
+// with the position of the delimiter following it (or the end of the input).
+func parseQuotedIdentifierPart(identifier string, start int, delimiter rune) (string, int, error) {
+	builder := new(strings.Builder)
+	i := start + 1
+	for {
+		end := strings.IndexByte(identifier[i:], '"')
+		if end < 0 {
+			return "", 0, NewError(fmt.Sprintf("unterminated quoted identifier part starting at position %d", start))
+		}
+		builder.WriteString(identifier[i : i+end])
+		i += end + 1
+		if !strings.HasPrefix(identifier[i:], `"`) {
+			break
+		}
+		builder.WriteByte('"')
+		i++
+	}
+	if builder.Len() == 0 {
+		return "", 0, NewError(fmt.Sprintf("empty quoted identifier part at position %d", start))
+	}
+	if i < len(identifier) {
+		if r, _ := utf8.DecodeRuneInString(identifier[i:]); r != delimiter {
+			return "", 0, NewError(fmt.Sprintf("unexpected character %q after quoted identifier part at position %d", r, i))
+		}
+	}
+	return builder.String(), i, nil
+}
+
+// parseUnquotedIdentifierPart reads the unquoted part starting at the given position and returns it together
+// with the position of the delimiter following it (or the end of the input).
+func parseUnquotedIdentifierPart(identifier string, start int, opts identifierParserOptions) (string, int, error) {
+	i := len(identifier)
+	if end := strings.IndexRune(identifier[start:], opts.delimiter); end >= 0 {
+		i = start + end
+	}
+	part := identifier[start:i]
+	if part == "" {
+		return "", 0, NewError(fmt.Sprintf("empty identifier part at position %d", start))
+	}
+	if opts.strict {
+		if !unquotedIdentifierRegexp.MatchString(part) {
+			return "", 0, NewError(fmt.Sprintf("invalid unquoted identifier part %s at position %d, it can only contain letters, digits, underscores and dollar signs and has to start with a letter or underscore; quote it to use other characters", part, start))
+		}
+		part = strings.ToUpper(part)
+	}
+	return part, i, nil
+}
+
+// ParseIdentifierString splits the identifier into parts following Snowflake identifier rules:
+// parts are separated by dots, quoted parts are case-sensitive and can contain any character ("" stands for a double quote),
+// unquoted parts are case-insensitive (returned upper-cased) and can contain only letters, digits, underscores and dollar signs.
+func ParseIdentifierString(identifier string) ([]string, error) {
+	return parseIdentifierParts(identifier, identifierParserOptions{delimiter: identifierPartsDelimiter, strict: true})
+}
+
+// SplitIdentifier splits the identifier into parts separated by the given delimiter. Parts starting with a double quote
+// are parsed as quoted identifiers (so they can contain the delimiter), other parts are returned as they are (without case folding).
+// It's meant for identifiers passed in the provider configuration and resource ids, which have always been case-sensitive.
+func SplitIdentifier(identifier string, delimiter rune) ([]string, error) {
+	return parseIdentifierParts(identifier, identifierParserOptions{delimiter: delimiter})
+}
+
+// QuoteIdentifierPart returns the part quoted if it's needed to read it back with SplitIdentifier using the given delimiter.
+func QuoteIdentifierPart(part string, delimiter rune) string {
+	if part != "" && !strings.ContainsRune(part, delimiter) && !strings.HasPrefix(part, `"`) {
+		return part
+	}
+	return quoteIdentifier(part)
+}
+
+// quoteIdentifier returns the identifier part quoted as expected in SQL statements.
+func quoteIdentifier(part string) string {
+	return `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+}
+
+// ParseObjectIdentifier parses the fully qualified name (see ParseIdentifierString) and returns the identifier type
+// matching the number of parts.
+func ParseObjectIdentifier(identifier string) (ObjectIdentifier, error) {
+	parts, err := ParseIdentifierString(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return objectIdentifierFromParts(identifier, parts)
+}
+
+func objectIdentifierFromParts(identifier string, parts []string) (ObjectIdentifier, error) {
+	switch len(parts) {
+	case 1:
+		return AccountObjectIdentifier{name: parts[0]}, nil
+	case 2:
+		return DatabaseObjectIdentifier{databaseName: parts[0], name: parts[1]}, nil
+	case 3:
+		return SchemaObjectIdentifier{databaseName: parts[0], schemaName: parts[1], name: parts[2]}, nil
+	case 4:
+		return TableColumnIdentifier{databaseName: parts[0], schemaName: parts[1], tableName: parts[2], columnName: parts[3]}, nil
+	default:
+		return nil, NewError(fmt.Sprintf("unable to classify identifier %s, expected from 1 to 4 parts, got %d", identifier, len(parts)))
+	}
+}
+
+func ParseAccountObjectIdentifier(identifier string) (AccountObjectIdentifier, error) {
+	return parseIdentifierWithParts(identifier, 1, func(parts []string) AccountObjectIdentifier {
+		return AccountObjectIdentifier{name: parts[0]}
+	})
+}
+
+func ParseDatabaseObjectIdentifier(identifier string) (DatabaseObjectIdentifier, error) {
+	return parseIdentifierWithParts(identifier, 2, func(parts []string) DatabaseObjectIdentifier {
+		return DatabaseObjectIdentifier{databaseName: parts[0], name: parts[1]}
+	})
+}
+
+func ParseSchemaObjectIdentifier(identifier string) (SchemaObjectIdentifier, error) {
+	return parseIdentifierWithParts(identifier, 3, func(parts []string) SchemaObjectIdentifier {
+		return SchemaObjectIdentifier{databaseName: parts[0], schemaName: parts[1], name: parts[2]}
+	})
+}
+
+func ParseTableColumnIdentifier(identifier string) (TableColumnIdentifier, error) {
+	return parseIdentifierWithParts(identifier, 4, func(parts []string) TableColumnIdentifier {
+		return TableColumnIdentifier{databaseName: parts[0], schemaName: parts[1], tableName: parts[2], columnName: parts[3]}
+	})
+}
+
+// ParseAccountIdentifier parses <organization_name>.<account_name> or <account_locator>.
+func ParseAccountIdentifier(identifier string) (AccountIdentifier, error) {
+	parts, err := ParseIdentifierString(identifier)
+	if err != nil {
+		return AccountIdentifier{}, err
+	}
+	switch len(parts) {
+	case 1:
+		return AccountIdentifier{accountLocator: parts[0]}, nil
+	case 2:
+		return AccountIdentifier{organizationName: parts[0], accountName: parts[1]}, nil
+	default:
+		return AccountIdentifier{}, NewError(fmt.Sprintf("unexpected account identifier %s, expected <organization_name>.<account_name> or <account_locator>", identifier))
+	}
+}
+
+// ParseExternalObjectIdentifier parses <organization_name>.<account_name>.<name> or <account_locator>.<name>.
+func ParseExternalObjectIdentifier(identifier string) (ExternalObjectIdentifier, error) {
+	parts, err := ParseIdentifierString(identifier)
+	if err != nil {
+		return ExternalObjectIdentifier{}, err
+	}
+	switch len(parts) {
+	case 2:
+		return NewExternalObjectIdentifier(AccountIdentifier{accountLocator: parts[0]}, AccountObjectIdentifier{name: parts[1]}), nil
+	case 3:
+		return NewExternalObjectIdentifier(AccountIdentifier{organizationName: parts[0], accountName: parts[1]}, AccountObjectIdentifier{name: parts[2]}), nil
+	default:
+		return ExternalObjectIdentifier{}, NewError(fmt.Sprintf("unexpected external object identifier %s, expected <organization_name>.<account_name>.<name> or <account_locator>.<name>", identifier))
+	}
+}
+
+// ParseFullyQualifiedName parses the fully qualified name passed in the provider configuration or returned by Snowflake
+// and returns the identifier of the expected type. Quoted parts can contain dots, unquoted parts are not case-folded,
+// because such names have always been case-sensitive in the provider (see SplitIdentifier).
+func ParseFullyQualifiedName[T AccountObjectIdentifier | DatabaseObjectIdentifier | SchemaObjectIdentifier | TableColumnIdentifier](fullyQualifiedName string) (T, error) {
+	var zero T
+	parts, err := SplitIdentifier(fullyQualifiedName, identifierPartsDelimiter)
+	if err != nil {
+		return zero, err
+	}
+	return identifierOfType[T](fullyQualifiedName, parts)
+}
+
+// identifierOfType creates the identifier of the expected type from the parts, failing if the number of parts doesn't match the type
+func identifierOfType[T AccountObjectIdentifier | DatabaseObjectIdentifier | SchemaObjectIdentifier | TableColumnIdentifier](identifier string, parts []string) (T, error) {
+	var zero T
+	form := identifierForm(any(zero).(ObjectIdentifier))
+	if expectedParts := strings.Count(form, ".") + 1; len(parts) != expectedParts {
+		return zero, NewError(fmt.Sprintf("unexpected number of parts %d in identifier %s, expected %d in the form of %s", len(parts), identifier, expectedParts, form))
+	}
+	id, err := objectIdentifierFromParts(identifier, parts)
+	if err != nil {
+		return zero, err
+	}
+	return any(id).(T), nil
+}
+
+func parseIdentifierWithParts[T ObjectIdentifier](identifier string, expectedParts int, fromParts func(parts []string) T) (T, error) {
+	var zero T
+	parts, err := ParseIdentifierString(identifier)
+	if err != nil {
+		return zero, err
+	}
+	if len(parts) != expectedParts {
+		return zero, NewError(fmt.Sprintf("unexpected number of parts %d in identifier %s, expected %d in the form of %s", len(parts), identifier, expectedParts, identifierForm(zero)))
+	}
+	return fromParts(parts), nil
+}
+
+func identifierForm(id ObjectIdentifier) string {
+	switch id.(type) {
+	case AccountObjectIdentifier:
+		return "<name>"
+	case DatabaseObjectIdentifier:
+		return "<database_name>.<name>"
+	case SchemaObjectIdentifier:
+		return "<database_name>.<schema_name>.<name>"
+	case TableColumnIdentifier:
+		return "<database_name>.<schema_name>.<table_name>.<column_name>"
+	}
+	return ""
+}
+
+// splitFullyQualifiedName splits the fully qualified name returned by Snowflake or passed in the configuration without
+// case folding. For backward compatibility, names which cannot be parsed are split on every dot with quotes trimmed.
+func splitFullyQualifiedName(fullyQualifiedName string) []string {
+	parts, err := SplitIdentifier(fullyQualifiedName, identifierPartsDelimiter)
+	if err == nil {
+		return parts
+	}
+	parts = strings.Split(fullyQualifiedName, ".")
+	for i, part := range parts {
+		parts[i] = strings.Trim(part, `"`)
+	}
+	return parts
+}
+
+// ParseSchemaObjectIdentifierWithArguments parses <database_name>.<schema_name>.<name>(<argument_data_types>) following
+// Snowflake rules for the name parts (see ParseIdentifierString). A missing arguments list means no arguments.
+func ParseSchemaObjectIdentifierWithArguments(identifier string) (SchemaObjectIdentifierWithArguments, error) {
+	return parseSchemaObjectIdentifierWithArguments(identifier, identifierParserOptions{delimiter: identifierPartsDelimiter, strict: true})
+}
+
+func parseSchemaObjectIdentifierWithArguments(identifier string, opts identifierParserOptions) (SchemaObjectIdentifierWithArguments, error) {
+	name, arguments, _ := cutIdentifierArguments(identifier)
+	parts, err := parseIdentifierParts(name, opts)
+	if err != nil {
+		return SchemaObjectIdentifierWithArguments{}, err
+	}
+	if len(parts) != 3 {
+		return SchemaObjectIdentifierWithArguments{}, NewError(fmt.Sprintf("unexpected number of parts %d in identifier %s, expected 3 in the form of <database_name>.<schema_name>.<name>(<argument_data_types>)", len(parts), identifier))
+	}
+	argumentDataTypes, err := parseArgumentDataTypes(arguments)
+	if err != nil {
+		return SchemaObjectIdentifierWithArguments{}, fmt.Errorf("unable to parse identifier %s: %w", identifier, err)
+	}
+	return SchemaObjectIdentifierWithArguments{
+		databaseName:      parts[0],
+		schemaName:        parts[1],
+		name:              parts[2],
+		argumentDataTypes: argumentDataTypes,
+	}, nil
+}
+
+// parseArgumentDataTypes parses a comma-separated list of argument data types. Commas inside parentheses
+// (e.g. in NUMBER(38,0)) do not separate the arguments.
+func parseArgumentDataTypes(arguments string) ([]DataType, error) {
+	argumentDataTypes := make([]DataType, 0)
+	if strings.TrimSpace(arguments) == "" {
+		return argumentDataTypes, nil
+	}
+	items, err := splitArguments(arguments)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		item = strings.TrimSpace(strings.Trim(strings.TrimSpace(item), `"`))
+		if item == "" {
+			return nil, NewError(fmt.Sprintf("empty data type of argument %d", i+1))
+		}
+		dataType, err := ToDataType(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data type of argument %d: %w", i+1, err)
+		}
+		argumentDataTypes = append(argumentDataTypes, dataType)
+	}
+	return argumentDataTypes, nil
+}
+
+func splitArguments(arguments string) ([]string, error) {
+	items := make([]string, 0)
+	depth, start := 0, 0
+	for i, r := range arguments {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, NewError(fmt.Sprintf("unexpected closing parenthesis at position %d in arguments %s", i, arguments))
+			}
+		case ',':
+			if depth == 0 {
+				items = append(items, arguments[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, NewError(fmt.Sprintf("unclosed parenthesis in arguments %s", arguments))
+	}
+	return append(items, arguments[start:]), nil
+}
+
+// ParseShowArgumentDataTypes parses the arguments column returned by SHOW FUNCTIONS, SHOW PROCEDURES
+// and SHOW EXTERNAL FUNCTIONS (e.g. "FN(NUMBER, VARCHAR) RETURN NUMBER") and returns the argument data types.
+func ParseShowArgumentDataTypes(arguments string) ([]DataType, error) {
+	signature := strings.TrimSpace(arguments)
+	if i := strings.LastIndex(signature, ") RETURN "); i >= 0 {
+		signature = signature[:i+1]
+	}
+	if !strings.HasSuffix(signature, ")") {
+		return nil, NewError(fmt.Sprintf("unable to find the arguments list in %s", arguments))
+	}
+	depth := 0
+	for i := len(signature) - 1; i >= 0; i-- {
+		switch signature[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				argumentDataTypes, err := parseArgumentDataTypes(signature[i+1 : len(signature)-1])
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse arguments %s: %w", arguments, err)
+				}
+				return argumentDataTypes, nil
+			}
+		}
+	}
+	return nil, NewError(fmt.Sprintf("unbalanced parentheses in arguments %s", arguments))
+}
+
+// argumentDataTypesMatch checks if the arguments column of SHOW output describes exactly the given argument data types.
+// Data type synonyms (e.g. INT and NUMBER) are treated as equal.
+func argumentDataTypesMatch(showArguments string, argumentDataTypes []DataType) bool {
+	shown, err := ParseShowArgumentDataTypes(showArguments)
+	if err != nil || len(shown) != len(argumentDataTypes) {
+		return false
+	}
+	for i, dataType := range argumentDataTypes {
+		expected, err := ToDataType(string(dataType))
+		if err != nil {
+			expected = DataType(strings.ToUpper(string(dataType)))
+		}
+		if shown[i] != expected {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,297 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdentifierString(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected []string
+		err      string
+	}{
+		"unquoted":                       {input: "db", expected: []string{"DB"}},
+		"unquoted lower case is folded":  {input: "db.schema.my_table", expected: []string{"DB", "SCHEMA", "MY_TABLE"}},
+		"unquoted with digits and $":     {input: "_db1.sch$ema", expected: []string{"_DB1", "SCH$EMA"}},
+		"quoted keeps case":              {input: `"db"."Schema"`, expected: []string{"db", "Schema"}},
+		"quoted with dots":               {input: `"d.b"."sch.ema"."ta.ble"`, expected: []string{"d.b", "sch.ema", "ta.ble"}},
+		"quoted with escaped quotes":     {input: `"a""b"."""c"""`, expected: []string{`a"b`, `"c"`}},
+		"quoted with pipes and spaces":   {input: `"a|b"."c d"`, expected: []string{"a|b", "c d"}},
+		"mixed quoted and unquoted":      {input: `db."Schema".tab`, expected: []string{"DB", "Schema", "TAB"}},
+		"four parts":                     {input: `a.b.c.d`, expected: []string{"A", "B", "C", "D"}},
+		"empty":                          {input: "", err: "identifier cannot be empty"},
+		"empty part":                     {input: "a..b", err: "empty identifier part at position 2"},
+		"leading delimiter":              {input: ".a", err: "empty identifier part at position 0"},
+		"trailing delimiter":             {input: "a.", err: "identifier cannot end with the delimiter"},
+		"unterminated quote":             {input: `"a.b`, err: "unterminated quoted identifier part starting at position 0"},
+		"character after quoted part":    {input: `"a"b.c`, err: `unexpected character 'b' after quoted identifier part at position 3`},
+		"empty quoted part":              {input: `"".a`, err: "empty quoted identifier part at position 0"},
+		"unquoted starting with digit":   {input: "1db", err: "invalid unquoted identifier part 1db at position 0"},
+		"unquoted starting with $":       {input: "$db", err: "invalid unquoted identifier part $db at position 0"},
+		"unquoted with dash":             {input: "db.my-schema", err: "invalid unquoted identifier part my-schema at position 3"},
+		"unquoted with quote inside":     {input: `db.a"b"`, err: `invalid unquoted identifier part a"b" at position 3`},
+		"unquoted with space":            {input: "my db", err: "invalid unquoted identifier part my db at position 0"},
+		"unquoted with parentheses":      {input: "db.schema.fn(NUMBER)", err: "invalid unquoted identifier part fn(NUMBER) at position 10"},
+		"quoted part followed by quotes": {input: `"a"""`, expected: []string{`a"`}},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			parts, err := ParseIdentifierString(tc.input)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, parts)
+			}
+		})
+	}
+}
+
+func TestSplitIdentifier(t *testing.T) {
+	testCases := map[string]struct {
+		input     string
+		delimiter rune
+		expected  []string
+		err       string
+	}{
+		"unquoted parts are not folded":       {input: "db.schema.Table", delimiter: '.', expected: []string{"db", "schema", "Table"}},
+		"unquoted parts can contain anything": {input: "my-db.my schema", delimiter: '.', expected: []string{"my-db", "my schema"}},
+		"quoted parts with delimiter":         {input: `"a.b".c`, delimiter: '.', expected: []string{"a.b", "c"}},
+		"pipe delimiter":                      {input: `db.x|schema|"ta|ble"`, delimiter: '|', expected: []string{"db.x", "schema", "ta|ble"}},
+		"quote inside unquoted part":          {input: `a"b|c`, delimiter: '|', expected: []string{`a"b`, "c"}},
+		"escaped quotes":                      {input: `"a""|b"|c`, delimiter: '|', expected: []string{`a"|b`, "c"}},
+		"empty part":                          {input: "a||b", delimiter: '|', err: "empty identifier part at position 2"},
+		"unterminated quote":                  {input: `a|"b`, delimiter: '|', err: "unterminated quoted identifier part starting at position 2"},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			parts, err := SplitIdentifier(tc.input, tc.delimiter)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, parts)
+			}
+		})
+	}
+}
+
+func TestParseObjectIdentifier(t *testing.T) {
+	t.Run("account object identifier", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`"my.warehouse"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("my.warehouse"), id)
+	})
+
+	t.Run("database object identifier", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`db."schema"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewDatabaseObjectIdentifier("DB", "schema"), id)
+	})
+
+	t.Run("schema object identifier", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`db.schema."Table"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "Table"), id)
+	})
+
+	t.Run("table column identifier", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`db.schema.table.col`)
+		require.NoError(t, err)
+		assert.Equal(t, NewTableColumnIdentifier("DB", "SCHEMA", "TABLE", "COL"), id)
+	})
+
+	t.Run("quoted names are not trimmed", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`"""quoted"""`)
+		require.NoError(t, err)
+		assert.Equal(t, `"quoted"`, id.Name())
+	})
+
+	t.Run("too many parts", func(t *testing.T) {
+		_, err := ParseObjectIdentifier(`a.b.c.d.e`)
+		require.ErrorContains(t, err, "unable to classify identifier a.b.c.d.e, expected from 1 to 4 parts, got 5")
+	})
+
+	t.Run("typed parsers validate the number of parts", func(t *testing.T) {
+		_, err := ParseAccountObjectIdentifier(`a.b`)
+		require.ErrorContains(t, err, "unexpected number of parts 2 in identifier a.b, expected 1 in the form of <name>")
+
+		_, err = ParseDatabaseObjectIdentifier(`a`)
+		require.ErrorContains(t, err, "expected 2 in the form of <database_name>.<name>")
+
+		_, err = ParseSchemaObjectIdentifier(`"a.b".c`)
+		require.ErrorContains(t, err, "expected 3 in the form of <database_name>.<schema_name>.<name>")
+
+		_, err = ParseTableColumnIdentifier(`a.b.c`)
+		require.ErrorContains(t, err, "expected 4 in the form of <database_name>.<schema_name>.<table_name>.<column_name>")
+	})
+
+	t.Run("typed parsers", func(t *testing.T) {
+		accountObjectId, err := ParseAccountObjectIdentifier(`wh`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("WH"), accountObjectId)
+
+		databaseObjectId, err := ParseDatabaseObjectIdentifier(`"db".schema`)
+		require.NoError(t, err)
+		assert.Equal(t, NewDatabaseObjectIdentifier("db", "SCHEMA"), databaseObjectId)
+
+		schemaObjectId, err := ParseSchemaObjectIdentifier(`"db"."sch.ema"."ta""ble"`)
+		require.NoError(t, err)
+		assert.Equal(t, SchemaObjectIdentifier{databaseName: "db", schemaName: "sch.ema", name: `ta"ble`}, schemaObjectId)
+
+		tableColumnId, err := ParseTableColumnIdentifier(`db.schema.table."column"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewTableColumnIdentifier("DB", "SCHEMA", "TABLE", "column"), tableColumnId)
+
+		functionId, err := ParseSchemaObjectIdentifierWithArguments(`db."schema".fn(NUMBER(38,0), varchar)`)
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifierWithArguments("DB", "schema", "FN", []DataType{DataTypeNumber, DataTypeVARCHAR}), functionId)
+	})
+
+	t.Run("account identifier", func(t *testing.T) {
+		id, err := ParseAccountIdentifier(`org.account`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountIdentifier("ORG", "ACCOUNT"), id)
+
+		id, err = ParseAccountIdentifier(`AB12345`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountIdentifierFromAccountLocator("AB12345"), id)
+
+		_, err = ParseAccountIdentifier(`a.b.c`)
+		require.ErrorContains(t, err, "unexpected account identifier a.b.c")
+	})
+
+	t.Run("external object identifier", func(t *testing.T) {
+		id, err := ParseExternalObjectIdentifier(`org.account."share.name"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewExternalObjectIdentifier(NewAccountIdentifier("ORG", "ACCOUNT"), NewAccountObjectIdentifier("share.name")), id)
+
+		id, err = ParseExternalObjectIdentifier(`AB12345.share`)
+		require.NoError(t, err)
+		assert.Equal(t, NewExternalObjectIdentifier(NewAccountIdentifierFromAccountLocator("AB12345"), NewAccountObjectIdentifier("SHARE")), id)
+
+		_, err = ParseExternalObjectIdentifier(`share`)
+		require.ErrorContains(t, err, "unexpected external object identifier share")
+	})
+}
+
+func TestNewObjectIdentifierFromFullyQualifiedName_QuotedParts(t *testing.T) {
+	assert.Equal(t, NewSchemaObjectIdentifier("d.b", "schema", "ta.ble"), NewObjectIdentifierFromFullyQualifiedName(`"d.b"."schema"."ta.ble"`))
+	assert.Equal(t, NewDatabaseObjectIdentifier("db", "sch.ema"), NewDatabaseObjectIdentifierFromFullyQualifiedName(`db."sch.ema"`))
+	assert.Equal(t, NewAccountObjectIdentifier("data.base"), NewAccountObjectIdentifierFromFullyQualifiedName(`"data.base"`))
+	assert.Equal(t, NewAccountObjectIdentifier("data.base"), NewAccountObjectIdentifierFromFullyQualifiedName(`data.base`))
+	assert.Equal(t, NewTableColumnIdentifier("db", "schema", "table", "co.l"), NewTableColumnIdentifierFromFullyQualifiedName(`db.schema.table."co.l"`))
+	assert.Equal(t, NewAccountIdentifier("org", "acc"), NewAccountIdentifierFromFullyQualifiedName(`"org"."acc"`))
+	assert.Equal(t, NewSchemaObjectIdentifier("d.b", "schema", "fn(x)"), NewSchemaObjectIdentifierFromFullyQualifiedName(`"d.b"."schema"."fn(x)"(NUMBER, VARCHAR)`))
+	functionId, err := NewSchemaObjectIdentifierWithArgumentsFromFullyQualifiedName(`"d.b"."schema"."fn(x)"(NUMBER, VARCHAR)`)
+	require.NoError(t, err)
+	assert.Equal(t, NewSchemaObjectIdentifierWithArguments("d.b", "schema", "fn(x)", []DataType{DataTypeNumber, DataTypeVARCHAR}), functionId)
+}
+
+func TestParseFullyQualifiedName(t *testing.T) {
+	t.Run("typed identifiers without case folding", func(t *testing.T) {
+		accountObjectId, err := ParseFullyQualifiedName[AccountObjectIdentifier](`wh`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("wh"), accountObjectId)
+
+		databaseObjectId, err := ParseFullyQualifiedName[DatabaseObjectIdentifier](`"d.b".schema`)
+		require.NoError(t, err)
+		assert.Equal(t, NewDatabaseObjectIdentifier("d.b", "schema"), databaseObjectId)
+
+		schemaObjectId, err := ParseFullyQualifiedName[SchemaObjectIdentifier](`db."Schema"."ta""ble"`)
+		require.NoError(t, err)
+		assert.Equal(t, SchemaObjectIdentifier{databaseName: "db", schemaName: "Schema", name: `ta"ble`}, schemaObjectId)
+
+		tableColumnId, err := ParseFullyQualifiedName[TableColumnIdentifier](`db.schema.table.column`)
+		require.NoError(t, err)
+		assert.Equal(t, NewTableColumnIdentifier("db", "schema", "table", "column"), tableColumnId)
+	})
+
+	t.Run("unexpected number of parts", func(t *testing.T) {
+		_, err := ParseFullyQualifiedName[DatabaseObjectIdentifier](`db`)
+		require.ErrorContains(t, err, "unexpected number of parts 1 in identifier db, expected 2 in the form of <database_name>.<name>")
+
+		_, err = ParseFullyQualifiedName[SchemaObjectIdentifier](`"db.schema".table`)
+		require.ErrorContains(t, err, "expected 3 in the form of <database_name>.<schema_name>.<name>")
+	})
+
+	t.Run("malformed names", func(t *testing.T) {
+		_, err := ParseFullyQualifiedName[AccountObjectIdentifier](``)
+		require.ErrorContains(t, err, "identifier cannot be empty")
+
+		_, err = ParseFullyQualifiedName[DatabaseObjectIdentifier](`"db.schema`)
+		require.ErrorContains(t, err, "unterminated quoted identifier part")
+	})
+}
+
+func TestNewObjectIdentifierFromFullyQualifiedName_MissingParts(t *testing.T) {
+	assert.Equal(t, NewDatabaseObjectIdentifier("db", ""), NewDatabaseObjectIdentifierFromFullyQualifiedName("db"))
+	assert.Equal(t, NewSchemaObjectIdentifier("db", "schema", ""), NewSchemaObjectIdentifierFromFullyQualifiedName("db.schema"))
+	assert.Equal(t, NewTableColumnIdentifier("db", "", "", ""), NewTableColumnIdentifierFromFullyQualifiedName("db"))
+}
+
+func FuzzParseIdentifierString(f *testing.F) {
+	for _, seed := range []string{`db`, `db.schema.table`, `"d.b"."Sch""ema"`, `a..b`, `"a`, `"a"b`, `a.`, `1a`, `a$1._b`, `"a|b".c.d.e`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		parts, err := ParseIdentifierString(input)
+		if err != nil {
+			return
+		}
+		require.NotEmpty(t, parts)
+		quoted := make([]string, len(parts))
+		for i, part := range parts {
+			require.NotEmpty(t, part)
+			quoted[i] = quoteIdentifier(part)
+		}
+		// parsing the quoted parts has to give the same result
+		reparsed, err := ParseIdentifierString(strings.Join(quoted, "."))
+		require.NoError(t, err)
+		require.Equal(t, parts, reparsed)
+	})
+}
+
+func FuzzSplitIdentifier(f *testing.F) {
+	for _, seed := range []string{`db|schema|table`, `"a|b"|c`, `a"b|c`, `""|a`, `a||b`, `|`, `"a""b"`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		parts, err := SplitIdentifier(input, '|')
+		if err != nil {
+			return
+		}
+		encoded := make([]string, len(parts))
+		for i, part := range parts {
+			require.NotEmpty(t, part)
+			encoded[i] = QuoteIdentifierPart(part, '|')
+		}
+		// encoding the parts and splitting them again has to give the same result
+		split, err := SplitIdentifier(strings.Join(encoded, "|"), '|')
+		require.NoError(t, err)
+		require.Equal(t, parts, split)
+	})
+}
+
+func FuzzParseFullyQualifiedName(f *testing.F) {
+	for _, seed := range []string{`db.schema.table`, `"d.b"."Sch""ema".table`, `db.schema`, `"a`, `a..b`, `a.b.c.d`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		id, err := ParseFullyQualifiedName[SchemaObjectIdentifier](input)
+		if err != nil || strings.Contains(id.DatabaseName()+id.SchemaName()+id.Name(), `"`) {
+			// FullyQualifiedName doesn't escape double quotes inside the parts, so such identifiers are not round-tripped
+			return
+		}
+		// the fully qualified name of the parsed identifier has to be parsed to the same identifier
+		reparsed, err := ParseFullyQualifiedName[SchemaObjectIdentifier](id.FullyQualifiedName())
+		require.NoError(t, err)
+		require.Equal(t, id, reparsed)
+	})
+}
@@ -0,0 +1,168 @@
+// Package fake implements an in-memory Snowflake backend as a database/sql driver. It interprets the statements
+// generated by the SDK for databases, schemas, warehouses, roles, users, tables, tags and grants, and answers
+// SHOW and DESCRIBE with rows shaped like the ones returned by Snowflake. It allows running the SDK, the provider and
+// the acceptance tests without a Snowflake account.
+//
+// Limitations:
+//   - statements for other object types are rejected with an "unsupported statement" error,
+//   - transactions are not isolated; statements are applied immediately and ROLLBACK does not revert them,
+//   - CLONE copies the current state of the source object; AT and BEFORE are ignored,
+//   - privileges are recorded but not enforced.
+package fake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// DriverName is the name the fake driver is registered with in database/sql.
+const DriverName = "snowflake-fake"
+
+func init() {
+	sql.Register(DriverName, &fakeDriver{})
+}
+
+// DSN builds the data source name for the fake driver. Connections opened with the same account share the state.
+func DSN(account string, user string, role string) string {
+	values := url.Values{}
+	values.Set("user", user)
+	values.Set("role", role)
+	return fmt.Sprintf("%s?%s", account, values.Encode())
+}
+
+type fakeDriver struct{}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	accountName, query, _ := strings.Cut(dsn, "?")
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fake DSN %s: %w", dsn, err)
+	}
+	if accountName == "" {
+		accountName = "FAKE"
+	}
+	a := accountByName(strings.ToUpper(accountName))
+	return &conn{session: a.newSession(values.Get("user"), values.Get("role"))}, nil
+}
+
+type conn struct {
+	session *session
+}
+
+var (
+	_ driver.Conn               = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+	_ driver.NamedValueChecker  = (*conn)(nil)
+	_ driver.SessionResetter    = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) PrepareContext(_ context.Context, query string) (driver.Stmt, error) {
+	return c.Prepare(query)
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return tx{}, nil
+}
+
+func (c *conn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	return tx{}, nil
+}
+
+func (c *conn) Ping(_ context.Context) error {
+	return nil
+}
+
+func (c *conn) ResetSession(_ context.Context) error {
+	return nil
+}
+
+// CheckNamedValue rejects bind parameters; the SDK always inlines the values into the statements.
+func (c *conn) CheckNamedValue(_ *driver.NamedValue) error {
+	return errors.New("fake backend: bind parameters are not supported")
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.session.execute(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	r, err := c.session.execute(query)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{result: r}, nil
+}
+
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	return 0
+}
+
+func (s *stmt) Exec(_ []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, nil)
+}
+
+func (s *stmt) Query(_ []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, nil)
+}
+
+type tx struct{}
+
+func (tx) Commit() error {
+	return nil
+}
+
+func (tx) Rollback() error {
+	return nil
+}
+
+type rows struct {
+	result *result
+	next   int
+}
+
+func (r *rows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.next])
+	r.next++
+	return nil
+}
@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// Errors are returned as *gosnowflake.SnowflakeError with the numbers used by Snowflake, so they are decoded by the SDK
+// the same way as errors returned by the real driver.
+
+func syntaxError(message string) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   1003,
+		SQLState: "42000",
+		Message:  fmt.Sprintf("SQL compilation error:\nsyntax error: %s", message),
+	}
+}
+
+func objectNotExistError(kind string, id identifier) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   2003,
+		SQLState: "02000",
+		Message:  fmt.Sprintf("SQL compilation error:\n%s '%s' does not exist or not authorized.", kindDisplayName(kind), id),
+	}
+}
+
+func objectAlreadyExistsError(id identifier) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   2002,
+		SQLState: "42710",
+		Message:  fmt.Sprintf("SQL compilation error:\nObject '%s' already exists.", id),
+	}
+}
+
+func invalidStatementError(message string) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   2000,
+		SQLState: "42601",
+		Message:  fmt.Sprintf("SQL compilation error:\n%s", message),
+	}
+}
+
+// unsupportedError is returned for statements the fake backend does not interpret; tests relying on them must run against a real account.
+func unsupportedError(sql string) error {
+	return fmt.Errorf("fake backend: unsupported statement: %s", sql)
+}
@@ -0,0 +1,349 @@
+//go:build fake_backend
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeClient(t *testing.T, account string) *sdk.Client {
+	t.Helper()
+	sdk.ResetFakeAccount(account)
+	t.Cleanup(func() { sdk.ResetFakeAccount(account) })
+	client, err := sdk.NewFakeClient(account)
+	require.NoError(t, err)
+	return client
+}
+
+func TestFake_ContextFunctions(t *testing.T) {
+	client := fakeClient(t, "context_functions")
+	ctx := context.Background()
+
+	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "CONTEXT_FUNCTIONS", account)
+
+	role, err := client.ContextFunctions.CurrentRole(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ACCOUNTADMIN", role)
+
+	inSession, err := client.ContextFunctions.IsRoleInSession(ctx, sdk.NewAccountObjectIdentifier("SYSADMIN"))
+	require.NoError(t, err)
+	assert.True(t, inSession)
+}
+
+func TestFake_SharedState(t *testing.T) {
+	client := fakeClient(t, "shared_state")
+	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier("DB")
+
+	require.NoError(t, client.Databases.Create(ctx, id, nil))
+
+	other, err := sdk.NewFakeClient("shared_state")
+	require.NoError(t, err)
+	_, err = other.Databases.ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	separate := fakeClient(t, "separate_state")
+	_, err = separate.Databases.ShowByID(ctx, id)
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+}
+
+func TestFake_Databases(t *testing.T) {
+	client := fakeClient(t, "databases")
+	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier("DB")
+
+	err := client.Databases.Create(ctx, id, &sdk.CreateDatabaseOptions{
+		Transient:               sdk.Bool(true),
+		DataRetentionTimeInDays: sdk.Int(0),
+		Comment:                 sdk.String("it's a comment"),
+	})
+	require.NoError(t, err)
+
+	err = client.Databases.Create(ctx, id, nil)
+	require.ErrorIs(t, err, sdk.ErrObjectAlreadyExists)
+
+	database, err := client.Databases.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "DB", database.Name)
+	assert.True(t, database.Transient)
+	assert.Equal(t, 0, database.RetentionTime)
+	assert.Equal(t, "it's a comment", database.Comment)
+	assert.Equal(t, "ACCOUNTADMIN", database.Owner)
+
+	schema, err := client.Schemas.ShowByID(ctx, sdk.NewDatabaseObjectIdentifier("DB", "PUBLIC"))
+	require.NoError(t, err)
+	assert.Equal(t, "PUBLIC", schema.Name)
+
+	err = client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: &sdk.DatabaseSet{DataRetentionTimeInDays: sdk.Int(5)}})
+	require.NoError(t, err)
+	err = client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Unset: &sdk.DatabaseUnset{Comment: sdk.Bool(true)}})
+	require.NoError(t, err)
+
+	retention, err := client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterDataRetentionTimeInDays, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id})
+	require.NoError(t, err)
+	assert.Equal(t, "5", retention.Value)
+	assert.Equal(t, sdk.ParameterType("DATABASE"), retention.Level)
+
+	newId := sdk.NewAccountObjectIdentifier("DB2")
+	err = client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{NewName: newId})
+	require.NoError(t, err)
+
+	database, err = client.Databases.ShowByID(ctx, newId)
+	require.NoError(t, err)
+	assert.Equal(t, 5, database.RetentionTime)
+	assert.Empty(t, database.Comment)
+	_, err = client.Schemas.ShowByID(ctx, sdk.NewDatabaseObjectIdentifier("DB2", "PUBLIC"))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Databases.Drop(ctx, newId, nil))
+	_, err = client.Databases.ShowByID(ctx, newId)
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	err = client.Databases.Drop(ctx, newId, nil)
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+}
+
+func TestFake_Schemas(t *testing.T) {
+	client := fakeClient(t, "schemas")
+	ctx := context.Background()
+	id := sdk.NewDatabaseObjectIdentifier("DB", "SC")
+
+	err := client.Schemas.Create(ctx, id, nil)
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+	require.NoError(t, client.Databases.Create(ctx, sdk.NewAccountObjectIdentifier("DB"), nil))
+	err = client.Schemas.Create(ctx, id, &sdk.CreateSchemaOptions{
+		WithManagedAccess: sdk.Bool(true),
+		Comment:           sdk.String("schema"),
+	})
+	require.NoError(t, err)
+
+	schema, err := client.Schemas.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "DB", schema.DatabaseName)
+	assert.Equal(t, "schema", *schema.Comment)
+	assert.Contains(t, *schema.Options, "MANAGED ACCESS")
+
+	schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: sdk.NewAccountObjectIdentifier("DB")}})
+	require.NoError(t, err)
+	assert.Len(t, schemas, 2)
+}
+
+func TestFake_Warehouses(t *testing.T) {
+	client := fakeClient(t, "warehouses")
+	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier("WH")
+
+	err := client.Warehouses.Create(ctx, id, &sdk.CreateWarehouseOptions{
+		WarehouseSize:      &sdk.WarehouseSizeXSmall,
+		AutoSuspend:        sdk.Int(60),
+		InitiallySuspended: sdk.Bool(true),
+	})
+	require.NoError(t, err)
+
+	warehouse, err := client.Warehouses.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.WarehouseSizeXSmall, warehouse.Size)
+	assert.Equal(t, 60, warehouse.AutoSuspend)
+	assert.Equal(t, sdk.WarehouseStateSuspended, warehouse.State)
+
+	err = client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Set: &sdk.WarehouseSet{WarehouseSize: &sdk.WarehouseSizeLarge}})
+	require.NoError(t, err)
+	err = client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Resume: sdk.Bool(true)})
+	require.NoError(t, err)
+
+	warehouse, err = client.Warehouses.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.WarehouseSizeLarge, warehouse.Size)
+	assert.Equal(t, sdk.WarehouseStateStarted, warehouse.State)
+}
+
+func TestFake_Tables(t *testing.T) {
+	client := fakeClient(t, "tables")
+	ctx := context.Background()
+	require.NoError(t, client.Databases.Create(ctx, sdk.NewAccountObjectIdentifier("DB"), nil))
+	id := sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "T")
+
+	err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(sdk.Bool(true)),
+		*sdk.NewTableColumnRequest("NAME", sdk.DataTypeVARCHAR).WithComment(sdk.String("name")),
+	}).WithComment(sdk.String("table")))
+	require.NoError(t, err)
+
+	table, err := client.Tables.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "T", table.Name)
+	assert.Equal(t, "table", table.Comment)
+
+	err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().
+		WithAdd(sdk.NewTableColumnAddActionRequest("AGE", sdk.DataTypeNumber))))
+	require.NoError(t, err)
+
+	columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	require.NoError(t, err)
+	require.Len(t, columns, 3)
+	assert.Equal(t, "ID", columns[0].Name)
+	assert.Equal(t, sdk.DataType("NUMBER(38,0)"), columns[0].Type)
+	assert.False(t, columns[0].IsNullable)
+	assert.Equal(t, sdk.DataType("VARCHAR(16777216)"), columns[1].Type)
+	assert.Equal(t, "name", *columns[1].Comment)
+	assert.Equal(t, "AGE", columns[2].Name)
+
+	require.NoError(t, client.Tables.Drop(ctx, sdk.NewDropTableRequest(id)))
+	_, err = client.Tables.ShowByID(ctx, id)
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+}
+
+func TestFake_RolesAndUsers(t *testing.T) {
+	client := fakeClient(t, "roles_and_users")
+	ctx := context.Background()
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+	userId := sdk.NewAccountObjectIdentifier("USER")
+
+	require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(roleId).WithComment("role")))
+	err := client.Users.Create(ctx, userId, &sdk.CreateUserOptions{ObjectProperties: &sdk.UserObjectProperties{
+		LoginName: sdk.String("login"),
+		Password:  sdk.String("secret"),
+	}})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleId, sdk.GrantRole{User: &userId})))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleId, sdk.GrantRole{Role: sdk.Pointer(sdk.NewAccountObjectIdentifier("SYSADMIN"))})))
+
+	role, err := client.Roles.ShowByID(ctx, sdk.NewShowByIdRoleRequest(roleId))
+	require.NoError(t, err)
+	assert.Equal(t, "role", role.Comment)
+	assert.Equal(t, 1, role.AssignedToUsers)
+	assert.Equal(t, 1, role.GrantedToRoles)
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{Role: roleId}})
+	require.NoError(t, err)
+	assert.Len(t, grants, 2)
+
+	user, err := client.Users.Describe(ctx, userId)
+	require.NoError(t, err)
+	assert.Equal(t, "LOGIN", user.LoginName.Value)
+
+	require.NoError(t, client.Roles.Drop(ctx, sdk.NewDropRoleRequest(roleId)))
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{User: userId}})
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+}
+
+func TestFake_Tags(t *testing.T) {
+	client := fakeClient(t, "tags")
+	ctx := context.Background()
+	tagId := sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "TAG")
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+
+	require.NoError(t, client.Databases.Create(ctx, sdk.NewAccountObjectIdentifier("DB"), nil))
+	require.NoError(t, client.Tags.Create(ctx, sdk.NewCreateTagRequest(tagId).WithAllowedValues([]string{"a", "b"})))
+
+	tag, err := client.Tags.ShowByID(ctx, tagId)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tag.AllowedValues)
+
+	err = client.Roles.Create(ctx, sdk.NewCreateRoleRequest(roleId).WithTag([]sdk.TagAssociation{{Name: tagId, Value: "a"}}))
+	require.NoError(t, err)
+	value, err := client.SystemFunctions.GetTag(ctx, tagId, roleId, sdk.ObjectTypeRole)
+	require.NoError(t, err)
+	assert.Equal(t, "a", value)
+
+	require.NoError(t, client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(roleId).WithSetTags([]sdk.TagAssociation{{Name: tagId, Value: "b"}})))
+	value, err = client.SystemFunctions.GetTag(ctx, tagId, roleId, sdk.ObjectTypeRole)
+	require.NoError(t, err)
+	assert.Equal(t, "b", value)
+
+	// an unset tag is NULL
+	require.NoError(t, client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(roleId).WithUnsetTags([]sdk.ObjectIdentifier{tagId})))
+	_, err = client.SystemFunctions.GetTag(ctx, tagId, roleId, sdk.ObjectTypeRole)
+	require.Error(t, err)
+
+	require.NoError(t, client.Tags.Drop(ctx, sdk.NewDropTagRequest(tagId)))
+	err = client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(roleId).WithSetTags([]sdk.TagAssociation{{Name: tagId, Value: "a"}}))
+	require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+}
+
+func TestFake_Clones(t *testing.T) {
+	client := fakeClient(t, "clones")
+	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier("DB")
+	cloneId := sdk.NewAccountObjectIdentifier("DB_CLONE")
+
+	require.NoError(t, client.Databases.Create(ctx, id, &sdk.CreateDatabaseOptions{Comment: sdk.String("source")}))
+	require.NoError(t, client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier("DB", "SC"), nil))
+	require.NoError(t, client.Databases.Create(ctx, cloneId, &sdk.CreateDatabaseOptions{Clone: &sdk.Clone{SourceObject: id}}))
+
+	database, err := client.Databases.ShowByID(ctx, cloneId)
+	require.NoError(t, err)
+	assert.Equal(t, "source", database.Comment)
+	_, err = client.Schemas.ShowByID(ctx, sdk.NewDatabaseObjectIdentifier("DB_CLONE", "SC"))
+	require.NoError(t, err)
+
+	databases, err := client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{Terse: sdk.Bool(true), Like: &sdk.Like{Pattern: sdk.String("DB%")}})
+	require.NoError(t, err)
+	require.Len(t, databases, 2)
+	assert.Empty(t, databases[0].Owner)
+}
+
+func TestFake_Grants(t *testing.T) {
+	client := fakeClient(t, "grants")
+	ctx := context.Background()
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "PUBLIC")
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+	require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(roleId)))
+
+	err := client.Grants.GrantPrivilegesToAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}},
+		&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+		roleId,
+		&sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(true)},
+	)
+	require.NoError(t, err)
+	err = client.Grants.GrantPrivilegesToAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{SchemaObjectPrivileges: []sdk.SchemaObjectPrivilege{sdk.SchemaObjectPrivilegeSelect}},
+		&sdk.AccountRoleGrantOn{SchemaObject: &sdk.GrantOnSchemaObject{Future: &sdk.GrantOnSchemaObjectIn{
+			PluralObjectType: sdk.PluralObjectTypeTables,
+			InSchema:         &schemaId,
+		}}},
+		roleId,
+		nil,
+	)
+	require.NoError(t, err)
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "USAGE", grants[0].Privilege)
+	assert.Equal(t, sdk.ObjectTypeDatabase, grants[0].GrantedOn)
+	assert.Equal(t, databaseId.FullyQualifiedName(), grants[0].Name.FullyQualifiedName())
+	assert.True(t, grants[0].GrantOption)
+	assert.Equal(t, "ACCOUNTADMIN", grants[0].GrantedBy.Name())
+
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{Future: sdk.Bool(true), In: &sdk.ShowGrantsIn{Schema: &schemaId}})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "SELECT", grants[0].Privilege)
+	assert.Equal(t, sdk.ObjectTypeTable, grants[0].GrantOn)
+
+	err = client.Grants.RevokePrivilegesFromAccountRole(ctx,
+		&sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage}},
+		&sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}},
+		roleId,
+		nil,
+	)
+	require.NoError(t, err)
+
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{On: &sdk.ShowGrantsOn{Object: &sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: databaseId}}})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "OWNERSHIP", grants[0].Privilege)
+}
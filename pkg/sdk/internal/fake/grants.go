@@ -0,0 +1,560 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const kindAccount = "ACCOUNT"
+
+// grant is a privilege granted to a role (or a role granted to a role or a user). Future grants are defined in
+// a database or a schema (name) for the objects of the given type (on) created there later.
+type grant struct {
+	createdOn   time.Time
+	privilege   string
+	on          string
+	name        identifier
+	future      bool
+	to          string
+	grantee     string
+	grantOption bool
+	grantedBy   string
+}
+
+// targetKind returns the type of the object the grant is attached to.
+func (g *grant) targetKind() string {
+	if g.future {
+		return []string{kindDatabase, kindSchema}[len(g.name)-1]
+	}
+	return g.on
+}
+
+func (g *grant) matches(other *grant) bool {
+	return g.privilege == other.privilege && g.on == other.on && g.name.equals(other.name) && g.future == other.future &&
+		g.to == other.to && g.grantee == other.grantee
+}
+
+// allPrivileges lists the privileges granted by ALL [PRIVILEGES] for the object types stored by the fake backend.
+var allPrivileges = map[string][]string{
+	kindAccount: {
+		"CREATE DATABASE", "CREATE INTEGRATION", "CREATE ROLE", "CREATE USER", "CREATE WAREHOUSE", "EXECUTE TASK",
+		"MANAGE GRANTS", "MONITOR EXECUTION", "MONITOR USAGE",
+	},
+	kindDatabase: {"CREATE DATABASE ROLE", "CREATE SCHEMA", "MODIFY", "MONITOR", "USAGE"},
+	kindSchema: {
+		"CREATE FILE FORMAT", "CREATE FUNCTION", "CREATE PIPE", "CREATE PROCEDURE", "CREATE SEQUENCE", "CREATE STAGE",
+		"CREATE STREAM", "CREATE TABLE", "CREATE TASK", "CREATE VIEW", "MODIFY", "MONITOR", "USAGE",
+	},
+	kindTable:     {"DELETE", "EVOLVE SCHEMA", "INSERT", "REFERENCES", "SELECT", "TRUNCATE", "UPDATE"},
+	kindWarehouse: {"APPLYBUDGET", "MODIFY", "MONITOR", "OPERATE", "USAGE"},
+	kindUser:      {"MONITOR"},
+}
+
+// builtInRoleHierarchy is granted in every account: ACCOUNTADMIN inherits SYSADMIN and SECURITYADMIN that inherits USERADMIN.
+var builtInRoleHierarchy = [][2]string{
+	{"SYSADMIN", "ACCOUNTADMIN"},
+	{"SECURITYADMIN", "ACCOUNTADMIN"},
+	{"USERADMIN", "SECURITYADMIN"},
+}
+
+// grantTarget is the object (or objects) in the ON clause of GRANT and REVOKE.
+type grantTarget struct {
+	kind   string
+	ids    []identifier
+	future bool
+	in     identifier
+}
+
+func singular(plural string) string {
+	if strings.HasSuffix(plural, "IES") {
+		return strings.TrimSuffix(plural, "IES") + "Y"
+	}
+	return strings.TrimSuffix(plural, "S")
+}
+
+func (s *session) parseGrantTarget(p *parser) (*grantTarget, error) {
+	switch {
+	case p.acceptKeyword("ACCOUNT"):
+		return &grantTarget{kind: kindAccount, ids: []identifier{{s.account.name}}}, nil
+	case p.isKeyword("ALL"), p.isKeyword("FUTURE"):
+		future := p.next().isWord("FUTURE")
+		kind := singular(p.words("IN"))
+		if err := p.expectKeyword("IN"); err != nil {
+			return nil, err
+		}
+		containerKind, ok := p.acceptOneOf(kindDatabase, kindSchema)
+		if !ok {
+			return nil, p.unexpected("DATABASE or SCHEMA")
+		}
+		in, err := s.objectIdentifier(p, objectKindByName(containerKind))
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(containerKind, in) == nil {
+			return nil, objectNotExistError(containerKind, in)
+		}
+		target := &grantTarget{kind: kind, future: future, in: in}
+		if !future && objectKindByName(kind) != nil {
+			for _, o := range s.account.list(kind) {
+				if o.id.hasPrefix(in) {
+					target.ids = append(target.ids, o.id)
+				}
+			}
+		}
+		return target, nil
+	default:
+		kind, err := p.objectKind()
+		if err != nil {
+			return nil, err
+		}
+		id, err := s.objectIdentifier(p, kind)
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kind.name, id) == nil {
+			return nil, objectNotExistError(kind.name, id)
+		}
+		return &grantTarget{kind: kind.name, ids: []identifier{id}}, nil
+	}
+}
+
+// parsePrivileges parses the comma-separated privileges up to ON, e.g. SELECT, INSERT or ALL PRIVILEGES.
+func parsePrivileges(p *parser) []string {
+	var privileges []string
+	for {
+		privilege := p.words("ON")
+		if privilege == "ALL PRIVILEGES" {
+			privilege = "ALL"
+		}
+		privileges = append(privileges, privilege)
+		if !p.acceptSymbol(",") {
+			return privileges
+		}
+	}
+}
+
+func expandPrivileges(privileges []string, kind string) []string {
+	var result []string
+	for _, privilege := range privileges {
+		if privilege == "ALL" {
+			if all, ok := allPrivileges[kind]; ok {
+				result = append(result, all...)
+				continue
+			}
+		}
+		result = append(result, privilege)
+	}
+	return result
+}
+
+func (s *session) grantee(p *parser) (string, string, error) {
+	kind, ok := p.acceptOneOf(kindRole, kindUser)
+	if !ok {
+		return "", "", unsupportedError(p.sql)
+	}
+	id, err := p.identifierOfLength(1)
+	if err != nil {
+		return "", "", err
+	}
+	if s.account.find(kind, id) == nil {
+		return "", "", objectNotExistError(kind, id)
+	}
+	return kind, id.name(), nil
+}
+
+func (s *session) grant(p *parser) (*result, error) {
+	switch {
+	case p.acceptKeyword("ROLE"):
+		return s.grantRole(p)
+	case p.acceptKeyword("OWNERSHIP"):
+		return s.grantOwnership(p)
+	}
+
+	privileges := parsePrivileges(p)
+	if err := p.expectKeyword("ON"); err != nil {
+		return nil, err
+	}
+	target, err := s.parseGrantTarget(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("TO"); err != nil {
+		return nil, err
+	}
+	granteeKind, grantee, err := s.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	withGrantOption := p.acceptKeyword("WITH", "GRANT", "OPTION")
+	if !p.done() || granteeKind != kindRole {
+		return nil, unsupportedError(p.sql)
+	}
+
+	for _, privilege := range expandPrivileges(privileges, target.kind) {
+		for _, g := range s.targetGrants(target, privilege, grantee) {
+			g.grantOption = withGrantOption
+			s.account.addGrant(g)
+		}
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// targetGrants returns the grants of the privilege on every object of the target.
+func (s *session) targetGrants(target *grantTarget, privilege string, grantee string) []*grant {
+	newGrant := func(name identifier) *grant {
+		return &grant{
+			createdOn: time.Now(),
+			privilege: privilege,
+			on:        target.kind,
+			name:      name,
+			future:    target.future,
+			to:        kindRole,
+			grantee:   grantee,
+			grantedBy: s.role,
+		}
+	}
+	if target.future {
+		return []*grant{newGrant(target.in)}
+	}
+	grants := make([]*grant, len(target.ids))
+	for i, id := range target.ids {
+		grants[i] = newGrant(id)
+	}
+	return grants
+}
+
+func (a *account) addGrant(g *grant) {
+	for _, existing := range a.grants {
+		if existing.matches(g) {
+			existing.grantOption = existing.grantOption || g.grantOption
+			return
+		}
+	}
+	a.grants = append(a.grants, g)
+}
+
+func (s *session) grantRole(p *parser) (*result, error) {
+	role, err := p.identifierOfLength(1)
+	if err != nil {
+		return nil, err
+	}
+	if s.account.find(kindRole, role) == nil {
+		return nil, objectNotExistError(kindRole, role)
+	}
+	if err := p.expectKeyword("TO"); err != nil {
+		return nil, err
+	}
+	granteeKind, grantee, err := s.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	s.account.addGrant(&grant{
+		createdOn: time.Now(),
+		privilege: "USAGE",
+		on:        kindRole,
+		name:      role,
+		to:        granteeKind,
+		grantee:   grantee,
+		grantedBy: s.role,
+	})
+	return statusResult("Statement executed successfully."), nil
+}
+
+func (s *session) grantOwnership(p *parser) (*result, error) {
+	if err := p.expectKeyword("ON"); err != nil {
+		return nil, err
+	}
+	target, err := s.parseGrantTarget(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("TO"); err != nil {
+		return nil, err
+	}
+	granteeKind, grantee, err := s.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.acceptOneOf("REVOKE CURRENT GRANTS", "COPY CURRENT GRANTS"); !ok && !p.done() || granteeKind != kindRole || target.kind == kindAccount {
+		return nil, unsupportedError(p.sql)
+	}
+
+	if target.future {
+		s.account.addGrant(s.targetGrants(target, "OWNERSHIP", grantee)[0])
+		return statusResult("Statement executed successfully."), nil
+	}
+	for _, id := range target.ids {
+		if o := s.account.find(target.kind, id); o != nil {
+			o.owner = grantee
+		}
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+func (s *session) revoke(p *parser) (*result, error) {
+	if p.acceptKeyword("ROLE") {
+		return s.revokeRole(p)
+	}
+
+	grantOptionFor := p.acceptKeyword("GRANT", "OPTION", "FOR")
+	privileges := parsePrivileges(p)
+	if err := p.expectKeyword("ON"); err != nil {
+		return nil, err
+	}
+	target, err := s.parseGrantTarget(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	granteeKind, grantee, err := s.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	p.acceptOneOf("CASCADE", "RESTRICT")
+	if !p.done() || granteeKind != kindRole {
+		return nil, unsupportedError(p.sql)
+	}
+
+	for _, privilege := range expandPrivileges(privileges, target.kind) {
+		for _, revoked := range s.targetGrants(target, privilege, grantee) {
+			s.account.grants = slices.DeleteFunc(s.account.grants, func(g *grant) bool {
+				if !g.matches(revoked) {
+					return false
+				}
+				if grantOptionFor {
+					g.grantOption = false
+					return false
+				}
+				return true
+			})
+		}
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+func (s *session) revokeRole(p *parser) (*result, error) {
+	role, err := p.identifierOfLength(1)
+	if err != nil {
+		return nil, err
+	}
+	if s.account.find(kindRole, role) == nil {
+		return nil, objectNotExistError(kindRole, role)
+	}
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	granteeKind, grantee, err := s.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	s.account.grants = slices.DeleteFunc(s.account.grants, func(g *grant) bool {
+		return g.on == kindRole && g.name.equals(role) && g.to == granteeKind && g.grantee == grantee
+	})
+	return statusResult("Statement executed successfully."), nil
+}
+
+// roleGrantsOf returns the grants of the role to the roles or users (depending on the kind).
+func (a *account) roleGrantsOf(role string, kind string) []*grant {
+	var result []*grant
+	for _, g := range a.grants {
+		if g.on == kindRole && !g.future && g.name.name() == role && g.to == kind {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// rolesGrantedTo returns the grants of other roles to the role.
+func (a *account) rolesGrantedTo(role string) []*grant {
+	var result []*grant
+	for _, g := range a.grants {
+		if g.on == kindRole && !g.future && g.to == kindRole && g.grantee == role {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// isRoleGranted checks if the role is inherited by the grantee role, directly or through other roles. PUBLIC is inherited by every role.
+func (a *account) isRoleGranted(role string, grantee string) bool {
+	if role == "PUBLIC" {
+		return true
+	}
+	visited := map[string]bool{grantee: true}
+	queue := []string{grantee}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, g := range a.rolesGrantedTo(current) {
+			inherited := g.name.name()
+			if inherited == role {
+				return true
+			}
+			if !visited[inherited] {
+				visited[inherited] = true
+				queue = append(queue, inherited)
+			}
+		}
+	}
+	return false
+}
+
+// grantedOn returns the object type the way SHOW GRANTS presents it, e.g. MATERIALIZED_VIEW.
+func grantedOn(kind string) string {
+	return strings.ReplaceAll(kind, " ", "_")
+}
+
+func (g *grant) futureName() string {
+	return fmt.Sprintf("%s.<%s>", g.name, grantedOn(g.on))
+}
+
+func (s *session) showGrants(p *parser, future bool) (*result, error) {
+	var filter func(g *grant) bool
+	var ownedBy func(o *object) bool
+	switch {
+	case future && p.acceptKeyword("IN"):
+		kind, ok := p.acceptOneOf(kindDatabase, kindSchema)
+		if !ok {
+			return nil, p.unexpected("DATABASE or SCHEMA")
+		}
+		in, err := s.objectIdentifier(p, objectKindByName(kind))
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kind, in) == nil {
+			return nil, objectNotExistError(kind, in)
+		}
+		filter = func(g *grant) bool { return g.future && g.name.equals(in) }
+	case future && p.acceptKeyword("TO", "ROLE"):
+		role, err := p.identifierOfLength(1)
+		if err != nil {
+			return nil, err
+		}
+		filter = func(g *grant) bool { return g.future && g.to == kindRole && g.grantee == role.name() }
+	case future:
+		return nil, unsupportedError(p.sql)
+	case p.done():
+		filter = func(g *grant) bool { return !g.future && g.to == kindRole && g.grantee == s.role }
+		ownedBy = func(o *object) bool { return o.owner == s.role }
+	case p.acceptKeyword("ON"):
+		target, err := s.parseGrantTarget(p)
+		if err != nil {
+			return nil, err
+		}
+		if target.future {
+			return nil, unsupportedError(p.sql)
+		}
+		id := target.ids[0]
+		filter = func(g *grant) bool { return !g.future && g.on == target.kind && g.name.equals(id) }
+		ownedBy = func(o *object) bool { return o.kind == target.kind && o.id.equals(id) }
+	case p.acceptKeyword("TO", "ROLE"):
+		role, err := p.identifierOfLength(1)
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kindRole, role) == nil {
+			return nil, objectNotExistError(kindRole, role)
+		}
+		filter = func(g *grant) bool { return !g.future && g.to == kindRole && g.grantee == role.name() }
+		ownedBy = func(o *object) bool { return o.owner == role.name() }
+	case p.acceptKeyword("TO", "USER"):
+		user, err := p.identifierOfLength(1)
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kindUser, user) == nil {
+			return nil, objectNotExistError(kindUser, user)
+		}
+		return s.roleGrantsResult(func(g *grant) bool { return g.to == kindUser && g.grantee == user.name() }), nil
+	case p.acceptKeyword("OF", "ROLE"):
+		role, err := p.identifierOfLength(1)
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kindRole, role) == nil {
+			return nil, objectNotExistError(kindRole, role)
+		}
+		return s.roleGrantsResult(func(g *grant) bool { return g.name.equals(role) }), nil
+	default:
+		return nil, unsupportedError(p.sql)
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+
+	if future {
+		r := newResult("created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option")
+		for _, g := range s.account.grants {
+			if filter(g) {
+				r.addRow(
+					cell{"created_on", g.createdOn},
+					cell{"privilege", g.privilege},
+					cell{"grant_on", grantedOn(g.on)},
+					cell{"name", g.futureName()},
+					cell{"grant_to", g.to},
+					cell{"grantee_name", g.grantee},
+					cell{"grant_option", g.grantOption},
+				)
+			}
+		}
+		return r, nil
+	}
+
+	r := newResult("created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by")
+	for _, o := range s.account.objects {
+		if o.owner != "" && ownedBy(o) {
+			r.addRow(
+				cell{"created_on", o.createdOn},
+				cell{"privilege", "OWNERSHIP"},
+				cell{"granted_on", grantedOn(o.kind)},
+				cell{"name", o.id.String()},
+				cell{"granted_to", kindRole},
+				cell{"grantee_name", o.owner},
+				cell{"grant_option", true},
+				cell{"granted_by", o.owner},
+			)
+		}
+	}
+	for _, g := range s.account.grants {
+		if filter(g) {
+			r.addRow(
+				cell{"created_on", g.createdOn},
+				cell{"privilege", g.privilege},
+				cell{"granted_on", grantedOn(g.on)},
+				cell{"name", g.name.String()},
+				cell{"granted_to", g.to},
+				cell{"grantee_name", g.grantee},
+				cell{"grant_option", g.grantOption},
+				cell{"granted_by", g.grantedBy},
+			)
+		}
+	}
+	return r, nil
+}
+
+// roleGrantsResult answers SHOW GRANTS OF ROLE and SHOW GRANTS TO USER that list the grants of roles.
+func (s *session) roleGrantsResult(filter func(g *grant) bool) *result {
+	r := newResult("created_on", "role", "granted_to", "grantee_name", "granted_by")
+	for _, g := range s.account.grants {
+		if g.on == kindRole && !g.future && filter(g) {
+			r.addRow(
+				cell{"created_on", g.createdOn},
+				cell{"role", g.name.name()},
+				cell{"granted_to", g.to},
+				cell{"grantee_name", g.grantee},
+				cell{"granted_by", g.grantedBy},
+			)
+		}
+	}
+	return r
+}
@@ -0,0 +1,138 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenQuotedIdentifier
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// isWord checks if the token is an unquoted word equal (case-insensitively) to the given keyword.
+func (t token) isWord(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) isSymbol(symbol string) bool {
+	return t.kind == tokenSymbol && t.text == symbol
+}
+
+// tokenize splits the statement produced by the SDK into tokens. Strings are unescaped following
+// https://docs.snowflake.com/en/sql-reference/data-types-text#single-quoted-string-constants.
+func tokenize(sql string) ([]token, error) {
+	var tokens []token
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			value, next, err := readString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value})
+			i = next
+		case r == '$' && i+1 < len(runes) && runes[i+1] == '$':
+			end := strings.Index(string(runes[i+2:]), "$$")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated $$ string at position %d", i)
+			}
+			value := string(runes[i+2:])[:end]
+			tokens = append(tokens, token{kind: tokenString, text: value})
+			i += 2 + len([]rune(value)) + 2
+		case r == '"':
+			value, next, err := readQuotedIdentifier(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenQuotedIdentifier, text: value})
+			i = next
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i])})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i])})
+		case r == '=' && i+1 < len(runes) && runes[i+1] == '>':
+			tokens = append(tokens, token{kind: tokenSymbol, text: "=>"})
+			i += 2
+		default:
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(r)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+var stringEscapes = map[rune]rune{
+	'b':  '\b',
+	'f':  '\f',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'0':  0,
+	'\\': '\\',
+	'\'': '\'',
+	'"':  '"',
+}
+
+func readString(runes []rune, start int) (string, int, error) {
+	var b strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\\' && i+1 < len(runes):
+			i++
+			if escaped, ok := stringEscapes[runes[i]]; ok {
+				b.WriteRune(escaped)
+			} else {
+				b.WriteRune(runes[i])
+			}
+		case r == '\'' && i+1 < len(runes) && runes[i+1] == '\'':
+			b.WriteRune('\'')
+			i++
+		case r == '\'':
+			return b.String(), i + 1, nil
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+func readQuotedIdentifier(runes []rune, start int) (string, int, error) {
+	var b strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '"' && i+1 < len(runes) && runes[i+1] == '"':
+			b.WriteRune('"')
+			i++
+		case r == '"':
+			return b.String(), i + 1, nil
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted identifier at position %d", start)
+}
@@ -0,0 +1,504 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// objectKind consumes the object type keyword, e.g. DATABASE in CREATE DATABASE. Unknown types are reported as unsupported.
+func (p *parser) objectKind() (*objectKind, error) {
+	t := p.peek()
+	if t.kind != tokenWord {
+		return nil, p.unexpected("object type")
+	}
+	kind := objectKindByName(strings.ToUpper(t.text))
+	if kind == nil {
+		return nil, unsupportedError(p.sql)
+	}
+	p.pos++
+	return kind, nil
+}
+
+// objectIdentifier parses the name of an object of the given kind; unqualified names are resolved using the current database and schema.
+func (s *session) objectIdentifier(p *parser, kind *objectKind) (identifier, error) {
+	id, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	return s.qualify(kind, id)
+}
+
+func (s *session) qualify(kind *objectKind, id identifier) (identifier, error) {
+	switch {
+	case len(id) == kind.parts:
+		return id, nil
+	case len(id) > kind.parts:
+		return nil, syntaxError(fmt.Sprintf("invalid identifier '%s'", id))
+	case kind.parts-len(id) == 1 && kind.parentKind == kindDatabase && s.database != nil:
+		return append(slices.Clone(s.database), id...), nil
+	case kind.parts-len(id) == 1 && kind.parentKind == kindSchema && s.schema != nil:
+		return append(slices.Clone(s.schema), id...), nil
+	case kind.parts-len(id) == 2 && s.schema != nil:
+		return append(slices.Clone(s.schema), id...), nil
+	default:
+		return nil, invalidStatementError(fmt.Sprintf("Cannot perform operation on %s '%s'. This session does not have a current database. Call 'USE DATABASE', or use a qualified name.", strings.ToLower(kind.name), id))
+	}
+}
+
+func (s *session) create(p *parser) (*result, error) {
+	orReplace := p.acceptKeyword("OR", "REPLACE")
+	transient := p.acceptKeyword("TRANSIENT")
+	kind, err := p.objectKind()
+	if err != nil {
+		return nil, err
+	}
+	ifNotExists := p.acceptKeyword("IF", "NOT", "EXISTS")
+	id, err := s.objectIdentifier(p, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &object{
+		kind:       kind.name,
+		id:         id,
+		createdOn:  time.Now(),
+		owner:      s.role,
+		properties: make(map[string]value),
+		transient:  transient,
+	}
+	var source *object
+	if p.acceptKeyword("CLONE") {
+		sourceId, err := s.objectIdentifier(p, kind)
+		if err != nil {
+			return nil, err
+		}
+		// Time Travel is not supported; the clone is made of the current state of the source
+		if _, ok := p.acceptOneOf("AT", "BEFORE"); ok {
+			if _, err := p.skipParenthesized(); err != nil {
+				return nil, err
+			}
+		}
+		if source = s.account.find(kind.name, sourceId); source == nil {
+			return nil, objectNotExistError(kind.name, sourceId)
+		}
+	}
+	if kind.name == kindTable && source == nil {
+		if !p.peek().isSymbol("(") {
+			return nil, unsupportedError(p.sql)
+		}
+		if err := p.tableElements(o); err != nil {
+			return nil, err
+		}
+	}
+	properties, flags, err := p.properties()
+	if err != nil {
+		return nil, err
+	}
+	var tags []*tagValue
+	for p.acceptKeyword("WITH", "TAG") || p.acceptKeyword("TAG") {
+		assignments, err := s.tagAssignments(p)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, assignments...)
+		moreProperties, moreFlags, err := p.properties()
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(properties, moreProperties)
+		flags = append(flags, moreFlags...)
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	o.setProperties(properties)
+	for _, t := range tags {
+		o.setTag(t.tag, t.value)
+	}
+	o.managedAccess = kind.name == kindSchema && slices.Contains(flags, "MANAGED")
+	if kind.name == kindWarehouse {
+		o.state = "STARTED"
+		if o.boolProperty("INITIALLY_SUSPENDED", false) {
+			o.state = "SUSPENDED"
+		}
+		delete(o.properties, "INITIALLY_SUSPENDED")
+	}
+
+	if kind.parentKind != "" {
+		if parent := s.account.find(kind.parentKind, id.parent()); parent == nil {
+			return nil, objectNotExistError(kind.parentKind, id.parent())
+		}
+	}
+	if existing := s.account.find(kind.name, id); existing != nil {
+		switch {
+		case orReplace:
+			s.account.remove(existing)
+		case ifNotExists:
+			return statusResult(fmt.Sprintf("%s already exists, statement succeeded.", id.name())), nil
+		default:
+			return nil, objectAlreadyExistsError(id)
+		}
+	}
+	if source != nil {
+		cloned := s.account.clone(source, id, s.role, o.createdOn)
+		cloned.transient = cloned.transient || o.transient
+		cloned.managedAccess = cloned.managedAccess || o.managedAccess
+		cloned.setProperties(o.properties)
+		for _, t := range o.tags {
+			cloned.setTag(t.tag, t.value)
+		}
+		return statusResult(fmt.Sprintf("%s %s successfully created.", kindDisplayName(kind.name), id.name())), nil
+	}
+	s.account.objects = append(s.account.objects, o)
+	if kind.name == kindDatabase {
+		s.account.objects = append(s.account.objects, &object{
+			kind:       kindSchema,
+			id:         append(slices.Clone(id), "PUBLIC"),
+			createdOn:  o.createdOn,
+			owner:      s.role,
+			properties: make(map[string]value),
+		})
+	}
+	return statusResult(fmt.Sprintf("%s %s successfully created.", kindDisplayName(kind.name), id.name())), nil
+}
+
+func (s *session) drop(p *parser) (*result, error) {
+	kind, err := p.objectKind()
+	if err != nil {
+		return nil, err
+	}
+	ifExists := p.acceptKeyword("IF", "EXISTS")
+	id, err := s.objectIdentifier(p, kind)
+	if err != nil {
+		return nil, err
+	}
+	p.acceptOneOf("CASCADE", "RESTRICT")
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+
+	o := s.account.find(kind.name, id)
+	if o == nil {
+		if ifExists {
+			return statusResult("Drop statement executed successfully."), nil
+		}
+		return nil, objectNotExistError(kind.name, id)
+	}
+	s.account.remove(o)
+	if s.database != nil && o.contains(s.database) {
+		s.database, s.schema = nil, nil
+	}
+	if s.schema != nil && o.contains(s.schema) {
+		s.schema = nil
+	}
+	return statusResult(fmt.Sprintf("%s successfully dropped.", id.name())), nil
+}
+
+func (s *session) alter(p *parser) (*result, error) {
+	if p.acceptKeyword(kindAccount) {
+		return s.alterAccount(p)
+	}
+	kind, err := p.objectKind()
+	if err != nil {
+		return nil, err
+	}
+	ifExists := p.acceptKeyword("IF", "EXISTS")
+	id, err := s.objectIdentifier(p, kind)
+	if err != nil {
+		return nil, err
+	}
+	o := s.account.find(kind.name, id)
+	if o == nil {
+		if ifExists {
+			return statusResult("Statement executed successfully."), nil
+		}
+		return nil, objectNotExistError(kind.name, id)
+	}
+
+	switch {
+	case p.acceptKeyword("RENAME", "TO"):
+		newId, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		if len(newId) < kind.parts {
+			newId = append(slices.Clone(id[:kind.parts-len(newId)]), newId...)
+		}
+		if err := s.renameObject(o, newId); err != nil {
+			return nil, err
+		}
+	case p.acceptKeyword("SWAP", "WITH"):
+		otherId, err := s.objectIdentifier(p, kind)
+		if err != nil {
+			return nil, err
+		}
+		other := s.account.find(kind.name, otherId)
+		if other == nil {
+			return nil, objectNotExistError(kind.name, otherId)
+		}
+		temporaryId := append(slices.Clone(id.parent()), "\x00swap")
+		s.account.rename(o, temporaryId)
+		s.account.rename(other, id)
+		s.account.rename(o, otherId)
+	case p.acceptKeyword("SET", "TAG"):
+		tags, err := s.tagAssignments(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tags {
+			o.setTag(t.tag, t.value)
+		}
+	case p.acceptKeyword("UNSET", "TAG"):
+		if err := s.unsetTags(o, p); err != nil {
+			return nil, err
+		}
+	case p.acceptKeyword("SET"):
+		properties, _, err := p.properties()
+		if err != nil {
+			return nil, err
+		}
+		o.setProperties(properties)
+	case p.acceptKeyword("UNSET"):
+		for _, property := range p.keywordList() {
+			delete(o.properties, property)
+		}
+	case kind.name == kindSchema && p.acceptKeyword("ENABLE", "MANAGED", "ACCESS"):
+		o.managedAccess = true
+	case kind.name == kindSchema && p.acceptKeyword("DISABLE", "MANAGED", "ACCESS"):
+		o.managedAccess = false
+	case kind.name == kindTag && p.acceptKeyword("ADD", "ALLOWED_VALUES"):
+		allowedValues := o.properties["ALLOWED_VALUES"]
+		allowedValues.kind = valueList
+		for _, v := range p.stringList() {
+			if !slices.ContainsFunc(allowedValues.items, func(item value) bool { return item.text == v }) {
+				allowedValues.items = append(allowedValues.items, value{kind: valueString, text: v})
+			}
+		}
+		o.properties["ALLOWED_VALUES"] = allowedValues
+	case kind.name == kindTag && p.acceptKeyword("DROP", "ALLOWED_VALUES"):
+		allowedValues := o.properties["ALLOWED_VALUES"]
+		for _, v := range p.stringList() {
+			allowedValues.items = slices.DeleteFunc(allowedValues.items, func(item value) bool { return item.text == v })
+		}
+		o.properties["ALLOWED_VALUES"] = allowedValues
+	case kind.name == kindWarehouse && p.acceptKeyword("SUSPEND"):
+		o.state = "SUSPENDED"
+	case kind.name == kindWarehouse && p.acceptKeyword("RESUME"):
+		p.acceptKeyword("IF", "SUSPENDED")
+		o.state = "STARTED"
+	case kind.name == kindWarehouse && p.acceptKeyword("ABORT", "ALL", "QUERIES"):
+	case kind.name == kindUser && (p.acceptKeyword("RESET", "PASSWORD") || p.acceptKeyword("ABORT", "ALL", "QUERIES")):
+	case kind.name == kindTable:
+		if err := s.alterTable(o, p); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, unsupportedError(p.sql)
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+func (s *session) renameObject(o *object, newId identifier) error {
+	kind := objectKindByName(o.kind)
+	if len(newId) != kind.parts {
+		return syntaxError(fmt.Sprintf("invalid identifier '%s'", newId))
+	}
+	if kind.parentKind != "" && s.account.find(kind.parentKind, newId.parent()) == nil {
+		return objectNotExistError(kind.parentKind, newId.parent())
+	}
+	if s.account.find(o.kind, newId) != nil {
+		return objectAlreadyExistsError(newId)
+	}
+	s.account.rename(o, newId)
+	return nil
+}
+
+// showFilter holds the LIKE, IN, STARTS WITH and LIMIT ... FROM clauses of SHOW.
+type showFilter struct {
+	like       *regexp.Regexp
+	inKind     string
+	in         identifier
+	startsWith string
+	limit      int
+	from       string
+}
+
+func (s *session) parseShowFilter(p *parser) (*showFilter, error) {
+	filter := &showFilter{}
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("HISTORY"):
+		case p.acceptKeyword("LIKE"):
+			pattern, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			filter.like = likePattern(pattern)
+		case p.acceptKeyword("IN", "ACCOUNT"):
+		case p.acceptKeyword("IN"):
+			kind, _ := p.acceptOneOf(kindDatabase, kindSchema)
+			var id identifier
+			if !p.done() && !p.isKeyword("STARTS") && !p.isKeyword("LIMIT") {
+				var err error
+				if id, err = p.identifier(); err != nil {
+					return nil, err
+				}
+			}
+			switch {
+			case kind == "" && len(id) == 1:
+				kind = kindDatabase
+			case kind == "" && len(id) == 2:
+				kind = kindSchema
+			case kind == kindDatabase && id == nil:
+				id = s.database
+			case kind == kindSchema && id == nil:
+				id = s.schema
+			case kind == kindSchema && len(id) == 1 && s.database != nil:
+				id = append(slices.Clone(s.database), id...)
+			}
+			if id == nil {
+				return nil, invalidStatementError("This session does not have a current database. Call 'USE DATABASE', or use a qualified name.")
+			}
+			if s.account.find(kind, id) == nil {
+				return nil, objectNotExistError(kind, id)
+			}
+			filter.inKind, filter.in = kind, id
+		case p.acceptKeyword("STARTS", "WITH"):
+			prefix, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			filter.startsWith = prefix
+		case p.acceptKeyword("LIMIT"):
+			t := p.next()
+			limit, err := strconv.Atoi(t.text)
+			if err != nil {
+				return nil, syntaxError(fmt.Sprintf("invalid limit '%s'", t.text))
+			}
+			filter.limit = limit
+			if p.acceptKeyword("FROM") {
+				from, err := p.stringLiteral()
+				if err != nil {
+					return nil, err
+				}
+				filter.from = from
+			}
+		default:
+			return nil, unsupportedError(p.sql)
+		}
+	}
+	return filter, nil
+}
+
+// likePattern converts the SQL LIKE pattern into a regular expression; SHOW ... LIKE is case-insensitive.
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func (f *showFilter) apply(objects []*object) []*object {
+	var result []*object
+	for _, o := range objects {
+		if f.like != nil && !f.like.MatchString(o.id.name()) {
+			continue
+		}
+		if f.in != nil && !o.id.hasPrefix(f.in) {
+			continue
+		}
+		if f.startsWith != "" && !strings.HasPrefix(o.id.name(), f.startsWith) {
+			continue
+		}
+		// FROM is a cursor: only the names following it are returned
+		if f.from != "" && o.id.name() <= f.from {
+			continue
+		}
+		result = append(result, o)
+		if f.limit > 0 && len(result) == f.limit {
+			break
+		}
+	}
+	return result
+}
+
+func (s *session) show(p *parser) (*result, error) {
+	terse := p.acceptKeyword("TERSE")
+	switch {
+	case p.acceptKeyword("GRANTS"):
+		return s.showGrants(p, false)
+	case p.acceptKeyword("FUTURE", "GRANTS"):
+		return s.showGrants(p, true)
+	case p.acceptKeyword("PARAMETERS"):
+		return s.showParameters(p)
+	}
+
+	kind := objectKindByPlural(strings.ToUpper(p.next().text))
+	if kind == nil {
+		return nil, unsupportedError(p.sql)
+	}
+	filter, err := s.parseShowFilter(p)
+	if err != nil {
+		return nil, err
+	}
+	columns := showColumns[kind.name]
+	if terse {
+		columns = terseColumns
+	}
+	r := newResult(columns...)
+	for _, o := range filter.apply(s.account.list(kind.name)) {
+		cells := s.showRow(o)
+		if terse {
+			cells = slices.DeleteFunc(cells, func(c cell) bool {
+				return !slices.Contains(columns, c.column)
+			})
+		}
+		r.addRow(cells...)
+	}
+	return r, nil
+}
+
+func (s *session) describe(p *parser) (*result, error) {
+	kind, err := p.objectKind()
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.objectIdentifier(p, kind)
+	if err != nil {
+		return nil, err
+	}
+	if kind.name == kindTable && p.acceptKeyword("TYPE") {
+		if err := p.expectSymbol("="); err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword("COLUMNS") {
+			return nil, unsupportedError(p.sql)
+		}
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	o := s.account.find(kind.name, id)
+	if o == nil {
+		return nil, objectNotExistError(kind.name, id)
+	}
+	return s.describeObject(o), nil
+}
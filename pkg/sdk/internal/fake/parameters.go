@@ -0,0 +1,199 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+)
+
+const kindSession = "SESSION"
+
+// parameterDefinition describes a parameter answered by SHOW PARAMETERS and the levels it can be set on.
+type parameterDefinition struct {
+	key          string
+	defaultValue string
+	levels       []string
+	description  string
+}
+
+var parameterDefinitions = []parameterDefinition{
+	{
+		key:          "DATA_RETENTION_TIME_IN_DAYS",
+		defaultValue: "1",
+		levels:       []string{kindAccount, kindDatabase, kindSchema, kindTable},
+		description:  "number of days to retain the old version of deleted/updated data",
+	},
+	{
+		key:          "DEFAULT_DDL_COLLATION",
+		defaultValue: "",
+		levels:       []string{kindAccount, kindDatabase, kindSchema, kindTable},
+		description:  "Collation that is used for all the new columns created by the DDL statements (if not specified)",
+	},
+	{
+		key:          "LOG_LEVEL",
+		defaultValue: "OFF",
+		levels:       []string{kindAccount, kindSession, kindDatabase, kindSchema},
+		description:  "LOG_LEVEL to use when filtering events",
+	},
+	{
+		key:          "MAX_CONCURRENCY_LEVEL",
+		defaultValue: "8",
+		levels:       []string{kindAccount, kindWarehouse},
+		description:  "Concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse cluster",
+	},
+	{
+		key:          "MAX_DATA_EXTENSION_TIME_IN_DAYS",
+		defaultValue: "14",
+		levels:       []string{kindAccount, kindDatabase, kindSchema, kindTable},
+		description:  "Maximum number of days to extend data retention beyond the retention period to prevent a stream becoming stale.",
+	},
+	{
+		key:          "QUERY_TAG",
+		defaultValue: "",
+		levels:       []string{kindAccount, kindSession, kindUser},
+		description:  "String (up to 2000 characters) used to tag statements executed by the session",
+	},
+	{
+		key:          "STATEMENT_QUEUED_TIMEOUT_IN_SECONDS",
+		defaultValue: "0",
+		levels:       []string{kindAccount, kindSession, kindUser, kindWarehouse},
+		description:  "Timeout in seconds for queued statements: statements will automatically be canceled if they are queued on a warehouse for longer than this amount of time; disabled if set to zero.",
+	},
+	{
+		key:          "STATEMENT_TIMEOUT_IN_SECONDS",
+		defaultValue: "172800",
+		levels:       []string{kindAccount, kindSession, kindUser, kindWarehouse},
+		description:  "Timeout in seconds for statements: statements are automatically canceled if they run for longer; if set to zero, max value (604800) is enforced.",
+	},
+	{
+		key:          "TIMEZONE",
+		defaultValue: "America/Los_Angeles",
+		levels:       []string{kindAccount, kindSession, kindUser},
+		description:  "time zone",
+	},
+	{
+		key:          "TRACE_LEVEL",
+		defaultValue: "OFF",
+		levels:       []string{kindAccount, kindSession, kindDatabase, kindSchema},
+		description:  "Trace level value to use when generating/filtering trace events",
+	},
+}
+
+// alterAccount answers ALTER ACCOUNT SET and UNSET for account parameters.
+func (s *session) alterAccount(p *parser) (*result, error) {
+	switch {
+	case p.acceptKeyword("SET"):
+		properties, _, err := p.properties()
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range properties {
+			s.account.parameters[k] = v
+		}
+	case p.acceptKeyword("UNSET"):
+		for _, parameter := range p.keywordList() {
+			delete(s.account.parameters, parameter)
+		}
+	default:
+		return nil, unsupportedError(p.sql)
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// parameterSources returns the objects the parameters of the object are inherited from, starting with the object itself.
+func (s *session) parameterSources(level string, o *object) []*object {
+	var sources []*object
+	switch level {
+	case kindDatabase, kindSchema, kindTable:
+		for id := o.id; len(id) > 0; id = id.parent() {
+			kind := []string{kindDatabase, kindSchema, kindTable}[len(id)-1]
+			if candidate := s.account.find(kind, id); candidate != nil {
+				sources = append(sources, candidate)
+			}
+		}
+	case kindSession:
+		if user := s.account.find(kindUser, identifier{s.user}); user != nil {
+			sources = append(sources, user)
+		}
+	default:
+		sources = append(sources, o)
+	}
+	return sources
+}
+
+// showParameters answers SHOW PARAMETERS [LIKE '<pattern>'] [IN <level> [<name>]].
+func (s *session) showParameters(p *parser) (*result, error) {
+	filter := &showFilter{}
+	if p.acceptKeyword("LIKE") {
+		pattern, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		filter.like = likePattern(pattern)
+	}
+
+	level := kindSession
+	var o *object
+	if p.acceptKeyword("IN") || p.acceptKeyword("FOR") {
+		switch {
+		case p.acceptKeyword(kindAccount):
+			level = kindAccount
+		case p.acceptKeyword(kindSession):
+		default:
+			kind, err := p.objectKind()
+			if err != nil {
+				return nil, err
+			}
+			id, err := s.objectIdentifier(p, kind)
+			if err != nil {
+				return nil, err
+			}
+			if o = s.account.find(kind.name, id); o == nil {
+				return nil, objectNotExistError(kind.name, id)
+			}
+			level = kind.name
+		}
+	}
+	if !p.done() {
+		return nil, unsupportedError(p.sql)
+	}
+
+	var sources []*object
+	if level != kindAccount {
+		sources = s.parameterSources(level, o)
+	}
+	r := newResult("key", "value", "default", "level", "description", "type")
+	for _, definition := range parameterDefinitions {
+		if !slices.Contains(definition.levels, level) || filter.like != nil && !filter.like.MatchString(definition.key) {
+			continue
+		}
+		parameterValue, parameterLevel := definition.defaultValue, ""
+		for _, source := range sources {
+			if v, ok := source.properties[definition.key]; ok {
+				parameterValue, parameterLevel = v.String(), source.kind
+				break
+			}
+		}
+		if v, ok := s.account.parameters[definition.key]; ok && parameterLevel == "" {
+			parameterValue, parameterLevel = v.String(), kindAccount
+		}
+		r.addRow(
+			cell{"key", definition.key},
+			cell{"value", parameterValue},
+			cell{"default", definition.defaultValue},
+			cell{"level", parameterLevel},
+			cell{"description", definition.description},
+			cell{"type", parameterType(definition.defaultValue)},
+		)
+	}
+	return r, nil
+}
+
+func parameterType(defaultValue string) string {
+	if defaultValue != "" && strings.Trim(defaultValue, "0123456789") == "" {
+		return "NUMBER"
+	}
+	return "STRING"
+}
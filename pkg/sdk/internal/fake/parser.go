@@ -0,0 +1,454 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// identifier is a (possibly qualified) object name; unquoted parts are upper-cased the same way Snowflake resolves them.
+type identifier []string
+
+func (i identifier) name() string {
+	if len(i) == 0 {
+		return ""
+	}
+	return i[len(i)-1]
+}
+
+func (i identifier) parent() identifier {
+	if len(i) == 0 {
+		return nil
+	}
+	return i[:len(i)-1]
+}
+
+func (i identifier) equals(other identifier) bool {
+	if len(i) != len(other) {
+		return false
+	}
+	for idx := range i {
+		if i[idx] != other[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+func (i identifier) hasPrefix(prefix identifier) bool {
+	return len(i) > len(prefix) && i[:len(prefix)].equals(prefix)
+}
+
+// String returns the name the way SHOW GRANTS presents it: parts are quoted only when needed.
+func (i identifier) String() string {
+	parts := make([]string, len(i))
+	for idx, part := range i {
+		if isSimpleIdentifier(part) {
+			parts[idx] = part
+		} else {
+			parts[idx] = fmt.Sprintf(`"%s"`, strings.ReplaceAll(part, `"`, `""`))
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func isSimpleIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for idx, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r == '_':
+		case idx > 0 && (r >= '0' && r <= '9' || r == '$'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type valueKind int
+
+const (
+	valueString valueKind = iota
+	valueNumber
+	valueWord
+	valueIdentifier
+	valueList
+)
+
+// value is the right-hand side of a property assignment, e.g. COMMENT = 'x' or DEFAULT_SECONDARY_ROLES = ('ALL').
+type value struct {
+	kind  valueKind
+	text  string
+	items []value
+}
+
+func (v value) String() string {
+	if v.kind != valueList {
+		return v.text
+	}
+	items := make([]string, len(v.items))
+	for i, item := range v.items {
+		items[i] = fmt.Sprintf(`"%s"`, item.text)
+	}
+	return "[" + strings.Join(items, ",") + "]"
+}
+
+func (v value) bool() bool {
+	return strings.EqualFold(v.text, "true")
+}
+
+type parser struct {
+	sql    string
+	tokens []token
+	pos    int
+}
+
+func newParser(sql string) (*parser, error) {
+	tokens, err := tokenize(sql)
+	if err != nil {
+		return nil, syntaxError(err.Error())
+	}
+	// trailing semicolons are allowed by Snowflake
+	for len(tokens) > 0 && tokens[len(tokens)-1].isSymbol(";") {
+		tokens = tokens[:len(tokens)-1]
+	}
+	return &parser{sql: sql, tokens: tokens}, nil
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return token{kind: tokenSymbol}
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	if !p.done() {
+		p.pos++
+	}
+	return t
+}
+
+// isKeyword checks if the next tokens are the given keywords; a keyword may consist of many words, e.g. "IF NOT EXISTS".
+func (p *parser) isKeyword(keywords ...string) bool {
+	offset := 0
+	for _, keyword := range keywords {
+		for _, word := range strings.Fields(keyword) {
+			if !p.peekAt(offset).isWord(word) {
+				return false
+			}
+			offset++
+		}
+	}
+	return true
+}
+
+func (p *parser) acceptKeyword(keywords ...string) bool {
+	if !p.isKeyword(keywords...) {
+		return false
+	}
+	for _, keyword := range keywords {
+		p.pos += len(strings.Fields(keyword))
+	}
+	return true
+}
+
+func (p *parser) expectKeyword(keywords ...string) error {
+	if !p.acceptKeyword(keywords...) {
+		return p.unexpected(strings.Join(keywords, " "))
+	}
+	return nil
+}
+
+func (p *parser) acceptSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectSymbol(symbol string) error {
+	if !p.acceptSymbol(symbol) {
+		return p.unexpected(symbol)
+	}
+	return nil
+}
+
+// acceptOneOf consumes the first matching keyword and returns it.
+func (p *parser) acceptOneOf(keywords ...string) (string, bool) {
+	for _, keyword := range keywords {
+		if p.acceptKeyword(keyword) {
+			return keyword, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) unexpected(expected string) error {
+	if p.done() {
+		return syntaxError(fmt.Sprintf("unexpected end of statement, expected %s", expected))
+	}
+	return syntaxError(fmt.Sprintf("unexpected '%s', expected %s", p.peek().text, expected))
+}
+
+func (p *parser) identifierPart() (string, error) {
+	switch t := p.peek(); t.kind {
+	case tokenQuotedIdentifier:
+		p.pos++
+		return t.text, nil
+	case tokenWord:
+		p.pos++
+		return strings.ToUpper(t.text), nil
+	default:
+		return "", p.unexpected("identifier")
+	}
+}
+
+func (p *parser) identifier() (identifier, error) {
+	part, err := p.identifierPart()
+	if err != nil {
+		return nil, err
+	}
+	id := identifier{part}
+	for p.acceptSymbol(".") {
+		part, err := p.identifierPart()
+		if err != nil {
+			return nil, err
+		}
+		id = append(id, part)
+	}
+	return id, nil
+}
+
+// identifierOfLength parses an identifier with exactly the given number of parts, e.g. 3 for tables.
+func (p *parser) identifierOfLength(parts int) (identifier, error) {
+	id, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) != parts {
+		return nil, syntaxError(fmt.Sprintf("invalid identifier '%s'", id))
+	}
+	return id, nil
+}
+
+// identifierList parses a comma-separated list of identifiers, optionally wrapped in parentheses.
+func (p *parser) identifierList() ([]identifier, error) {
+	parenthesized := p.acceptSymbol("(")
+	var ids []identifier
+	for {
+		id, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	if parenthesized {
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+func (p *parser) stringLiteral() (string, error) {
+	if t := p.peek(); t.kind == tokenString {
+		p.pos++
+		return t.text, nil
+	}
+	return "", p.unexpected("string literal")
+}
+
+func (p *parser) value() (value, error) {
+	switch t := p.peek(); {
+	case t.kind == tokenString:
+		p.pos++
+		return value{kind: valueString, text: t.text}, nil
+	case t.kind == tokenNumber:
+		p.pos++
+		return value{kind: valueNumber, text: t.text}, nil
+	case t.isSymbol("("):
+		p.pos++
+		list := value{kind: valueList}
+		for !p.acceptSymbol(")") {
+			if p.done() {
+				return value{}, p.unexpected(")")
+			}
+			if p.acceptSymbol(",") {
+				continue
+			}
+			item, err := p.value()
+			if err != nil {
+				return value{}, err
+			}
+			list.items = append(list.items, item)
+		}
+		return list, nil
+	case t.kind == tokenQuotedIdentifier, t.kind == tokenWord && p.peekAt(1).isSymbol("."):
+		id, err := p.identifier()
+		if err != nil {
+			return value{}, err
+		}
+		return value{kind: valueIdentifier, text: strings.Join(id, ".")}, nil
+	case t.kind == tokenWord:
+		p.pos++
+		return value{kind: valueWord, text: strings.ToUpper(t.text)}, nil
+	default:
+		return value{}, p.unexpected("value")
+	}
+}
+
+// skipParenthesized skips the balanced parentheses block starting at the current token and returns its text.
+func (p *parser) skipParenthesized() (string, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return "", err
+	}
+	var parts []string
+	depth := 1
+	for depth > 0 {
+		if p.done() {
+			return "", p.unexpected(")")
+		}
+		t := p.next()
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+		}
+		if depth > 0 {
+			parts = append(parts, tokenText(t))
+		}
+	}
+	return joinTokenTexts(parts), nil
+}
+
+func tokenText(t token) string {
+	switch t.kind {
+	case tokenString:
+		return "'" + strings.ReplaceAll(t.text, "'", `\'`) + "'"
+	case tokenQuotedIdentifier:
+		return `"` + t.text + `"`
+	default:
+		return t.text
+	}
+}
+
+// joinTokenTexts joins the tokens back into an expression, without spaces around punctuation.
+func joinTokenTexts(parts []string) string {
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 && part != "," && part != ")" && part != "." && parts[i-1] != "(" && parts[i-1] != "." {
+			b.WriteString(" ")
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// properties parses assignments like KEY = value, separated by spaces or commas. Words without a value (e.g. WITH MANAGED ACCESS)
+// are returned as flags. Parsing stops at WITH TAG (parsed by session.tagAssignments) and at the first token that is neither.
+func (p *parser) properties() (map[string]value, []string, error) {
+	properties := make(map[string]value)
+	var flags []string
+	for !p.done() {
+		if p.acceptSymbol(",") {
+			continue
+		}
+		t := p.peek()
+		if t.kind != tokenWord {
+			break
+		}
+		if p.isKeyword("WITH", "TAG") || p.isKeyword("TAG") {
+			break
+		}
+		// ALLOWED_VALUES of tags is a list of strings without parentheses, e.g. ALLOWED_VALUES 'a', 'b'
+		if p.isKeyword("ALLOWED_VALUES") && p.peekAt(1).kind == tokenString {
+			p.pos++
+			list := value{kind: valueList}
+			for _, item := range p.stringList() {
+				list.items = append(list.items, value{kind: valueString, text: item})
+			}
+			properties["ALLOWED_VALUES"] = list
+			continue
+		}
+		if p.acceptKeyword("CLUSTER", "BY") {
+			expression, err := p.skipParenthesized()
+			if err != nil {
+				return nil, nil, err
+			}
+			properties["CLUSTER_BY"] = value{kind: valueString, text: fmt.Sprintf("LINEAR(%s)", expression)}
+			continue
+		}
+		if p.peekAt(1).isSymbol("=") {
+			p.pos += 2
+			v, err := p.value()
+			if err != nil {
+				return nil, nil, err
+			}
+			properties[strings.ToUpper(t.text)] = v
+			continue
+		}
+		p.pos++
+		flags = append(flags, strings.ToUpper(t.text))
+	}
+	return properties, flags, nil
+}
+
+// stringList parses a comma-separated list of string literals, e.g. 'a', 'b'.
+func (p *parser) stringList() []string {
+	var items []string
+	for p.peek().kind == tokenString {
+		items = append(items, p.next().text)
+		if !p.peek().isSymbol(",") || p.peekAt(1).kind != tokenString {
+			break
+		}
+		p.pos++
+	}
+	return items
+}
+
+// keywordList parses the names of properties in UNSET, e.g. UNSET COMMENT, DATA_RETENTION_TIME_IN_DAYS.
+func (p *parser) keywordList() []string {
+	var keywords []string
+	for !p.done() {
+		if p.acceptSymbol(",") {
+			continue
+		}
+		t := p.peek()
+		if t.kind != tokenWord {
+			break
+		}
+		p.pos++
+		keywords = append(keywords, strings.ToUpper(t.text))
+	}
+	return keywords
+}
+
+// words consumes the unquoted words up to the next token of other kind and returns them joined with spaces.
+func (p *parser) words(stopAt ...string) string {
+	var words []string
+	for p.peek().kind == tokenWord {
+		for _, stop := range stopAt {
+			if p.isKeyword(stop) {
+				return strings.Join(words, " ")
+			}
+		}
+		words = append(words, strings.ToUpper(p.next().text))
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,349 @@
+package fake
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"strings"
+)
+
+// showColumns lists the columns returned by SHOW for each object kind, in the order Snowflake returns them.
+var showColumns = map[string][]string{
+	kindDatabase: {
+		"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time",
+		"resource_group", "dropped_on", "kind",
+	},
+	kindSchema: {
+		"created_on", "name", "is_default", "is_current", "database_name", "owner", "comment", "options", "retention_time",
+		"owner_role_type",
+	},
+	kindTable: {
+		"created_on", "name", "database_name", "schema_name", "kind", "comment", "cluster_by", "rows", "bytes", "owner",
+		"retention_time", "dropped_on", "automatic_clustering", "change_tracking", "search_optimization",
+		"search_optimization_progress", "search_optimization_bytes", "is_external", "enable_schema_evolution",
+		"owner_role_type", "is_event", "budget",
+	},
+	kindWarehouse: {
+		"name", "state", "type", "size", "min_cluster_count", "max_cluster_count", "started_clusters", "running", "queued",
+		"is_default", "is_current", "auto_suspend", "auto_resume", "available", "provisioning", "quiescing", "other",
+		"created_on", "resumed_on", "updated_on", "owner", "comment", "enable_query_acceleration",
+		"query_acceleration_max_scale_factor", "resource_monitor", "actives", "pendings", "failed", "suspended", "uuid",
+		"scaling_policy", "owner_role_type",
+	},
+	kindRole: {
+		"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles",
+		"granted_roles", "owner", "comment",
+	},
+	kindUser: {
+		"name", "created_on", "login_name", "display_name", "first_name", "last_name", "email", "mins_to_unlock",
+		"days_to_expiry", "comment", "disabled", "must_change_password", "snowflake_lock", "default_warehouse",
+		"default_namespace", "default_role", "default_secondary_roles", "ext_authn_duo", "ext_authn_uid",
+		"mins_to_bypass_mfa", "owner", "last_success_login", "expires_at_time", "locked_until_time", "has_password",
+		"has_rsa_public_key",
+	},
+	kindTag: {
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values", "owner_role_type",
+	},
+}
+
+// terseColumns are the columns returned by SHOW TERSE; the other columns are omitted.
+var terseColumns = []string{"created_on", "name", "kind", "database_name", "schema_name"}
+
+// warehouseSizes maps the sizes used in statements to the ones presented by SHOW WAREHOUSES.
+var warehouseSizes = map[string]string{
+	"XSMALL":   "X-Small",
+	"SMALL":    "Small",
+	"MEDIUM":   "Medium",
+	"LARGE":    "Large",
+	"XLARGE":   "X-Large",
+	"XXLARGE":  "2X-Large",
+	"XXXLARGE": "3X-Large",
+	"X4LARGE":  "4X-Large",
+	"X5LARGE":  "5X-Large",
+	"X6LARGE":  "6X-Large",
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Y"
+	}
+	return "N"
+}
+
+func onOff(b bool) string {
+	if b {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// nullable returns the property value or NULL when it is not set.
+func (o *object) nullable(name string) driver.Value {
+	if v, ok := o.properties[name]; ok {
+		return v.String()
+	}
+	return nil
+}
+
+func (o *object) intProperty(name string, defaultValue int64) int64 {
+	if v, ok := o.properties[name]; ok {
+		if i, err := strconv.ParseInt(v.text, 10, 64); err == nil {
+			return i
+		}
+	}
+	return defaultValue
+}
+
+// retentionTime returns DATA_RETENTION_TIME_IN_DAYS of the object, inherited from the schema and the database when not set.
+func (s *session) retentionTime(o *object) int64 {
+	for id := o.id; len(id) > 0; id = id.parent() {
+		kind := []string{kindDatabase, kindSchema, kindTable}[len(id)-1]
+		if candidate := s.account.find(kind, id); candidate != nil {
+			if _, ok := candidate.properties["DATA_RETENTION_TIME_IN_DAYS"]; ok {
+				return candidate.intProperty("DATA_RETENTION_TIME_IN_DAYS", 1)
+			}
+		}
+	}
+	return 1
+}
+
+func (s *session) showRow(o *object) []cell {
+	switch o.kind {
+	case kindDatabase:
+		options := ""
+		if o.transient {
+			options = "TRANSIENT"
+		}
+		return []cell{
+			{"created_on", o.createdOn},
+			{"name", o.id.name()},
+			{"is_default", "N"},
+			{"is_current", yesNo(o.id.equals(s.database))},
+			{"origin", ""},
+			{"owner", o.owner},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+			{"options", options},
+			{"retention_time", strconv.FormatInt(s.retentionTime(o), 10)},
+			{"resource_group", ""},
+			{"kind", "STANDARD"},
+		}
+	case kindSchema:
+		var options []string
+		if o.transient {
+			options = append(options, "TRANSIENT")
+		}
+		if o.managedAccess {
+			options = append(options, "MANAGED ACCESS")
+		}
+		return []cell{
+			{"created_on", o.createdOn},
+			{"name", o.id.name()},
+			{"is_default", "N"},
+			{"is_current", yesNo(o.id.equals(s.schema))},
+			{"database_name", o.id[0]},
+			{"owner", o.owner},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+			{"options", strings.Join(options, ", ")},
+			{"retention_time", strconv.FormatInt(s.retentionTime(o), 10)},
+			{"owner_role_type", "ROLE"},
+		}
+	case kindTable:
+		kind := "TABLE"
+		if o.transient {
+			kind = "TRANSIENT"
+		}
+		clusterBy := o.propertyOrDefault("CLUSTER_BY", "")
+		return []cell{
+			{"created_on", o.createdOn},
+			{"name", o.id.name()},
+			{"database_name", o.id[0]},
+			{"schema_name", o.id[1]},
+			{"kind", kind},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+			{"cluster_by", clusterBy},
+			{"rows", int64(0)},
+			{"bytes", int64(0)},
+			{"owner", o.owner},
+			{"retention_time", s.retentionTime(o)},
+			{"automatic_clustering", onOff(clusterBy != "")},
+			{"change_tracking", onOff(o.boolProperty("CHANGE_TRACKING", false))},
+			{"search_optimization", "OFF"},
+			{"is_external", "N"},
+			{"enable_schema_evolution", yesNo(o.boolProperty("ENABLE_SCHEMA_EVOLUTION", false))},
+			{"owner_role_type", "ROLE"},
+			{"is_event", "N"},
+		}
+	case kindWarehouse:
+		size := strings.ToUpper(o.propertyOrDefault("WAREHOUSE_SIZE", "XSMALL"))
+		if displaySize, ok := warehouseSizes[size]; ok {
+			size = displaySize
+		}
+		return []cell{
+			{"name", o.id.name()},
+			{"state", o.state},
+			{"type", o.propertyOrDefault("WAREHOUSE_TYPE", "STANDARD")},
+			{"size", size},
+			{"min_cluster_count", o.intProperty("MIN_CLUSTER_COUNT", 1)},
+			{"max_cluster_count", o.intProperty("MAX_CLUSTER_COUNT", 1)},
+			{"started_clusters", int64(0)},
+			{"running", int64(0)},
+			{"queued", int64(0)},
+			{"is_default", "N"},
+			{"is_current", yesNo(o.id.equals(s.warehouse))},
+			{"auto_suspend", o.intProperty("AUTO_SUSPEND", 600)},
+			{"auto_resume", o.boolProperty("AUTO_RESUME", true)},
+			{"available", ""},
+			{"provisioning", ""},
+			{"quiescing", ""},
+			{"other", ""},
+			{"created_on", o.createdOn},
+			{"resumed_on", o.createdOn},
+			{"updated_on", o.createdOn},
+			{"owner", o.owner},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+			{"enable_query_acceleration", o.boolProperty("ENABLE_QUERY_ACCELERATION", false)},
+			{"query_acceleration_max_scale_factor", o.intProperty("QUERY_ACCELERATION_MAX_SCALE_FACTOR", 8)},
+			{"resource_monitor", o.propertyOrDefault("RESOURCE_MONITOR", "null")},
+			{"actives", "0"},
+			{"pendings", "0"},
+			{"failed", "0"},
+			{"suspended", "0"},
+			{"uuid", ""},
+			{"scaling_policy", o.propertyOrDefault("SCALING_POLICY", "STANDARD")},
+			{"owner_role_type", "ROLE"},
+		}
+	case kindRole:
+		return []cell{
+			{"created_on", o.createdOn},
+			{"name", o.id.name()},
+			{"is_default", "N"},
+			{"is_current", yesNo(o.id.name() == s.role)},
+			{"is_inherited", yesNo(s.account.isRoleGranted(o.id.name(), s.role))},
+			{"assigned_to_users", int64(len(s.account.roleGrantsOf(o.id.name(), kindUser)))},
+			{"granted_to_roles", int64(len(s.account.roleGrantsOf(o.id.name(), kindRole)))},
+			{"granted_roles", int64(len(s.account.rolesGrantedTo(o.id.name())))},
+			{"owner", o.owner},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+		}
+	case kindUser:
+		_, hasPassword := o.properties["PASSWORD"]
+		_, hasRsaPublicKey := o.properties["RSA_PUBLIC_KEY"]
+		return []cell{
+			{"name", o.id.name()},
+			{"created_on", o.createdOn},
+			{"login_name", strings.ToUpper(o.propertyOrDefault("LOGIN_NAME", o.id.name()))},
+			{"display_name", o.propertyOrDefault("DISPLAY_NAME", o.id.name())},
+			{"first_name", o.nullable("FIRST_NAME")},
+			{"last_name", o.nullable("LAST_NAME")},
+			{"email", o.nullable("EMAIL")},
+			{"mins_to_unlock", o.nullable("MINS_TO_UNLOCK")},
+			{"days_to_expiry", o.nullable("DAYS_TO_EXPIRY")},
+			{"comment", o.nullable("COMMENT")},
+			{"disabled", o.boolProperty("DISABLED", false)},
+			{"must_change_password", o.boolProperty("MUST_CHANGE_PASSWORD", false)},
+			{"snowflake_lock", false},
+			{"default_warehouse", o.nullable("DEFAULT_WAREHOUSE")},
+			{"default_namespace", o.propertyOrDefault("DEFAULT_NAMESPACE", "")},
+			{"default_role", o.propertyOrDefault("DEFAULT_ROLE", "")},
+			{"default_secondary_roles", o.propertyOrDefault("DEFAULT_SECONDARY_ROLES", "")},
+			{"ext_authn_duo", false},
+			{"ext_authn_uid", ""},
+			{"mins_to_bypass_mfa", o.propertyOrDefault("MINS_TO_BYPASS_MFA", "")},
+			{"owner", o.owner},
+			{"has_password", hasPassword},
+			{"has_rsa_public_key", hasRsaPublicKey},
+		}
+	case kindTag:
+		return []cell{
+			{"created_on", o.createdOn},
+			{"name", o.id.name()},
+			{"database_name", o.id[0]},
+			{"schema_name", o.id[1]},
+			{"owner", o.owner},
+			{"comment", o.propertyOrDefault("COMMENT", "")},
+			{"allowed_values", o.nullable("ALLOWED_VALUES")},
+			{"owner_role_type", "ROLE"},
+		}
+	default:
+		return nil
+	}
+}
+
+// userDescribeProperties lists the properties returned by DESCRIBE USER with their defaults.
+var userDescribeProperties = []struct {
+	name         string
+	defaultValue string
+}{
+	{"NAME", "null"},
+	{"COMMENT", "null"},
+	{"DISPLAY_NAME", "null"},
+	{"LOGIN_NAME", "null"},
+	{"FIRST_NAME", "null"},
+	{"MIDDLE_NAME", "null"},
+	{"LAST_NAME", "null"},
+	{"EMAIL", "null"},
+	{"PASSWORD", "null"},
+	{"MUST_CHANGE_PASSWORD", "false"},
+	{"DISABLED", "false"},
+	{"SNOWFLAKE_LOCK", "false"},
+	{"SNOWFLAKE_SUPPORT", "false"},
+	{"DAYS_TO_EXPIRY", "null"},
+	{"MINS_TO_UNLOCK", "null"},
+	{"DEFAULT_WAREHOUSE", "null"},
+	{"DEFAULT_NAMESPACE", "null"},
+	{"DEFAULT_ROLE", "null"},
+	{"DEFAULT_SECONDARY_ROLES", "null"},
+	{"EXT_AUTHN_DUO", "false"},
+	{"EXT_AUTHN_UID", "null"},
+	{"MINS_TO_BYPASS_MFA", "null"},
+	{"MINS_TO_BYPASS_NETWORK_POLICY", "null"},
+	{"RSA_PUBLIC_KEY", "null"},
+	{"RSA_PUBLIC_KEY_FP", "null"},
+	{"RSA_PUBLIC_KEY_2", "null"},
+	{"RSA_PUBLIC_KEY_2_FP", "null"},
+	{"PASSWORD_LAST_SET_TIME", "null"},
+	{"CUSTOM_LANDING_PAGE_URL", "null"},
+	{"CUSTOM_LANDING_PAGE_URL_FLUSH_NEXT_UI_LOAD", "false"},
+}
+
+func (s *session) describeObject(o *object) *result {
+	switch o.kind {
+	case kindUser:
+		r := newResult("property", "value", "default", "description")
+		for _, property := range userDescribeProperties {
+			v := o.propertyOrDefault(property.name, property.defaultValue)
+			switch property.name {
+			case "NAME":
+				v = o.id.name()
+			case "LOGIN_NAME":
+				v = strings.ToUpper(o.propertyOrDefault("LOGIN_NAME", o.id.name()))
+			case "DISPLAY_NAME":
+				v = o.propertyOrDefault("DISPLAY_NAME", o.id.name())
+			case "PASSWORD":
+				if _, ok := o.properties["PASSWORD"]; ok {
+					v = "********"
+				}
+			}
+			r.addRow(cell{"property", property.name}, cell{"value", v}, cell{"default", property.defaultValue}, cell{"description", ""})
+		}
+		return r
+	case kindTable:
+		return describeTableColumns(o)
+	default:
+		r := newResult("created_on", "name", "kind")
+		var children []*object
+		switch o.kind {
+		case kindDatabase:
+			children = s.account.list(kindSchema)
+		case kindSchema:
+			children = s.account.list(kindTable)
+		default:
+			r.addRow(cell{"created_on", o.createdOn}, cell{"name", o.id.name()}, cell{"kind", o.kind})
+		}
+		for _, child := range children {
+			if child.id.hasPrefix(o.id) && len(child.id) == len(o.id)+1 {
+				r.addRow(cell{"created_on", child.createdOn}, cell{"name", child.id.name()}, cell{"kind", child.kind})
+			}
+		}
+		return r
+	}
+}
@@ -0,0 +1,265 @@
+package fake
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// result is the outcome of a statement; DDL statements return a single status row the same as Snowflake.
+type result struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type cell struct {
+	column string
+	value  driver.Value
+}
+
+func newResult(columns ...string) *result {
+	return &result{columns: columns, rows: make([][]driver.Value, 0)}
+}
+
+func statusResult(message string) *result {
+	r := newResult("status")
+	r.addRow(cell{"status", message})
+	return r
+}
+
+// addRow adds a row with the given cells; columns without a cell are NULL.
+func (r *result) addRow(cells ...cell) {
+	row := make([]driver.Value, len(r.columns))
+	for _, c := range cells {
+		idx := -1
+		for i, name := range r.columns {
+			if name == c.column {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			panic(fmt.Sprintf("fake backend: unknown column %s", c.column))
+		}
+		row[idx] = c.value
+	}
+	r.rows = append(r.rows, row)
+}
+
+// session is the state of a single connection.
+type session struct {
+	account        *account
+	id             int
+	user           string
+	role           string
+	secondaryRoles string
+	database       identifier
+	schema         identifier
+	warehouse      identifier
+}
+
+func (a *account) newSession(user string, role string) *session {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sessionSequence++
+	if user == "" {
+		user = "FAKE_USER"
+	}
+	if role == "" {
+		role = "ACCOUNTADMIN"
+	}
+	return &session{
+		account: a,
+		id:      a.sessionSequence,
+		user:    strings.ToUpper(user),
+		role:    strings.ToUpper(role),
+	}
+}
+
+func (s *session) execute(sql string) (*result, error) {
+	p, err := newParser(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	s.account.mu.Lock()
+	defer s.account.mu.Unlock()
+
+	switch {
+	case p.acceptKeyword("CREATE"):
+		return s.create(p)
+	case p.acceptKeyword("ALTER", "SESSION"):
+		return statusResult("Statement executed successfully."), nil
+	case p.acceptKeyword("ALTER"):
+		return s.alter(p)
+	case p.acceptKeyword("DROP"):
+		return s.drop(p)
+	case p.acceptKeyword("SHOW"):
+		return s.show(p)
+	case p.acceptKeyword("DESCRIBE"), p.acceptKeyword("DESC"):
+		return s.describe(p)
+	case p.acceptKeyword("GRANT"):
+		return s.grant(p)
+	case p.acceptKeyword("REVOKE"):
+		return s.revoke(p)
+	case p.acceptKeyword("USE"):
+		return s.use(p)
+	case p.acceptKeyword("SELECT"):
+		return s.selectContextFunctions(p)
+	case p.acceptKeyword("BEGIN"), p.acceptKeyword("COMMIT"), p.acceptKeyword("ROLLBACK"):
+		return statusResult("Statement executed successfully."), nil
+	default:
+		return nil, unsupportedError(sql)
+	}
+}
+
+func (s *session) use(p *parser) (*result, error) {
+	if p.acceptKeyword("SECONDARY", "ROLES") {
+		roles := p.words()
+		if roles == "" {
+			ids, err := p.identifierList()
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, len(ids))
+			for i, id := range ids {
+				names[i] = id.name()
+			}
+			roles = strings.Join(names, ",")
+		}
+		s.secondaryRoles = roles
+		return statusResult("Statement executed successfully."), nil
+	}
+
+	kind := kindDatabase
+	if k, ok := p.acceptOneOf(kindRole, kindWarehouse, kindDatabase, kindSchema); ok {
+		kind = k
+	}
+	id, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	if kind == kindSchema && len(id) == 1 && s.database != nil {
+		id = append(s.database, id...)
+	}
+	if s.account.find(kind, id) == nil {
+		return nil, objectNotExistError(kind, id)
+	}
+	switch kind {
+	case kindRole:
+		s.role = id.name()
+	case kindWarehouse:
+		s.warehouse = id
+	case kindDatabase:
+		s.database = id
+		s.schema = nil
+	case kindSchema:
+		s.database = id.parent()
+		s.schema = id
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// selectContextFunctions answers SELECT statements built from context and system functions taking string arguments,
+// e.g. SELECT CURRENT_ROLE() as CURRENT_ROLE.
+func (s *session) selectContextFunctions(p *parser) (*result, error) {
+	var cells []cell
+	for !p.done() {
+		t := p.next()
+		if t.kind != tokenWord {
+			return nil, unsupportedError(p.sql)
+		}
+		function := strings.ToUpper(t.text)
+		if err := p.expectSymbol("("); err != nil {
+			return nil, err
+		}
+		var arguments []string
+		for !p.acceptSymbol(")") {
+			if len(arguments) > 0 {
+				if err := p.expectSymbol(","); err != nil {
+					return nil, err
+				}
+			}
+			argument, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, argument)
+		}
+		column := function
+		if p.acceptKeyword("AS") {
+			alias, err := p.identifierPart()
+			if err != nil {
+				return nil, err
+			}
+			column = alias
+		}
+		v, err := s.contextFunction(function, arguments)
+		if err != nil {
+			return nil, err
+		}
+		cells = append(cells, cell{column, v})
+		if !p.acceptSymbol(",") && !p.done() {
+			return nil, p.unexpected(",")
+		}
+	}
+
+	columns := make([]string, len(cells))
+	for i, c := range cells {
+		columns[i] = c.column
+	}
+	r := newResult(columns...)
+	r.addRow(cells...)
+	return r, nil
+}
+
+func (s *session) contextFunction(function string, arguments []string) (driver.Value, error) {
+	nullable := func(id identifier) driver.Value {
+		if id == nil {
+			return nil
+		}
+		return id.name()
+	}
+	switch function {
+	case "CURRENT_ACCOUNT":
+		return s.account.name, nil
+	case "CURRENT_ACCOUNT_NAME":
+		return s.account.name, nil
+	case "CURRENT_ORGANIZATION_NAME":
+		return "FAKE_ORGANIZATION", nil
+	case "CURRENT_REGION":
+		return "AWS_US_WEST_2", nil
+	case "CURRENT_SESSION":
+		return fmt.Sprintf("%d", s.id), nil
+	case "CURRENT_USER":
+		return s.user, nil
+	case "CURRENT_ROLE":
+		return s.role, nil
+	case "CURRENT_SECONDARY_ROLES":
+		value := s.secondaryRoles
+		roles := ""
+		if value != "" && value != "ALL" && value != "NONE" {
+			roles, value = value, ""
+		}
+		encoded, err := json.Marshal(map[string]string{"roles": roles, "value": value})
+		return string(encoded), err
+	case "CURRENT_DATABASE":
+		return nullable(s.database), nil
+	case "CURRENT_SCHEMA":
+		return nullable(s.schema), nil
+	case "CURRENT_WAREHOUSE":
+		return nullable(s.warehouse), nil
+	case "IS_ROLE_IN_SESSION":
+		if len(arguments) != 1 {
+			return nil, invalidStatementError(fmt.Sprintf("IS_ROLE_IN_SESSION expects 1 argument, got %d", len(arguments)))
+		}
+		role := strings.Trim(arguments[0], `"`)
+		return role == s.role || s.account.isRoleGranted(role, s.role), nil
+	case "SYSTEM$GET_TAG":
+		return s.getTag(arguments)
+	default:
+		return nil, unsupportedError(fmt.Sprintf("SELECT %s()", function))
+	}
+}
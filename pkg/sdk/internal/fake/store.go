@@ -0,0 +1,283 @@
+package fake
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// objectKind describes the object types the fake backend stores.
+type objectKind struct {
+	name       string
+	plural     string
+	parts      int
+	parentKind string
+}
+
+const (
+	kindDatabase  = "DATABASE"
+	kindSchema    = "SCHEMA"
+	kindTable     = "TABLE"
+	kindWarehouse = "WAREHOUSE"
+	kindRole      = "ROLE"
+	kindUser      = "USER"
+	kindTag       = "TAG"
+)
+
+var objectKinds = []*objectKind{
+	{name: kindDatabase, plural: "DATABASES", parts: 1},
+	{name: kindSchema, plural: "SCHEMAS", parts: 2, parentKind: kindDatabase},
+	{name: kindTable, plural: "TABLES", parts: 3, parentKind: kindSchema},
+	{name: kindWarehouse, plural: "WAREHOUSES", parts: 1},
+	{name: kindRole, plural: "ROLES", parts: 1},
+	{name: kindUser, plural: "USERS", parts: 1},
+	{name: kindTag, plural: "TAGS", parts: 3, parentKind: kindSchema},
+}
+
+func objectKindByName(name string) *objectKind {
+	for _, kind := range objectKinds {
+		if kind.name == name {
+			return kind
+		}
+	}
+	return nil
+}
+
+func objectKindByPlural(plural string) *objectKind {
+	for _, kind := range objectKinds {
+		if kind.plural == plural {
+			return kind
+		}
+	}
+	return nil
+}
+
+// kindDisplayName returns the object type the way Snowflake presents it in error messages, e.g. Database.
+func kindDisplayName(kind string) string {
+	if kind == "" {
+		return "Object"
+	}
+	return strings.ToUpper(kind[:1]) + strings.ToLower(kind[1:])
+}
+
+type object struct {
+	kind       string
+	id         identifier
+	createdOn  time.Time
+	owner      string
+	properties map[string]value
+	transient  bool
+	tags       []*tagValue
+
+	// schemas
+	managedAccess bool
+	// warehouses
+	state string
+	// tables
+	columns    []*column
+	primaryKey []string
+}
+
+func (o *object) propertyOrDefault(name string, defaultValue string) string {
+	if v, ok := o.properties[name]; ok {
+		return v.String()
+	}
+	return defaultValue
+}
+
+func (o *object) boolProperty(name string, defaultValue bool) bool {
+	if v, ok := o.properties[name]; ok {
+		return v.bool()
+	}
+	return defaultValue
+}
+
+func (o *object) setProperties(properties map[string]value) {
+	for k, v := range properties {
+		o.properties[k] = v
+	}
+}
+
+// account holds the state of one fake Snowflake account. Every connection opened with the same DSN shares it.
+type account struct {
+	mu      sync.Mutex
+	name    string
+	objects []*object
+	grants  []*grant
+	// parameters set with ALTER ACCOUNT
+	parameters map[string]value
+
+	sessionSequence int
+}
+
+var (
+	accountsMu sync.Mutex
+	accounts   = make(map[string]*account)
+)
+
+func accountByName(name string) *account {
+	accountsMu.Lock()
+	defer accountsMu.Unlock()
+
+	if a, ok := accounts[name]; ok {
+		return a
+	}
+	a := newAccount(name)
+	accounts[name] = a
+	return a
+}
+
+// Reset drops the state of the given account; the next connection starts with an empty account.
+func Reset(name string) {
+	accountsMu.Lock()
+	defer accountsMu.Unlock()
+
+	delete(accounts, strings.ToUpper(name))
+}
+
+// systemRoles are created with every account, the same as in Snowflake.
+var systemRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "PUBLIC", "SECURITYADMIN", "SYSADMIN", "USERADMIN"}
+
+func newAccount(name string) *account {
+	a := &account{name: name, parameters: make(map[string]value)}
+	for _, role := range systemRoles {
+		a.objects = append(a.objects, &object{
+			kind:       kindRole,
+			id:         identifier{role},
+			createdOn:  time.Now(),
+			properties: make(map[string]value),
+		})
+	}
+	for _, hierarchy := range builtInRoleHierarchy {
+		a.grants = append(a.grants, &grant{
+			createdOn: time.Now(),
+			privilege: "USAGE",
+			on:        kindRole,
+			name:      identifier{hierarchy[0]},
+			to:        kindRole,
+			grantee:   hierarchy[1],
+		})
+	}
+	return a
+}
+
+func (a *account) find(kind string, id identifier) *object {
+	for _, o := range a.objects {
+		if o.kind == kind && o.id.equals(id) {
+			return o
+		}
+	}
+	return nil
+}
+
+// list returns the objects of the given kind sorted the way SHOW sorts them.
+func (a *account) list(kind string) []*object {
+	var result []*object
+	for _, o := range a.objects {
+		if o.kind == kind {
+			result = append(result, o)
+		}
+	}
+	slices.SortFunc(result, func(x, y *object) int {
+		return strings.Compare(strings.Join(x.id, "\x00"), strings.Join(y.id, "\x00"))
+	})
+	return result
+}
+
+func isContainer(kind string) bool {
+	return kind == kindDatabase || kind == kindSchema
+}
+
+// contains checks if the identifier belongs to the object or, for databases and schemas, to one of its children.
+func (o *object) contains(id identifier) bool {
+	return id.equals(o.id) || isContainer(o.kind) && id.hasPrefix(o.id)
+}
+
+// remove drops the object together with its children (schemas of databases, tables of schemas), the grants on them
+// and, for tags, the values set on other objects.
+func (a *account) remove(o *object) {
+	a.grants = slices.DeleteFunc(a.grants, func(g *grant) bool {
+		if (g.to == o.kind) && g.grantee == o.id.name() {
+			return true
+		}
+		return g.targetKind() == o.kind && g.name.equals(o.id) || isContainer(o.kind) && g.name.hasPrefix(o.id)
+	})
+	a.objects = slices.DeleteFunc(a.objects, func(candidate *object) bool {
+		return candidate == o || isContainer(o.kind) && candidate.id.hasPrefix(o.id)
+	})
+	for _, candidate := range a.objects {
+		candidate.tags = slices.DeleteFunc(candidate.tags, func(t *tagValue) bool {
+			return o.kind == kindTag && t.tag.equals(o.id) || isContainer(o.kind) && t.tag.hasPrefix(o.id)
+		})
+	}
+}
+
+// rename changes the identifier of the object, its children and the grants on them.
+func (a *account) rename(o *object, newId identifier) {
+	oldId := o.id
+	renamed := func(id identifier) identifier {
+		return append(slices.Clone(newId), id[len(oldId):]...)
+	}
+	for _, candidate := range a.objects {
+		switch {
+		case candidate == o:
+			candidate.id = slices.Clone(newId)
+		case isContainer(o.kind) && candidate.id.hasPrefix(oldId):
+			candidate.id = renamed(candidate.id)
+		case o.kind == kindRole && candidate.owner == oldId.name():
+			candidate.owner = newId.name()
+		}
+		for _, t := range candidate.tags {
+			if o.kind == kindTag && t.tag.equals(oldId) || isContainer(o.kind) && t.tag.hasPrefix(oldId) {
+				t.tag = renamed(t.tag)
+			}
+		}
+	}
+	for _, g := range a.grants {
+		if g.targetKind() == o.kind && g.name.equals(oldId) || isContainer(o.kind) && g.name.hasPrefix(oldId) {
+			g.name = renamed(g.name)
+		}
+		if g.to == o.kind && g.grantee == oldId.name() {
+			g.grantee = newId.name()
+		}
+		if o.kind == kindRole && g.grantedBy == oldId.name() {
+			g.grantedBy = newId.name()
+		}
+	}
+}
+
+// clone copies the object together with its children (schemas of databases, tables of schemas) under the new identifier.
+// The grants on the source objects are not copied.
+func (a *account) clone(o *object, newId identifier, owner string, createdOn time.Time) *object {
+	var result *object
+	for _, candidate := range slices.Clone(a.objects) {
+		if candidate != o && !(isContainer(o.kind) && candidate.id.hasPrefix(o.id)) {
+			continue
+		}
+		copied := &object{
+			kind:          candidate.kind,
+			id:            append(slices.Clone(newId), candidate.id[len(o.id):]...),
+			createdOn:     createdOn,
+			owner:         owner,
+			properties:    maps.Clone(candidate.properties),
+			transient:     candidate.transient,
+			managedAccess: candidate.managedAccess,
+			state:         candidate.state,
+			primaryKey:    slices.Clone(candidate.primaryKey),
+		}
+		for _, c := range candidate.columns {
+			columnCopy := *c
+			copied.columns = append(copied.columns, &columnCopy)
+		}
+		for _, t := range candidate.tags {
+			copied.tags = append(copied.tags, &tagValue{tag: t.tag, value: t.value})
+		}
+		a.objects = append(a.objects, copied)
+		if candidate == o {
+			result = copied
+		}
+	}
+	return result
+}
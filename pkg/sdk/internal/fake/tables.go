@@ -0,0 +1,394 @@
+package fake
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type column struct {
+	name         string
+	dataType     string
+	nullable     bool
+	defaultValue *string
+	comment      *string
+	collation    string
+}
+
+func (o *object) column(name string) *column {
+	for _, c := range o.columns {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// columnDefinitionKeywords end the data type in the column definition.
+var columnDefinitionKeywords = []string{
+	"NOT", "NULL", "COMMENT", "DEFAULT", "AUTOINCREMENT", "IDENTITY", "COLLATE", "PRIMARY", "UNIQUE", "CONSTRAINT",
+	"FOREIGN", "REFERENCES", "MASKING", "WITH", "TAG", "PROJECTION",
+}
+
+// tableElements parses the column definitions and out-of-line constraints of CREATE TABLE.
+func (p *parser) tableElements(o *object) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+	for {
+		switch {
+		case p.isKeyword("CONSTRAINT"), p.isKeyword("PRIMARY", "KEY"), p.isKeyword("UNIQUE"), p.isKeyword("FOREIGN", "KEY"):
+			if err := p.outOfLineConstraint(o); err != nil {
+				return err
+			}
+		default:
+			c, err := p.columnDefinition(o)
+			if err != nil {
+				return err
+			}
+			if o.column(c.name) != nil {
+				return invalidStatementError(fmt.Sprintf("duplicate column name '%s'", c.name))
+			}
+			o.columns = append(o.columns, c)
+		}
+		if p.acceptSymbol(")") {
+			return nil
+		}
+		if err := p.expectSymbol(","); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) columnDefinition(o *object) (*column, error) {
+	name, err := p.identifierPart()
+	if err != nil {
+		return nil, err
+	}
+	dataType, err := p.dataType()
+	if err != nil {
+		return nil, err
+	}
+	c := &column{name: name, dataType: dataType, nullable: true}
+	for !p.done() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		switch {
+		case p.acceptKeyword("NOT", "NULL"):
+			c.nullable = false
+		case p.acceptKeyword("NULL"):
+			c.nullable = true
+		case p.acceptKeyword("COMMENT"):
+			comment, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			c.comment = &comment
+		case p.acceptKeyword("COLLATE"):
+			collation, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			c.collation = collation
+		case p.acceptKeyword("DEFAULT"):
+			expression := p.expression()
+			c.defaultValue = &expression
+		case p.acceptKeyword("PRIMARY", "KEY"):
+			o.primaryKey = []string{name}
+		case p.acceptKeyword("UNIQUE"):
+		case p.acceptKeyword("WITH"), p.acceptKeyword("MASKING", "POLICY"), p.acceptKeyword("PROJECTION", "POLICY"),
+			p.acceptKeyword("TAG"), p.acceptKeyword("AUTOINCREMENT"), p.acceptKeyword("IDENTITY"):
+			// masking policies, tags and identities are accepted and not stored
+			p.skipUntilColumnEnd()
+		default:
+			return nil, unsupportedError(p.sql)
+		}
+	}
+	return c, nil
+}
+
+// dataType parses the data type of the column, e.g. NUMBER(38,0) or TIMESTAMP_NTZ(9), and normalizes it the way DESCRIBE TABLE presents it.
+func (p *parser) dataType() (string, error) {
+	var parts []string
+	for !p.done() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		t := p.peek()
+		if t.kind == tokenWord && slices.Contains(columnDefinitionKeywords, strings.ToUpper(t.text)) {
+			break
+		}
+		if t.isSymbol("(") {
+			arguments, err := p.skipParenthesized()
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, "("+strings.ReplaceAll(arguments, " ", "")+")")
+			continue
+		}
+		p.pos++
+		parts = append(parts, strings.ToUpper(t.text))
+	}
+	if len(parts) == 0 {
+		return "", p.unexpected("data type")
+	}
+	return normalizeDataType(strings.Join(parts, " ")), nil
+}
+
+var dataTypeWithArgumentsRegex = regexp.MustCompile(`^([A-Z0-9_ ]+?)\s*(\(.*\))?$`)
+
+func normalizeDataType(dataType string) string {
+	dataType = strings.ReplaceAll(dataType, " (", "(")
+	matches := dataTypeWithArgumentsRegex.FindStringSubmatch(dataType)
+	if matches == nil {
+		return dataType
+	}
+	name, arguments := matches[1], matches[2]
+	switch name {
+	case "NUMBER", "DECIMAL", "NUMERIC":
+		switch {
+		case arguments == "":
+			return "NUMBER(38,0)"
+		case !strings.Contains(arguments, ","):
+			return "NUMBER" + strings.TrimSuffix(arguments, ")") + ",0)"
+		default:
+			return "NUMBER" + arguments
+		}
+	case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT":
+		return "NUMBER(38,0)"
+	case "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION", "REAL":
+		return "FLOAT"
+	case "VARCHAR", "STRING", "TEXT", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING":
+		if arguments == "" {
+			return "VARCHAR(16777216)"
+		}
+		return "VARCHAR" + arguments
+	case "CHAR", "CHARACTER", "NCHAR":
+		if arguments == "" {
+			return "VARCHAR(1)"
+		}
+		return "VARCHAR" + arguments
+	case "BINARY", "VARBINARY":
+		if arguments == "" {
+			return "BINARY(8388608)"
+		}
+		return "BINARY" + arguments
+	case "TIMESTAMP", "DATETIME":
+		name = "TIMESTAMP_NTZ"
+		fallthrough
+	case "TIMESTAMP_NTZ", "TIMESTAMP_LTZ", "TIMESTAMP_TZ", "TIME":
+		if arguments == "" {
+			return name + "(9)"
+		}
+		return name + arguments
+	default:
+		return dataType
+	}
+}
+
+// expression returns the text of the default value expression up to the next column option.
+func (p *parser) expression() string {
+	var parts []string
+	for !p.done() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		t := p.peek()
+		if t.kind == tokenWord && slices.Contains(columnDefinitionKeywords, strings.ToUpper(t.text)) {
+			break
+		}
+		if t.isSymbol("(") {
+			inner, _ := p.skipParenthesized()
+			parts = append(parts, "(", inner, ")")
+			continue
+		}
+		p.pos++
+		parts = append(parts, tokenText(t))
+	}
+	return joinTokenTexts(parts)
+}
+
+func (p *parser) skipUntilColumnEnd() {
+	for !p.done() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		if p.peek().isSymbol("(") {
+			_, _ = p.skipParenthesized()
+			continue
+		}
+		p.pos++
+	}
+}
+
+func (p *parser) outOfLineConstraint(o *object) error {
+	if p.acceptKeyword("CONSTRAINT") {
+		if _, err := p.identifierPart(); err != nil {
+			return err
+		}
+	}
+	switch {
+	case p.acceptKeyword("PRIMARY", "KEY"):
+		columns, err := p.identifierList()
+		if err != nil {
+			return err
+		}
+		o.primaryKey = nil
+		for _, c := range columns {
+			o.primaryKey = append(o.primaryKey, c.name())
+		}
+	case p.acceptKeyword("UNIQUE"), p.acceptKeyword("FOREIGN", "KEY"):
+		// other constraints are accepted and not stored
+		p.skipUntilColumnEnd()
+	default:
+		return unsupportedError(p.sql)
+	}
+	return nil
+}
+
+func (s *session) alterTable(o *object, p *parser) error {
+	switch {
+	case p.acceptKeyword("ADD", "COLUMN"):
+		p.acceptKeyword("IF", "NOT", "EXISTS")
+		c, err := p.columnDefinition(o)
+		if err != nil {
+			return err
+		}
+		if o.column(c.name) != nil {
+			return invalidStatementError(fmt.Sprintf("column '%s' already exists", c.name))
+		}
+		o.columns = append(o.columns, c)
+	case p.acceptKeyword("ADD"):
+		return p.outOfLineConstraint(o)
+	case p.acceptKeyword("DROP", "COLUMN"):
+		ifExists := p.acceptKeyword("IF", "EXISTS")
+		names, err := p.identifierList()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if o.column(name.name()) == nil && !ifExists {
+				return invalidStatementError(fmt.Sprintf("invalid identifier '%s'", name.name()))
+			}
+			o.columns = slices.DeleteFunc(o.columns, func(c *column) bool { return c.name == name.name() })
+			o.primaryKey = slices.DeleteFunc(o.primaryKey, func(c string) bool { return c == name.name() })
+		}
+	case p.acceptKeyword("DROP", "PRIMARY", "KEY"):
+		if p.peek().isSymbol("(") {
+			if _, err := p.identifierList(); err != nil {
+				return err
+			}
+		}
+		o.primaryKey = nil
+	case p.acceptKeyword("DROP", "CONSTRAINT"), p.acceptKeyword("DROP", "UNIQUE"), p.acceptKeyword("DROP", "FOREIGN", "KEY"):
+		p.pos = len(p.tokens)
+	case p.acceptKeyword("RENAME", "COLUMN"):
+		oldName, err := p.identifierPart()
+		if err != nil {
+			return err
+		}
+		if err := p.expectKeyword("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifierPart()
+		if err != nil {
+			return err
+		}
+		c := o.column(oldName)
+		if c == nil {
+			return invalidStatementError(fmt.Sprintf("invalid identifier '%s'", oldName))
+		}
+		c.name = newName
+		for i, key := range o.primaryKey {
+			if key == oldName {
+				o.primaryKey[i] = newName
+			}
+		}
+	case p.acceptKeyword("ALTER"), p.acceptKeyword("MODIFY"):
+		return p.alterColumns(o)
+	case p.acceptKeyword("CLUSTER", "BY"):
+		expression, err := p.skipParenthesized()
+		if err != nil {
+			return err
+		}
+		o.properties["CLUSTER_BY"] = value{kind: valueString, text: fmt.Sprintf("LINEAR(%s)", expression)}
+	case p.acceptKeyword("DROP", "CLUSTERING", "KEY"):
+		delete(o.properties, "CLUSTER_BY")
+	case p.acceptKeyword("RECLUSTER"), p.acceptKeyword("SUSPEND", "RECLUSTER"), p.acceptKeyword("RESUME", "RECLUSTER"):
+		p.pos = len(p.tokens)
+	default:
+		return unsupportedError(p.sql)
+	}
+	return nil
+}
+
+// alterColumns parses the comma-separated column actions of ALTER TABLE ... ALTER COLUMN.
+func (p *parser) alterColumns(o *object) error {
+	for {
+		p.acceptKeyword("COLUMN")
+		name, err := p.identifierPart()
+		if err != nil {
+			return err
+		}
+		c := o.column(name)
+		if c == nil {
+			return invalidStatementError(fmt.Sprintf("invalid identifier '%s'", name))
+		}
+		switch {
+		case p.acceptKeyword("SET", "DATA", "TYPE"), p.acceptKeyword("TYPE"):
+			dataType, err := p.dataType()
+			if err != nil {
+				return err
+			}
+			c.dataType = dataType
+		case p.acceptKeyword("SET", "NOT", "NULL"):
+			c.nullable = false
+		case p.acceptKeyword("DROP", "NOT", "NULL"):
+			c.nullable = true
+		case p.acceptKeyword("DROP", "DEFAULT"):
+			c.defaultValue = nil
+		case p.acceptKeyword("SET", "DEFAULT"):
+			expression := p.expression()
+			c.defaultValue = &expression
+		case p.acceptKeyword("COMMENT"):
+			comment, err := p.stringLiteral()
+			if err != nil {
+				return err
+			}
+			c.comment = &comment
+		case p.acceptKeyword("UNSET", "COMMENT"):
+			c.comment = nil
+		case p.acceptKeyword("COLLATE"):
+			collation, err := p.stringLiteral()
+			if err != nil {
+				return err
+			}
+			c.collation = collation
+		case p.acceptKeyword("SET", "MASKING", "POLICY"), p.acceptKeyword("UNSET", "MASKING", "POLICY"),
+			p.acceptKeyword("SET", "TAG"), p.acceptKeyword("UNSET", "TAG"):
+			// masking policies and tags are not stored
+			p.skipUntilColumnEnd()
+		default:
+			return unsupportedError(p.sql)
+		}
+		if !p.acceptSymbol(",") {
+			return nil
+		}
+	}
+}
+
+func describeTableColumns(o *object) *result {
+	r := newResult("name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment", "policy name")
+	for _, c := range o.columns {
+		dataType := c.dataType
+		if c.collation != "" {
+			dataType = fmt.Sprintf("%s COLLATE '%s'", dataType, c.collation)
+		}
+		cells := []cell{
+			{"name", c.name},
+			{"type", dataType},
+			{"kind", "COLUMN"},
+			{"null?", yesNo(c.nullable)},
+			{"primary key", yesNo(slices.Contains(o.primaryKey, c.name))},
+			{"unique key", "N"},
+		}
+		if c.defaultValue != nil {
+			cells = append(cells, cell{"default", *c.defaultValue})
+		}
+		if c.comment != nil {
+			cells = append(cells, cell{"comment", *c.comment})
+		}
+		r.addRow(cells...)
+	}
+	return r
+}
@@ -0,0 +1,134 @@
+package fake
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// tagValue is a tag set on an object, e.g. with CREATE ROLE ... WITH TAG (tag = 'value') or ALTER ... SET TAG tag = 'value'.
+type tagValue struct {
+	tag   identifier
+	value string
+}
+
+func (o *object) tag(tag identifier) (string, bool) {
+	for _, t := range o.tags {
+		if t.tag.equals(tag) {
+			return t.value, true
+		}
+	}
+	return "", false
+}
+
+func (o *object) setTag(tag identifier, value string) {
+	for _, t := range o.tags {
+		if t.tag.equals(tag) {
+			t.value = value
+			return
+		}
+	}
+	o.tags = append(o.tags, &tagValue{tag: tag, value: value})
+}
+
+func (o *object) unsetTag(tag identifier) {
+	o.tags = slices.DeleteFunc(o.tags, func(t *tagValue) bool {
+		return t.tag.equals(tag)
+	})
+}
+
+// tagAssignments parses the tag assignments of WITH TAG (tag = 'value', ...) and SET TAG tag = 'value', ...;
+// the tag names are resolved using the current database and schema.
+func (s *session) tagAssignments(p *parser) ([]*tagValue, error) {
+	parenthesized := p.acceptSymbol("(")
+	var assignments []*tagValue
+	for {
+		tag, err := s.objectIdentifier(p, objectKindByName(kindTag))
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSymbol("="); err != nil {
+			return nil, err
+		}
+		value, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		if s.account.find(kindTag, tag) == nil {
+			return nil, objectNotExistError(kindTag, tag)
+		}
+		assignments = append(assignments, &tagValue{tag: tag, value: value})
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	if parenthesized {
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
+
+// unsetTags parses the tag names of UNSET TAG tag, ... and removes them from the object.
+func (s *session) unsetTags(o *object, p *parser) error {
+	tags, err := p.identifierList()
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		tag, err := s.qualify(objectKindByName(kindTag), tag)
+		if err != nil {
+			return err
+		}
+		o.unsetTag(tag)
+	}
+	return nil
+}
+
+// getTag answers SYSTEM$GET_TAG('<tag>', '<object>', '<object type>'); the value is NULL when the tag is not set on the object.
+func (s *session) getTag(arguments []string) (driver.Value, error) {
+	if len(arguments) != 3 {
+		return nil, invalidStatementError(fmt.Sprintf("SYSTEM$GET_TAG expects 3 arguments, got %d", len(arguments)))
+	}
+	kind := objectKindByName(strings.ToUpper(arguments[2]))
+	if kind == nil {
+		return nil, unsupportedError(fmt.Sprintf("SELECT SYSTEM$GET_TAG for %s", arguments[2]))
+	}
+	tag, err := s.parseIdentifier(arguments[0], objectKindByName(kindTag))
+	if err != nil {
+		return nil, err
+	}
+	if s.account.find(kindTag, tag) == nil {
+		return nil, objectNotExistError(kindTag, tag)
+	}
+	id, err := s.parseIdentifier(arguments[1], kind)
+	if err != nil {
+		return nil, err
+	}
+	o := s.account.find(kind.name, id)
+	if o == nil {
+		return nil, objectNotExistError(kind.name, id)
+	}
+	if v, ok := o.tag(tag); ok {
+		return v, nil
+	}
+	return nil, nil
+}
+
+// parseIdentifier parses the name of an object of the given kind passed as a string argument, e.g. to SYSTEM$GET_TAG.
+func (s *session) parseIdentifier(name string, kind *objectKind) (identifier, error) {
+	p, err := newParser(name)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.objectIdentifier(p, kind)
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, syntaxError(fmt.Sprintf("invalid identifier '%s'", name))
+	}
+	return id, nil
+}
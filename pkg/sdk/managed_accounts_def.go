@@ -24,7 +24,7 @@ var managedAccount = g.PlainStruct("ManagedAccount").
 	Text("URL").
 	Text("AccountLocatorURL").
 	Bool("IsReader").
-	OptionalText("Comment")
+	Text("Comment")
 
 var ManagedAccountsDef = g.NewInterface(
 	"ManagedAccounts",
@@ -67,4 +67,4 @@ var ManagedAccountsDef = g.NewInterface(
 			SQL("MANAGED ACCOUNTS").
 			OptionalLike(),
 	).
-	ShowByIdOperation()
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering)
@@ -13,10 +13,6 @@ type CreateManagedAccountRequest struct {
 	CreateManagedAccountParams CreateManagedAccountParamsRequest // required
 }
 
-func (r *CreateManagedAccountRequest) GetName() AccountObjectIdentifier {
-	return r.name
-}
-
 type CreateManagedAccountParamsRequest struct {
 	AdminName     string // required
 	AdminPassword string // required
@@ -30,3 +26,10 @@ type DropManagedAccountRequest struct {
 type ShowManagedAccountRequest struct {
 	Like *Like
 }
+
+// generator:protected-begin declarations
+func (r *CreateManagedAccountRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
@@ -10,6 +10,8 @@ type ManagedAccounts interface {
 	Drop(ctx context.Context, request *DropManagedAccountRequest) error
 	Show(ctx context.Context, request *ShowManagedAccountRequest) ([]ManagedAccount, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error)
+	// generator:protected-begin ManagedAccounts methods
+	// generator:protected-end
 }
 
 // CreateManagedAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-managed-account.
@@ -64,3 +66,6 @@ type ManagedAccount struct {
 	IsReader          bool
 	Comment           string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ ManagedAccounts = (*managedAccounts)(nil)
 
@@ -33,11 +29,14 @@ func (v *managedAccounts) Show(ctx context.Context, request *ShowManagedAccountR
 }
 
 func (v *managedAccounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error) {
-	managedAccounts, err := v.Show(ctx, NewShowManagedAccountRequest().WithLike(&Like{String(id.Name())}))
+	// generator:protected-begin managedAccounts.ShowByID
+	request := NewShowManagedAccountRequest().WithLike(&Like{Pattern: String(id.Name())})
+	managedAccounts, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(managedAccounts, func(r ManagedAccount) bool { return r.Name == id.Name() })
+	return findOne(managedAccounts, func(r ManagedAccount) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (r *CreateManagedAccountRequest) toOpts() *CreateManagedAccountOptions {
@@ -67,6 +66,7 @@ func (r *ShowManagedAccountRequest) toOpts() *ShowManagedAccountOptions {
 }
 
 func (r managedAccountDBRow) convert() *ManagedAccount {
+	// generator:protected-begin managedAccountDBRow.convert
 	managedAccount := &ManagedAccount{
 		Name:              r.Name,
 		Cloud:             r.Cloud,
@@ -81,4 +81,8 @@ func (r managedAccountDBRow) convert() *ManagedAccount {
 		managedAccount.Comment = r.Comment.String
 	}
 	return managedAccount
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -14,14 +14,14 @@ func (opts *CreateManagedAccountOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.CreateManagedAccountParams) {
-		if !valueSet(opts.CreateManagedAccountParams.AdminName) {
-			errs = append(errs, errNotSet("CreateManagedAccountOptions.CreateManagedAccountParams", "AdminName"))
-		}
-		if !valueSet(opts.CreateManagedAccountParams.AdminPassword) {
-			errs = append(errs, errNotSet("CreateManagedAccountOptions.CreateManagedAccountParams", "AdminPassword"))
-		}
+	if !valueSet(opts.CreateManagedAccountParams.AdminName) {
+		errs = append(errs, errNotSet("CreateManagedAccountOptions.CreateManagedAccountParams", "AdminName"))
 	}
+	if !valueSet(opts.CreateManagedAccountParams.AdminPassword) {
+		errs = append(errs, errNotSet("CreateManagedAccountOptions.CreateManagedAccountParams", "AdminPassword"))
+	}
+	// generator:protected-begin CreateManagedAccountOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -33,6 +33,8 @@ func (opts *DropManagedAccountOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropManagedAccountOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -41,5 +43,10 @@ func (opts *ShowManagedAccountOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowManagedAccountOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
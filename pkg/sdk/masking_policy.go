@@ -335,7 +335,7 @@ type maskingPolicyDetailsRow struct {
 }
 
 func (row maskingPolicyDetailsRow) toMaskingPolicyDetails() *MaskingPolicyDetails {
-	dataType, err := ToDataType(row.ReturnType)
+	dataType, err := toDataTypeWithParameters(row.ReturnType)
 	if err != nil {
 		return nil
 	}
@@ -345,19 +345,22 @@ func (row maskingPolicyDetailsRow) toMaskingPolicyDetails() *MaskingPolicyDetail
 		ReturnType: dataType,
 		Body:       row.Body,
 	}
-	s := strings.Trim(row.Signature, "()")
-	parts := strings.Split(s, ",")
+	s := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(row.Signature), "("), ")")
+	parts, err := splitArguments(s)
+	if err != nil {
+		return v
+	}
 	for _, part := range parts {
-		p := strings.Split(strings.TrimSpace(part), " ")
-		if len(p) != 2 {
+		name, columnType, ok := strings.Cut(strings.TrimSpace(part), " ")
+		if !ok {
 			continue
 		}
-		dType, err := ToDataType(p[1])
+		dType, err := toDataTypeWithParameters(columnType)
 		if err != nil {
 			continue
 		}
 		v.Signature = append(v.Signature, TableColumnSignature{
-			Name: p[0],
+			Name: name,
 			Type: dType,
 		})
 	}
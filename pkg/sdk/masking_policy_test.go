@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMaskingPolicyCreate(t *testing.T) {
@@ -216,3 +218,21 @@ func TestMaskingPolicyDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE MASKING POLICY %s", id.FullyQualifiedName())
 	})
 }
+
+func TestMaskingPolicyDetailsRow(t *testing.T) {
+	row := maskingPolicyDetailsRow{
+		Name:       "MP",
+		Signature:  "(VAL NUMBER(38,2), NAME VARCHAR)",
+		ReturnType: "NUMBER(38,2)",
+		Body:       "val",
+	}
+
+	details := row.toMaskingPolicyDetails()
+
+	require.NotNil(t, details)
+	assert.Equal(t, DataType("NUMBER(38,2)"), details.ReturnType)
+	assert.Equal(t, []TableColumnSignature{
+		{Name: "VAL", Type: DataType("NUMBER(38,2)")},
+		{Name: "NAME", Type: DataType("VARCHAR")},
+	}, details.Signature)
+}
@@ -25,10 +25,6 @@ type CreateMaterializedViewRequest struct {
 	sql                    string // required
 }
 
-func (r *CreateMaterializedViewRequest) GetName() SchemaObjectIdentifier {
-	return r.name
-}
-
 type MaterializedViewColumnRequest struct {
 	Name    string // required
 	Comment *string
@@ -90,3 +86,10 @@ type ShowMaterializedViewRequest struct {
 type DescribeMaterializedViewRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+func (r *CreateMaterializedViewRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
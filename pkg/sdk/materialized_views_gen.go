@@ -13,6 +13,8 @@ type MaterializedViews interface {
 	Show(ctx context.Context, request *ShowMaterializedViewRequest) ([]MaterializedView, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*MaterializedView, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]MaterializedViewDetails, error)
+	// generator:protected-begin MaterializedViews methods
+	// generator:protected-end
 }
 
 // CreateMaterializedViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-materialized-view.
@@ -154,10 +156,6 @@ type MaterializedView struct {
 	Budget              string
 }
 
-func (v *MaterializedView) ID() SchemaObjectIdentifier {
-	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
-}
-
 // DescribeMaterializedViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-materialized-view.
 type DescribeMaterializedViewOptions struct {
 	describe         bool                   `ddl:"static" sql:"DESCRIBE"`
@@ -169,7 +167,7 @@ type materializedViewDetailsRow struct {
 	Name       string         `db:"name"`
 	Type       DataType       `db:"type"`
 	Kind       string         `db:"kind"`
-	Null       string         `db:"null?"`
+	Null       string         `db:"null"`
 	Default    sql.NullString `db:"default"`
 	PrimaryKey string         `db:"primary key"`
 	UniqueKey  string         `db:"unique key"`
@@ -190,3 +188,10 @@ type MaterializedViewDetails struct {
 	Expression *string
 	Comment    *string
 }
+
+// generator:protected-begin declarations
+func (v *MaterializedView) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// generator:protected-end
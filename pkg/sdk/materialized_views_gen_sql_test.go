@@ -0,0 +1,130 @@
+package sdk
+
+import "testing"
+
+func TestMaterializedViews_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("RenameTo", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "RenameTo")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("ClusterBy", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ClusterBy")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("DropClusteringKey", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "DropClusteringKey")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SuspendRecluster", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SuspendRecluster")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("ResumeRecluster", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ResumeRecluster")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Suspend", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Suspend")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Resume", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Resume")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			clearSQLFixtureField(opts, "Set.Comment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			clearSQLFixtureField(opts, "Unset.Comment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowMaterializedViewOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowMaterializedViewOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeMaterializedViewOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
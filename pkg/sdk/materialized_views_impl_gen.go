@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ MaterializedViews = (*materializedViews)(nil)
 
@@ -38,12 +34,14 @@ func (v *materializedViews) Show(ctx context.Context, request *ShowMaterializedV
 }
 
 func (v *materializedViews) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*MaterializedView, error) {
-	request := NewShowMaterializedViewRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin materializedViews.ShowByID
+	request := NewShowMaterializedViewRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	materializedViews, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(materializedViews, func(r MaterializedView) bool { return r.Name == id.Name() })
+	return findOne(materializedViews, func(r MaterializedView) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *materializedViews) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]MaterializedViewDetails, error) {
@@ -71,14 +69,22 @@ func (r *CreateMaterializedViewRequest) toOpts() *CreateMaterializedViewOptions
 	if r.Columns != nil {
 		s := make([]MaterializedViewColumn, len(r.Columns))
 		for i, v := range r.Columns {
-			s[i] = MaterializedViewColumn(v)
+			s[i] = MaterializedViewColumn{
+				Name:    v.Name,
+				Comment: v.Comment,
+			}
 		}
 		opts.Columns = s
 	}
 	if r.ColumnsMaskingPolicies != nil {
 		s := make([]MaterializedViewColumnMaskingPolicy, len(r.ColumnsMaskingPolicies))
 		for i, v := range r.ColumnsMaskingPolicies {
-			s[i] = MaterializedViewColumnMaskingPolicy(v)
+			s[i] = MaterializedViewColumnMaskingPolicy{
+				Name:          v.Name,
+				MaskingPolicy: v.MaskingPolicy,
+				Using:         v.Using,
+				Tag:           v.Tag,
+			}
 		}
 		opts.ColumnsMaskingPolicies = s
 	}
@@ -93,7 +99,9 @@ func (r *CreateMaterializedViewRequest) toOpts() *CreateMaterializedViewOptions
 		if r.ClusterBy.Expressions != nil {
 			s := make([]MaterializedViewClusterByExpression, len(r.ClusterBy.Expressions))
 			for i, v := range r.ClusterBy.Expressions {
-				s[i] = MaterializedViewClusterByExpression(v)
+				s[i] = MaterializedViewClusterByExpression{
+					Name: v.Name,
+				}
 			}
 			opts.ClusterBy.Expressions = s
 		}
@@ -116,7 +124,9 @@ func (r *AlterMaterializedViewRequest) toOpts() *AlterMaterializedViewOptions {
 		if r.ClusterBy.Expressions != nil {
 			s := make([]MaterializedViewClusterByExpression, len(r.ClusterBy.Expressions))
 			for i, v := range r.ClusterBy.Expressions {
-				s[i] = MaterializedViewClusterByExpression(v)
+				s[i] = MaterializedViewClusterByExpression{
+					Name: v.Name,
+				}
 			}
 			opts.ClusterBy.Expressions = s
 		}
@@ -153,6 +163,7 @@ func (r *ShowMaterializedViewRequest) toOpts() *ShowMaterializedViewOptions {
 }
 
 func (r materializedViewDBRow) convert() *MaterializedView {
+	// generator:protected-begin materializedViewDBRow.convert
 	materializedView := MaterializedView{
 		CreatedOn:          r.CreatedOn,
 		Name:               r.Name,
@@ -191,6 +202,7 @@ func (r materializedViewDBRow) convert() *MaterializedView {
 		materializedView.Budget = r.Budget.String
 	}
 	return &materializedView
+	// generator:protected-end
 }
 
 func (r *DescribeMaterializedViewRequest) toOpts() *DescribeMaterializedViewOptions {
@@ -201,6 +213,7 @@ func (r *DescribeMaterializedViewRequest) toOpts() *DescribeMaterializedViewOpti
 }
 
 func (r materializedViewDetailsRow) convert() *MaterializedViewDetails {
+	// generator:protected-begin materializedViewDetailsRow.convert
 	details := &MaterializedViewDetails{
 		Name:       r.Name,
 		Type:       r.Type,
@@ -222,4 +235,8 @@ func (r materializedViewDetailsRow) convert() *MaterializedViewDetails {
 		details.Comment = String(r.Comment.String)
 	}
 	return details
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
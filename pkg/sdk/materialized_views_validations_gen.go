@@ -32,6 +32,8 @@ func (opts *CreateMaterializedViewOptions) validate() error {
 			errs = append(errs, errNotSet("CreateMaterializedViewOptions.ClusterBy", "Expressions"))
 		}
 	}
+	// generator:protected-begin CreateMaterializedViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -61,6 +63,8 @@ func (opts *AlterMaterializedViewOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("AlterMaterializedViewOptions.Unset", "Secure", "Comment"))
 		}
 	}
+	// generator:protected-begin AlterMaterializedViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -72,6 +76,8 @@ func (opts *DropMaterializedViewOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropMaterializedViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -80,6 +86,8 @@ func (opts *ShowMaterializedViewOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowMaterializedViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -91,5 +99,10 @@ func (opts *DescribeMaterializedViewOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeMaterializedViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
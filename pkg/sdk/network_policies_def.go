@@ -8,6 +8,12 @@ var (
 	ip = g.NewQueryStruct("IP").
 		Text("IP", g.KeywordOptions().SingleQuotes().Required())
 
+	allowedNetworkRuleList = g.NewQueryStruct("AllowedNetworkRuleList").
+				List("AllowedNetworkRuleList", "SchemaObjectIdentifier", g.ListOptions().MustParentheses())
+
+	blockedNetworkRuleList = g.NewQueryStruct("BlockedNetworkRuleList").
+				List("BlockedNetworkRuleList", "SchemaObjectIdentifier", g.ListOptions().MustParentheses())
+
 	NetworkPoliciesDef = g.NewInterface(
 		"NetworkPolicies",
 		"NetworkPolicy",
@@ -20,6 +26,8 @@ var (
 				OrReplace().
 				SQL("NETWORK POLICY").
 				Name().
+				ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+				ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
 				ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 				ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
 				OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -35,10 +43,12 @@ var (
 				OptionalQueryStructField(
 					"Set",
 					g.NewQueryStruct("NetworkPolicySet").
+						OptionalQueryStructField("AllowedNetworkRuleList", allowedNetworkRuleList, g.ParameterOptions().SQL("ALLOWED_NETWORK_RULE_LIST")).
+						OptionalQueryStructField("BlockedNetworkRuleList", blockedNetworkRuleList, g.ParameterOptions().SQL("BLOCKED_NETWORK_RULE_LIST")).
 						ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 						ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
 						OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "Comment"),
+						WithValidation(g.AtLeastOneValueSet, "AllowedNetworkRuleList", "BlockedNetworkRuleList", "AllowedIpList", "BlockedIpList", "Comment"),
 					g.KeywordOptions().SQL("SET"),
 				).
 				OptionalSQL("UNSET COMMENT").
@@ -63,17 +73,22 @@ var (
 				Field("name", "string").
 				Field("comment", "string").
 				Field("entries_in_allowed_ip_list", "int").
-				Field("entries_in_blocked_ip_list", "int"),
+				Field("entries_in_blocked_ip_list", "int").
+				Field("entries_in_allowed_network_rules", "int").
+				Field("entries_in_blocked_network_rules", "int"),
 			g.PlainStruct("NetworkPolicy").
 				Field("CreatedOn", "string").
 				Field("Name", "string").
 				Field("Comment", "string").
 				Field("EntriesInAllowedIpList", "int").
-				Field("EntriesInBlockedIpList", "int"),
+				Field("EntriesInBlockedIpList", "int").
+				Field("EntriesInAllowedNetworkRules", "int").
+				Field("EntriesInBlockedNetworkRules", "int"),
 			g.NewQueryStruct("ShowNetworkPolicies").
 				Show().
 				SQL("NETWORK POLICIES"),
 		).
+		ShowByIdOperation().
 		DescribeOperation(
 			g.DescriptionMappingKindSlice,
 			"https://docs.snowflake.com/en/sql-reference/sql/desc-network-policy",
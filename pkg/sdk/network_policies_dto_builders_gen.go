@@ -15,6 +15,16 @@ func (s *CreateNetworkPolicyRequest) WithOrReplace(OrReplace *bool) *CreateNetwo
 	return s
 }
 
+func (s *CreateNetworkPolicyRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *CreateNetworkPolicyRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *CreateNetworkPolicyRequest) WithAllowedIpList(AllowedIpList []IPRequest) *CreateNetworkPolicyRequest {
 	s.AllowedIpList = AllowedIpList
 	return s
@@ -70,6 +80,16 @@ func NewNetworkPolicySetRequest() *NetworkPolicySetRequest {
 	return &NetworkPolicySetRequest{}
 }
 
+func (s *NetworkPolicySetRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList *AllowedNetworkRuleListRequest) *NetworkPolicySetRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicySetRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList *BlockedNetworkRuleListRequest) *NetworkPolicySetRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *NetworkPolicySetRequest) WithAllowedIpList(AllowedIpList []IPRequest) *NetworkPolicySetRequest {
 	s.AllowedIpList = AllowedIpList
 	return s
@@ -85,6 +105,24 @@ func (s *NetworkPolicySetRequest) WithComment(Comment *string) *NetworkPolicySet
 	return s
 }
 
+func NewAllowedNetworkRuleListRequest() *AllowedNetworkRuleListRequest {
+	return &AllowedNetworkRuleListRequest{}
+}
+
+func (s *AllowedNetworkRuleListRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *AllowedNetworkRuleListRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func NewBlockedNetworkRuleListRequest() *BlockedNetworkRuleListRequest {
+	return &BlockedNetworkRuleListRequest{}
+}
+
+func (s *BlockedNetworkRuleListRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *BlockedNetworkRuleListRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func NewDropNetworkPolicyRequest(
 	name AccountObjectIdentifier,
 ) *DropNetworkPolicyRequest {
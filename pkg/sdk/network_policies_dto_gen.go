@@ -11,15 +11,13 @@ var (
 )
 
 type CreateNetworkPolicyRequest struct {
-	OrReplace     *bool
-	name          AccountObjectIdentifier // required
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
-}
-
-func (r *CreateNetworkPolicyRequest) GetName() AccountObjectIdentifier {
-	return r.name
+	OrReplace              *bool
+	name                   AccountObjectIdentifier // required
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	Comment                *string
 }
 
 type IPRequest struct {
@@ -35,9 +33,19 @@ type AlterNetworkPolicyRequest struct {
 }
 
 type NetworkPolicySetRequest struct {
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
+	AllowedNetworkRuleList *AllowedNetworkRuleListRequest
+	BlockedNetworkRuleList *BlockedNetworkRuleListRequest
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	Comment                *string
+}
+
+type AllowedNetworkRuleListRequest struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+}
+
+type BlockedNetworkRuleListRequest struct {
+	BlockedNetworkRuleList []SchemaObjectIdentifier
 }
 
 type DropNetworkPolicyRequest struct {
@@ -45,8 +53,16 @@ type DropNetworkPolicyRequest struct {
 	name     AccountObjectIdentifier // required
 }
 
-type ShowNetworkPolicyRequest struct{}
+type ShowNetworkPolicyRequest struct {
+}
 
 type DescribeNetworkPolicyRequest struct {
 	name AccountObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+func (r *CreateNetworkPolicyRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
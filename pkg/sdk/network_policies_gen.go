@@ -9,17 +9,21 @@ type NetworkPolicies interface {
 	Show(ctx context.Context, request *ShowNetworkPolicyRequest) ([]NetworkPolicy, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NetworkPolicy, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) ([]NetworkPolicyDescription, error)
+	// generator:protected-begin NetworkPolicies methods
+	// generator:protected-end
 }
 
 // CreateNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-network-policy.
 type CreateNetworkPolicyOptions struct {
-	create        bool                    `ddl:"static" sql:"CREATE"`
-	OrReplace     *bool                   `ddl:"keyword" sql:"OR REPLACE"`
-	networkPolicy bool                    `ddl:"static" sql:"NETWORK POLICY"`
-	name          AccountObjectIdentifier `ddl:"identifier"`
-	AllowedIpList []IP                    `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
-	BlockedIpList []IP                    `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
-	Comment       *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                 bool                     `ddl:"static" sql:"CREATE"`
+	OrReplace              *bool                    `ddl:"keyword" sql:"OR REPLACE"`
+	networkPolicy          bool                     `ddl:"static" sql:"NETWORK POLICY"`
+	name                   AccountObjectIdentifier  `ddl:"identifier"`
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"BLOCKED_NETWORK_RULE_LIST"`
+	AllowedIpList          []IP                     `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
+	BlockedIpList          []IP                     `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
+	Comment                *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type IP struct {
@@ -38,9 +42,19 @@ type AlterNetworkPolicyOptions struct {
 }
 
 type NetworkPolicySet struct {
-	AllowedIpList []IP    `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
-	BlockedIpList []IP    `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
-	Comment       *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	AllowedNetworkRuleList *AllowedNetworkRuleList `ddl:"parameter" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList *BlockedNetworkRuleList `ddl:"parameter" sql:"BLOCKED_NETWORK_RULE_LIST"`
+	AllowedIpList          []IP                    `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
+	BlockedIpList          []IP                    `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
+	Comment                *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type AllowedNetworkRuleList struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"list,must_parentheses"`
+}
+
+type BlockedNetworkRuleList struct {
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"list,must_parentheses"`
 }
 
 // DropNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-network-policy.
@@ -58,19 +72,23 @@ type ShowNetworkPolicyOptions struct {
 }
 
 type showNetworkPolicyDBRow struct {
-	CreatedOn              string `db:"created_on"`
-	Name                   string `db:"name"`
-	Comment                string `db:"comment"`
-	EntriesInAllowedIpList int    `db:"entries_in_allowed_ip_list"`
-	EntriesInBlockedIpList int    `db:"entries_in_blocked_ip_list"`
+	CreatedOn                    string `db:"created_on"`
+	Name                         string `db:"name"`
+	Comment                      string `db:"comment"`
+	EntriesInAllowedIpList       int    `db:"entries_in_allowed_ip_list"`
+	EntriesInBlockedIpList       int    `db:"entries_in_blocked_ip_list"`
+	EntriesInAllowedNetworkRules int    `db:"entries_in_allowed_network_rules"`
+	EntriesInBlockedNetworkRules int    `db:"entries_in_blocked_network_rules"`
 }
 
 type NetworkPolicy struct {
-	CreatedOn              string
-	Name                   string
-	Comment                string
-	EntriesInAllowedIpList int
-	EntriesInBlockedIpList int
+	CreatedOn                    string
+	Name                         string
+	Comment                      string
+	EntriesInAllowedIpList       int
+	EntriesInBlockedIpList       int
+	EntriesInAllowedNetworkRules int
+	EntriesInBlockedNetworkRules int
 }
 
 // DescribeNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-network-policy.
@@ -89,3 +107,6 @@ type NetworkPolicyDescription struct {
 	Name  string
 	Value string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
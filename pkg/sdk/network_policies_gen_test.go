@@ -6,15 +6,19 @@ import (
 
 func TestNetworkPolicies_Create(t *testing.T) {
 	id := RandomAccountObjectIdentifier()
+	allowedRuleId := NewSchemaObjectIdentifier("db", "schema", "allowed_rule")
+	blockedRuleId := NewSchemaObjectIdentifier("db", "schema", "blocked_rule")
 
 	// Minimal valid CreateNetworkPolicyOptions
 	defaultOpts := func() *CreateNetworkPolicyOptions {
 		return &CreateNetworkPolicyOptions{
-			OrReplace:     Bool(true),
-			name:          id,
-			AllowedIpList: []IP{{IP: "123.0.0.1"}, {IP: "321.0.0.1"}},
-			BlockedIpList: []IP{{IP: "123.0.0.1"}, {IP: "321.0.0.1"}},
-			Comment:       String("some_comment"),
+			OrReplace:              Bool(true),
+			name:                   id,
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{allowedRuleId},
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{blockedRuleId},
+			AllowedIpList:          []IP{{IP: "123.0.0.1"}, {IP: "321.0.0.1"}},
+			BlockedIpList:          []IP{{IP: "123.0.0.1"}, {IP: "321.0.0.1"}},
+			Comment:                String("some_comment"),
 		}
 	}
 
@@ -31,6 +35,13 @@ func TestNetworkPolicies_Create(t *testing.T) {
 
 	t.Run("all options", func(t *testing.T) {
 		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_NETWORK_RULE_LIST = (%s) BLOCKED_NETWORK_RULE_LIST = (%s) ALLOWED_IP_LIST = ('123.0.0.1', '321.0.0.1') BLOCKED_IP_LIST = ('123.0.0.1', '321.0.0.1') COMMENT = 'some_comment'", opts.name.FullyQualifiedName(), allowedRuleId.FullyQualifiedName(), blockedRuleId.FullyQualifiedName())
+	})
+
+	t.Run("without network rule lists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AllowedNetworkRuleList = nil
+		opts.BlockedNetworkRuleList = []SchemaObjectIdentifier{}
 		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_IP_LIST = ('123.0.0.1', '321.0.0.1') BLOCKED_IP_LIST = ('123.0.0.1', '321.0.0.1') COMMENT = 'some_comment'", opts.name.FullyQualifiedName())
 	})
 }
@@ -63,10 +74,30 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetComment", "RenameTo"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.AllowedNetworkRuleList opts.Set.BlockedNetworkRuleList opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &NetworkPolicySet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "AllowedIpList", "BlockedIpList", "Comment"))
+	})
+
+	t.Run("set allowed network rule list", func(t *testing.T) {
+		opts := defaultOpts()
+		ruleId := NewSchemaObjectIdentifier("db", "schema", "rule")
+		otherRuleId := NewSchemaObjectIdentifier("db", "schema", "other_rule")
+		opts.Set = &NetworkPolicySet{
+			AllowedNetworkRuleList: &AllowedNetworkRuleList{
+				AllowedNetworkRuleList: []SchemaObjectIdentifier{ruleId, otherRuleId},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET ALLOWED_NETWORK_RULE_LIST = (%s, %s)", id.FullyQualifiedName(), ruleId.FullyQualifiedName(), otherRuleId.FullyQualifiedName())
+	})
+
+	t.Run("set empty blocked network rule list", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &NetworkPolicySet{
+			BlockedNetworkRuleList: &BlockedNetworkRuleList{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET BLOCKED_NETWORK_RULE_LIST = ()", id.FullyQualifiedName())
 	})
 
 	t.Run("set allowed ip list", func(t *testing.T) {
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ NetworkPolicies = (*networkPolicies)(nil)
 
@@ -38,43 +34,50 @@ func (v *networkPolicies) Show(ctx context.Context, request *ShowNetworkPolicyRe
 }
 
 func (v *networkPolicies) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NetworkPolicy, error) {
-	networkPolicies, err := v.Show(ctx, NewShowNetworkPolicyRequest())
+	// generator:protected-begin networkPolicies.ShowByID
+	request := NewShowNetworkPolicyRequest()
+	networkPolicies, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-
-	return collections.FindOne(networkPolicies, func(r NetworkPolicy) bool { return r.Name == id.Name() })
+	return findOne(networkPolicies, func(r NetworkPolicy) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *networkPolicies) Describe(ctx context.Context, id AccountObjectIdentifier) ([]NetworkPolicyDescription, error) {
 	opts := &DescribeNetworkPolicyOptions{
 		name: id,
 	}
-	s, err := validateAndQuery[describeNetworkPolicyDBRow](v.client, ctx, opts)
+	rows, err := validateAndQuery[describeNetworkPolicyDBRow](v.client, ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	return convertRows[describeNetworkPolicyDBRow, NetworkPolicyDescription](s), nil
+	return convertRows[describeNetworkPolicyDBRow, NetworkPolicyDescription](rows), nil
 }
 
 func (r *CreateNetworkPolicyRequest) toOpts() *CreateNetworkPolicyOptions {
 	opts := &CreateNetworkPolicyOptions{
-		OrReplace: r.OrReplace,
-		name:      r.name,
-
-		Comment: r.Comment,
+		OrReplace:              r.OrReplace,
+		name:                   r.name,
+		AllowedNetworkRuleList: r.AllowedNetworkRuleList,
+		BlockedNetworkRuleList: r.BlockedNetworkRuleList,
+		Comment:                r.Comment,
 	}
 	if r.AllowedIpList != nil {
 		s := make([]IP, len(r.AllowedIpList))
 		for i, v := range r.AllowedIpList {
-			s[i] = IP(v)
+			s[i] = IP{
+				IP: v.IP,
+			}
 		}
 		opts.AllowedIpList = s
 	}
 	if r.BlockedIpList != nil {
 		s := make([]IP, len(r.BlockedIpList))
 		for i, v := range r.BlockedIpList {
-			s[i] = IP(v)
+			s[i] = IP{
+				IP: v.IP,
+			}
 		}
 		opts.BlockedIpList = s
 	}
@@ -83,9 +86,8 @@ func (r *CreateNetworkPolicyRequest) toOpts() *CreateNetworkPolicyOptions {
 
 func (r *AlterNetworkPolicyRequest) toOpts() *AlterNetworkPolicyOptions {
 	opts := &AlterNetworkPolicyOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
+		IfExists:     r.IfExists,
+		name:         r.name,
 		UnsetComment: r.UnsetComment,
 		RenameTo:     r.RenameTo,
 	}
@@ -93,17 +95,31 @@ func (r *AlterNetworkPolicyRequest) toOpts() *AlterNetworkPolicyOptions {
 		opts.Set = &NetworkPolicySet{
 			Comment: r.Set.Comment,
 		}
+		if r.Set.AllowedNetworkRuleList != nil {
+			opts.Set.AllowedNetworkRuleList = &AllowedNetworkRuleList{
+				AllowedNetworkRuleList: r.Set.AllowedNetworkRuleList.AllowedNetworkRuleList,
+			}
+		}
+		if r.Set.BlockedNetworkRuleList != nil {
+			opts.Set.BlockedNetworkRuleList = &BlockedNetworkRuleList{
+				BlockedNetworkRuleList: r.Set.BlockedNetworkRuleList.BlockedNetworkRuleList,
+			}
+		}
 		if r.Set.AllowedIpList != nil {
 			s := make([]IP, len(r.Set.AllowedIpList))
 			for i, v := range r.Set.AllowedIpList {
-				s[i] = IP(v)
+				s[i] = IP{
+					IP: v.IP,
+				}
 			}
 			opts.Set.AllowedIpList = s
 		}
 		if r.Set.BlockedIpList != nil {
 			s := make([]IP, len(r.Set.BlockedIpList))
 			for i, v := range r.Set.BlockedIpList {
-				s[i] = IP(v)
+				s[i] = IP{
+					IP: v.IP,
+				}
 			}
 			opts.Set.BlockedIpList = s
 		}
@@ -125,13 +141,17 @@ func (r *ShowNetworkPolicyRequest) toOpts() *ShowNetworkPolicyOptions {
 }
 
 func (r showNetworkPolicyDBRow) convert() *NetworkPolicy {
+	// generator:protected-begin showNetworkPolicyDBRow.convert
 	return &NetworkPolicy{
-		CreatedOn:              r.CreatedOn,
-		Name:                   r.Name,
-		Comment:                r.Comment,
-		EntriesInAllowedIpList: r.EntriesInAllowedIpList,
-		EntriesInBlockedIpList: r.EntriesInBlockedIpList,
+		CreatedOn:                    r.CreatedOn,
+		Name:                         r.Name,
+		Comment:                      r.Comment,
+		EntriesInAllowedIpList:       r.EntriesInAllowedIpList,
+		EntriesInBlockedIpList:       r.EntriesInBlockedIpList,
+		EntriesInAllowedNetworkRules: r.EntriesInAllowedNetworkRules,
+		EntriesInBlockedNetworkRules: r.EntriesInBlockedNetworkRules,
 	}
+	// generator:protected-end
 }
 
 func (r *DescribeNetworkPolicyRequest) toOpts() *DescribeNetworkPolicyOptions {
@@ -142,8 +162,13 @@ func (r *DescribeNetworkPolicyRequest) toOpts() *DescribeNetworkPolicyOptions {
 }
 
 func (r describeNetworkPolicyDBRow) convert() *NetworkPolicyDescription {
+	// generator:protected-begin describeNetworkPolicyDBRow.convert
 	return &NetworkPolicyDescription{
 		Name:  r.Name,
 		Value: r.Value,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,7 +1,5 @@
 package sdk
 
-import "errors"
-
 var (
 	_ validatable = new(CreateNetworkPolicyOptions)
 	_ validatable = new(AlterNetworkPolicyOptions)
@@ -12,63 +10,76 @@ var (
 
 func (opts *CreateNetworkPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateNetworkPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterNetworkPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.RenameTo); !ok {
+	if !exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.RenameTo) {
 		errs = append(errs, errExactlyOneOf("AlterNetworkPolicyOptions", "Set", "UnsetComment", "RenameTo"))
 	}
-	if valueSet(opts.RenameTo) && !ValidObjectIdentifier(opts.RenameTo) {
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedIpList", "BlockedIpList", "Comment"))
+		if !anyValueSet(opts.Set.AllowedNetworkRuleList, opts.Set.BlockedNetworkRuleList, opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterNetworkPolicyOptions.Set", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "AllowedIpList", "BlockedIpList", "Comment"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin AlterNetworkPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DropNetworkPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DropNetworkPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *ShowNetworkPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
-	return errors.Join(errs...)
+	// generator:protected-begin ShowNetworkPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DescribeNetworkPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DescribeNetworkPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -4,28 +4,20 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
-type NetworkRuleType string
+var networkRuleTypeEnum = g.NewEnum("NetworkRuleType").
+	WithValue("NetworkRuleTypeIpv4", "IPV4").
+	WithValue("NetworkRuleTypeAwsVpcEndpointId", "AWSVPCEID").
+	WithValue("NetworkRuleTypeAzureLinkId", "AZURELINKID").
+	WithValue("NetworkRuleTypeHostPort", "HOST_PORT")
 
-const (
-	NetworkRuleTypeIpv4             NetworkRuleType = "IPV4"
-	NetworkRuleTypeAwsVpcEndpointId NetworkRuleType = "AWSVPCEID"
-	NetworkRuleTypeAzureLinkId      NetworkRuleType = "AZURELINKID"
-	NetworkRuleTypeHostPort         NetworkRuleType = "HOST_PORT"
-)
-
-type NetworkRuleMode string
-
-const (
-	NetworkRuleModeIngress       NetworkRuleMode = "INGRESS"
-	NetworkRuleModeInternalStage NetworkRuleMode = "INTERNAL_STAGE"
-	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
-)
+var networkRuleModeEnum = g.NewEnum("NetworkRuleMode", "INGRESS", "INTERNAL_STAGE", "EGRESS")
 
 var NetworkRuleDef = g.NewInterface(
 	"NetworkRules",
 	"NetworkRule",
 	g.KindOfT[SchemaObjectIdentifier](),
 ).
+	WithEnums(networkRuleTypeEnum, networkRuleModeEnum).
 	CreateOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/create-network-rule",
 		g.NewQueryStruct("CreateNetworkRule").
@@ -33,9 +25,9 @@ var NetworkRuleDef = g.NewInterface(
 			OrReplace().
 			SQL("NETWORK RULE").
 			Name().
-			Assignment("TYPE", g.KindOfT[NetworkRuleType](), g.ParameterOptions().Required().NoQuotes()).
+			Assignment("TYPE", networkRuleTypeEnum.Kind(), g.ParameterOptions().Required().NoQuotes()).
 			ListAssignment("VALUE_LIST", "NetworkRuleValue", g.ParameterOptions().Required().Parentheses()).
-			Assignment("MODE", g.KindOfT[NetworkRuleMode](), g.ParameterOptions().Required().NoQuotes()).
+			Assignment("MODE", networkRuleModeEnum.Kind(), g.ParameterOptions().Required().NoQuotes()).
 			OptionalComment().
 			WithValidation(g.ValidIdentifier, "name"),
 		g.NewQueryStruct("NetworkRuleValue").
@@ -96,8 +88,8 @@ var NetworkRuleDef = g.NewInterface(
 			Text("SchemaName").
 			Text("Owner").
 			Text("Comment").
-			Text("Type").
-			Text("Mode").
+			Field("Type", networkRuleTypeEnum.Kind()).
+			Field("Mode", networkRuleModeEnum.Kind()).
 			Number("EntriesInValueList").
 			Text("OwnerRoleType"),
 		g.NewQueryStruct("ShowNetworkRules").
@@ -108,7 +100,7 @@ var NetworkRuleDef = g.NewInterface(
 			OptionalStartsWith().
 			OptionalLimitFrom(),
 	).
-	ShowByIdOperation().
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
 	DescribeOperation(
 		g.DescriptionMappingKindSingleValue,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-network-rule",
@@ -129,8 +121,8 @@ var NetworkRuleDef = g.NewInterface(
 			Text("SchemaName").
 			Text("Owner").
 			Text("Comment").
-			Text("Type").
-			Text("Mode").
+			Field("Type", networkRuleTypeEnum.Kind()).
+			Field("Mode", networkRuleModeEnum.Kind()).
 			Field("ValueList", "[]string"),
 		g.NewQueryStruct("ShowNetworkRules").
 			Describe().
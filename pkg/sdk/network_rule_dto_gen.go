@@ -51,3 +51,6 @@ type ShowNetworkRuleRequest struct {
 type DescribeNetworkRuleRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -12,6 +14,66 @@ type NetworkRules interface {
 	Show(ctx context.Context, request *ShowNetworkRuleRequest) ([]NetworkRule, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error)
+	// generator:protected-begin NetworkRules methods
+	// generator:protected-end
+}
+
+type NetworkRuleType string
+
+const (
+	NetworkRuleTypeIpv4             NetworkRuleType = "IPV4"
+	NetworkRuleTypeAwsVpcEndpointId NetworkRuleType = "AWSVPCEID"
+	NetworkRuleTypeAzureLinkId      NetworkRuleType = "AZURELINKID"
+	NetworkRuleTypeHostPort         NetworkRuleType = "HOST_PORT"
+)
+
+var AllNetworkRuleTypes = []NetworkRuleType{
+	NetworkRuleTypeIpv4,
+	NetworkRuleTypeAwsVpcEndpointId,
+	NetworkRuleTypeAzureLinkId,
+	NetworkRuleTypeHostPort,
+}
+
+func ToNetworkRuleType(s string) (NetworkRuleType, error) {
+	switch strings.ToUpper(s) {
+	case "IPV4":
+		return NetworkRuleTypeIpv4, nil
+	case "AWSVPCEID":
+		return NetworkRuleTypeAwsVpcEndpointId, nil
+	case "AZURELINKID":
+		return NetworkRuleTypeAzureLinkId, nil
+	case "HOST_PORT":
+		return NetworkRuleTypeHostPort, nil
+	default:
+		return "", fmt.Errorf("invalid NetworkRuleType: %s", s)
+	}
+}
+
+type NetworkRuleMode string
+
+const (
+	NetworkRuleModeIngress       NetworkRuleMode = "INGRESS"
+	NetworkRuleModeInternalStage NetworkRuleMode = "INTERNAL_STAGE"
+	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
+)
+
+var AllNetworkRuleModes = []NetworkRuleMode{
+	NetworkRuleModeIngress,
+	NetworkRuleModeInternalStage,
+	NetworkRuleModeEgress,
+}
+
+func ToNetworkRuleMode(s string) (NetworkRuleMode, error) {
+	switch strings.ToUpper(s) {
+	case "INGRESS":
+		return NetworkRuleModeIngress, nil
+	case "INTERNAL_STAGE":
+		return NetworkRuleModeInternalStage, nil
+	case "EGRESS":
+		return NetworkRuleModeEgress, nil
+	default:
+		return "", fmt.Errorf("invalid NetworkRuleMode: %s", s)
+	}
 }
 
 // CreateNetworkRuleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-network-rule.
@@ -77,7 +139,7 @@ type ShowNetworkRulesRow struct {
 	Comment            string    `db:"comment"`
 	Type               string    `db:"type"`
 	Mode               string    `db:"mode"`
-	EntriesInValueList int       `db:"entries_in_valuelist"`
+	EntriesInValuelist int       `db:"entries_in_valuelist"`
 	OwnerRoleType      string    `db:"owner_role_type"`
 }
 
@@ -124,3 +186,6 @@ type NetworkRuleDetails struct {
 	Mode         NetworkRuleMode
 	ValueList    []string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -3,8 +3,6 @@ package sdk
 import (
 	"context"
 	"strings"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 )
 
 var _ NetworkRules = (*networkRules)(nil)
@@ -39,15 +37,14 @@ func (v *networkRules) Show(ctx context.Context, request *ShowNetworkRuleRequest
 }
 
 func (v *networkRules) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error) {
-	networkRules, err := v.Show(ctx, NewShowNetworkRuleRequest().WithIn(&In{
-		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
-	}).WithLike(&Like{
-		Pattern: String(id.Name()),
-	}))
+	// generator:protected-begin networkRules.ShowByID
+	request := NewShowNetworkRuleRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
+	networkRules, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(networkRules, func(r NetworkRule) bool { return r.Name == id.Name() })
+	return findOne(networkRules, func(r NetworkRule) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *networkRules) Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error) {
@@ -111,19 +108,21 @@ func (r *ShowNetworkRuleRequest) toOpts() *ShowNetworkRuleOptions {
 	return opts
 }
 
-func (row ShowNetworkRulesRow) convert() *NetworkRule {
+func (r ShowNetworkRulesRow) convert() *NetworkRule {
+	// generator:protected-begin ShowNetworkRulesRow.convert
 	return &NetworkRule{
-		CreatedOn:          row.CreatedOn,
-		Name:               row.Name,
-		DatabaseName:       row.DatabaseName,
-		SchemaName:         row.SchemaName,
-		Owner:              row.Owner,
-		Comment:            row.Comment,
-		Type:               NetworkRuleType(row.Type),
-		Mode:               NetworkRuleMode(row.Mode),
-		EntriesInValueList: row.EntriesInValueList,
-		OwnerRoleType:      row.OwnerRoleType,
+		CreatedOn:          r.CreatedOn,
+		Name:               r.Name,
+		DatabaseName:       r.DatabaseName,
+		SchemaName:         r.SchemaName,
+		Owner:              r.Owner,
+		Comment:            r.Comment,
+		Type:               NetworkRuleType(r.Type),
+		Mode:               NetworkRuleMode(r.Mode),
+		EntriesInValueList: r.EntriesInValuelist,
+		OwnerRoleType:      r.OwnerRoleType,
 	}
+	// generator:protected-end
 }
 
 func (r *DescribeNetworkRuleRequest) toOpts() *DescribeNetworkRuleOptions {
@@ -133,20 +132,25 @@ func (r *DescribeNetworkRuleRequest) toOpts() *DescribeNetworkRuleOptions {
 	return opts
 }
 
-func (row DescNetworkRulesRow) convert() *NetworkRuleDetails {
-	valueList := strings.Split(row.ValueList, ",")
+func (r DescNetworkRulesRow) convert() *NetworkRuleDetails {
+	// generator:protected-begin DescNetworkRulesRow.convert
+	valueList := strings.Split(r.ValueList, ",")
 	if len(valueList) == 1 && valueList[0] == "" {
 		valueList = []string{}
 	}
 	return &NetworkRuleDetails{
-		CreatedOn:    row.CreatedOn,
-		Name:         row.Name,
-		DatabaseName: row.DatabaseName,
-		SchemaName:   row.SchemaName,
-		Owner:        row.Owner,
-		Comment:      row.Comment,
-		Type:         NetworkRuleType(row.Type),
-		Mode:         NetworkRuleMode(row.Mode),
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+		Comment:      r.Comment,
+		Type:         NetworkRuleType(r.Type),
+		Mode:         NetworkRuleMode(r.Mode),
 		ValueList:    valueList,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
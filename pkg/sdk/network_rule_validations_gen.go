@@ -16,6 +16,8 @@ func (opts *CreateNetworkRuleOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CreateNetworkRuleOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -40,6 +42,8 @@ func (opts *AlterNetworkRuleOptions) validate() error {
 			errs = append(errs, errAtLeastOneOf("AlterNetworkRuleOptions.Unset", "ValueList", "Comment"))
 		}
 	}
+	// generator:protected-begin AlterNetworkRuleOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -51,6 +55,8 @@ func (opts *DropNetworkRuleOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropNetworkRuleOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -59,6 +65,8 @@ func (opts *ShowNetworkRuleOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowNetworkRuleOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -70,5 +78,10 @@ func (opts *DescribeNetworkRuleOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeNetworkRuleOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
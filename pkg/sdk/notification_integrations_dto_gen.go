@@ -21,10 +21,6 @@ type CreateNotificationIntegrationRequest struct {
 	Comment                  *string
 }
 
-func (r *CreateNotificationIntegrationRequest) GetName() AccountObjectIdentifier {
-	return r.name
-}
-
 type AutomatedDataLoadsParamsRequest struct {
 	GoogleAutoParams *GoogleAutoParamsRequest
 	AzureAutoParams  *AzureAutoParamsRequest
@@ -120,3 +116,10 @@ type ShowNotificationIntegrationRequest struct {
 type DescribeNotificationIntegrationRequest struct {
 	name AccountObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+func (r *CreateNotificationIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
@@ -13,6 +13,8 @@ type NotificationIntegrations interface {
 	Show(ctx context.Context, request *ShowNotificationIntegrationRequest) ([]NotificationIntegration, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegration, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) ([]NotificationIntegrationProperty, error)
+	// generator:protected-begin NotificationIntegrations methods
+	// generator:protected-end
 }
 
 // CreateNotificationIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration.
@@ -161,10 +163,6 @@ type NotificationIntegration struct {
 	CreatedOn        time.Time
 }
 
-func (v *NotificationIntegration) ID() AccountObjectIdentifier {
-	return NewAccountObjectIdentifier(v.Name)
-}
-
 // DescribeNotificationIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-integration.
 type DescribeNotificationIntegrationOptions struct {
 	describe                bool                    `ddl:"static" sql:"DESCRIBE"`
@@ -185,3 +183,10 @@ type NotificationIntegrationProperty struct {
 	Value   string
 	Default string
 }
+
+// generator:protected-begin declarations
+func (v *NotificationIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// generator:protected-end
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ NotificationIntegrations = (*notificationIntegrations)(nil)
 
@@ -38,13 +34,14 @@ func (v *notificationIntegrations) Show(ctx context.Context, request *ShowNotifi
 }
 
 func (v *notificationIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegration, error) {
-	notificationIntegrations, err := v.Show(ctx, NewShowNotificationIntegrationRequest().WithLike(&Like{
-		Pattern: String(id.Name()),
-	}))
+	// generator:protected-begin notificationIntegrations.ShowByID
+	request := NewShowNotificationIntegrationRequest().WithLike(&Like{Pattern: String(id.Name())})
+	notificationIntegrations, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(notificationIntegrations, func(r NotificationIntegration) bool { return r.Name == id.Name() })
+	return findOne(notificationIntegrations, func(r NotificationIntegration) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *notificationIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]NotificationIntegrationProperty, error) {
@@ -64,8 +61,7 @@ func (r *CreateNotificationIntegrationRequest) toOpts() *CreateNotificationInteg
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
 		Enabled:     r.Enabled,
-
-		Comment: r.Comment,
+		Comment:     r.Comment,
 	}
 	if r.AutomatedDataLoadsParams != nil {
 		opts.AutomatedDataLoadsParams = &AutomatedDataLoadsParams{}
@@ -111,16 +107,14 @@ func (r *CreateNotificationIntegrationRequest) toOpts() *CreateNotificationInteg
 
 func (r *AlterNotificationIntegrationRequest) toOpts() *AlterNotificationIntegrationOptions {
 	opts := &AlterNotificationIntegrationOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
 	if r.Set != nil {
 		opts.Set = &NotificationIntegrationSet{
 			Enabled: r.Set.Enabled,
-
 			Comment: r.Set.Comment,
 		}
 		if r.Set.SetPushParams != nil {
@@ -174,6 +168,7 @@ func (r *ShowNotificationIntegrationRequest) toOpts() *ShowNotificationIntegrati
 }
 
 func (r showNotificationIntegrationsDbRow) convert() *NotificationIntegration {
+	// generator:protected-begin showNotificationIntegrationsDbRow.convert
 	s := &NotificationIntegration{
 		Name:             r.Name,
 		NotificationType: r.Type,
@@ -185,6 +180,7 @@ func (r showNotificationIntegrationsDbRow) convert() *NotificationIntegration {
 		s.Comment = r.Comment.String
 	}
 	return s
+	// generator:protected-end
 }
 
 func (r *DescribeNotificationIntegrationRequest) toOpts() *DescribeNotificationIntegrationOptions {
@@ -195,10 +191,15 @@ func (r *DescribeNotificationIntegrationRequest) toOpts() *DescribeNotificationI
 }
 
 func (r descNotificationIntegrationsDbRow) convert() *NotificationIntegrationProperty {
+	// generator:protected-begin descNotificationIntegrationsDbRow.convert
 	return &NotificationIntegrationProperty{
 		Name:    r.Property,
 		Type:    r.PropertyType,
 		Value:   r.PropertyValue,
 		Default: r.PropertyDefault,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
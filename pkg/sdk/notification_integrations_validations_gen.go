@@ -32,6 +32,8 @@ func (opts *CreateNotificationIntegrationOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("CreateNotificationIntegrationOptions.PushNotificationParams", "AmazonPushParams", "GooglePushParams", "AzurePushParams"))
 		}
 	}
+	// generator:protected-begin CreateNotificationIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -69,6 +71,8 @@ func (opts *AlterNotificationIntegrationOptions) validate() error {
 			errs = append(errs, errAtLeastOneOf("AlterNotificationIntegrationOptions.UnsetEmailParams", "AllowedRecipients", "Comment"))
 		}
 	}
+	// generator:protected-begin AlterNotificationIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -80,6 +84,8 @@ func (opts *DropNotificationIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropNotificationIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -88,6 +94,8 @@ func (opts *ShowNotificationIntegrationOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowNotificationIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -99,5 +107,10 @@ func (opts *DescribeNotificationIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeNotificationIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -71,7 +71,23 @@ func (parameters *parameters) SetAccountParameter(ctx context.Context, parameter
 		}
 		opts.Set.Parameters.AccountParameters.AllowIDToken = b
 	case AccountParameterEventTable:
-		opts.Set.Parameters.AccountParameters.EventTable = &value
+		// the default (no event table) can't be set explicitly, so the parameter has to be unset
+		if value == "" {
+			return parameters.client.Accounts.Alter(ctx, &AlterAccountOptions{
+				Unset: &AccountUnset{
+					Parameters: &AccountLevelParametersUnset{
+						AccountParameters: &AccountParametersUnset{
+							EventTable: Bool(true),
+						},
+					},
+				},
+			})
+		}
+		id, err := ParseSchemaObjectIdentifier(value)
+		if err != nil {
+			return fmt.Errorf("EVENT_TABLE account parameter is a fully qualified event table name, got %v: %w", value, err)
+		}
+		opts.Set.Parameters.AccountParameters.EventTable = String(id.FullyQualifiedName())
 	case AccountParameterEnableUnredactedQuerySyntaxError:
 		b, err := parseBooleanParameter(string(parameter), value)
 		if err != nil {
@@ -509,7 +525,7 @@ type AccountParameters struct {
 	ClientEncryptionKeySize                      *int     `ddl:"parameter" sql:"CLIENT_ENCRYPTION_KEY_SIZE"`
 	EnableInternalStagesPrivatelink              *bool    `ddl:"parameter" sql:"ENABLE_INTERNAL_STAGES_PRIVATELINK"`
 	EnableUnredactedQuerySyntaxError             *bool    `ddl:"parameter" sql:"ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR"`
-	EventTable                                   *string  `ddl:"parameter,single_quotes" sql:"EVENT_TABLE"`
+	EventTable                                   *string  `ddl:"parameter" sql:"EVENT_TABLE"`
 	ExternalOAuthAddPrivilegedRolesToBlockedList *bool    `ddl:"parameter" sql:"EXTERNAL_OAUTH_ADD_PRIVILEGED_ROLES_TO_BLOCKED_LIST"`
 	InitialReplicationSizeLimitInTB              *float64 `ddl:"parameter" sql:"INITIAL_REPLICATION_SIZE_LIMIT_IN_TB"`
 	MinDataRetentionTimeInDays                   *int     `ddl:"parameter" sql:"MIN_DATA_RETENTION_TIME_IN_DAYS"`
@@ -873,6 +889,7 @@ const (
 	ParameterTypeUser    ParameterType = "USER"
 	ParameterTypeSession ParameterType = "SESSION"
 	ParameterTypeObject  ParameterType = "OBJECT"
+	ParameterTypeTable   ParameterType = "TABLE"
 )
 
 type Parameter struct {
@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -15,3 +17,28 @@ func ParseTimestampWithOffset(s string, dateTimeFormat string) (string, error) {
 	adjustedTimeFormat := adjustedTime.Format(dateTimeFormat)
 	return adjustedTimeFormat, nil
 }
+
+type networkRuleListEntry struct {
+	FullyQualifiedRuleName string `json:"fullyQualifiedRuleName"`
+}
+
+// ParseNetworkRuleList parses the value of ALLOWED_NETWORK_RULE_LIST and BLOCKED_NETWORK_RULE_LIST properties
+// returned by DESCRIBE NETWORK POLICY, e.g. [{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"}].
+func ParseNetworkRuleList(value string) ([]SchemaObjectIdentifier, error) {
+	if value == "" {
+		return []SchemaObjectIdentifier{}, nil
+	}
+	var entries []networkRuleListEntry
+	if err := json.Unmarshal([]byte(value), &entries); err != nil {
+		return nil, fmt.Errorf("unable to parse network rule list %s: %w", value, err)
+	}
+	ids := make([]SchemaObjectIdentifier, len(entries))
+	for i, entry := range entries {
+		id, err := ParseSchemaObjectIdentifier(entry.FullyQualifiedRuleName)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
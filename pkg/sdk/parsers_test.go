@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworkRuleList(t *testing.T) {
+	t.Run("empty value", func(t *testing.T) {
+		ids, err := ParseNetworkRuleList("")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		ids, err := ParseNetworkRuleList("[]")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("multiple rules", func(t *testing.T) {
+		ids, err := ParseNetworkRuleList(`[{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"},{"fullyQualifiedRuleName":"\"db\".\"schema\".\"other.rule\""}]`)
+		require.NoError(t, err)
+		assert.Equal(t, []SchemaObjectIdentifier{
+			NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE"),
+			NewSchemaObjectIdentifier("db", "schema", "other.rule"),
+		}, ids)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseNetworkRuleList("DB.SCHEMA.RULE")
+		assert.ErrorContains(t, err, "unable to parse network rule list")
+	})
+
+	t.Run("invalid rule name", func(t *testing.T) {
+		_, err := ParseNetworkRuleList(`[{"fullyQualifiedRuleName":"SCHEMA.RULE"}]`)
+		assert.Error(t, err)
+	})
+}
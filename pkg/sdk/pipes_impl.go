@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Pipes = (*pipes)(nil)
 
@@ -56,7 +52,7 @@ func (v *pipes) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Pipe,
 		return nil, err
 	}
 
-	return collections.FindOne(pipes, func(p Pipe) bool { return p.ID().name == id.Name() })
+	return findOne(pipes, func(p Pipe) bool { return p.ID().name == id.Name() })
 }
 
 func (v *pipes) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Pipe, error) {
@@ -7,6 +7,8 @@ import (
 //go:generate go run ../main.go
 
 var (
+	databaseRoleOwnerRoleType = g.NewEnum("DatabaseRoleOwnerRoleType", "ROLE", "DATABASE_ROLE")
+
 	dbRoleRename = g.NewQueryStruct("DatabaseRoleRename").
 		// Fields
 		Identifier("Name", g.KindOfT[DatabaseObjectIdentifier](), g.IdentifierOptions().Required()).
@@ -30,11 +32,12 @@ var (
 		// Validations
 		WithValidation(g.AtLeastOneValueSet, "Comment")
 
-	DatabaseRole = g.NewInterface(
+	DatabaseRoleDef = g.NewInterface(
 		"DatabaseRoles",
 		"DatabaseRole",
 		"DatabaseObjectIdentifier",
 	).
+		WithEnums(databaseRoleOwnerRoleType).
 		CreateOperation(
 			"https://docs.snowflake.com/en/sql-reference/sql/create-database-role",
 			g.NewQueryStruct("CreateDatabaseRole").
@@ -63,5 +66,38 @@ var (
 				// Validations
 				WithValidation(g.ValidIdentifier, "name").
 				WithValidation(g.ExactlyOneValueSet, "Rename", "Set", "Unset"),
-		)
+		).
+		ShowOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/show-database-roles",
+			g.DbStruct("databaseRoleDBRow").
+				Field("created_on", "string").
+				Field("name", "string").
+				Field("is_default", "string").
+				Field("is_current", "string").
+				Field("is_inherited", "string").
+				Field("granted_to_roles", "sql.NullInt64").
+				Field("granted_to_database_roles", "sql.NullInt64").
+				Field("granted_database_roles", "sql.NullInt64").
+				Field("owner", "string").
+				Field("comment", "sql.NullString").
+				Field("owner_role_type", "string"),
+			g.PlainStruct("DatabaseRole").
+				Field("CreatedOn", "string").
+				Field("Name", "string").
+				Field("IsDefault", "string").
+				Field("IsCurrent", "string").
+				Field("IsInherited", "string").
+				Field("GrantedToRoles", "int").
+				Field("GrantedToDatabaseRoles", "int").
+				Field("GrantedDatabaseRoles", "int").
+				Field("Owner", "string").
+				Field("Comment", "*string").
+				Field("OwnerRoleType", databaseRoleOwnerRoleType.Kind()),
+			g.NewQueryStruct("ShowDatabaseRoles").
+				Show().
+				SQL("DATABASE ROLES").
+				OptionalLike().
+				OptionalIn(),
+		).
+		ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering, g.ShowByIDInFiltering)
 )
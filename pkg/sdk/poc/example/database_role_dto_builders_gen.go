@@ -2,6 +2,8 @@
 
 package example
 
+import ()
+
 func NewCreateDatabaseRoleRequest(
 	name DatabaseObjectIdentifier,
 ) *CreateDatabaseRoleRequest {
@@ -90,3 +92,17 @@ func (s *DatabaseRoleUnsetRequest) WithComment(Comment *bool) *DatabaseRoleUnset
 	s.Comment = Comment
 	return s
 }
+
+func NewShowDatabaseRoleRequest() *ShowDatabaseRoleRequest {
+	return &ShowDatabaseRoleRequest{}
+}
+
+func (s *ShowDatabaseRoleRequest) WithLike(Like *Like) *ShowDatabaseRoleRequest {
+	s.Like = Like
+	return s
+}
+
+func (s *ShowDatabaseRoleRequest) WithIn(In *In) *ShowDatabaseRoleRequest {
+	s.In = In
+	return s
+}
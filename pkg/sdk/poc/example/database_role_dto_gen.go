@@ -1,10 +1,11 @@
 package example
 
-//go:generate go run ./../../dto-builder-generator/main.go
+//go:generate go run ../../dto-builder-generator/main.go
 
 var (
 	_ optionsProvider[CreateDatabaseRoleOptions] = new(CreateDatabaseRoleRequest)
 	_ optionsProvider[AlterDatabaseRoleOptions]  = new(AlterDatabaseRoleRequest)
+	_ optionsProvider[ShowDatabaseRoleOptions]   = new(ShowDatabaseRoleRequest)
 )
 
 type CreateDatabaseRoleRequest struct {
@@ -38,3 +39,11 @@ type NestedThirdLevelRequest struct {
 type DatabaseRoleUnsetRequest struct {
 	Comment *bool
 }
+
+type ShowDatabaseRoleRequest struct {
+	Like *Like
+	In   *In
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,10 +1,42 @@
 package example
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
 
 type DatabaseRoles interface {
 	Create(ctx context.Context, request *CreateDatabaseRoleRequest) error
 	Alter(ctx context.Context, request *AlterDatabaseRoleRequest) error
+	Show(ctx context.Context, request *ShowDatabaseRoleRequest) ([]DatabaseRole, error)
+	ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error)
+	// generator:protected-begin DatabaseRoles methods
+	// generator:protected-end
+}
+
+type DatabaseRoleOwnerRoleType string
+
+const (
+	DatabaseRoleOwnerRoleTypeRole         DatabaseRoleOwnerRoleType = "ROLE"
+	DatabaseRoleOwnerRoleTypeDatabaseRole DatabaseRoleOwnerRoleType = "DATABASE_ROLE"
+)
+
+var AllDatabaseRoleOwnerRoleTypes = []DatabaseRoleOwnerRoleType{
+	DatabaseRoleOwnerRoleTypeRole,
+	DatabaseRoleOwnerRoleTypeDatabaseRole,
+}
+
+func ToDatabaseRoleOwnerRoleType(s string) (DatabaseRoleOwnerRoleType, error) {
+	switch strings.ToUpper(s) {
+	case "ROLE":
+		return DatabaseRoleOwnerRoleTypeRole, nil
+	case "DATABASE_ROLE":
+		return DatabaseRoleOwnerRoleTypeDatabaseRole, nil
+	default:
+		return "", fmt.Errorf("invalid DatabaseRoleOwnerRoleType: %s", s)
+	}
 }
 
 // CreateDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-database-role.
@@ -44,3 +76,42 @@ type NestedThirdLevel struct {
 type DatabaseRoleUnset struct {
 	Comment *bool `ddl:"keyword" sql:"COMMENT"`
 }
+
+// ShowDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-database-roles.
+type ShowDatabaseRoleOptions struct {
+	show          bool  `ddl:"static" sql:"SHOW"`
+	databaseRoles bool  `ddl:"static" sql:"DATABASE ROLES"`
+	Like          *Like `ddl:"keyword" sql:"LIKE"`
+	In            *In   `ddl:"keyword" sql:"IN"`
+}
+
+type databaseRoleDBRow struct {
+	CreatedOn              string         `db:"created_on"`
+	Name                   string         `db:"name"`
+	IsDefault              string         `db:"is_default"`
+	IsCurrent              string         `db:"is_current"`
+	IsInherited            string         `db:"is_inherited"`
+	GrantedToRoles         sql.NullInt64  `db:"granted_to_roles"`
+	GrantedToDatabaseRoles sql.NullInt64  `db:"granted_to_database_roles"`
+	GrantedDatabaseRoles   sql.NullInt64  `db:"granted_database_roles"`
+	Owner                  string         `db:"owner"`
+	Comment                sql.NullString `db:"comment"`
+	OwnerRoleType          string         `db:"owner_role_type"`
+}
+
+type DatabaseRole struct {
+	CreatedOn              string
+	Name                   string
+	IsDefault              string
+	IsCurrent              string
+	IsInherited            string
+	GrantedToRoles         int
+	GrantedToDatabaseRoles int
+	GrantedDatabaseRoles   int
+	Owner                  string
+	Comment                *string
+	OwnerRoleType          DatabaseRoleOwnerRoleType
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,56 @@
+package example
+
+import "testing"
+
+func TestDatabaseRoles_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateDatabaseRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateDatabaseRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("Rename", func(t *testing.T) {
+			opts := &AlterDatabaseRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Rename")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterDatabaseRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Unset", func(t *testing.T) {
+			opts := &AlterDatabaseRoleOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowDatabaseRoleOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowDatabaseRoleOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -3,7 +3,7 @@ package example
 import "testing"
 
 func TestDatabaseRoles_Create(t *testing.T) {
-	id := RandomDatabaseObjectIdentifier(t)
+	id := RandomDatabaseObjectIdentifier()
 
 	// Minimal valid CreateDatabaseRoleOptions
 	defaultOpts := func() *CreateDatabaseRoleOptions {
@@ -43,7 +43,7 @@ func TestDatabaseRoles_Create(t *testing.T) {
 }
 
 func TestDatabaseRoles_Alter(t *testing.T) {
-	id := RandomDatabaseObjectIdentifier(t)
+	id := RandomDatabaseObjectIdentifier()
 
 	// Minimal valid AlterDatabaseRoleOptions
 	defaultOpts := func() *AlterDatabaseRoleOptions {
@@ -66,7 +66,7 @@ func TestDatabaseRoles_Alter(t *testing.T) {
 	t.Run("validation: exactly one field from [opts.Rename opts.Set opts.Unset] should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Rename", "Set", "Unset"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterDatabaseRoleOptions", "Rename", "Set", "Unset"))
 	})
 
 	t.Run("validation: valid identifier for [opts.Rename.Name]", func(t *testing.T) {
@@ -78,13 +78,37 @@ func TestDatabaseRoles_Alter(t *testing.T) {
 	t.Run("validation: at least one of the fields [opts.Set.NestedThirdLevel.Field] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Field"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDatabaseRoleOptions.Set.NestedThirdLevel", "Field"))
 	})
 
 	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDatabaseRoleOptions.Unset", "Comment"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		// TODO: fill me
+		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		// TODO: fill me
+		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+	})
+}
+
+func TestDatabaseRoles_Show(t *testing.T) {
+	// Minimal valid ShowDatabaseRoleOptions
+	defaultOpts := func() *ShowDatabaseRoleOptions {
+		return &ShowDatabaseRoleOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowDatabaseRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
 	})
 
 	t.Run("basic", func(t *testing.T) {
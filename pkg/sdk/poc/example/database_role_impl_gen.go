@@ -18,6 +18,27 @@ func (v *databaseRoles) Alter(ctx context.Context, request *AlterDatabaseRoleReq
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *databaseRoles) Show(ctx context.Context, request *ShowDatabaseRoleRequest) ([]DatabaseRole, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[databaseRoleDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[databaseRoleDBRow, DatabaseRole](dbRows)
+	return resultList, nil
+}
+
+func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	// generator:protected-begin databaseRoles.ShowByID
+	request := NewShowDatabaseRoleRequest().WithLike(&Like{Pattern: String(id.Name())}).WithIn(&In{Database: NewAccountObjectIdentifier(id.DatabaseName())})
+	databaseRoles, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return findOne(databaseRoles, func(r DatabaseRole) bool { return r.Name == id.Name() })
+	// generator:protected-end
+}
+
 func (r *CreateDatabaseRoleRequest) toOpts() *CreateDatabaseRoleOptions {
 	opts := &CreateDatabaseRoleOptions{
 		OrReplace:   r.OrReplace,
@@ -55,3 +76,41 @@ func (r *AlterDatabaseRoleRequest) toOpts() *AlterDatabaseRoleOptions {
 	}
 	return opts
 }
+
+func (r *ShowDatabaseRoleRequest) toOpts() *ShowDatabaseRoleOptions {
+	opts := &ShowDatabaseRoleOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r databaseRoleDBRow) convert() *DatabaseRole {
+	// generator:protected-begin databaseRoleDBRow.convert
+	e := &DatabaseRole{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		IsDefault:     r.IsDefault,
+		IsCurrent:     r.IsCurrent,
+		IsInherited:   r.IsInherited,
+		Owner:         r.Owner,
+		OwnerRoleType: DatabaseRoleOwnerRoleType(r.OwnerRoleType),
+	}
+	if r.GrantedToRoles.Valid {
+		e.GrantedToRoles = int(r.GrantedToRoles.Int64)
+	}
+	if r.GrantedToDatabaseRoles.Valid {
+		e.GrantedToDatabaseRoles = int(r.GrantedToDatabaseRoles.Int64)
+	}
+	if r.GrantedDatabaseRoles.Valid {
+		e.GrantedDatabaseRoles = int(r.GrantedDatabaseRoles.Int64)
+	}
+	if r.Comment.Valid {
+		e.Comment = String(r.Comment.String)
+	}
+	return e
+	// generator:protected-end
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,15 +1,14 @@
 package example
 
-import "errors"
-
 var (
 	_ validatable = new(CreateDatabaseRoleOptions)
 	_ validatable = new(AlterDatabaseRoleOptions)
+	_ validatable = new(ShowDatabaseRoleOptions)
 )
 
 func (opts *CreateDatabaseRoleOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -18,19 +17,21 @@ func (opts *CreateDatabaseRoleOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateDatabaseRoleOptions", "OrReplace", "IfNotExists"))
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateDatabaseRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterDatabaseRoleOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Rename, opts.Set, opts.Unset); !ok {
-		errs = append(errs, errExactlyOneOf("Rename", "Set", "Unset"))
+	if !exactlyOneValueSet(opts.Rename, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterDatabaseRoleOptions", "Rename", "Set", "Unset"))
 	}
 	if valueSet(opts.Rename) {
 		if !ValidObjectIdentifier(opts.Rename.Name) {
@@ -39,15 +40,30 @@ func (opts *AlterDatabaseRoleOptions) validate() error {
 	}
 	if valueSet(opts.Set) {
 		if valueSet(opts.Set.NestedThirdLevel) {
-			if ok := anyValueSet(opts.Set.NestedThirdLevel.Field); !ok {
-				errs = append(errs, errAtLeastOneOf("Field"))
+			if !anyValueSet(opts.Set.NestedThirdLevel.Field) {
+				errs = append(errs, errAtLeastOneOf("AlterDatabaseRoleOptions.Set.NestedThirdLevel", "Field"))
 			}
 		}
 	}
 	if valueSet(opts.Unset) {
-		if ok := anyValueSet(opts.Unset.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("Comment"))
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDatabaseRoleOptions.Unset", "Comment"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin AlterDatabaseRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
+
+func (opts *ShowDatabaseRoleOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// generator:protected-begin ShowDatabaseRoleOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,4 +1,4 @@
-package example
+package testint
 
 import "testing"
 
@@ -12,4 +12,12 @@ func TestInt_DatabaseRoles(t *testing.T) {
 	t.Run("Alter", func(t *testing.T) {
 		// TODO: fill me
 	})
+
+	t.Run("Show", func(t *testing.T) {
+		// TODO: fill me
+	})
+
+	t.Run("ShowByID", func(t *testing.T) {
+		// TODO: fill me
+	})
 }
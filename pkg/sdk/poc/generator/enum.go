@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Split by any character that cannot be a part of Go identifier
+var splitEnumValuePattern = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// Enum defines a string type with a closed set of allowed values (e.g. StreamSourceType with TABLE, VIEW and STAGE values)
+type Enum struct {
+	// Name is the enum's type name, e.g. "StreamSourceType"
+	Name string
+	// Values contains all allowed values in the order of declaration
+	Values []*EnumValue
+}
+
+// EnumValue defines a single enum constant, e.g. StreamSourceTypeExternalTable = "EXTERNAL TABLE"
+type EnumValue struct {
+	// Name is the constant's name, e.g. "StreamSourceTypeExternalTable"
+	Name string
+	// Value is the value used in SQL and returned by Snowflake, e.g. "EXTERNAL TABLE"
+	Value string
+}
+
+// NewEnum creates enum with constants named after the values (e.g. "EXTERNAL TABLE" in StreamSourceType becomes StreamSourceTypeExternalTable)
+func NewEnum(name string, values ...string) *Enum {
+	e := &Enum{
+		Name:   name,
+		Values: make([]*EnumValue, 0),
+	}
+	for _, value := range values {
+		e.WithValue(name+enumValueToName(value), value)
+	}
+	return e
+}
+
+// WithValue adds value with explicitly given constant name, use it when the name derived from the value is not readable
+func (e *Enum) WithValue(name string, value string) *Enum {
+	e.Values = append(e.Values, &EnumValue{
+		Name:  name,
+		Value: value,
+	})
+	return e
+}
+
+// Kind returns enum type to be used as field kind in the definitions
+func (e *Enum) Kind() string {
+	return e.Name
+}
+
+// KindPointer returns enum pointer type to be used as optional field kind in the definitions
+func (e *Enum) KindPointer() string {
+	return KindOfPointer(e.Name)
+}
+
+// AllValuesName returns the name of generated slice with all the values, e.g. AllStreamSourceTypes
+func (e *Enum) AllValuesName() string {
+	return enumAllValuesName(e.Name)
+}
+
+// ConverterName returns the name of generated function converting string to the enum, e.g. ToStreamSourceType
+func (e *Enum) ConverterName() string {
+	return "To" + e.Name
+}
+
+// UpperCaseValue returns the value the converter compares with (conversion is case-insensitive)
+func (v *EnumValue) UpperCaseValue() string {
+	return strings.ToUpper(v.Value)
+}
+
+func enumAllValuesName(enumName string) string {
+	switch {
+	case strings.HasSuffix(enumName, "y") && !strings.HasSuffix(enumName, "ay") && !strings.HasSuffix(enumName, "ey"):
+		return "All" + strings.TrimSuffix(enumName, "y") + "ies"
+	case strings.HasSuffix(enumName, "s"):
+		return "All" + enumName + "es"
+	default:
+		return "All" + enumName + "s"
+	}
+}
+
+func enumValueToName(value string) string {
+	var b strings.Builder
+	for _, part := range splitEnumValuePattern.Split(value, -1) {
+		if part != "" {
+			b.WriteString(startingWithUpperCase(englishLowerCaser.String(part)))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEnum(t *testing.T) {
+	t.Run("constants named after the values", func(t *testing.T) {
+		enum := NewEnum("StreamSourceType", "TABLE", "EXTERNAL TABLE", "VIEW")
+
+		assert.Equal(t, "StreamSourceType", enum.Name)
+		assert.Equal(t, []*EnumValue{
+			{Name: "StreamSourceTypeTable", Value: "TABLE"},
+			{Name: "StreamSourceTypeExternalTable", Value: "EXTERNAL TABLE"},
+			{Name: "StreamSourceTypeView", Value: "VIEW"},
+		}, enum.Values)
+	})
+
+	t.Run("explicitly named values are kept in the order of declaration", func(t *testing.T) {
+		enum := NewEnum("NetworkRuleType", "IPV4").
+			WithValue("NetworkRuleTypeAwsVpcEndpointId", "AWSVPCEID")
+
+		assert.Equal(t, []*EnumValue{
+			{Name: "NetworkRuleTypeIpv4", Value: "IPV4"},
+			{Name: "NetworkRuleTypeAwsVpcEndpointId", Value: "AWSVPCEID"},
+		}, enum.Values)
+	})
+
+	t.Run("kinds and generated names", func(t *testing.T) {
+		enum := NewEnum("StreamSourceType")
+
+		assert.Equal(t, "StreamSourceType", enum.Kind())
+		assert.Equal(t, "*StreamSourceType", enum.KindPointer())
+		assert.Equal(t, "AllStreamSourceTypes", enum.AllValuesName())
+		assert.Equal(t, "ToStreamSourceType", enum.ConverterName())
+	})
+
+	t.Run("upper case value", func(t *testing.T) {
+		enum := NewEnum("TaskState", "started")
+
+		assert.Equal(t, "STARTED", enum.Values[0].UpperCaseValue())
+	})
+}
+
+func TestEnumAllValuesName(t *testing.T) {
+	testCases := []struct {
+		enumName string
+		expected string
+	}{
+		{enumName: "StreamSourceType", expected: "AllStreamSourceTypes"},
+		{enumName: "SecretType", expected: "AllSecretTypes"},
+		{enumName: "ApiIntegrationAwsApiProviderType", expected: "AllApiIntegrationAwsApiProviderTypes"},
+		{enumName: "StagePolicy", expected: "AllStagePolicies"},
+		{enumName: "IntegrationTypeKey", expected: "AllIntegrationTypeKeys"},
+		{enumName: "WeekDay", expected: "AllWeekDays"},
+		{enumName: "ReplicationGroupSecondaryStatus", expected: "AllReplicationGroupSecondaryStatuses"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.enumName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, enumAllValuesName(tc.enumName))
+		})
+	}
+}
+
+func TestEnumValueToName(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "TABLE", expected: "Table"},
+		{value: "EXTERNAL TABLE", expected: "ExternalTable"},
+		{value: "INTERNAL_STAGE", expected: "InternalStage"},
+		{value: "aws_api_gateway", expected: "AwsApiGateway"},
+		{value: "started", expected: "Started"},
+		{value: "ENABLE-FOR-PRIVILEGE", expected: "EnableForPrivilege"},
+		{value: " SNOWFLAKE_FULL ", expected: "SnowflakeFull"},
+		{value: "IPV4", expected: "Ipv4"},
+		{value: "", expected: ""},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.value, func(t *testing.T) {
+			assert.Equal(t, tc.expected, enumValueToName(tc.value))
+		})
+	}
+}
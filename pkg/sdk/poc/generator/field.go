@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"unicode"
 )
 
 // Field defines properties of a single field or struct (by defining Fields)
@@ -22,6 +23,11 @@ type Field struct {
 	Tags map[string][]string
 	// Required is used to mark fields which are essential (it's used e.g. for DTO builders generation)
 	Required bool
+	// SQLFixtureFields are the children which have to be set in the golden SQL fixtures apart from the ones required by the validations
+	SQLFixtureFields []string
+	// HasPredefinedRequest is used for predefined fields which have a request defined outside the generated files,
+	// converted with its toOpts method (e.g. FileFormatTypeOptionsRequest)
+	HasPredefinedRequest bool
 }
 
 func NewField(name string, kind string, tagBuilder *TagBuilder, transformer FieldTransformer) *Field {
@@ -42,6 +48,17 @@ func NewField(name string, kind string, tagBuilder *TagBuilder, transformer Fiel
 	return f
 }
 
+// copy returns a deep copy of the field, so the same query struct can be nested in many structs (e.g. in grant and revoke)
+// without sharing the parents of its fields
+func (f *Field) copy() *Field {
+	c := *f
+	c.Fields = nil
+	for _, child := range f.Fields {
+		c.Fields = append(c.Fields, child.copy())
+	}
+	return &c
+}
+
 func (f *Field) withField(fields *Field) *Field {
 	f.Fields = append(f.Fields, fields)
 	return f
@@ -58,6 +75,11 @@ func (f *Field) withValidations(validations ...*Validation) *Field {
 }
 
 // HasAnyValidationInSubtree checks if any validations are present from current field level downwards
+func (f *Field) withSQLFixtureFields(fieldNames ...string) *Field {
+	f.SQLFixtureFields = append(f.SQLFixtureFields, fieldNames...)
+	return f
+}
+
 func (f *Field) HasAnyValidationInSubtree() bool {
 	if len(f.Validations) > 0 {
 		return true
@@ -70,6 +92,20 @@ func (f *Field) HasAnyValidationInSubtree() bool {
 	return false
 }
 
+func (f *Field) hasValidationInSubtree(validationType ValidationType) bool {
+	if slices.ContainsFunc(f.Validations, func(v *Validation) bool { return v.Type == validationType }) {
+		return true
+	}
+	return slices.ContainsFunc(f.Fields, func(f *Field) bool { return f.hasValidationInSubtree(validationType) })
+}
+
+func (f *Field) hasKindInSubtree(kindPart string) bool {
+	if strings.Contains(f.Kind, kindPart) {
+		return true
+	}
+	return slices.ContainsFunc(f.Fields, func(f *Field) bool { return f.hasKindInSubtree(kindPart) })
+}
+
 // TagsPrintable defines how tags are printed in options structs, it ensures the same order of tags for every field
 func (f *Field) TagsPrintable() string {
 	tagNames := []string{"ddl", "sql", "db"}
@@ -112,6 +148,11 @@ func (f *Field) IsSlice() bool {
 	return strings.HasPrefix(f.Kind, "[]")
 }
 
+// IsExported checks if field is accessible outside the package (static SQL fields and identifiers are not)
+func (f *Field) IsExported() bool {
+	return f.Name != "" && unicode.IsUpper([]rune(f.Name)[0])
+}
+
 // ShouldBeInDto checks if field is not some static SQL field which should not be interacted with by SDK user
 // TODO: this is a very naive implementation, consider fixing it with DSL builder connection
 func (f *Field) ShouldBeInDto() bool {
@@ -147,7 +188,7 @@ func (f *Field) DtoKind() string {
 	case f.IsRoot():
 		withoutSuffix, _ := strings.CutSuffix(f.Kind, "Options")
 		return fmt.Sprintf("%sRequest", withoutSuffix)
-	case f.IsStruct():
+	case f.IsStruct(), f.HasPredefinedRequest:
 		return fmt.Sprintf("%sRequest", f.Kind)
 	default:
 		return f.Kind
@@ -160,7 +201,7 @@ func (f *Field) DtoDecl() string {
 	case f.Parent == nil:
 		withoutSuffix, _ := strings.CutSuffix(f.KindNoPtr(), "Options")
 		return fmt.Sprintf("%sRequest", withoutSuffix)
-	case f.IsStruct():
+	case f.IsStruct(), f.HasPredefinedRequest:
 		return fmt.Sprintf("%sRequest", f.KindNoPtr())
 	default:
 		return f.KindNoPtr()
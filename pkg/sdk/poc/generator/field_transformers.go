@@ -88,6 +88,7 @@ type ParameterTransformer struct {
 	quotes      string
 	parentheses string
 	equals      string
+	reverse     string
 }
 
 func ParameterOptions() *ParameterTransformer {
@@ -124,6 +125,11 @@ func (v *ParameterTransformer) ArrowEquals() *ParameterTransformer {
 	return v
 }
 
+func (v *ParameterTransformer) Reverse() *ParameterTransformer {
+	v.reverse = "reverse"
+	return v
+}
+
 func (v *ParameterTransformer) SingleQuotes() *ParameterTransformer {
 	v.quotes = "single_quotes"
 	return v
@@ -158,6 +164,7 @@ func (v *ParameterTransformer) Transform(f *Field) *Field {
 	addTagIfMissing(f.Tags, "ddl", v.quotes)
 	addTagIfMissing(f.Tags, "ddl", v.parentheses)
 	addTagIfMissing(f.Tags, "ddl", v.equals)
+	addTagIfMissing(f.Tags, "ddl", v.reverse)
 	return f
 }
 
@@ -2,23 +2,69 @@ package generator
 
 import (
 	"bytes"
+	"errors"
 	"go/format"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+
+	"golang.org/x/tools/imports"
 )
 
 func WriteCodeToFile(buffer *bytes.Buffer, fileName string) {
+	outputPath := outputPathFor(fileName)
+	src := generatedCode(buffer, outputPath)
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		log.Panicln(err)
+	}
+	if err := os.WriteFile(outputPath, src, 0o600); err != nil {
+		log.Panicln(err)
+	}
+}
+
+// IsCodeUpToDate checks if the file has the same content as the generated code (with the protected regions kept from the file)
+func IsCodeUpToDate(buffer *bytes.Buffer, fileName string) bool {
+	outputPath := outputPathFor(fileName)
+	existing, err := os.ReadFile(outputPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+	if err != nil {
+		log.Panicln(err)
+	}
+	return bytes.Equal(existing, generatedCode(buffer, outputPath))
+}
+
+func outputPathFor(fileName string) string {
 	wd, errWd := os.Getwd()
 	if errWd != nil {
 		log.Panicln(errWd)
 	}
-	outputPath := filepath.Join(wd, fileName)
+	return filepath.Join(wd, fileName)
+}
+
+// generatedCode formats the generated code and fills its protected regions with the content of the existing file;
+// the imports are fixed after merging, because the hand-written code can use other packages than the generated one
+func generatedCode(buffer *bytes.Buffer, outputPath string) []byte {
 	src, errSrcFormat := format.Source(buffer.Bytes())
 	if errSrcFormat != nil {
 		log.Panicln(errSrcFormat)
 	}
-	if err := os.WriteFile(outputPath, src, 0o600); err != nil {
+	existing, err := os.ReadFile(outputPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
 		log.Panicln(err)
 	}
+	if err == nil {
+		merged, errMerge := mergeProtectedRegions(string(src), string(existing))
+		if errMerge != nil {
+			log.Panicf("%s: %v", outputPath, errMerge)
+		}
+		src = []byte(merged)
+	}
+	src, errSrcFormat = imports.Process(outputPath, src, nil)
+	if errSrcFormat != nil {
+		log.Panicln(errSrcFormat)
+	}
+	return src
 }
@@ -1,5 +1,7 @@
 package generator
 
+import "slices"
+
 // Interface groups operations for particular object or objects family (e.g. DATABASE ROLE)
 type Interface struct {
 	// Name is the interface's name, e.g. "DatabaseRoles"
@@ -10,6 +12,8 @@ type Interface struct {
 	Operations []*Operation
 	// IdentifierKind keeps identifier of the underlying object (e.g. DatabaseObjectIdentifier)
 	IdentifierKind string
+	// Enums contains string types with closed set of values used by the operations (e.g. in options or show output)
+	Enums []*Enum
 }
 
 func NewInterface(name string, nameSingular string, identifierKind string, operations ...*Operation) *Interface {
@@ -25,3 +29,60 @@ func NewInterface(name string, nameSingular string, identifierKind string, opera
 func (i *Interface) NameLowerCased() string {
 	return startingWithLowerCase(i.Name)
 }
+
+// WithEnums adds enums which are generated together with the interface
+func (i *Interface) WithEnums(enums ...*Enum) *Interface {
+	i.Enums = append(i.Enums, enums...)
+	return i
+}
+
+// HasEnumValidations checks if any of the options validates enum values (which requires slices package)
+func (i *Interface) HasEnumValidations() bool {
+	for _, o := range i.Operations {
+		if o.OptsField != nil && o.OptsField.hasValidationInSubtree(ValidEnumValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// InterfaceImports returns packages used by the generated interface file (options, helper structs and enums)
+func (i *Interface) InterfaceImports() []string {
+	imports := []string{"context"}
+	if len(i.Enums) > 0 {
+		imports = append(imports, "fmt", "strings")
+	}
+	for _, o := range i.Operations {
+		for _, f := range o.HelperStructs {
+			if f.hasKindInSubtree("sql.") {
+				imports = append(imports, "database/sql")
+			}
+			if f.hasKindInSubtree("time.") {
+				imports = append(imports, "time")
+			}
+		}
+		if o.OptsField != nil && o.OptsField.hasKindInSubtree("time.") {
+			imports = append(imports, "time")
+		}
+	}
+	slices.Sort(imports)
+	return slices.Compact(imports)
+}
+
+func (i *Interface) operation(kind OperationKind) (*Operation, bool) {
+	for _, o := range i.Operations {
+		if o.Name == string(kind) {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+func (i *Interface) enum(kind string) (*Enum, bool) {
+	for _, e := range i.Enums {
+		if e.Name == kind {
+			return e, true
+		}
+	}
+	return nil, false
+}
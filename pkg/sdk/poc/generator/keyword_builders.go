@@ -75,7 +75,7 @@ func (v *QueryStruct) OptionalTags() *QueryStruct {
 }
 
 func (v *QueryStruct) SetTags() *QueryStruct {
-	return v.setTags(KeywordOptions().Required())
+	return v.setTags(KeywordOptions().SQL("SET TAG").Required())
 }
 
 func (v *QueryStruct) OptionalSetTags() *QueryStruct {
@@ -87,7 +87,7 @@ func (v *QueryStruct) setTags(transformer *KeywordTransformer) *QueryStruct {
 }
 
 func (v *QueryStruct) UnsetTags() *QueryStruct {
-	return v.unsetTags(KeywordOptions().Required())
+	return v.unsetTags(KeywordOptions().SQL("UNSET TAG").Required())
 }
 
 func (v *QueryStruct) OptionalUnsetTags() *QueryStruct {
@@ -0,0 +1,118 @@
+package generator
+
+import "fmt"
+
+type Mapping struct {
+	MappingFuncName string
+	From            *Field
+	To              *Field
+}
+
+func newMapping(mappingFuncName string, from, to *Field) *Mapping {
+	return &Mapping{
+		MappingFuncName: mappingFuncName,
+		From:            from,
+		To:              to,
+	}
+}
+
+// MappingAssignment is a single field assignment in generated convert function, e.g. Comment: r.Comment
+type MappingAssignment struct {
+	// Name is the name of the field in plain struct
+	Name string
+	// Value is the expression assigned to the field
+	Value string
+	// ValidField is the sql.Null* field which has to be valid before the assignment, empty for direct assignments
+	ValidField string
+}
+
+// MappingFunc is a model of generated convert function from db struct to plain struct
+type MappingFunc struct {
+	*Mapping
+	// Direct assignments are put inside the struct literal
+	Direct []MappingAssignment
+	// Optional assignments are put after the struct literal, checking the validity of sql.Null* value
+	Optional []MappingAssignment
+	// Unmapped contains plain struct fields which have to be mapped by hand
+	Unmapped []string
+}
+
+// nullableKinds maps sql.Null* kinds to their value field and kind
+var nullableKinds = map[string]struct{ valueField, kind string }{
+	"sql.NullString":  {"String", "string"},
+	"sql.NullBool":    {"Bool", "bool"},
+	"sql.NullInt64":   {"Int64", "int64"},
+	"sql.NullInt32":   {"Int32", "int32"},
+	"sql.NullFloat64": {"Float64", "float64"},
+	"sql.NullTime":    {"Time", "time.Time"},
+}
+
+// pointerHelpers maps kinds to the sdk helpers creating pointers, Pointer is used for other kinds
+var pointerHelpers = map[string]string{
+	"string": "String",
+	"bool":   "Bool",
+	"int":    "Int",
+}
+
+// mappingFunc matches db struct and plain struct fields by name and creates assignments converting sql.Null* values
+// to pointers (and strings to the interface enums)
+func (i *Interface) mappingFunc(mapping *Mapping) *MappingFunc {
+	m := &MappingFunc{Mapping: mapping}
+	for _, to := range mapping.To.Fields {
+		from, ok := fieldByName(mapping.From, to.Name)
+		if !ok {
+			m.Unmapped = append(m.Unmapped, to.Name)
+			continue
+		}
+		value, kind, validField := fmt.Sprintf("r.%s", from.Name), from.Kind, ""
+		if nullable, ok := nullableKinds[from.Kind]; ok {
+			value, kind, validField = fmt.Sprintf("r.%s.%s", from.Name, nullable.valueField), nullable.kind, value+".Valid"
+		}
+		value, ok = i.convertValue(value, kind, to.KindNoPtr())
+		if !ok {
+			m.Unmapped = append(m.Unmapped, to.Name)
+			continue
+		}
+		if to.IsPointer() {
+			value = pointerTo(value, to.KindNoPtr())
+		}
+		assignment := MappingAssignment{Name: to.Name, Value: value, ValidField: validField}
+		if validField == "" {
+			m.Direct = append(m.Direct, assignment)
+		} else {
+			m.Optional = append(m.Optional, assignment)
+		}
+	}
+	return m
+}
+
+// convertValue returns expression converting value of one kind to another, if conversion is supported
+func (i *Interface) convertValue(value string, from string, to string) (string, bool) {
+	switch {
+	case from == to:
+		return value, true
+	case from == "int64" && to == "int", from == "int32" && to == "int":
+		return fmt.Sprintf("int(%s)", value), true
+	case from == "string":
+		if _, ok := i.enum(to); ok {
+			return fmt.Sprintf("%s(%s)", to, value), true
+		}
+	}
+	return "", false
+}
+
+func pointerTo(value string, kind string) string {
+	if helper, ok := pointerHelpers[kind]; ok {
+		return fmt.Sprintf("%s(%s)", helper, value)
+	}
+	return fmt.Sprintf("Pointer(%s)", value)
+}
+
+func fieldByName(field *Field, name string) (*Field, bool) {
+	for _, f := range field.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
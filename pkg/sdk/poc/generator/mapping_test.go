@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterface_MappingFunc(t *testing.T) {
+	ownerRoleType := NewEnum("DatabaseRoleOwnerRoleType", "ROLE", "DATABASE_ROLE")
+	def := NewInterface("DatabaseRoles", "DatabaseRole", "DatabaseObjectIdentifier").WithEnums(ownerRoleType)
+
+	mappingFunc := func(from *dbStruct, to *plainStruct) *MappingFunc {
+		return def.mappingFunc(newMapping("convert", from.IntoField(), to.IntoField()))
+	}
+
+	t.Run("fields of the same kind are assigned directly", func(t *testing.T) {
+		m := mappingFunc(
+			DbStruct("databaseRoleDBRow").Text("name").Bool("is_default").Time("created_on"),
+			PlainStruct("DatabaseRole").Text("Name").Bool("IsDefault").Time("CreatedOn"),
+		)
+
+		assert.Equal(t, []MappingAssignment{
+			{Name: "Name", Value: "r.Name"},
+			{Name: "IsDefault", Value: "r.IsDefault"},
+			{Name: "CreatedOn", Value: "r.CreatedOn"},
+		}, m.Direct)
+		assert.Empty(t, m.Optional)
+		assert.Empty(t, m.Unmapped)
+	})
+
+	t.Run("nullable fields are assigned only when valid", func(t *testing.T) {
+		m := mappingFunc(
+			DbStruct("databaseRoleDBRow").OptionalText("comment").OptionalText("owner").OptionalBool("is_current").Field("created_on", "sql.NullTime"),
+			PlainStruct("DatabaseRole").OptionalText("Comment").Text("Owner").Bool("IsCurrent").Time("CreatedOn"),
+		)
+
+		assert.Empty(t, m.Direct)
+		assert.Equal(t, []MappingAssignment{
+			{Name: "Comment", Value: "String(r.Comment.String)", ValidField: "r.Comment.Valid"},
+			{Name: "Owner", Value: "r.Owner.String", ValidField: "r.Owner.Valid"},
+			{Name: "IsCurrent", Value: "r.IsCurrent.Bool", ValidField: "r.IsCurrent.Valid"},
+			{Name: "CreatedOn", Value: "r.CreatedOn.Time", ValidField: "r.CreatedOn.Valid"},
+		}, m.Optional)
+	})
+
+	t.Run("numbers are converted to int", func(t *testing.T) {
+		m := mappingFunc(
+			DbStruct("databaseRoleDBRow").OptionalNumber("granted_to_roles").Field("granted_database_roles", "int64").Field("retention_time", "sql.NullInt32"),
+			PlainStruct("DatabaseRole").Number("GrantedToRoles").Number("GrantedDatabaseRoles").OptionalNumber("RetentionTime"),
+		)
+
+		assert.Equal(t, []MappingAssignment{
+			{Name: "GrantedDatabaseRoles", Value: "int(r.GrantedDatabaseRoles)"},
+		}, m.Direct)
+		assert.Equal(t, []MappingAssignment{
+			{Name: "GrantedToRoles", Value: "int(r.GrantedToRoles.Int64)", ValidField: "r.GrantedToRoles.Valid"},
+			{Name: "RetentionTime", Value: "Int(int(r.RetentionTime.Int32))", ValidField: "r.RetentionTime.Valid"},
+		}, m.Optional)
+	})
+
+	t.Run("strings are converted to the interface enums", func(t *testing.T) {
+		m := mappingFunc(
+			DbStruct("databaseRoleDBRow").Text("owner_role_type").OptionalText("previous_owner_role_type"),
+			PlainStruct("DatabaseRole").Field("OwnerRoleType", ownerRoleType.Kind()).Field("PreviousOwnerRoleType", ownerRoleType.KindPointer()),
+		)
+
+		assert.Equal(t, []MappingAssignment{
+			{Name: "OwnerRoleType", Value: "DatabaseRoleOwnerRoleType(r.OwnerRoleType)"},
+		}, m.Direct)
+		assert.Equal(t, []MappingAssignment{
+			{Name: "PreviousOwnerRoleType", Value: "Pointer(DatabaseRoleOwnerRoleType(r.PreviousOwnerRoleType.String))", ValidField: "r.PreviousOwnerRoleType.Valid"},
+		}, m.Optional)
+	})
+
+	t.Run("fields without a counterpart or with unsupported conversion are unmapped", func(t *testing.T) {
+		m := mappingFunc(
+			DbStruct("databaseRoleDBRow").Text("name").Text("is_inherited").Text("kind"),
+			PlainStruct("DatabaseRole").Text("Name").Bool("IsInherited").Field("Kind", "StreamSourceType").Text("Options"),
+		)
+
+		assert.Equal(t, []MappingAssignment{{Name: "Name", Value: "r.Name"}}, m.Direct)
+		assert.Equal(t, []string{"IsInherited", "Kind", "Options"}, m.Unmapped)
+	})
+}
+
+func TestPointerTo(t *testing.T) {
+	testCases := []struct {
+		kind     string
+		expected string
+	}{
+		{kind: "string", expected: "String(value)"},
+		{kind: "bool", expected: "Bool(value)"},
+		{kind: "int", expected: "Int(value)"},
+		{kind: "time.Time", expected: "Pointer(value)"},
+		{kind: "StreamSourceType", expected: "Pointer(value)"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.kind, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pointerTo("value", tc.kind))
+		})
+	}
+}
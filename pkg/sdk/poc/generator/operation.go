@@ -1,5 +1,12 @@
 package generator
 
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
 type OperationKind string
 
 const (
@@ -20,6 +27,18 @@ const (
 	DescriptionMappingKindSlice       DescriptionMappingKind = "slice"
 )
 
+// ShowByIDFilteringKind defines a filter added to the Show request used in the ShowByID implementation
+type ShowByIDFilteringKind string
+
+const (
+	// ShowByIDLikeFiltering narrows the Show result with LIKE '<object name>'
+	ShowByIDLikeFiltering ShowByIDFilteringKind = "Like"
+	// ShowByIDInFiltering narrows the Show result with IN DATABASE / IN SCHEMA derived from the identifier
+	ShowByIDInFiltering ShowByIDFilteringKind = "In"
+)
+
+const defaultShowByIDFilter = "r.Name == id.Name()"
+
 // Operation defines a single operation for given object or objects family (e.g. CREATE DATABASE ROLE)
 type Operation struct {
 	// Name is the operation's name, e.g. "Create"
@@ -38,12 +57,30 @@ type Operation struct {
 	DescribeKind *DescriptionMappingKind
 	// DescribeMapping is a definition of mapping needed by Operation kind of OperationKindDescribe
 	DescribeMapping *Mapping
+	// ShowByIDFiltering defines filters of the Show request used by Operation kind of OperationKindShowByID (without them every object is listed)
+	ShowByIDFiltering []ShowByIDFilteringKind
+	// ShowByIDFilter is the condition (on r and id) used to find the object in the Show result, by default the names are compared
+	ShowByIDFilter string
+	// ResultKind is the type returned by the custom operation querying rows (e.g. []AccountObjectIdentifier), its implementation is written by hand
+	ResultKind string
+}
+
+// HasNameField checks if options of the operation are created with the object identifier
+func (s *Operation) HasNameField() bool {
+	return s.NameField() != nil
 }
 
-type Mapping struct {
-	MappingFuncName string
-	From            *Field
-	To              *Field
+// NameField returns the object identifier field of the options, it can be of other kind than the identifier of the interface
+// (e.g. function name without the arguments)
+func (s *Operation) NameField() *Field {
+	if s.OptsField == nil {
+		return nil
+	}
+	i := slices.IndexFunc(s.OptsField.Fields, func(f *Field) bool { return f.Name == "name" })
+	if i < 0 {
+		return nil
+	}
+	return s.OptsField.Fields[i]
 }
 
 func newOperation(kind string, doc string) *Operation {
@@ -54,14 +91,6 @@ func newOperation(kind string, doc string) *Operation {
 	}
 }
 
-func newMapping(mappingFuncName string, from, to *Field) *Mapping {
-	return &Mapping{
-		MappingFuncName: mappingFuncName,
-		From:            from,
-		To:              to,
-	}
-}
-
 func (s *Operation) withOptionsStruct(optsField *Field) *Operation {
 	s.OptsField = optsField
 	return s
@@ -77,6 +106,24 @@ func (s *Operation) withHelperStructs(helperStructs ...*Field) *Operation {
 	return s
 }
 
+// ShowMappingFunc returns the convert function generated for ShowMapping
+func (s *Operation) ShowMappingFunc() *MappingFunc {
+	return s.ObjectInterface.mappingFunc(s.ShowMapping)
+}
+
+// DescribeMappingFunc returns the convert function generated for DescribeMapping
+func (s *Operation) DescribeMappingFunc() *MappingFunc {
+	return s.ObjectInterface.mappingFunc(s.DescribeMapping)
+}
+
+// DescribeMappingReusesShow returns true if describe returns the same rows as show (e.g. DESCRIBE TASK), so the mapping
+// function generated for show is used by describe too
+func (s *Operation) DescribeMappingReusesShow() bool {
+	return slices.ContainsFunc(s.ObjectInterface.Operations, func(o *Operation) bool {
+		return o.ShowMapping != nil && o.ShowMapping.From.Name == s.DescribeMapping.From.Name
+	})
+}
+
 func addShowMapping(op *Operation, from, to *Field) {
 	op.ShowMapping = newMapping("convert", from, to)
 }
@@ -160,8 +207,71 @@ func (i *Interface) ShowOperation(doc string, dbRepresentation *dbStruct, resour
 	return i
 }
 
+// ShowByIdOperation is an alias of ShowByIdOperationWithNoFiltering
 func (i *Interface) ShowByIdOperation() *Interface {
-	return i.newNoSqlOperation(string(OperationKindShowByID))
+	return i.ShowByIdOperationWithNoFiltering()
+}
+
+// ShowByIdOperationWithNoFiltering generates ShowByID listing all the objects and finding the one with the same name
+func (i *Interface) ShowByIdOperationWithNoFiltering() *Interface {
+	return i.ShowByIdOperationWithCustomFilter(defaultShowByIDFilter)
+}
+
+// ShowByIdOperationWithFiltering generates ShowByID narrowing the Show request with the given filters (e.g. LIKE and IN)
+// and finding the object with the same name
+func (i *Interface) ShowByIdOperationWithFiltering(filtering ...ShowByIDFilteringKind) *Interface {
+	return i.ShowByIdOperationWithCustomFilter(defaultShowByIDFilter, filtering...)
+}
+
+// ShowByIdOperationWithCustomFilter generates ShowByID finding the object with the given condition on r (Show result row)
+// and id, e.g. "r.ID().FullyQualifiedName() == id.FullyQualifiedName()"
+func (i *Interface) ShowByIdOperationWithCustomFilter(filter string, filtering ...ShowByIDFilteringKind) *Interface {
+	i.newNoSqlOperation(string(OperationKindShowByID))
+	op := i.Operations[len(i.Operations)-1]
+	op.ShowByIDFiltering = filtering
+	op.ShowByIDFilter = filter
+	return i
+}
+
+// ShowByIDRequest returns the Show request used in ShowByID implementation with all the filters applied
+func (s *Operation) ShowByIDRequest() string {
+	show, ok := s.ObjectInterface.operation(OperationKindShow)
+	if !ok {
+		log.Panicf("ShowByID of %s requires Show operation", s.ObjectInterface.Name)
+	}
+	request := fmt.Sprintf("NewShow%sRequest()", s.ObjectInterface.NameSingular)
+	for _, filtering := range s.ShowByIDFiltering {
+		if !slices.ContainsFunc(show.OptsField.Fields, func(f *Field) bool { return f.Name == string(filtering) }) {
+			log.Panicf("ShowByID of %s requires Show operation with %s field", s.ObjectInterface.Name, filtering)
+		}
+		request += fmt.Sprintf(".With%s(%s)", filtering, s.showByIDFilteringValue(filtering))
+	}
+	return request
+}
+
+// ShowByIDFilterCondition returns the condition used to find the object in the Show result
+func (s *Operation) ShowByIDFilterCondition() string {
+	if s.ShowByIDFilter == "" {
+		return defaultShowByIDFilter
+	}
+	return s.ShowByIDFilter
+}
+
+func (s *Operation) showByIDFilteringValue(filtering ShowByIDFilteringKind) string {
+	switch filtering {
+	case ShowByIDLikeFiltering:
+		return "&Like{Pattern: String(id.Name())}"
+	case ShowByIDInFiltering:
+		switch {
+		case strings.HasPrefix(s.ObjectInterface.IdentifierKind, "SchemaObjectIdentifier"):
+			return "&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}"
+		case s.ObjectInterface.IdentifierKind == "DatabaseObjectIdentifier":
+			return "&In{Database: NewAccountObjectIdentifier(id.DatabaseName())}"
+		}
+		log.Panicf("IN filtering is not supported for %s", s.ObjectInterface.IdentifierKind)
+	}
+	log.Panicf("unknown ShowByID filtering %s", filtering)
+	return ""
 }
 
 func (i *Interface) DescribeOperation(describeKind DescriptionMappingKind, doc string, dbRepresentation *dbStruct, resourceRepresentation *plainStruct, queryStruct *QueryStruct) *Interface {
@@ -170,6 +280,14 @@ func (i *Interface) DescribeOperation(describeKind DescriptionMappingKind, doc s
 	return i
 }
 
-func (i *Interface) CustomOperation(kind string, doc string, queryStruct *QueryStruct) *Interface {
-	return i.newSimpleOperation(kind, doc, queryStruct)
+func (i *Interface) CustomOperation(kind string, doc string, queryStruct *QueryStruct, helperStructs ...IntoField) *Interface {
+	return i.newSimpleOperation(kind, doc, queryStruct, helperStructs...)
+}
+
+// CustomQueryOperation adds an operation returning the result of the given kind, e.g. identifiers listed by SHOW ... IN;
+// the conversion of the rows is written by hand in the protected region of the implementation
+func (i *Interface) CustomQueryOperation(kind string, doc string, queryStruct *QueryStruct, resultKind string, helperStructs ...IntoField) *Interface {
+	i.newSimpleOperation(kind, doc, queryStruct, helperStructs...)
+	i.Operations[len(i.Operations)-1].ResultKind = resultKind
+	return i
 }
@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Protected regions mark parts of the generated files which are filled by hand (e.g. custom validations or convert functions).
+// On regeneration the content of every protected region is taken from the existing file instead of the template, e.g.
+//
+//	// generator:protected-begin databaseRoleDBRow.convert
+//	return &DatabaseRole{
+//		Name: r.Name,
+//	}
+//	// generator:protected-end
+const (
+	protectedRegionBegin = "// generator:protected-begin "
+	protectedRegionEnd   = "// generator:protected-end"
+)
+
+type protectedRegion struct {
+	key   string
+	lines []string
+}
+
+// protectedRegionKeys makes the keys of the regions unique, the same key used more than once (e.g. for nested struct used
+// in a few places) is suffixed with the number of occurrence, so the regions are matched in order of appearance
+type protectedRegionKeys map[string]int
+
+func (k protectedRegionKeys) next(line string) string {
+	key := strings.TrimSpace(strings.TrimPrefix(line, protectedRegionBegin))
+	k[key]++
+	if k[key] > 1 {
+		return fmt.Sprintf("%s #%d", key, k[key])
+	}
+	return key
+}
+
+// parseProtectedRegions returns the protected regions of the source by their keys
+func parseProtectedRegions(src string) (map[string]protectedRegion, error) {
+	regions := make(map[string]protectedRegion)
+	keys := make(protectedRegionKeys)
+	var current *protectedRegion
+	for i, line := range strings.Split(src, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, protectedRegionBegin):
+			key := keys.next(trimmed)
+			if current != nil {
+				return nil, fmt.Errorf("line %d: protected region %s begins inside protected region %s", i+1, key, current.key)
+			}
+			current = &protectedRegion{key: key}
+		case trimmed == protectedRegionEnd:
+			if current == nil {
+				return nil, fmt.Errorf("line %d: protected region ends without beginning", i+1)
+			}
+			regions[current.key] = *current
+			current = nil
+		case current != nil:
+			current.lines = append(current.lines, line)
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("protected region %s is not closed", current.key)
+	}
+	return regions, nil
+}
+
+// mergeProtectedRegions replaces content of the protected regions in generated source with the content of the same regions
+// in existing source. It fails if any region of existing source would be lost, so no hand-written code is removed silently.
+func mergeProtectedRegions(generated string, existing string) (string, error) {
+	existingRegions, err := parseProtectedRegions(existing)
+	if err != nil {
+		return "", fmt.Errorf("existing file: %w", err)
+	}
+	generatedRegions, err := parseProtectedRegions(generated)
+	if err != nil {
+		return "", fmt.Errorf("generated code: %w", err)
+	}
+	for key := range existingRegions {
+		if _, ok := generatedRegions[key]; !ok {
+			return "", fmt.Errorf("protected region %s is not present in the generated code, move its content outside of the region or remove it", key)
+		}
+	}
+
+	var merged []string
+	var skipping bool
+	keys := make(protectedRegionKeys)
+	for _, line := range strings.Split(generated, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, protectedRegionBegin):
+			merged = append(merged, line)
+			if region, ok := existingRegions[keys.next(trimmed)]; ok {
+				merged = append(merged, region.lines...)
+				skipping = true
+			}
+		case trimmed == protectedRegionEnd:
+			merged = append(merged, line)
+			skipping = false
+		case !skipping:
+			merged = append(merged, line)
+		}
+	}
+	return strings.Join(merged, "\n"), nil
+}
@@ -0,0 +1,155 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeProtectedRegions(t *testing.T) {
+	t.Run("content of the existing regions is kept", func(t *testing.T) {
+		generated := `func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	// generator:protected-begin databaseRoles.ShowByID
+	// TODO: fill me
+	// generator:protected-end
+	newGeneratedCall()
+}`
+		existing := `func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	// generator:protected-begin databaseRoles.ShowByID
+	return findOne(databaseRoles, func(r DatabaseRole) bool { return r.Name == id.Name() })
+	// generator:protected-end
+	oldGeneratedCall()
+}`
+
+		merged, err := mergeProtectedRegions(generated, existing)
+
+		require.NoError(t, err)
+		assert.Equal(t, `func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	// generator:protected-begin databaseRoles.ShowByID
+	return findOne(databaseRoles, func(r DatabaseRole) bool { return r.Name == id.Name() })
+	// generator:protected-end
+	newGeneratedCall()
+}`, merged)
+	})
+
+	t.Run("new regions get the generated content", func(t *testing.T) {
+		generated := `// generator:protected-begin first
+// TODO: fill me
+// generator:protected-end
+// generator:protected-begin second
+// TODO: fill me
+// generator:protected-end`
+		existing := `// generator:protected-begin first
+filled()
+// generator:protected-end`
+
+		merged, err := mergeProtectedRegions(generated, existing)
+
+		require.NoError(t, err)
+		assert.Equal(t, `// generator:protected-begin first
+filled()
+// generator:protected-end
+// generator:protected-begin second
+// TODO: fill me
+// generator:protected-end`, merged)
+	})
+
+	t.Run("empty existing region is kept empty", func(t *testing.T) {
+		generated := `// generator:protected-begin first
+// TODO: fill me
+// generator:protected-end`
+		existing := `// generator:protected-begin first
+// generator:protected-end`
+
+		merged, err := mergeProtectedRegions(generated, existing)
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, merged)
+	})
+
+	t.Run("regions with the same key are matched in order", func(t *testing.T) {
+		generated := `// generator:protected-begin duplicated
+// TODO: fill me
+// generator:protected-end
+// generator:protected-begin duplicated
+// TODO: fill me
+// generator:protected-end`
+		existing := `// generator:protected-begin duplicated
+first()
+// generator:protected-end
+// generator:protected-begin duplicated
+second()
+// generator:protected-end`
+
+		merged, err := mergeProtectedRegions(generated, existing)
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, merged)
+	})
+
+	t.Run("region missing in the generated code", func(t *testing.T) {
+		generated := `// generator:protected-begin first
+// generator:protected-end`
+		existing := `// generator:protected-begin removed
+filled()
+// generator:protected-end`
+
+		_, err := mergeProtectedRegions(generated, existing)
+
+		require.ErrorContains(t, err, "protected region removed is not present in the generated code")
+	})
+
+	t.Run("second occurrence of the key missing in the generated code", func(t *testing.T) {
+		generated := `// generator:protected-begin duplicated
+// generator:protected-end`
+		existing := `// generator:protected-begin duplicated
+// generator:protected-end
+// generator:protected-begin duplicated
+second()
+// generator:protected-end`
+
+		_, err := mergeProtectedRegions(generated, existing)
+
+		require.ErrorContains(t, err, "protected region duplicated #2 is not present in the generated code")
+	})
+
+	t.Run("invalid regions", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			src           string
+			expectedError string
+		}{
+			{
+				name: "nested begin",
+				src: `// generator:protected-begin outer
+// generator:protected-begin inner
+// generator:protected-end`,
+				expectedError: "line 2: protected region inner begins inside protected region outer",
+			},
+			{
+				name: "not closed",
+				src: `// generator:protected-begin first
+filled()`,
+				expectedError: "protected region first is not closed",
+			},
+			{
+				name: "end without begin",
+				src: `filled()
+// generator:protected-end`,
+				expectedError: "line 2: protected region ends without beginning",
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.name+" in existing file", func(t *testing.T) {
+				_, err := mergeProtectedRegions("", tc.src)
+				require.ErrorContains(t, err, "existing file: "+tc.expectedError)
+			})
+			t.Run(tc.name+" in generated code", func(t *testing.T) {
+				_, err := mergeProtectedRegions(tc.src, "")
+				require.ErrorContains(t, err, "generated code: "+tc.expectedError)
+			})
+		}
+	})
+}
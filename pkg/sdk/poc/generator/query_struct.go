@@ -7,10 +7,11 @@ package generator
 //		...additional fields that are not present in the Field
 //	}
 type QueryStruct struct {
-	name            string
-	fields          []*Field
-	identifierField *Field
-	validations     []*Validation
+	name             string
+	fields           []*Field
+	identifierField  *Field
+	validations      []*Validation
+	sqlFixtureFields []string
 }
 
 func NewQueryStruct(name string) *QueryStruct {
@@ -22,9 +23,14 @@ func NewQueryStruct(name string) *QueryStruct {
 }
 
 func (v *QueryStruct) IntoField() *Field {
+	fields := make([]*Field, len(v.fields))
+	for i, f := range v.fields {
+		fields[i] = f.copy()
+	}
 	return NewField(v.name, v.name, nil, nil).
-		withFields(v.fields...).
-		withValidations(v.validations...)
+		withFields(fields...).
+		withValidations(v.validations...).
+		withSQLFixtureFields(v.sqlFixtureFields...)
 }
 
 func (v *QueryStruct) WithValidation(validationType ValidationType, fieldNames ...string) *QueryStruct {
@@ -32,11 +38,27 @@ func (v *QueryStruct) WithValidation(validationType ValidationType, fieldNames .
 	return v
 }
 
+// WithSQLFixtureFields marks the fields which have to be set in the golden SQL fixtures in addition to the ones required
+// by the validations of the definition, e.g. because of the validations written by hand
+func (v *QueryStruct) WithSQLFixtureFields(fieldNames ...string) *QueryStruct {
+	v.sqlFixtureFields = append(v.sqlFixtureFields, fieldNames...)
+	return v
+}
+
 func (v *QueryStruct) PredefinedQueryStructField(name string, kind string, transformer FieldTransformer) *QueryStruct {
 	v.fields = append(v.fields, NewField(name, kind, Tags(), transformer))
 	return v
 }
 
+// PredefinedQueryStructFieldWithRequest adds a predefined field which request is also defined outside the generated files
+// (named like the kind with Request suffix) and converted with its toOpts method, e.g. FileFormatTypeOptions
+func (v *QueryStruct) PredefinedQueryStructFieldWithRequest(name string, kind string, transformer FieldTransformer) *QueryStruct {
+	field := NewField(name, kind, Tags(), transformer)
+	field.HasPredefinedRequest = true
+	v.fields = append(v.fields, field)
+	return v
+}
+
 func (v *QueryStruct) QueryStructField(name string, queryStruct *QueryStruct, transformer FieldTransformer) *QueryStruct {
 	return v.queryStructField(name, queryStruct, "", transformer)
 }
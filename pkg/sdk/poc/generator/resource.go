@@ -0,0 +1,591 @@
+package generator
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// skippedResourceFields are create options which are not exposed as resource arguments
+var skippedResourceFields = []string{"OrReplace", "IfNotExists"}
+
+// schemaTypes maps primitive kinds to the types of Terraform schema
+var schemaTypes = map[string]string{
+	"string":  "schema.TypeString",
+	"bool":    "schema.TypeBool",
+	"int":     "schema.TypeInt",
+	"float64": "schema.TypeFloat",
+}
+
+// Resource is a model of Terraform resource skeleton (with its data source and acceptance test) generated from the interface:
+// create options are mapped to arguments, alter Set/Unset to in-place updates, ShowByID/Describe to read and drop to delete
+type Resource struct {
+	*Interface
+	// Arguments contains the identifier parts (e.g. database, schema and name) followed by the create options
+	Arguments []*ResourceArgument
+	// Unmapped contains create options which have to be added to the resource by hand
+	Unmapped []string
+	// RenameRequest is the alter request with RenameTo set to "newId", empty if the object cannot be renamed
+	RenameRequest string
+}
+
+// ResourceArgument is a single argument of the resource schema
+type ResourceArgument struct {
+	// Name is the argument name in the schema, e.g. "comment"
+	Name string
+	// FieldName is the name of the field in the SDK structs, e.g. "Comment"
+	FieldName string
+	// SchemaType is the type of the argument, e.g. schema.TypeString
+	SchemaType string
+	Required   bool
+	// ForceNew is set for arguments which cannot be altered in place
+	ForceNew bool
+	// IsIdentifier marks arguments building the object identifier
+	IsIdentifier bool
+	// ValidValues is the slice with allowed values for enum arguments, e.g. sdk.AllStreamSourceTypes
+	ValidValues string
+	// Value converts "v" taken from the schema to the kind expected by the create request
+	Value string
+	// UpdateRequest is the alter request with Set changing the argument to "v", empty if the argument is not alterable
+	UpdateRequest string
+	// UnsetRequest is the alter request with Unset of the argument, empty if it cannot be unset
+	UnsetRequest string
+	// ReadValue is the value of the argument in ShowByID (object) or Describe (details) output, empty if not found there
+	ReadValue string
+	// ConfigPlaceholder is the value used in the acceptance test configuration
+	ConfigPlaceholder string
+}
+
+// ResourceOutputField is a single computed field of the data source, mapped from Show output
+type ResourceOutputField struct {
+	Name       string
+	SchemaType string
+	// Value is the expression on the Show output element (named after the singular interface name)
+	Value string
+	// NotNilCondition is set for pointer fields which are set only if not nil
+	NotNilCondition string
+}
+
+// NewResource creates resource model from the interface, the interface has to be preprocessed (e.g. options named)
+func NewResource(i *Interface) *Resource {
+	r := &Resource{Interface: i}
+	r.Arguments = r.identifierArguments()
+	create, ok := i.operation(OperationKindCreate)
+	if !ok {
+		log.Panicf("Resource %s requires Create operation", i.Name)
+	}
+	for _, f := range create.OptsField.Fields {
+		if !f.IsExported() || !f.ShouldBeInDto() || slices.Contains(skippedResourceFields, f.Name) {
+			continue
+		}
+		argument, ok := r.argument(f)
+		if !ok {
+			r.Unmapped = append(r.Unmapped, f.Name)
+			continue
+		}
+		r.Arguments = append(r.Arguments, argument)
+	}
+	// update requests are created when all the arguments are known, because they can require values of other arguments
+	for _, a := range r.Arguments {
+		if a.IsIdentifier {
+			continue
+		}
+		a.UpdateRequest, a.UnsetRequest = r.updateRequests(a.FieldName)
+		a.ForceNew = a.UpdateRequest == ""
+	}
+	r.RenameRequest = r.renameRequest()
+	if r.RenameRequest != "" {
+		for _, a := range r.Arguments {
+			if a.Name == "name" {
+				a.ForceNew = false
+			}
+		}
+	}
+	return r
+}
+
+// ResourceName returns the resource type name, e.g. snowflake_network_rule
+func (r *Resource) ResourceName() string {
+	return "snowflake_" + fieldNameToSnakeCase(r.NameSingular)
+}
+
+// DataSourceName returns the data source type name, e.g. snowflake_network_rules
+func (r *Resource) DataSourceName() string {
+	return "snowflake_" + fieldNameToSnakeCase(r.Name)
+}
+
+// DataSourceListName returns the name of data source field listing the objects, e.g. network_rules
+func (r *Resource) DataSourceListName() string {
+	return fieldNameToSnakeCase(r.Name)
+}
+
+// ObjectName returns name of the object used in descriptions and error messages, e.g. network rule
+func (r *Resource) ObjectName() string {
+	return strings.ReplaceAll(fieldNameToSnakeCase(r.NameSingular), "_", " ")
+}
+
+// ObjectNamePlural returns plural name of the objects used in descriptions, e.g. network rules
+func (r *Resource) ObjectNamePlural() string {
+	return strings.ReplaceAll(fieldNameToSnakeCase(r.Name), "_", " ")
+}
+
+// VariableName returns name of the variable holding the object, e.g. networkRule
+func (r *Resource) VariableName() string {
+	return startingWithLowerCase(r.NameSingular)
+}
+
+// IdentifierValue returns the expression creating the identifier from the resource data
+func (r *Resource) IdentifierValue() string {
+	var parts []string
+	for _, a := range r.Arguments {
+		if a.IsIdentifier {
+			parts = append(parts, fmt.Sprintf("d.Get(%q).(string)", a.Name))
+		}
+	}
+	return fmt.Sprintf("sdk.New%s(%s)", r.IdentifierKind, strings.Join(parts, ", "))
+}
+
+// TestIdentifierValue returns the expression creating the identifier in the acceptance test
+func (r *Resource) TestIdentifierValue() string {
+	switch r.IdentifierKind {
+	case "AccountObjectIdentifier":
+		return "sdk.NewAccountObjectIdentifier(name)"
+	case "DatabaseObjectIdentifier":
+		return "sdk.NewDatabaseObjectIdentifier(acc.TestDatabaseName, name)"
+	default:
+		return "sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, name)"
+	}
+}
+
+// TestConfigArguments returns the arguments of the acceptance test configuration format (identifier parts)
+func (r *Resource) TestConfigArguments() string {
+	readValues := map[string]string{"database": "id.DatabaseName()", "schema": "id.SchemaName()", "name": "id.Name()"}
+	var arguments []string
+	for _, a := range r.Arguments {
+		if a.IsIdentifier {
+			arguments = append(arguments, readValues[a.Name])
+		}
+	}
+	return strings.Join(arguments, ", ")
+}
+
+// AlterRequestKind returns the type of the alter request used in the update
+func (r *Resource) AlterRequestKind() string {
+	alter, _ := r.operation(OperationKindAlter)
+	return "*sdk." + alter.OptsField.DtoDecl()
+}
+
+// CreateRequest returns the create request with all the required arguments
+func (r *Resource) CreateRequest() string {
+	create, _ := r.operation(OperationKindCreate)
+	return r.newRequest(create.OptsField, r.valueFromResourceData)
+}
+
+// OptionalArguments returns arguments set with builder methods of the create request
+func (r *Resource) OptionalArguments() []*ResourceArgument {
+	var arguments []*ResourceArgument
+	for _, a := range r.Arguments {
+		if !a.IsIdentifier && !a.Required {
+			arguments = append(arguments, a)
+		}
+	}
+	return arguments
+}
+
+// UpdatableArguments returns arguments which can be altered in place
+func (r *Resource) UpdatableArguments() []*ResourceArgument {
+	var arguments []*ResourceArgument
+	for _, a := range r.Arguments {
+		if a.UpdateRequest != "" {
+			arguments = append(arguments, a)
+		}
+	}
+	return arguments
+}
+
+// HasEnumArguments checks if validation package is used by the resource
+func (r *Resource) HasEnumArguments() bool {
+	return slices.ContainsFunc(r.Arguments, func(a *ResourceArgument) bool { return a.ValidValues != "" })
+}
+
+// HasUpdate checks if Update function is generated
+func (r *Resource) HasUpdate() bool {
+	return len(r.UpdatableArguments()) > 0 || r.HasRename()
+}
+
+// HasRename checks if the name is changed in place with RenameTo of Alter options
+func (r *Resource) HasRename() bool {
+	return r.RenameRequest != ""
+}
+
+// NewIdentifierValue returns the expression creating the identifier with the new name, the rest of the parts is taken from id
+func (r *Resource) NewIdentifierValue() string {
+	readValues := map[string]string{"database": "id.DatabaseName()", "schema": "id.SchemaName()", "name": `d.Get("name").(string)`}
+	var parts []string
+	for _, a := range r.Arguments {
+		if a.IsIdentifier {
+			parts = append(parts, readValues[a.Name])
+		}
+	}
+	return fmt.Sprintf("sdk.New%s(%s)", r.IdentifierKind, strings.Join(parts, ", "))
+}
+
+// HasRead checks if the object can be read with ShowByID
+func (r *Resource) HasRead() bool {
+	_, ok := r.operation(OperationKindShowByID)
+	return ok
+}
+
+// HasDescribeDetails checks if Describe returns a single object used to read the arguments
+func (r *Resource) HasDescribeDetails() bool {
+	describe, ok := r.operation(OperationKindDescribe)
+	return ok && describe.DescribeKind != nil && *describe.DescribeKind == DescriptionMappingKindSingleValue &&
+		slices.ContainsFunc(r.Arguments, func(a *ResourceArgument) bool { return strings.Contains(a.ReadValue, "details.") })
+}
+
+// HasDrop checks if Delete is implemented with Drop operation
+func (r *Resource) HasDrop() bool {
+	_, ok := r.operation(OperationKindDrop)
+	return ok
+}
+
+// DropRequest returns the drop request for the identifier
+func (r *Resource) DropRequest() string {
+	drop, _ := r.operation(OperationKindDrop)
+	return r.newRequest(drop.OptsField, func(f *Field) string {
+		if f.Name == "name" {
+			return "id"
+		}
+		return ""
+	})
+}
+
+// HasDataSource checks if the data source can be generated (it requires Show operation)
+func (r *Resource) HasDataSource() bool {
+	show, ok := r.operation(OperationKindShow)
+	return ok && show.ShowMapping != nil
+}
+
+// ShowRequest returns the show request without any filters
+func (r *Resource) ShowRequest() string {
+	show, _ := r.operation(OperationKindShow)
+	return r.newRequest(show.OptsField, func(*Field) string { return "" })
+}
+
+// HasShowFilter checks if Show options contain given filter (e.g. Like or In)
+func (r *Resource) HasShowFilter(filter string) bool {
+	show, ok := r.operation(OperationKindShow)
+	if !ok {
+		return false
+	}
+	_, ok = fieldByName(show.OptsField, filter)
+	return ok
+}
+
+// IsSchemaObject checks if objects are listed IN SCHEMA (otherwise IN DATABASE is used)
+func (r *Resource) IsSchemaObject() bool {
+	return strings.HasPrefix(r.IdentifierKind, "SchemaObjectIdentifier")
+}
+
+// IsAccountObject checks if objects cannot be listed IN DATABASE or IN SCHEMA
+func (r *Resource) IsAccountObject() bool {
+	return r.IdentifierKind == "AccountObjectIdentifier"
+}
+
+// OutputFields returns fields of the data source list element mapped from the Show output
+func (r *Resource) OutputFields() []*ResourceOutputField {
+	show, _ := r.operation(OperationKindShow)
+	var fields []*ResourceOutputField
+	for _, f := range show.ShowMapping.To.Fields {
+		schemaType, value, ok := r.outputValue(f, r.VariableName(), true)
+		if !ok {
+			continue
+		}
+		field := &ResourceOutputField{
+			Name:       fieldNameToSnakeCase(f.Name),
+			SchemaType: schemaType,
+			Value:      value,
+		}
+		if f.IsPointer() {
+			field.NotNilCondition = fmt.Sprintf("%s.%s != nil", r.VariableName(), f.Name)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// UnmappedOutputFields returns fields of the Show output which have to be added to the data source by hand
+func (r *Resource) UnmappedOutputFields() []string {
+	show, _ := r.operation(OperationKindShow)
+	var unmapped []string
+	for _, f := range show.ShowMapping.To.Fields {
+		if _, _, ok := r.outputValue(f, r.VariableName(), true); !ok {
+			unmapped = append(unmapped, f.Name)
+		}
+	}
+	return unmapped
+}
+
+func (r *Resource) identifierArguments() []*ResourceArgument {
+	var names []string
+	switch {
+	case r.IdentifierKind == "AccountObjectIdentifier":
+		names = []string{"name"}
+	case r.IdentifierKind == "DatabaseObjectIdentifier":
+		names = []string{"database", "name"}
+	case r.IsSchemaObject():
+		names = []string{"database", "schema", "name"}
+	default:
+		log.Panicf("Resource %s cannot be generated for %s", r.Name, r.IdentifierKind)
+	}
+	readValues := map[string]string{"database": "id.DatabaseName()", "schema": "id.SchemaName()", "name": "id.Name()"}
+	arguments := make([]*ResourceArgument, len(names))
+	for i, name := range names {
+		arguments[i] = &ResourceArgument{
+			Name:              name,
+			SchemaType:        schemaTypes["string"],
+			Required:          true,
+			ForceNew:          true,
+			IsIdentifier:      true,
+			ReadValue:         readValues[name],
+			ConfigPlaceholder: fmt.Sprintf(`"%%[%d]s"`, i+1),
+		}
+	}
+	return arguments
+}
+
+// argument maps create options field to the argument, if the field kind is supported
+func (r *Resource) argument(f *Field) (*ResourceArgument, bool) {
+	value, ok := r.valueFromSchema(f.Kind, "v")
+	if !ok {
+		return nil, false
+	}
+	kind := f.KindNoPtr()
+	a := &ResourceArgument{
+		Name:              fieldNameToSnakeCase(f.Name),
+		FieldName:         f.Name,
+		SchemaType:        schemaTypes["string"],
+		Required:          f.Required,
+		ForceNew:          true,
+		Value:             value,
+		ConfigPlaceholder: wrapWith(fieldNameToSnakeCase(f.Name), `"`),
+	}
+	if schemaType, ok := schemaTypes[kind]; ok {
+		a.SchemaType = schemaType
+	}
+	switch kind {
+	case "bool":
+		a.ConfigPlaceholder = "true"
+	case "int", "float64":
+		a.ConfigPlaceholder = "1"
+	}
+	if e, ok := r.enum(kind); ok {
+		a.ValidValues = "sdk." + e.AllValuesName()
+		if len(e.Values) > 0 {
+			a.ConfigPlaceholder = wrapWith(e.Values[0].Value, `"`)
+		}
+	}
+	a.ReadValue = r.readValue(f.Name)
+	return a, true
+}
+
+// valueFromSchema returns expression converting the schema value (interface{}) to the given kind
+func (r *Resource) valueFromSchema(kind string, v string) (string, bool) {
+	isPointer := strings.HasPrefix(kind, "*")
+	kind = strings.TrimPrefix(kind, "*")
+	var value string
+	switch {
+	case schemaTypes[kind] != "":
+		value = fmt.Sprintf("%s.(%s)", v, kind)
+		if isPointer {
+			return "sdk." + pointerTo(value, kind), true
+		}
+		return value, true
+	case kind == "AccountObjectIdentifier":
+		value = fmt.Sprintf("sdk.NewAccountObjectIdentifier(%s.(string))", v)
+	default:
+		if _, ok := r.enum(kind); !ok {
+			return "", false
+		}
+		value = fmt.Sprintf("sdk.%s(%s.(string))", kind, v)
+	}
+	if isPointer {
+		return fmt.Sprintf("sdk.Pointer(%s)", value), true
+	}
+	return value, true
+}
+
+// renameRequest returns alter request renaming the object to newId, if the alter options contain RenameTo of the identifier kind
+func (r *Resource) renameRequest() string {
+	alter, ok := r.operation(OperationKindAlter)
+	if !ok {
+		return ""
+	}
+	field, ok := fieldByName(alter.OptsField, "RenameTo")
+	if !ok || field.KindNoPtr() != r.IdentifierKind {
+		return ""
+	}
+	value := "newId"
+	if field.IsPointer() {
+		value = "&newId"
+	}
+	return r.nestedRequestWith(alter.OptsField, field, value)
+}
+
+// updateRequests returns alter requests setting and unsetting given field, if the alter options contain Set and Unset structs with it
+func (r *Resource) updateRequests(fieldName string) (string, string) {
+	alter, ok := r.operation(OperationKindAlter)
+	if !ok {
+		return "", ""
+	}
+	alterRequest := r.newRequest(alter.OptsField, r.valueFromResourceData)
+	var updateRequest, unsetRequest string
+	if set, ok := fieldByName(alter.OptsField, "Set"); ok && set.IsStruct() {
+		if field, ok := fieldByName(set, fieldName); ok {
+			if setValue, ok := r.valueFromSchema(field.Kind, "v"); ok {
+				updateRequest = fmt.Sprintf("%s.WithSet(%s)", alterRequest, r.nestedRequestWith(set, field, setValue))
+			}
+		}
+	}
+	if unset, ok := fieldByName(alter.OptsField, "Unset"); ok && unset.IsStruct() && updateRequest != "" {
+		if field, ok := fieldByName(unset, fieldName); ok && field.KindNoPtr() == "bool" {
+			unsetValue := "true"
+			if field.IsPointer() {
+				unsetValue = "sdk.Bool(true)"
+			}
+			unsetRequest = fmt.Sprintf("%s.WithUnset(%s)", alterRequest, r.nestedRequestWith(unset, field, unsetValue))
+		}
+	}
+	return updateRequest, unsetRequest
+}
+
+// nestedRequestWith returns request of the nested struct (e.g. Set) with given field set, the other required fields
+// of the struct are taken from the resource data
+func (r *Resource) nestedRequestWith(parent *Field, field *Field, value string) string {
+	request := r.newRequest(parent, func(f *Field) string {
+		if f == field {
+			return value
+		}
+		return r.valueFromResourceData(f)
+	})
+	if !field.Required {
+		request += fmt.Sprintf(".With%s(%s)", field.Name, value)
+	}
+	return request
+}
+
+// newRequest returns constructor call of the request for given options (or nested struct), the required fields
+// are taken from valueFor or set to zero values (with a TODO comment) if valueFor returns empty string
+func (r *Resource) newRequest(field *Field, valueFor func(*Field) string) string {
+	var args []string
+	for _, f := range field.Fields {
+		if !f.ShouldBeInDto() || !f.Required {
+			continue
+		}
+		value := valueFor(f)
+		if value == "" {
+			value = fmt.Sprintf("%s /* TODO: %s */", zeroValue(f.DtoKind()), f.Name)
+		}
+		args = append(args, value)
+	}
+	return fmt.Sprintf("sdk.New%s(%s)", field.DtoDecl(), strings.Join(args, ", "))
+}
+
+// readValue returns the value of the field in ShowByID output (object) or single value Describe output (details)
+func (r *Resource) readValue(fieldName string) string {
+	if show, ok := r.operation(OperationKindShow); ok && show.ShowMapping != nil && r.HasRead() {
+		if f, ok := fieldByName(show.ShowMapping.To, fieldName); ok {
+			if _, value, ok := r.outputValue(f, r.VariableName(), false); ok {
+				return value
+			}
+		}
+	}
+	if describe, ok := r.operation(OperationKindDescribe); ok && r.HasRead() && describe.DescribeMapping != nil &&
+		describe.DescribeKind != nil && *describe.DescribeKind == DescriptionMappingKindSingleValue {
+		if f, ok := fieldByName(describe.DescribeMapping.To, fieldName); ok {
+			if _, value, ok := r.outputValue(f, "details", false); ok {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+// outputValue returns the schema type and the value of the output field which can be set in resource data,
+// pointers are dereferenced if deref is set (it has to be checked before if they are not nil), otherwise only pointers
+// to primitive kinds are supported (they are handled by resource data)
+func (r *Resource) outputValue(f *Field, variable string, deref bool) (string, string, bool) {
+	kind := f.KindNoPtr()
+	value := fmt.Sprintf("%s.%s", variable, f.Name)
+	switch {
+	case f.IsPointer() && deref:
+		value = "*" + value
+	case f.IsPointer() && schemaTypes[kind] == "":
+		return "", "", false
+	}
+	switch {
+	case f.IsSlice():
+		return "", "", false
+	case schemaTypes[kind] != "":
+		return schemaTypes[kind], value, true
+	case kind == "time.Time":
+		return schemaTypes["string"], fmt.Sprintf("%s.String()", value), true
+	}
+	if _, ok := r.enum(kind); ok {
+		return schemaTypes["string"], fmt.Sprintf("string(%s)", value), true
+	}
+	return "", "", false
+}
+
+// valueFromResourceData returns the value of the field taken from the resource data (the identifier for the name of the object),
+// or empty string if there is no argument for the field
+func (r *Resource) valueFromResourceData(f *Field) string {
+	if f.Name == "name" {
+		return "id"
+	}
+	if a, ok := r.argumentFor(f.Name); ok {
+		value, _ := r.valueFromSchema(f.Kind, fmt.Sprintf("d.Get(%q)", a.Name))
+		return value
+	}
+	return ""
+}
+
+func (r *Resource) argumentFor(fieldName string) (*ResourceArgument, bool) {
+	for _, a := range r.Arguments {
+		if !a.IsIdentifier && a.FieldName == fieldName {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// zeroValue returns zero value of the kind used in sdk package
+func zeroValue(kind string) string {
+	switch {
+	case strings.HasPrefix(kind, "*"), strings.HasPrefix(kind, "[]"):
+		return "nil"
+	case kind == "string":
+		return `""`
+	case kind == "bool":
+		return "false"
+	case schemaTypes[kind] != "":
+		return "0"
+	default:
+		return fmt.Sprintf("*new(sdk.%s)", kind)
+	}
+}
+
+// fieldNameToSnakeCase converts field name to snake case, e.g. EntriesInValueList to entries_in_value_list
+func fieldNameToSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
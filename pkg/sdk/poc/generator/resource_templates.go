@@ -0,0 +1,420 @@
+package generator
+
+import "text/template"
+
+var ResourceTemplate, _ = template.New("resourceTemplate").Parse(`
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	{{- if .HasEnumArguments }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	{{- end }}
+)
+
+{{ $resource := . }}
+var {{ .VariableName }}Schema = map[string]*schema.Schema{
+	{{- range .Arguments }}
+	"{{ .Name }}": {
+		Type: {{ .SchemaType }},
+		{{- if .Required }}
+		Required: true,
+		{{- else }}
+		Optional: true,
+		{{- end }}
+		{{- if .ForceNew }}
+		ForceNew: true,
+		{{- end }}
+		{{- if .ValidValues }}
+		ValidateFunc: validation.StringInSlice(sdk.AsStringList({{ .ValidValues }}), false),
+		{{- end }}
+		{{- if eq .Name "name" }}
+		Description: "Specifies the identifier for the {{ $resource.ObjectName }}.",
+		{{- else if eq .Name "database" }}
+		Description: "The database in which to create the {{ $resource.ObjectName }}.",
+		{{- else if eq .Name "schema" }}
+		Description: "The schema in which to create the {{ $resource.ObjectName }}.",
+		{{- else }}
+		Description: "TODO: describe {{ .Name }} of the {{ $resource.ObjectName }}.",
+		{{- end }}
+	},
+	{{- end }}
+	{{- range .Unmapped }}
+	// TODO: add argument for {{ . }}
+	{{- end }}
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the {{ .ObjectName }}.",
+	},
+}
+
+// {{ .NameSingular }} returns a pointer to the resource representing a {{ .ObjectName }}.
+func {{ .NameSingular }}() *schema.Resource {
+	return &schema.Resource{
+		Create: Create{{ .NameSingular }},
+		Read:   Read{{ .NameSingular }},
+		{{- if .HasUpdate }}
+		Update: Update{{ .NameSingular }},
+		{{- end }}
+		Delete: Delete{{ .NameSingular }},
+
+		Schema: {{ .VariableName }}Schema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// Create{{ .NameSingular }} implements schema.CreateFunc.
+func Create{{ .NameSingular }}(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id := {{ .IdentifierValue }}
+
+	request := {{ .CreateRequest }}
+	{{- range .OptionalArguments }}
+	if v, ok := d.GetOk("{{ .Name }}"); ok {
+		request.With{{ .FieldName }}({{ .Value }})
+	}
+	{{- end }}
+
+	ctx := context.Background()
+	if err := client.{{ .Name }}.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating {{ .ObjectName }} %v err = %w", id.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return Read{{ .NameSingular }}(d, meta)
+}
+
+// Read{{ .NameSingular }} implements schema.ReadFunc.
+func Read{{ .NameSingular }}(d *schema.ResourceData, meta interface{}) error {
+	{{- if .HasRead }}
+	client := meta.(*provider.Context).Client
+	{{- end }}
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .IdentifierKind }})
+	{{- if .HasRead }}
+
+	ctx := context.Background()
+	{{ .VariableName }}, err := client.{{ .Name }}.ShowByID(ctx, id)
+	if err != nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] {{ .ObjectName }} (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	{{- if .HasDescribeDetails }}
+
+	details, err := client.{{ .Name }}.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+	{{- end }}
+	{{- else }}
+	// TODO: read the {{ .ObjectName }}, there is no ShowByID operation in the definition
+	log.Printf("[DEBUG] reading {{ .ObjectName }} (%s)", d.Id())
+	{{- end }}
+	{{- range .Arguments }}
+	{{- if .ReadValue }}
+
+	if err := d.Set("{{ .Name }}", {{ .ReadValue }}); err != nil {
+		return err
+	}
+	{{- else }}
+
+	// TODO: set {{ .Name }}
+	{{- end }}
+	{{- end }}
+
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+{{- if .HasUpdate }}
+
+// Update{{ .NameSingular }} implements schema.UpdateFunc.
+func Update{{ .NameSingular }}(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .IdentifierKind }})
+	ctx := context.Background()
+	{{- if .HasRename }}
+
+	if d.HasChange("name") {
+		newId := {{ .NewIdentifierValue }}
+		if err := client.{{ .Name }}.Alter(ctx, {{ .RenameRequest }}); err != nil {
+			return fmt.Errorf("error renaming {{ .ObjectName }} %v to %v err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+	{{- end }}
+	{{- range .UpdatableArguments }}
+
+	if d.HasChange("{{ .Name }}") {
+		{{- if .UnsetRequest }}
+		var request {{ $resource.AlterRequestKind }}
+		if v, ok := d.GetOk("{{ .Name }}"); ok {
+			request = {{ .UpdateRequest }}
+		} else {
+			request = {{ .UnsetRequest }}
+		}
+		{{- else }}
+		v := d.Get("{{ .Name }}")
+		request := {{ .UpdateRequest }}
+		{{- end }}
+		if err := client.{{ $resource.Name }}.Alter(ctx, request); err != nil {
+			return fmt.Errorf("error updating {{ .Name }} of {{ $resource.ObjectName }} %v err = %w", id.Name(), err)
+		}
+	}
+	{{- end }}
+
+	return Read{{ .NameSingular }}(d, meta)
+}
+{{- end }}
+
+// Delete{{ .NameSingular }} implements schema.DeleteFunc.
+func Delete{{ .NameSingular }}(d *schema.ResourceData, meta interface{}) error {
+	{{- if .HasDrop }}
+	client := meta.(*provider.Context).Client
+	{{- end }}
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .IdentifierKind }})
+	{{- if .HasDrop }}
+
+	ctx := context.Background()
+	if err := client.{{ .Name }}.Drop(ctx, {{ .DropRequest }}); err != nil {
+		return fmt.Errorf("error deleting {{ .ObjectName }} %v err = %w", id.Name(), err)
+	}
+	{{- else }}
+	// TODO: delete the {{ .ObjectName }}, there is no Drop operation in the definition
+	log.Printf("[DEBUG] deleting {{ .ObjectName }} %v", id.Name())
+	{{- end }}
+
+	d.SetId("")
+	return nil
+}
+`)
+
+var DataSourceTemplate, _ = template.New("dataSourceTemplate").Parse(`
+package datasources
+
+import (
+	"context"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+{{ $resource := . }}
+{{- $plural := .NameLowerCased }}
+var {{ $plural }}Schema = map[string]*schema.Schema{
+	{{- if and (.HasShowFilter "In") (not .IsAccountObject) }}
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The database from which to return the {{ .ObjectNamePlural }} from.",
+	},
+	{{- if .IsSchemaObject }}
+	"schema": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"database"},
+		Description:  "The schema from which to return the {{ .ObjectNamePlural }} from.",
+	},
+	{{- end }}
+	{{- end }}
+	{{- if .HasShowFilter "Like" }}
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the command output by object name.",
+	},
+	{{- end }}
+	"{{ .DataSourceListName }}": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Lists {{ .ObjectNamePlural }}.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				{{- range .OutputFields }}
+				"{{ .Name }}": {
+					Type:        {{ .SchemaType }},
+					Computed:    true,
+					Description: "TODO: describe {{ .Name }} of the {{ $resource.ObjectName }}.",
+				},
+				{{- end }}
+				{{- range .UnmappedOutputFields }}
+				// TODO: add field for {{ . }}
+				{{- end }}
+			},
+		},
+	},
+}
+
+// {{ .Name }} Snowflake {{ .ObjectNamePlural }} resource.
+func {{ .Name }}() *schema.Resource {
+	return &schema.Resource{
+		Read:   Read{{ .Name }},
+		Schema: {{ $plural }}Schema,
+	}
+}
+
+// Read{{ .Name }} Reads the {{ .ObjectNamePlural }} metadata information.
+func Read{{ .Name }}(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	d.SetId("{{ .DataSourceListName }}_read")
+
+	request := {{ .ShowRequest }}
+	{{- if .HasShowFilter "Like" }}
+
+	if v, ok := d.GetOk("pattern"); ok {
+		request.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+	{{- end }}
+	{{- if and (.HasShowFilter "In") (not .IsAccountObject) }}
+
+	if v, ok := d.GetOk("database"); ok {
+		databaseName := v.(string)
+		{{- if .IsSchemaObject }}
+
+		if v, ok := d.GetOk("schema"); ok {
+			request.WithIn(&sdk.In{
+				Schema: sdk.NewDatabaseObjectIdentifier(databaseName, v.(string)),
+			})
+		} else {
+			request.WithIn(&sdk.In{
+				Database: sdk.NewAccountObjectIdentifier(databaseName),
+			})
+		}
+		{{- else }}
+		request.WithIn(&sdk.In{
+			Database: sdk.NewAccountObjectIdentifier(databaseName),
+		})
+		{{- end }}
+	}
+	{{- end }}
+
+	list, err := client.{{ .Name }}.Show(ctx, request)
+	if err != nil {
+		log.Printf("[DEBUG] failed to list {{ .ObjectNamePlural }} (%s)", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	{{ $plural }} := make([]map[string]any, 0, len(list))
+	for _, {{ .VariableName }} := range list {
+		{{ .VariableName }}Map := map[string]any{}
+		{{- range .OutputFields }}
+		{{- if .NotNilCondition }}
+		if {{ .NotNilCondition }} {
+			{{ $resource.VariableName }}Map["{{ .Name }}"] = {{ .Value }}
+		}
+		{{- else }}
+		{{ $resource.VariableName }}Map["{{ .Name }}"] = {{ .Value }}
+		{{- end }}
+		{{- end }}
+		{{ $plural }} = append({{ $plural }}, {{ .VariableName }}Map)
+	}
+
+	return d.Set("{{ .DataSourceListName }}", {{ $plural }})
+}
+`)
+
+var ResourceAcceptanceTestTemplate, _ = template.New("resourceAcceptanceTestTemplate").Parse(`
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_{{ .NameSingular }}_basic(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	id := {{ .TestIdentifierValue }}
+	resourceName := "{{ .ResourceName }}.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheck{{ .NameSingular }}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: {{ .VariableName }}Config(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceName, "qualified_name", id.FullyQualifiedName()),
+					// TODO: check the other attributes and add steps changing the attributes in place
+				),
+			},
+			// IMPORT
+			{
+				Config:            {{ .VariableName }}Config(id),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func {{ .VariableName }}Config(id sdk.{{ .IdentifierKind }}) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{ .ResourceName }}" "test" {
+	{{- range .Arguments }}
+	{{- if .Required }}
+	{{ .Name }} = {{ .ConfigPlaceholder }}{{ if not .IsIdentifier }} # TODO: set correct value{{ end }}
+	{{- end }}
+	{{- end }}
+}
+` + "`" + `, {{ .TestConfigArguments }})
+}
+
+func testAccCheck{{ .NameSingular }}Destroy(s *terraform.State) error {
+	client := acc.TestAccProvider.Meta().(*provider.Context).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "{{ .ResourceName }}" {
+			continue
+		}
+		ctx := context.Background()
+		id := helpers.DecodeSnowflakeID(rs.Primary.ID).(sdk.{{ .IdentifierKind }})
+		{{- if .HasRead }}
+		existing{{ .NameSingular }}, err := client.{{ .Name }}.ShowByID(ctx, id)
+		if err == nil {
+			return fmt.Errorf("{{ .ObjectName }} %v still exists", existing{{ .NameSingular }}.Name)
+		}
+		{{- else }}
+		// TODO: check if the {{ .ObjectName }} does not exist
+		_, _, _ = client, ctx, id
+		{{- end }}
+	}
+	return nil
+}
+`)
@@ -0,0 +1,218 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResource(t *testing.T) {
+	createStreamlit := func() *QueryStruct {
+		return NewQueryStruct("CreateStreamlit").
+			Create().
+			SQL("STREAMLIT").
+			Name().
+			TextAssignment("ROOT_LOCATION", ParameterOptions().SingleQuotes().Required()).
+			TextAssignment("MAIN_FILE", ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("TITLE", ParameterOptions().SingleQuotes()).
+			OptionalComment()
+	}
+	streamlitSet := func() *QueryStruct {
+		return NewQueryStruct("StreamlitSet").
+			TextAssignment("ROOT_LOCATION", ParameterOptions().SingleQuotes().Required()).
+			TextAssignment("MAIN_FILE", ParameterOptions().SingleQuotes().Required()).
+			OptionalComment()
+	}
+	streamlitUnset := func() *QueryStruct {
+		return NewQueryStruct("StreamlitUnset").
+			OptionalSQL("COMMENT")
+	}
+	resource := func(alter *QueryStruct) *Resource {
+		def := NewInterface("Streamlits", "Streamlit", "SchemaObjectIdentifier").
+			CreateOperation("https://docs.snowflake.com/en/sql-reference/sql/create-streamlit", createStreamlit())
+		if alter != nil {
+			def.AlterOperation("https://docs.snowflake.com/en/sql-reference/sql/alter-streamlit", alter)
+		}
+		preprocessResourceDefinition(def)
+		return NewResource(def)
+	}
+	argument := func(t *testing.T, r *Resource, name string) *ResourceArgument {
+		t.Helper()
+		for _, a := range r.Arguments {
+			if a.Name == name {
+				return a
+			}
+		}
+		require.FailNow(t, fmt.Sprintf("argument %s not found", name))
+		return nil
+	}
+
+	t.Run("identifier parts and create options are mapped to arguments", func(t *testing.T) {
+		r := resource(nil)
+
+		var names []string
+		for _, a := range r.Arguments {
+			names = append(names, a.Name)
+		}
+		assert.Equal(t, []string{"database", "schema", "name", "root_location", "main_file", "title", "comment"}, names)
+		assert.True(t, argument(t, r, "root_location").Required)
+		assert.False(t, argument(t, r, "comment").Required)
+		assert.Equal(t, `sdk.NewCreateStreamlitRequest(id, d.Get("root_location").(string), d.Get("main_file").(string))`, r.CreateRequest())
+	})
+
+	t.Run("all arguments are ForceNew without alter", func(t *testing.T) {
+		r := resource(nil)
+
+		for _, a := range r.Arguments {
+			assert.True(t, a.ForceNew, a.Name)
+		}
+		assert.False(t, r.HasUpdate())
+		assert.False(t, r.HasRename())
+	})
+
+	t.Run("arguments changed with Set are updated in place", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalQueryStructField("Set", streamlitSet(), KeywordOptions().SQL("SET")))
+
+		comment := argument(t, r, "comment")
+		assert.False(t, comment.ForceNew)
+		assert.Equal(t, `sdk.NewAlterStreamlitRequest(id).WithSet(sdk.NewStreamlitSetRequest(d.Get("root_location").(string), d.Get("main_file").(string)).WithComment(sdk.String(v.(string))))`, comment.UpdateRequest)
+		assert.Empty(t, comment.UnsetRequest)
+		assert.True(t, r.HasUpdate())
+	})
+
+	t.Run("arguments required in Set are updated in place with the other required arguments taken from the resource data", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalQueryStructField("Set", streamlitSet(), KeywordOptions().SQL("SET")))
+
+		rootLocation := argument(t, r, "root_location")
+		assert.False(t, rootLocation.ForceNew)
+		assert.Equal(t, `sdk.NewAlterStreamlitRequest(id).WithSet(sdk.NewStreamlitSetRequest(v.(string), d.Get("main_file").(string)))`, rootLocation.UpdateRequest)
+
+		mainFile := argument(t, r, "main_file")
+		assert.False(t, mainFile.ForceNew)
+		assert.Equal(t, `sdk.NewAlterStreamlitRequest(id).WithSet(sdk.NewStreamlitSetRequest(d.Get("root_location").(string), v.(string)))`, mainFile.UpdateRequest)
+	})
+
+	t.Run("arguments missing in Set are ForceNew", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalQueryStructField("Set", streamlitSet(), KeywordOptions().SQL("SET")))
+
+		title := argument(t, r, "title")
+		assert.True(t, title.ForceNew)
+		assert.Empty(t, title.UpdateRequest)
+	})
+
+	t.Run("arguments in Unset are unset in place", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalQueryStructField("Set", streamlitSet(), KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", streamlitUnset(), KeywordOptions().SQL("UNSET")))
+
+		assert.Equal(t, `sdk.NewAlterStreamlitRequest(id).WithUnset(sdk.NewStreamlitUnsetRequest().WithComment(sdk.Bool(true)))`, argument(t, r, "comment").UnsetRequest)
+		assert.Empty(t, argument(t, r, "root_location").UnsetRequest)
+	})
+
+	t.Run("name is changed in place with RenameTo", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalIdentifier("RenameTo", "*SchemaObjectIdentifier", IdentifierOptions().SQL("RENAME TO")))
+
+		assert.True(t, r.HasRename())
+		assert.True(t, r.HasUpdate())
+		assert.Equal(t, "sdk.NewAlterStreamlitRequest(id).WithRenameTo(&newId)", r.RenameRequest)
+		assert.Equal(t, `sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))`, r.NewIdentifierValue())
+		assert.False(t, argument(t, r, "name").ForceNew)
+		assert.True(t, argument(t, r, "database").ForceNew)
+		assert.True(t, argument(t, r, "schema").ForceNew)
+	})
+
+	t.Run("RenameTo of other identifier kind is not used", func(t *testing.T) {
+		r := resource(NewQueryStruct("AlterStreamlit").
+			Alter().
+			SQL("STREAMLIT").
+			Name().
+			OptionalIdentifier("RenameTo", "*AccountObjectIdentifier", IdentifierOptions().SQL("RENAME TO")))
+
+		assert.False(t, r.HasRename())
+		assert.False(t, r.HasUpdate())
+		assert.True(t, argument(t, r, "name").ForceNew)
+	})
+}
+
+func TestGenerateResource(t *testing.T) {
+	def := NewInterface("Sequences", "Sequence", "SchemaObjectIdentifier").
+		CreateOperation("https://docs.snowflake.com/en/sql-reference/sql/create-sequence",
+			NewQueryStruct("CreateSequence").
+				Create().
+				SQL("SEQUENCE").
+				Name().
+				OptionalComment(),
+		).
+		AlterOperation("https://docs.snowflake.com/en/sql-reference/sql/alter-sequence",
+			NewQueryStruct("AlterSequence").
+				Alter().
+				SQL("SEQUENCE").
+				Name().
+				OptionalIdentifier("RenameTo", "*SchemaObjectIdentifier", IdentifierOptions().SQL("RENAME TO")).
+				OptionalQueryStructField("Set", NewQueryStruct("SequenceSet").OptionalComment(), KeywordOptions().SQL("SET")),
+		)
+	preprocessResourceDefinition(def)
+
+	buffer := bytes.Buffer{}
+	GenerateResource(&buffer, def)
+	src, err := format.Source(buffer.Bytes())
+	require.NoError(t, err)
+
+	assert.Contains(t, string(src), `
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.Sequences.Alter(ctx, sdk.NewAlterSequenceRequest(id).WithRenameTo(&newId)); err != nil {
+			return fmt.Errorf("error renaming sequence %v to %v err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+`)
+	assert.Contains(t, string(src), `
+	if d.HasChange("comment") {
+		v := d.Get("comment")
+		request := sdk.NewAlterSequenceRequest(id).WithSet(sdk.NewSequenceSetRequest().WithComment(sdk.String(v.(string))))
+`)
+}
+
+// preprocessResourceDefinition names the options and sets the parents of the fields, like the generator does before generation
+func preprocessResourceDefinition(definition *Interface) {
+	var setParent func(field *Field)
+	setParent = func(field *Field) {
+		for _, f := range field.Fields {
+			f.Parent = field
+			setParent(f)
+		}
+	}
+	for _, o := range definition.Operations {
+		o.ObjectInterface = definition
+		if o.OptsField != nil {
+			o.OptsField.Name = fmt.Sprintf("%s%sOptions", o.Name, o.ObjectInterface.NameSingular)
+			o.OptsField.Kind = fmt.Sprintf("%s%sOptions", o.Name, o.ObjectInterface.NameSingular)
+			setParent(o.OptsField)
+		}
+	}
+}
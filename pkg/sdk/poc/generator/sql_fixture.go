@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"slices"
+)
+
+// SQLFixture is a single options struct rendered in the golden SQL tests
+type SQLFixture struct {
+	// Name is the subtest name, e.g. "all options"
+	Name string
+	// AllFields fills every field of the options
+	AllFields bool
+	// FieldPaths fills only the given fields, nested fields are separated with dots (e.g. "Set.Comment")
+	FieldPaths []string
+	// ClearedPaths are reset after filling, because they conflict with the other filled fields (e.g. IfNotExists with OrReplace)
+	ClearedPaths []string
+	// EnumValues sets the enum fields to one of the allowed values
+	EnumValues []SQLFixtureEnumValue
+}
+
+// SQLFixtureEnumValue sets the field under Path to the first value of the enum
+type SQLFixtureEnumValue struct {
+	Path string
+	// AllValuesName is the name of the slice with all the enum values, e.g. AllStreamSourceTypes
+	AllValuesName string
+}
+
+// SQLFixtures returns fixtures covering the operation in the golden SQL tests: options with only required fields set and
+// options with every field set; alter is covered with every action separately instead, because exactly one action can be set.
+// The fields are chosen with the validations of the definition, so every fixture passes the validation of the options.
+func (s *Operation) SQLFixtures() []SQLFixture {
+	if s.Name == string(OperationKindAlter) {
+		if actions := alterActions(s.OptsField); len(actions) > 0 {
+			fixtures := make([]SQLFixture, 0, len(actions))
+			for _, action := range actions {
+				fixture := SQLFixture{Name: action}
+				fixture.addFields(s.OptsField, "", false, action)
+				fixtures = append(fixtures, fixture)
+			}
+			return fixtures
+		}
+	}
+	basic := SQLFixture{Name: "basic"}
+	basic.addFields(s.OptsField, "", false, "")
+	all := SQLFixture{Name: "all options", AllFields: true}
+	all.addFields(s.OptsField, "", true, "")
+	return []SQLFixture{basic, all}
+}
+
+// alterActions returns the fields of which exactly one (or at least one) has to be set in alter, e.g. Set, Unset and RenameTo,
+// in the order of the fields
+func alterActions(optsField *Field) []string {
+	var actions []string
+	for _, field := range optsField.Fields {
+		if slices.ContainsFunc(optsField.Validations, func(v *Validation) bool {
+			return (v.Type == ExactlyOneValueSet || v.Type == AtLeastOneValueSet) && slices.Contains(v.FieldNames, field.Name)
+		}) {
+			actions = append(actions, field.Name)
+		}
+	}
+	return actions
+}
+
+// addFields adds the fields of the struct to the fixture: every valid field (all) or only the ones needed to pass the validation.
+// The preferred field is chosen when it's one of the fields of which only one can be set, and it's filled completely.
+func (f *SQLFixture) addFields(field *Field, prefix string, all bool, preferred string) {
+	chosen, excluded := fixtureFieldsSelection(field, preferred)
+	for _, child := range field.Fields {
+		path := prefix + child.Name
+		if !child.IsExported() {
+			// the name is set when the options are created, the other unexported fields only when they're required (e.g. the source of clone)
+			if chosen[child.Name] && child.Name != "name" {
+				f.FieldPaths = append(f.FieldPaths, path)
+			}
+			continue
+		}
+		switch {
+		case excluded[child.Name]:
+			if all {
+				f.ClearedPaths = append(f.ClearedPaths, path)
+			}
+		case enumValidated(field, child.Name):
+			if all || chosen[child.Name] {
+				f.EnumValues = append(f.EnumValues, SQLFixtureEnumValue{Path: path, AllValuesName: enumAllValuesName(child.KindNoPtr())})
+			}
+		case all:
+			if child.IsStruct() {
+				f.addFields(child, path+".", true, "")
+			}
+		case child.Name == preferred, chosen[child.Name] && !child.IsStruct():
+			f.FieldPaths = append(f.FieldPaths, path)
+			if child.IsStruct() {
+				f.addFields(child, path+".", true, "")
+			}
+		case chosen[child.Name]:
+			before := len(f.FieldPaths) + len(f.EnumValues)
+			f.addFields(child, path+".", false, "")
+			if len(f.FieldPaths)+len(f.EnumValues) == before {
+				// nothing is required inside, so the struct is filled completely
+				f.FieldPaths = append(f.FieldPaths, path)
+				f.addFields(child, path+".", true, "")
+			}
+		}
+	}
+}
+
+// fixtureFieldsSelection returns the children of the struct which have to be set to pass the validation (chosen) and the ones
+// which cannot be set together with them (excluded), e.g. IfNotExists when OrReplace is set
+func fixtureFieldsSelection(field *Field, preferred string) (map[string]bool, map[string]bool) {
+	chosen, excluded := make(map[string]bool), make(map[string]bool)
+	for _, child := range field.Fields {
+		// structs which are not pointers are always set, so their validations have to pass too
+		if child.IsExported() && (child.Required || child.IsStruct() && !child.IsPointer() && !child.IsSlice()) {
+			chosen[child.Name] = true
+		}
+	}
+	for _, name := range field.SQLFixtureFields {
+		chosen[name] = true
+	}
+	for _, v := range field.Validations {
+		if v.Type == ValidIdentifier || v.Type == ValidateValueSet {
+			for _, name := range v.FieldNames {
+				chosen[name] = true
+			}
+		}
+	}
+	for _, v := range field.Validations {
+		if v.Type != ExactlyOneValueSet && v.Type != AtLeastOneValueSet {
+			continue
+		}
+		choice := v.FieldNames[0]
+		if slices.Contains(v.FieldNames, preferred) {
+			choice = preferred
+		} else if i := slices.IndexFunc(v.FieldNames, func(name string) bool { return chosen[name] }); i >= 0 {
+			choice = v.FieldNames[i]
+		}
+		chosen[choice] = true
+		for _, name := range v.FieldNames {
+			if name != choice && (v.Type == ExactlyOneValueSet || name != preferred && preferred != "") {
+				excluded[name] = true
+			}
+		}
+	}
+	for _, v := range field.Validations {
+		if v.Type != ConflictingFields {
+			continue
+		}
+		kept := v.FieldNames[0]
+		if i := slices.IndexFunc(v.FieldNames, func(name string) bool { return chosen[name] }); i >= 0 {
+			kept = v.FieldNames[i]
+		}
+		for _, name := range v.FieldNames {
+			if name != kept {
+				excluded[name] = true
+				delete(chosen, name)
+			}
+		}
+	}
+	return chosen, excluded
+}
+
+func enumValidated(field *Field, name string) bool {
+	return slices.ContainsFunc(field.Validations, func(v *Validation) bool {
+		return v.Type == ValidEnumValue && v.FieldNames[0] == name
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"slices"
+	"strings"
 	"text/template"
 )
 
@@ -17,11 +19,15 @@ var (
 func GenerateInterface(writer io.Writer, def *Interface) {
 	generatePackageDirective(writer)
 	printTo(writer, InterfaceTemplate, def)
+	for _, e := range def.Enums {
+		printTo(writer, EnumTemplate, e)
+	}
 	for _, o := range def.Operations {
 		if o.OptsField != nil {
 			generateOptionsStruct(writer, o)
 		}
 	}
+	printTo(writer, DeclarationsTemplate, nil)
 }
 
 func generateOptionsStruct(writer io.Writer, operation *Operation) {
@@ -56,12 +62,14 @@ func generateStruct(writer io.Writer, field *Field) {
 
 func GenerateDtos(writer io.Writer, def *Interface) {
 	generatePackageDirective(writer)
+	printTo(writer, DtoGenerateDirectiveTemplate, dtoBuilderGeneratorPath())
 	printTo(writer, DtoTemplate, def)
 	for _, o := range def.Operations {
 		if o.OptsField != nil {
 			generateDtoDecls(writer, o.OptsField)
 		}
 	}
+	printTo(writer, DeclarationsTemplate, nil)
 }
 
 func generateDtoDecls(writer io.Writer, field *Field) {
@@ -80,6 +88,7 @@ func generateDtoDecls(writer io.Writer, field *Field) {
 func GenerateImplementation(writer io.Writer, def *Interface) {
 	generatePackageDirective(writer)
 	printTo(writer, ImplementationTemplate, def)
+	printTo(writer, DeclarationsTemplate, nil)
 }
 
 func GenerateUnitTests(writer io.Writer, def *Interface) {
@@ -87,13 +96,19 @@ func GenerateUnitTests(writer io.Writer, def *Interface) {
 	printTo(writer, TestFuncTemplate, def)
 }
 
+func GenerateSQLTests(writer io.Writer, def *Interface) {
+	generatePackageDirective(writer)
+	printTo(writer, SQLTestsTemplate, def)
+}
+
 func GenerateValidations(writer io.Writer, def *Interface) {
 	generatePackageDirective(writer)
 	printTo(writer, ValidationsImplTemplate, def)
+	printTo(writer, DeclarationsTemplate, nil)
 }
 
+// GenerateIntegrationTests generates the skeleton of the integration tests in the testint package
 func GenerateIntegrationTests(writer io.Writer, def *Interface) {
-	generatePackageDirective(writer)
 	printTo(writer, IntegrationTestsTemplate, def)
 }
 
@@ -101,9 +116,48 @@ func generatePackageDirective(writer io.Writer) {
 	printTo(writer, PackageTemplate, os.Getenv("GOPACKAGE"))
 }
 
+// dtoBuilderGeneratorPath returns the path of the dto builder generator relative to the directory the generator is run in
+// (go generate runs it in the directory of the definition), so the definitions outside pkg/sdk (e.g. poc/example) are supported too
+func dtoBuilderGeneratorPath() string {
+	const generatorDir = "dto-builder-generator"
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Panicln(err)
+	}
+	for dir := wd; dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, generatorDir, "main.go")); err == nil {
+			rel, err := filepath.Rel(wd, filepath.Join(dir, generatorDir, "main.go"))
+			if err != nil {
+				log.Panicln(err)
+			}
+			if !strings.HasPrefix(rel, "..") {
+				rel = "./" + rel
+			}
+			return filepath.ToSlash(rel)
+		}
+	}
+	return "./" + generatorDir + "/main.go"
+}
+
 func printTo(writer io.Writer, template *template.Template, model any) {
 	err := template.Execute(writer, model)
 	if err != nil {
 		log.Panicln(err)
 	}
 }
+
+func GenerateResource(writer io.Writer, def *Interface) {
+	printTo(writer, ResourceTemplate, NewResource(def))
+}
+
+func GenerateDataSource(writer io.Writer, def *Interface) {
+	resource := NewResource(def)
+	if !resource.HasDataSource() {
+		log.Panicf("Data source for %s requires Show operation", def.Name)
+	}
+	printTo(writer, DataSourceTemplate, resource)
+}
+
+func GenerateResourceAcceptanceTests(writer io.Writer, def *Interface) {
+	printTo(writer, ResourceAcceptanceTestTemplate, NewResource(def))
+}
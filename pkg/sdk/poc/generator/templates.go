@@ -11,7 +11,15 @@ var InterfaceTemplate, _ = template.New("interfaceTemplate").
 		"deref": func(p *DescriptionMappingKind) string { return string(*p) },
 	}).
 	Parse(`
+{{- if eq (len .InterfaceImports) 1 }}
 import "context"
+{{- else }}
+import (
+	{{- range .InterfaceImports }}
+	"{{ . }}"
+	{{- end }}
+)
+{{- end }}
 
 type {{ .Name }} interface {
 	{{- range .Operations }}
@@ -19,6 +27,8 @@ type {{ .Name }} interface {
 			{{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ([]{{ .ShowMapping.To.Name }}, error)
 		{{- else if eq .Name "ShowByID" }}
 			{{ .Name }}(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.NameSingular }}, error)
+		{{- else if .ResultKind }}
+			{{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ({{ .ResultKind }}, error)
 		{{- else if and (eq .Name "Describe") .DescribeMapping }}
 			{{- if .DescribeKind }}
 				{{- if eq (deref .DescribeKind) "single_value" }}
@@ -31,6 +41,43 @@ type {{ .Name }} interface {
 			{{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) error
 		{{- end -}}
 	{{ end }}
+	// generator:protected-begin {{ .Name }} methods
+	// generator:protected-end
+}
+`)
+
+// DeclarationsTemplate ends the generated files with a protected region for additional hand-written declarations,
+// e.g. helper functions or methods of the generated structs
+var DeclarationsTemplate, _ = template.New("declarationsTemplate").Parse(`
+// generator:protected-begin declarations
+// generator:protected-end
+`)
+
+var EnumTemplate, _ = template.New("enumTemplate").Parse(`
+{{ $enum := . }}
+type {{ .Name }} string
+
+const (
+	{{- range .Values }}
+	{{ .Name }} {{ $enum.Name }} = {{ printf "%q" .Value }}
+	{{- end }}
+)
+
+var {{ .AllValuesName }} = []{{ .Name }}{
+	{{- range .Values }}
+	{{ .Name }},
+	{{- end }}
+}
+
+func {{ .ConverterName }}(s string) ({{ .Name }}, error) {
+	switch strings.ToUpper(s) {
+	{{- range .Values }}
+	case {{ printf "%q" .UpperCaseValue }}:
+		return {{ .Name }}, nil
+	{{- end }}
+	default:
+		return "", fmt.Errorf("invalid {{ .Name }}: %s", s)
+	}
 }
 `)
 
@@ -52,9 +99,11 @@ type {{ .KindNoPtr }} struct {
 }
 `)
 
-var DtoTemplate, _ = template.New("dtoTemplate").Parse(`
-//go:generate go run ./dto-builder-generator/main.go
+var DtoGenerateDirectiveTemplate, _ = template.New("dtoGenerateDirectiveTemplate").Parse(`
+//go:generate go run {{ . }}
+`)
 
+var DtoTemplate, _ = template.New("dtoTemplate").Parse(`
 var (
 	{{- range .Operations }}
 	{{- if .OptsField }}
@@ -79,17 +128,30 @@ var ImplementationTemplate, _ = template.New("implementationTemplate").
 		"deref": func(p *DescriptionMappingKind) string { return string(*p) },
 	}).
 	Parse(`
-{{ define "MAPPING" -}}
-	&{{ .KindNoPtr }}{
+{{ define "STRUCT_LITERAL" -}}
+	{{ .KindNoPtr }}{
 		{{- range .Fields }}
-			{{- if .ShouldBeInDto }}
-			{{ if .IsStruct }}{{ else }}{{ .Name }}: r{{ .Path }},{{ end -}}
-			{{- end -}}
+			{{- if and .ShouldBeInDto (not .IsStruct) (not .HasPredefinedRequest) }}
+			{{ .Name }}: r{{ .Path }},
+			{{- end }}
 		{{- end }}
 	}
+{{- end }}
+{{ define "MAPPING" -}}
+	&{{ template "STRUCT_LITERAL" . }}
+	{{- template "NESTED_MAPPING" . }}
+{{ end }}
+{{ define "NESTED_MAPPING" -}}
 	{{- range .Fields }}
 		{{- if .ShouldBeInDto }}
-			{{- if .IsStruct }}
+			{{- if .HasPredefinedRequest }}
+				if r{{ .Path }} != nil {
+					opts{{ .Path }} = r{{ .Path }}.toOpts()
+				}
+			{{- else if and .IsStruct (not .IsPointer) (not .IsSlice) }}
+				opts{{ .Path }} = {{ template "STRUCT_LITERAL" . }}
+				{{- template "NESTED_MAPPING" . }}
+			{{- else if .IsStruct }}
 				if r{{ .Path }} != nil {
 					{{- if not .IsSlice }}
 						opts{{ .Path }} = {{ template "MAPPING" . -}}
@@ -98,7 +160,9 @@ var ImplementationTemplate, _ = template.New("implementationTemplate").
 						for i, v := range r{{ .Path }} {
 							s[i] = {{ .KindNoSlice }}{
 							  {{- range .Fields }}
+								{{- if .ShouldBeInDto }}
 								   {{ .Name }}: v.{{ .Name }},
+								{{- end }}
 							  {{- end }}
 							}
 						}
@@ -108,18 +172,28 @@ var ImplementationTemplate, _ = template.New("implementationTemplate").
 			{{- end -}}
 		{{ end -}}
 	{{ end }}
-{{ end }}
+{{- end }}
 {{ define "MAPPING_FUNC" }}
 	func (r {{ .From.Name }}) {{ .MappingFuncName }}() *{{ .To.KindNoPtr }} {
-		// TODO: Mapping
-		return &{{ .To.KindNoPtr }}{}
+		// generator:protected-begin {{ .From.Name }}.{{ .MappingFuncName }}
+		e := &{{ .To.KindNoPtr }}{
+			{{- range .Direct }}
+			{{ .Name }}: {{ .Value }},
+			{{- end }}
+		}
+		{{- range .Unmapped }}
+		// TODO: Mapping {{ . }}
+		{{- end }}
+		{{- range .Optional }}
+		if {{ .ValidField }} {
+			e.{{ .Name }} = {{ .Value }}
+		}
+		{{- end }}
+		return e
+		// generator:protected-end
 	}
 {{ end }}
-import (
-"context"
-
-"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 {{ $impl := .NameLowerCased }}
 var _ {{ .Name }} = (*{{ $impl }})(nil)
@@ -140,12 +214,23 @@ type {{ $impl }} struct {
 		}
 	{{ else if eq .Name "ShowByID" }}
 		func (v *{{ $impl }}) ShowByID(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.NameSingular }}, error) {
-			// TODO: adjust request if e.g. LIKE is supported for the resource
-			{{ $impl }}, err := v.Show(ctx, NewShow{{ .ObjectInterface.NameSingular }}Request())
+			// generator:protected-begin {{ $impl }}.ShowByID
+			request := {{ .ShowByIDRequest }}
+			{{ $impl }}, err := v.Show(ctx, request)
 			if err != nil {
 				return nil, err
 			}
-			return collections.FindOne({{ $impl }}, func(r {{ .ObjectInterface.NameSingular }}) bool { return r.Name == id.Name() })
+			return findOne({{ $impl }}, func(r {{ .ObjectInterface.NameSingular }}) bool { return {{ .ShowByIDFilterCondition }} })
+			// generator:protected-end
+		}
+	{{ else if .ResultKind }}
+		func (v *{{ $impl }}) {{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ({{ .ResultKind }}, error) {
+			// generator:protected-begin {{ $impl }}.{{ .Name }}
+			opts := request.toOpts()
+			// TODO: query the rows and convert them to the result
+			var result {{ .ResultKind }}
+			return result, validateAndExec(v.client, ctx, opts)
+			// generator:protected-end
 		}
 	{{ else if and (eq .Name "Describe") .DescribeMapping }}
 		{{ if .DescribeKind }}
@@ -188,10 +273,10 @@ type {{ $impl }} struct {
 		return opts
 	}
 	{{ if .ShowMapping }}
-		{{ template "MAPPING_FUNC" .ShowMapping }}
+		{{ template "MAPPING_FUNC" .ShowMappingFunc }}
 	{{ end }}
-	{{ if .DescribeMapping }}
-		{{ template "MAPPING_FUNC" .DescribeMapping }}
+	{{ if and .DescribeMapping (not .DescribeMappingReusesShow) }}
+		{{ template "MAPPING_FUNC" .DescribeMappingFunc }}
 	{{ end }}
 	{{- end}}
 {{ end }}
@@ -223,12 +308,15 @@ import "testing"
 {{ range .Operations }}
 	{{- if .OptsField }}
 	func Test{{ .ObjectInterface.Name }}_{{ .Name }}(t *testing.T) {
-		id := Random{{ .ObjectInterface.IdentifierKind }}()
-
+		{{- if .HasNameField }}
+		id := Random{{ .NameField.Kind }}()
+		{{ end }}
 		// Minimal valid {{ .OptsField.KindNoPtr }}
 		defaultOpts := func() *{{ .OptsField.KindNoPtr }} {
 			return &{{ .OptsField.KindNoPtr }}{
+				{{- if .HasNameField }}
 				name: id,
+				{{- end }}
 			}
 		}
 
@@ -255,23 +343,73 @@ import "testing"
 {{ end }}
 `)
 
+var SQLTestsTemplate, _ = template.New("sqlTestsTemplate").Parse(`
+import "testing"
+
+func Test{{ .Name }}_GoldenSQL(t *testing.T) {
+{{- range .Operations }}
+	{{- if .OptsField }}
+	{{- $operation := . }}
+	t.Run("{{ .Name }}", func(t *testing.T) {
+		{{- range .SQLFixtures }}
+		t.Run("{{ .Name }}", func(t *testing.T) {
+			opts := &{{ $operation.OptsField.KindNoPtr }}{
+				{{- if $operation.HasNameField }}
+				name: sqlFixtureIdentifier[{{ $operation.NameField.Kind }}](),
+				{{- end }}
+			}
+			{{- if .AllFields }}
+			fillSQLFixture(opts)
+			{{- end }}
+			{{- range .FieldPaths }}
+			fillSQLFixtureField(opts, "{{ . }}")
+			{{- end }}
+			{{- range .ClearedPaths }}
+			clearSQLFixtureField(opts, "{{ . }}")
+			{{- end }}
+			{{- range .EnumValues }}
+			setSQLFixtureField(opts, "{{ .Path }}", {{ .AllValuesName }}[0])
+			{{- end }}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		{{- end }}
+	})
+	{{- end }}
+{{- end }}
+}
+`)
+
 var ValidationsImplTemplate, _ = template.New("validationsImplTemplate").Parse(`
 {{ define "VALIDATIONS" }}
 	{{- $field := . -}}
 	{{- range .Validations }}
-		if {{ .Condition $field }} {
-			errs = append(errs, {{ .ReturnedError $field }})
-		}
+		{{- if .Guard $field }}
+			if {{ .Guard $field }} {
+				if {{ .Condition $field }} {
+					errs = append(errs, {{ .ReturnedError $field }})
+				}
+			}
+		{{- else }}
+			if {{ .Condition $field }} {
+				errs = append(errs, {{ .ReturnedError $field }})
+			}
+		{{- end }}
 	{{- end -}}
 	{{- range .Fields }}
 		{{- if .HasAnyValidationInSubtree }}
+			{{- if .IsPointer }}
 			if valueSet(opts{{ .Path }}) {
 				{{- template "VALIDATIONS" . }}
 			}
+			{{- else }}
+				{{- template "VALIDATIONS" . }}
+			{{- end }}
 		{{- end -}}
 	{{- end -}}
 {{ end }}
 
+{{ if .HasEnumValidations }}import "slices"{{ end }}
+
 var (
 {{- range .Operations }}
 	{{- if .OptsField }}
@@ -287,6 +425,8 @@ var (
 		}
 		var errs []error
 		{{- template "VALIDATIONS" .OptsField }}
+		// generator:protected-begin {{ .OptsField.KindNoPtr }}.validate
+		// generator:protected-end
 		return JoinErrors(errs...)
 	}
 	{{- end }}
@@ -294,6 +434,8 @@ var (
 `)
 
 var IntegrationTestsTemplate, _ = template.New("integrationTestsTemplate").Parse(`
+package testint
+
 import "testing"
 
 func TestInt_{{ .Name }}(t *testing.T) {
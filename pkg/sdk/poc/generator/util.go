@@ -13,7 +13,7 @@ import (
 
 var (
 	// Split by empty space or underscore
-	splitSQLPattern   = regexp.MustCompile(`\s+|_`)
+	splitSQLPattern   = regexp.MustCompile(`[^a-zA-Z0-9]+`)
 	englishLowerCaser = cases.Lower(language.English)
 	englishTitleCaser = cases.Title(language.English)
 )
@@ -52,11 +52,15 @@ func wrapWith(s string, with string) string {
 func sqlToFieldName(sql string, shouldExport bool) string {
 	sqlWords := splitSQLPattern.Split(sql, -1)
 	for i, s := range sqlWords {
-		if !shouldExport && i == 0 {
+		switch {
+		case !shouldExport && i == 0:
 			sqlWords[i] = englishLowerCaser.String(s)
-			continue
+		case s != strings.ToLower(s) && s != strings.ToUpper(s):
+			// camel case words keep their capitals, e.g. tableOn column of SHOW STREAMS
+			sqlWords[i] = startingWithUpperCase(s)
+		default:
+			sqlWords[i] = englishTitleCaser.String(s)
 		}
-		sqlWords[i] = englishTitleCaser.String(s)
 	}
 	return strings.Join(sqlWords, "")
 }
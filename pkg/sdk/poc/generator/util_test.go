@@ -0,0 +1,28 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSqlToFieldName(t *testing.T) {
+	testCases := []struct {
+		sql          string
+		shouldExport bool
+		expected     string
+	}{
+		{sql: "created_on", shouldExport: true, expected: "CreatedOn"},
+		{sql: "USER_TASK_TIMEOUT_MS", shouldExport: true, expected: "UserTaskTimeoutMs"},
+		{sql: "COPY GRANTS", shouldExport: true, expected: "CopyGrants"},
+		{sql: "tableOn", shouldExport: true, expected: "TableOn"},
+		{sql: "null?", shouldExport: true, expected: "Null"},
+		{sql: "EXTERNAL FUNCTION", shouldExport: false, expected: "externalFunction"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.sql, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sqlToFieldName(tc.sql, tc.shouldExport))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 // - exactly one value set - present here, put on level containing given fields
 // - at least one value set - present here, put on level containing given fields
 // - validate nested field - present here, used for common structs which have their own validate() methods specified
+// - valid enum value - present here, put on level containing given field (field kind has to be one of the interface enums)
 // - nested validation conditionally - not present here, handled by putting validations on lower level fields
 type ValidationType int64
 
@@ -23,6 +24,7 @@ const (
 	AtLeastOneValueSet
 	ValidateValue
 	ValidateValueSet
+	ValidEnumValue
 )
 
 type Validation struct {
@@ -60,6 +62,10 @@ func (v *Validation) Condition(field *Field) string {
 	case ValidIdentifierIfSet:
 		return fmt.Sprintf("%s != nil && !ValidObjectIdentifier(%s)", strings.Join(v.fieldsWithPath(field), ","), strings.Join(v.fieldsWithPath(field), ","))
 	case ConflictingFields:
+		// more than two fields conflict when any two of them are set, not only all of them
+		if len(v.FieldNames) > 2 {
+			return fmt.Sprintf("moreThanOneValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		}
 		return fmt.Sprintf("everyValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
 	case ExactlyOneValueSet:
 		return fmt.Sprintf("!exactlyOneValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
@@ -68,11 +74,26 @@ func (v *Validation) Condition(field *Field) string {
 	case ValidateValueSet:
 		return fmt.Sprintf("!valueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
 	case ValidateValue:
-		return fmt.Sprintf("err := %s.validate(); err != nil", strings.Join(v.fieldsWithPath(field.Parent), ","))
+		return fmt.Sprintf("err := %s.validate(); err != nil", strings.Join(v.fieldsWithPath(field), ","))
+	case ValidEnumValue:
+		enumField := v.enumField(field)
+		value := v.fieldsWithPath(field)[0]
+		if enumField.IsPointer() {
+			return fmt.Sprintf("%s != nil && !slices.Contains(%s, *%s)", value, enumAllValuesName(enumField.KindNoPtr()), value)
+		}
+		return fmt.Sprintf("!slices.Contains(%s, %s)", enumAllValuesName(enumField.KindNoPtr()), value)
 	}
 	panic("condition for validation unknown")
 }
 
+// Guard returns the condition which has to be met before checking the validation, e.g. nested struct has to be set to validate it
+func (v *Validation) Guard(field *Field) string {
+	if v.Type == ValidateValue {
+		return fmt.Sprintf("valueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+	}
+	return ""
+}
+
 func (v *Validation) ReturnedError(field *Field) string {
 	switch v.Type {
 	case ValidIdentifier:
@@ -80,6 +101,9 @@ func (v *Validation) ReturnedError(field *Field) string {
 	case ValidIdentifierIfSet:
 		return "ErrInvalidObjectIdentifier"
 	case ConflictingFields:
+		if len(v.FieldNames) > 2 {
+			return fmt.Sprintf(`errMoreThanOneOf("%s", %s)`, field.PathWithRoot(), strings.Join(v.paramsQuoted(), ","))
+		}
 		return fmt.Sprintf(`errOneOf("%s", %s)`, field.PathWithRoot(), strings.Join(v.paramsQuoted(), ","))
 	case ExactlyOneValueSet:
 		return fmt.Sprintf(`errExactlyOneOf("%s", %s)`, field.PathWithRoot(), strings.Join(v.paramsQuoted(), ","))
@@ -89,6 +113,12 @@ func (v *Validation) ReturnedError(field *Field) string {
 		return fmt.Sprintf(`errNotSet("%s", %s)`, field.PathWithRoot(), strings.Join(v.paramsQuoted(), ","))
 	case ValidateValue:
 		return "err"
+	case ValidEnumValue:
+		value := v.fieldsWithPath(field)[0]
+		if v.enumField(field).IsPointer() {
+			value = "*" + value
+		}
+		return fmt.Sprintf(`errInvalidValue("%s", "%s", string(%s))`, field.PathWithRoot(), v.FieldNames[0], value)
 	}
 	panic("condition for validation unknown")
 }
@@ -109,6 +139,16 @@ func (v *Validation) TodoComment(field *Field) string {
 		return fmt.Sprintf("validation: %v should be set", v.fieldsWithPath(field))
 	case ValidateValue:
 		return fmt.Sprintf("validation: %v should be valid", v.fieldsWithPath(field)[0])
+	case ValidEnumValue:
+		return fmt.Sprintf("validation: %v should be one of the enum values", v.fieldsWithPath(field)[0])
 	}
 	panic("condition for validation unknown")
 }
+
+// enumField returns validated child field of given field, enum validation is put on level containing given field
+func (v *Validation) enumField(field *Field) *Field {
+	if f, ok := fieldByName(field, v.FieldNames[0]); ok {
+		return f
+	}
+	panic(fmt.Sprintf("field %s not found in %s", v.FieldNames[0], field.PathWithRoot()))
+}
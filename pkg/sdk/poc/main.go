@@ -4,10 +4,14 @@ package main
 
 import (
 	"bytes"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -16,38 +20,114 @@ import (
 )
 
 var definitionMapping = map[string]*generator.Interface{
-	"database_role_def.go":             example.DatabaseRole,
-	"network_policies_def.go":          sdk.NetworkPoliciesDef,
-	"session_policies_def.go":          sdk.SessionPoliciesDef,
-	"tasks_def.go":                     sdk.TasksDef,
-	"streams_def.go":                   sdk.StreamsDef,
-	"application_roles_def.go":         sdk.ApplicationRolesDef,
-	"views_def.go":                     sdk.ViewsDef,
-	"stages_def.go":                    sdk.StagesDef,
-	"functions_def.go":                 sdk.FunctionsDef,
-	"procedures_def.go":                sdk.ProceduresDef,
-	"event_tables_def.go":              sdk.EventTablesDef,
-	"application_packages_def.go":      sdk.ApplicationPackagesDef,
-	"storage_integration_def.go":       sdk.StorageIntegrationDef,
-	"managed_accounts_def.go":          sdk.ManagedAccountsDef,
-	"row_access_policies_def.go":       sdk.RowAccessPoliciesDef,
-	"applications_def.go":              sdk.ApplicationsDef,
-	"sequences_def.go":                 sdk.SequencesDef,
-	"materialized_views_def.go":        sdk.MaterializedViewsDef,
-	"api_integrations_def.go":          sdk.ApiIntegrationsDef,
-	"notification_integrations_def.go": sdk.NotificationIntegrationsDef,
-	"external_functions_def.go":        sdk.ExternalFunctionsDef,
-	"streamlits_def.go":                sdk.StreamlitsDef,
-	"network_rule_def.go":              sdk.NetworkRuleDef,
+	"database_role_def.go":                example.DatabaseRoleDef,
+	"network_policies_def.go":             sdk.NetworkPoliciesDef,
+	"session_policies_def.go":             sdk.SessionPoliciesDef,
+	"tasks_def.go":                        sdk.TasksDef,
+	"streams_def.go":                      sdk.StreamsDef,
+	"application_roles_def.go":            sdk.ApplicationRolesDef,
+	"views_def.go":                        sdk.ViewsDef,
+	"stages_def.go":                       sdk.StagesDef,
+	"functions_def.go":                    sdk.FunctionsDef,
+	"procedures_def.go":                   sdk.ProceduresDef,
+	"event_tables_def.go":                 sdk.EventTablesDef,
+	"application_packages_def.go":         sdk.ApplicationPackagesDef,
+	"storage_integration_def.go":          sdk.StorageIntegrationDef,
+	"managed_accounts_def.go":             sdk.ManagedAccountsDef,
+	"row_access_policies_def.go":          sdk.RowAccessPoliciesDef,
+	"applications_def.go":                 sdk.ApplicationsDef,
+	"sequences_def.go":                    sdk.SequencesDef,
+	"materialized_views_def.go":           sdk.MaterializedViewsDef,
+	"api_integrations_def.go":             sdk.ApiIntegrationsDef,
+	"notification_integrations_def.go":    sdk.NotificationIntegrationsDef,
+	"external_functions_def.go":           sdk.ExternalFunctionsDef,
+	"streamlits_def.go":                   sdk.StreamlitsDef,
+	"network_rule_def.go":                 sdk.NetworkRuleDef,
+	"replication_groups_def.go":           sdk.ReplicationGroupsDef,
+	"secrets_def.go":                      sdk.SecretsDef,
+	"external_access_integrations_def.go": sdk.ExternalAccessIntegrationsDef,
+	"security_integrations_def.go":        sdk.SecurityIntegrationsDef,
+}
+
+// artifact is a single file generated from the definition
+type artifact struct {
+	name     string
+	genFunc  func(io.Writer, *generator.Interface)
+	fileName func(prefix string, def *generator.Interface) string
+	// once artifacts are meant to be finished by hand, so they are generated only if the file does not exist yet and are never checked
+	once bool
+	// onDemand artifacts are generated only when requested with the -artifacts flag
+	onDemand bool
+}
+
+var artifacts = []artifact{
+	{"interface", generator.GenerateInterface, sdkFile(""), false, false},
+	{"dto", generator.GenerateDtos, sdkFile("_dto"), false, false},
+	{"impl", generator.GenerateImplementation, sdkFile("_impl"), false, false},
+	{"unit_tests", generator.GenerateUnitTests, sdkTestFile("_gen"), true, false},
+	{"sql_tests", generator.GenerateSQLTests, sdkTestFile("_gen_sql"), false, false},
+	{"validations", generator.GenerateValidations, sdkFile("_validations"), false, false},
+	{"integration_tests", generator.GenerateIntegrationTests, func(prefix string, _ *generator.Interface) string {
+		return filename("testint/", prefix, "_gen_integration_test.go")
+	}, true, true},
+	{"resource", generator.GenerateResource, func(_ string, def *generator.Interface) string {
+		return filename("../resources/", resourceFileName(def), ".go")
+	}, true, true},
+	{"data_source", generator.GenerateDataSource, func(_ string, def *generator.Interface) string {
+		return filename("../datasources/", generator.NewResource(def).DataSourceListName(), ".go")
+	}, true, true},
+	{"resource_acceptance_tests", generator.GenerateResourceAcceptanceTests, func(_ string, def *generator.Interface) string {
+		return filename("../resources/", resourceFileName(def), "_acceptance_test.go")
+	}, true, true},
+}
+
+type generatorArgs struct {
+	// artifacts contains names of the artifacts to generate, all by default
+	artifacts []string
+	// check makes generator fail if any of the files differs from the generated code, instead of overriding it
+	check bool
 }
 
 func main() {
 	file := os.Getenv("GOFILE")
-	fmt.Printf("Running generator on %s with args %#v\n", file, os.Args[1:])
+	// go generate does not allow passing additional arguments, so they can be also passed through SF_TF_GENERATOR_ARGS (e.g. by make)
+	args := append(os.Args[1:], strings.Fields(os.Getenv("SF_TF_GENERATOR_ARGS"))...)
+	fmt.Printf("Running generator on %s with args %#v\n", file, args)
+	generatorArgs := parseArgs(args)
 	definition := getDefinition(file)
 
 	// runAllTemplatesToStdOut(definition)
-	runAllTemplatesAndSave(definition, file)
+	if generatorArgs.check {
+		checkTemplates(definition, file, generatorArgs.artifacts)
+	} else {
+		runTemplatesAndSave(definition, file, generatorArgs.artifacts)
+	}
+}
+
+func parseArgs(args []string) generatorArgs {
+	var allArtifacts, defaultArtifacts []string
+	for _, a := range artifacts {
+		allArtifacts = append(allArtifacts, a.name)
+		if !a.onDemand {
+			defaultArtifacts = append(defaultArtifacts, a.name)
+		}
+	}
+	flags := flag.NewFlagSet("generator", flag.ExitOnError)
+	artifactsArg := flags.String("artifacts", strings.Join(defaultArtifacts, ","), fmt.Sprintf("comma separated artifacts to generate, available: %s", strings.Join(allArtifacts, ", ")))
+	check := flags.Bool("check", false, "fail if generated files are not up to date with the definition, without changing them")
+	if err := flags.Parse(args); err != nil {
+		log.Panicln(err)
+	}
+	selected := strings.Split(*artifactsArg, ",")
+	for _, a := range selected {
+		if !slices.Contains(allArtifacts, a) {
+			log.Panicf("Unknown artifact %s, available: %s", a, strings.Join(allArtifacts, ", "))
+		}
+	}
+	return generatorArgs{
+		artifacts: selected,
+		check:     *check,
+	}
 }
 
 func getDefinition(file string) *generator.Interface {
@@ -84,18 +164,24 @@ func runAllTemplatesToStdOut(definition *generator.Interface) {
 	generator.GenerateDtos(writer, definition)
 	generator.GenerateImplementation(writer, definition)
 	generator.GenerateUnitTests(writer, definition)
+	generator.GenerateSQLTests(writer, definition)
 	generator.GenerateValidations(writer, definition)
 	generator.GenerateIntegrationTests(writer, definition)
 }
 
-func runAllTemplatesAndSave(definition *generator.Interface, file string) {
+func runTemplatesAndSave(definition *generator.Interface, file string, selected []string) {
 	fileWithoutSuffix, _ := strings.CutSuffix(file, "_def.go")
-	runTemplateAndSave(definition, generator.GenerateInterface, filenameFor(fileWithoutSuffix, ""))
-	runTemplateAndSave(definition, generator.GenerateDtos, filenameFor(fileWithoutSuffix, "_dto"))
-	runTemplateAndSave(definition, generator.GenerateImplementation, filenameFor(fileWithoutSuffix, "_impl"))
-	runTemplateAndSave(definition, generator.GenerateUnitTests, filename(fileWithoutSuffix, "_gen", "_test.go"))
-	runTemplateAndSave(definition, generator.GenerateValidations, filenameFor(fileWithoutSuffix, "_validations"))
-	runTemplateAndSave(definition, generator.GenerateIntegrationTests, filename(fileWithoutSuffix, "_gen_integration", "_test.go"))
+	for _, a := range artifacts {
+		if !slices.Contains(selected, a.name) {
+			continue
+		}
+		fileName := a.fileName(fileWithoutSuffix, definition)
+		if a.once && fileExists(fileName) {
+			fmt.Printf("Skipping %s, file %s already exists\n", a.name, fileName)
+			continue
+		}
+		runTemplateAndSave(definition, a.genFunc, fileName)
+	}
 }
 
 func runTemplateAndSave(def *generator.Interface, genFunc func(io.Writer, *generator.Interface), fileName string) {
@@ -104,6 +190,52 @@ func runTemplateAndSave(def *generator.Interface, genFunc func(io.Writer, *gener
 	generator.WriteCodeToFile(&buffer, fileName)
 }
 
+func checkTemplates(definition *generator.Interface, file string, selected []string) {
+	fileWithoutSuffix, _ := strings.CutSuffix(file, "_def.go")
+	var staleFiles []string
+	for _, a := range artifacts {
+		// once artifacts are changed by hand after generation, so they are never up to date
+		if !slices.Contains(selected, a.name) || a.once {
+			continue
+		}
+		buffer := bytes.Buffer{}
+		a.genFunc(&buffer, definition)
+		fileName := a.fileName(fileWithoutSuffix, definition)
+		if !generator.IsCodeUpToDate(&buffer, fileName) {
+			staleFiles = append(staleFiles, fileName)
+		}
+	}
+	if len(staleFiles) > 0 {
+		fmt.Printf("Generated files are not up to date with %s: %s\n", file, strings.Join(staleFiles, ", "))
+		os.Exit(1)
+	}
+	fmt.Printf("Generated files are up to date with %s\n", file)
+}
+
+func sdkFile(part string) func(string, *generator.Interface) string {
+	return func(prefix string, _ *generator.Interface) string { return filenameFor(prefix, part) }
+}
+
+func sdkTestFile(part string) func(string, *generator.Interface) string {
+	return func(prefix string, _ *generator.Interface) string { return filename(prefix, part, "_test.go") }
+}
+
+// resourceFileName returns the name of the resource file without extension, e.g. network_rule
+func resourceFileName(def *generator.Interface) string {
+	return strings.TrimPrefix(generator.NewResource(def).ResourceName(), "snowflake_")
+}
+
+func fileExists(fileName string) bool {
+	_, err := os.Stat(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+	if err != nil {
+		log.Panicln(err)
+	}
+	return true
+}
+
 func filenameFor(prefix string, part string) string {
 	return filename(prefix, part, "_gen.go")
 }
@@ -59,6 +59,7 @@ var (
 
 // https://docs.snowflake.com/en/sql-reference/constructs/with and https://docs.snowflake.com/en/user-guide/queries-cte
 var procedureWithClause = g.NewQueryStruct("ProcedureWithClause").
+	PredefinedQueryStructField("prefix", "bool", g.StaticOptions().SQL(",")).
 	Identifier("CteName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required()).
 	PredefinedQueryStructField("CteColumns", "[]string", g.KeywordOptions().Parentheses()).
 	PredefinedQueryStructField("Statement", "string", g.ParameterOptions().NoEquals().NoQuotes().SQL("AS").Required())
@@ -66,7 +67,7 @@ var procedureWithClause = g.NewQueryStruct("ProcedureWithClause").
 var ProceduresDef = g.NewInterface(
 	"Procedures",
 	"Procedure",
-	g.KindOfT[SchemaObjectIdentifier](),
+	g.KindOfT[SchemaObjectIdentifierWithArguments](),
 ).CustomOperation(
 	"CreateForJava",
 	"https://docs.snowflake.com/en/sql-reference/sql/create-procedure#java-handler",
@@ -75,7 +76,7 @@ var ProceduresDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("PROCEDURE").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			procedureArgument,
@@ -101,7 +102,7 @@ var ProceduresDef = g.NewInterface(
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
-		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "SecretReference", g.ParameterOptions().Parentheses()).
 		OptionalTextAssignment("TARGET_PATH", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("NullInputBehavior", "*NullInputBehavior", g.KeywordOptions()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -119,7 +120,7 @@ var ProceduresDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("PROCEDURE").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			procedureArgument,
@@ -143,7 +144,7 @@ var ProceduresDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("PROCEDURE").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			procedureArgument,
@@ -169,7 +170,7 @@ var ProceduresDef = g.NewInterface(
 		).
 		TextAssignment("HANDLER", g.ParameterOptions().SingleQuotes().Required()).
 		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
-		ListAssignment("SECRETS", "Secret", g.ParameterOptions().Parentheses()).
+		ListAssignment("SECRETS", "SecretReference", g.ParameterOptions().Parentheses()).
 		PredefinedQueryStructField("NullInputBehavior", "*NullInputBehavior", g.KeywordOptions()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("ExecuteAs", "*ExecuteAs", g.KeywordOptions()).
@@ -186,7 +187,7 @@ var ProceduresDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("PROCEDURE").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			procedureArgument,
@@ -228,7 +229,7 @@ var ProceduresDef = g.NewInterface(
 		OrReplace().
 		OptionalSQL("SECURE").
 		SQL("PROCEDURE").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		ListQueryStructField(
 			"Arguments",
 			procedureArgument,
@@ -253,7 +254,7 @@ var ProceduresDef = g.NewInterface(
 		Alter().
 		SQL("PROCEDURE").
 		IfExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -272,7 +273,7 @@ var ProceduresDef = g.NewInterface(
 		Drop().
 		SQL("PROCEDURE").
 		IfExists().
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		WithValidation(g.ValidIdentifier, "name"),
 ).ShowOperation(
@@ -312,7 +313,11 @@ var ProceduresDef = g.NewInterface(
 		SQL("PROCEDURES").
 		OptionalLike().
 		OptionalIn(), // TODO: 'In' struct for procedures not support keyword "CLASS" now
-).ShowByIdOperation().DescribeOperation(
+).ShowByIdOperationWithCustomFilter(
+	"r.Name == id.Name() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())",
+	g.ShowByIDInFiltering,
+	g.ShowByIDLikeFiltering,
+).DescribeOperation(
 	g.DescriptionMappingKindSlice,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-procedure",
 	g.DbStruct("procedureDetailRow").
@@ -325,14 +330,13 @@ var ProceduresDef = g.NewInterface(
 		Describe().
 		SQL("PROCEDURE").
 		Name().
-		PredefinedQueryStructField("ArgumentDataTypes", "[]DataType", g.KeywordOptions().MustParentheses().Required()).
 		WithValidation(g.ValidIdentifier, "name"),
 ).CustomOperation(
 	"Call",
 	"https://docs.snowflake.com/en/sql-reference/sql/call",
 	g.NewQueryStruct("Call").
 		SQL("CALL").
-		Name().
+		Identifier("name", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
 		PredefinedQueryStructField("CallArguments", "[]string", g.KeywordOptions().MustParentheses()).
 		PredefinedQueryStructField("ScriptingVariable", "*string", g.ParameterOptions().NoEquals().NoQuotes().SQL("INTO")).
 		WithValidation(g.ValidIdentifier, "name"),
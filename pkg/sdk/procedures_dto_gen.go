@@ -32,7 +32,7 @@ type CreateForJavaProcedureRequest struct {
 	Imports                    []ProcedureImportRequest
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
-	Secrets                    []Secret
+	Secrets                    []SecretReference
 	TargetPath                 *string
 	NullInputBehavior          *NullInputBehavior
 	Comment                    *string
@@ -100,7 +100,7 @@ type CreateForPythonProcedureRequest struct {
 	Imports                    []ProcedureImportRequest
 	Handler                    string // required
 	ExternalAccessIntegrations []AccountObjectIdentifier
-	Secrets                    []Secret
+	Secrets                    []SecretReference
 	NullInputBehavior          *NullInputBehavior
 	Comment                    *string
 	ExecuteAs                  *ExecuteAs
@@ -170,8 +170,7 @@ type ShowProcedureRequest struct {
 }
 
 type DescribeProcedureRequest struct {
-	name              SchemaObjectIdentifier // required
-	ArgumentDataTypes []DataType             // required
+	name SchemaObjectIdentifierWithArguments // required
 }
 
 type CallProcedureRequest struct {
@@ -258,3 +257,6 @@ type CreateAndCallForSQLProcedureRequest struct {
 	CallArguments       []string
 	ScriptingVariable   *string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
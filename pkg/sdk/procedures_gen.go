@@ -14,14 +14,16 @@ type Procedures interface {
 	Alter(ctx context.Context, request *AlterProcedureRequest) error
 	Drop(ctx context.Context, request *DropProcedureRequest) error
 	Show(ctx context.Context, request *ShowProcedureRequest) ([]Procedure, error)
-	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Procedure, error)
-	Describe(ctx context.Context, request *DescribeProcedureRequest) ([]ProcedureDetail, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*Procedure, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]ProcedureDetail, error)
 	Call(ctx context.Context, request *CallProcedureRequest) error
 	CreateAndCallForJava(ctx context.Context, request *CreateAndCallForJavaProcedureRequest) error
 	CreateAndCallForScala(ctx context.Context, request *CreateAndCallForScalaProcedureRequest) error
 	CreateAndCallForJavaScript(ctx context.Context, request *CreateAndCallForJavaScriptProcedureRequest) error
 	CreateAndCallForPython(ctx context.Context, request *CreateAndCallForPythonProcedureRequest) error
 	CreateAndCallForSQL(ctx context.Context, request *CreateAndCallForSQLProcedureRequest) error
+	// generator:protected-begin Procedures methods
+	// generator:protected-end
 }
 
 // CreateForJavaProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-procedure#java-handler.
@@ -40,7 +42,7 @@ type CreateForJavaProcedureOptions struct {
 	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	TargetPath                 *string                   `ddl:"parameter,single_quotes" sql:"TARGET_PATH"`
 	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
 	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
@@ -116,7 +118,7 @@ type CreateForPythonProcedureOptions struct {
 	Imports                    []ProcedureImport         `ddl:"parameter,parentheses" sql:"IMPORTS"`
 	Handler                    string                    `ddl:"parameter,single_quotes" sql:"HANDLER"`
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Secrets                    []Secret                  `ddl:"parameter,parentheses" sql:"SECRETS"`
+	Secrets                    []SecretReference         `ddl:"parameter,parentheses" sql:"SECRETS"`
 	NullInputBehavior          *NullInputBehavior        `ddl:"keyword"`
 	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	ExecuteAs                  *ExecuteAs                `ddl:"keyword"`
@@ -238,10 +240,9 @@ type Procedure struct {
 
 // DescribeProcedureOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-procedure.
 type DescribeProcedureOptions struct {
-	describe          bool                   `ddl:"static" sql:"DESCRIBE"`
-	procedure         bool                   `ddl:"static" sql:"PROCEDURE"`
-	name              SchemaObjectIdentifier `ddl:"identifier"`
-	ArgumentDataTypes []DataType             `ddl:"keyword,must_parentheses"`
+	describe  bool                                `ddl:"static" sql:"DESCRIBE"`
+	procedure bool                                `ddl:"static" sql:"PROCEDURE"`
+	name      SchemaObjectIdentifierWithArguments `ddl:"identifier"`
 }
 
 type procedureDetailRow struct {
@@ -366,3 +367,6 @@ type CreateAndCallForSQLProcedureOptions struct {
 	CallArguments       []string                `ddl:"keyword,must_parentheses"`
 	ScriptingVariable   *string                 `ddl:"parameter,no_quotes,no_equals" sql:"INTO"`
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,309 @@
+package sdk
+
+import "testing"
+
+func TestProcedures_GoldenSQL(t *testing.T) {
+	t.Run("CreateForJava", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForJavaProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForJavaProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForJavaScript", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForJavaScriptProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ResultDataType")
+			fillSQLFixtureField(opts, "ProcedureDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForJavaScriptProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForPython", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForPythonProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForPythonProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForScala", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForScalaProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForScalaProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateForSQL", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateForSQLProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "ProcedureDefinition")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateForSQLProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("RenameTo", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "RenameTo")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetComment", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetLogLevel", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetLogLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTraceLevel", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetTraceLevel")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetComment", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("ExecuteAs", func(t *testing.T) {
+			opts := &AlterProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			fillSQLFixtureField(opts, "ExecuteAs")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ArgumentDataTypes")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowProcedureOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowProcedureOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifierWithArguments](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Call", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CallProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CallProcedureOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAndCallForJava", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateAndCallForJavaProcedureOptions{}
+			fillSQLFixtureField(opts, "Name")
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "ProcedureName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateAndCallForJavaProcedureOptions{}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAndCallForScala", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateAndCallForScalaProcedureOptions{}
+			fillSQLFixtureField(opts, "Name")
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "ProcedureName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateAndCallForScalaProcedureOptions{}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAndCallForJavaScript", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateAndCallForJavaScriptProcedureOptions{}
+			fillSQLFixtureField(opts, "Name")
+			fillSQLFixtureField(opts, "ResultDataType")
+			fillSQLFixtureField(opts, "ProcedureDefinition")
+			fillSQLFixtureField(opts, "ProcedureName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateAndCallForJavaScriptProcedureOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAndCallForPython", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateAndCallForPythonProcedureOptions{}
+			fillSQLFixtureField(opts, "Name")
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "RuntimeVersion")
+			fillSQLFixtureField(opts, "Packages.Package")
+			fillSQLFixtureField(opts, "Handler")
+			fillSQLFixtureField(opts, "ProcedureName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateAndCallForPythonProcedureOptions{}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAndCallForSQL", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateAndCallForSQLProcedureOptions{}
+			fillSQLFixtureField(opts, "Name")
+			fillSQLFixtureField(opts, "Returns.ResultDataType.ResultDataType")
+			fillSQLFixtureField(opts, "ProcedureDefinition")
+			fillSQLFixtureField(opts, "ProcedureName")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateAndCallForSQLProcedureOptions{}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Returns.Table")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -95,7 +95,7 @@ func TestProcedures_CreateForJava(t *testing.T) {
 		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
 			NewAccountObjectIdentifier("ext_integration"),
 		}
-		opts.Secrets = []Secret{
+		opts.Secrets = []SecretReference{
 			{
 				VariableName: "variable1",
 				Name:         "name1",
@@ -237,7 +237,7 @@ func TestProcedures_CreateForPython(t *testing.T) {
 		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{
 			NewAccountObjectIdentifier("ext_integration"),
 		}
-		opts.Secrets = []Secret{
+		opts.Secrets = []SecretReference{
 			{
 				VariableName: "variable1",
 				Name:         "name1",
@@ -564,7 +564,7 @@ func TestProcedures_Show(t *testing.T) {
 }
 
 func TestProcedures_Describe(t *testing.T) {
-	id := RandomSchemaObjectIdentifier()
+	id := RandomSchemaObjectIdentifierWithArguments()
 
 	defaultOpts := func() *DescribeProcedureOptions {
 		return &DescribeProcedureOptions{
@@ -579,19 +579,19 @@ func TestProcedures_Describe(t *testing.T) {
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.name = NewSchemaObjectIdentifierWithArguments("", "", "", nil)
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
 	t.Run("no arguments", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE PROCEDURE %s ()`, id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE PROCEDURE %s()`, id.SchemaObjectId().FullyQualifiedName())
 	})
 
 	t.Run("all options", func(t *testing.T) {
 		opts := defaultOpts()
-		opts.ArgumentDataTypes = []DataType{DataTypeVARCHAR, DataTypeNumber}
-		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE PROCEDURE %s (VARCHAR, NUMBER)`, id.FullyQualifiedName())
+		opts.name = NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []DataType{DataTypeVARCHAR, DataTypeNumber})
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE PROCEDURE %s(VARCHAR, NUMBER)`, id.SchemaObjectId().FullyQualifiedName())
 	})
 }
 
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Procedures = (*procedures)(nil)
 
@@ -57,17 +53,23 @@ func (v *procedures) Show(ctx context.Context, request *ShowProcedureRequest) ([
 	return resultList, nil
 }
 
-func (v *procedures) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Procedure, error) {
-	request := NewShowProcedureRequest().WithIn(&In{Database: NewAccountObjectIdentifier(id.DatabaseName())}).WithLike(&Like{String(id.Name())})
+func (v *procedures) ShowByID(ctx context.Context, id SchemaObjectIdentifierWithArguments) (*Procedure, error) {
+	// generator:protected-begin procedures.ShowByID
+	request := NewShowProcedureRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	procedures, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(procedures, func(r Procedure) bool { return r.Name == id.Name() })
+	return findOne(procedures, func(r Procedure) bool {
+		return r.Name == id.Name() && argumentDataTypesMatch(r.Arguments, id.ArgumentDataTypes())
+	})
+	// generator:protected-end
 }
 
-func (v *procedures) Describe(ctx context.Context, request *DescribeProcedureRequest) ([]ProcedureDetail, error) {
-	opts := request.toOpts()
+func (v *procedures) Describe(ctx context.Context, id SchemaObjectIdentifierWithArguments) ([]ProcedureDetail, error) {
+	opts := &DescribeProcedureOptions{
+		name: id,
+	}
 	rows, err := validateAndQuery[procedureDetailRow](v.client, ctx, opts)
 	if err != nil {
 		return nil, err
@@ -107,14 +109,11 @@ func (v *procedures) CreateAndCallForSQL(ctx context.Context, request *CreateAnd
 
 func (r *CreateForJavaProcedureRequest) toOpts() *CreateForJavaProcedureOptions {
 	opts := &CreateForJavaProcedureOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		OrReplace:                  r.OrReplace,
+		Secure:                     r.Secure,
+		name:                       r.name,
+		CopyGrants:                 r.CopyGrants,
+		RuntimeVersion:             r.RuntimeVersion,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -127,7 +126,11 @@ func (r *CreateForJavaProcedureRequest) toOpts() *CreateForJavaProcedureOptions
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -144,7 +147,10 @@ func (r *CreateForJavaProcedureRequest) toOpts() *CreateForJavaProcedureOptions
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -152,14 +158,18 @@ func (r *CreateForJavaProcedureRequest) toOpts() *CreateForJavaProcedureOptions
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -168,10 +178,9 @@ func (r *CreateForJavaProcedureRequest) toOpts() *CreateForJavaProcedureOptions
 
 func (r *CreateForJavaScriptProcedureRequest) toOpts() *CreateForJavaScriptProcedureOptions {
 	opts := &CreateForJavaScriptProcedureOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
+		OrReplace:           r.OrReplace,
+		Secure:              r.Secure,
+		name:                r.name,
 		CopyGrants:          r.CopyGrants,
 		ResultDataType:      r.ResultDataType,
 		NotNull:             r.NotNull,
@@ -183,7 +192,11 @@ func (r *CreateForJavaScriptProcedureRequest) toOpts() *CreateForJavaScriptProce
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -192,14 +205,11 @@ func (r *CreateForJavaScriptProcedureRequest) toOpts() *CreateForJavaScriptProce
 
 func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOptions {
 	opts := &CreateForPythonProcedureOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		OrReplace:                  r.OrReplace,
+		Secure:                     r.Secure,
+		name:                       r.name,
+		CopyGrants:                 r.CopyGrants,
+		RuntimeVersion:             r.RuntimeVersion,
 		Handler:                    r.Handler,
 		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
 		Secrets:                    r.Secrets,
@@ -211,7 +221,11 @@ func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOpti
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -228,7 +242,10 @@ func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOpti
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -236,14 +253,18 @@ func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOpti
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -252,14 +273,11 @@ func (r *CreateForPythonProcedureRequest) toOpts() *CreateForPythonProcedureOpti
 
 func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOptions {
 	opts := &CreateForScalaProcedureOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		OrReplace:           r.OrReplace,
+		Secure:              r.Secure,
+		name:                r.name,
+		CopyGrants:          r.CopyGrants,
+		RuntimeVersion:      r.RuntimeVersion,
 		Handler:             r.Handler,
 		TargetPath:          r.TargetPath,
 		NullInputBehavior:   r.NullInputBehavior,
@@ -270,7 +288,11 @@ func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOption
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -287,7 +309,10 @@ func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOption
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -295,14 +320,18 @@ func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOption
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -311,12 +340,10 @@ func (r *CreateForScalaProcedureRequest) toOpts() *CreateForScalaProcedureOption
 
 func (r *CreateForSQLProcedureRequest) toOpts() *CreateForSQLProcedureOptions {
 	opts := &CreateForSQLProcedureOptions{
-		OrReplace: r.OrReplace,
-		Secure:    r.Secure,
-		name:      r.name,
-
-		CopyGrants: r.CopyGrants,
-
+		OrReplace:           r.OrReplace,
+		Secure:              r.Secure,
+		name:                r.name,
+		CopyGrants:          r.CopyGrants,
 		NullInputBehavior:   r.NullInputBehavior,
 		Comment:             r.Comment,
 		ExecuteAs:           r.ExecuteAs,
@@ -325,7 +352,11 @@ func (r *CreateForSQLProcedureRequest) toOpts() *CreateForSQLProcedureOptions {
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -342,7 +373,10 @@ func (r *CreateForSQLProcedureRequest) toOpts() *CreateForSQLProcedureOptions {
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -385,6 +419,7 @@ func (r *ShowProcedureRequest) toOpts() *ShowProcedureOptions {
 }
 
 func (r procedureRow) convert() *Procedure {
+	// generator:protected-begin procedureRow.convert
 	e := &Procedure{
 		CreatedOn:          r.CreatedOn,
 		Name:               r.Name,
@@ -404,17 +439,18 @@ func (r procedureRow) convert() *Procedure {
 		e.IsSecure = r.IsSecure.String == "Y"
 	}
 	return e
+	// generator:protected-end
 }
 
 func (r *DescribeProcedureRequest) toOpts() *DescribeProcedureOptions {
 	opts := &DescribeProcedureOptions{
-		name:              r.name,
-		ArgumentDataTypes: r.ArgumentDataTypes,
+		name: r.name,
 	}
 	return opts
 }
 
 func (r procedureDetailRow) convert() *ProcedureDetail {
+	// generator:protected-begin procedureDetailRow.convert
 	e := &ProcedureDetail{
 		Property: r.Property,
 	}
@@ -422,6 +458,7 @@ func (r procedureDetailRow) convert() *ProcedureDetail {
 		e.Value = r.Value.String
 	}
 	return e
+	// generator:protected-end
 }
 
 func (r *CallProcedureRequest) toOpts() *CallProcedureOptions {
@@ -435,22 +472,23 @@ func (r *CallProcedureRequest) toOpts() *CallProcedureOptions {
 
 func (r *CreateAndCallForJavaProcedureRequest) toOpts() *CreateAndCallForJavaProcedureOptions {
 	opts := &CreateAndCallForJavaProcedureOptions{
-		Name: r.Name,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		Name:                r.Name,
+		RuntimeVersion:      r.RuntimeVersion,
 		Handler:             r.Handler,
 		NullInputBehavior:   r.NullInputBehavior,
 		ProcedureDefinition: r.ProcedureDefinition,
-
-		ProcedureName:     r.ProcedureName,
-		CallArguments:     r.CallArguments,
-		ScriptingVariable: r.ScriptingVariable,
+		ProcedureName:       r.ProcedureName,
+		CallArguments:       r.CallArguments,
+		ScriptingVariable:   r.ScriptingVariable,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -467,7 +505,10 @@ func (r *CreateAndCallForJavaProcedureRequest) toOpts() *CreateAndCallForJavaPro
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -475,14 +516,18 @@ func (r *CreateAndCallForJavaProcedureRequest) toOpts() *CreateAndCallForJavaPro
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -498,22 +543,23 @@ func (r *CreateAndCallForJavaProcedureRequest) toOpts() *CreateAndCallForJavaPro
 
 func (r *CreateAndCallForScalaProcedureRequest) toOpts() *CreateAndCallForScalaProcedureOptions {
 	opts := &CreateAndCallForScalaProcedureOptions{
-		Name: r.Name,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		Name:                r.Name,
+		RuntimeVersion:      r.RuntimeVersion,
 		Handler:             r.Handler,
 		NullInputBehavior:   r.NullInputBehavior,
 		ProcedureDefinition: r.ProcedureDefinition,
-
-		ProcedureName:     r.ProcedureName,
-		CallArguments:     r.CallArguments,
-		ScriptingVariable: r.ScriptingVariable,
+		ProcedureName:       r.ProcedureName,
+		CallArguments:       r.CallArguments,
+		ScriptingVariable:   r.ScriptingVariable,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -530,7 +576,10 @@ func (r *CreateAndCallForScalaProcedureRequest) toOpts() *CreateAndCallForScalaP
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -538,14 +587,18 @@ func (r *CreateAndCallForScalaProcedureRequest) toOpts() *CreateAndCallForScalaP
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -565,21 +618,23 @@ func (r *CreateAndCallForScalaProcedureRequest) toOpts() *CreateAndCallForScalaP
 
 func (r *CreateAndCallForJavaScriptProcedureRequest) toOpts() *CreateAndCallForJavaScriptProcedureOptions {
 	opts := &CreateAndCallForJavaScriptProcedureOptions{
-		Name: r.Name,
-
+		Name:                r.Name,
 		ResultDataType:      r.ResultDataType,
 		NotNull:             r.NotNull,
 		NullInputBehavior:   r.NullInputBehavior,
 		ProcedureDefinition: r.ProcedureDefinition,
-
-		ProcedureName:     r.ProcedureName,
-		CallArguments:     r.CallArguments,
-		ScriptingVariable: r.ScriptingVariable,
+		ProcedureName:       r.ProcedureName,
+		CallArguments:       r.CallArguments,
+		ScriptingVariable:   r.ScriptingVariable,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -599,22 +654,23 @@ func (r *CreateAndCallForJavaScriptProcedureRequest) toOpts() *CreateAndCallForJ
 
 func (r *CreateAndCallForPythonProcedureRequest) toOpts() *CreateAndCallForPythonProcedureOptions {
 	opts := &CreateAndCallForPythonProcedureOptions{
-		Name: r.Name,
-
-		RuntimeVersion: r.RuntimeVersion,
-
+		Name:                r.Name,
+		RuntimeVersion:      r.RuntimeVersion,
 		Handler:             r.Handler,
 		NullInputBehavior:   r.NullInputBehavior,
 		ProcedureDefinition: r.ProcedureDefinition,
-
-		ProcedureName:     r.ProcedureName,
-		CallArguments:     r.CallArguments,
-		ScriptingVariable: r.ScriptingVariable,
+		ProcedureName:       r.ProcedureName,
+		CallArguments:       r.CallArguments,
+		ScriptingVariable:   r.ScriptingVariable,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -631,7 +687,10 @@ func (r *CreateAndCallForPythonProcedureRequest) toOpts() *CreateAndCallForPytho
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -639,14 +698,18 @@ func (r *CreateAndCallForPythonProcedureRequest) toOpts() *CreateAndCallForPytho
 	if r.Packages != nil {
 		s := make([]ProcedurePackage, len(r.Packages))
 		for i, v := range r.Packages {
-			s[i] = ProcedurePackage(v)
+			s[i] = ProcedurePackage{
+				Package: v.Package,
+			}
 		}
 		opts.Packages = s
 	}
 	if r.Imports != nil {
 		s := make([]ProcedureImport, len(r.Imports))
 		for i, v := range r.Imports {
-			s[i] = ProcedureImport(v)
+			s[i] = ProcedureImport{
+				Import: v.Import,
+			}
 		}
 		opts.Imports = s
 	}
@@ -666,19 +729,21 @@ func (r *CreateAndCallForPythonProcedureRequest) toOpts() *CreateAndCallForPytho
 
 func (r *CreateAndCallForSQLProcedureRequest) toOpts() *CreateAndCallForSQLProcedureOptions {
 	opts := &CreateAndCallForSQLProcedureOptions{
-		Name: r.Name,
-
+		Name:                r.Name,
 		NullInputBehavior:   r.NullInputBehavior,
 		ProcedureDefinition: r.ProcedureDefinition,
-
-		ProcedureName:     r.ProcedureName,
-		CallArguments:     r.CallArguments,
-		ScriptingVariable: r.ScriptingVariable,
+		ProcedureName:       r.ProcedureName,
+		CallArguments:       r.CallArguments,
+		ScriptingVariable:   r.ScriptingVariable,
 	}
 	if r.Arguments != nil {
 		s := make([]ProcedureArgument, len(r.Arguments))
 		for i, v := range r.Arguments {
-			s[i] = ProcedureArgument(v)
+			s[i] = ProcedureArgument{
+				ArgName:      v.ArgName,
+				ArgDataType:  v.ArgDataType,
+				DefaultValue: v.DefaultValue,
+			}
 		}
 		opts.Arguments = s
 	}
@@ -695,7 +760,10 @@ func (r *CreateAndCallForSQLProcedureRequest) toOpts() *CreateAndCallForSQLProce
 		if r.Returns.Table.Columns != nil {
 			s := make([]ProcedureColumn, len(r.Returns.Table.Columns))
 			for i, v := range r.Returns.Table.Columns {
-				s[i] = ProcedureColumn(v)
+				s[i] = ProcedureColumn{
+					ColumnName:     v.ColumnName,
+					ColumnDataType: v.ColumnDataType,
+				}
 			}
 			opts.Returns.Table.Columns = s
 		}
@@ -713,3 +781,6 @@ func (r *CreateAndCallForSQLProcedureRequest) toOpts() *CreateAndCallForSQLProce
 	}
 	return opts
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
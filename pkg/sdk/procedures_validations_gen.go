@@ -35,14 +35,14 @@ func (opts *CreateForJavaProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForJavaProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForJavaProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForJavaProcedureOptions.validate
 	if opts.ProcedureDefinition == nil && opts.TargetPath != nil {
 		errs = append(errs, NewError("TARGET_PATH must be nil when AS is nil"))
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -57,6 +57,8 @@ func (opts *CreateForJavaScriptProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CreateForJavaScriptProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -77,11 +79,11 @@ func (opts *CreateForPythonProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForPythonProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForPythonProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForPythonProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -102,14 +104,14 @@ func (opts *CreateForScalaProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForScalaProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForScalaProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForScalaProcedureOptions.validate
 	if opts.ProcedureDefinition == nil && opts.TargetPath != nil {
 		errs = append(errs, NewError("TARGET_PATH must be nil when AS is nil"))
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -124,11 +126,11 @@ func (opts *CreateForSQLProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateForSQLProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateForSQLProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateForSQLProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -146,6 +148,8 @@ func (opts *AlterProcedureOptions) validate() error {
 	if !exactlyOneValueSet(opts.RenameTo, opts.SetComment, opts.SetLogLevel, opts.SetTraceLevel, opts.UnsetComment, opts.SetTags, opts.UnsetTags, opts.ExecuteAs) {
 		errs = append(errs, errExactlyOneOf("AlterProcedureOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"))
 	}
+	// generator:protected-begin AlterProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -157,6 +161,8 @@ func (opts *DropProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -165,6 +171,8 @@ func (opts *ShowProcedureOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -176,6 +184,8 @@ func (opts *DescribeProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -187,6 +197,8 @@ func (opts *CallProcedureOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CallProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -205,16 +217,16 @@ func (opts *CreateAndCallForJavaProcedureOptions) validate() error {
 		errs = append(errs, errNotSet("CreateAndCallForJavaProcedureOptions", "Handler"))
 	}
 	if !ValidObjectIdentifier(opts.ProcedureName) {
-		errs = append(errs, errInvalidIdentifier("CreateAndCallForJavaProcedureOptions", "ProcedureName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !ValidObjectIdentifier(opts.Name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateAndCallForJavaProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateAndCallForJavaProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateAndCallForJavaProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -233,16 +245,16 @@ func (opts *CreateAndCallForScalaProcedureOptions) validate() error {
 		errs = append(errs, errNotSet("CreateAndCallForScalaProcedureOptions", "Handler"))
 	}
 	if !ValidObjectIdentifier(opts.ProcedureName) {
-		errs = append(errs, errInvalidIdentifier("CreateAndCallForScalaProcedureOptions", "ProcedureName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !ValidObjectIdentifier(opts.Name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateAndCallForScalaProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateAndCallForScalaProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateAndCallForScalaProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -258,11 +270,13 @@ func (opts *CreateAndCallForJavaScriptProcedureOptions) validate() error {
 		errs = append(errs, errNotSet("CreateAndCallForJavaScriptProcedureOptions", "ResultDataType"))
 	}
 	if !ValidObjectIdentifier(opts.ProcedureName) {
-		errs = append(errs, errInvalidIdentifier("CreateAndCallForJavaScriptProcedureOptions", "ProcedureName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !ValidObjectIdentifier(opts.Name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin CreateAndCallForJavaScriptProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -281,16 +295,16 @@ func (opts *CreateAndCallForPythonProcedureOptions) validate() error {
 		errs = append(errs, errNotSet("CreateAndCallForPythonProcedureOptions", "Handler"))
 	}
 	if !ValidObjectIdentifier(opts.ProcedureName) {
-		errs = append(errs, errInvalidIdentifier("CreateAndCallForPythonProcedureOptions", "ProcedureName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !ValidObjectIdentifier(opts.Name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateAndCallForPythonProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateAndCallForPythonProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateAndCallForPythonProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -303,15 +317,18 @@ func (opts *CreateAndCallForSQLProcedureOptions) validate() error {
 		errs = append(errs, errNotSet("CreateAndCallForSQLProcedureOptions", "ProcedureDefinition"))
 	}
 	if !ValidObjectIdentifier(opts.ProcedureName) {
-		errs = append(errs, errInvalidIdentifier("CreateAndCallForSQLProcedureOptions", "ProcedureName"))
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if !ValidObjectIdentifier(opts.Name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if valueSet(opts.Returns) {
-		if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
-			errs = append(errs, errExactlyOneOf("CreateAndCallForSQLProcedureOptions.Returns", "ResultDataType", "Table"))
-		}
+	if !exactlyOneValueSet(opts.Returns.ResultDataType, opts.Returns.Table) {
+		errs = append(errs, errExactlyOneOf("CreateAndCallForSQLProcedureOptions.Returns", "ResultDataType", "Table"))
 	}
+	// generator:protected-begin CreateAndCallForSQLProcedureOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
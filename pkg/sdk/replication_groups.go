@@ -1,4 +0,0 @@
-package sdk
-
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
-// also: TestInt_AlterReplication
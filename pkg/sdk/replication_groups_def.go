@@ -0,0 +1,183 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var replicationGroupSecondaryStateEnum = g.NewEnum("ReplicationGroupSecondaryState", "SUSPENDED", "STARTED", "NULL")
+
+var replicationGroupSet = g.NewQueryStruct("ReplicationGroupSet").
+	PredefinedQueryStructField("ObjectTypes", "[]PluralObjectType", g.ParameterOptions().SQL("OBJECT_TYPES")).
+	PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_DATABASES")).
+	PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_SHARES")).
+	PredefinedQueryStructField("AllowedIntegrationTypes", "[]IntegrationType", g.ParameterOptions().SQL("ALLOWED_INTEGRATION_TYPES")).
+	OptionalTextAssignment("REPLICATION_SCHEDULE", g.ParameterOptions().SingleQuotes()).
+	WithValidation(g.AtLeastOneValueSet, "ObjectTypes", "AllowedDatabases", "AllowedShares", "AllowedIntegrationTypes", "ReplicationSchedule")
+
+var replicationGroupAdd = g.NewQueryStruct("ReplicationGroupAdd").
+	PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("TO ALLOWED_DATABASES").Reverse()).
+	PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("TO ALLOWED_SHARES").Reverse()).
+	PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("TO ALLOWED_ACCOUNTS").Reverse()).
+	OptionalSQL("IGNORE EDITION CHECK").
+	WithValidation(g.ExactlyOneValueSet, "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+
+var replicationGroupMove = g.NewQueryStruct("ReplicationGroupMove").
+	PredefinedQueryStructField("Databases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("DATABASES").NoEquals()).
+	PredefinedQueryStructField("Shares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("SHARES").NoEquals()).
+	Identifier("To", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("TO REPLICATION GROUP").Required()).
+	WithValidation(g.ExactlyOneValueSet, "Databases", "Shares").
+	WithValidation(g.ValidIdentifier, "To")
+
+var replicationGroupRemove = g.NewQueryStruct("ReplicationGroupRemove").
+	PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_DATABASES").Reverse()).
+	PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_SHARES").Reverse()).
+	PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("FROM ALLOWED_ACCOUNTS").Reverse()).
+	WithValidation(g.ExactlyOneValueSet, "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+
+var ReplicationGroupsDef = g.NewInterface(
+	"ReplicationGroups",
+	"ReplicationGroup",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	WithEnums(replicationGroupSecondaryStateEnum).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-replication-group",
+		g.NewQueryStruct("CreateReplicationGroup").
+			Create().
+			SQL("REPLICATION GROUP").
+			IfNotExists().
+			Name().
+			PredefinedQueryStructField("ObjectTypes", "[]PluralObjectType", g.ParameterOptions().SQL("OBJECT_TYPES").Required()).
+			PredefinedQueryStructField("AllowedDatabases", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_DATABASES")).
+			PredefinedQueryStructField("AllowedShares", "[]AccountObjectIdentifier", g.ParameterOptions().SQL("ALLOWED_SHARES")).
+			PredefinedQueryStructField("AllowedIntegrationTypes", "[]IntegrationType", g.ParameterOptions().SQL("ALLOWED_INTEGRATION_TYPES")).
+			PredefinedQueryStructField("AllowedAccounts", "[]AccountIdentifier", g.ParameterOptions().SQL("ALLOWED_ACCOUNTS").Required()).
+			OptionalSQL("IGNORE EDITION CHECK").
+			OptionalTextAssignment("REPLICATION_SCHEDULE", g.ParameterOptions().SingleQuotes()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "ObjectTypes").
+			WithValidation(g.ValidateValueSet, "AllowedAccounts"),
+	).
+	CustomOperation(
+		"CreateSecondary",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-replication-group",
+		g.NewQueryStruct("CreateSecondaryReplicationGroup").
+			Create().
+			SQL("REPLICATION GROUP").
+			IfNotExists().
+			Name().
+			Identifier("PrimaryReplicationGroup", g.KindOfT[ExternalObjectIdentifier](), g.IdentifierOptions().SQL("AS REPLICA OF").Required()).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifier, "PrimaryReplicationGroup"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group",
+		g.NewQueryStruct("AlterReplicationGroup").
+			Alter().
+			SQL("REPLICATION GROUP").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Set",
+				replicationGroupSet,
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Add",
+				replicationGroupAdd,
+				g.KeywordOptions().SQL("ADD"),
+			).
+			OptionalQueryStructField(
+				"Move",
+				replicationGroupMove,
+				g.KeywordOptions().SQL("MOVE"),
+			).
+			OptionalQueryStructField(
+				"Remove",
+				replicationGroupRemove,
+				g.KeywordOptions().SQL("REMOVE"),
+			).
+			OptionalSQL("REFRESH").
+			OptionalSQL("SUSPEND").
+			OptionalSQL("RESUME").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Add", "Move", "Remove", "Refresh", "Suspend", "Resume"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group",
+		g.NewQueryStruct("DropReplicationGroup").
+			Drop().
+			SQL("REPLICATION GROUP").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups",
+		g.DbStruct("replicationGroupDBRow").
+			Text("region_group").
+			Text("snowflake_region").
+			Time("created_on").
+			Text("account_name").
+			Text("name").
+			Text("type").
+			OptionalText("comment").
+			Bool("is_primary").
+			Text("primary").
+			Text("object_types").
+			Text("allowed_integration_types").
+			Text("allowed_accounts").
+			Text("organization_name").
+			Text("account_locator").
+			OptionalText("replication_schedule").
+			OptionalText("secondary_state").
+			OptionalText("next_scheduled_refresh").
+			OptionalText("owner"),
+		g.PlainStruct("ReplicationGroup").
+			Text("RegionGroup").
+			Text("SnowflakeRegion").
+			Time("CreatedOn").
+			Text("AccountName").
+			Text("Name").
+			Text("Type").
+			Text("Comment").
+			Bool("IsPrimary").
+			Field("Primary", "ExternalObjectIdentifier").
+			Field("ObjectTypes", "[]PluralObjectType").
+			Field("AllowedIntegrationTypes", "[]IntegrationType").
+			Field("AllowedAccounts", "[]AccountIdentifier").
+			Text("OrganizationName").
+			Text("AccountLocator").
+			Text("ReplicationSchedule").
+			Field("SecondaryState", replicationGroupSecondaryStateEnum.Kind()).
+			Text("NextScheduledRefresh").
+			Text("Owner"),
+		g.NewQueryStruct("ShowReplicationGroups").
+			Show().
+			SQL("REPLICATION GROUPS").
+			OptionalIdentifier("InAccount", g.KindOfT[AccountIdentifier](), g.IdentifierOptions().SQL("IN ACCOUNT")),
+	).
+	// SHOW REPLICATION GROUPS lists the groups of all the accounts in the organization, so ShowByID compares the account locator too (by hand)
+	ShowByIdOperationWithNoFiltering().
+	CustomQueryOperation(
+		"ShowDatabases",
+		"https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group",
+		g.NewQueryStruct("ShowDatabasesInReplicationGroup").
+			Show().
+			SQL("DATABASES").
+			Identifier("In", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("IN REPLICATION GROUP").Required()).
+			WithValidation(g.ValidIdentifier, "In"),
+		"[]AccountObjectIdentifier",
+	).
+	CustomQueryOperation(
+		"ShowShares",
+		"https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group",
+		g.NewQueryStruct("ShowSharesInReplicationGroup").
+			Show().
+			SQL("SHARES").
+			Identifier("In", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("IN REPLICATION GROUP").Required()).
+			WithValidation(g.ValidIdentifier, "In"),
+		"[]AccountObjectIdentifier",
+	)
@@ -0,0 +1,91 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateReplicationGroupOptions]          = new(CreateReplicationGroupRequest)
+	_ optionsProvider[CreateSecondaryReplicationGroupOptions] = new(CreateSecondaryReplicationGroupRequest)
+	_ optionsProvider[AlterReplicationGroupOptions]           = new(AlterReplicationGroupRequest)
+	_ optionsProvider[DropReplicationGroupOptions]            = new(DropReplicationGroupRequest)
+	_ optionsProvider[ShowReplicationGroupOptions]            = new(ShowReplicationGroupRequest)
+	_ optionsProvider[ShowDatabasesReplicationGroupOptions]   = new(ShowDatabasesReplicationGroupRequest)
+	_ optionsProvider[ShowSharesReplicationGroupOptions]      = new(ShowSharesReplicationGroupRequest)
+)
+
+type CreateReplicationGroupRequest struct {
+	IfNotExists             *bool
+	name                    AccountObjectIdentifier // required
+	ObjectTypes             []PluralObjectType      // required
+	AllowedDatabases        []AccountObjectIdentifier
+	AllowedShares           []AccountObjectIdentifier
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier // required
+	IgnoreEditionCheck      *bool
+	ReplicationSchedule     *string
+}
+
+type CreateSecondaryReplicationGroupRequest struct {
+	IfNotExists             *bool
+	name                    AccountObjectIdentifier  // required
+	PrimaryReplicationGroup ExternalObjectIdentifier // required
+}
+
+type AlterReplicationGroupRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+	RenameTo *AccountObjectIdentifier
+	Set      *ReplicationGroupSetRequest
+	Add      *ReplicationGroupAddRequest
+	Move     *ReplicationGroupMoveRequest
+	Remove   *ReplicationGroupRemoveRequest
+	Refresh  *bool
+	Suspend  *bool
+	Resume   *bool
+}
+
+type ReplicationGroupSetRequest struct {
+	ObjectTypes             []PluralObjectType
+	AllowedDatabases        []AccountObjectIdentifier
+	AllowedShares           []AccountObjectIdentifier
+	AllowedIntegrationTypes []IntegrationType
+	ReplicationSchedule     *string
+}
+
+type ReplicationGroupAddRequest struct {
+	AllowedDatabases   []AccountObjectIdentifier
+	AllowedShares      []AccountObjectIdentifier
+	AllowedAccounts    []AccountIdentifier
+	IgnoreEditionCheck *bool
+}
+
+type ReplicationGroupMoveRequest struct {
+	Databases []AccountObjectIdentifier
+	Shares    []AccountObjectIdentifier
+	To        AccountObjectIdentifier // required
+}
+
+type ReplicationGroupRemoveRequest struct {
+	AllowedDatabases []AccountObjectIdentifier
+	AllowedShares    []AccountObjectIdentifier
+	AllowedAccounts  []AccountIdentifier
+}
+
+type DropReplicationGroupRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier // required
+}
+
+type ShowReplicationGroupRequest struct {
+	InAccount *AccountIdentifier
+}
+
+type ShowDatabasesReplicationGroupRequest struct {
+	In AccountObjectIdentifier // required
+}
+
+type ShowSharesReplicationGroupRequest struct {
+	In AccountObjectIdentifier // required
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,207 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ReplicationGroups interface {
+	Create(ctx context.Context, request *CreateReplicationGroupRequest) error
+	CreateSecondary(ctx context.Context, request *CreateSecondaryReplicationGroupRequest) error
+	Alter(ctx context.Context, request *AlterReplicationGroupRequest) error
+	Drop(ctx context.Context, request *DropReplicationGroupRequest) error
+	Show(ctx context.Context, request *ShowReplicationGroupRequest) ([]ReplicationGroup, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	ShowDatabases(ctx context.Context, request *ShowDatabasesReplicationGroupRequest) ([]AccountObjectIdentifier, error)
+	ShowShares(ctx context.Context, request *ShowSharesReplicationGroupRequest) ([]AccountObjectIdentifier, error)
+	// generator:protected-begin ReplicationGroups methods
+	// generator:protected-end
+}
+
+type ReplicationGroupSecondaryState string
+
+const (
+	ReplicationGroupSecondaryStateSuspended ReplicationGroupSecondaryState = "SUSPENDED"
+	ReplicationGroupSecondaryStateStarted   ReplicationGroupSecondaryState = "STARTED"
+	ReplicationGroupSecondaryStateNull      ReplicationGroupSecondaryState = "NULL"
+)
+
+var AllReplicationGroupSecondaryStates = []ReplicationGroupSecondaryState{
+	ReplicationGroupSecondaryStateSuspended,
+	ReplicationGroupSecondaryStateStarted,
+	ReplicationGroupSecondaryStateNull,
+}
+
+func ToReplicationGroupSecondaryState(s string) (ReplicationGroupSecondaryState, error) {
+	switch strings.ToUpper(s) {
+	case "SUSPENDED":
+		return ReplicationGroupSecondaryStateSuspended, nil
+	case "STARTED":
+		return ReplicationGroupSecondaryStateStarted, nil
+	case "NULL":
+		return ReplicationGroupSecondaryStateNull, nil
+	default:
+		return "", fmt.Errorf("invalid ReplicationGroupSecondaryState: %s", s)
+	}
+}
+
+// CreateReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicationGroupOptions struct {
+	create                  bool                      `ddl:"static" sql:"CREATE"`
+	replicationGroup        bool                      `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists             *bool                     `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier   `ddl:"identifier"`
+	ObjectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	AllowedAccounts         []AccountIdentifier       `ddl:"parameter" sql:"ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck      *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+// CreateSecondaryReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateSecondaryReplicationGroupOptions struct {
+	create                  bool                     `ddl:"static" sql:"CREATE"`
+	replicationGroup        bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists             *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier  `ddl:"identifier"`
+	PrimaryReplicationGroup ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+}
+
+// AlterReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterReplicationGroupOptions struct {
+	alter            bool                     `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier  `ddl:"identifier"`
+	RenameTo         *AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set              *ReplicationGroupSet     `ddl:"keyword" sql:"SET"`
+	Add              *ReplicationGroupAdd     `ddl:"keyword" sql:"ADD"`
+	Move             *ReplicationGroupMove    `ddl:"keyword" sql:"MOVE"`
+	Remove           *ReplicationGroupRemove  `ddl:"keyword" sql:"REMOVE"`
+	Refresh          *bool                    `ddl:"keyword" sql:"REFRESH"`
+	Suspend          *bool                    `ddl:"keyword" sql:"SUSPEND"`
+	Resume           *bool                    `ddl:"keyword" sql:"RESUME"`
+}
+
+type ReplicationGroupSet struct {
+	ObjectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+type ReplicationGroupAdd struct {
+	AllowedDatabases   []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_DATABASES"`
+	AllowedShares      []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_SHARES"`
+	AllowedAccounts    []AccountIdentifier       `ddl:"parameter,reverse" sql:"TO ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+type ReplicationGroupMove struct {
+	Databases []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"DATABASES"`
+	Shares    []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"SHARES"`
+	To        AccountObjectIdentifier   `ddl:"identifier" sql:"TO REPLICATION GROUP"`
+}
+
+type ReplicationGroupRemove struct {
+	AllowedDatabases []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_DATABASES"`
+	AllowedShares    []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_SHARES"`
+	AllowedAccounts  []AccountIdentifier       `ddl:"parameter,reverse" sql:"FROM ALLOWED_ACCOUNTS"`
+}
+
+// DropReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group.
+type DropReplicationGroupOptions struct {
+	drop             bool                    `ddl:"static" sql:"DROP"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups.
+type ShowReplicationGroupOptions struct {
+	show              bool               `ddl:"static" sql:"SHOW"`
+	replicationGroups bool               `ddl:"static" sql:"REPLICATION GROUPS"`
+	InAccount         *AccountIdentifier `ddl:"identifier" sql:"IN ACCOUNT"`
+}
+
+type replicationGroupDBRow struct {
+	RegionGroup             string         `db:"region_group"`
+	SnowflakeRegion         string         `db:"snowflake_region"`
+	CreatedOn               time.Time      `db:"created_on"`
+	AccountName             string         `db:"account_name"`
+	Name                    string         `db:"name"`
+	Type                    string         `db:"type"`
+	Comment                 sql.NullString `db:"comment"`
+	IsPrimary               bool           `db:"is_primary"`
+	Primary                 string         `db:"primary"`
+	ObjectTypes             string         `db:"object_types"`
+	AllowedIntegrationTypes string         `db:"allowed_integration_types"`
+	AllowedAccounts         string         `db:"allowed_accounts"`
+	OrganizationName        string         `db:"organization_name"`
+	AccountLocator          string         `db:"account_locator"`
+	ReplicationSchedule     sql.NullString `db:"replication_schedule"`
+	SecondaryState          sql.NullString `db:"secondary_state"`
+	NextScheduledRefresh    sql.NullString `db:"next_scheduled_refresh"`
+	Owner                   sql.NullString `db:"owner"`
+}
+
+type ReplicationGroup struct {
+	RegionGroup             string
+	SnowflakeRegion         string
+	CreatedOn               time.Time
+	AccountName             string
+	Name                    string
+	Type                    string
+	Comment                 string
+	IsPrimary               bool
+	Primary                 ExternalObjectIdentifier
+	ObjectTypes             []PluralObjectType
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier
+	OrganizationName        string
+	AccountLocator          string
+	ReplicationSchedule     string
+	SecondaryState          ReplicationGroupSecondaryState
+	NextScheduledRefresh    string
+	Owner                   string
+}
+
+// ShowDatabasesReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group.
+type ShowDatabasesReplicationGroupOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`
+	databases bool                    `ddl:"static" sql:"DATABASES"`
+	In        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+// ShowSharesReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group.
+type ShowSharesReplicationGroupOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`
+	shares bool                    `ddl:"static" sql:"SHARES"`
+	In     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+// generator:protected-begin declarations
+func (v *ReplicationGroup) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ReplicationGroup) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(NewAccountIdentifier(v.OrganizationName, v.AccountName), v.ID())
+}
+
+func (v *ReplicationGroup) ObjectType() ObjectType {
+	return ObjectTypeReplicationGroup
+}
+
+// replicationGroupObjectDBRow is used to decode the result of SHOW DATABASES/SHARES IN REPLICATION GROUP queries.
+type replicationGroupObjectDBRow struct {
+	Name string `db:"name"`
+}
+
+// generator:protected-end
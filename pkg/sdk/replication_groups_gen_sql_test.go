@@ -0,0 +1,152 @@
+package sdk
+
+import "testing"
+
+func TestReplicationGroups_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ObjectTypes")
+			fillSQLFixtureField(opts, "AllowedAccounts")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateSecondary", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateSecondaryReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "PrimaryReplicationGroup")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateSecondaryReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("RenameTo", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "RenameTo")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Add", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Add")
+			clearSQLFixtureField(opts, "Add.AllowedShares")
+			clearSQLFixtureField(opts, "Add.AllowedAccounts")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Move", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Move")
+			clearSQLFixtureField(opts, "Move.Shares")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Remove", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Remove")
+			clearSQLFixtureField(opts, "Remove.AllowedShares")
+			clearSQLFixtureField(opts, "Remove.AllowedAccounts")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Refresh", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Refresh")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Suspend", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Suspend")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("Resume", func(t *testing.T) {
+			opts := &AlterReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Resume")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropReplicationGroupOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowReplicationGroupOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowReplicationGroupOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("ShowDatabases", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowDatabasesReplicationGroupOptions{}
+			fillSQLFixtureField(opts, "In")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowDatabasesReplicationGroupOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("ShowShares", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowSharesReplicationGroupOptions{}
+			fillSQLFixtureField(opts, "In")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowSharesReplicationGroupOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -0,0 +1,254 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+)
+
+var _ ReplicationGroups = (*replicationGroups)(nil)
+
+type replicationGroups struct {
+	client *Client
+}
+
+func (v *replicationGroups) Create(ctx context.Context, request *CreateReplicationGroupRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *replicationGroups) CreateSecondary(ctx context.Context, request *CreateSecondaryReplicationGroupRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *replicationGroups) Alter(ctx context.Context, request *AlterReplicationGroupRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *replicationGroups) Drop(ctx context.Context, request *DropReplicationGroupRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *replicationGroups) Show(ctx context.Context, request *ShowReplicationGroupRequest) ([]ReplicationGroup, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[replicationGroupDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[replicationGroupDBRow, ReplicationGroup](dbRows)
+	return resultList, nil
+}
+
+func (v *replicationGroups) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	// generator:protected-begin replicationGroups.ShowByID
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// SHOW REPLICATION GROUPS lists the groups from all the accounts in the organization, so the primary and its secondaries have to be distinguished by the account
+	replicationGroups, err := v.Show(ctx, NewShowReplicationGroupRequest())
+	if err != nil {
+		return nil, err
+	}
+	for _, replicationGroup := range replicationGroups {
+		if replicationGroup.ID() == id && replicationGroup.AccountLocator == currentAccount {
+			return &replicationGroup, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+	// generator:protected-end
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, request *ShowDatabasesReplicationGroupRequest) ([]AccountObjectIdentifier, error) {
+	// generator:protected-begin replicationGroups.ShowDatabases
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[replicationGroupObjectDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dbRows))
+	for i, row := range dbRows {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+	// generator:protected-end
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, request *ShowSharesReplicationGroupRequest) ([]AccountObjectIdentifier, error) {
+	// generator:protected-begin replicationGroups.ShowShares
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[replicationGroupObjectDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dbRows))
+	for i, row := range dbRows {
+		// shares are listed with the fully qualified name containing the account
+		resultList[i] = NewAccountObjectIdentifier(NewExternalObjectIdentifierFromFullyQualifiedName(row.Name).Name())
+	}
+	return resultList, nil
+	// generator:protected-end
+}
+
+func (r *CreateReplicationGroupRequest) toOpts() *CreateReplicationGroupOptions {
+	opts := &CreateReplicationGroupOptions{
+		IfNotExists:             r.IfNotExists,
+		name:                    r.name,
+		ObjectTypes:             r.ObjectTypes,
+		AllowedDatabases:        r.AllowedDatabases,
+		AllowedShares:           r.AllowedShares,
+		AllowedIntegrationTypes: r.AllowedIntegrationTypes,
+		AllowedAccounts:         r.AllowedAccounts,
+		IgnoreEditionCheck:      r.IgnoreEditionCheck,
+		ReplicationSchedule:     r.ReplicationSchedule,
+	}
+	return opts
+}
+
+func (r *CreateSecondaryReplicationGroupRequest) toOpts() *CreateSecondaryReplicationGroupOptions {
+	opts := &CreateSecondaryReplicationGroupOptions{
+		IfNotExists:             r.IfNotExists,
+		name:                    r.name,
+		PrimaryReplicationGroup: r.PrimaryReplicationGroup,
+	}
+	return opts
+}
+
+func (r *AlterReplicationGroupRequest) toOpts() *AlterReplicationGroupOptions {
+	opts := &AlterReplicationGroupOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		RenameTo: r.RenameTo,
+		Refresh:  r.Refresh,
+		Suspend:  r.Suspend,
+		Resume:   r.Resume,
+	}
+	if r.Set != nil {
+		opts.Set = &ReplicationGroupSet{
+			ObjectTypes:             r.Set.ObjectTypes,
+			AllowedDatabases:        r.Set.AllowedDatabases,
+			AllowedShares:           r.Set.AllowedShares,
+			AllowedIntegrationTypes: r.Set.AllowedIntegrationTypes,
+			ReplicationSchedule:     r.Set.ReplicationSchedule,
+		}
+	}
+	if r.Add != nil {
+		opts.Add = &ReplicationGroupAdd{
+			AllowedDatabases:   r.Add.AllowedDatabases,
+			AllowedShares:      r.Add.AllowedShares,
+			AllowedAccounts:    r.Add.AllowedAccounts,
+			IgnoreEditionCheck: r.Add.IgnoreEditionCheck,
+		}
+	}
+	if r.Move != nil {
+		opts.Move = &ReplicationGroupMove{
+			Databases: r.Move.Databases,
+			Shares:    r.Move.Shares,
+			To:        r.Move.To,
+		}
+	}
+	if r.Remove != nil {
+		opts.Remove = &ReplicationGroupRemove{
+			AllowedDatabases: r.Remove.AllowedDatabases,
+			AllowedShares:    r.Remove.AllowedShares,
+			AllowedAccounts:  r.Remove.AllowedAccounts,
+		}
+	}
+	return opts
+}
+
+func (r *DropReplicationGroupRequest) toOpts() *DropReplicationGroupOptions {
+	opts := &DropReplicationGroupOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowReplicationGroupRequest) toOpts() *ShowReplicationGroupOptions {
+	opts := &ShowReplicationGroupOptions{
+		InAccount: r.InAccount,
+	}
+	return opts
+}
+
+func (r replicationGroupDBRow) convert() *ReplicationGroup {
+	// generator:protected-begin replicationGroupDBRow.convert
+	replicationGroup := &ReplicationGroup{
+		RegionGroup:      r.RegionGroup,
+		SnowflakeRegion:  r.SnowflakeRegion,
+		CreatedOn:        r.CreatedOn,
+		AccountName:      r.AccountName,
+		Name:             r.Name,
+		Type:             r.Type,
+		IsPrimary:        r.IsPrimary,
+		Primary:          NewExternalObjectIdentifierFromFullyQualifiedName(r.Primary),
+		OrganizationName: r.OrganizationName,
+		AccountLocator:   r.AccountLocator,
+		SecondaryState:   ReplicationGroupSecondaryStateNull,
+	}
+	if r.Comment.Valid {
+		replicationGroup.Comment = r.Comment.String
+	}
+	if r.ReplicationSchedule.Valid {
+		replicationGroup.ReplicationSchedule = r.ReplicationSchedule.String
+	}
+	if r.SecondaryState.Valid {
+		replicationGroup.SecondaryState = ReplicationGroupSecondaryState(r.SecondaryState.String)
+	}
+	if r.NextScheduledRefresh.Valid {
+		replicationGroup.NextScheduledRefresh = r.NextScheduledRefresh.String
+	}
+	if r.Owner.Valid {
+		replicationGroup.Owner = r.Owner.String
+	}
+	replicationGroup.ObjectTypes = make([]PluralObjectType, 0)
+	for _, objectType := range strings.Split(r.ObjectTypes, ",") {
+		objectType = strings.TrimSpace(objectType)
+		if objectType == "" {
+			continue
+		}
+		// account parameters are listed as PARAMETERS, but they have to be passed as ACCOUNT PARAMETERS
+		if PluralObjectType(objectType) == PluralObjectTypeParameters {
+			objectType = "ACCOUNT PARAMETERS"
+		}
+		replicationGroup.ObjectTypes = append(replicationGroup.ObjectTypes, PluralObjectType(objectType))
+	}
+	replicationGroup.AllowedIntegrationTypes = make([]IntegrationType, 0)
+	for _, integrationType := range strings.Split(r.AllowedIntegrationTypes, ",") {
+		integrationType = strings.TrimSpace(integrationType)
+		if integrationType == "" {
+			continue
+		}
+		replicationGroup.AllowedIntegrationTypes = append(replicationGroup.AllowedIntegrationTypes, IntegrationType(integrationType+" INTEGRATIONS"))
+	}
+	replicationGroup.AllowedAccounts = make([]AccountIdentifier, 0)
+	for _, allowedAccount := range strings.Split(r.AllowedAccounts, ",") {
+		parts := strings.Split(strings.TrimSpace(allowedAccount), ".")
+		if len(parts) != 2 {
+			continue
+		}
+		replicationGroup.AllowedAccounts = append(replicationGroup.AllowedAccounts, NewAccountIdentifier(parts[0], parts[1]))
+	}
+	return replicationGroup
+	// generator:protected-end
+}
+
+func (r *ShowDatabasesReplicationGroupRequest) toOpts() *ShowDatabasesReplicationGroupOptions {
+	opts := &ShowDatabasesReplicationGroupOptions{
+		In: r.In,
+	}
+	return opts
+}
+
+func (r *ShowSharesReplicationGroupRequest) toOpts() *ShowSharesReplicationGroupOptions {
+	opts := &ShowSharesReplicationGroupOptions{
+		In: r.In,
+	}
+	return opts
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,140 @@
+package sdk
+
+var (
+	_ validatable = new(CreateReplicationGroupOptions)
+	_ validatable = new(CreateSecondaryReplicationGroupOptions)
+	_ validatable = new(AlterReplicationGroupOptions)
+	_ validatable = new(DropReplicationGroupOptions)
+	_ validatable = new(ShowReplicationGroupOptions)
+	_ validatable = new(ShowDatabasesReplicationGroupOptions)
+	_ validatable = new(ShowSharesReplicationGroupOptions)
+)
+
+func (opts *CreateReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.ObjectTypes) {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "ObjectTypes"))
+	}
+	if !valueSet(opts.AllowedAccounts) {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "AllowedAccounts"))
+	}
+	// generator:protected-begin CreateReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateSecondaryReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.PrimaryReplicationGroup) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin CreateSecondaryReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Add, opts.Move, opts.Remove, opts.Refresh, opts.Suspend, opts.Resume) {
+		errs = append(errs, errExactlyOneOf("AlterReplicationGroupOptions", "RenameTo", "Set", "Add", "Move", "Remove", "Refresh", "Suspend", "Resume"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.ObjectTypes, opts.Set.AllowedDatabases, opts.Set.AllowedShares, opts.Set.AllowedIntegrationTypes, opts.Set.ReplicationSchedule) {
+			errs = append(errs, errAtLeastOneOf("AlterReplicationGroupOptions.Set", "ObjectTypes", "AllowedDatabases", "AllowedShares", "AllowedIntegrationTypes", "ReplicationSchedule"))
+		}
+	}
+	if valueSet(opts.Add) {
+		if !exactlyOneValueSet(opts.Add.AllowedDatabases, opts.Add.AllowedShares, opts.Add.AllowedAccounts) {
+			errs = append(errs, errExactlyOneOf("AlterReplicationGroupOptions.Add", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+		}
+	}
+	if valueSet(opts.Move) {
+		if !exactlyOneValueSet(opts.Move.Databases, opts.Move.Shares) {
+			errs = append(errs, errExactlyOneOf("AlterReplicationGroupOptions.Move", "Databases", "Shares"))
+		}
+		if !ValidObjectIdentifier(opts.Move.To) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	if valueSet(opts.Remove) {
+		if !exactlyOneValueSet(opts.Remove.AllowedDatabases, opts.Remove.AllowedShares, opts.Remove.AllowedAccounts) {
+			errs = append(errs, errExactlyOneOf("AlterReplicationGroupOptions.Remove", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+		}
+	}
+	// generator:protected-begin AlterReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *DropReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin DropReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// generator:protected-begin ShowReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowDatabasesReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.In) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin ShowDatabasesReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowSharesReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.In) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin ShowSharesReplicationGroupOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,116 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// RetryPolicy describes how the client retries statements that failed because of transient errors.
+// Only idempotent statements are retried (see isIdempotentStatement).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts (including the first one). Values lower than 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the time to wait before the first retry. It's doubled before every next retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the time to wait between retries (no cap when zero).
+	MaxBackoff time.Duration
+	// RetryableErrors lists errors (matched with errors.Is) that are worth retrying.
+	RetryableErrors []error
+}
+
+// DefaultRetryableErrors are the transient errors retried by the policy returned from DefaultRetryPolicy.
+var DefaultRetryableErrors = []error{
+	ErrServiceUnavailable,
+	ErrConcurrentOperation,
+	ErrLockWaitTimeout,
+	ErrSessionExpired,
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:     3,
+		InitialBackoff:  time.Second,
+		MaxBackoff:      30 * time.Second,
+		RetryableErrors: DefaultRetryableErrors,
+	}
+}
+
+// SetRetryPolicy sets the policy used by the client to retry idempotent statements. Retries are disabled by default.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = &policy
+}
+
+func (p *RetryPolicy) isRetryable(err error) bool {
+	for _, retryable := range p.RetryableErrors {
+		if errors.Is(err, retryable) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// withRetries runs f until it succeeds, returns a non-retryable error, or the attempts are exhausted.
+// Statements which are not idempotent are run exactly once. dest (if not nil) is reset before every retry,
+// so the rows scanned by the failed attempt are not returned.
+func (c *Client) withRetries(ctx context.Context, sql string, dest interface{}, f func() error) error {
+	policy := c.retryPolicy
+	if policy == nil || policy.MaxAttempts < 2 || !isIdempotentStatement(sql) {
+		return f()
+	}
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = f()
+		if err == nil || attempt >= policy.MaxAttempts || !policy.isRetryable(err) {
+			return err
+		}
+		backoff := policy.backoff(attempt)
+		log.Printf("[DEBUG] attempt %d of %d failed with transient error, retrying in %v: %v\n", attempt, policy.MaxAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		if dest != nil {
+			v := reflect.ValueOf(dest).Elem()
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+}
+
+var (
+	readOnlyStatementRegexp     = regexp.MustCompile(`^(SHOW|DESCRIBE|DESC|SELECT)\b`)
+	createIfNotExistsRegexp     = regexp.MustCompile(`^CREATE\b.*\bIF\s+NOT\s+EXISTS\b`)
+	dropIfExistsStatementRegexp = regexp.MustCompile(`^DROP\b.*\bIF\s+EXISTS\b`)
+)
+
+// isIdempotentStatement checks if running the statement more than once has the same effect as running it once.
+// These are read-only statements (SHOW, DESCRIBE, SELECT) and CREATE ... IF NOT EXISTS / DROP ... IF EXISTS statements.
+// Only the part before the first quoted identifier or string is checked, so the names of the objects do not matter.
+func isIdempotentStatement(sql string) bool {
+	statement := strings.ToUpper(strings.TrimSpace(sql))
+	if i := strings.IndexAny(statement, `"'`); i >= 0 {
+		statement = statement[:i]
+	}
+	return readOnlyStatementRegexp.MatchString(statement) ||
+		createIfNotExistsRegexp.MatchString(statement) ||
+		dropIfExistsStatementRegexp.MatchString(statement)
+}
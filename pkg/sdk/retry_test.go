@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRetries(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("wh1")
+	transientErr := &gosnowflake.SnowflakeError{Number: 625, Message: "lock wait timeout"}
+	nonTransientErr := &gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "insufficient privileges"}
+
+	newRetryingClient := func(t *testing.T, maxAttempts int) (*Client, sqlmock.Sqlmock) {
+		t.Helper()
+		client, mock := newMockClient(t)
+		client.SetRetryPolicy(RetryPolicy{
+			MaxAttempts:     maxAttempts,
+			InitialBackoff:  time.Millisecond,
+			RetryableErrors: DefaultRetryableErrors,
+		})
+		return client, mock
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(transientErr)
+
+		_, err := client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrLockWaitTimeout)
+	})
+
+	t.Run("show is retried after transient error", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 3)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(transientErr)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnRows(warehouseRows("wh1"))
+
+		wh, err := client.Warehouses.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "wh1", wh.Name)
+	})
+
+	t.Run("rows from failed attempt are not returned", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 2)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnRows(warehouseRows("wh1").RowError(0, transientErr))
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnRows(warehouseRows("wh1"))
+
+		warehouses, err := client.Warehouses.Show(ctx, &ShowWarehouseOptions{Like: &Like{Pattern: String("wh1")}})
+		require.NoError(t, err)
+		require.Len(t, warehouses, 1)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 2)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(transientErr)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(transientErr)
+
+		_, err := client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrLockWaitTimeout)
+	})
+
+	t.Run("non transient error is not retried", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 3)
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(nonTransientErr)
+
+		_, err := client.Warehouses.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrInsufficientPrivileges)
+	})
+
+	t.Run("create if not exists is retried", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 3)
+		mock.ExpectExec(`CREATE WAREHOUSE IF NOT EXISTS "wh1"`).WillReturnError(transientErr)
+		mock.ExpectExec(`CREATE WAREHOUSE IF NOT EXISTS "wh1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.Warehouses.Create(ctx, id, &CreateWarehouseOptions{IfNotExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("non idempotent statement is not retried", func(t *testing.T) {
+		client, mock := newRetryingClient(t, 3)
+		mock.ExpectExec(`CREATE WAREHOUSE "wh1"`).WillReturnError(transientErr)
+
+		err := client.Warehouses.Create(ctx, id, nil)
+		require.ErrorIs(t, err, ErrLockWaitTimeout)
+	})
+
+	t.Run("context deadline stops retries", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, RetryableErrors: DefaultRetryableErrors})
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnError(transientErr)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := client.Warehouses.ShowByID(timeoutCtx, id)
+		require.ErrorIs(t, err, ErrLockWaitTimeout)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 2*time.Second, policy.backoff(2))
+	assert.Equal(t, 4*time.Second, policy.backoff(3))
+	assert.Equal(t, 5*time.Second, policy.backoff(4))
+	assert.Equal(t, 5*time.Second, policy.backoff(100))
+}
+
+func TestIsIdempotentStatement(t *testing.T) {
+	testCases := map[string]bool{
+		`SHOW WAREHOUSES LIKE 'wh1'`:                        true,
+		`  show databases`:                                  true,
+		`DESCRIBE TABLE "db"."schema"."table"`:              true,
+		`DESC USER "user"`:                                  true,
+		`SELECT CURRENT_ACCOUNT() as CURRENT_ACCOUNT`:       true,
+		`CREATE DATABASE IF NOT EXISTS "db"`:                true,
+		`DROP TABLE IF EXISTS "db"."schema"."table"`:        true,
+		`CREATE DATABASE "db"`:                              false,
+		`CREATE OR REPLACE DATABASE "db"`:                   false,
+		`CREATE DATABASE "IF NOT EXISTS"`:                   false,
+		`DROP TABLE "db"."schema"."table"`:                  false,
+		`ALTER WAREHOUSE IF EXISTS "wh1" RENAME TO "wh2"`:   false,
+		`GRANT ROLE "role1" TO ROLE "role2"`:                false,
+		`INSERT INTO "table" SELECT * FROM "other_table"`:   false,
+		`CALL "db"."schema"."procedure"() -- SELECT`:        false,
+		`UNDROP DATABASE "db"`:                              false,
+		`CREATE TABLE IF NOT EXISTS "t" AS SELECT * FROM x`: true,
+		`SHOWCASE WAREHOUSES LIKE 'wh1'`:                    false,
+	}
+	for statement, expected := range testCases {
+		statement, expected := statement, expected
+		t.Run(statement, func(t *testing.T) {
+			assert.Equal(t, expected, isIdempotentStatement(statement))
+		})
+	}
+}
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var (
 	_ Roles                = (*roles)(nil)
@@ -41,7 +37,7 @@ func (v *roles) ShowByID(ctx context.Context, req *ShowRoleByIdRequest) (*Role,
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(roleList, func(r Role) bool { return r.ID().name == req.id.Name() })
+	return findOne(roleList, func(r Role) bool { return r.ID().name == req.id.Name() })
 }
 
 func (v *roles) Grant(ctx context.Context, req *GrantRoleRequest) error {
@@ -0,0 +1,81 @@
+package sdk
+
+import "testing"
+
+func TestRoles_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateRoleOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateRoleOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		for _, action := range []string{"RenameTo", "SetComment", "SetTags", "UnsetComment", "UnsetTags"} {
+			t.Run(action, func(t *testing.T) {
+				opts := &AlterRoleOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, action)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropRoleOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropRoleOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowRoleOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowRoleOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Grant", func(t *testing.T) {
+		for _, grantee := range []string{"Role", "User"} {
+			t.Run(grantee, func(t *testing.T) {
+				opts := &GrantRoleOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, "Grant."+grantee)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		for _, grantee := range []string{"Role", "User"} {
+			t.Run(grantee, func(t *testing.T) {
+				opts := &RevokeRoleOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, "Revoke."+grantee)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+	})
+}
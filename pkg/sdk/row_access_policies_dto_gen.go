@@ -47,3 +47,6 @@ type ShowRowAccessPolicyRequest struct {
 type DescribeRowAccessPolicyRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
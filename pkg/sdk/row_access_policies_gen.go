@@ -12,6 +12,8 @@ type RowAccessPolicies interface {
 	Show(ctx context.Context, request *ShowRowAccessPolicyRequest) ([]RowAccessPolicy, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*RowAccessPolicy, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*RowAccessPolicyDescription, error)
+	// generator:protected-begin RowAccessPolicies methods
+	// generator:protected-end
 }
 
 // CreateRowAccessPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-row-access-policy.
@@ -86,10 +88,6 @@ type RowAccessPolicy struct {
 	OwnerRoleType string
 }
 
-func (v *RowAccessPolicy) ID() SchemaObjectIdentifier {
-	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
-}
-
 // DescribeRowAccessPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-row-access-policy.
 type DescribeRowAccessPolicyOptions struct {
 	describe        bool                   `ddl:"static" sql:"DESCRIBE"`
@@ -110,3 +108,10 @@ type RowAccessPolicyDescription struct {
 	ReturnType string
 	Body       string
 }
+
+// generator:protected-begin declarations
+func (v *RowAccessPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// generator:protected-end
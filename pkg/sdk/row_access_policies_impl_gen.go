@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ RowAccessPolicies = (*rowAccessPolicies)(nil)
 
@@ -38,12 +34,14 @@ func (v *rowAccessPolicies) Show(ctx context.Context, request *ShowRowAccessPoli
 }
 
 func (v *rowAccessPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*RowAccessPolicy, error) {
-	request := NewShowRowAccessPolicyRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin rowAccessPolicies.ShowByID
+	request := NewShowRowAccessPolicyRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	rowAccessPolicies, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(rowAccessPolicies, func(r RowAccessPolicy) bool { return r.Name == id.Name() })
+	return findOne(rowAccessPolicies, func(r RowAccessPolicy) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *rowAccessPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*RowAccessPolicyDescription, error) {
@@ -62,14 +60,16 @@ func (r *CreateRowAccessPolicyRequest) toOpts() *CreateRowAccessPolicyOptions {
 		OrReplace:   r.OrReplace,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		body:    r.body,
-		Comment: r.Comment,
+		body:        r.body,
+		Comment:     r.Comment,
 	}
 	if r.args != nil {
 		s := make([]CreateRowAccessPolicyArgs, len(r.args))
 		for i, v := range r.args {
-			s[i] = CreateRowAccessPolicyArgs(v)
+			s[i] = CreateRowAccessPolicyArgs{
+				Name: v.Name,
+				Type: v.Type,
+			}
 		}
 		opts.args = s
 	}
@@ -106,6 +106,7 @@ func (r *ShowRowAccessPolicyRequest) toOpts() *ShowRowAccessPolicyOptions {
 }
 
 func (r rowAccessPolicyDBRow) convert() *RowAccessPolicy {
+	// generator:protected-begin rowAccessPolicyDBRow.convert
 	rowAccessPolicy := &RowAccessPolicy{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -120,6 +121,7 @@ func (r rowAccessPolicyDBRow) convert() *RowAccessPolicy {
 		rowAccessPolicy.Comment = r.Comment.String
 	}
 	return rowAccessPolicy
+	// generator:protected-end
 }
 
 func (r *DescribeRowAccessPolicyRequest) toOpts() *DescribeRowAccessPolicyOptions {
@@ -130,6 +132,7 @@ func (r *DescribeRowAccessPolicyRequest) toOpts() *DescribeRowAccessPolicyOption
 }
 
 func (r describeRowAccessPolicyDBRow) convert() *RowAccessPolicyDescription {
+	// generator:protected-begin describeRowAccessPolicyDBRow.convert
 	rowAccessPolicyDescription := &RowAccessPolicyDescription{
 		Name:       r.Name,
 		Signature:  r.Signature,
@@ -137,4 +140,8 @@ func (r describeRowAccessPolicyDBRow) convert() *RowAccessPolicyDescription {
 		Body:       r.Body,
 	}
 	return rowAccessPolicyDescription
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
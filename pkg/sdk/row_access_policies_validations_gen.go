@@ -25,6 +25,8 @@ func (opts *CreateRowAccessPolicyOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateRowAccessPolicyOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateRowAccessPolicyOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -39,6 +41,8 @@ func (opts *AlterRowAccessPolicyOptions) validate() error {
 	if !exactlyOneValueSet(opts.RenameTo, opts.SetBody, opts.SetTags, opts.UnsetTags, opts.SetComment, opts.UnsetComment) {
 		errs = append(errs, errExactlyOneOf("AlterRowAccessPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
 	}
+	// generator:protected-begin AlterRowAccessPolicyOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -50,6 +54,8 @@ func (opts *DropRowAccessPolicyOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropRowAccessPolicyOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -58,6 +64,8 @@ func (opts *ShowRowAccessPolicyOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowRowAccessPolicyOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -69,5 +77,10 @@ func (opts *DescribeRowAccessPolicyOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeRowAccessPolicyOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -139,6 +139,7 @@ func (v *schemas) Create(ctx context.Context, id DatabaseObjectIdentifier, opts
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeSchema, id)
 	return err
 }
 
@@ -224,6 +225,7 @@ func (v *schemas) Alter(ctx context.Context, id DatabaseObjectIdentifier, opts *
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeSchema, id, opts.NewName, opts.SwapWith)
 	return err
 }
 
@@ -265,6 +267,7 @@ func (v *schemas) Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *D
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeSchema, id)
 	return err
 }
 
@@ -297,6 +300,7 @@ func (v *schemas) Undrop(ctx context.Context, id DatabaseObjectIdentifier) error
 		return err
 	}
 	_, err = v.client.exec(ctx, sql)
+	v.client.showCache.invalidate(ObjectTypeSchema, id)
 	return err
 }
 
@@ -388,24 +392,20 @@ func (v *schemas) Show(ctx context.Context, opts *ShowSchemaOptions) ([]Schema,
 }
 
 func (v *schemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
-	schemas, err := v.client.Schemas.Show(ctx, &ShowSchemaOptions{
-		In: &SchemaIn{
-			Database: Bool(true),
-			Name:     NewAccountObjectIdentifier(id.DatabaseName()),
-		},
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+	in := &SchemaIn{
+		Database: Bool(true),
+		Name:     NewAccountObjectIdentifier(id.DatabaseName()),
+	}
+	return showByIDWithCache(v.client, ObjectTypeSchema, id, func(s Schema) bool { return s.ID() == id }, func() ([]Schema, error) {
+		return v.client.Schemas.Show(ctx, &ShowSchemaOptions{
+			In: in,
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+		})
+	}, func() ([]Schema, error) {
+		return v.client.Schemas.Show(ctx, &ShowSchemaOptions{In: in})
 	})
-	if err != nil {
-		return nil, err
-	}
-	for _, s := range schemas {
-		if s.ID() == id {
-			return &s, nil
-		}
-	}
-	return nil, ErrObjectNotExistOrAuthorized
 }
 
 func (v *schemas) Use(ctx context.Context, id DatabaseObjectIdentifier) error {
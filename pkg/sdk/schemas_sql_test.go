@@ -0,0 +1,75 @@
+package sdk
+
+import "testing"
+
+func TestSchemas_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateSchemaOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateSchemaOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			// clone is rendered separately by Schemas.Create
+			clearSQLFixtureField(opts, "Clone")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		for _, action := range []string{"NewName", "SwapWith", "Set", "Unset", "SetTag", "UnsetTag", "EnableManagedAccess", "DisableManagedAccess"} {
+			t.Run(action, func(t *testing.T) {
+				opts := &AlterSchemaOptions{
+					name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, action)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropSchemaOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropSchemaOptions{
+				name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "Restrict")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Undrop", func(t *testing.T) {
+		opts := &undropSchemaOptions{
+			name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowSchemaOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowSchemaOptions{}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "In.Account")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		opts := &describeSchemaOptions{
+			name: sqlFixtureIdentifier[DatabaseObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+}
@@ -0,0 +1,197 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var secretTypeEnum = g.NewEnum("SecretType").
+	WithValue("SecretTypeOAuth2", "OAUTH2").
+	WithValue("SecretTypePassword", "PASSWORD").
+	WithValue("SecretTypeGenericString", "GENERIC_STRING").
+	WithValue("SecretTypeCloudProviderToken", "CLOUD_PROVIDER_TOKEN")
+
+var secretScope = g.NewQueryStruct("SecretScope").
+	Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+
+var secretDbRow = g.DbStruct("secretDBRow").
+	Time("created_on").
+	Text("name").
+	Text("schema_name").
+	Text("database_name").
+	Text("owner").
+	OptionalText("comment").
+	Text("secret_type").
+	OptionalText("oauth_scopes").
+	Text("owner_role_type")
+
+var secret = g.PlainStruct("Secret").
+	Time("CreatedOn").
+	Text("Name").
+	Text("SchemaName").
+	Text("DatabaseName").
+	Text("Owner").
+	OptionalText("Comment").
+	Text("SecretType").
+	Field("OauthScopes", "[]string").
+	Text("OwnerRoleType")
+
+// Secret values (passwords, secret strings and refresh tokens) are never returned by Snowflake,
+// so the details below contain only the non-sensitive properties of a secret.
+var secretDetailsDbRow = g.DbStruct("secretDetailsDBRow").
+	Time("created_on").
+	Text("name").
+	Text("schema_name").
+	Text("database_name").
+	Text("owner").
+	OptionalText("comment").
+	Text("secret_type").
+	OptionalText("username").
+	OptionalText("oauth_access_token_expiry_time").
+	OptionalText("oauth_refresh_token_expiry_time").
+	OptionalText("oauth_scopes").
+	OptionalText("integration_name")
+
+var secretDetails = g.PlainStruct("SecretDetails").
+	Time("CreatedOn").
+	Text("Name").
+	Text("SchemaName").
+	Text("DatabaseName").
+	Text("Owner").
+	OptionalText("Comment").
+	Text("SecretType").
+	OptionalText("Username").
+	OptionalText("OauthAccessTokenExpiryTime").
+	OptionalText("OauthRefreshTokenExpiryTime").
+	Field("OauthScopes", "[]string").
+	OptionalText("IntegrationName")
+
+func createSecretOperation(structName string, secretType string, apply func(qs *g.QueryStruct) *g.QueryStruct) *g.QueryStruct {
+	qs := g.NewQueryStruct(structName).
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name().
+		PredefinedQueryStructField("secretType", "string", g.StaticOptions().SQL(secretType))
+	qs = apply(qs)
+	return qs.
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists")
+}
+
+var SecretsDef = g.NewInterface(
+	"Secrets",
+	"Secret",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	WithEnums(secretTypeEnum).
+	CustomOperation(
+		"CreateWithOAuthClientCredentialsFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithOAuthClientCredentialsFlow", "TYPE = OAUTH2", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				Identifier("SecurityIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("API_AUTHENTICATION =").Required()).
+				ListAssignment("OAUTH_SCOPES", "SecretScope", g.ParameterOptions().Parentheses()).
+				WithValidation(g.ValidIdentifier, "SecurityIntegration")
+		}),
+		secretScope,
+	).
+	CustomOperation(
+		"CreateWithOAuthAuthorizationCodeFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithOAuthAuthorizationCodeFlow", "TYPE = OAUTH2", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				TextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes().Required()).
+				Identifier("SecurityIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("API_AUTHENTICATION =").Required()).
+				WithValidation(g.ValidIdentifier, "SecurityIntegration")
+		}),
+	).
+	CustomOperation(
+		"CreateWithBasicAuthentication",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithBasicAuthentication", "TYPE = PASSWORD", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				TextAssignment("USERNAME", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes().Required())
+		}),
+	).
+	CustomOperation(
+		"CreateWithGenericString",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithGenericString", "TYPE = GENERIC_STRING", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				TextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes().Required())
+		}),
+	).
+	CustomOperation(
+		"CreateWithCloudProviderToken",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithCloudProviderToken", "TYPE = CLOUD_PROVIDER_TOKEN", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				Identifier("SecurityIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("API_AUTHENTICATION =").Required()).
+				OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+				WithValidation(g.ValidIdentifier, "SecurityIntegration")
+		}),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-secret",
+		g.NewQueryStruct("AlterSecret").
+			Alter().
+			SQL("SECRET").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("SecretSet").
+					ListAssignment("OAUTH_SCOPES", "SecretScope", g.ParameterOptions().Parentheses()).
+					OptionalTextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes()).
+					OptionalTextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes()).
+					OptionalTextAssignment("USERNAME", g.ParameterOptions().SingleQuotes()).
+					OptionalTextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes()).
+					OptionalTextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "OauthScopes", "OauthRefreshToken", "OauthRefreshTokenExpiryTime", "Username", "Password", "SecretString", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("SecretUnset").
+					OptionalSQL("COMMENT"),
+				g.KeywordOptions().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-secret",
+		g.NewQueryStruct("DropSecret").
+			Drop().
+			SQL("SECRET").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-secrets",
+		secretDbRow,
+		secret,
+		g.NewQueryStruct("ShowSecrets").
+			Show().
+			SQL("SECRETS").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-secret",
+		secretDetailsDbRow,
+		secretDetails,
+		g.NewQueryStruct("DescribeSecret").
+			Describe().
+			SQL("SECRET").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,256 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+var _ Secrets = (*secrets)(nil)
+
+type secrets struct {
+	client *Client
+}
+
+func (v *secrets) CreateWithOAuthClientCredentialsFlow(ctx context.Context, request *CreateWithOAuthClientCredentialsFlowSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithOAuthAuthorizationCodeFlow(ctx context.Context, request *CreateWithOAuthAuthorizationCodeFlowSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithBasicAuthentication(ctx context.Context, request *CreateWithBasicAuthenticationSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithGenericString(ctx context.Context, request *CreateWithGenericStringSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithCloudProviderToken(ctx context.Context, request *CreateWithCloudProviderTokenSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Alter(ctx context.Context, request *AlterSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Drop(ctx context.Context, request *DropSecretRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Show(ctx context.Context, request *ShowSecretRequest) ([]Secret, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[secretDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[secretDBRow, Secret](dbRows)
+	return resultList, nil
+}
+
+func (v *secrets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error) {
+	// generator:protected-begin secrets.ShowByID
+	request := NewShowSecretRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
+	secrets, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return findOne(secrets, func(r Secret) bool { return r.Name == id.Name() })
+	// generator:protected-end
+}
+
+func (v *secrets) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error) {
+	opts := &DescribeSecretOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[secretDetailsDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateWithOAuthClientCredentialsFlowSecretRequest) toOpts() *CreateWithOAuthClientCredentialsFlowSecretOptions {
+	opts := &CreateWithOAuthClientCredentialsFlowSecretOptions{
+		OrReplace:           r.OrReplace,
+		IfNotExists:         r.IfNotExists,
+		name:                r.name,
+		SecurityIntegration: r.SecurityIntegration,
+		OauthScopes:         r.OauthScopes,
+		Comment:             r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithOAuthAuthorizationCodeFlowSecretRequest) toOpts() *CreateWithOAuthAuthorizationCodeFlowSecretOptions {
+	opts := &CreateWithOAuthAuthorizationCodeFlowSecretOptions{
+		OrReplace:                   r.OrReplace,
+		IfNotExists:                 r.IfNotExists,
+		name:                        r.name,
+		OauthRefreshToken:           r.OauthRefreshToken,
+		OauthRefreshTokenExpiryTime: r.OauthRefreshTokenExpiryTime,
+		SecurityIntegration:         r.SecurityIntegration,
+		Comment:                     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithBasicAuthenticationSecretRequest) toOpts() *CreateWithBasicAuthenticationSecretOptions {
+	opts := &CreateWithBasicAuthenticationSecretOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Username:    r.Username,
+		Password:    r.Password,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithGenericStringSecretRequest) toOpts() *CreateWithGenericStringSecretOptions {
+	opts := &CreateWithGenericStringSecretOptions{
+		OrReplace:    r.OrReplace,
+		IfNotExists:  r.IfNotExists,
+		name:         r.name,
+		SecretString: r.SecretString,
+		Comment:      r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithCloudProviderTokenSecretRequest) toOpts() *CreateWithCloudProviderTokenSecretOptions {
+	opts := &CreateWithCloudProviderTokenSecretOptions{
+		OrReplace:           r.OrReplace,
+		IfNotExists:         r.IfNotExists,
+		name:                r.name,
+		SecurityIntegration: r.SecurityIntegration,
+		Enabled:             r.Enabled,
+		Comment:             r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterSecretRequest) toOpts() *AlterSecretOptions {
+	opts := &AlterSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &SecretSet{
+			OauthScopes:                 r.Set.OauthScopes,
+			OauthRefreshToken:           r.Set.OauthRefreshToken,
+			OauthRefreshTokenExpiryTime: r.Set.OauthRefreshTokenExpiryTime,
+			Username:                    r.Set.Username,
+			Password:                    r.Set.Password,
+			SecretString:                r.Set.SecretString,
+			Comment:                     r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &SecretUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropSecretRequest) toOpts() *DropSecretOptions {
+	opts := &DropSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowSecretRequest) toOpts() *ShowSecretOptions {
+	opts := &ShowSecretOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r secretDBRow) convert() *Secret {
+	// generator:protected-begin secretDBRow.convert
+	s := &Secret{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		SchemaName:    r.SchemaName,
+		DatabaseName:  r.DatabaseName,
+		Owner:         r.Owner,
+		SecretType:    r.SecretType,
+		OauthScopes:   parseSecretOauthScopes(r.OauthScopes),
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		s.Comment = String(r.Comment.String)
+	}
+	return s
+	// generator:protected-end
+}
+
+func (r *DescribeSecretRequest) toOpts() *DescribeSecretOptions {
+	opts := &DescribeSecretOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r secretDetailsDBRow) convert() *SecretDetails {
+	// generator:protected-begin secretDetailsDBRow.convert
+	s := &SecretDetails{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		SchemaName:   r.SchemaName,
+		DatabaseName: r.DatabaseName,
+		Owner:        r.Owner,
+		SecretType:   r.SecretType,
+		OauthScopes:  parseSecretOauthScopes(r.OauthScopes),
+	}
+	if r.Comment.Valid {
+		s.Comment = String(r.Comment.String)
+	}
+	if r.Username.Valid {
+		s.Username = String(r.Username.String)
+	}
+	if r.OauthAccessTokenExpiryTime.Valid {
+		s.OauthAccessTokenExpiryTime = String(r.OauthAccessTokenExpiryTime.String)
+	}
+	if r.OauthRefreshTokenExpiryTime.Valid {
+		s.OauthRefreshTokenExpiryTime = String(r.OauthRefreshTokenExpiryTime.String)
+	}
+	if r.IntegrationName.Valid {
+		s.IntegrationName = String(r.IntegrationName.String)
+	}
+	return s
+	// generator:protected-end
+}
+
+// generator:protected-begin declarations
+// parseSecretOauthScopes parses the oauth_scopes column, which is returned in the [scope1, scope2] format.
+func parseSecretOauthScopes(scopes sql.NullString) []string {
+	if !scopes.Valid {
+		return nil
+	}
+	trimmed := strings.TrimSpace(strings.Trim(scopes.String, "[]"))
+	if trimmed == "" {
+		return nil
+	}
+	parts := strings.Split(trimmed, ",")
+	result := make([]string, len(parts))
+	for i, part := range parts {
+		result[i] = strings.Trim(strings.TrimSpace(part), `'"`)
+	}
+	return result
+}
+
+// generator:protected-end
@@ -0,0 +1,162 @@
+package sdk
+
+var (
+	_ validatable = new(CreateWithOAuthClientCredentialsFlowSecretOptions)
+	_ validatable = new(CreateWithOAuthAuthorizationCodeFlowSecretOptions)
+	_ validatable = new(CreateWithBasicAuthenticationSecretOptions)
+	_ validatable = new(CreateWithGenericStringSecretOptions)
+	_ validatable = new(CreateWithCloudProviderTokenSecretOptions)
+	_ validatable = new(AlterSecretOptions)
+	_ validatable = new(DropSecretOptions)
+	_ validatable = new(ShowSecretOptions)
+	_ validatable = new(DescribeSecretOptions)
+)
+
+func (opts *CreateWithOAuthClientCredentialsFlowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.SecurityIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithOAuthClientCredentialsFlowSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// generator:protected-begin CreateWithOAuthClientCredentialsFlowSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithOAuthAuthorizationCodeFlowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.SecurityIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithOAuthAuthorizationCodeFlowSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// generator:protected-begin CreateWithOAuthAuthorizationCodeFlowSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithBasicAuthenticationSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithBasicAuthenticationSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// generator:protected-begin CreateWithBasicAuthenticationSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithGenericStringSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithGenericStringSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// generator:protected-begin CreateWithGenericStringSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithCloudProviderTokenSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.SecurityIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithCloudProviderTokenSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// generator:protected-begin CreateWithCloudProviderTokenSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterSecretOptions", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.OauthScopes, opts.Set.OauthRefreshToken, opts.Set.OauthRefreshTokenExpiryTime, opts.Set.Username, opts.Set.Password, opts.Set.SecretString, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterSecretOptions.Set", "OauthScopes", "OauthRefreshToken", "OauthRefreshTokenExpiryTime", "Username", "Password", "SecretString", "Comment"))
+		}
+	}
+	// generator:protected-begin AlterSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *DropSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin DropSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// generator:protected-begin ShowSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// generator:protected-begin DescribeSecretOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
+}
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,576 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum = g.NewEnum("ApiAuthenticationSecurityIntegrationOauthClientAuthMethodOption").
+	WithValue("ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost", "CLIENT_SECRET_POST")
+
+var externalOauthSecurityIntegrationTypeOptionEnum = g.NewEnum("ExternalOauthSecurityIntegrationTypeOption").
+	WithValue("ExternalOauthSecurityIntegrationTypeOkta", "OKTA").
+	WithValue("ExternalOauthSecurityIntegrationTypeAzure", "AZURE").
+	WithValue("ExternalOauthSecurityIntegrationTypePingFederate", "PING_FEDERATE").
+	WithValue("ExternalOauthSecurityIntegrationTypeCustom", "CUSTOM")
+
+var externalOauthSecurityIntegrationSnowflakeUserMappingAttributeOptionEnum = g.NewEnum("ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeOption").
+	WithValue("ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeLoginName", "LOGIN_NAME").
+	WithValue("ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeEmailAddress", "EMAIL_ADDRESS")
+
+var externalOauthSecurityIntegrationAnyRoleModeOptionEnum = g.NewEnum("ExternalOauthSecurityIntegrationAnyRoleModeOption").
+	WithValue("ExternalOauthSecurityIntegrationAnyRoleModeDisable", "DISABLE").
+	WithValue("ExternalOauthSecurityIntegrationAnyRoleModeEnable", "ENABLE").
+	WithValue("ExternalOauthSecurityIntegrationAnyRoleModeEnableForPrivilege", "ENABLE_FOR_PRIVILEGE")
+
+var oauthSecurityIntegrationClientOptionEnum = g.NewEnum("OauthSecurityIntegrationClientOption").
+	WithValue("OauthSecurityIntegrationClientTableauDesktop", "TABLEAU_DESKTOP").
+	WithValue("OauthSecurityIntegrationClientTableauServer", "TABLEAU_SERVER").
+	WithValue("OauthSecurityIntegrationClientLooker", "LOOKER")
+
+var oauthSecurityIntegrationClientTypeOptionEnum = g.NewEnum("OauthSecurityIntegrationClientTypeOption").
+	WithValue("OauthSecurityIntegrationClientTypeConfidential", "CONFIDENTIAL").
+	WithValue("OauthSecurityIntegrationClientTypePublic", "PUBLIC")
+
+var oauthSecurityIntegrationUseSecondaryRolesOptionEnum = g.NewEnum("OauthSecurityIntegrationUseSecondaryRolesOption").
+	WithValue("OauthSecurityIntegrationUseSecondaryRolesImplicit", "IMPLICIT").
+	WithValue("OauthSecurityIntegrationUseSecondaryRolesNone", "NONE")
+
+var saml2SecurityIntegrationSaml2ProviderOptionEnum = g.NewEnum("Saml2SecurityIntegrationSaml2ProviderOption").
+	WithValue("Saml2SecurityIntegrationSaml2ProviderOkta", "OKTA").
+	WithValue("Saml2SecurityIntegrationSaml2ProviderAdfs", "ADFS").
+	WithValue("Saml2SecurityIntegrationSaml2ProviderCustom", "CUSTOM")
+
+var scimSecurityIntegrationScimClientOptionEnum = g.NewEnum("ScimSecurityIntegrationScimClientOption").
+	WithValue("ScimSecurityIntegrationScimClientOkta", "OKTA").
+	WithValue("ScimSecurityIntegrationScimClientAzure", "AZURE").
+	WithValue("ScimSecurityIntegrationScimClientGeneric", "GENERIC")
+
+var scimSecurityIntegrationRunAsRoleOptionEnum = g.NewEnum("ScimSecurityIntegrationRunAsRoleOption").
+	WithValue("ScimSecurityIntegrationRunAsRoleOktaProvisioner", "OKTA_PROVISIONER").
+	WithValue("ScimSecurityIntegrationRunAsRoleAadProvisioner", "AAD_PROVISIONER").
+	WithValue("ScimSecurityIntegrationRunAsRoleGenericScimProvisioner", "GENERIC_SCIM_PROVISIONER")
+
+var (
+	userDomainDef            = g.NewQueryStruct("UserDomain").Text("Domain", g.KeywordOptions().SingleQuotes().Required())
+	emailPatternDef          = g.NewQueryStruct("EmailPattern").Text("Pattern", g.KeywordOptions().SingleQuotes().Required())
+	allowedScopeDef          = g.NewQueryStruct("AllowedScope").Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+	tokenUserMappingClaimDef = g.NewQueryStruct("TokenUserMappingClaim").Text("Claim", g.KeywordOptions().SingleQuotes().Required())
+	jwsKeysUrlDef            = g.NewQueryStruct("JwsKeysUrl").Text("JwsKeyUrl", g.KeywordOptions().SingleQuotes().Required())
+	audienceListItemDef      = g.NewQueryStruct("AudienceListItem").Text("Item", g.KeywordOptions().SingleQuotes().Required())
+
+	// Role lists are wrapped in structs, so that they can be explicitly set to an empty list (e.g. BLOCKED_ROLES_LIST = ()).
+	blockedRolesListDef       = g.NewQueryStruct("BlockedRolesList").List("BlockedRolesList", "AccountObjectIdentifier", g.ListOptions().Required().MustParentheses())
+	allowedRolesListDef       = g.NewQueryStruct("AllowedRolesList").List("AllowedRolesList", "AccountObjectIdentifier", g.ListOptions().Required().MustParentheses())
+	preAuthorizedRolesListDef = g.NewQueryStruct("PreAuthorizedRolesList").List("PreAuthorizedRolesList", "AccountObjectIdentifier", g.ListOptions().Required().MustParentheses())
+)
+
+func createSecurityIntegrationOperation(structName string, apply func(qs *g.QueryStruct) *g.QueryStruct) *g.QueryStruct {
+	qs := g.NewQueryStruct(structName).
+		Create().
+		OrReplace().
+		SQL("SECURITY INTEGRATION").
+		IfNotExists().
+		Name()
+	qs = apply(qs)
+	return qs.
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists")
+}
+
+func alterSecurityIntegrationOperation(structName string, apply func(qs *g.QueryStruct) *g.QueryStruct) *g.QueryStruct {
+	qs := g.NewQueryStruct(structName).
+		Alter().
+		SQL("SECURITY INTEGRATION").
+		IfExists().
+		Name().
+		OptionalSetTags().
+		OptionalUnsetTags()
+	qs = apply(qs)
+	return qs.
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "IfExists", "SetTags").
+		WithValidation(g.ConflictingFields, "IfExists", "UnsetTags")
+}
+
+var apiAuthClientCredentialsFlowIntegrationSetDef = g.NewQueryStruct("ApiAuthenticationWithClientCredentialsFlowIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes()).
+	OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+	ListAssignment("OAUTH_ALLOWED_SCOPES", "AllowedScope", g.ParameterOptions().Parentheses()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthTokenEndpoint", "OauthClientAuthMethod", "OauthClientId", "OauthClientSecret", "OauthAccessTokenValidity",
+		"OauthRefreshTokenValidity", "OauthAllowedScopes", "Comment")
+
+var apiAuthCodeGrantFlowIntegrationSetDef = g.NewQueryStruct("ApiAuthenticationWithAuthorizationCodeGrantFlowIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_AUTHORIZATION_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes()).
+	OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthAuthorizationEndpoint", "OauthTokenEndpoint", "OauthClientAuthMethod", "OauthClientId", "OauthClientSecret",
+		"OauthAccessTokenValidity", "OauthRefreshTokenValidity", "Comment")
+
+var apiAuthJwtBearerFlowIntegrationSetDef = g.NewQueryStruct("ApiAuthenticationWithJwtBearerFlowIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_AUTHORIZATION_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes()).
+	OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthAuthorizationEndpoint", "OauthTokenEndpoint", "OauthClientAuthMethod", "OauthClientId", "OauthClientSecret",
+		"OauthAccessTokenValidity", "OauthRefreshTokenValidity", "Comment")
+
+var apiAuthIntegrationUnsetDef = func(structName string) *g.QueryStruct {
+	return g.NewQueryStruct(structName).
+		OptionalSQL("ENABLED").
+		OptionalSQL("COMMENT").
+		WithValidation(g.AtLeastOneValueSet, "Enabled", "Comment")
+}
+
+var externalOauthIntegrationSetDef = g.NewQueryStruct("ExternalOauthIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalAssignment("EXTERNAL_OAUTH_TYPE", externalOauthSecurityIntegrationTypeOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("EXTERNAL_OAUTH_ISSUER", g.ParameterOptions().SingleQuotes()).
+	ListAssignment("EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM", "TokenUserMappingClaim", g.ParameterOptions().Parentheses()).
+	OptionalAssignment("EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE", externalOauthSecurityIntegrationSnowflakeUserMappingAttributeOptionEnum.Kind(), g.ParameterOptions().SingleQuotes()).
+	ListAssignment("EXTERNAL_OAUTH_JWS_KEYS_URL", "JwsKeysUrl", g.ParameterOptions().Parentheses()).
+	OptionalAssignment("EXTERNAL_OAUTH_BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions()).
+	OptionalAssignment("EXTERNAL_OAUTH_ALLOWED_ROLES_LIST", "AllowedRolesList", g.ParameterOptions()).
+	OptionalTextAssignment("EXTERNAL_OAUTH_RSA_PUBLIC_KEY", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2", g.ParameterOptions().SingleQuotes()).
+	ListAssignment("EXTERNAL_OAUTH_AUDIENCE_LIST", "AudienceListItem", g.ParameterOptions().Parentheses()).
+	OptionalAssignment("EXTERNAL_OAUTH_ANY_ROLE_MODE", externalOauthSecurityIntegrationAnyRoleModeOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("EXTERNAL_OAUTH_SCOPE_DELIMITER", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.ConflictingFields, "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList").
+	WithValidation(g.ConflictingFields, "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey").
+	WithValidation(g.ConflictingFields, "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey2").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "ExternalOauthType", "ExternalOauthIssuer", "ExternalOauthTokenUserMappingClaim", "ExternalOauthSnowflakeUserMappingAttribute",
+		"ExternalOauthJwsKeysUrl", "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList", "ExternalOauthRsaPublicKey", "ExternalOauthRsaPublicKey2",
+		"ExternalOauthAudienceList", "ExternalOauthAnyRoleMode", "ExternalOauthScopeDelimiter", "ExternalOauthScopeMappingAttribute", "Comment")
+
+var externalOauthIntegrationUnsetDef = g.NewQueryStruct("ExternalOauthIntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("EXTERNAL_OAUTH_AUDIENCE_LIST").
+	OptionalSQL("EXTERNAL_OAUTH_RSA_PUBLIC_KEY").
+	OptionalSQL("EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2").
+	OptionalSQL("EXTERNAL_OAUTH_SCOPE_DELIMITER").
+	OptionalSQL("EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "ExternalOauthAudienceList", "ExternalOauthRsaPublicKey", "ExternalOauthRsaPublicKey2",
+		"ExternalOauthScopeDelimiter", "ExternalOauthScopeMappingAttribute", "Comment")
+
+var oauthForPartnerApplicationsIntegrationSetDef = g.NewQueryStruct("OauthForPartnerApplicationsIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_REDIRECT_URI", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("OAUTH_ISSUE_REFRESH_TOKENS", g.ParameterOptions()).
+	OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalAssignment("OAUTH_USE_SECONDARY_ROLES", oauthSecurityIntegrationUseSecondaryRolesOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalAssignment("BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthRedirectUri", "OauthIssueRefreshTokens", "OauthRefreshTokenValidity", "OauthUseSecondaryRoles",
+		"BlockedRolesList", "Comment")
+
+var oauthForPartnerApplicationsIntegrationUnsetDef = g.NewQueryStruct("OauthForPartnerApplicationsIntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("OAUTH_USE_SECONDARY_ROLES").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthUseSecondaryRoles", "Comment")
+
+var oauthForCustomClientsIntegrationSetDef = g.NewQueryStruct("OauthForCustomClientsIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("OAUTH_REDIRECT_URI", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("OAUTH_ALLOW_NON_TLS_REDIRECT_URI", g.ParameterOptions()).
+	OptionalBooleanAssignment("OAUTH_ENFORCE_PKCE", g.ParameterOptions()).
+	OptionalAssignment("PRE_AUTHORIZED_ROLES_LIST", "PreAuthorizedRolesList", g.ParameterOptions()).
+	OptionalAssignment("BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions()).
+	OptionalBooleanAssignment("OAUTH_ISSUE_REFRESH_TOKENS", g.ParameterOptions()).
+	OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+	OptionalAssignment("OAUTH_USE_SECONDARY_ROLES", oauthSecurityIntegrationUseSecondaryRolesOptionEnum.Kind(), g.ParameterOptions()).
+	OptionalTextAssignment("NETWORK_POLICY", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_RSA_PUBLIC_KEY", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("OAUTH_CLIENT_RSA_PUBLIC_KEY_2", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "OauthRedirectUri", "OauthAllowNonTlsRedirectUri", "OauthEnforcePkce", "PreAuthorizedRolesList",
+		"BlockedRolesList", "OauthIssueRefreshTokens", "OauthRefreshTokenValidity", "OauthUseSecondaryRoles", "NetworkPolicy", "OauthClientRsaPublicKey",
+		"OauthClientRsaPublicKey2", "Comment")
+
+var oauthForCustomClientsIntegrationUnsetDef = g.NewQueryStruct("OauthForCustomClientsIntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("NETWORK_POLICY").
+	OptionalSQL("OAUTH_CLIENT_RSA_PUBLIC_KEY").
+	OptionalSQL("OAUTH_CLIENT_RSA_PUBLIC_KEY_2").
+	OptionalSQL("OAUTH_USE_SECONDARY_ROLES").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "NetworkPolicy", "OauthClientRsaPublicKey", "OauthClientRsaPublicKey2", "OauthUseSecondaryRoles", "Comment")
+
+var saml2IntegrationSetDef = g.NewQueryStruct("Saml2IntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("SAML2_ISSUER", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SAML2_SSO_URL", g.ParameterOptions().SingleQuotes()).
+	OptionalAssignment("SAML2_PROVIDER", saml2SecurityIntegrationSaml2ProviderOptionEnum.Kind(), g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SAML2_X509_CERT", g.ParameterOptions().SingleQuotes()).
+	ListAssignment("ALLOWED_USER_DOMAINS", "UserDomain", g.ParameterOptions().Parentheses()).
+	ListAssignment("ALLOWED_EMAIL_PATTERNS", "EmailPattern", g.ParameterOptions().Parentheses()).
+	OptionalTextAssignment("SAML2_SP_INITIATED_LOGIN_PAGE_LABEL", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("SAML2_ENABLE_SP_INITIATED", g.ParameterOptions()).
+	OptionalTextAssignment("SAML2_SNOWFLAKE_X509_CERT", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("SAML2_SIGN_REQUEST", g.ParameterOptions()).
+	OptionalTextAssignment("SAML2_REQUESTED_NAMEID_FORMAT", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SAML2_POST_LOGOUT_REDIRECT_URL", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("SAML2_FORCE_AUTHN", g.ParameterOptions()).
+	OptionalTextAssignment("SAML2_SNOWFLAKE_ISSUER_URL", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("SAML2_SNOWFLAKE_ACS_URL", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "Saml2Issuer", "Saml2SsoUrl", "Saml2Provider", "Saml2X509Cert", "AllowedUserDomains", "AllowedEmailPatterns",
+		"Saml2SpInitiatedLoginPageLabel", "Saml2EnableSpInitiated", "Saml2SnowflakeX509Cert", "Saml2SignRequest", "Saml2RequestedNameidFormat",
+		"Saml2PostLogoutRedirectUrl", "Saml2ForceAuthn", "Saml2SnowflakeIssuerUrl", "Saml2SnowflakeAcsUrl", "Comment")
+
+var saml2IntegrationUnsetDef = g.NewQueryStruct("Saml2IntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("SAML2_FORCE_AUTHN").
+	OptionalSQL("SAML2_REQUESTED_NAMEID_FORMAT").
+	OptionalSQL("SAML2_POST_LOGOUT_REDIRECT_URL").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "Saml2ForceAuthn", "Saml2RequestedNameidFormat", "Saml2PostLogoutRedirectUrl", "Comment")
+
+var scimIntegrationSetDef = g.NewQueryStruct("ScimIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("NETWORK_POLICY", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("SYNC_PASSWORD", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "NetworkPolicy", "SyncPassword", "Comment")
+
+var scimIntegrationUnsetDef = g.NewQueryStruct("ScimIntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("NETWORK_POLICY").
+	OptionalSQL("SYNC_PASSWORD").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "NetworkPolicy", "SyncPassword", "Comment")
+
+// TODO [SNOW-1016561]: all integrations reuse almost the same show, drop, and describe. For now we are copying it. Consider reusing in linked issue.
+var SecurityIntegrationsDef = g.NewInterface(
+	"SecurityIntegrations",
+	"SecurityIntegration",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	WithEnums(
+		apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum,
+		externalOauthSecurityIntegrationTypeOptionEnum,
+		externalOauthSecurityIntegrationSnowflakeUserMappingAttributeOptionEnum,
+		externalOauthSecurityIntegrationAnyRoleModeOptionEnum,
+		oauthSecurityIntegrationClientOptionEnum,
+		oauthSecurityIntegrationClientTypeOptionEnum,
+		oauthSecurityIntegrationUseSecondaryRolesOptionEnum,
+		saml2SecurityIntegrationSaml2ProviderOptionEnum,
+		scimSecurityIntegrationScimClientOptionEnum,
+		scimSecurityIntegrationRunAsRoleOptionEnum,
+	).
+	CustomOperation(
+		"CreateApiAuthenticationWithClientCredentialsFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth",
+		createSecurityIntegrationOperation("CreateApiAuthenticationWithClientCredentialsFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = API_AUTHENTICATION")).
+				PredefinedQueryStructField("authType", "string", g.StaticOptions().SQL("AUTH_TYPE = OAUTH2")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+				OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+				TextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()).
+				PredefinedQueryStructField("oauthGrant", "string", g.StaticOptions().SQL("OAUTH_GRANT = CLIENT_CREDENTIALS")).
+				OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+				ListAssignment("OAUTH_ALLOWED_SCOPES", "AllowedScope", g.ParameterOptions().Parentheses())
+		}),
+		allowedScopeDef,
+	).
+	CustomOperation(
+		"CreateApiAuthenticationWithAuthorizationCodeGrantFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth",
+		createSecurityIntegrationOperation("CreateApiAuthenticationWithAuthorizationCodeGrantFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = API_AUTHENTICATION")).
+				PredefinedQueryStructField("authType", "string", g.StaticOptions().SQL("AUTH_TYPE = OAUTH2")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				OptionalTextAssignment("OAUTH_AUTHORIZATION_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+				OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+				TextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()).
+				PredefinedQueryStructField("oauthGrant", "string", g.StaticOptions().SQL("OAUTH_GRANT = AUTHORIZATION_CODE")).
+				OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions())
+		}),
+	).
+	CustomOperation(
+		"CreateApiAuthenticationWithJwtBearerFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth",
+		createSecurityIntegrationOperation("CreateApiAuthenticationWithJwtBearerFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = API_AUTHENTICATION")).
+				PredefinedQueryStructField("authType", "string", g.StaticOptions().SQL("AUTH_TYPE = OAUTH2")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				TextAssignment("OAUTH_ASSERTION_ISSUER", g.ParameterOptions().SingleQuotes().Required()).
+				OptionalTextAssignment("OAUTH_AUTHORIZATION_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("OAUTH_TOKEN_ENDPOINT", g.ParameterOptions().SingleQuotes()).
+				OptionalAssignment("OAUTH_CLIENT_AUTH_METHOD", apiAuthenticationSecurityIntegrationOauthClientAuthMethodOptionEnum.Kind(), g.ParameterOptions()).
+				TextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()).
+				PredefinedQueryStructField("oauthGrant", "string", g.StaticOptions().SQL("OAUTH_GRANT = JWT_BEARER")).
+				OptionalNumberAssignment("OAUTH_ACCESS_TOKEN_VALIDITY", g.ParameterOptions()).
+				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions())
+		}),
+	).
+	CustomOperation(
+		"CreateExternalOauth",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-external",
+		createSecurityIntegrationOperation("CreateExternalOauth", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = EXTERNAL_OAUTH")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				Assignment("EXTERNAL_OAUTH_TYPE", externalOauthSecurityIntegrationTypeOptionEnum.Kind(), g.ParameterOptions().Required()).
+				TextAssignment("EXTERNAL_OAUTH_ISSUER", g.ParameterOptions().SingleQuotes().Required()).
+				ListAssignment("EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM", "TokenUserMappingClaim", g.ParameterOptions().Parentheses().Required()).
+				Assignment("EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE", externalOauthSecurityIntegrationSnowflakeUserMappingAttributeOptionEnum.Kind(), g.ParameterOptions().SingleQuotes().Required()).
+				ListAssignment("EXTERNAL_OAUTH_JWS_KEYS_URL", "JwsKeysUrl", g.ParameterOptions().Parentheses()).
+				OptionalAssignment("EXTERNAL_OAUTH_BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions()).
+				OptionalAssignment("EXTERNAL_OAUTH_ALLOWED_ROLES_LIST", "AllowedRolesList", g.ParameterOptions()).
+				OptionalTextAssignment("EXTERNAL_OAUTH_RSA_PUBLIC_KEY", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2", g.ParameterOptions().SingleQuotes()).
+				ListAssignment("EXTERNAL_OAUTH_AUDIENCE_LIST", "AudienceListItem", g.ParameterOptions().Parentheses()).
+				OptionalAssignment("EXTERNAL_OAUTH_ANY_ROLE_MODE", externalOauthSecurityIntegrationAnyRoleModeOptionEnum.Kind(), g.ParameterOptions()).
+				OptionalTextAssignment("EXTERNAL_OAUTH_SCOPE_DELIMITER", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE", g.ParameterOptions().SingleQuotes()).
+				WithValidation(g.ValidateValueSet, "ExternalOauthTokenUserMappingClaim").
+				WithValidation(g.ConflictingFields, "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList").
+				WithValidation(g.ExactlyOneValueSet, "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey").
+				WithValidation(g.ConflictingFields, "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey2")
+		}),
+		tokenUserMappingClaimDef,
+		jwsKeysUrlDef,
+		audienceListItemDef,
+		blockedRolesListDef,
+		allowedRolesListDef,
+	).
+	CustomOperation(
+		"CreateOauthForPartnerApplications",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake",
+		createSecurityIntegrationOperation("CreateOauthForPartnerApplications", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = OAUTH")).
+				Assignment("OAUTH_CLIENT", oauthSecurityIntegrationClientOptionEnum.Kind(), g.ParameterOptions().Required()).
+				OptionalTextAssignment("OAUTH_REDIRECT_URI", g.ParameterOptions().SingleQuotes()).
+				OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+				OptionalBooleanAssignment("OAUTH_ISSUE_REFRESH_TOKENS", g.ParameterOptions()).
+				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+				OptionalAssignment("OAUTH_USE_SECONDARY_ROLES", oauthSecurityIntegrationUseSecondaryRolesOptionEnum.Kind(), g.ParameterOptions()).
+				OptionalAssignment("BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions())
+		}),
+	).
+	CustomOperation(
+		"CreateOauthForCustomClients",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake",
+		createSecurityIntegrationOperation("CreateOauthForCustomClients", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = OAUTH")).
+				PredefinedQueryStructField("oauthClient", "string", g.StaticOptions().SQL("OAUTH_CLIENT = CUSTOM")).
+				Assignment("OAUTH_CLIENT_TYPE", oauthSecurityIntegrationClientTypeOptionEnum.Kind(), g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_REDIRECT_URI", g.ParameterOptions().SingleQuotes().Required()).
+				OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+				OptionalBooleanAssignment("OAUTH_ALLOW_NON_TLS_REDIRECT_URI", g.ParameterOptions()).
+				OptionalBooleanAssignment("OAUTH_ENFORCE_PKCE", g.ParameterOptions()).
+				OptionalAssignment("OAUTH_USE_SECONDARY_ROLES", oauthSecurityIntegrationUseSecondaryRolesOptionEnum.Kind(), g.ParameterOptions()).
+				OptionalAssignment("PRE_AUTHORIZED_ROLES_LIST", "PreAuthorizedRolesList", g.ParameterOptions()).
+				OptionalAssignment("BLOCKED_ROLES_LIST", "BlockedRolesList", g.ParameterOptions()).
+				OptionalBooleanAssignment("OAUTH_ISSUE_REFRESH_TOKENS", g.ParameterOptions()).
+				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions()).
+				OptionalTextAssignment("NETWORK_POLICY", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("OAUTH_CLIENT_RSA_PUBLIC_KEY", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("OAUTH_CLIENT_RSA_PUBLIC_KEY_2", g.ParameterOptions().SingleQuotes())
+		}),
+		preAuthorizedRolesListDef,
+	).
+	CustomOperation(
+		"CreateSaml2",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-saml2",
+		createSecurityIntegrationOperation("CreateSaml2", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = SAML2")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				TextAssignment("SAML2_ISSUER", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("SAML2_SSO_URL", g.ParameterOptions().SingleQuotes().Required()).
+				Assignment("SAML2_PROVIDER", saml2SecurityIntegrationSaml2ProviderOptionEnum.Kind(), g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("SAML2_X509_CERT", g.ParameterOptions().SingleQuotes().Required()).
+				ListAssignment("ALLOWED_USER_DOMAINS", "UserDomain", g.ParameterOptions().Parentheses()).
+				ListAssignment("ALLOWED_EMAIL_PATTERNS", "EmailPattern", g.ParameterOptions().Parentheses()).
+				OptionalTextAssignment("SAML2_SP_INITIATED_LOGIN_PAGE_LABEL", g.ParameterOptions().SingleQuotes()).
+				OptionalBooleanAssignment("SAML2_ENABLE_SP_INITIATED", g.ParameterOptions()).
+				OptionalTextAssignment("SAML2_SNOWFLAKE_X509_CERT", g.ParameterOptions().SingleQuotes()).
+				OptionalBooleanAssignment("SAML2_SIGN_REQUEST", g.ParameterOptions()).
+				OptionalTextAssignment("SAML2_REQUESTED_NAMEID_FORMAT", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("SAML2_POST_LOGOUT_REDIRECT_URL", g.ParameterOptions().SingleQuotes()).
+				OptionalBooleanAssignment("SAML2_FORCE_AUTHN", g.ParameterOptions()).
+				OptionalTextAssignment("SAML2_SNOWFLAKE_ISSUER_URL", g.ParameterOptions().SingleQuotes()).
+				OptionalTextAssignment("SAML2_SNOWFLAKE_ACS_URL", g.ParameterOptions().SingleQuotes())
+		}),
+		userDomainDef,
+		emailPatternDef,
+	).
+	CustomOperation(
+		"CreateScim",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-scim",
+		createSecurityIntegrationOperation("CreateScim", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = SCIM")).
+				OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+				Assignment("SCIM_CLIENT", scimSecurityIntegrationScimClientOptionEnum.Kind(), g.ParameterOptions().SingleQuotes().Required()).
+				Assignment("RUN_AS_ROLE", scimSecurityIntegrationRunAsRoleOptionEnum.Kind(), g.ParameterOptions().SingleQuotes().Required()).
+				OptionalTextAssignment("NETWORK_POLICY", g.ParameterOptions().SingleQuotes()).
+				OptionalBooleanAssignment("SYNC_PASSWORD", g.ParameterOptions())
+		}),
+	).
+	CustomOperation(
+		"AlterApiAuthenticationWithClientCredentialsFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-api-auth",
+		alterSecurityIntegrationOperation("AlterApiAuthenticationWithClientCredentialsFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", apiAuthClientCredentialsFlowIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", apiAuthIntegrationUnsetDef("ApiAuthenticationWithClientCredentialsFlowIntegrationUnset"), g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterApiAuthenticationWithAuthorizationCodeGrantFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-api-auth",
+		alterSecurityIntegrationOperation("AlterApiAuthenticationWithAuthorizationCodeGrantFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", apiAuthCodeGrantFlowIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", apiAuthIntegrationUnsetDef("ApiAuthenticationWithAuthorizationCodeGrantFlowIntegrationUnset"), g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterApiAuthenticationWithJwtBearerFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-api-auth",
+		alterSecurityIntegrationOperation("AlterApiAuthenticationWithJwtBearerFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", apiAuthJwtBearerFlowIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", apiAuthIntegrationUnsetDef("ApiAuthenticationWithJwtBearerFlowIntegrationUnset"), g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterExternalOauth",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-oauth-external",
+		alterSecurityIntegrationOperation("AlterExternalOauth", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", externalOauthIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", externalOauthIntegrationUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterOauthForPartnerApplications",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-oauth-snowflake",
+		alterSecurityIntegrationOperation("AlterOauthForPartnerApplications", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", oauthForPartnerApplicationsIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", oauthForPartnerApplicationsIntegrationUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterOauthForCustomClients",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-oauth-snowflake",
+		alterSecurityIntegrationOperation("AlterOauthForCustomClients", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", oauthForCustomClientsIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", oauthForCustomClientsIntegrationUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterSaml2",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-saml2",
+		alterSecurityIntegrationOperation("AlterSaml2", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", saml2IntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", saml2IntegrationUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+				OptionalSQL("REFRESH SAML2_SNOWFLAKE_PRIVATE_KEY").
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "RefreshSaml2SnowflakePrivateKey", "SetTags", "UnsetTags")
+		}),
+	).
+	CustomOperation(
+		"AlterScim",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-scim",
+		alterSecurityIntegrationOperation("AlterScim", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				OptionalQueryStructField("Set", scimIntegrationSetDef, g.KeywordOptions().SQL("SET")).
+				OptionalQueryStructField("Unset", scimIntegrationUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
+		g.NewQueryStruct("DropSecurityIntegration").
+			Drop().
+			SQL("SECURITY INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-integrations",
+		g.DbStruct("showSecurityIntegrationsDbRow").
+			Text("name").
+			Text("type").
+			Text("category").
+			Bool("enabled").
+			OptionalText("comment").
+			Time("created_on"),
+		g.PlainStruct("SecurityIntegration").
+			Text("Name").
+			Text("IntegrationType").
+			Text("Category").
+			Bool("Enabled").
+			Text("Comment").
+			Time("CreatedOn"),
+		g.NewQueryStruct("ShowSecurityIntegrations").
+			Show().
+			SQL("SECURITY INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering).
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
+		g.DbStruct("descSecurityIntegrationsDbRow").
+			Text("property").
+			Text("property_type").
+			Text("property_value").
+			Text("property_default"),
+		g.PlainStruct("SecurityIntegrationProperty").
+			Text("Name").
+			Text("Type").
+			Text("Value").
+			Text("Default"),
+		g.NewQueryStruct("DescribeSecurityIntegration").
+			Describe().
+			SQL("SECURITY INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
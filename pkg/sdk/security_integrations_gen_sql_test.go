@@ -0,0 +1,360 @@
+package sdk
+
+import "testing"
+
+func TestSecurityIntegrations_GoldenSQL(t *testing.T) {
+	t.Run("CreateApiAuthenticationWithClientCredentialsFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Enabled")
+			fillSQLFixtureField(opts, "OauthClientId")
+			fillSQLFixtureField(opts, "OauthClientSecret")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateApiAuthenticationWithAuthorizationCodeGrantFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Enabled")
+			fillSQLFixtureField(opts, "OauthClientId")
+			fillSQLFixtureField(opts, "OauthClientSecret")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateApiAuthenticationWithJwtBearerFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Enabled")
+			fillSQLFixtureField(opts, "OauthAssertionIssuer")
+			fillSQLFixtureField(opts, "OauthClientId")
+			fillSQLFixtureField(opts, "OauthClientSecret")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateExternalOauth", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateExternalOauthSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Enabled")
+			fillSQLFixtureField(opts, "ExternalOauthType")
+			fillSQLFixtureField(opts, "ExternalOauthIssuer")
+			fillSQLFixtureField(opts, "ExternalOauthTokenUserMappingClaim")
+			fillSQLFixtureField(opts, "ExternalOauthSnowflakeUserMappingAttribute")
+			fillSQLFixtureField(opts, "ExternalOauthJwsKeysUrl")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateExternalOauthSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			clearSQLFixtureField(opts, "ExternalOauthAllowedRolesList")
+			clearSQLFixtureField(opts, "ExternalOauthRsaPublicKey")
+			clearSQLFixtureField(opts, "ExternalOauthRsaPublicKey2")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateOauthForPartnerApplications", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOauthForPartnerApplicationsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "OauthClient")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOauthForPartnerApplicationsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateOauthForCustomClients", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOauthForCustomClientsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "OauthClientType")
+			fillSQLFixtureField(opts, "OauthRedirectUri")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOauthForCustomClientsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateSaml2", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateSaml2SecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Enabled")
+			fillSQLFixtureField(opts, "Saml2Issuer")
+			fillSQLFixtureField(opts, "Saml2SsoUrl")
+			fillSQLFixtureField(opts, "Saml2Provider")
+			fillSQLFixtureField(opts, "Saml2X509Cert")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateSaml2SecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateScim", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateScimSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ScimClient")
+			fillSQLFixtureField(opts, "RunAsRole")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateScimSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterApiAuthenticationWithClientCredentialsFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterApiAuthenticationWithAuthorizationCodeGrantFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterApiAuthenticationWithJwtBearerFlow", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterExternalOauth", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterExternalOauthSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterExternalOauthSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Set.ExternalOauthAllowedRolesList")
+			clearSQLFixtureField(opts, "Set.ExternalOauthRsaPublicKey")
+			clearSQLFixtureField(opts, "Set.ExternalOauthRsaPublicKey2")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterOauthForPartnerApplications", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterOauthForPartnerApplicationsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterOauthForPartnerApplicationsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterOauthForCustomClients", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterOauthForCustomClientsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterOauthForCustomClientsSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterSaml2", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterSaml2SecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterSaml2SecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			clearSQLFixtureField(opts, "RefreshSaml2SnowflakePrivateKey")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("AlterScim", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &AlterScimSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set.Enabled")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &AlterScimSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "SetTags")
+			clearSQLFixtureField(opts, "UnsetTags")
+			clearSQLFixtureField(opts, "Unset")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowSecurityIntegrationOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowSecurityIntegrationOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeSecurityIntegrationOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -0,0 +1,1152 @@
+package sdk
+
+import "testing"
+
+func TestSecurityIntegrations_CreateApiAuthenticationWithClientCredentialsFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions
+	defaultOpts := func() *CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions {
+		return &CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+			name:              id,
+			Enabled:           true,
+			OauthClientId:     "foo",
+			OauthClientSecret: "bar",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = CLIENT_CREDENTIALS", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OauthTokenEndpoint = String("http://example.com")
+		opts.OauthClientAuthMethod = Pointer(ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost)
+		opts.OauthAccessTokenValidity = Int(42)
+		opts.OauthRefreshTokenValidity = Int(43)
+		opts.OauthAllowedScopes = []AllowedScope{{Scope: "foo"}, {Scope: "bar"}}
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION IF NOT EXISTS %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_TOKEN_ENDPOINT = 'http://example.com'"+
+			" OAUTH_CLIENT_AUTH_METHOD = CLIENT_SECRET_POST OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = CLIENT_CREDENTIALS OAUTH_ACCESS_TOKEN_VALIDITY = 42"+
+			" OAUTH_REFRESH_TOKEN_VALIDITY = 43 OAUTH_ALLOWED_SCOPES = ('foo', 'bar') COMMENT = 'a'", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateApiAuthenticationWithAuthorizationCodeGrantFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions
+	defaultOpts := func() *CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions {
+		return &CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+			name:              id,
+			Enabled:           true,
+			OauthClientId:     "foo",
+			OauthClientSecret: "bar",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = AUTHORIZATION_CODE", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OauthAuthorizationEndpoint = String("http://example.com/authorize")
+		opts.OauthTokenEndpoint = String("http://example.com/token")
+		opts.OauthClientAuthMethod = Pointer(ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost)
+		opts.OauthAccessTokenValidity = Int(42)
+		opts.OauthRefreshTokenValidity = Int(43)
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_AUTHORIZATION_ENDPOINT = 'http://example.com/authorize'"+
+			" OAUTH_TOKEN_ENDPOINT = 'http://example.com/token' OAUTH_CLIENT_AUTH_METHOD = CLIENT_SECRET_POST OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = AUTHORIZATION_CODE"+
+			" OAUTH_ACCESS_TOKEN_VALIDITY = 42 OAUTH_REFRESH_TOKEN_VALIDITY = 43 COMMENT = 'a'", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateApiAuthenticationWithJwtBearerFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions
+	defaultOpts := func() *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions {
+		return &CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+			name:                 id,
+			Enabled:              true,
+			OauthAssertionIssuer: "issuer",
+			OauthClientId:        "foo",
+			OauthClientSecret:    "bar",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_ASSERTION_ISSUER = 'issuer' OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = JWT_BEARER", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OauthAuthorizationEndpoint = String("http://example.com/authorize")
+		opts.OauthTokenEndpoint = String("http://example.com/token")
+		opts.OauthClientAuthMethod = Pointer(ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost)
+		opts.OauthAccessTokenValidity = Int(42)
+		opts.OauthRefreshTokenValidity = Int(43)
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_ASSERTION_ISSUER = 'issuer'"+
+			" OAUTH_AUTHORIZATION_ENDPOINT = 'http://example.com/authorize' OAUTH_TOKEN_ENDPOINT = 'http://example.com/token' OAUTH_CLIENT_AUTH_METHOD = CLIENT_SECRET_POST"+
+			" OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_GRANT = JWT_BEARER OAUTH_ACCESS_TOKEN_VALIDITY = 42 OAUTH_REFRESH_TOKEN_VALIDITY = 43 COMMENT = 'a'", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateExternalOauth(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateExternalOauthSecurityIntegrationOptions
+	defaultOpts := func() *CreateExternalOauthSecurityIntegrationOptions {
+		return &CreateExternalOauthSecurityIntegrationOptions{
+			name:                               id,
+			Enabled:                            true,
+			ExternalOauthType:                  ExternalOauthSecurityIntegrationTypeCustom,
+			ExternalOauthIssuer:                "foo",
+			ExternalOauthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "foo"}},
+			ExternalOauthSnowflakeUserMappingAttribute: ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeLoginName,
+			ExternalOauthJwsKeysUrl:                    []JwsKeysUrl{{JwsKeyUrl: "http://example.com"}},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateExternalOauthSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalOauthSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: [opts.ExternalOauthTokenUserMappingClaim] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalOauthTokenUserMappingClaim = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateExternalOauthSecurityIntegrationOptions", "ExternalOauthTokenUserMappingClaim"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.ExternalOauthBlockedRolesList opts.ExternalOauthAllowedRolesList]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalOauthBlockedRolesList = &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{NewAccountObjectIdentifier("foo")}}
+		opts.ExternalOauthAllowedRolesList = &AllowedRolesList{AllowedRolesList: []AccountObjectIdentifier{NewAccountObjectIdentifier("bar")}}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalOauthSecurityIntegrationOptions", "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList"))
+	})
+
+	t.Run("validation: exactly one field from [opts.ExternalOauthJwsKeysUrl opts.ExternalOauthRsaPublicKey] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalOauthJwsKeysUrl = nil
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateExternalOauthSecurityIntegrationOptions", "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey"))
+	})
+
+	t.Run("validation: exactly one field from [opts.ExternalOauthJwsKeysUrl opts.ExternalOauthRsaPublicKey] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalOauthRsaPublicKey = String("foo")
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateExternalOauthSecurityIntegrationOptions", "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.ExternalOauthJwsKeysUrl opts.ExternalOauthRsaPublicKey2]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ExternalOauthRsaPublicKey2 = String("foo")
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalOauthSecurityIntegrationOptions", "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey2"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = CUSTOM EXTERNAL_OAUTH_ISSUER = 'foo'"+
+			" EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('foo') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'LOGIN_NAME' EXTERNAL_OAUTH_JWS_KEYS_URL = ('http://example.com')", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		roleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ExternalOauthJwsKeysUrl = nil
+		opts.ExternalOauthBlockedRolesList = &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{roleID}}
+		opts.ExternalOauthRsaPublicKey = String("key")
+		opts.ExternalOauthRsaPublicKey2 = String("key2")
+		opts.ExternalOauthAudienceList = []AudienceListItem{{Item: "foo"}}
+		opts.ExternalOauthAnyRoleMode = Pointer(ExternalOauthSecurityIntegrationAnyRoleModeDisable)
+		opts.ExternalOauthScopeDelimiter = String(",")
+		opts.ExternalOauthScopeMappingAttribute = String("foo")
+		opts.Comment = String("foo")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = CUSTOM EXTERNAL_OAUTH_ISSUER = 'foo'"+
+			" EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('foo') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'LOGIN_NAME' EXTERNAL_OAUTH_BLOCKED_ROLES_LIST = (%s)"+
+			" EXTERNAL_OAUTH_RSA_PUBLIC_KEY = 'key' EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2 = 'key2' EXTERNAL_OAUTH_AUDIENCE_LIST = ('foo') EXTERNAL_OAUTH_ANY_ROLE_MODE = DISABLE"+
+			" EXTERNAL_OAUTH_SCOPE_DELIMITER = ',' EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE = 'foo' COMMENT = 'foo'", id.FullyQualifiedName(), roleID.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateOauthForPartnerApplications(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateOauthForPartnerApplicationsSecurityIntegrationOptions
+	defaultOpts := func() *CreateOauthForPartnerApplicationsSecurityIntegrationOptions {
+		return &CreateOauthForPartnerApplicationsSecurityIntegrationOptions{
+			name:        id,
+			OauthClient: OauthSecurityIntegrationClientTableauDesktop,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOauthForPartnerApplicationsSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOauthForPartnerApplicationsSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: [opts.OauthRedirectUri] is required for LOOKER", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OauthClient = OauthSecurityIntegrationClientLooker
+		assertOptsInvalidJoinedErrors(t, opts, NewError("CreateOauthForPartnerApplicationsSecurityIntegrationOptions.OauthRedirectUri is required when CreateOauthForPartnerApplicationsSecurityIntegrationOptions.OauthClient is LOOKER"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = OAUTH OAUTH_CLIENT = TABLEAU_DESKTOP", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		roleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OauthClient = OauthSecurityIntegrationClientLooker
+		opts.OauthRedirectUri = String("uri")
+		opts.Enabled = Bool(true)
+		opts.OauthIssueRefreshTokens = Bool(true)
+		opts.OauthRefreshTokenValidity = Int(42)
+		opts.OauthUseSecondaryRoles = Pointer(OauthSecurityIntegrationUseSecondaryRolesNone)
+		opts.BlockedRolesList = &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{roleID}}
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = OAUTH OAUTH_CLIENT = LOOKER OAUTH_REDIRECT_URI = 'uri' ENABLED = true OAUTH_ISSUE_REFRESH_TOKENS = true"+
+			" OAUTH_REFRESH_TOKEN_VALIDITY = 42 OAUTH_USE_SECONDARY_ROLES = NONE BLOCKED_ROLES_LIST = (%s) COMMENT = 'a'", id.FullyQualifiedName(), roleID.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateOauthForCustomClients(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateOauthForCustomClientsSecurityIntegrationOptions
+	defaultOpts := func() *CreateOauthForCustomClientsSecurityIntegrationOptions {
+		return &CreateOauthForCustomClientsSecurityIntegrationOptions{
+			name:             id,
+			OauthClientType:  OauthSecurityIntegrationClientTypePublic,
+			OauthRedirectUri: "uri",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOauthForCustomClientsSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOauthForCustomClientsSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = OAUTH OAUTH_CLIENT = CUSTOM OAUTH_CLIENT_TYPE = 'PUBLIC' OAUTH_REDIRECT_URI = 'uri'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		blockedRoleID := RandomAccountObjectIdentifier()
+		preAuthorizedRoleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Enabled = Bool(true)
+		opts.OauthAllowNonTlsRedirectUri = Bool(true)
+		opts.OauthEnforcePkce = Bool(true)
+		opts.OauthUseSecondaryRoles = Pointer(OauthSecurityIntegrationUseSecondaryRolesImplicit)
+		opts.PreAuthorizedRolesList = &PreAuthorizedRolesList{PreAuthorizedRolesList: []AccountObjectIdentifier{preAuthorizedRoleID}}
+		opts.BlockedRolesList = &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{blockedRoleID}}
+		opts.OauthIssueRefreshTokens = Bool(true)
+		opts.OauthRefreshTokenValidity = Int(42)
+		opts.NetworkPolicy = String("policy")
+		opts.OauthClientRsaPublicKey = String("key")
+		opts.OauthClientRsaPublicKey2 = String("key2")
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = OAUTH OAUTH_CLIENT = CUSTOM OAUTH_CLIENT_TYPE = 'PUBLIC' OAUTH_REDIRECT_URI = 'uri' ENABLED = true"+
+			" OAUTH_ALLOW_NON_TLS_REDIRECT_URI = true OAUTH_ENFORCE_PKCE = true OAUTH_USE_SECONDARY_ROLES = IMPLICIT PRE_AUTHORIZED_ROLES_LIST = (%s) BLOCKED_ROLES_LIST = (%s)"+
+			" OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 42 NETWORK_POLICY = 'policy' OAUTH_CLIENT_RSA_PUBLIC_KEY = 'key' OAUTH_CLIENT_RSA_PUBLIC_KEY_2 = 'key2'"+
+			" COMMENT = 'a'", id.FullyQualifiedName(), preAuthorizedRoleID.FullyQualifiedName(), blockedRoleID.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateSaml2(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateSaml2SecurityIntegrationOptions
+	defaultOpts := func() *CreateSaml2SecurityIntegrationOptions {
+		return &CreateSaml2SecurityIntegrationOptions{
+			name:          id,
+			Enabled:       true,
+			Saml2Issuer:   "issuer",
+			Saml2SsoUrl:   "url",
+			Saml2Provider: Saml2SecurityIntegrationSaml2ProviderCustom,
+			Saml2X509Cert: "cert",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateSaml2SecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateSaml2SecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'issuer' SAML2_SSO_URL = 'url' SAML2_PROVIDER = 'CUSTOM' SAML2_X509_CERT = 'cert'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.AllowedUserDomains = []UserDomain{{Domain: "domain"}}
+		opts.AllowedEmailPatterns = []EmailPattern{{Pattern: "pattern"}}
+		opts.Saml2SpInitiatedLoginPageLabel = String("label")
+		opts.Saml2EnableSpInitiated = Bool(true)
+		opts.Saml2SnowflakeX509Cert = String("cert")
+		opts.Saml2SignRequest = Bool(true)
+		opts.Saml2RequestedNameidFormat = String("format")
+		opts.Saml2PostLogoutRedirectUrl = String("redirect")
+		opts.Saml2ForceAuthn = Bool(true)
+		opts.Saml2SnowflakeIssuerUrl = String("issuer")
+		opts.Saml2SnowflakeAcsUrl = String("acs")
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION IF NOT EXISTS %s TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'issuer' SAML2_SSO_URL = 'url' SAML2_PROVIDER = 'CUSTOM'"+
+			" SAML2_X509_CERT = 'cert' ALLOWED_USER_DOMAINS = ('domain') ALLOWED_EMAIL_PATTERNS = ('pattern') SAML2_SP_INITIATED_LOGIN_PAGE_LABEL = 'label' SAML2_ENABLE_SP_INITIATED = true"+
+			" SAML2_SNOWFLAKE_X509_CERT = 'cert' SAML2_SIGN_REQUEST = true SAML2_REQUESTED_NAMEID_FORMAT = 'format' SAML2_POST_LOGOUT_REDIRECT_URL = 'redirect' SAML2_FORCE_AUTHN = true"+
+			" SAML2_SNOWFLAKE_ISSUER_URL = 'issuer' SAML2_SNOWFLAKE_ACS_URL = 'acs' COMMENT = 'a'", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_CreateScim(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid CreateScimSecurityIntegrationOptions
+	defaultOpts := func() *CreateScimSecurityIntegrationOptions {
+		return &CreateScimSecurityIntegrationOptions{
+			name:       id,
+			ScimClient: ScimSecurityIntegrationScimClientGeneric,
+			RunAsRole:  ScimSecurityIntegrationRunAsRoleGenericScimProvisioner,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateScimSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateScimSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION %s TYPE = SCIM SCIM_CLIENT = 'GENERIC' RUN_AS_ROLE = 'GENERIC_SCIM_PROVISIONER'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Enabled = Bool(true)
+		opts.NetworkPolicy = String("policy")
+		opts.SyncPassword = Bool(true)
+		opts.Comment = String("a")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = SCIM ENABLED = true SCIM_CLIENT = 'GENERIC' RUN_AS_ROLE = 'GENERIC_SCIM_PROVISIONER'"+
+			" NETWORK_POLICY = 'policy' SYNC_PASSWORD = true COMMENT = 'a'", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterApiAuthenticationWithClientCredentialsFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions
+	defaultOpts := func() *AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions {
+		return &AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Unset = &ApiAuthenticationWithClientCredentialsFlowIntegrationUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithClientCredentialsFlowIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions.Set", "Enabled", "OauthTokenEndpoint", "OauthClientAuthMethod",
+			"OauthClientId", "OauthClientSecret", "OauthAccessTokenValidity", "OauthRefreshTokenValidity", "OauthAllowedScopes", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithClientCredentialsFlowIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions.Unset", "Enabled", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithClientCredentialsFlowIntegrationSet{
+			Enabled:                   Bool(true),
+			OauthTokenEndpoint:        String("http://example.com"),
+			OauthClientAuthMethod:     Pointer(ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost),
+			OauthClientId:             String("foo"),
+			OauthClientSecret:         String("bar"),
+			OauthAccessTokenValidity:  Int(42),
+			OauthRefreshTokenValidity: Int(43),
+			OauthAllowedScopes:        []AllowedScope{{Scope: "foo"}},
+			Comment:                   String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true OAUTH_TOKEN_ENDPOINT = 'http://example.com' OAUTH_CLIENT_AUTH_METHOD = CLIENT_SECRET_POST"+
+			" OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' OAUTH_ACCESS_TOKEN_VALIDITY = 42 OAUTH_REFRESH_TOKEN_VALIDITY = 43 OAUTH_ALLOWED_SCOPES = ('foo') COMMENT = 'a'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithClientCredentialsFlowIntegrationUnset{
+			Enabled: Bool(true),
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterApiAuthenticationWithAuthorizationCodeGrantFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions
+	defaultOpts := func() *AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions {
+		return &AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithAuthorizationCodeGrantFlowIntegrationSet{
+			Enabled:                    Bool(true),
+			OauthAuthorizationEndpoint: String("http://example.com/authorize"),
+			OauthTokenEndpoint:         String("http://example.com/token"),
+			OauthClientId:              String("foo"),
+			OauthClientSecret:          String("bar"),
+			Comment:                    String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true OAUTH_AUTHORIZATION_ENDPOINT = 'http://example.com/authorize' OAUTH_TOKEN_ENDPOINT = 'http://example.com/token'"+
+			" OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'bar' COMMENT = 'a'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithAuthorizationCodeGrantFlowIntegrationUnset{
+			Enabled: Bool(true),
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterApiAuthenticationWithJwtBearerFlow(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions
+	defaultOpts := func() *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions {
+		return &AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithJwtBearerFlowIntegrationSet{
+			Enabled:                   Bool(false),
+			OauthClientAuthMethod:     Pointer(ApiAuthenticationSecurityIntegrationOauthClientAuthMethodClientSecretPost),
+			OauthAccessTokenValidity:  Int(42),
+			OauthRefreshTokenValidity: Int(43),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = false OAUTH_CLIENT_AUTH_METHOD = CLIENT_SECRET_POST OAUTH_ACCESS_TOKEN_VALIDITY = 42"+
+			" OAUTH_REFRESH_TOKEN_VALIDITY = 43", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithJwtBearerFlowIntegrationUnset{
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterExternalOauth(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterExternalOauthSecurityIntegrationOptions
+	defaultOpts := func() *AlterExternalOauthSecurityIntegrationOptions {
+		return &AlterExternalOauthSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterExternalOauthSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Unset = &ExternalOauthIntegrationUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.SetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalOauthSecurityIntegrationOptions", "IfExists", "SetTags"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.UnsetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("one"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalOauthSecurityIntegrationOptions", "IfExists", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalOauthSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{Enabled: Bool(true)}
+		opts.Unset = &ExternalOauthIntegrationUnset{Enabled: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalOauthSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.Set.ExternalOauthBlockedRolesList opts.Set.ExternalOauthAllowedRolesList]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{
+			ExternalOauthBlockedRolesList: &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{NewAccountObjectIdentifier("foo")}},
+			ExternalOauthAllowedRolesList: &AllowedRolesList{AllowedRolesList: []AccountObjectIdentifier{NewAccountObjectIdentifier("bar")}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalOauthSecurityIntegrationOptions.Set", "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.Set.ExternalOauthJwsKeysUrl opts.Set.ExternalOauthRsaPublicKey]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{
+			ExternalOauthJwsKeysUrl:   []JwsKeysUrl{{JwsKeyUrl: "http://example.com"}},
+			ExternalOauthRsaPublicKey: String("key"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalOauthSecurityIntegrationOptions.Set", "ExternalOauthJwsKeysUrl", "ExternalOauthRsaPublicKey"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalOauthSecurityIntegrationOptions.Set", "Enabled", "ExternalOauthType", "ExternalOauthIssuer",
+			"ExternalOauthTokenUserMappingClaim", "ExternalOauthSnowflakeUserMappingAttribute", "ExternalOauthJwsKeysUrl", "ExternalOauthBlockedRolesList", "ExternalOauthAllowedRolesList",
+			"ExternalOauthRsaPublicKey", "ExternalOauthRsaPublicKey2", "ExternalOauthAudienceList", "ExternalOauthAnyRoleMode", "ExternalOauthScopeDelimiter",
+			"ExternalOauthScopeMappingAttribute", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ExternalOauthIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalOauthSecurityIntegrationOptions.Unset", "Enabled", "ExternalOauthAudienceList", "ExternalOauthRsaPublicKey",
+			"ExternalOauthRsaPublicKey2", "ExternalOauthScopeDelimiter", "ExternalOauthScopeMappingAttribute", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		roleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{
+			Enabled:                            Bool(true),
+			ExternalOauthType:                  Pointer(ExternalOauthSecurityIntegrationTypeAzure),
+			ExternalOauthIssuer:                String("issuer"),
+			ExternalOauthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "foo"}, {Claim: "bar"}},
+			ExternalOauthSnowflakeUserMappingAttribute: Pointer(ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeEmailAddress),
+			ExternalOauthJwsKeysUrl:                    []JwsKeysUrl{{JwsKeyUrl: "http://example.com"}},
+			ExternalOauthAllowedRolesList:              &AllowedRolesList{AllowedRolesList: []AccountObjectIdentifier{roleID}},
+			ExternalOauthAudienceList:                  []AudienceListItem{{Item: "foo"}},
+			ExternalOauthAnyRoleMode:                   Pointer(ExternalOauthSecurityIntegrationAnyRoleModeEnableForPrivilege),
+			ExternalOauthScopeDelimiter:                String(" "),
+			ExternalOauthScopeMappingAttribute:         String("scp"),
+			Comment:                                    String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true EXTERNAL_OAUTH_TYPE = AZURE EXTERNAL_OAUTH_ISSUER = 'issuer'"+
+			" EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('foo', 'bar') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'EMAIL_ADDRESS' EXTERNAL_OAUTH_JWS_KEYS_URL = ('http://example.com')"+
+			" EXTERNAL_OAUTH_ALLOWED_ROLES_LIST = (%s) EXTERNAL_OAUTH_AUDIENCE_LIST = ('foo') EXTERNAL_OAUTH_ANY_ROLE_MODE = ENABLE_FOR_PRIVILEGE EXTERNAL_OAUTH_SCOPE_DELIMITER = ' '"+
+			" EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE = 'scp' COMMENT = 'a'", id.FullyQualifiedName(), roleID.FullyQualifiedName())
+	})
+
+	t.Run("set empty blocked roles list", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalOauthIntegrationSet{
+			ExternalOauthBlockedRolesList: &BlockedRolesList{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET EXTERNAL_OAUTH_BLOCKED_ROLES_LIST = ()", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ExternalOauthIntegrationUnset{
+			Enabled:                            Bool(true),
+			ExternalOauthAudienceList:          Bool(true),
+			ExternalOauthRsaPublicKey:          Bool(true),
+			ExternalOauthRsaPublicKey2:         Bool(true),
+			ExternalOauthScopeDelimiter:        Bool(true),
+			ExternalOauthScopeMappingAttribute: Bool(true),
+			Comment:                            Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, EXTERNAL_OAUTH_AUDIENCE_LIST, EXTERNAL_OAUTH_RSA_PUBLIC_KEY, EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2,"+
+			" EXTERNAL_OAUTH_SCOPE_DELIMITER, EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("second-name"),
+				Value: "second-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECURITY INTEGRATION %s SET TAG "name" = 'value', "second-name" = 'second-value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+			NewAccountObjectIdentifier("second-name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECURITY INTEGRATION %s UNSET TAG "name", "second-name"`, id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterOauthForPartnerApplications(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterOauthForPartnerApplicationsSecurityIntegrationOptions
+	defaultOpts := func() *AlterOauthForPartnerApplicationsSecurityIntegrationOptions {
+		return &AlterOauthForPartnerApplicationsSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterOauthForPartnerApplicationsSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOauthForPartnerApplicationsSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &OauthForPartnerApplicationsIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterOauthForPartnerApplicationsSecurityIntegrationOptions.Set", "Enabled", "OauthRedirectUri", "OauthIssueRefreshTokens",
+			"OauthRefreshTokenValidity", "OauthUseSecondaryRoles", "BlockedRolesList", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &OauthForPartnerApplicationsIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterOauthForPartnerApplicationsSecurityIntegrationOptions.Unset", "Enabled", "OauthUseSecondaryRoles", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		roleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &OauthForPartnerApplicationsIntegrationSet{
+			Enabled:                   Bool(true),
+			OauthRedirectUri:          String("uri"),
+			OauthIssueRefreshTokens:   Bool(true),
+			OauthRefreshTokenValidity: Int(42),
+			OauthUseSecondaryRoles:    Pointer(OauthSecurityIntegrationUseSecondaryRolesImplicit),
+			BlockedRolesList:          &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{roleID}},
+			Comment:                   String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true OAUTH_REDIRECT_URI = 'uri' OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 42"+
+			" OAUTH_USE_SECONDARY_ROLES = IMPLICIT BLOCKED_ROLES_LIST = (%s) COMMENT = 'a'", id.FullyQualifiedName(), roleID.FullyQualifiedName())
+	})
+
+	t.Run("set empty blocked roles list", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &OauthForPartnerApplicationsIntegrationSet{
+			BlockedRolesList: &BlockedRolesList{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET BLOCKED_ROLES_LIST = ()", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &OauthForPartnerApplicationsIntegrationUnset{
+			Enabled:                Bool(true),
+			OauthUseSecondaryRoles: Bool(true),
+			Comment:                Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, OAUTH_USE_SECONDARY_ROLES, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterOauthForCustomClients(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterOauthForCustomClientsSecurityIntegrationOptions
+	defaultOpts := func() *AlterOauthForCustomClientsSecurityIntegrationOptions {
+		return &AlterOauthForCustomClientsSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterOauthForCustomClientsSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOauthForCustomClientsSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &OauthForCustomClientsIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterOauthForCustomClientsSecurityIntegrationOptions.Unset", "Enabled", "NetworkPolicy", "OauthClientRsaPublicKey",
+			"OauthClientRsaPublicKey2", "OauthUseSecondaryRoles", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		blockedRoleID := RandomAccountObjectIdentifier()
+		preAuthorizedRoleID := RandomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &OauthForCustomClientsIntegrationSet{
+			Enabled:                     Bool(true),
+			OauthRedirectUri:            String("uri"),
+			OauthAllowNonTlsRedirectUri: Bool(true),
+			OauthEnforcePkce:            Bool(true),
+			PreAuthorizedRolesList:      &PreAuthorizedRolesList{PreAuthorizedRolesList: []AccountObjectIdentifier{preAuthorizedRoleID}},
+			BlockedRolesList:            &BlockedRolesList{BlockedRolesList: []AccountObjectIdentifier{blockedRoleID}},
+			OauthIssueRefreshTokens:     Bool(true),
+			OauthRefreshTokenValidity:   Int(42),
+			OauthUseSecondaryRoles:      Pointer(OauthSecurityIntegrationUseSecondaryRolesNone),
+			NetworkPolicy:               String("policy"),
+			OauthClientRsaPublicKey:     String("key"),
+			OauthClientRsaPublicKey2:    String("key2"),
+			Comment:                     String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true OAUTH_REDIRECT_URI = 'uri' OAUTH_ALLOW_NON_TLS_REDIRECT_URI = true OAUTH_ENFORCE_PKCE = true"+
+			" PRE_AUTHORIZED_ROLES_LIST = (%s) BLOCKED_ROLES_LIST = (%s) OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 42 OAUTH_USE_SECONDARY_ROLES = NONE"+
+			" NETWORK_POLICY = 'policy' OAUTH_CLIENT_RSA_PUBLIC_KEY = 'key' OAUTH_CLIENT_RSA_PUBLIC_KEY_2 = 'key2' COMMENT = 'a'", id.FullyQualifiedName(), preAuthorizedRoleID.FullyQualifiedName(), blockedRoleID.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &OauthForCustomClientsIntegrationUnset{
+			Enabled:                  Bool(true),
+			NetworkPolicy:            Bool(true),
+			OauthClientRsaPublicKey:  Bool(true),
+			OauthClientRsaPublicKey2: Bool(true),
+			OauthUseSecondaryRoles:   Bool(true),
+			Comment:                  Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, NETWORK_POLICY, OAUTH_CLIENT_RSA_PUBLIC_KEY, OAUTH_CLIENT_RSA_PUBLIC_KEY_2,"+
+			" OAUTH_USE_SECONDARY_ROLES, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterSaml2(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterSaml2SecurityIntegrationOptions
+	defaultOpts := func() *AlterSaml2SecurityIntegrationOptions {
+		return &AlterSaml2SecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterSaml2SecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.RefreshSaml2SnowflakePrivateKey = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RefreshSaml2SnowflakePrivateKey opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSaml2SecurityIntegrationOptions", "Set", "Unset", "RefreshSaml2SnowflakePrivateKey", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RefreshSaml2SnowflakePrivateKey opts.SetTags opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &Saml2IntegrationSet{Enabled: Bool(true)}
+		opts.RefreshSaml2SnowflakePrivateKey = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSaml2SecurityIntegrationOptions", "Set", "Unset", "RefreshSaml2SnowflakePrivateKey", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &Saml2IntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSaml2SecurityIntegrationOptions.Set", "Enabled", "Saml2Issuer", "Saml2SsoUrl", "Saml2Provider", "Saml2X509Cert",
+			"AllowedUserDomains", "AllowedEmailPatterns", "Saml2SpInitiatedLoginPageLabel", "Saml2EnableSpInitiated", "Saml2SnowflakeX509Cert", "Saml2SignRequest",
+			"Saml2RequestedNameidFormat", "Saml2PostLogoutRedirectUrl", "Saml2ForceAuthn", "Saml2SnowflakeIssuerUrl", "Saml2SnowflakeAcsUrl", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &Saml2IntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSaml2SecurityIntegrationOptions.Unset", "Enabled", "Saml2ForceAuthn", "Saml2RequestedNameidFormat",
+			"Saml2PostLogoutRedirectUrl", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &Saml2IntegrationSet{
+			Enabled:                        Bool(true),
+			Saml2Issuer:                    String("issuer"),
+			Saml2SsoUrl:                    String("url"),
+			Saml2Provider:                  Pointer(Saml2SecurityIntegrationSaml2ProviderOkta),
+			Saml2X509Cert:                  String("cert"),
+			AllowedUserDomains:             []UserDomain{{Domain: "domain"}},
+			AllowedEmailPatterns:           []EmailPattern{{Pattern: "pattern"}},
+			Saml2SpInitiatedLoginPageLabel: String("label"),
+			Saml2EnableSpInitiated:         Bool(true),
+			Saml2SnowflakeX509Cert:         String("cert"),
+			Saml2SignRequest:               Bool(true),
+			Saml2RequestedNameidFormat:     String("format"),
+			Saml2PostLogoutRedirectUrl:     String("redirect"),
+			Saml2ForceAuthn:                Bool(true),
+			Saml2SnowflakeIssuerUrl:        String("issuer"),
+			Saml2SnowflakeAcsUrl:           String("acs"),
+			Comment:                        String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true SAML2_ISSUER = 'issuer' SAML2_SSO_URL = 'url' SAML2_PROVIDER = 'OKTA' SAML2_X509_CERT = 'cert'"+
+			" ALLOWED_USER_DOMAINS = ('domain') ALLOWED_EMAIL_PATTERNS = ('pattern') SAML2_SP_INITIATED_LOGIN_PAGE_LABEL = 'label' SAML2_ENABLE_SP_INITIATED = true SAML2_SNOWFLAKE_X509_CERT = 'cert'"+
+			" SAML2_SIGN_REQUEST = true SAML2_REQUESTED_NAMEID_FORMAT = 'format' SAML2_POST_LOGOUT_REDIRECT_URL = 'redirect' SAML2_FORCE_AUTHN = true SAML2_SNOWFLAKE_ISSUER_URL = 'issuer'"+
+			" SAML2_SNOWFLAKE_ACS_URL = 'acs' COMMENT = 'a'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &Saml2IntegrationUnset{
+			Enabled:                    Bool(true),
+			Saml2ForceAuthn:            Bool(true),
+			Saml2RequestedNameidFormat: Bool(true),
+			Saml2PostLogoutRedirectUrl: Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, SAML2_FORCE_AUTHN, SAML2_REQUESTED_NAMEID_FORMAT, SAML2_POST_LOGOUT_REDIRECT_URL, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("refresh SAML2_SNOWFLAKE_PRIVATE_KEY", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RefreshSaml2SnowflakePrivateKey = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s REFRESH SAML2_SNOWFLAKE_PRIVATE_KEY", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_AlterScim(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid AlterScimSecurityIntegrationOptions
+	defaultOpts := func() *AlterScimSecurityIntegrationOptions {
+		return &AlterScimSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterScimSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterScimSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ScimIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterScimSecurityIntegrationOptions.Set", "Enabled", "NetworkPolicy", "SyncPassword", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ScimIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterScimSecurityIntegrationOptions.Unset", "Enabled", "NetworkPolicy", "SyncPassword", "Comment"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ScimIntegrationSet{
+			Enabled:       Bool(true),
+			NetworkPolicy: String("policy"),
+			SyncPassword:  Bool(true),
+			Comment:       String("a"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true NETWORK_POLICY = 'policy' SYNC_PASSWORD = true COMMENT = 'a'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ScimIntegrationUnset{
+			Enabled:       Bool(true),
+			NetworkPolicy: Bool(true),
+			SyncPassword:  Bool(true),
+			Comment:       Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, NETWORK_POLICY, SYNC_PASSWORD, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_Drop(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid DropSecurityIntegrationOptions
+	defaultOpts := func() *DropSecurityIntegrationOptions {
+		return &DropSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP SECURITY INTEGRATION %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP SECURITY INTEGRATION IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestSecurityIntegrations_Show(t *testing.T) {
+	// Minimal valid ShowSecurityIntegrationOptions
+	defaultOpts := func() *ShowSecurityIntegrationOptions {
+		return &ShowSecurityIntegrationOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SECURITY INTEGRATIONS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SECURITY INTEGRATIONS LIKE 'some pattern'")
+	})
+}
+
+func TestSecurityIntegrations_Describe(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	// Minimal valid DescribeSecurityIntegrationOptions
+	defaultOpts := func() *DescribeSecurityIntegrationOptions {
+		return &DescribeSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SECURITY INTEGRATION %s", id.FullyQualifiedName())
+	})
+}
@@ -53,3 +53,6 @@ type SequenceConstraintRequest struct {
 	Cascade  *bool
 	Restrict *bool
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -9,6 +9,8 @@ type Sequences interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Sequence, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*SequenceDetail, error)
 	Drop(ctx context.Context, request *DropSequenceRequest) error
+	// generator:protected-begin Sequences methods
+	// generator:protected-end
 }
 
 // CreateSequenceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-sequence.
@@ -121,3 +123,6 @@ type SequenceConstraint struct {
 	Cascade  *bool `ddl:"keyword" sql:"CASCADE"`
 	Restrict *bool `ddl:"keyword" sql:"RESTRICT"`
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Sequences = (*sequences)(nil)
 
@@ -33,12 +29,14 @@ func (v *sequences) Show(ctx context.Context, request *ShowSequenceRequest) ([]S
 }
 
 func (v *sequences) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Sequence, error) {
-	request := NewShowSequenceRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin sequences.ShowByID
+	request := NewShowSequenceRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	sequences, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(sequences, func(r Sequence) bool { return r.Name == id.Name() })
+	return findOne(sequences, func(r Sequence) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *sequences) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SequenceDetail, error) {
@@ -76,7 +74,6 @@ func (r *AlterSequenceRequest) toOpts() *AlterSequenceOptions {
 		name:         r.name,
 		RenameTo:     r.RenameTo,
 		SetIncrement: r.SetIncrement,
-
 		UnsetComment: r.UnsetComment,
 	}
 	if r.Set != nil {
@@ -97,6 +94,7 @@ func (r *ShowSequenceRequest) toOpts() *ShowSequenceOptions {
 }
 
 func (r sequenceRow) convert() *Sequence {
+	// generator:protected-begin sequenceRow.convert
 	return &Sequence{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -109,6 +107,7 @@ func (r sequenceRow) convert() *Sequence {
 		Comment:       r.Comment,
 		Ordered:       r.Ordered == "Y",
 	}
+	// generator:protected-end
 }
 
 func (r *DescribeSequenceRequest) toOpts() *DescribeSequenceOptions {
@@ -119,6 +118,7 @@ func (r *DescribeSequenceRequest) toOpts() *DescribeSequenceOptions {
 }
 
 func (r sequenceDetailRow) convert() *SequenceDetail {
+	// generator:protected-begin sequenceDetailRow.convert
 	return &SequenceDetail{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -131,6 +131,7 @@ func (r sequenceDetailRow) convert() *SequenceDetail {
 		Comment:       r.Comment,
 		Ordered:       r.Ordered == "Y",
 	}
+	// generator:protected-end
 }
 
 func (r *DropSequenceRequest) toOpts() *DropSequenceOptions {
@@ -146,3 +147,6 @@ func (r *DropSequenceRequest) toOpts() *DropSequenceOptions {
 	}
 	return opts
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
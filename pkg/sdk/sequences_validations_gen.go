@@ -19,6 +19,8 @@ func (opts *CreateSequenceOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateSequenceOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateSequenceOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -36,6 +38,8 @@ func (opts *AlterSequenceOptions) validate() error {
 	if !exactlyOneValueSet(opts.RenameTo, opts.SetIncrement, opts.Set, opts.UnsetComment) {
 		errs = append(errs, errExactlyOneOf("AlterSequenceOptions", "RenameTo", "SetIncrement", "Set", "UnsetComment"))
 	}
+	// generator:protected-begin AlterSequenceOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -44,6 +48,8 @@ func (opts *ShowSequenceOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowSequenceOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -55,6 +61,8 @@ func (opts *DescribeSequenceOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeSequenceOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -71,5 +79,10 @@ func (opts *DropSequenceOptions) validate() error {
 			errs = append(errs, errExactlyOneOf("DropSequenceOptions.Constraint", "Cascade", "Restrict"))
 		}
 	}
+	// generator:protected-begin DropSequenceOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -87,6 +87,7 @@ var SessionPoliciesDef = g.NewInterface(
 			Show().
 			SQL("SESSION POLICIES"),
 	).
+	ShowByIdOperation().
 	DescribeOperation(
 		g.DescriptionMappingKindSingleValue,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-session-policy",
@@ -95,7 +96,7 @@ var SessionPoliciesDef = g.NewInterface(
 			Field("name", "string").
 			Field("session_idle_timeout_mins", "int").
 			Field("session_ui_idle_timeout_mins", "int").
-			Field("comment", "string"),
+			Field("comment", "sql.NullString"),
 		g.PlainStruct("SessionPolicyDescription").
 			Field("CreatedOn", "string").
 			Field("Name", "string").
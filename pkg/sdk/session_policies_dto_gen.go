@@ -46,8 +46,12 @@ type DropSessionPolicyRequest struct {
 	name     SchemaObjectIdentifier // required
 }
 
-type ShowSessionPolicyRequest struct{}
+type ShowSessionPolicyRequest struct {
+}
 
 type DescribeSessionPolicyRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -12,6 +12,8 @@ type SessionPolicies interface {
 	Show(ctx context.Context, request *ShowSessionPolicyRequest) ([]SessionPolicy, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDescription, error)
+	// generator:protected-begin SessionPolicies methods
+	// generator:protected-end
 }
 
 // CreateSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-session-policy.
@@ -110,6 +112,9 @@ type SessionPolicyDescription struct {
 	Comment                  string
 }
 
+// generator:protected-begin declarations
 func (v *SessionPolicy) ID() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
 }
+
+// generator:protected-end
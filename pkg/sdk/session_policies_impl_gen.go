@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ SessionPolicies = (*sessionPolicies)(nil)
 
@@ -38,12 +34,14 @@ func (v *sessionPolicies) Show(ctx context.Context, request *ShowSessionPolicyRe
 }
 
 func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error) {
-	sessionPolicies, err := v.Show(ctx, NewShowSessionPolicyRequest())
+	// generator:protected-begin sessionPolicies.ShowByID
+	request := NewShowSessionPolicyRequest()
+	sessionPolicies, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-
-	return collections.FindOne(sessionPolicies, func(r SessionPolicy) bool { return r.Name == id.Name() })
+	return findOne(sessionPolicies, func(r SessionPolicy) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *sessionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDescription, error) {
@@ -71,10 +69,9 @@ func (r *CreateSessionPolicyRequest) toOpts() *CreateSessionPolicyOptions {
 
 func (r *AlterSessionPolicyRequest) toOpts() *AlterSessionPolicyOptions {
 	opts := &AlterSessionPolicyOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-		RenameTo: r.RenameTo,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
+		RenameTo:  r.RenameTo,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
@@ -109,6 +106,7 @@ func (r *ShowSessionPolicyRequest) toOpts() *ShowSessionPolicyOptions {
 }
 
 func (r showSessionPolicyDBRow) convert() *SessionPolicy {
+	// generator:protected-begin showSessionPolicyDBRow.convert
 	return &SessionPolicy{
 		CreatedOn:    r.CreatedOn,
 		Name:         r.Name,
@@ -119,6 +117,7 @@ func (r showSessionPolicyDBRow) convert() *SessionPolicy {
 		Comment:      r.Comment,
 		Options:      r.Options,
 	}
+	// generator:protected-end
 }
 
 func (r *DescribeSessionPolicyRequest) toOpts() *DescribeSessionPolicyOptions {
@@ -129,6 +128,7 @@ func (r *DescribeSessionPolicyRequest) toOpts() *DescribeSessionPolicyOptions {
 }
 
 func (r describeSessionPolicyDBRow) convert() *SessionPolicyDescription {
+	// generator:protected-begin describeSessionPolicyDBRow.convert
 	sessionPolicyDescription := SessionPolicyDescription{
 		CreatedOn:                r.CreatedOn,
 		Name:                     r.Name,
@@ -139,4 +139,8 @@ func (r describeSessionPolicyDBRow) convert() *SessionPolicyDescription {
 		sessionPolicyDescription.Comment = r.Comment.String
 	}
 	return &sessionPolicyDescription
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
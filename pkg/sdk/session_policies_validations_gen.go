@@ -1,7 +1,5 @@
 package sdk
 
-import "errors"
-
 var (
 	_ validatable = new(CreateSessionPolicyOptions)
 	_ validatable = new(AlterSessionPolicyOptions)
@@ -12,7 +10,7 @@ var (
 
 func (opts *CreateSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -21,59 +19,72 @@ func (opts *CreateSessionPolicyOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateSessionPolicyOptions", "OrReplace", "IfNotExists"))
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateSessionPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.RenameTo, opts.Set, opts.SetTags, opts.UnsetTags, opts.Unset); !ok {
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.SetTags, opts.UnsetTags, opts.Unset) {
 		errs = append(errs, errExactlyOneOf("AlterSessionPolicyOptions", "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.Comment); !ok {
+		if !anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.Comment) {
 			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
 		}
 	}
 	if valueSet(opts.Unset) {
-		if ok := anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.Comment); !ok {
+		if !anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.Comment) {
 			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin AlterSessionPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DropSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DropSessionPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *ShowSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
-	return errors.Join(errs...)
+	// generator:protected-begin ShowSessionPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DescribeSessionPolicyOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DescribeSessionPolicyOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
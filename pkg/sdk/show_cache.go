@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"strings"
+	"sync"
+)
+
+// showCache is an opt-in, read-through cache of SHOW results. Instead of running one SHOW ... LIKE query per ShowByID call,
+// the whole container (account, database or schema) is listed once and later ShowByID calls for objects of the same type
+// in the same container are served from memory. Entries are invalidated by Create/Alter/Drop operations run through the same client.
+//
+// SHOW commands return at most 10K rows, so objects missing from the cached list of a bigger container are looked up one by one.
+type showCache struct {
+	mu      sync.Mutex
+	entries map[showCacheKey]*showCacheEntry
+}
+
+type showCacheKey struct {
+	objectType ObjectType
+	// container is the fully qualified name of the database or schema the objects were listed in (empty for the account level).
+	container string
+}
+
+type showCacheEntry struct {
+	mu      sync.Mutex
+	loaded  bool
+	objects any
+}
+
+func newShowCache() *showCache {
+	return &showCache{
+		entries: make(map[showCacheKey]*showCacheEntry),
+	}
+}
+
+// EnableShowCache turns on the show cache used by ShowByID of databases, schemas, tables and warehouses.
+func (c *Client) EnableShowCache() {
+	c.showCache = newShowCache()
+}
+
+func (c *Client) ShowCacheEnabled() bool {
+	return c.showCache != nil
+}
+
+func (c *showCache) entry(key showCacheKey) *showCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &showCacheEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// invalidate removes the cached list of objects of the given type containing the given ids,
+// together with all the cached lists of objects nested in the given ids (e.g. tables in a dropped schema).
+// It is safe to call on a nil cache.
+func (c *showCache) invalidate(objectType ObjectType, ids ...ObjectIdentifier) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		if id == nil {
+			continue
+		}
+		delete(c.entries, showCacheKey{objectType: objectType, container: showCacheContainer(id)})
+		nestedPrefix := id.FullyQualifiedName() + "."
+		for key := range c.entries {
+			if key.container == id.FullyQualifiedName() || strings.HasPrefix(key.container, nestedPrefix) {
+				delete(c.entries, key)
+			}
+		}
+	}
+}
+
+// invalidateAll is used after operations which could have changed any object (e.g. unsafe execute or ownership transfer).
+// It is safe to call on a nil cache.
+func (c *showCache) invalidateAll() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[showCacheKey]*showCacheEntry)
+}
+
+// showCacheContainer returns the fully qualified name of the container holding the object with the given id.
+func showCacheContainer(id ObjectIdentifier) string {
+	switch v := id.(type) {
+	case DatabaseObjectIdentifier:
+		return NewAccountObjectIdentifier(v.DatabaseName()).FullyQualifiedName()
+	case SchemaObjectIdentifier:
+		return NewDatabaseObjectIdentifier(v.DatabaseName(), v.SchemaName()).FullyQualifiedName()
+	default:
+		return ""
+	}
+}
+
+// showByIDWithCache returns the object with the given id. Without the cache, the showLike function
+// (usually SHOW ... LIKE '<name>' IN <container>) is run every time. With the cache enabled, showAll (SHOW ... IN <container>)
+// is run once per container and the result is reused until it's invalidated. Objects missing from the cached result
+// (e.g. created outside of this client after the container was listed, or cut off by the 10K rows limit) are looked up
+// with showLike before reporting them as not existing, and added to the cache when found.
+func showByIDWithCache[T any](client *Client, objectType ObjectType, id ObjectIdentifier, matches func(T) bool, showLike func() ([]T, error), showAll func() ([]T, error)) (*T, error) {
+	if client.showCache == nil {
+		objects, err := showLike()
+		if err != nil {
+			return nil, err
+		}
+		return findOne(objects, matches)
+	}
+	e := client.showCache.entry(showCacheKey{objectType: objectType, container: showCacheContainer(id)})
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.loaded {
+		objects, err := showAll()
+		if err != nil {
+			return nil, err
+		}
+		e.objects = objects
+		e.loaded = true
+	}
+	cached := e.objects.([]T)
+	if object, err := findOne(cached, matches); err == nil {
+		return object, nil
+	}
+	objects, err := showLike()
+	if err != nil {
+		return nil, err
+	}
+	object, err := findOne(objects, matches)
+	if err != nil {
+		return nil, err
+	}
+	e.objects = append(cached, *object)
+	return object, nil
+}
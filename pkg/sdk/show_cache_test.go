@@ -0,0 +1,184 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockClient(t *testing.T) (*Client, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, mock.ExpectationsWereMet())
+		_ = db.Close()
+	})
+	return NewClientFromDB(db), mock
+}
+
+func tableRows(tables ...SchemaObjectIdentifier) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "owner"})
+	for _, id := range tables {
+		rows.AddRow("2023-01-01", id.Name(), id.DatabaseName(), id.SchemaName(), "TABLE", "ACCOUNTADMIN")
+	}
+	return rows
+}
+
+func warehouseRows(names ...string) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"name", "state", "type", "size"})
+	for _, name := range names {
+		rows.AddRow(name, "STARTED", "STANDARD", "X-Small")
+	}
+	return rows
+}
+
+func TestShowCache(t *testing.T) {
+	ctx := context.Background()
+	table1 := NewSchemaObjectIdentifier("db", "schema", "table1")
+	table2 := NewSchemaObjectIdentifier("db", "schema", "table2")
+	otherSchemaTable := NewSchemaObjectIdentifier("db", "other_schema", "table1")
+
+	t.Run("disabled by default: one show like query per object", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table1' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1))
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table2' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table2))
+
+		require.False(t, client.ShowCacheEnabled())
+		_, err := client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		_, err = client.Tables.ShowByID(ctx, table2)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: one show query per container", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1, table2))
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."other_schema"`).WillReturnRows(tableRows(otherSchemaTable))
+
+		t1, err := client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		require.Equal(t, "table1", t1.Name)
+		t2, err := client.Tables.ShowByID(ctx, table2)
+		require.NoError(t, err)
+		require.Equal(t, "table2", t2.Name)
+		_, err = client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		t3, err := client.Tables.ShowByID(ctx, otherSchemaTable)
+		require.NoError(t, err)
+		require.Equal(t, "other_schema", t3.SchemaName)
+	})
+
+	t.Run("enabled: object missing from the container", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1))
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table2' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows())
+
+		_, err := client.Tables.ShowByID(ctx, table2)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		_, err = client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: object missing from the cached container is looked up and cached", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		// table2 created outside of this client after the container was listed (or cut off by the SHOW rows limit)
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1))
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table2' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table2))
+
+		_, err := client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		t2, err := client.Tables.ShowByID(ctx, table2)
+		require.NoError(t, err)
+		require.Equal(t, "table2", t2.Name)
+		_, err = client.Tables.ShowByID(ctx, table2)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: failed show is not cached", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnError(ErrAccountIsEmpty)
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1))
+
+		_, err := client.Tables.ShowByID(ctx, table1)
+		require.Error(t, err)
+		_, err = client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: drop invalidates the container", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1, table2))
+		mock.ExpectExec(`DROP TABLE "db"."schema"."table1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table2))
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table1' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows())
+
+		_, err := client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		require.NoError(t, client.Tables.Drop(ctx, NewDropTableRequest(table1)))
+		_, err = client.Tables.ShowByID(ctx, table1)
+		require.Error(t, err)
+		_, err = client.Tables.ShowByID(ctx, table2)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: dropping a schema invalidates objects inside it", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows(table1))
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."other_schema"`).WillReturnRows(tableRows(otherSchemaTable))
+		mock.ExpectExec(`DROP SCHEMA "db"."schema"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SHOW TABLES IN SCHEMA "db"."schema"`).WillReturnRows(tableRows())
+		mock.ExpectQuery(`SHOW TABLES LIKE 'table1' IN SCHEMA "db"."schema"`).WillReturnRows(tableRows())
+
+		_, err := client.Tables.ShowByID(ctx, table1)
+		require.NoError(t, err)
+		_, err = client.Tables.ShowByID(ctx, otherSchemaTable)
+		require.NoError(t, err)
+		require.NoError(t, client.Schemas.Drop(ctx, NewDatabaseObjectIdentifier("db", "schema"), nil))
+		_, err = client.Tables.ShowByID(ctx, table1)
+		require.Error(t, err)
+		_, err = client.Tables.ShowByID(ctx, otherSchemaTable)
+		require.NoError(t, err)
+	})
+
+	t.Run("enabled: account level objects", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW WAREHOUSES`).WillReturnRows(warehouseRows("wh1", "wh2"))
+		mock.ExpectExec(`ALTER WAREHOUSE "wh1" RENAME TO "wh3"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SHOW WAREHOUSES`).WillReturnRows(warehouseRows("wh3", "wh2"))
+
+		_, err := client.Warehouses.ShowByID(ctx, NewAccountObjectIdentifier("wh1"))
+		require.NoError(t, err)
+		_, err = client.Warehouses.ShowByID(ctx, NewAccountObjectIdentifier("wh2"))
+		require.NoError(t, err)
+		require.NoError(t, client.Warehouses.Alter(ctx, NewAccountObjectIdentifier("wh1"), &AlterWarehouseOptions{NewName: Pointer(NewAccountObjectIdentifier("wh3"))}))
+		wh, err := client.Warehouses.ShowByID(ctx, NewAccountObjectIdentifier("wh3"))
+		require.NoError(t, err)
+		require.Equal(t, "wh3", wh.Name)
+	})
+
+	t.Run("enabled: unsafe execute invalidates everything", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		client.EnableShowCache()
+		mock.ExpectQuery(`SHOW WAREHOUSES`).WillReturnRows(warehouseRows("wh1"))
+		mock.ExpectExec(`DROP WAREHOUSE "wh1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SHOW WAREHOUSES`).WillReturnRows(warehouseRows())
+		mock.ExpectQuery(`SHOW WAREHOUSES LIKE 'wh1'`).WillReturnRows(warehouseRows())
+
+		_, err := client.Warehouses.ShowByID(ctx, NewAccountObjectIdentifier("wh1"))
+		require.NoError(t, err)
+		_, err = client.ExecUnsafe(ctx, `DROP WAREHOUSE "wh1"`)
+		require.NoError(t, err)
+		_, err = client.Warehouses.ShowByID(ctx, NewAccountObjectIdentifier("wh1"))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
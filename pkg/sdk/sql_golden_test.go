@@ -0,0 +1,260 @@
+package sdk
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// To regenerate the golden files after an intended change in the generated SQL run `make update-sql-golden-files`.
+var updateGoldenFiles = flag.Bool("update", false, "update golden files of the SQL fixtures in testdata/sql")
+
+// maxSQLFixtureDepth stops filling the fixtures built from recursive structs.
+const maxSQLFixtureDepth = 8
+
+// sqlFixtureNumbers are the values of the numeric fields which are validated to be in a given range.
+var sqlFixtureNumbers = map[string]int64{
+	"TwoDigitCenturyStart": 1970,
+}
+
+// sqlFixtureStrings are the values of the string fields rendered without quotes, which have to be valid SQL expressions.
+var sqlFixtureStrings = map[string]string{
+	"sql":        "SELECT 1",
+	"ModifyAs":   "SELECT 1",
+	"When":       "SYSTEM$STREAM_HAS_DATA('stream')",
+	"ModifyWhen": "SYSTEM$STREAM_HAS_DATA('stream')",
+	"Config":     `$${"output_dir": "/temp/test_directory/"}$$`,
+}
+
+// assertSQLMatchesGoldenFile validates the options, renders them with structToSQL and compares the result with testdata/sql/<test name>.sql.
+// Only valid options are rendered, so the golden files show the statements the SDK can actually run.
+func assertSQLMatchesGoldenFile(t *testing.T, opts validatable) {
+	t.Helper()
+	fillRequiredSQLFixtureFields(opts)
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+
+	path := filepath.Join("testdata", "sql", filepath.FromSlash(t.Name())+".sql")
+	if *updateGoldenFiles {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(actual+"\n"), 0o600))
+		return
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "golden file %s not found, run the test with -update to create it", path)
+	assert.Equal(t, strings.TrimSuffix(string(expected), "\n"), actual)
+}
+
+// fillRequiredSQLFixtureFields fills the empty unexported fields of the options (e.g. the query of CREATE VIEW or the source of CLONE).
+// They are set by the SDK from the required arguments of the requests, so the statements run by the SDK always contain them.
+func fillRequiredSQLFixtureFields(opts any) {
+	v := reflect.ValueOf(opts).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.IsExported() || field.Tag.Get("ddl") == "static" || field.Tag.Get("ddl") == "-" || !v.Field(i).IsZero() {
+			continue
+		}
+		fillSQLFixtureValue(reflect.NewAt(field.Type, unsafe.Pointer(v.Field(i).UnsafeAddr())).Elem(), field.Name, 0)
+	}
+}
+
+// sqlFixtureIdentifier returns a constant identifier, so the golden files don't change between the runs.
+func sqlFixtureIdentifier[T any]() T {
+	var id T
+	reflect.ValueOf(&id).Elem().Set(sqlFixtureIdentifierValue(reflect.TypeOf(id), "name"))
+	return id
+}
+
+// sqlFixtureIdentifierValue returns an identifier of the given type named after the field, or an invalid value for non-identifier types.
+func sqlFixtureIdentifierValue(typ reflect.Type, name string) reflect.Value {
+	var id any
+	switch typ {
+	case reflect.TypeOf(AccountObjectIdentifier{}):
+		id = NewAccountObjectIdentifier(name)
+	case reflect.TypeOf(DatabaseObjectIdentifier{}):
+		id = NewDatabaseObjectIdentifier("database", name)
+	case reflect.TypeOf(SchemaObjectIdentifier{}):
+		id = NewSchemaObjectIdentifier("database", "schema", name)
+	case reflect.TypeOf(SchemaObjectIdentifierWithArguments{}):
+		id = NewSchemaObjectIdentifierWithArguments("database", "schema", name, []DataType{DataTypeVARCHAR})
+	case reflect.TypeOf(TableColumnIdentifier{}):
+		id = NewTableColumnIdentifier("database", "schema", "table", name)
+	case reflect.TypeOf(AccountIdentifier{}):
+		id = NewAccountIdentifier("organization", name)
+	case reflect.TypeOf(ExternalObjectIdentifier{}):
+		id = NewExternalObjectIdentifier(NewAccountIdentifier("organization", "account"), NewAccountObjectIdentifier(name))
+	default:
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(id)
+}
+
+// fillSQLFixture sets every exported field of the options (recursively) to a value derived from the field name,
+// so the golden file shows every part of the statement.
+func fillSQLFixture(opts any) {
+	fillSQLFixtureValue(reflect.ValueOf(opts).Elem(), "value", 0)
+}
+
+// fillSQLFixtureField fills only the given field of the options, e.g. a single branch of ALTER. Nested fields are separated
+// with dots (e.g. "Set.Comment"); the structs on the way are created and for slices the first element is used.
+func fillSQLFixtureField(opts any, path string) {
+	field, name := sqlFixtureField(opts, path, true)
+	fillSQLFixtureValue(field, name, 0)
+}
+
+// clearSQLFixtureField resets the given field of the options, e.g. one of the conflicting fields filled by fillSQLFixture.
+func clearSQLFixtureField(opts any, path string) {
+	if field, _ := sqlFixtureField(opts, path, false); field.IsValid() {
+		field.SetZero()
+	}
+}
+
+// setSQLFixtureField sets the given field of the options to the value, e.g. to one of the allowed enum values.
+func setSQLFixtureField(opts any, path string, value any) {
+	field, _ := sqlFixtureField(opts, path, true)
+	v := reflect.ValueOf(value)
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+	field.Set(v.Convert(field.Type()))
+}
+
+// sqlFixtureField finds the field under the dot-separated path. When create is false, an invalid value is returned for
+// the fields of unset structs instead of creating them.
+func sqlFixtureField(opts any, path string, create bool) (reflect.Value, string) {
+	v := reflect.ValueOf(opts).Elem()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		for i > 0 && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Slice) {
+			switch v.Kind() {
+			case reflect.Pointer:
+				if v.IsNil() {
+					if !create {
+						return reflect.Value{}, name
+					}
+					v.Set(reflect.New(v.Type().Elem()))
+				}
+				v = v.Elem()
+			case reflect.Slice:
+				if v.Len() == 0 {
+					if !create {
+						return reflect.Value{}, name
+					}
+					v.Set(reflect.Append(v, reflect.New(v.Type().Elem()).Elem()))
+				}
+				v = v.Index(0)
+			}
+		}
+		v = v.FieldByName(name)
+		if !v.IsValid() {
+			panic("unknown field " + path)
+		}
+		if !v.CanSet() {
+			// unexported fields, e.g. the source of clone, can be set only through their address
+			v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+		}
+	}
+	return v, names[len(names)-1]
+}
+
+func fillSQLFixtureValue(v reflect.Value, name string, depth int) {
+	if depth > maxSQLFixtureDepth || !v.CanSet() {
+		return
+	}
+	if id := sqlFixtureIdentifierValue(v.Type(), toSnakeCase(name)); id.IsValid() {
+		v.Set(id)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		accountObjectIdentifier := reflect.TypeOf(AccountObjectIdentifier{})
+		if v.Type().NumMethod() > 0 && accountObjectIdentifier.Implements(v.Type()) {
+			v.Set(sqlFixtureIdentifierValue(accountObjectIdentifier, toSnakeCase(name)))
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fillSQLFixtureValue(v.Elem(), name, depth+1)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.String:
+		if s, ok := sqlFixtureStrings[name]; ok {
+			v.SetString(s)
+		} else {
+			v.SetString(toSnakeCase(name))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := sqlFixtureNumbers[name]; ok {
+			v.SetInt(n)
+		} else {
+			v.SetInt(1)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Slice:
+		element := reflect.New(v.Type().Elem()).Elem()
+		fillSQLFixtureValue(element, name, depth+1)
+		v.Set(reflect.Append(reflect.MakeSlice(v.Type(), 0, 1), element))
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if strings.HasPrefix(field.Tag.Get("ddl"), "parameter") && isStructOrStructPointer(field.Type) {
+				// struct parameters render exactly one of their fields
+				fillSQLFixtureFirstField(v.Field(i), field.Name, depth+1)
+			} else {
+				fillSQLFixtureValue(v.Field(i), field.Name, depth+1)
+			}
+		}
+	}
+}
+
+func isStructOrStructPointer(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct
+}
+
+func fillSQLFixtureFirstField(v reflect.Value, name string, depth int) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).IsExported() {
+			fillSQLFixtureValue(v.Field(i), v.Type().Field(i).Name, depth+1)
+			return
+		}
+	}
+	fillSQLFixtureValue(v, name, depth)
+}
+
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
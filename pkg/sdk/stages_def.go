@@ -4,43 +4,28 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
-type InternalStageEncryptionOption string
+var internalStageEncryptionOptionEnum = g.NewEnum("InternalStageEncryptionOption").
+	WithValue("InternalStageEncryptionFull", "SNOWFLAKE_FULL").
+	WithValue("InternalStageEncryptionSSE", "SNOWFLAKE_SSE")
 
-var (
-	InternalStageEncryptionFull InternalStageEncryptionOption = "SNOWFLAKE_FULL"
-	InternalStageEncryptionSSE  InternalStageEncryptionOption = "SNOWFLAKE_SSE"
-)
+var externalStageS3EncryptionOptionEnum = g.NewEnum("ExternalStageS3EncryptionOption").
+	WithValue("ExternalStageS3EncryptionCSE", "AWS_CSE").
+	WithValue("ExternalStageS3EncryptionSSES3", "AWS_SSE_S3").
+	WithValue("ExternalStageS3EncryptionSSEKMS", "AWS_SSE_KMS").
+	WithValue("ExternalStageS3EncryptionNone", "NONE")
 
-type ExternalStageS3EncryptionOption string
+var externalStageGCSEncryptionOptionEnum = g.NewEnum("ExternalStageGCSEncryptionOption").
+	WithValue("ExternalStageGCSEncryptionSSEKMS", "GCS_SSE_KMS").
+	WithValue("ExternalStageGCSEncryptionNone", "NONE")
 
-var (
-	ExternalStageS3EncryptionCSE    ExternalStageS3EncryptionOption = "AWS_CSE"
-	ExternalStageS3EncryptionSSES3  ExternalStageS3EncryptionOption = "AWS_SSE_S3"
-	ExternalStageS3EncryptionSSEKMS ExternalStageS3EncryptionOption = "AWS_SSE_KMS"
-	ExternalStageS3EncryptionNone   ExternalStageS3EncryptionOption = "NONE"
-)
+var externalStageAzureEncryptionOptionEnum = g.NewEnum("ExternalStageAzureEncryptionOption").
+	WithValue("ExternalStageAzureEncryptionCSE", "AZURE_CSE").
+	WithValue("ExternalStageAzureEncryptionNone", "NONE")
 
-type ExternalStageGCSEncryptionOption string
-
-var (
-	ExternalStageGCSEncryptionSSEKMS ExternalStageGCSEncryptionOption = "GCS_SSE_KMS"
-	ExternalStageGCSEncryptionNone   ExternalStageGCSEncryptionOption = "NONE"
-)
-
-type ExternalStageAzureEncryptionOption string
-
-var (
-	ExternalStageAzureEncryptionCSE  ExternalStageAzureEncryptionOption = "AZURE_CSE"
-	ExternalStageAzureEncryptionNone ExternalStageAzureEncryptionOption = "NONE"
-)
-
-type StageCopyColumnMapOption string
-
-var (
-	StageCopyColumnMapCaseSensitive   StageCopyColumnMapOption = "CASE_SENSITIVE"
-	StageCopyColumnMapCaseInsensitive StageCopyColumnMapOption = "CASE_INSENSITIVE"
-	StageCopyColumnMapCaseNone        StageCopyColumnMapOption = "NONE"
-)
+var stageCopyColumnMapOptionEnum = g.NewEnum("StageCopyColumnMapOption").
+	WithValue("StageCopyColumnMapCaseSensitive", "CASE_SENSITIVE").
+	WithValue("StageCopyColumnMapCaseInsensitive", "CASE_INSENSITIVE").
+	WithValue("StageCopyColumnMapCaseNone", "NONE")
 
 func createStageOperation(structName string, apply func(qs *g.QueryStruct) *g.QueryStruct) *g.QueryStruct {
 	qs := g.NewQueryStruct(structName).
@@ -67,6 +52,7 @@ func alterStageOperation(structName string, apply func(qs *g.QueryStruct) *g.Que
 		Name().
 		SQL("SET")
 	qs = apply(qs)
+	// TODO (SNOW-1019005): Move parameters below to the AlterStageOptions as they're common across stage types (+ remove from other alter option structs)
 	return qs.
 		OptionalQueryStructField("FileFormat", stageFileFormatDef, g.ListOptions().Parentheses().SQL("FILE_FORMAT =")).
 		OptionalQueryStructField("CopyOptions", stageCopyOptionsDef, g.ListOptions().Parentheses().NoComma().SQL("COPY_OPTIONS =")).
@@ -77,14 +63,14 @@ func alterStageOperation(structName string, apply func(qs *g.QueryStruct) *g.Que
 var stageFileFormatDef = g.NewQueryStruct("StageFileFormat").
 	OptionalTextAssignment("FORMAT_NAME", g.ParameterOptions().SingleQuotes()).
 	OptionalAssignment("TYPE", g.KindOfTPointer[FileFormatType](), g.ParameterOptions()).
-	PredefinedQueryStructField("Options", g.KindOfTPointer[FileFormatTypeOptions](), g.ListOptions().NoComma())
+	PredefinedQueryStructFieldWithRequest("Options", g.KindOfTPointer[FileFormatTypeOptions](), g.ListOptions().NoComma())
 
 var stageCopyOptionsDef = g.NewQueryStruct("StageCopyOptions").
 	OptionalQueryStructField(
 		"OnError",
 		g.NewQueryStruct("StageCopyOnErrorOptions").
 			OptionalSQL("CONTINUE").
-			OptionalSQL("SKIP_FILE").
+			PredefinedQueryStructField("SkipFile", "*string", g.KeywordOptions()).
 			// OptionalSQL("SKIP_FILE_n"). // TODO templated value - not even supported by structToSQL (could be keyword without space in-between)
 			// OptionalSQL("SKIP_FILE_n%"). // TODO templated value with % - not even supported by structToSQL (could be keyword without space in-between)
 			OptionalSQL("ABORT_STATEMENT"),
@@ -93,7 +79,7 @@ var stageCopyOptionsDef = g.NewQueryStruct("StageCopyOptions").
 	OptionalNumberAssignment("SIZE_LIMIT", nil).
 	OptionalBooleanAssignment("PURGE", nil).
 	OptionalBooleanAssignment("RETURN_FAILED_ONLY", nil).
-	OptionalAssignment("MATCH_BY_COLUMN_NAME", g.KindOfTPointer[StageCopyColumnMapOption](), nil).
+	OptionalAssignment("MATCH_BY_COLUMN_NAME", stageCopyColumnMapOptionEnum.KindPointer(), nil).
 	OptionalBooleanAssignment("ENFORCE_LENGTH", nil).
 	OptionalBooleanAssignment("TRUNCATECOLUMNS", nil).
 	OptionalBooleanAssignment("FORCE", nil)
@@ -104,17 +90,17 @@ var externalS3StageParamsDef = g.NewQueryStruct("ExternalS3StageParams").
 	OptionalQueryStructField(
 		"Credentials",
 		g.NewQueryStruct("ExternalStageS3Credentials").
-			OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes()).
-			OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes()).
-			OptionalTextAssignment("AWS_TOKEN", g.ParameterOptions().SingleQuotes()).
-			OptionalTextAssignment("AWS_ROLE", g.ParameterOptions().SingleQuotes()).
-			WithValidation(g.ConflictingFields, "AwsKeyId", "AwsRole"),
+			PredefinedQueryStructField("AWSKeyId", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_KEY_ID")).
+			PredefinedQueryStructField("AWSSecretKey", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_SECRET_KEY")).
+			PredefinedQueryStructField("AWSToken", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_TOKEN")).
+			PredefinedQueryStructField("AWSRole", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_ROLE")).
+			WithValidation(g.ConflictingFields, "AWSKeyId", "AWSRole"),
 		g.ListOptions().Parentheses().NoComma().SQL("CREDENTIALS ="),
 	).
 	OptionalQueryStructField("Encryption", g.NewQueryStruct("ExternalStageS3Encryption").
 		OptionalAssignment(
 			"TYPE",
-			g.KindOfT[ExternalStageS3EncryptionOption](),
+			externalStageS3EncryptionOptionEnum.Kind(),
 			g.ParameterOptions().SingleQuotes().Required(),
 		).
 		OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes()).
@@ -131,7 +117,7 @@ var externalGCSStageParamsDef = g.NewQueryStruct("ExternalGCSStageParams").
 		g.NewQueryStruct("ExternalStageGCSEncryption").
 			OptionalAssignment(
 				"TYPE",
-				g.KindOfT[ExternalStageGCSEncryptionOption](),
+				externalStageGCSEncryptionOptionEnum.Kind(),
 				g.ParameterOptions().SingleQuotes().Required(),
 			).
 			OptionalTextAssignment("KMS_KEY_ID", g.ParameterOptions().SingleQuotes()),
@@ -152,7 +138,7 @@ var externalAzureStageParamsDef = g.NewQueryStruct("ExternalAzureStageParams").
 		g.NewQueryStruct("ExternalStageAzureEncryption").
 			OptionalAssignment(
 				"TYPE",
-				g.KindOfT[ExternalStageAzureEncryptionOption](),
+				externalStageAzureEncryptionOptionEnum.Kind(),
 				g.ParameterOptions().SingleQuotes().Required(),
 			).
 			OptionalTextAssignment("MASTER_KEY", g.ParameterOptions().SingleQuotes()),
@@ -165,6 +151,13 @@ var StagesDef = g.NewInterface(
 	"Stage",
 	g.KindOfT[SchemaObjectIdentifier](),
 ).
+	WithEnums(
+		internalStageEncryptionOptionEnum,
+		externalStageS3EncryptionOptionEnum,
+		externalStageGCSEncryptionOptionEnum,
+		externalStageAzureEncryptionOptionEnum,
+		stageCopyColumnMapOptionEnum,
+	).
 	CustomOperation(
 		"CreateInternal",
 		"https://docs.snowflake.com/en/sql-reference/sql/create-stage",
@@ -175,7 +168,7 @@ var StagesDef = g.NewInterface(
 					g.NewQueryStruct("InternalStageEncryption").
 						OptionalAssignment(
 							"TYPE",
-							g.KindOfT[InternalStageEncryptionOption](),
+							internalStageEncryptionOptionEnum.Kind(),
 							g.ParameterOptions().SingleQuotes().Required(),
 						),
 					g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
@@ -249,8 +242,8 @@ var StagesDef = g.NewInterface(
 				OptionalQueryStructField(
 					"Credentials",
 					g.NewQueryStruct("ExternalStageS3CompatibleCredentials").
-						OptionalTextAssignment("AWS_KEY_ID", g.ParameterOptions().SingleQuotes().Required()).
-						OptionalTextAssignment("AWS_SECRET_KEY", g.ParameterOptions().SingleQuotes().Required()),
+						PredefinedQueryStructField("AWSKeyId", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_KEY_ID").Required()).
+						PredefinedQueryStructField("AWSSecretKey", "*string", g.ParameterOptions().SingleQuotes().SQL("AWS_SECRET_KEY").Required()),
 					g.ListOptions().Parentheses().NoComma().SQL("CREDENTIALS ="),
 				).
 				// TODO: Can be used with compat ?
@@ -272,8 +265,8 @@ var StagesDef = g.NewInterface(
 			IfExists().
 			Name().
 			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
-			NamedList("SET TAG", g.KindOfT[TagAssociation]()).
-			NamedList("UNSET TAG", g.KindOfT[ObjectIdentifier]()).
+			OptionalSetTags().
+			OptionalUnsetTags().
 			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
 			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetTags", "UnsetTags").
 			WithValidation(g.ConflictingFields, "IfExists", "UnsetTags").
@@ -398,4 +391,4 @@ var StagesDef = g.NewInterface(
 			OptionalLike().
 			OptionalIn(),
 	).
-	ShowByIdOperation()
+	ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering)
@@ -261,3 +261,6 @@ type ShowStageRequest struct {
 	Like *Like
 	In   *In
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
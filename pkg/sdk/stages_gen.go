@@ -3,6 +3,8 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -22,6 +24,135 @@ type Stages interface {
 	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]StageProperty, error)
 	Show(ctx context.Context, request *ShowStageRequest) ([]Stage, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stage, error)
+	// generator:protected-begin Stages methods
+	// generator:protected-end
+}
+
+type InternalStageEncryptionOption string
+
+const (
+	InternalStageEncryptionFull InternalStageEncryptionOption = "SNOWFLAKE_FULL"
+	InternalStageEncryptionSSE  InternalStageEncryptionOption = "SNOWFLAKE_SSE"
+)
+
+var AllInternalStageEncryptionOptions = []InternalStageEncryptionOption{
+	InternalStageEncryptionFull,
+	InternalStageEncryptionSSE,
+}
+
+func ToInternalStageEncryptionOption(s string) (InternalStageEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case "SNOWFLAKE_FULL":
+		return InternalStageEncryptionFull, nil
+	case "SNOWFLAKE_SSE":
+		return InternalStageEncryptionSSE, nil
+	default:
+		return "", fmt.Errorf("invalid InternalStageEncryptionOption: %s", s)
+	}
+}
+
+type ExternalStageS3EncryptionOption string
+
+const (
+	ExternalStageS3EncryptionCSE    ExternalStageS3EncryptionOption = "AWS_CSE"
+	ExternalStageS3EncryptionSSES3  ExternalStageS3EncryptionOption = "AWS_SSE_S3"
+	ExternalStageS3EncryptionSSEKMS ExternalStageS3EncryptionOption = "AWS_SSE_KMS"
+	ExternalStageS3EncryptionNone   ExternalStageS3EncryptionOption = "NONE"
+)
+
+var AllExternalStageS3EncryptionOptions = []ExternalStageS3EncryptionOption{
+	ExternalStageS3EncryptionCSE,
+	ExternalStageS3EncryptionSSES3,
+	ExternalStageS3EncryptionSSEKMS,
+	ExternalStageS3EncryptionNone,
+}
+
+func ToExternalStageS3EncryptionOption(s string) (ExternalStageS3EncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case "AWS_CSE":
+		return ExternalStageS3EncryptionCSE, nil
+	case "AWS_SSE_S3":
+		return ExternalStageS3EncryptionSSES3, nil
+	case "AWS_SSE_KMS":
+		return ExternalStageS3EncryptionSSEKMS, nil
+	case "NONE":
+		return ExternalStageS3EncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid ExternalStageS3EncryptionOption: %s", s)
+	}
+}
+
+type ExternalStageGCSEncryptionOption string
+
+const (
+	ExternalStageGCSEncryptionSSEKMS ExternalStageGCSEncryptionOption = "GCS_SSE_KMS"
+	ExternalStageGCSEncryptionNone   ExternalStageGCSEncryptionOption = "NONE"
+)
+
+var AllExternalStageGCSEncryptionOptions = []ExternalStageGCSEncryptionOption{
+	ExternalStageGCSEncryptionSSEKMS,
+	ExternalStageGCSEncryptionNone,
+}
+
+func ToExternalStageGCSEncryptionOption(s string) (ExternalStageGCSEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case "GCS_SSE_KMS":
+		return ExternalStageGCSEncryptionSSEKMS, nil
+	case "NONE":
+		return ExternalStageGCSEncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid ExternalStageGCSEncryptionOption: %s", s)
+	}
+}
+
+type ExternalStageAzureEncryptionOption string
+
+const (
+	ExternalStageAzureEncryptionCSE  ExternalStageAzureEncryptionOption = "AZURE_CSE"
+	ExternalStageAzureEncryptionNone ExternalStageAzureEncryptionOption = "NONE"
+)
+
+var AllExternalStageAzureEncryptionOptions = []ExternalStageAzureEncryptionOption{
+	ExternalStageAzureEncryptionCSE,
+	ExternalStageAzureEncryptionNone,
+}
+
+func ToExternalStageAzureEncryptionOption(s string) (ExternalStageAzureEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case "AZURE_CSE":
+		return ExternalStageAzureEncryptionCSE, nil
+	case "NONE":
+		return ExternalStageAzureEncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid ExternalStageAzureEncryptionOption: %s", s)
+	}
+}
+
+type StageCopyColumnMapOption string
+
+const (
+	StageCopyColumnMapCaseSensitive   StageCopyColumnMapOption = "CASE_SENSITIVE"
+	StageCopyColumnMapCaseInsensitive StageCopyColumnMapOption = "CASE_INSENSITIVE"
+	StageCopyColumnMapCaseNone        StageCopyColumnMapOption = "NONE"
+)
+
+var AllStageCopyColumnMapOptions = []StageCopyColumnMapOption{
+	StageCopyColumnMapCaseSensitive,
+	StageCopyColumnMapCaseInsensitive,
+	StageCopyColumnMapCaseNone,
+}
+
+func ToStageCopyColumnMapOption(s string) (StageCopyColumnMapOption, error) {
+	switch strings.ToUpper(s) {
+	case "CASE_SENSITIVE":
+		return StageCopyColumnMapCaseSensitive, nil
+	case "CASE_INSENSITIVE":
+		return StageCopyColumnMapCaseInsensitive, nil
+	case "NONE":
+		return StageCopyColumnMapCaseNone, nil
+	default:
+		return "", fmt.Errorf("invalid StageCopyColumnMapOption: %s", s)
+	}
 }
 
 // CreateInternalStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-stage.
@@ -223,15 +354,14 @@ type AlterStageOptions struct {
 
 // AlterInternalStageStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-stage.
 type AlterInternalStageStageOptions struct {
-	alter    bool                   `ddl:"static" sql:"ALTER"`
-	stage    bool                   `ddl:"static" sql:"STAGE"`
-	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
-	name     SchemaObjectIdentifier `ddl:"identifier"`
-	set      bool                   `ddl:"static" sql:"SET"`
-	// TODO (SNOW-1019005): Move parameters below to the AlterStageOptions as they're common across stage types (+ remove from other alter option structs)
-	FileFormat  *StageFileFormat  `ddl:"list,parentheses" sql:"FILE_FORMAT ="`
-	CopyOptions *StageCopyOptions `ddl:"list,parentheses,no_comma" sql:"COPY_OPTIONS ="`
-	Comment     *string           `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	alter       bool                   `ddl:"static" sql:"ALTER"`
+	stage       bool                   `ddl:"static" sql:"STAGE"`
+	IfExists    *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	set         bool                   `ddl:"static" sql:"SET"`
+	FileFormat  *StageFileFormat       `ddl:"list,parentheses" sql:"FILE_FORMAT ="`
+	CopyOptions *StageCopyOptions      `ddl:"list,parentheses,no_comma" sql:"COPY_OPTIONS ="`
+	Comment     *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 // AlterExternalS3StageStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-stage.
@@ -368,6 +498,7 @@ type Stage struct {
 	DirectoryEnabled   bool
 }
 
+// generator:protected-begin declarations
 func (s *Stage) ID() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(s.DatabaseName, s.SchemaName, s.Name)
 }
@@ -379,3 +510,5 @@ func (s *Stage) ObjectType() ObjectType {
 func (s *Stage) Location() string {
 	return "@" + s.ID().FullyQualifiedName()
 }
+
+// generator:protected-end
@@ -40,7 +40,7 @@ func TestStages_CreateInternal(t *testing.T) {
 		opts.Temporary = Bool(true)
 		opts.IfNotExists = Bool(true)
 		opts.Encryption = &InternalStageEncryption{
-			Type: &InternalStageEncryptionFull,
+			Type: Pointer(InternalStageEncryptionFull),
 		}
 		opts.DirectoryTableOptions = &InternalDirectoryTableOptions{
 			Enable:          Bool(true),
@@ -56,7 +56,7 @@ func TestStages_CreateInternal(t *testing.T) {
 			SizeLimit:         Int(123),
 			Purge:             Bool(true),
 			ReturnFailedOnly:  Bool(true),
-			MatchByColumnName: &StageCopyColumnMapCaseNone,
+			MatchByColumnName: Pointer(StageCopyColumnMapCaseNone),
 			EnforceLength:     Bool(true),
 			Truncatecolumns:   Bool(true),
 			Force:             Bool(true),
@@ -126,7 +126,7 @@ func TestStages_CreateOnS3(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageS3Encryption{
-				Type:      &ExternalStageS3EncryptionCSE,
+				Type:      Pointer(ExternalStageS3EncryptionCSE),
 				MasterKey: String("master-key"),
 			},
 		}
@@ -189,7 +189,7 @@ func TestStages_CreateOnGCS(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageGCSEncryption{
-				Type:     &ExternalStageGCSEncryptionSSEKMS,
+				Type:     Pointer(ExternalStageGCSEncryptionSSEKMS),
 				KmsKeyId: String("kms-key-id"),
 			},
 		}
@@ -250,7 +250,7 @@ func TestStages_CreateOnAzure(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageAzureEncryption{
-				Type:      &ExternalStageAzureEncryptionCSE,
+				Type:      Pointer(ExternalStageAzureEncryptionCSE),
 				MasterKey: String("master-key"),
 			},
 		}
@@ -276,7 +276,7 @@ func TestStages_CreateOnAzure(t *testing.T) {
 				AzureSasToken: "azure-sas-token",
 			},
 			Encryption: &ExternalStageAzureEncryption{
-				Type:      &ExternalStageAzureEncryptionCSE,
+				Type:      Pointer(ExternalStageAzureEncryptionCSE),
 				MasterKey: String("master-key"),
 			},
 		}
@@ -434,7 +434,7 @@ func TestStages_AlterInternalStage(t *testing.T) {
 			SizeLimit:         Int(123),
 			Purge:             Bool(true),
 			ReturnFailedOnly:  Bool(true),
-			MatchByColumnName: &StageCopyColumnMapCaseNone,
+			MatchByColumnName: Pointer(StageCopyColumnMapCaseNone),
 			EnforceLength:     Bool(true),
 			Truncatecolumns:   Bool(true),
 			Force:             Bool(true),
@@ -496,7 +496,7 @@ func TestStages_AlterExternalS3Stage(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageS3Encryption{
-				Type: &ExternalStageS3EncryptionNone,
+				Type: Pointer(ExternalStageS3EncryptionNone),
 			},
 		}
 		opts.FileFormat = &StageFileFormat{
@@ -509,7 +509,7 @@ func TestStages_AlterExternalS3Stage(t *testing.T) {
 			SizeLimit:         Int(123),
 			Purge:             Bool(true),
 			ReturnFailedOnly:  Bool(true),
-			MatchByColumnName: &StageCopyColumnMapCaseNone,
+			MatchByColumnName: Pointer(StageCopyColumnMapCaseNone),
 			EnforceLength:     Bool(true),
 			Truncatecolumns:   Bool(true),
 			Force:             Bool(true),
@@ -548,7 +548,7 @@ func TestStages_AlterExternalGCSStage(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageGCSEncryption{
-				Type: &ExternalStageGCSEncryptionNone,
+				Type: Pointer(ExternalStageGCSEncryptionNone),
 			},
 		}
 		opts.FileFormat = &StageFileFormat{
@@ -561,7 +561,7 @@ func TestStages_AlterExternalGCSStage(t *testing.T) {
 			SizeLimit:         Int(123),
 			Purge:             Bool(true),
 			ReturnFailedOnly:  Bool(true),
-			MatchByColumnName: &StageCopyColumnMapCaseNone,
+			MatchByColumnName: Pointer(StageCopyColumnMapCaseNone),
 			EnforceLength:     Bool(true),
 			Truncatecolumns:   Bool(true),
 			Force:             Bool(true),
@@ -610,7 +610,7 @@ func TestStages_AlterExternalAzureStage(t *testing.T) {
 			Url:                "some url",
 			StorageIntegration: &integrationId,
 			Encryption: &ExternalStageAzureEncryption{
-				Type: &ExternalStageAzureEncryptionNone,
+				Type: Pointer(ExternalStageAzureEncryptionNone),
 			},
 		}
 		opts.FileFormat = &StageFileFormat{
@@ -623,7 +623,7 @@ func TestStages_AlterExternalAzureStage(t *testing.T) {
 			SizeLimit:         Int(123),
 			Purge:             Bool(true),
 			ReturnFailedOnly:  Bool(true),
-			MatchByColumnName: &StageCopyColumnMapCaseNone,
+			MatchByColumnName: Pointer(StageCopyColumnMapCaseNone),
 			EnforceLength:     Bool(true),
 			Truncatecolumns:   Bool(true),
 			Force:             Bool(true),
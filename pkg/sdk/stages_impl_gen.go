@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Stages = (*stages)(nil)
 
@@ -94,17 +90,14 @@ func (v *stages) Show(ctx context.Context, request *ShowStageRequest) ([]Stage,
 }
 
 func (v *stages) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stage, error) {
-	stages, err := v.Show(ctx, NewShowStageRequest().
-		WithLike(&Like{
-			Pattern: String(id.Name()),
-		}).
-		WithIn(&In{
-			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
-		}))
+	// generator:protected-begin stages.ShowByID
+	request := NewShowStageRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
+	stages, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(stages, func(r Stage) bool { return r.Name == id.Name() })
+	return findOne(stages, func(r Stage) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (r *CreateInternalStageRequest) toOpts() *CreateInternalStageOptions {
@@ -113,9 +106,8 @@ func (r *CreateInternalStageRequest) toOpts() *CreateInternalStageOptions {
 		Temporary:   r.Temporary,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		Comment: r.Comment,
-		Tag:     r.Tag,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.Encryption != nil {
 		opts.Encryption = &InternalStageEncryption{
@@ -129,7 +121,13 @@ func (r *CreateInternalStageRequest) toOpts() *CreateInternalStageOptions {
 		}
 	}
 	if r.FileFormat != nil {
-		opts.FileFormat = r.FileFormat.toOpts()
+		opts.FileFormat = &StageFileFormat{
+			FormatName: r.FileFormat.FormatName,
+			Type:       r.FileFormat.Type,
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
+		}
 	}
 	if r.CopyOptions != nil {
 		opts.CopyOptions = &StageCopyOptions{
@@ -158,9 +156,8 @@ func (r *CreateOnS3StageRequest) toOpts() *CreateOnS3StageOptions {
 		Temporary:   r.Temporary,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		Comment: r.Comment,
-		Tag:     r.Tag,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalS3StageParams{
@@ -194,7 +191,9 @@ func (r *CreateOnS3StageRequest) toOpts() *CreateOnS3StageOptions {
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -224,9 +223,8 @@ func (r *CreateOnGCSStageRequest) toOpts() *CreateOnGCSStageOptions {
 		Temporary:   r.Temporary,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		Comment: r.Comment,
-		Tag:     r.Tag,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalGCSStageParams{
@@ -252,7 +250,9 @@ func (r *CreateOnGCSStageRequest) toOpts() *CreateOnGCSStageOptions {
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -282,9 +282,8 @@ func (r *CreateOnAzureStageRequest) toOpts() *CreateOnAzureStageOptions {
 		Temporary:   r.Temporary,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		Comment: r.Comment,
-		Tag:     r.Tag,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalAzureStageParams{
@@ -315,7 +314,9 @@ func (r *CreateOnAzureStageRequest) toOpts() *CreateOnAzureStageOptions {
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -347,9 +348,8 @@ func (r *CreateOnS3CompatibleStageRequest) toOpts() *CreateOnS3CompatibleStageOp
 		name:        r.name,
 		Url:         r.Url,
 		Endpoint:    r.Endpoint,
-
-		Comment: r.Comment,
-		Tag:     r.Tag,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
 	}
 	if r.Credentials != nil {
 		opts.Credentials = &ExternalStageS3CompatibleCredentials{
@@ -368,7 +368,9 @@ func (r *CreateOnS3CompatibleStageRequest) toOpts() *CreateOnS3CompatibleStageOp
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -407,14 +409,15 @@ func (r *AlterInternalStageStageRequest) toOpts() *AlterInternalStageStageOption
 	opts := &AlterInternalStageStageOptions{
 		IfExists: r.IfExists,
 		name:     r.name,
-
-		Comment: r.Comment,
+		Comment:  r.Comment,
 	}
 	if r.FileFormat != nil {
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -442,8 +445,7 @@ func (r *AlterExternalS3StageStageRequest) toOpts() *AlterExternalS3StageStageOp
 	opts := &AlterExternalS3StageStageOptions{
 		IfExists: r.IfExists,
 		name:     r.name,
-
-		Comment: r.Comment,
+		Comment:  r.Comment,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalS3StageParams{
@@ -470,7 +472,9 @@ func (r *AlterExternalS3StageStageRequest) toOpts() *AlterExternalS3StageStageOp
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -498,8 +502,7 @@ func (r *AlterExternalGCSStageStageRequest) toOpts() *AlterExternalGCSStageStage
 	opts := &AlterExternalGCSStageStageOptions{
 		IfExists: r.IfExists,
 		name:     r.name,
-
-		Comment: r.Comment,
+		Comment:  r.Comment,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalGCSStageParams{
@@ -517,7 +520,9 @@ func (r *AlterExternalGCSStageStageRequest) toOpts() *AlterExternalGCSStageStage
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -545,8 +550,7 @@ func (r *AlterExternalAzureStageStageRequest) toOpts() *AlterExternalAzureStageS
 	opts := &AlterExternalAzureStageStageOptions{
 		IfExists: r.IfExists,
 		name:     r.name,
-
-		Comment: r.Comment,
+		Comment:  r.Comment,
 	}
 	if r.ExternalStageParams != nil {
 		opts.ExternalStageParams = &ExternalAzureStageParams{
@@ -569,7 +573,9 @@ func (r *AlterExternalAzureStageStageRequest) toOpts() *AlterExternalAzureStageS
 		opts.FileFormat = &StageFileFormat{
 			FormatName: r.FileFormat.FormatName,
 			Type:       r.FileFormat.Type,
-			Options:    r.FileFormat.Options.toOpts(),
+		}
+		if r.FileFormat.Options != nil {
+			opts.FileFormat.Options = r.FileFormat.Options.toOpts()
 		}
 	}
 	if r.CopyOptions != nil {
@@ -627,6 +633,7 @@ func (r *DescribeStageRequest) toOpts() *DescribeStageOptions {
 }
 
 func (r stageDescRow) convert() *StageProperty {
+	// generator:protected-begin stageDescRow.convert
 	stageProp := &StageProperty{
 		Parent:  r.ParentProperty,
 		Name:    r.Property,
@@ -635,6 +642,7 @@ func (r stageDescRow) convert() *StageProperty {
 		Default: r.PropertyDefault,
 	}
 	return stageProp
+	// generator:protected-end
 }
 
 func (r *ShowStageRequest) toOpts() *ShowStageOptions {
@@ -646,6 +654,7 @@ func (r *ShowStageRequest) toOpts() *ShowStageOptions {
 }
 
 func (r stageShowRow) convert() *Stage {
+	// generator:protected-begin stageShowRow.convert
 	stage := &Stage{
 		CreatedOn:        r.CreatedOn,
 		Name:             r.Name,
@@ -675,4 +684,8 @@ func (r stageShowRow) convert() *Stage {
 		stage.OwnerRoleType = &r.OwnerRoleType.String
 	}
 	return stage
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
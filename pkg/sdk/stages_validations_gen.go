@@ -25,6 +25,8 @@ func (opts *CreateInternalStageOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateInternalStageOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateInternalStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -42,10 +44,12 @@ func (opts *CreateOnS3StageOptions) validate() error {
 		}
 		if valueSet(opts.ExternalStageParams.Credentials) {
 			if everyValueSet(opts.ExternalStageParams.Credentials.AWSKeyId, opts.ExternalStageParams.Credentials.AWSRole) {
-				errs = append(errs, errOneOf("AlterExternalS3StageStageOptions.ExternalStageParams.Credentials", "AWSKeyId", "AWSRole"))
+				errs = append(errs, errOneOf("CreateOnS3StageOptions.ExternalStageParams.Credentials", "AWSKeyId", "AWSRole"))
 			}
 		}
 	}
+	// generator:protected-begin CreateOnS3StageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -57,6 +61,8 @@ func (opts *CreateOnGCSStageOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateOnGCSStageOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateOnGCSStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -73,6 +79,8 @@ func (opts *CreateOnAzureStageOptions) validate() error {
 			errs = append(errs, errOneOf("CreateOnAzureStageOptions.ExternalStageParams", "StorageIntegration", "Credentials"))
 		}
 	}
+	// generator:protected-begin CreateOnAzureStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -84,6 +92,8 @@ func (opts *CreateOnS3CompatibleStageOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateOnS3CompatibleStageOptions", "OrReplace", "IfNotExists"))
 	}
+	// generator:protected-begin CreateOnS3CompatibleStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -104,6 +114,8 @@ func (opts *AlterStageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin AlterStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -115,6 +127,8 @@ func (opts *AlterInternalStageStageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin AlterInternalStageStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -136,6 +150,8 @@ func (opts *AlterExternalS3StageStageOptions) validate() error {
 			}
 		}
 	}
+	// generator:protected-begin AlterExternalS3StageStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -147,6 +163,8 @@ func (opts *AlterExternalGCSStageStageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin AlterExternalGCSStageStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -163,6 +181,8 @@ func (opts *AlterExternalAzureStageStageOptions) validate() error {
 			errs = append(errs, errOneOf("AlterExternalAzureStageStageOptions.ExternalStageParams", "StorageIntegration", "Credentials"))
 		}
 	}
+	// generator:protected-begin AlterExternalAzureStageStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -177,6 +197,8 @@ func (opts *AlterDirectoryTableStageOptions) validate() error {
 	if everyValueSet(opts.SetDirectory, opts.Refresh) {
 		errs = append(errs, errOneOf("AlterDirectoryTableStageOptions", "SetDirectory", "Refresh"))
 	}
+	// generator:protected-begin AlterDirectoryTableStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -188,6 +210,8 @@ func (opts *DropStageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -199,6 +223,8 @@ func (opts *DescribeStageOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -207,5 +233,10 @@ func (opts *ShowStageOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowStageOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -73,7 +73,7 @@ var StorageIntegrationDef = g.NewInterface(
 							TextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()),
 						g.KeywordOptions(),
 					).
-					BooleanAssignment("ENABLED", g.ParameterOptions()).
+					OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
 					ListAssignment("STORAGE_ALLOWED_LOCATIONS", "StorageLocation", g.ParameterOptions().Parentheses()).
 					ListAssignment("STORAGE_BLOCKED_LOCATIONS", "StorageLocation", g.ParameterOptions().Parentheses()).
 					OptionalComment(),
@@ -124,7 +124,7 @@ var StorageIntegrationDef = g.NewInterface(
 			SQL("STORAGE INTEGRATIONS").
 			OptionalLike(),
 	).
-	ShowByIdOperation().
+	ShowByIdOperationWithFiltering(g.ShowByIDLikeFiltering).
 	DescribeOperation(
 		g.DescriptionMappingKindSlice,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-integration",
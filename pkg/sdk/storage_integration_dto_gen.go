@@ -28,7 +28,8 @@ type S3StorageParamsRequest struct {
 	StorageAwsObjectAcl *string
 }
 
-type GCSStorageParamsRequest struct{}
+type GCSStorageParamsRequest struct {
+}
 
 type AzureStorageParamsRequest struct {
 	AzureTenantId *string // required
@@ -80,3 +81,6 @@ type ShowStorageIntegrationRequest struct {
 type DescribeStorageIntegrationRequest struct {
 	name AccountObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
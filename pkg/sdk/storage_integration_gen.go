@@ -13,6 +13,8 @@ type StorageIntegrations interface {
 	Show(ctx context.Context, request *ShowStorageIntegrationRequest) ([]StorageIntegration, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegration, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) ([]StorageIntegrationProperty, error)
+	// generator:protected-begin StorageIntegrations methods
+	// generator:protected-end
 }
 
 // CreateStorageIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-storage-integration.
@@ -141,3 +143,6 @@ type StorageIntegrationProperty struct {
 	Value   string
 	Default string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
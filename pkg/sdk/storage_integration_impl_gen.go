@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ StorageIntegrations = (*storageIntegrations)(nil)
 
@@ -38,13 +34,14 @@ func (v *storageIntegrations) Show(ctx context.Context, request *ShowStorageInte
 }
 
 func (v *storageIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegration, error) {
-	storageIntegrations, err := v.Show(ctx, NewShowStorageIntegrationRequest().WithLike(&Like{
-		Pattern: String(id.Name()),
-	}))
+	// generator:protected-begin storageIntegrations.ShowByID
+	request := NewShowStorageIntegrationRequest().WithLike(&Like{Pattern: String(id.Name())})
+	storageIntegrations, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(storageIntegrations, func(r StorageIntegration) bool { return r.Name == id.Name() })
+	return findOne(storageIntegrations, func(r StorageIntegration) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *storageIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]StorageIntegrationProperty, error) {
@@ -60,10 +57,9 @@ func (v *storageIntegrations) Describe(ctx context.Context, id AccountObjectIden
 
 func (r *CreateStorageIntegrationRequest) toOpts() *CreateStorageIntegrationOptions {
 	opts := &CreateStorageIntegrationOptions{
-		OrReplace:   r.OrReplace,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
+		OrReplace:               r.OrReplace,
+		IfNotExists:             r.IfNotExists,
+		name:                    r.name,
 		Enabled:                 r.Enabled,
 		StorageAllowedLocations: r.StorageAllowedLocations,
 		StorageBlockedLocations: r.StorageBlockedLocations,
@@ -88,9 +84,8 @@ func (r *CreateStorageIntegrationRequest) toOpts() *CreateStorageIntegrationOpti
 
 func (r *AlterStorageIntegrationRequest) toOpts() *AlterStorageIntegrationOptions {
 	opts := &AlterStorageIntegrationOptions{
-		IfExists: r.IfExists,
-		name:     r.name,
-
+		IfExists:  r.IfExists,
+		name:      r.name,
 		SetTags:   r.SetTags,
 		UnsetTags: r.UnsetTags,
 	}
@@ -140,6 +135,7 @@ func (r *ShowStorageIntegrationRequest) toOpts() *ShowStorageIntegrationOptions
 }
 
 func (r showStorageIntegrationsDbRow) convert() *StorageIntegration {
+	// generator:protected-begin showStorageIntegrationsDbRow.convert
 	s := &StorageIntegration{
 		Name:        r.Name,
 		StorageType: r.Type,
@@ -151,6 +147,7 @@ func (r showStorageIntegrationsDbRow) convert() *StorageIntegration {
 		s.Comment = r.Comment.String
 	}
 	return s
+	// generator:protected-end
 }
 
 func (r *DescribeStorageIntegrationRequest) toOpts() *DescribeStorageIntegrationOptions {
@@ -161,10 +158,15 @@ func (r *DescribeStorageIntegrationRequest) toOpts() *DescribeStorageIntegration
 }
 
 func (r descStorageIntegrationsDbRow) convert() *StorageIntegrationProperty {
+	// generator:protected-begin descStorageIntegrationsDbRow.convert
 	return &StorageIntegrationProperty{
 		Name:    r.Property,
 		Type:    r.PropertyType,
 		Value:   r.PropertyValue,
 		Default: r.PropertyDefault,
 	}
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -22,6 +22,8 @@ func (opts *CreateStorageIntegrationOptions) validate() error {
 	if !exactlyOneValueSet(opts.S3StorageProviderParams, opts.GCSStorageProviderParams, opts.AzureStorageProviderParams) {
 		errs = append(errs, errExactlyOneOf("CreateStorageIntegrationOptions", "S3StorageProviderParams", "GCSStorageProviderParams", "AzureStorageProviderParams"))
 	}
+	// generator:protected-begin CreateStorageIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -39,6 +41,8 @@ func (opts *AlterStorageIntegrationOptions) validate() error {
 	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
 		errs = append(errs, errExactlyOneOf("AlterStorageIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
 	}
+	// generator:protected-begin AlterStorageIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -50,6 +54,8 @@ func (opts *DropStorageIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropStorageIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -58,6 +64,8 @@ func (opts *ShowStorageIntegrationOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowStorageIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -69,5 +77,10 @@ func (opts *DescribeStorageIntegrationOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeStorageIntegrationOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
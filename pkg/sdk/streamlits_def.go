@@ -9,8 +9,15 @@ var streamlitSet = g.NewQueryStruct("StreamlitSet").
 	OptionalTextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes().Required()).
 	OptionalIdentifier("Warehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
 	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 	WithValidation(g.ValidIdentifierIfSet, "Warehouse")
 
+var streamlitUnset = g.NewQueryStruct("StreamlitUnset").
+	OptionalSQL("QUERY_WAREHOUSE").
+	OptionalSQL("COMMENT").
+	OptionalSQL("TITLE").
+	WithValidation(g.AtLeastOneValueSet, "QueryWarehouse", "Comment", "Title")
+
 var StreamlitsDef = g.NewInterface(
 	"Streamlits",
 	"Streamlit",
@@ -27,6 +34,7 @@ var StreamlitsDef = g.NewInterface(
 		TextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes().Required()).
 		OptionalIdentifier("Warehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "Warehouse").
 		WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
@@ -42,10 +50,15 @@ var StreamlitsDef = g.NewInterface(
 			streamlitSet,
 			g.KeywordOptions().SQL("SET"),
 		).
+		OptionalQueryStructField(
+			"Unset",
+			streamlitUnset,
+			g.ListOptions().SQL("UNSET"),
+		).
 		Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-streamlit",
 	g.NewQueryStruct("DropStreamlit").
@@ -85,7 +98,7 @@ var StreamlitsDef = g.NewInterface(
 		OptionalLike().
 		OptionalIn().
 		OptionalLimit(),
-).ShowByIdOperation().DescribeOperation(
+).ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).DescribeOperation(
 	g.DescriptionMappingKindSingleValue,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-streamlit",
 	g.DbStruct("streamlitsDetailRow").
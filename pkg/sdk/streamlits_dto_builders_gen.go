@@ -36,6 +36,11 @@ func (s *CreateStreamlitRequest) WithComment(Comment *string) *CreateStreamlitRe
 	return s
 }
 
+func (s *CreateStreamlitRequest) WithTitle(Title *string) *CreateStreamlitRequest {
+	s.Title = Title
+	return s
+}
+
 func NewAlterStreamlitRequest(
 	name SchemaObjectIdentifier,
 ) *AlterStreamlitRequest {
@@ -54,6 +59,11 @@ func (s *AlterStreamlitRequest) WithSet(Set *StreamlitSetRequest) *AlterStreamli
 	return s
 }
 
+func (s *AlterStreamlitRequest) WithUnset(Unset *StreamlitUnsetRequest) *AlterStreamlitRequest {
+	s.Unset = Unset
+	return s
+}
+
 func (s *AlterStreamlitRequest) WithRenameTo(RenameTo *SchemaObjectIdentifier) *AlterStreamlitRequest {
 	s.RenameTo = RenameTo
 	return s
@@ -79,6 +89,30 @@ func (s *StreamlitSetRequest) WithComment(Comment *string) *StreamlitSetRequest
 	return s
 }
 
+func (s *StreamlitSetRequest) WithTitle(Title *string) *StreamlitSetRequest {
+	s.Title = Title
+	return s
+}
+
+func NewStreamlitUnsetRequest() *StreamlitUnsetRequest {
+	return &StreamlitUnsetRequest{}
+}
+
+func (s *StreamlitUnsetRequest) WithQueryWarehouse(QueryWarehouse *bool) *StreamlitUnsetRequest {
+	s.QueryWarehouse = QueryWarehouse
+	return s
+}
+
+func (s *StreamlitUnsetRequest) WithComment(Comment *bool) *StreamlitUnsetRequest {
+	s.Comment = Comment
+	return s
+}
+
+func (s *StreamlitUnsetRequest) WithTitle(Title *bool) *StreamlitUnsetRequest {
+	s.Title = Title
+	return s
+}
+
 func NewDropStreamlitRequest(
 	name SchemaObjectIdentifier,
 ) *DropStreamlitRequest {
@@ -18,12 +18,14 @@ type CreateStreamlitRequest struct {
 	MainFile     string                 // required
 	Warehouse    *AccountObjectIdentifier
 	Comment      *string
+	Title        *string
 }
 
 type AlterStreamlitRequest struct {
 	IfExists *bool
 	name     SchemaObjectIdentifier // required
 	Set      *StreamlitSetRequest
+	Unset    *StreamlitUnsetRequest
 	RenameTo *SchemaObjectIdentifier
 }
 
@@ -32,6 +34,13 @@ type StreamlitSetRequest struct {
 	MainFile     *string // required
 	Warehouse    *AccountObjectIdentifier
 	Comment      *string
+	Title        *string
+}
+
+type StreamlitUnsetRequest struct {
+	QueryWarehouse *bool
+	Comment        *bool
+	Title          *bool
 }
 
 type DropStreamlitRequest struct {
@@ -49,3 +58,6 @@ type ShowStreamlitRequest struct {
 type DescribeStreamlitRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -12,6 +12,8 @@ type Streamlits interface {
 	Show(ctx context.Context, request *ShowStreamlitRequest) ([]Streamlit, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetail, error)
+	// generator:protected-begin Streamlits methods
+	// generator:protected-end
 }
 
 // CreateStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-streamlit.
@@ -25,6 +27,7 @@ type CreateStreamlitOptions struct {
 	MainFile     string                   `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
 	Warehouse    *AccountObjectIdentifier `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
 	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Title        *string                  `ddl:"parameter,single_quotes" sql:"TITLE"`
 }
 
 // AlterStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-streamlit.
@@ -34,6 +37,7 @@ type AlterStreamlitOptions struct {
 	IfExists  *bool                   `ddl:"keyword" sql:"IF EXISTS"`
 	name      SchemaObjectIdentifier  `ddl:"identifier"`
 	Set       *StreamlitSet           `ddl:"keyword" sql:"SET"`
+	Unset     *StreamlitUnset         `ddl:"list" sql:"UNSET"`
 	RenameTo  *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
 }
 
@@ -42,6 +46,13 @@ type StreamlitSet struct {
 	MainFile     *string                  `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
 	Warehouse    *AccountObjectIdentifier `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
 	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Title        *string                  `ddl:"parameter,single_quotes" sql:"TITLE"`
+}
+
+type StreamlitUnset struct {
+	QueryWarehouse *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+	Comment        *bool `ddl:"keyword" sql:"COMMENT"`
+	Title          *bool `ddl:"keyword" sql:"TITLE"`
 }
 
 // DropStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-streamlit.
@@ -112,3 +123,6 @@ type StreamlitDetail struct {
 	QueryWarehouse string
 	UrlId          string
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
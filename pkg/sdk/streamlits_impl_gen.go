@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Streamlits = (*streamlits)(nil)
 
@@ -38,12 +34,14 @@ func (v *streamlits) Show(ctx context.Context, request *ShowStreamlitRequest) ([
 }
 
 func (v *streamlits) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error) {
-	request := NewShowStreamlitRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin streamlits.ShowByID
+	request := NewShowStreamlitRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	streamlits, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(streamlits, func(r Streamlit) bool { return r.Name == id.Name() })
+	return findOne(streamlits, func(r Streamlit) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *streamlits) Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetail, error) {
@@ -66,6 +64,7 @@ func (r *CreateStreamlitRequest) toOpts() *CreateStreamlitOptions {
 		MainFile:     r.MainFile,
 		Warehouse:    r.Warehouse,
 		Comment:      r.Comment,
+		Title:        r.Title,
 	}
 	return opts
 }
@@ -74,7 +73,6 @@ func (r *AlterStreamlitRequest) toOpts() *AlterStreamlitOptions {
 	opts := &AlterStreamlitOptions{
 		IfExists: r.IfExists,
 		name:     r.name,
-
 		RenameTo: r.RenameTo,
 	}
 	if r.Set != nil {
@@ -83,6 +81,14 @@ func (r *AlterStreamlitRequest) toOpts() *AlterStreamlitOptions {
 			MainFile:     r.Set.MainFile,
 			Warehouse:    r.Set.Warehouse,
 			Comment:      r.Set.Comment,
+			Title:        r.Set.Title,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &StreamlitUnset{
+			QueryWarehouse: r.Unset.QueryWarehouse,
+			Comment:        r.Unset.Comment,
+			Title:          r.Unset.Title,
 		}
 	}
 	return opts
@@ -107,6 +113,7 @@ func (r *ShowStreamlitRequest) toOpts() *ShowStreamlitOptions {
 }
 
 func (r streamlitsRow) convert() *Streamlit {
+	// generator:protected-begin streamlitsRow.convert
 	e := &Streamlit{
 		CreatedOn:     r.CreatedOn,
 		Name:          r.Name,
@@ -126,6 +133,7 @@ func (r streamlitsRow) convert() *Streamlit {
 		e.QueryWarehouse = r.QueryWarehouse.String
 	}
 	return e
+	// generator:protected-end
 }
 
 func (r *DescribeStreamlitRequest) toOpts() *DescribeStreamlitOptions {
@@ -136,6 +144,7 @@ func (r *DescribeStreamlitRequest) toOpts() *DescribeStreamlitOptions {
 }
 
 func (r streamlitsDetailRow) convert() *StreamlitDetail {
+	// generator:protected-begin streamlitsDetailRow.convert
 	e := &StreamlitDetail{
 		Name:         r.Name,
 		RootLocation: r.RootLocation,
@@ -149,4 +158,8 @@ func (r streamlitsDetailRow) convert() *StreamlitDetail {
 		e.QueryWarehouse = r.QueryWarehouse.String
 	}
 	return e
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
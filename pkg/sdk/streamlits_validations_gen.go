@@ -22,6 +22,8 @@ func (opts *CreateStreamlitOptions) validate() error {
 	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
 		errs = append(errs, errOneOf("CreateStreamlitOptions", "IfNotExists", "OrReplace"))
 	}
+	// generator:protected-begin CreateStreamlitOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -36,14 +38,21 @@ func (opts *AlterStreamlitOptions) validate() error {
 	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if !exactlyOneValueSet(opts.RenameTo, opts.Set) {
-		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set"))
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset"))
 	}
 	if valueSet(opts.Set) {
 		if opts.Set.Warehouse != nil && !ValidObjectIdentifier(opts.Set.Warehouse) {
 			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
 	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.QueryWarehouse, opts.Unset.Comment, opts.Unset.Title) {
+			errs = append(errs, errAtLeastOneOf("AlterStreamlitOptions.Unset", "QueryWarehouse", "Comment", "Title"))
+		}
+	}
+	// generator:protected-begin AlterStreamlitOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -55,6 +64,8 @@ func (opts *DropStreamlitOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropStreamlitOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -63,9 +74,11 @@ func (opts *ShowStreamlitOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowStreamlitOptions.validate
 	if valueSet(opts.Like) && !valueSet(opts.Like.Pattern) {
 		errs = append(errs, ErrPatternRequiredForLikeKeyword)
 	}
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -77,5 +90,10 @@ func (opts *DescribeStreamlitOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeStreamlitOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -186,7 +186,7 @@ var (
 				OptionalStartsWith().
 				OptionalLimit(),
 		).
-		ShowByIdOperation().
+		ShowByIdOperationWithFiltering(g.ShowByIDInFiltering, g.ShowByIDLikeFiltering).
 		DescribeOperation(
 			g.DescriptionMappingKindSingleValue,
 			"https://docs.snowflake.com/en/sql-reference/sql/desc-stream",
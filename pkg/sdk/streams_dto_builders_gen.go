@@ -300,14 +300,6 @@ func (s *ShowStreamRequest) WithLimit(Limit *LimitFrom) *ShowStreamRequest {
 	return s
 }
 
-func NewShowByIdStreamRequest(
-	name SchemaObjectIdentifier,
-) *ShowByIdStreamRequest {
-	s := ShowByIdStreamRequest{}
-	s.name = name
-	return &s
-}
-
 func NewDescribeStreamRequest(
 	name SchemaObjectIdentifier,
 ) *DescribeStreamRequest {
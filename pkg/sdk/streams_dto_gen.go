@@ -100,10 +100,9 @@ type ShowStreamRequest struct {
 	Limit      *LimitFrom
 }
 
-type ShowByIdStreamRequest struct {
-	name SchemaObjectIdentifier // required
-}
-
 type DescribeStreamRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -15,8 +15,10 @@ type Streams interface {
 	Alter(ctx context.Context, request *AlterStreamRequest) error
 	Drop(ctx context.Context, request *DropStreamRequest) error
 	Show(ctx context.Context, request *ShowStreamRequest) ([]Stream, error)
-	ShowByID(ctx context.Context, request *ShowByIdStreamRequest) (*Stream, error)
-	Describe(ctx context.Context, request *DescribeStreamRequest) (*Stream, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error)
+	// generator:protected-begin Streams methods
+	// generator:protected-end
 }
 
 // CreateOnTableStreamOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-stream.
@@ -129,7 +131,7 @@ type ShowStreamOptions struct {
 	streams    bool       `ddl:"static" sql:"STREAMS"`
 	Like       *Like      `ddl:"keyword" sql:"LIKE"`
 	In         *In        `ddl:"keyword" sql:"IN"`
-	StartsWith *string    `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
 	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
 
@@ -177,3 +179,6 @@ type DescribeStreamOptions struct {
 	stream   bool                   `ddl:"static" sql:"STREAM"`
 	name     SchemaObjectIdentifier `ddl:"identifier"`
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -0,0 +1,172 @@
+package sdk
+
+import "testing"
+
+func TestStreams_GoldenSQL(t *testing.T) {
+	t.Run("CreateOnTable", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOnTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "TableId")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOnTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "OrReplace")
+			clearSQLFixtureField(opts, "On.Before")
+			clearSQLFixtureField(opts, "On.Statement.Offset")
+			clearSQLFixtureField(opts, "On.Statement.Statement")
+			clearSQLFixtureField(opts, "On.Statement.Stream")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateOnExternalTable", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOnExternalTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ExternalTableId")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOnExternalTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "OrReplace")
+			clearSQLFixtureField(opts, "On.Before")
+			clearSQLFixtureField(opts, "On.Statement.Offset")
+			clearSQLFixtureField(opts, "On.Statement.Statement")
+			clearSQLFixtureField(opts, "On.Statement.Stream")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateOnDirectoryTable", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOnDirectoryTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "StageId")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOnDirectoryTableStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "OrReplace")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateOnView", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateOnViewStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "ViewId")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateOnViewStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "OrReplace")
+			clearSQLFixtureField(opts, "On.Before")
+			clearSQLFixtureField(opts, "On.Statement.Offset")
+			clearSQLFixtureField(opts, "On.Statement.Statement")
+			clearSQLFixtureField(opts, "On.Statement.Stream")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Clone", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CloneStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CloneStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		t.Run("SetComment", func(t *testing.T) {
+			opts := &AlterStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetComment", func(t *testing.T) {
+			opts := &AlterStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetComment")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			opts := &AlterStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "SetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			opts := &AlterStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "UnsetTags")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowStreamOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowStreamOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DescribeStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DescribeStreamOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+}
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Streams = (*streams)(nil)
 
@@ -57,21 +53,23 @@ func (v *streams) Show(ctx context.Context, request *ShowStreamRequest) ([]Strea
 	return resultList, nil
 }
 
-func (v *streams) ShowByID(ctx context.Context, request *ShowByIdStreamRequest) (*Stream, error) {
+func (v *streams) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error) {
+	// generator:protected-begin streams.ShowByID
 	streams, err := v.Show(ctx, NewShowStreamRequest().
 		WithIn(&In{
-			Schema: NewDatabaseObjectIdentifier(request.name.DatabaseName(), request.name.SchemaName()),
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
 		}).
-		WithLike(&Like{Pattern: String(request.name.Name())}))
+		WithLike(&Like{Pattern: String(id.Name())}))
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(streams, func(r Stream) bool { return r.Name == request.name.Name() })
+	return findOne(streams, func(r Stream) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
-func (v *streams) Describe(ctx context.Context, request *DescribeStreamRequest) (*Stream, error) {
+func (v *streams) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error) {
 	opts := &DescribeStreamOptions{
-		name: request.name,
+		name: id,
 	}
 	result, err := validateAndQueryOne[showStreamsDbRow](v.client, ctx, opts)
 	if err != nil {
@@ -82,12 +80,11 @@ func (v *streams) Describe(ctx context.Context, request *DescribeStreamRequest)
 
 func (r *CreateOnTableStreamRequest) toOpts() *CreateOnTableStreamOptions {
 	opts := &CreateOnTableStreamOptions{
-		OrReplace:   r.OrReplace,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-		CopyGrants:  r.CopyGrants,
-		TableId:     r.TableId,
-
+		OrReplace:       r.OrReplace,
+		IfNotExists:     r.IfNotExists,
+		name:            r.name,
+		CopyGrants:      r.CopyGrants,
+		TableId:         r.TableId,
 		AppendOnly:      r.AppendOnly,
 		ShowInitialRows: r.ShowInitialRows,
 		Comment:         r.Comment,
@@ -96,12 +93,12 @@ func (r *CreateOnTableStreamRequest) toOpts() *CreateOnTableStreamOptions {
 		opts.On = &OnStream{
 			At:     r.On.At,
 			Before: r.On.Before,
-			Statement: OnStreamStatement{
-				Timestamp: r.On.Statement.Timestamp,
-				Offset:    r.On.Statement.Offset,
-				Statement: r.On.Statement.Statement,
-				Stream:    r.On.Statement.Stream,
-			},
+		}
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
 		}
 	}
 	return opts
@@ -114,20 +111,19 @@ func (r *CreateOnExternalTableStreamRequest) toOpts() *CreateOnExternalTableStre
 		name:            r.name,
 		CopyGrants:      r.CopyGrants,
 		ExternalTableId: r.ExternalTableId,
-
-		InsertOnly: r.InsertOnly,
-		Comment:    r.Comment,
+		InsertOnly:      r.InsertOnly,
+		Comment:         r.Comment,
 	}
 	if r.On != nil {
 		opts.On = &OnStream{
 			At:     r.On.At,
 			Before: r.On.Before,
-			Statement: OnStreamStatement{
-				Timestamp: r.On.Statement.Timestamp,
-				Offset:    r.On.Statement.Offset,
-				Statement: r.On.Statement.Statement,
-				Stream:    r.On.Statement.Stream,
-			},
+		}
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
 		}
 	}
 	return opts
@@ -147,12 +143,11 @@ func (r *CreateOnDirectoryTableStreamRequest) toOpts() *CreateOnDirectoryTableSt
 
 func (r *CreateOnViewStreamRequest) toOpts() *CreateOnViewStreamOptions {
 	opts := &CreateOnViewStreamOptions{
-		OrReplace:   r.OrReplace,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-		CopyGrants:  r.CopyGrants,
-		ViewId:      r.ViewId,
-
+		OrReplace:       r.OrReplace,
+		IfNotExists:     r.IfNotExists,
+		name:            r.name,
+		CopyGrants:      r.CopyGrants,
+		ViewId:          r.ViewId,
 		AppendOnly:      r.AppendOnly,
 		ShowInitialRows: r.ShowInitialRows,
 		Comment:         r.Comment,
@@ -161,12 +156,12 @@ func (r *CreateOnViewStreamRequest) toOpts() *CreateOnViewStreamOptions {
 		opts.On = &OnStream{
 			At:     r.On.At,
 			Before: r.On.Before,
-			Statement: OnStreamStatement{
-				Timestamp: r.On.Statement.Timestamp,
-				Offset:    r.On.Statement.Offset,
-				Statement: r.On.Statement.Statement,
-				Stream:    r.On.Statement.Stream,
-			},
+		}
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
 		}
 	}
 	return opts
@@ -214,6 +209,7 @@ func (r *ShowStreamRequest) toOpts() *ShowStreamOptions {
 }
 
 func (r showStreamsDbRow) convert() *Stream {
+	// generator:protected-begin showStreamsDbRow.convert
 	s := &Stream{
 		CreatedOn:    r.CreatedOn,
 		Name:         r.Name,
@@ -257,6 +253,7 @@ func (r showStreamsDbRow) convert() *Stream {
 		s.OwnerRoleType = &r.OwnerRoleType.String
 	}
 	return s
+	// generator:protected-end
 }
 
 func (r *DescribeStreamRequest) toOpts() *DescribeStreamOptions {
@@ -265,3 +262,6 @@ func (r *DescribeStreamRequest) toOpts() *DescribeStreamOptions {
 	}
 	return opts
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
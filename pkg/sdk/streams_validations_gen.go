@@ -1,7 +1,5 @@
 package sdk
 
-import "errors"
-
 var (
 	_ validatable = new(CreateOnTableStreamOptions)
 	_ validatable = new(CreateOnExternalTableStreamOptions)
@@ -16,7 +14,7 @@ var (
 
 func (opts *CreateOnTableStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -29,21 +27,21 @@ func (opts *CreateOnTableStreamOptions) validate() error {
 		errs = append(errs, errOneOf("CreateOnTableStreamOptions", "IfNotExists", "OrReplace"))
 	}
 	if valueSet(opts.On) {
-		if ok := exactlyOneValueSet(opts.On.At, opts.On.Before); !ok {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
 			errs = append(errs, errExactlyOneOf("CreateOnTableStreamOptions.On", "At", "Before"))
 		}
-		if valueSet(opts.On.Statement) {
-			if ok := exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream); !ok {
-				errs = append(errs, errExactlyOneOf("CreateOnTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
-			}
+		if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+			errs = append(errs, errExactlyOneOf("CreateOnTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateOnTableStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *CreateOnExternalTableStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -56,21 +54,21 @@ func (opts *CreateOnExternalTableStreamOptions) validate() error {
 		errs = append(errs, errOneOf("CreateOnExternalTableStreamOptions", "IfNotExists", "OrReplace"))
 	}
 	if valueSet(opts.On) {
-		if ok := exactlyOneValueSet(opts.On.At, opts.On.Before); !ok {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
 			errs = append(errs, errExactlyOneOf("CreateOnExternalTableStreamOptions.On", "At", "Before"))
 		}
-		if valueSet(opts.On.Statement) {
-			if ok := exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream); !ok {
-				errs = append(errs, errExactlyOneOf("CreateOnExternalTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
-			}
+		if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+			errs = append(errs, errExactlyOneOf("CreateOnExternalTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateOnExternalTableStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *CreateOnDirectoryTableStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -82,12 +80,14 @@ func (opts *CreateOnDirectoryTableStreamOptions) validate() error {
 	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
 		errs = append(errs, errOneOf("CreateOnDirectoryTableStreamOptions", "IfNotExists", "OrReplace"))
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateOnDirectoryTableStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *CreateOnViewStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -100,32 +100,34 @@ func (opts *CreateOnViewStreamOptions) validate() error {
 		errs = append(errs, errOneOf("CreateOnViewStreamOptions", "IfNotExists", "OrReplace"))
 	}
 	if valueSet(opts.On) {
-		if ok := exactlyOneValueSet(opts.On.At, opts.On.Before); !ok {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
 			errs = append(errs, errExactlyOneOf("CreateOnViewStreamOptions.On", "At", "Before"))
 		}
-		if valueSet(opts.On.Statement) {
-			if ok := exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream); !ok {
-				errs = append(errs, errExactlyOneOf("CreateOnViewStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
-			}
+		if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+			errs = append(errs, errExactlyOneOf("CreateOnViewStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
 		}
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CreateOnViewStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *CloneStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin CloneStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
@@ -134,38 +136,49 @@ func (opts *AlterStreamOptions) validate() error {
 	if everyValueSet(opts.IfExists, opts.UnsetTags) {
 		errs = append(errs, errOneOf("AlterStreamOptions", "IfExists", "UnsetTags"))
 	}
-	if ok := exactlyOneValueSet(opts.SetComment, opts.UnsetComment, opts.SetTags, opts.UnsetTags); !ok {
+	if !exactlyOneValueSet(opts.SetComment, opts.UnsetComment, opts.SetTags, opts.UnsetTags) {
 		errs = append(errs, errExactlyOneOf("AlterStreamOptions", "SetComment", "UnsetComment", "SetTags", "UnsetTags"))
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin AlterStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DropStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DropStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *ShowStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
-	return errors.Join(errs...)
+	// generator:protected-begin ShowStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
 
 func (opts *DescribeStreamOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return errors.Join(errs...)
+	// generator:protected-begin DescribeStreamOptions.validate
+	// generator:protected-end
+	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -10,6 +10,7 @@ import (
 func Sweep(client *Client, prefix string) error {
 	sweepers := []func() error{
 		getAccountPolicyAttachementsSweeper(client),
+		getUserPolicyAttachmentsSweeper(client, prefix),
 		getResourceMonitorSweeper(client, prefix),
 		getFailoverGroupSweeper(client, prefix),
 		getShareSweeper(client, prefix),
@@ -231,3 +232,29 @@ func getAccountPolicyAttachementsSweeper(client *Client) func() error {
 		return nil
 	}
 }
+
+func getUserPolicyAttachmentsSweeper(client *Client, prefix string) func() error {
+	return func() error {
+		if prefix == "" {
+			log.Printf("[DEBUG] Unsetting session policies set on all users")
+		} else {
+			log.Printf("[DEBUG] Unsetting session policies set on users with prefix %s", prefix)
+		}
+		ctx := context.Background()
+		users, err := client.Users.Show(ctx, nil)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			if prefix == "" || strings.HasPrefix(user.Name, prefix) {
+				log.Printf("[DEBUG] Unsetting session policy on user %s", user.Name)
+				_ = client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+					Unset: &UserUnset{
+						SessionPolicy: Bool(true),
+					},
+				})
+			}
+		}
+		return nil
+	}
+}
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Tables = (*tables)(nil)
 
@@ -32,36 +28,50 @@ type tables struct {
 
 func (v *tables) Create(ctx context.Context, request *CreateTableRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) CreateAsSelect(ctx context.Context, request *CreateTableAsSelectRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) CreateUsingTemplate(ctx context.Context, request *CreateTableUsingTemplateRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) CreateLike(ctx context.Context, request *CreateTableLikeRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) CreateClone(ctx context.Context, request *CreateTableCloneRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) Alter(ctx context.Context, request *AlterTableRequest) error {
 	opts := request.toOpts()
+	ids := []ObjectIdentifier{request.name}
+	if request.NewName != nil {
+		ids = append(ids, *request.NewName)
+	}
+	if request.SwapWith != nil {
+		ids = append(ids, *request.SwapWith)
+	}
+	defer v.client.showCache.invalidate(ObjectTypeTable, ids...)
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *tables) Drop(ctx context.Context, request *DropTableRequest) error {
 	opts := request.toOpts()
+	defer v.client.showCache.invalidate(ObjectTypeTable, request.Name)
 	return validateAndExec(v.client, ctx, opts)
 }
 
@@ -78,12 +88,12 @@ func (v *tables) Show(ctx context.Context, request *ShowTableRequest) ([]Table,
 }
 
 func (v *tables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
-	request := NewShowTableRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLikePattern(id.Name())
-	returnedTables, err := v.Show(ctx, request)
-	if err != nil {
-		return nil, err
-	}
-	return collections.FindOne(returnedTables, func(r Table) bool { return r.Name == id.Name() })
+	in := &In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}
+	return showByIDWithCache(v.client, ObjectTypeTable, id, func(r Table) bool { return r.Name == id.Name() }, func() ([]Table, error) {
+		return v.Show(ctx, NewShowTableRequest().WithIn(in).WithLikePattern(id.Name()))
+	}, func() ([]Table, error) {
+		return v.Show(ctx, NewShowTableRequest().WithIn(in))
+	})
 }
 
 func (v *tables) DescribeColumns(ctx context.Context, req *DescribeTableColumnsRequest) ([]TableColumnDetails, error) {
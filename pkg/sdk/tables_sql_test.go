@@ -0,0 +1,234 @@
+package sdk
+
+import "testing"
+
+func TestTables_GoldenSQL(t *testing.T) {
+	column := TableColumn{Name: "column", Type: DataTypeVARCHAR}
+	tag := TagAssociation{Name: NewSchemaObjectIdentifier("database", "schema", "tag"), Value: "value"}
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &createTableOptions{
+				name:                  sqlFixtureIdentifier[SchemaObjectIdentifier](),
+				ColumnsAndConstraints: CreateTableColumnsAndConstraints{Columns: []TableColumn{column}},
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &createTableOptions{
+				OrReplace: Bool(true),
+				Scope:     Pointer(GlobalTableScope),
+				Kind:      Pointer(TransientTableKind),
+				name:      sqlFixtureIdentifier[SchemaObjectIdentifier](),
+				ColumnsAndConstraints: CreateTableColumnsAndConstraints{
+					Columns: []TableColumn{
+						{
+							Name:             "column",
+							Type:             DataTypeVARCHAR,
+							InlineConstraint: &ColumnInlineConstraint{Name: String("inline_constraint"), Type: ColumnConstraintTypeUnique},
+							NotNull:          Bool(true),
+							Collate:          String("de"),
+							DefaultValue:     &ColumnDefaultValue{Expression: String("'default'")},
+							MaskingPolicy:    &ColumnMaskingPolicy{Name: NewSchemaObjectIdentifier("database", "schema", "masking_policy"), Using: []string{"column"}},
+							Tags:             []TagAssociation{tag},
+							Comment:          String("column comment"),
+						},
+					},
+					OutOfLineConstraint: []OutOfLineConstraint{
+						{Name: String("out_of_line_constraint"), Type: ColumnConstraintTypePrimaryKey, Columns: []string{"column"}},
+					},
+				},
+				ClusterBy:                  []string{"column"},
+				EnableSchemaEvolution:      Bool(true),
+				StageFileFormat:            &StageFileFormat{FormatName: String("format_name")},
+				DataRetentionTimeInDays:    Int(1),
+				MaxDataExtensionTimeInDays: Int(1),
+				ChangeTracking:             Bool(true),
+				DefaultDDLCollation:        String("en"),
+				CopyGrants:                 Bool(true),
+				RowAccessPolicy:            &TableRowAccessPolicy{Name: NewSchemaObjectIdentifier("database", "schema", "row_access_policy"), On: []string{"column"}},
+				Tags:                       []TagAssociation{tag},
+				Comment:                    String("comment"),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateAsSelect", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &createTableAsSelectOptions{
+				name:    sqlFixtureIdentifier[SchemaObjectIdentifier](),
+				Columns: []TableAsSelectColumn{{Name: "column"}},
+				Query:   "SELECT column FROM source_table",
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &createTableAsSelectOptions{
+				OrReplace: Bool(true),
+				name:      sqlFixtureIdentifier[SchemaObjectIdentifier](),
+				Columns: []TableAsSelectColumn{
+					{
+						Name:          "column",
+						Type:          Pointer(DataTypeVARCHAR),
+						MaskingPolicy: &TableAsSelectColumnMaskingPolicy{Name: NewSchemaObjectIdentifier("database", "schema", "masking_policy")},
+					},
+				},
+				ClusterBy:       []string{"column"},
+				CopyGrants:      Bool(true),
+				RowAccessPolicy: &TableRowAccessPolicy{Name: NewSchemaObjectIdentifier("database", "schema", "row_access_policy"), On: []string{"column"}},
+				Query:           "SELECT column FROM source_table",
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("CreateUsingTemplate", func(t *testing.T) {
+		opts := &createTableUsingTemplateOptions{
+			OrReplace:  Bool(true),
+			name:       sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			CopyGrants: Bool(true),
+			Query:      []string{"SELECT ARRAY_AGG(OBJECT_CONSTRUCT(*)) FROM TABLE(INFER_SCHEMA(LOCATION => '@stage', FILE_FORMAT => 'format'))"},
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("CreateLike", func(t *testing.T) {
+		opts := &createTableLikeOptions{
+			OrReplace:   Bool(true),
+			name:        sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			SourceTable: NewSchemaObjectIdentifier("database", "schema", "source_table"),
+			ClusterBy:   []string{"column"},
+			CopyGrants:  Bool(true),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("CreateClone", func(t *testing.T) {
+		opts := &createTableCloneOptions{
+			OrReplace:   Bool(true),
+			name:        sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			SourceTable: NewSchemaObjectIdentifier("database", "schema", "source_table"),
+			ClonePoint:  &ClonePoint{Moment: CloneMomentAt, At: TimeTravel{Offset: Int(-60)}},
+			CopyGrants:  Bool(true),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("Alter", func(t *testing.T) {
+		alter := func(set func(opts *alterTableOptions)) *alterTableOptions {
+			opts := &alterTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			set(opts)
+			return opts
+		}
+		t.Run("NewName", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.IfExists = Bool(true)
+				opts.NewName = Pointer(NewSchemaObjectIdentifier("database", "schema", "new_name"))
+			}))
+		})
+		t.Run("SwapWith", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.SwapWith = Pointer(NewSchemaObjectIdentifier("database", "schema", "swap_with"))
+			}))
+		})
+		t.Run("ClusteringAction", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.ClusteringAction = &TableClusteringAction{ClusterBy: []string{"column"}}
+			}))
+		})
+		t.Run("ColumnAction", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.ColumnAction = &TableColumnAction{Add: &TableColumnAddAction{
+					IfNotExists: Bool(true),
+					Name:        "column",
+					Type:        DataTypeVARCHAR,
+					Comment:     String("column comment"),
+				}}
+			}))
+		})
+		t.Run("SearchOptimizationAction", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.SearchOptimizationAction = &TableSearchOptimizationAction{Add: &AddSearchOptimization{On: []string{"EQUALITY(column)"}}}
+			}))
+		})
+		t.Run("Set", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.Set = &TableSet{
+					EnableSchemaEvolution:      Bool(true),
+					DataRetentionTimeInDays:    Int(1),
+					MaxDataExtensionTimeInDays: Int(1),
+					ChangeTracking:             Bool(true),
+					DefaultDDLCollation:        String("en"),
+					Comment:                    String("comment"),
+				}
+			}))
+		})
+		t.Run("SetTags", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.SetTags = []TagAssociation{tag}
+			}))
+		})
+		t.Run("UnsetTags", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.UnsetTags = []ObjectIdentifier{tag.Name}
+			}))
+		})
+		t.Run("Unset", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.Unset = &TableUnset{DataRetentionTimeInDays: Bool(true), Comment: Bool(true)}
+			}))
+		})
+		t.Run("AddRowAccessPolicy", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.AddRowAccessPolicy = &TableAddRowAccessPolicy{RowAccessPolicy: NewSchemaObjectIdentifier("database", "schema", "row_access_policy"), On: []string{"column"}}
+			}))
+		})
+		t.Run("DropAllAccessRowPolicies", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, alter(func(opts *alterTableOptions) {
+				opts.DropAllAccessRowPolicies = Bool(true)
+			}))
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &dropTableOptions{
+				name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &dropTableOptions{
+				IfExists: Bool(true),
+				name:     sqlFixtureIdentifier[SchemaObjectIdentifier](),
+				Cascade:  Bool(true),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			assertSQLMatchesGoldenFile(t, &showTableOptions{})
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &showTableOptions{
+				Terse:      Bool(true),
+				History:    Bool(true),
+				Like:       &Like{Pattern: String("pattern")},
+				In:         &In{Schema: NewDatabaseObjectIdentifier("database", "schema")},
+				StartsWith: String("starts_with"),
+				LimitFrom:  &LimitFrom{Rows: Int(10), From: String("from")},
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("DescribeColumns", func(t *testing.T) {
+		opts := &describeTableColumnsOptions{
+			name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("DescribeStage", func(t *testing.T) {
+		opts := &describeTableStageOptions{
+			name: sqlFixtureIdentifier[SchemaObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+}
@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Tags = (*tags)(nil)
 
@@ -38,7 +34,7 @@ func (v *tags) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Tag, e
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(tags, func(r Tag) bool { return r.Name == id.Name() })
+	return findOne(tags, func(r Tag) bool { return r.Name == id.Name() })
 }
 
 func (v *tags) Drop(ctx context.Context, request *DropTagRequest) error {
@@ -4,27 +4,29 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var taskStateEnum = g.NewEnum("TaskState", "started", "suspended")
+
 var taskDbRow = g.DbStruct("taskDBRow").
 	Field("created_on", "string").
 	Field("name", "string").
-	Field("id", "string").
+	Field("id", "sql.NullString").
 	Field("database_name", "string").
 	Field("schema_name", "string").
-	Field("owner", "string").
-	Field("comment", "string").
-	Field("warehouse", "string").
-	Field("schedule", "string").
-	Field("predecessors", "string").
-	Field("state", "string").
-	Field("definition", "string").
-	Field("condition", "string").
-	Field("allow_overlapping_execution", "string").
-	Field("error_integration", "string").
-	Field("last_committed_on", "string").
-	Field("last_suspended_on", "string").
-	Field("owner_role_type", "string").
-	Field("config", "string").
-	Field("budget", "string")
+	Field("owner", "sql.NullString").
+	Field("comment", "sql.NullString").
+	Field("warehouse", "sql.NullString").
+	Field("schedule", "sql.NullString").
+	Field("predecessors", "sql.NullString").
+	Field("state", "sql.NullString").
+	Field("definition", "sql.NullString").
+	Field("condition", "sql.NullString").
+	Field("allow_overlapping_execution", "sql.NullString").
+	Field("error_integration", "sql.NullString").
+	Field("last_committed_on", "sql.NullString").
+	Field("last_suspended_on", "sql.NullString").
+	Field("owner_role_type", "sql.NullString").
+	Field("config", "sql.NullString").
+	Field("budget", "sql.NullString")
 
 var task = g.PlainStruct("Task").
 	Field("CreatedOn", "string").
@@ -36,11 +38,11 @@ var task = g.PlainStruct("Task").
 	Field("Comment", "string").
 	Field("Warehouse", "string").
 	Field("Schedule", "string").
-	Field("Predecessors", "string").
-	Field("State", "string").
+	Field("Predecessors", g.KindOfTSlice[SchemaObjectIdentifier]()).
+	Field("State", taskStateEnum.Kind()).
 	Field("Definition", "string").
 	Field("Condition", "string").
-	Field("AllowOverlappingExecution", "string").
+	Field("AllowOverlappingExecution", "bool").
 	Field("ErrorIntegration", "string").
 	Field("LastCommittedOn", "string").
 	Field("LastSuspendedOn", "string").
@@ -53,6 +55,7 @@ var TasksDef = g.NewInterface(
 	"Task",
 	g.KindOfT[SchemaObjectIdentifier](),
 ).
+	WithEnums(taskStateEnum).
 	CreateOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/create-task",
 		g.NewQueryStruct("CreateTask").
@@ -126,7 +129,7 @@ var TasksDef = g.NewInterface(
 					OptionalSessionParameters().
 					WithValidation(g.AtLeastOneValueSet, "Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParameters").
 					WithValidation(g.ConflictingFields, "Warehouse", "UserTaskManagedInitialWarehouseSize"),
-				g.KeywordOptions().SQL("SET"),
+				g.ListOptions().NoParentheses().SQL("SET"),
 			).
 			OptionalQueryStructField(
 				"Unset",
@@ -141,7 +144,7 @@ var TasksDef = g.NewInterface(
 					OptionalSQL("COMMENT").
 					OptionalSessionParametersUnset().
 					WithValidation(g.AtLeastOneValueSet, "Warehouse", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParametersUnset"),
-				g.KeywordOptions().SQL("UNSET"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
 			).
 			OptionalSetTags().
 			OptionalUnsetTags().
@@ -173,7 +176,8 @@ var TasksDef = g.NewInterface(
 			OptionalSQL("ROOT ONLY").
 			OptionalLimit(),
 	).
-	ShowByIdOperation().
+	// ShowByID is implemented by hand with Describe, which returns the same columns as SHOW TASKS
+	ShowByIdOperationWithNoFiltering().
 	DescribeOperation(
 		g.DescriptionMappingKindSingleValue,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-task",
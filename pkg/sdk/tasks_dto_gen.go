@@ -32,10 +32,6 @@ type CreateTaskRequest struct {
 	sql                         string // required
 }
 
-func (r *CreateTaskRequest) GetName() SchemaObjectIdentifier {
-	return r.name
-}
-
 type CreateTaskWarehouseRequest struct {
 	Warehouse                           *AccountObjectIdentifier
 	UserTaskManagedInitialWarehouseSize *WarehouseSize
@@ -48,10 +44,6 @@ type CloneTaskRequest struct {
 	CopyGrants *bool
 }
 
-func (r *CloneTaskRequest) GetName() SchemaObjectIdentifier {
-	return r.name
-}
-
 type AlterTaskRequest struct {
 	IfExists    *bool
 	name        SchemaObjectIdentifier // required
@@ -114,3 +106,14 @@ type ExecuteTaskRequest struct {
 	name      SchemaObjectIdentifier // required
 	RetryLast *bool
 }
+
+// generator:protected-begin declarations
+func (r *CreateTaskRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+func (r *CloneTaskRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
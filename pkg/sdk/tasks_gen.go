@@ -3,6 +3,8 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 )
 
 type Tasks interface {
@@ -14,6 +16,31 @@ type Tasks interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Task, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*Task, error)
 	Execute(ctx context.Context, request *ExecuteTaskRequest) error
+	// generator:protected-begin Tasks methods
+	// generator:protected-end
+}
+
+type TaskState string
+
+const (
+	TaskStateStarted   TaskState = "started"
+	TaskStateSuspended TaskState = "suspended"
+)
+
+var AllTaskStates = []TaskState{
+	TaskStateStarted,
+	TaskStateSuspended,
+}
+
+func ToTaskState(s string) (TaskState, error) {
+	switch strings.ToUpper(s) {
+	case "STARTED":
+		return TaskStateStarted, nil
+	case "SUSPENDED":
+		return TaskStateSuspended, nil
+	default:
+		return "", fmt.Errorf("invalid TaskState: %s", s)
+	}
 }
 
 // CreateTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-task.
@@ -114,7 +141,7 @@ type ShowTaskOptions struct {
 	tasks      bool       `ddl:"static" sql:"TASKS"`
 	Like       *Like      `ddl:"keyword" sql:"LIKE"`
 	In         *In        `ddl:"keyword" sql:"IN"`
-	StartsWith *string    `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
 	RootOnly   *bool      `ddl:"keyword" sql:"ROOT ONLY"`
 	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
@@ -180,17 +207,13 @@ type ExecuteTaskOptions struct {
 	RetryLast *bool                  `ddl:"keyword" sql:"RETRY LAST"`
 }
 
+// generator:protected-begin declarations
 func (v *Task) ID() SchemaObjectIdentifier {
 	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
 }
 
-type TaskState string
-
-const (
-	TaskStateStarted   TaskState = "started"
-	TaskStateSuspended TaskState = "suspended"
-)
-
 func (v *Task) IsStarted() bool {
 	return v.State == TaskStateStarted
 }
+
+// generator:protected-end
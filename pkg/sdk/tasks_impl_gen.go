@@ -46,7 +46,9 @@ func (v *tasks) Show(ctx context.Context, request *ShowTaskRequest) ([]Task, err
 }
 
 func (v *tasks) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Task, error) {
+	// generator:protected-begin tasks.ShowByID
 	return v.Describe(ctx, id)
+	// generator:protected-end
 }
 
 func (v *tasks) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Task, error) {
@@ -65,49 +67,11 @@ func (v *tasks) Execute(ctx context.Context, request *ExecuteTaskRequest) error
 	return validateAndExec(v.client, ctx, opts)
 }
 
-// GetRootTasks is a way to get all root tasks for the given tasks.
-// Snowflake does not have (yet) a method to do it without traversing the task graph manually.
-// Task DAG should have a single root but this is checked when the root task is being resumed; that's why we return here multiple roots.
-// Cycles should not be possible in a task DAG, but it is checked when the root task is being resumed; that's why this method has to be cycle-proof.
-func GetRootTasks(v Tasks, ctx context.Context, id SchemaObjectIdentifier) ([]Task, error) {
-	tasksToExamine := collections.NewQueue[SchemaObjectIdentifier]()
-	alreadyExaminedTasksNames := make([]string, 0)
-	rootTasks := make([]Task, 0)
-
-	tasksToExamine.Push(id)
-
-	for tasksToExamine.Head() != nil {
-		current := tasksToExamine.Pop()
-
-		if slices.Contains(alreadyExaminedTasksNames, current.Name()) {
-			continue
-		}
-
-		task, err := v.ShowByID(ctx, *current)
-		if err != nil {
-			return nil, err
-		}
-
-		predecessors := task.Predecessors
-		if len(predecessors) == 0 {
-			rootTasks = append(rootTasks, *task)
-		} else {
-			for _, p := range predecessors {
-				tasksToExamine.Push(p)
-			}
-		}
-		alreadyExaminedTasksNames = append(alreadyExaminedTasksNames, current.Name())
-	}
-
-	return rootTasks, nil
-}
-
 func (r *CreateTaskRequest) toOpts() *CreateTaskOptions {
 	opts := &CreateTaskOptions{
-		OrReplace:   r.OrReplace,
-		IfNotExists: r.IfNotExists,
-		name:        r.name,
-
+		OrReplace:                   r.OrReplace,
+		IfNotExists:                 r.IfNotExists,
+		name:                        r.name,
 		Schedule:                    r.Schedule,
 		Config:                      r.Config,
 		AllowOverlappingExecution:   r.AllowOverlappingExecution,
@@ -149,11 +113,10 @@ func (r *AlterTaskRequest) toOpts() *AlterTaskOptions {
 		Suspend:     r.Suspend,
 		RemoveAfter: r.RemoveAfter,
 		AddAfter:    r.AddAfter,
-
-		SetTags:    r.SetTags,
-		UnsetTags:  r.UnsetTags,
-		ModifyAs:   r.ModifyAs,
-		ModifyWhen: r.ModifyWhen,
+		SetTags:     r.SetTags,
+		UnsetTags:   r.UnsetTags,
+		ModifyAs:    r.ModifyAs,
+		ModifyWhen:  r.ModifyWhen,
 	}
 	if r.Set != nil {
 		opts.Set = &TaskSet{
@@ -206,6 +169,7 @@ func (r *ShowTaskRequest) toOpts() *ShowTaskOptions {
 }
 
 func (r taskDBRow) convert() *Task {
+	// generator:protected-begin taskDBRow.convert
 	task := Task{
 		CreatedOn:    r.CreatedOn,
 		Name:         r.Name,
@@ -272,6 +236,60 @@ func (r taskDBRow) convert() *Task {
 		task.Budget = r.Budget.String
 	}
 	return &task
+	// generator:protected-end
+}
+
+func (r *DescribeTaskRequest) toOpts() *DescribeTaskOptions {
+	opts := &DescribeTaskOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r *ExecuteTaskRequest) toOpts() *ExecuteTaskOptions {
+	opts := &ExecuteTaskOptions{
+		name:      r.name,
+		RetryLast: r.RetryLast,
+	}
+	return opts
+}
+
+// generator:protected-begin declarations
+// GetRootTasks is a way to get all root tasks for the given tasks.
+// Snowflake does not have (yet) a method to do it without traversing the task graph manually.
+// Task DAG should have a single root but this is checked when the root task is being resumed; that's why we return here multiple roots.
+// Cycles should not be possible in a task DAG, but it is checked when the root task is being resumed; that's why this method has to be cycle-proof.
+func GetRootTasks(v Tasks, ctx context.Context, id SchemaObjectIdentifier) ([]Task, error) {
+	tasksToExamine := collections.NewQueue[SchemaObjectIdentifier]()
+	alreadyExaminedTasksNames := make([]string, 0)
+	rootTasks := make([]Task, 0)
+
+	tasksToExamine.Push(id)
+
+	for tasksToExamine.Head() != nil {
+		current := tasksToExamine.Pop()
+
+		if slices.Contains(alreadyExaminedTasksNames, current.Name()) {
+			continue
+		}
+
+		task, err := v.ShowByID(ctx, *current)
+		if err != nil {
+			return nil, err
+		}
+
+		predecessors := task.Predecessors
+		if len(predecessors) == 0 {
+			rootTasks = append(rootTasks, *task)
+		} else {
+			for _, p := range predecessors {
+				tasksToExamine.Push(p)
+			}
+		}
+		alreadyExaminedTasksNames = append(alreadyExaminedTasksNames, current.Name())
+	}
+
+	return rootTasks, nil
 }
 
 func getPredecessors(predecessors string) ([]string, error) {
@@ -297,17 +315,4 @@ func getPredecessors(predecessors string) ([]string, error) {
 	return predecessorNames, err
 }
 
-func (r *DescribeTaskRequest) toOpts() *DescribeTaskOptions {
-	opts := &DescribeTaskOptions{
-		name: r.name,
-	}
-	return opts
-}
-
-func (r *ExecuteTaskRequest) toOpts() *ExecuteTaskOptions {
-	opts := &ExecuteTaskOptions{
-		name:      r.name,
-		RetryLast: r.RetryLast,
-	}
-	return opts
-}
+// generator:protected-end
@@ -0,0 +1 @@
+ALTER API INTEGRATION "name" SET API_AWS_ROLE_ARN = 'api_aws_role_arn' API_KEY = 'api_key' ENABLED = true API_ALLOWED_PREFIXES = ('path') API_BLOCKED_PREFIXES = ('path') COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER API INTEGRATION "name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER API INTEGRATION "name" UNSET API_KEY, ENABLED, API_BLOCKED_PREFIXES, COMMENT
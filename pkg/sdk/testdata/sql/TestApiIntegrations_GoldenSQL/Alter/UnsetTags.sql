@@ -0,0 +1 @@
+ALTER API INTEGRATION "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE API INTEGRATION IF NOT EXISTS "name" API_PROVIDER = api_provider API_AWS_ROLE_ARN = 'api_aws_role_arn' API_KEY = 'api_key' API_ALLOWED_PREFIXES = ('path') API_BLOCKED_PREFIXES = ('path') ENABLED = true COMMENT = 'comment'
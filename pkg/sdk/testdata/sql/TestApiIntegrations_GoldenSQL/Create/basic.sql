@@ -0,0 +1 @@
+CREATE API INTEGRATION "name" API_PROVIDER = api_provider API_AWS_ROLE_ARN = 'api_aws_role_arn' API_ALLOWED_PREFIXES = ('path') ENABLED = true
@@ -0,0 +1 @@
+DESCRIBE API INTEGRATION "name"
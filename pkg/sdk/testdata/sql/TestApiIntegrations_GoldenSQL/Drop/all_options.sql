@@ -0,0 +1 @@
+DROP API INTEGRATION IF EXISTS "name"
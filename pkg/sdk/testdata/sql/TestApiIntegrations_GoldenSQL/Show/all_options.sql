@@ -0,0 +1 @@
+SHOW API INTEGRATIONS LIKE 'pattern'
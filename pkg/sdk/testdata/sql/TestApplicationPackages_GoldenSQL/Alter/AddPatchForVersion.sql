@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" ADD PATCH FOR VERSION version_identifier USING 'using' LABEL = 'label'
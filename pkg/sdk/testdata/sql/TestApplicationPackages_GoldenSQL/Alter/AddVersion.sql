@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" ADD VERSION version_identifier USING 'using' LABEL = 'label'
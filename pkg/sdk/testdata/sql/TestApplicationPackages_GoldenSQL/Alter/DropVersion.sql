@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" DROP VERSION version_identifier
@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" MODIFY RELEASE DIRECTIVE release_directive VERSION = version PATCH = 1
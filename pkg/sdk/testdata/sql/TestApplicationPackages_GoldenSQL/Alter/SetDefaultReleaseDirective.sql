@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" SET DEFAULT RELEASE DIRECTIVE VERSION = version PATCH = 1
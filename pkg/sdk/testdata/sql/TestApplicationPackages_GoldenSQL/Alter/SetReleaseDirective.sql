@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" SET RELEASE DIRECTIVE release_directive ACCOUNTS = (accounts) VERSION = version PATCH = 1
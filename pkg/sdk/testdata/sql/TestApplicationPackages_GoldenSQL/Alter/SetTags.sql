@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" UNSET RELEASE DIRECTIVE release_directive
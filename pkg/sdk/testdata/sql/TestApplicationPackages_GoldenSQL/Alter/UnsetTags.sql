@@ -0,0 +1 @@
+ALTER APPLICATION PACKAGE "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE APPLICATION PACKAGE IF NOT EXISTS "name" DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 DEFAULT_DDL_COLLATION = 'default_ddl_collation' COMMENT = 'comment' DISTRIBUTION = distribution TAG ("name" = 'value')
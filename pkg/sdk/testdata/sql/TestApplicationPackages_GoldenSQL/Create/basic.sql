@@ -0,0 +1 @@
+CREATE APPLICATION PACKAGE "name"
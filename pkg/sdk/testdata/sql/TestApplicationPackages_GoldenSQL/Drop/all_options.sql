@@ -0,0 +1 @@
+DROP APPLICATION PACKAGE "name"
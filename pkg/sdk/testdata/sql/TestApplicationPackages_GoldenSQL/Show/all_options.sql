@@ -0,0 +1 @@
+SHOW APPLICATION PACKAGES LIKE 'pattern' STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
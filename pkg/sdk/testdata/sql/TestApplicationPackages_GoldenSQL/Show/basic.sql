@@ -0,0 +1 @@
+SHOW APPLICATION PACKAGES
@@ -0,0 +1 @@
+GRANT APPLICATION ROLE "database"."name" TO ROLE "role_name"
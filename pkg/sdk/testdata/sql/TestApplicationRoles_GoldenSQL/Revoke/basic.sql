@@ -0,0 +1 @@
+REVOKE APPLICATION ROLE "database"."name" FROM ROLE "role_name"
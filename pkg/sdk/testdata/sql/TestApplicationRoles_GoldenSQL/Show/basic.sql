@@ -0,0 +1 @@
+SHOW APPLICATION ROLES IN APPLICATION "application_name"
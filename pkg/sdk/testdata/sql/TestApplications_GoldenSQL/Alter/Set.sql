@@ -0,0 +1 @@
+ALTER APPLICATION "name" SET COMMENT = 'comment' SHARE_EVENTS_WITH_PROVIDER = true DEBUG_MODE = true
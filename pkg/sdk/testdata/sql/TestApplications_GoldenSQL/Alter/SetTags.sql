@@ -0,0 +1 @@
+ALTER APPLICATION "name" SET TAG "name" = 'value'
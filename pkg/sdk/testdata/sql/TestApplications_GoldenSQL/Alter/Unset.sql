@@ -0,0 +1 @@
+ALTER APPLICATION "name" UNSET COMMENT, SHARE_EVENTS_WITH_PROVIDER, DEBUG_MODE
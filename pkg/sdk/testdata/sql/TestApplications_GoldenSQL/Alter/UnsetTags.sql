@@ -0,0 +1 @@
+ALTER APPLICATION "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+ALTER APPLICATION "name" UPGRADE
@@ -0,0 +1 @@
+CREATE APPLICATION "name" FROM APPLICATION PACKAGE "package_name" USING 'version_directory' DEBUG_MODE = true COMMENT = 'comment' TAG ("name" = 'value')
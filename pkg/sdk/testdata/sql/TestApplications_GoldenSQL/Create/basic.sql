@@ -0,0 +1 @@
+CREATE APPLICATION "name" FROM APPLICATION PACKAGE "package_name"
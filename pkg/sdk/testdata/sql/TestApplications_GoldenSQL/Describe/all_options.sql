@@ -0,0 +1 @@
+DESCRIBE APPLICATION "name"
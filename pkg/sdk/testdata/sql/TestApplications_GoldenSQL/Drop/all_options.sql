@@ -0,0 +1 @@
+DROP APPLICATION IF EXISTS "name" CASCADE
@@ -0,0 +1 @@
+DROP APPLICATION "name"
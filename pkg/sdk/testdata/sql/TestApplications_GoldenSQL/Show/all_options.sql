@@ -0,0 +1 @@
+SHOW APPLICATIONS LIKE 'pattern' STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
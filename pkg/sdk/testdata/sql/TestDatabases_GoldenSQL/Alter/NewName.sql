@@ -0,0 +1 @@
+ALTER DATABASE IF EXISTS "name" RENAME TO "new_name"
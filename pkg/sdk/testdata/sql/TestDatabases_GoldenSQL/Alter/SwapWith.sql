@@ -0,0 +1 @@
+ALTER DATABASE "name" SWAP WITH "swap_with"
@@ -0,0 +1 @@
+ALTER DATABASE "name" DISABLE FAILOVER TO ACCOUNTS "organization"."to_accounts"
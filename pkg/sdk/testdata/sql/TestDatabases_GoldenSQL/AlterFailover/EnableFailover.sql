@@ -0,0 +1 @@
+ALTER DATABASE "name" ENABLE FAILOVER TO ACCOUNTS "organization"."to_accounts"
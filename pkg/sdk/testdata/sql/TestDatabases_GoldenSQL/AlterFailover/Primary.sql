@@ -0,0 +1 @@
+ALTER DATABASE "name" PRIMARY
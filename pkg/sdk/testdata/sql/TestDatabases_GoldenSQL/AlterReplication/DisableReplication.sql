@@ -0,0 +1 @@
+ALTER DATABASE "name" DISABLE REPLICATION TO ACCOUNTS "organization"."to_accounts"
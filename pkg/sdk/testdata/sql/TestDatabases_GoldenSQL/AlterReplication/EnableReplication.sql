@@ -0,0 +1 @@
+ALTER DATABASE "name" ENABLE REPLICATION TO ACCOUNTS "organization"."to_accounts" IGNORE EDITION CHECK
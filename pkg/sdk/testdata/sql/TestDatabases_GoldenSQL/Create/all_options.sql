@@ -0,0 +1 @@
+CREATE OR REPLACE TRANSIENT DATABASE "name" DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 COMMENT = 'comment' TAG ("name" = 'value')
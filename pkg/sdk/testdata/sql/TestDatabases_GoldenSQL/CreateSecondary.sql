@@ -0,0 +1 @@
+CREATE DATABASE "name" AS REPLICA OF "organization"."account"."primary_database" DATA_RETENTION_TIME_IN_DAYS = 1
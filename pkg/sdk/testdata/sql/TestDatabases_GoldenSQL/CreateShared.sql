@@ -0,0 +1 @@
+CREATE DATABASE "name" FROM SHARE "organization"."account"."share" COMMENT = 'comment'
@@ -0,0 +1 @@
+DESCRIBE DATABASE "name"
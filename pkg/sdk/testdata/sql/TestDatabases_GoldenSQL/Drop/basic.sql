@@ -0,0 +1 @@
+DROP DATABASE "name"
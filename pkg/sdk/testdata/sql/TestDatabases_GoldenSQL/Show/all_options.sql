@@ -0,0 +1 @@
+SHOW TERSE DATABASES HISTORY LIKE 'pattern' STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
@@ -0,0 +1 @@
+UNDROP DATABASE "name"
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" ADD ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (on)
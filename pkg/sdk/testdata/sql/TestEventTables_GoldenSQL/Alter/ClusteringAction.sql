@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" CLUSTER BY (cluster_by) SUSPEND RECLUSTER RESUME RECLUSTER DROP CLUSTERING KEY
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" ADD SEARCH OPTIMIZATION ON on DROP SEARCH OPTIMIZATION ON on
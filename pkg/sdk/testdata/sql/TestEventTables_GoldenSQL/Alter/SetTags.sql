@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" SET TAG "name" = 'value'
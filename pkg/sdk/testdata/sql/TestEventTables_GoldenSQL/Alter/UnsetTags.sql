@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" UNSET TAG "unset_tags"
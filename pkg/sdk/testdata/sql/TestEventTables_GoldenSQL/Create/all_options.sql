@@ -0,0 +1 @@
+CREATE OR REPLACE EVENT TABLE "database"."schema"."name" CLUSTER BY (cluster_by) DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'default_ddl_collation' COPY GRANTS COMMENT = 'comment' ROW ACCESS POLICY "database"."schema"."name" ON (on) TAG ("name" = 'value')
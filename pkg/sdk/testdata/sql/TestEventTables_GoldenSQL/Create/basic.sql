@@ -0,0 +1 @@
+CREATE EVENT TABLE "database"."schema"."name"
@@ -0,0 +1 @@
+DESCRIBE EVENT TABLE "database"."schema"."name"
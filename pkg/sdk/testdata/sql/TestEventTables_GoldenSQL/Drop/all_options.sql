@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "database"."schema"."name" RESTRICT
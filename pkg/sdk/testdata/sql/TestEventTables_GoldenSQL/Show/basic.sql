@@ -0,0 +1 @@
+SHOW EVENT TABLES
@@ -0,0 +1 @@
+ALTER EXTERNAL ACCESS INTEGRATION "name" UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS, ALLOWED_AUTHENTICATION_SECRETS, COMMENT
@@ -0,0 +1 @@
+CREATE OR REPLACE EXTERNAL ACCESS INTEGRATION "name" ALLOWED_NETWORK_RULES = ("database"."schema"."allowed_network_rules") ALLOWED_API_AUTHENTICATION_INTEGRATIONS = ("allowed_api_authentication_integrations") ALLOWED_AUTHENTICATION_SECRETS = ("database"."schema"."allowed_authentication_secrets") ENABLED = true COMMENT = 'comment'
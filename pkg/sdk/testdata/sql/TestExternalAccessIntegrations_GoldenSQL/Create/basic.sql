@@ -0,0 +1 @@
+CREATE EXTERNAL ACCESS INTEGRATION "name" ALLOWED_NETWORK_RULES = ("database"."schema"."allowed_network_rules") ENABLED = true
@@ -0,0 +1 @@
+DESCRIBE EXTERNAL ACCESS INTEGRATION "name"
@@ -0,0 +1 @@
+DROP EXTERNAL ACCESS INTEGRATION IF EXISTS "name"
@@ -0,0 +1 @@
+DROP EXTERNAL ACCESS INTEGRATION "name"
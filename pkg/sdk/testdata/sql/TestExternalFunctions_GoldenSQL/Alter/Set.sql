@@ -0,0 +1 @@
+ALTER FUNCTION "database"."schema"."name" (argument_data_types) SET API_INTEGRATION = "api_integration"
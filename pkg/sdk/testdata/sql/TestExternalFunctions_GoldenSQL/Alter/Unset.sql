@@ -0,0 +1 @@
+ALTER FUNCTION "database"."schema"."name" (argument_data_types) UNSET COMMENT, HEADERS, CONTEXT_HEADERS, MAX_BATCH_ROWS, COMPRESSION, SECURE, REQUEST_TRANSLATOR, RESPONSE_TRANSLATOR
@@ -0,0 +1 @@
+CREATE OR REPLACE SECURE EXTERNAL FUNCTION "database"."schema"."name" (arg_name arg_data_type) RETURNS result_data_type return_null_values null_input_behavior return_results_behavior COMMENT = 'comment' API_INTEGRATION = "api_integration" HEADERS = ('name' = 'value') CONTEXT_HEADERS = (context_function) MAX_BATCH_ROWS = 1 COMPRESSION = compression REQUEST_TRANSLATOR = "database"."schema"."request_translator" RESPONSE_TRANSLATOR = "database"."schema"."response_translator" AS 'as'
@@ -0,0 +1 @@
+CREATE EXTERNAL FUNCTION "database"."schema"."name" () RETURNS result_data_type API_INTEGRATION = "api_integration" AS 'as'
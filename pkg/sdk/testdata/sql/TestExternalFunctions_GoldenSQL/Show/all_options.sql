@@ -0,0 +1 @@
+SHOW EXTERNAL FUNCTIONS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
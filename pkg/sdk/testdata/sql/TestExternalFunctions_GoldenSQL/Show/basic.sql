@@ -0,0 +1 @@
+SHOW EXTERNAL FUNCTIONS
@@ -0,0 +1 @@
+ALTER FUNCTION "database"."schema"."name" (argument_data_types) SET LOG_LEVEL = 'set_log_level'
@@ -0,0 +1 @@
+ALTER FUNCTION "database"."schema"."name" (argument_data_types) SET TAG "name" = 'value'
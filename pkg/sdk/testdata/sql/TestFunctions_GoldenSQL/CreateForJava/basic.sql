@@ -0,0 +1 @@
+CREATE FUNCTION "database"."schema"."name" () RETURNS result_data_type LANGUAGE JAVA HANDLER = 'handler' AS 'function_definition'
@@ -0,0 +1 @@
+CREATE OR REPLACE TEMPORARY SECURE FUNCTION "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type return_null_values LANGUAGE JAVASCRIPT null_input_behavior return_results_behavior COMMENT = 'comment' AS 'function_definition'
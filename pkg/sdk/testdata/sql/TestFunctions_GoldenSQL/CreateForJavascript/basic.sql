@@ -0,0 +1 @@
+CREATE FUNCTION "database"."schema"."name" () RETURNS result_data_type LANGUAGE JAVASCRIPT AS 'function_definition'
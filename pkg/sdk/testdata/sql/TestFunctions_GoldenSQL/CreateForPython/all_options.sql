@@ -0,0 +1 @@
+CREATE OR REPLACE TEMPORARY SECURE FUNCTION "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type return_null_values LANGUAGE PYTHON null_input_behavior return_results_behavior RUNTIME_VERSION = 'runtime_version' COMMENT = 'comment' IMPORTS = ('import') PACKAGES = ('package') HANDLER = 'handler' EXTERNAL_ACCESS_INTEGRATIONS = ("external_access_integrations") SECRETS = ('variable_name' = name) AS 'function_definition'
@@ -0,0 +1 @@
+CREATE FUNCTION "database"."schema"."name" () RETURNS result_data_type LANGUAGE PYTHON RUNTIME_VERSION = 'runtime_version' HANDLER = 'handler' AS 'function_definition'
@@ -0,0 +1 @@
+CREATE OR REPLACE TEMPORARY SECURE FUNCTION "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type return_null_values return_results_behavior MEMOIZABLE COMMENT = 'comment' AS 'function_definition'
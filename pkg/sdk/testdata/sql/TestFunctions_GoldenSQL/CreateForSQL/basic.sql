@@ -0,0 +1 @@
+CREATE FUNCTION "database"."schema"."name" () RETURNS result_data_type AS 'function_definition'
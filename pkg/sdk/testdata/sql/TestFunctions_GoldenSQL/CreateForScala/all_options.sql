@@ -0,0 +1 @@
+CREATE OR REPLACE TEMPORARY SECURE FUNCTION "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type return_null_values LANGUAGE SCALA null_input_behavior return_results_behavior RUNTIME_VERSION = 'runtime_version' COMMENT = 'comment' IMPORTS = ('import') PACKAGES = ('package') HANDLER = 'handler' TARGET_PATH = 'target_path' AS 'function_definition'
@@ -0,0 +1 @@
+DESCRIBE FUNCTION "database"."schema"."name"(VARCHAR)
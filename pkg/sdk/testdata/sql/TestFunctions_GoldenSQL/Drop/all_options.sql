@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS "database"."schema"."name" (argument_data_types)
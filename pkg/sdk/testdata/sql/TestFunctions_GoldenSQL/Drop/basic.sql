@@ -0,0 +1 @@
+DROP FUNCTION "database"."schema"."name" (argument_data_types)
@@ -0,0 +1 @@
+SHOW USER FUNCTIONS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
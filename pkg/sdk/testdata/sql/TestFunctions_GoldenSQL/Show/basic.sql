@@ -0,0 +1 @@
+SHOW USER FUNCTIONS
@@ -0,0 +1 @@
+GRANT OWNERSHIP ON FUTURE TABLES IN SCHEMA "database"."schema" TO DATABASE ROLE "database"."database_role" COPY CURRENT GRANTS
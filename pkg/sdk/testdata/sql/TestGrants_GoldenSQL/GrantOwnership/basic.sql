@@ -0,0 +1 @@
+GRANT OWNERSHIP ON TABLE "database"."schema"."table" TO ROLE "role"
@@ -0,0 +1 @@
+GRANT USAGE ON DATABASE "database" TO SHARE "name"
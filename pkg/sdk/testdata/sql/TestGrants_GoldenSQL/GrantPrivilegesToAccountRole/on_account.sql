@@ -0,0 +1 @@
+GRANT MONITOR USAGE, APPLY TAG ON ACCOUNT TO ROLE "name" WITH GRANT OPTION
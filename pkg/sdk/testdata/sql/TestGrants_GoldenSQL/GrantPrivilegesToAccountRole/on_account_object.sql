@@ -0,0 +1 @@
+GRANT ALL PRIVILEGES ON DATABASE "database" TO ROLE "name"
@@ -0,0 +1 @@
+GRANT SELECT ON FUTURE TABLES IN SCHEMA "database"."schema" TO ROLE "name"
@@ -0,0 +1 @@
+GRANT CREATE ALERT ON FUTURE SCHEMAS IN DATABASE "database" TO ROLE "name"
@@ -0,0 +1 @@
+GRANT SELECT, UPDATE ON TABLE "database"."schema"."table" TO ROLE "name"
@@ -0,0 +1 @@
+GRANT USAGE ON DATABASE "database" TO APPLICATION ROLE "database"."name" WITH GRANT OPTION
@@ -0,0 +1 @@
+GRANT CREATE SCHEMA ON DATABASE "database" TO DATABASE ROLE "database"."name" WITH GRANT OPTION
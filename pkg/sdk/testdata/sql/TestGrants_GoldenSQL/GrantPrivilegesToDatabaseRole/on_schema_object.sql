@@ -0,0 +1 @@
+GRANT ALL PRIVILEGES ON TABLE "database"."schema"."table" TO DATABASE ROLE "database"."name"
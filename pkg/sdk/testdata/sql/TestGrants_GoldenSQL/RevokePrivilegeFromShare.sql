@@ -0,0 +1 @@
+REVOKE SELECT ON ALL TABLES IN SCHEMA "database"."schema" FROM SHARE "name"
@@ -0,0 +1 @@
+REVOKE GRANT OPTION FOR SELECT ON ALL TABLES IN SCHEMA "database"."schema" FROM ROLE "name" CASCADE
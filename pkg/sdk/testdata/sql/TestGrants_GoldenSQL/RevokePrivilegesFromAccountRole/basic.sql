@@ -0,0 +1 @@
+REVOKE MONITOR USAGE ON ACCOUNT FROM ROLE "name"
@@ -0,0 +1 @@
+REVOKE GRANT OPTION FOR MONITOR USAGE ON ACCOUNT FROM APPLICATION ROLE "database"."name" CASCADE
@@ -0,0 +1 @@
+REVOKE GRANT OPTION FOR CREATE ALERT ON SCHEMA "database"."schema" FROM DATABASE ROLE "database"."name" RESTRICT
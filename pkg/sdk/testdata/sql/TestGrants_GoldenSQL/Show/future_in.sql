@@ -0,0 +1 @@
+SHOW FUTURE GRANTS IN SCHEMA "database"."schema"
@@ -0,0 +1 @@
+SHOW GRANTS OF DATABASE ROLE "database"."database_role"
@@ -0,0 +1 @@
+SHOW GRANTS ON TABLE "database"."schema"."table"
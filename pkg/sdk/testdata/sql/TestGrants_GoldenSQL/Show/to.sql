@@ -0,0 +1 @@
+SHOW GRANTS TO ROLE "role"
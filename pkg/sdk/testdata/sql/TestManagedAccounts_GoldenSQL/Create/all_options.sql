@@ -0,0 +1 @@
+CREATE MANAGED ACCOUNT "name" ADMIN_NAME = 'admin_name', ADMIN_PASSWORD = 'admin_password', TYPE = READER, COMMENT = 'comment'
@@ -0,0 +1 @@
+DROP MANAGED ACCOUNT "name"
@@ -0,0 +1 @@
+SHOW MANAGED ACCOUNTS LIKE 'pattern'
@@ -0,0 +1 @@
+ALTER MATERIALIZED VIEW "database"."schema"."name" DROP CLUSTERING KEY
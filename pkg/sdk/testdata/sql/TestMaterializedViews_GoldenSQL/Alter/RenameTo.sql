@@ -0,0 +1 @@
+ALTER MATERIALIZED VIEW "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER MATERIALIZED VIEW "database"."schema"."name" SUSPEND RECLUSTER
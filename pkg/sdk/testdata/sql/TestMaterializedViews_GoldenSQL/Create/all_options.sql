@@ -0,0 +1 @@
+CREATE OR REPLACE SECURE MATERIALIZED VIEW "database"."schema"."name" COPY GRANTS ("name" COMMENT 'comment') name MASKING POLICY "database"."schema"."masking_policy" USING (using) TAG ("name" = 'value') COMMENT = 'comment' ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (on) TAG ("name" = 'value') CLUSTER BY ("name") AS SELECT 1
@@ -0,0 +1 @@
+CREATE MATERIALIZED VIEW "database"."schema"."name" AS SELECT 1
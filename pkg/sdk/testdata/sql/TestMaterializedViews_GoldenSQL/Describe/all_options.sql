@@ -0,0 +1 @@
+DESCRIBE MATERIALIZED VIEW "database"."schema"."name"
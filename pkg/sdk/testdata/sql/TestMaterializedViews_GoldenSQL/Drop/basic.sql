@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW "database"."schema"."name"
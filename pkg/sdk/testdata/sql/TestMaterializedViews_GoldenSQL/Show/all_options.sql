@@ -0,0 +1 @@
+SHOW MATERIALIZED VIEWS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
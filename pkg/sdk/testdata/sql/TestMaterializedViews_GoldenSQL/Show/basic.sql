@@ -0,0 +1 @@
+SHOW MATERIALIZED VIEWS
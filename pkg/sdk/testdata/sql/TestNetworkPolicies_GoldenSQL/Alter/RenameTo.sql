@@ -0,0 +1 @@
+ALTER NETWORK POLICY "name" RENAME TO "rename_to"
@@ -0,0 +1 @@
+ALTER NETWORK POLICY "name" UNSET COMMENT
@@ -0,0 +1 @@
+CREATE OR REPLACE NETWORK POLICY "name" ALLOWED_NETWORK_RULE_LIST = ("database"."schema"."allowed_network_rule_list") BLOCKED_NETWORK_RULE_LIST = ("database"."schema"."blocked_network_rule_list") ALLOWED_IP_LIST = ('ip') BLOCKED_IP_LIST = ('ip') COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE NETWORK POLICY "name"
@@ -0,0 +1 @@
+DESCRIBE NETWORK POLICY "name"
@@ -0,0 +1 @@
+DROP NETWORK POLICY IF EXISTS "name"
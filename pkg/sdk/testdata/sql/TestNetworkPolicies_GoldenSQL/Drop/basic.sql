@@ -0,0 +1 @@
+DROP NETWORK POLICY "name"
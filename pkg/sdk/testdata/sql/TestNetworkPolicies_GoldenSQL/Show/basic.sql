@@ -0,0 +1 @@
+SHOW NETWORK POLICIES
@@ -0,0 +1 @@
+ALTER NETWORK RULE "database"."schema"."name" SET VALUE_LIST = ('value'), COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER NETWORK RULE "database"."schema"."name" UNSET VALUE_LIST, COMMENT
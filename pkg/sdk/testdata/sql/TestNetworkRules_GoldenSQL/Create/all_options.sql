@@ -0,0 +1 @@
+CREATE OR REPLACE NETWORK RULE "database"."schema"."name" TYPE = type VALUE_LIST = ('value') MODE = mode COMMENT = 'comment'
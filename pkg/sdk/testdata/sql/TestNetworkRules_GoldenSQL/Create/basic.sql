@@ -0,0 +1 @@
+CREATE NETWORK RULE "database"."schema"."name" TYPE = type VALUE_LIST = ('value') MODE = mode
@@ -0,0 +1 @@
+DESCRIBE NETWORK RULE "database"."schema"."name"
@@ -0,0 +1 @@
+DROP NETWORK RULE IF EXISTS "database"."schema"."name"
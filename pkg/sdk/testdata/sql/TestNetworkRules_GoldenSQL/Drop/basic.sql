@@ -0,0 +1 @@
+DROP NETWORK RULE "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW NETWORK RULES LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema" STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
@@ -0,0 +1 @@
+ALTER NOTIFICATION INTEGRATION "name" SET ENABLED = true AWS_SNS_TOPIC_ARN = 'aws_sns_topic_arn' AWS_SNS_ROLE_ARN = 'aws_sns_role_arn' COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER NOTIFICATION INTEGRATION "name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER NOTIFICATION INTEGRATION "name" UNSET ALLOWED_RECIPIENTS, COMMENT
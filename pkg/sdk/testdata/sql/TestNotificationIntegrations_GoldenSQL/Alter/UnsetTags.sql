@@ -0,0 +1 @@
+ALTER NOTIFICATION INTEGRATION "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE NOTIFICATION INTEGRATION IF NOT EXISTS "name" ENABLED = true TYPE = QUEUE NOTIFICATION_PROVIDER = GCP_PUBSUB GCP_PUBSUB_SUBSCRIPTION_NAME = 'gcp_pubsub_subscription_name' COMMENT = 'comment'
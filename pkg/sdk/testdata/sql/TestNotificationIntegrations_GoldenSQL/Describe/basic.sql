@@ -0,0 +1 @@
+DESCRIBE NOTIFICATION INTEGRATION "name"
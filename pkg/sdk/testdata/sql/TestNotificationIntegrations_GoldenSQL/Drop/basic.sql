@@ -0,0 +1 @@
+DROP NOTIFICATION INTEGRATION "name"
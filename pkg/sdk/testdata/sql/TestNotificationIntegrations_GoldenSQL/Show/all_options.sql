@@ -0,0 +1 @@
+SHOW NOTIFICATION INTEGRATIONS LIKE 'pattern'
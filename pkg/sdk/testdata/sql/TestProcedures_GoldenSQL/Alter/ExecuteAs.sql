@@ -0,0 +1 @@
+ALTER PROCEDURE "database"."schema"."name" (argument_data_types) execute_as
@@ -0,0 +1 @@
+ALTER PROCEDURE "database"."schema"."name" (argument_data_types) SET COMMENT = 'set_comment'
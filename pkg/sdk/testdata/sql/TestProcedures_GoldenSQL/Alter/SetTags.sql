@@ -0,0 +1 @@
+ALTER PROCEDURE "database"."schema"."name" (argument_data_types) SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER PROCEDURE "database"."schema"."name" (argument_data_types) SET TRACE_LEVEL = 'set_trace_level'
@@ -0,0 +1 @@
+CALL "database"."schema"."name" (call_arguments) INTO scripting_variable
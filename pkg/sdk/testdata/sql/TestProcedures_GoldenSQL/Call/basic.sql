@@ -0,0 +1 @@
+CALL "database"."schema"."name" ()
@@ -0,0 +1 @@
+WITH "name" AS PROCEDURE () RETURNS result_data_type LANGUAGE PYTHON RUNTIME_VERSION = 'runtime_version' PACKAGES = ('package') HANDLER = 'handler' CALL "procedure_name" ()
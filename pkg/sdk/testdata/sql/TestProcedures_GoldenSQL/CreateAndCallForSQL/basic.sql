@@ -0,0 +1 @@
+WITH "name" AS PROCEDURE () RETURNS result_data_type LANGUAGE SQL AS 'procedure_definition' CALL "procedure_name" ()
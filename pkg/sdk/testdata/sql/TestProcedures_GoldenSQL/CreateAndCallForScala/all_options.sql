@@ -0,0 +1 @@
+WITH "name" AS PROCEDURE (arg_name arg_data_type DEFAULT default_value) RETURNS result_data_type NULL NOT NULL LANGUAGE SCALA RUNTIME_VERSION = 'runtime_version' PACKAGES = ('package') IMPORTS = ('import') HANDLER = 'handler' null_input_behavior AS 'procedure_definition' , "cte_name" (cte_columns) AS statement CALL "procedure_name" (call_arguments) INTO scripting_variable
@@ -0,0 +1 @@
+CREATE PROCEDURE "database"."schema"."name" () RETURNS result_data_type LANGUAGE JAVA RUNTIME_VERSION = 'runtime_version' PACKAGES = ('package') HANDLER = 'handler'
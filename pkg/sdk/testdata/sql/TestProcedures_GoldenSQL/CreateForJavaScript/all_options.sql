@@ -0,0 +1 @@
+CREATE OR REPLACE SECURE PROCEDURE "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type NOT NULL LANGUAGE JAVASCRIPT null_input_behavior COMMENT = 'comment' execute_as AS 'procedure_definition'
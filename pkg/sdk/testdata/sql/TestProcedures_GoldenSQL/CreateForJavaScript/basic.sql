@@ -0,0 +1 @@
+CREATE PROCEDURE "database"."schema"."name" () RETURNS result_data_type LANGUAGE JAVASCRIPT AS 'procedure_definition'
@@ -0,0 +1 @@
+CREATE PROCEDURE "database"."schema"."name" () RETURNS result_data_type LANGUAGE SQL AS 'procedure_definition'
@@ -0,0 +1 @@
+CREATE OR REPLACE SECURE PROCEDURE "database"."schema"."name" (arg_name arg_data_type DEFAULT default_value) COPY GRANTS RETURNS result_data_type NULL NOT NULL LANGUAGE SCALA RUNTIME_VERSION = 'runtime_version' PACKAGES = ('package') IMPORTS = ('import') HANDLER = 'handler' TARGET_PATH = 'target_path' null_input_behavior COMMENT = 'comment' execute_as AS 'procedure_definition'
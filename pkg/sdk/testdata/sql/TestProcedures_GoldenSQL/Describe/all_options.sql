@@ -0,0 +1 @@
+DESCRIBE PROCEDURE "database"."schema"."name"(VARCHAR)
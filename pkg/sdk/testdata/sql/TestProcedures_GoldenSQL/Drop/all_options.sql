@@ -0,0 +1 @@
+DROP PROCEDURE IF EXISTS "database"."schema"."name" (argument_data_types)
@@ -0,0 +1 @@
+DROP PROCEDURE "database"."schema"."name" (argument_data_types)
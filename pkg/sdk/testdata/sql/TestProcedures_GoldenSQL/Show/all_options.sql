@@ -0,0 +1 @@
+SHOW PROCEDURES LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
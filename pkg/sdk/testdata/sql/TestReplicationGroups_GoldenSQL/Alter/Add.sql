@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" ADD "allowed_databases" TO ALLOWED_DATABASES IGNORE EDITION CHECK
@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" MOVE DATABASES "databases" TO REPLICATION GROUP "to"
@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" REFRESH
@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" REMOVE "allowed_databases" FROM ALLOWED_DATABASES
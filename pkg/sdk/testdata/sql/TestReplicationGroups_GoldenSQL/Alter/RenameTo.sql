@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" RENAME TO "rename_to"
@@ -0,0 +1 @@
+ALTER REPLICATION GROUP "name" SET OBJECT_TYPES = object_types ALLOWED_DATABASES = "allowed_databases" ALLOWED_SHARES = "allowed_shares" ALLOWED_INTEGRATION_TYPES = allowed_integration_types REPLICATION_SCHEDULE = 'replication_schedule'
@@ -0,0 +1 @@
+CREATE REPLICATION GROUP IF NOT EXISTS "name" OBJECT_TYPES = object_types ALLOWED_DATABASES = "allowed_databases" ALLOWED_SHARES = "allowed_shares" ALLOWED_INTEGRATION_TYPES = allowed_integration_types ALLOWED_ACCOUNTS = "organization"."allowed_accounts" IGNORE EDITION CHECK REPLICATION_SCHEDULE = 'replication_schedule'
@@ -0,0 +1 @@
+CREATE REPLICATION GROUP "name" OBJECT_TYPES = object_types ALLOWED_ACCOUNTS = "organization"."allowed_accounts"
@@ -0,0 +1 @@
+CREATE REPLICATION GROUP IF NOT EXISTS "name" AS REPLICA OF "organization"."account"."primary_replication_group"
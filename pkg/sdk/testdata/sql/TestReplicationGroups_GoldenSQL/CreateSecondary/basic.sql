@@ -0,0 +1 @@
+CREATE REPLICATION GROUP "name" AS REPLICA OF "organization"."account"."primary_replication_group"
@@ -0,0 +1 @@
+DROP REPLICATION GROUP "name"
@@ -0,0 +1 @@
+SHOW REPLICATION GROUPS IN ACCOUNT "organization"."in_account"
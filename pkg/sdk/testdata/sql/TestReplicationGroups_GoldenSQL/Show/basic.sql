@@ -0,0 +1 @@
+SHOW REPLICATION GROUPS
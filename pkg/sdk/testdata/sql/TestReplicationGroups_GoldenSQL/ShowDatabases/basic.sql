@@ -0,0 +1 @@
+SHOW DATABASES IN REPLICATION GROUP "in"
@@ -0,0 +1 @@
+SHOW SHARES IN REPLICATION GROUP "in"
@@ -0,0 +1 @@
+ALTER ROLE "name" RENAME TO "rename_to"
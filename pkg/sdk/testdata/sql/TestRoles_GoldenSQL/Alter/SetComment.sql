@@ -0,0 +1 @@
+ALTER ROLE "name" SET COMMENT = 'set_comment'
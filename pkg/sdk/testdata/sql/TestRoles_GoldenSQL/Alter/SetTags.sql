@@ -0,0 +1 @@
+ALTER ROLE "name" SET TAG "name" = 'value'
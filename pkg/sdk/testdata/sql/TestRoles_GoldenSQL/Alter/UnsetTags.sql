@@ -0,0 +1 @@
+ALTER ROLE "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE OR REPLACE ROLE "name" COMMENT = 'comment' TAG ("name" = 'value')
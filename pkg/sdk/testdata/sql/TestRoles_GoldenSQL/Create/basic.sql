@@ -0,0 +1 @@
+CREATE ROLE "name"
@@ -0,0 +1 @@
+DROP ROLE IF EXISTS "name"
@@ -0,0 +1 @@
+DROP ROLE "name"
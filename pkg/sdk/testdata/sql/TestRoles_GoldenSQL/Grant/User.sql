@@ -0,0 +1 @@
+GRANT ROLE "name" TO USER "user"
@@ -0,0 +1 @@
+REVOKE ROLE "name" FROM ROLE "role"
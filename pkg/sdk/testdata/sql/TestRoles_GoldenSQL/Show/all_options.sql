@@ -0,0 +1 @@
+SHOW ROLES LIKE 'pattern' IN CLASS "class"
@@ -0,0 +1 @@
+ALTER ROW ACCESS POLICY "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
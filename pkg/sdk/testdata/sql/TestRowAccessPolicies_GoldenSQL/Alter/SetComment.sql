@@ -0,0 +1 @@
+ALTER ROW ACCESS POLICY "database"."schema"."name" SET COMMENT = 'set_comment'
@@ -0,0 +1 @@
+CREATE OR REPLACE ROW ACCESS POLICY "database"."schema"."name" AS (name type) RETURNS BOOLEAN -> body COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE ROW ACCESS POLICY "database"."schema"."name" AS (name type) RETURNS BOOLEAN -> body
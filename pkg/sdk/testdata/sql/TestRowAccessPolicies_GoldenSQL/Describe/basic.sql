@@ -0,0 +1 @@
+DESCRIBE ROW ACCESS POLICY "database"."schema"."name"
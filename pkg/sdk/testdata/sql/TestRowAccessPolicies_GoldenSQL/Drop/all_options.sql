@@ -0,0 +1 @@
+DROP ROW ACCESS POLICY IF EXISTS "database"."schema"."name"
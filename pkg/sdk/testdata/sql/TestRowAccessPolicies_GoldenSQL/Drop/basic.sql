@@ -0,0 +1 @@
+DROP ROW ACCESS POLICY "database"."schema"."name"
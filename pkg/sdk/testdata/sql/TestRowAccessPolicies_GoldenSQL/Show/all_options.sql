@@ -0,0 +1 @@
+SHOW ROW ACCESS POLICIES LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
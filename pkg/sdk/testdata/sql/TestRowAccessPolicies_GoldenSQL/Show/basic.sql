@@ -0,0 +1 @@
+SHOW ROW ACCESS POLICIES
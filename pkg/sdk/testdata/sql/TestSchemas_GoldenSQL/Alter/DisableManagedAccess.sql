@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" DISABLE MANAGED ACCESS
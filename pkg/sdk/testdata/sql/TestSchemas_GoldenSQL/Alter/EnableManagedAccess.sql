@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" ENABLE MANAGED ACCESS
@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" RENAME TO "database"."new_name"
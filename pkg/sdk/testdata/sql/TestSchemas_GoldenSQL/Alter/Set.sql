@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" SET DATA_RETENTION_TIME_IN_DAYS = 1, MAX_DATA_EXTENSION_TIME_IN_DAYS = 1, DEFAULT_DDL_COLLATION = 'default_ddl_collation', COMMENT = 'comment'
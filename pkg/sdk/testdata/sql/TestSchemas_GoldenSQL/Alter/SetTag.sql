@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" SET TAG "name" = 'value'
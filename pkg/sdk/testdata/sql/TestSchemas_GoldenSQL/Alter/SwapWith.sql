@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" SWAP WITH "database"."swap_with"
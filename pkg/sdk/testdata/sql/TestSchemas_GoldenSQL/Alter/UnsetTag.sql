@@ -0,0 +1 @@
+ALTER SCHEMA "database"."name" UNSET TAG "unset_tag"
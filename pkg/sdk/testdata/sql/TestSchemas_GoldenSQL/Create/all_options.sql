@@ -0,0 +1 @@
+CREATE OR REPLACE TRANSIENT SCHEMA "database"."name" WITH MANAGED ACCESS DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 DEFAULT_DDL_COLLATION = 'default_ddl_collation' TAG ("name" = 'value') COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SCHEMA "database"."name"
@@ -0,0 +1 @@
+DESCRIBE SCHEMA "database"."name"
@@ -0,0 +1 @@
+DROP SCHEMA "database"."name"
@@ -0,0 +1 @@
+SHOW TERSE SCHEMAS HISTORY LIKE 'pattern' IN DATABASE "name" STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
@@ -0,0 +1 @@
+SHOW SCHEMAS
@@ -0,0 +1 @@
+UNDROP SCHEMA "database"."name"
@@ -0,0 +1 @@
+ALTER SECRET "database"."schema"."name" SET OAUTH_SCOPES = ('scope') OAUTH_REFRESH_TOKEN = 'oauth_refresh_token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = 'oauth_refresh_token_expiry_time' USERNAME = 'username' PASSWORD = 'password' SECRET_STRING = 'secret_string' COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER SECRET "database"."schema"."name" UNSET COMMENT
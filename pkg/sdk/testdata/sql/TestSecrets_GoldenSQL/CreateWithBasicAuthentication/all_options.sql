@@ -0,0 +1 @@
+CREATE OR REPLACE SECRET "database"."schema"."name" TYPE = PASSWORD USERNAME = 'username' PASSWORD = 'password' COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SECRET "database"."schema"."name" TYPE = PASSWORD USERNAME = 'username' PASSWORD = 'password'
@@ -0,0 +1 @@
+CREATE OR REPLACE SECRET "database"."schema"."name" TYPE = CLOUD_PROVIDER_TOKEN API_AUTHENTICATION = "security_integration" ENABLED = true COMMENT = 'comment'
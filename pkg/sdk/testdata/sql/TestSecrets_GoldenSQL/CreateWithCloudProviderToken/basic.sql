@@ -0,0 +1 @@
+CREATE SECRET "database"."schema"."name" TYPE = CLOUD_PROVIDER_TOKEN API_AUTHENTICATION = "security_integration"
@@ -0,0 +1 @@
+CREATE OR REPLACE SECRET "database"."schema"."name" TYPE = GENERIC_STRING SECRET_STRING = 'secret_string' COMMENT = 'comment'
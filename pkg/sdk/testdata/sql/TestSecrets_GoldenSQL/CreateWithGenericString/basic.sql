@@ -0,0 +1 @@
+CREATE SECRET "database"."schema"."name" TYPE = GENERIC_STRING SECRET_STRING = 'secret_string'
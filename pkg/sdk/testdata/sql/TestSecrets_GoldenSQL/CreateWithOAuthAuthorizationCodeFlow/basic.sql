@@ -0,0 +1 @@
+CREATE SECRET "database"."schema"."name" TYPE = OAUTH2 OAUTH_REFRESH_TOKEN = 'oauth_refresh_token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = 'oauth_refresh_token_expiry_time' API_AUTHENTICATION = "security_integration"
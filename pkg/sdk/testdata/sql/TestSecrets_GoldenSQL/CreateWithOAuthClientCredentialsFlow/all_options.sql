@@ -0,0 +1 @@
+CREATE OR REPLACE SECRET "database"."schema"."name" TYPE = OAUTH2 API_AUTHENTICATION = "security_integration" OAUTH_SCOPES = ('scope') COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SECRET "database"."schema"."name" TYPE = OAUTH2 API_AUTHENTICATION = "security_integration"
@@ -0,0 +1 @@
+DESCRIBE SECRET "database"."schema"."name"
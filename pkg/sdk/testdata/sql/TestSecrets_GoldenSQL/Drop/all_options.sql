@@ -0,0 +1 @@
+DROP SECRET IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP SECRET "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW SECRETS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
@@ -0,0 +1 @@
+ALTER SECURITY INTEGRATION "name" SET ENABLED = true
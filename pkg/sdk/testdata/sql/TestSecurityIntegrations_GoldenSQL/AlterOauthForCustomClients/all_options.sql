@@ -0,0 +1 @@
+ALTER SECURITY INTEGRATION IF EXISTS "name" SET ENABLED = true OAUTH_REDIRECT_URI = 'oauth_redirect_uri' OAUTH_ALLOW_NON_TLS_REDIRECT_URI = true OAUTH_ENFORCE_PKCE = true PRE_AUTHORIZED_ROLES_LIST = ("pre_authorized_roles_list") BLOCKED_ROLES_LIST = ("blocked_roles_list") OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 1 OAUTH_USE_SECONDARY_ROLES = oauth_use_secondary_roles NETWORK_POLICY = 'network_policy' OAUTH_CLIENT_RSA_PUBLIC_KEY = 'oauth_client_rsa_public_key' OAUTH_CLIENT_RSA_PUBLIC_KEY_2 = 'oauth_client_rsa_public_key2' COMMENT = 'comment'
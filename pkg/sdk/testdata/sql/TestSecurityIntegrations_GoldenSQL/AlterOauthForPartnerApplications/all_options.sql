@@ -0,0 +1 @@
+ALTER SECURITY INTEGRATION IF EXISTS "name" SET ENABLED = true OAUTH_REDIRECT_URI = 'oauth_redirect_uri' OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 1 OAUTH_USE_SECONDARY_ROLES = oauth_use_secondary_roles BLOCKED_ROLES_LIST = ("blocked_roles_list") COMMENT = 'comment'
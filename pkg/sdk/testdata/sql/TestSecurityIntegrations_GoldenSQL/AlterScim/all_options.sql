@@ -0,0 +1 @@
+ALTER SECURITY INTEGRATION IF EXISTS "name" SET ENABLED = true NETWORK_POLICY = 'network_policy' SYNC_PASSWORD = true COMMENT = 'comment'
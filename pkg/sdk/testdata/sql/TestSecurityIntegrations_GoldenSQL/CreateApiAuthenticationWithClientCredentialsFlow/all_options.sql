@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_TOKEN_ENDPOINT = 'oauth_token_endpoint' OAUTH_CLIENT_AUTH_METHOD = oauth_client_auth_method OAUTH_CLIENT_ID = 'oauth_client_id' OAUTH_CLIENT_SECRET = 'oauth_client_secret' OAUTH_GRANT = CLIENT_CREDENTIALS OAUTH_ACCESS_TOKEN_VALIDITY = 1 OAUTH_REFRESH_TOKEN_VALIDITY = 1 OAUTH_ALLOWED_SCOPES = ('scope') COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 ENABLED = true OAUTH_CLIENT_ID = 'oauth_client_id' OAUTH_CLIENT_SECRET = 'oauth_client_secret' OAUTH_GRANT = CLIENT_CREDENTIALS
@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = external_oauth_type EXTERNAL_OAUTH_ISSUER = 'external_oauth_issuer' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('claim') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'external_oauth_snowflake_user_mapping_attribute' EXTERNAL_OAUTH_JWS_KEYS_URL = ('jws_key_url') EXTERNAL_OAUTH_BLOCKED_ROLES_LIST = ("blocked_roles_list") EXTERNAL_OAUTH_AUDIENCE_LIST = ('item') EXTERNAL_OAUTH_ANY_ROLE_MODE = external_oauth_any_role_mode EXTERNAL_OAUTH_SCOPE_DELIMITER = 'external_oauth_scope_delimiter' EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE = 'external_oauth_scope_mapping_attribute' COMMENT = 'comment'
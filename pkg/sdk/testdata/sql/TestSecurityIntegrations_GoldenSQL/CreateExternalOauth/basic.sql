@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = external_oauth_type EXTERNAL_OAUTH_ISSUER = 'external_oauth_issuer' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('claim') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'external_oauth_snowflake_user_mapping_attribute' EXTERNAL_OAUTH_JWS_KEYS_URL = ('jws_key_url')
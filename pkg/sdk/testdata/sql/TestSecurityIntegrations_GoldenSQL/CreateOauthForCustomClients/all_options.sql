@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = OAUTH OAUTH_CLIENT = CUSTOM OAUTH_CLIENT_TYPE = 'oauth_client_type' OAUTH_REDIRECT_URI = 'oauth_redirect_uri' ENABLED = true OAUTH_ALLOW_NON_TLS_REDIRECT_URI = true OAUTH_ENFORCE_PKCE = true OAUTH_USE_SECONDARY_ROLES = oauth_use_secondary_roles PRE_AUTHORIZED_ROLES_LIST = ("pre_authorized_roles_list") BLOCKED_ROLES_LIST = ("blocked_roles_list") OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 1 NETWORK_POLICY = 'network_policy' OAUTH_CLIENT_RSA_PUBLIC_KEY = 'oauth_client_rsa_public_key' OAUTH_CLIENT_RSA_PUBLIC_KEY_2 = 'oauth_client_rsa_public_key2' COMMENT = 'comment'
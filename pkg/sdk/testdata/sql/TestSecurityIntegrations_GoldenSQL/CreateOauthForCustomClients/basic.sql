@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = OAUTH OAUTH_CLIENT = CUSTOM OAUTH_CLIENT_TYPE = 'oauth_client_type' OAUTH_REDIRECT_URI = 'oauth_redirect_uri'
@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = OAUTH OAUTH_CLIENT = oauth_client OAUTH_REDIRECT_URI = 'oauth_redirect_uri' ENABLED = true OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 1 OAUTH_USE_SECONDARY_ROLES = oauth_use_secondary_roles BLOCKED_ROLES_LIST = ("blocked_roles_list") COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = OAUTH OAUTH_CLIENT = oauth_client
@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'saml2_issuer' SAML2_SSO_URL = 'saml2_sso_url' SAML2_PROVIDER = 'saml2_provider' SAML2_X509_CERT = 'saml2x509_cert' ALLOWED_USER_DOMAINS = ('domain') ALLOWED_EMAIL_PATTERNS = ('pattern') SAML2_SP_INITIATED_LOGIN_PAGE_LABEL = 'saml2_sp_initiated_login_page_label' SAML2_ENABLE_SP_INITIATED = true SAML2_SNOWFLAKE_X509_CERT = 'saml2_snowflake_x509_cert' SAML2_SIGN_REQUEST = true SAML2_REQUESTED_NAMEID_FORMAT = 'saml2_requested_nameid_format' SAML2_POST_LOGOUT_REDIRECT_URL = 'saml2_post_logout_redirect_url' SAML2_FORCE_AUTHN = true SAML2_SNOWFLAKE_ISSUER_URL = 'saml2_snowflake_issuer_url' SAML2_SNOWFLAKE_ACS_URL = 'saml2_snowflake_acs_url' COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'saml2_issuer' SAML2_SSO_URL = 'saml2_sso_url' SAML2_PROVIDER = 'saml2_provider' SAML2_X509_CERT = 'saml2x509_cert'
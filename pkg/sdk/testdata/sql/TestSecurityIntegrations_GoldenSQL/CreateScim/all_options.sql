@@ -0,0 +1 @@
+CREATE OR REPLACE SECURITY INTEGRATION "name" TYPE = SCIM ENABLED = true SCIM_CLIENT = 'scim_client' RUN_AS_ROLE = 'run_as_role' NETWORK_POLICY = 'network_policy' SYNC_PASSWORD = true COMMENT = 'comment'
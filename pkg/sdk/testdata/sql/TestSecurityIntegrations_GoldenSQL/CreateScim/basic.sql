@@ -0,0 +1 @@
+CREATE SECURITY INTEGRATION "name" TYPE = SCIM SCIM_CLIENT = 'scim_client' RUN_AS_ROLE = 'run_as_role'
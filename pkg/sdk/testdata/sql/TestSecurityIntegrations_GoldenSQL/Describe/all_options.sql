@@ -0,0 +1 @@
+DESCRIBE SECURITY INTEGRATION "name"
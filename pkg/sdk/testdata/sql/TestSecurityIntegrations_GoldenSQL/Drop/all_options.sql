@@ -0,0 +1 @@
+DROP SECURITY INTEGRATION IF EXISTS "name"
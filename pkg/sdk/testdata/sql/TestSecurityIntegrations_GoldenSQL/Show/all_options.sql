@@ -0,0 +1 @@
+SHOW SECURITY INTEGRATIONS LIKE 'pattern'
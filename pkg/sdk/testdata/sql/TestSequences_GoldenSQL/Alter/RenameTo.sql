@@ -0,0 +1 @@
+ALTER SEQUENCE "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
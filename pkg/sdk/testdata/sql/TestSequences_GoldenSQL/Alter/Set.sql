@@ -0,0 +1 @@
+ALTER SEQUENCE "database"."schema"."name" SET values_behavior COMMENT = 'comment'
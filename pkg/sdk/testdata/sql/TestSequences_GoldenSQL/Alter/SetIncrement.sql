@@ -0,0 +1 @@
+ALTER SEQUENCE "database"."schema"."name" SET INCREMENT = 1
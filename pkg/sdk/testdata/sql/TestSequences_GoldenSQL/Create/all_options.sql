@@ -0,0 +1 @@
+CREATE OR REPLACE SEQUENCE "database"."schema"."name" START = 1 INCREMENT = 1 values_behavior COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE SEQUENCE "database"."schema"."name"
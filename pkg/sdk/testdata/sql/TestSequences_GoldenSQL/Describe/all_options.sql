@@ -0,0 +1 @@
+DESCRIBE SEQUENCE "database"."schema"."name"
@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS "database"."schema"."name" CASCADE
@@ -0,0 +1 @@
+DROP SEQUENCE "database"."schema"."name"
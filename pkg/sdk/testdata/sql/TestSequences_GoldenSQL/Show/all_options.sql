@@ -0,0 +1 @@
+SHOW SEQUENCES LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
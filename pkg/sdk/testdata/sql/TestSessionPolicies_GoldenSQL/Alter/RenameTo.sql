@@ -0,0 +1 @@
+ALTER SESSION POLICY "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER SESSION POLICY "database"."schema"."name" SET SESSION_IDLE_TIMEOUT_MINS = 1 SESSION_UI_IDLE_TIMEOUT_MINS = 1 COMMENT = 'comment'
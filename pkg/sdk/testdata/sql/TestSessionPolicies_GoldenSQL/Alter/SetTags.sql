@@ -0,0 +1 @@
+ALTER SESSION POLICY "database"."schema"."name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER SESSION POLICY "database"."schema"."name" UNSET SESSION_IDLE_TIMEOUT_MINS SESSION_UI_IDLE_TIMEOUT_MINS COMMENT
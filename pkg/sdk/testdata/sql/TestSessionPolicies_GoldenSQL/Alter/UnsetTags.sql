@@ -0,0 +1 @@
+ALTER SESSION POLICY "database"."schema"."name" UNSET TAG "unset_tags"
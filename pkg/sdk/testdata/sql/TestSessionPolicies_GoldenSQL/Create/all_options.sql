@@ -0,0 +1 @@
+CREATE OR REPLACE SESSION POLICY "database"."schema"."name" SESSION_IDLE_TIMEOUT_MINS = 1 SESSION_UI_IDLE_TIMEOUT_MINS = 1 COMMENT = 'comment'
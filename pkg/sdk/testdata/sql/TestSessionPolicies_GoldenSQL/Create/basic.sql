@@ -0,0 +1 @@
+CREATE SESSION POLICY "database"."schema"."name"
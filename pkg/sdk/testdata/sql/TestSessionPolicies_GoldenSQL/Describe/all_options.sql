@@ -0,0 +1 @@
+DESCRIBE SESSION POLICY "database"."schema"."name"
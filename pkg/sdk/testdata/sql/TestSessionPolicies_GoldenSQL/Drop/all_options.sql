@@ -0,0 +1 @@
+DROP SESSION POLICY IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP SESSION POLICY "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW SESSION POLICIES
@@ -0,0 +1 @@
+ALTER STAGE "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER STAGE "database"."schema"."name" SET TAG "name" = 'value'
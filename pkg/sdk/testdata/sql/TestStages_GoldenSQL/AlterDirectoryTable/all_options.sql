@@ -0,0 +1 @@
+ALTER STAGE IF EXISTS "database"."schema"."name" SET DIRECTORY = (ENABLE = true)
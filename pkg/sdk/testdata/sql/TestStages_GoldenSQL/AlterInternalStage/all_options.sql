@@ -0,0 +1 @@
+ALTER STAGE IF EXISTS "database"."schema"."name" SET FILE_FORMAT = (FORMAT_NAME = 'format_name', TYPE = type, COMMENT = 'comment' COMPRESSION = csv_compression RECORD_DELIMITER = 'csv_record_delimiter' FIELD_DELIMITER = 'csv_field_delimiter' FILE_EXTENSION = 'csv_file_extension' PARSE_HEADER = true SKIP_HEADER = 1 SKIP_BLANK_LINES = true DATE_FORMAT = 'csv_date_format' TIME_FORMAT = 'csv_time_format' TIMESTAMP_FORMAT = 'csv_timestamp_format' BINARY_FORMAT = csv_binary_format ESCAPE = 'csv_escape' ESCAPE_UNENCLOSED_FIELD = 'csv_escape_unenclosed_field' TRIM_SPACE = true FIELD_OPTIONALLY_ENCLOSED_BY = 'csv_field_optionally_enclosed_by' NULL_IF = ('s') ERROR_ON_COLUMN_COUNT_MISMATCH = true REPLACE_INVALID_CHARACTERS = true EMPTY_FIELD_AS_NULL = true SKIP_BYTE_ORDER_MARK = true ENCODING = 'csv_encoding' COMPRESSION = json_compression DATE_FORMAT = 'json_date_format' TIME_FORMAT = 'json_time_format' TIMESTAMP_FORMAT = 'json_timestamp_format' BINARY_FORMAT = json_binary_format TRIM_SPACE = true NULL_IF = ('s') FILE_EXTENSION = 'json_file_extension' ENABLE_OCTAL = true ALLOW_DUPLICATE = true STRIP_OUTER_ARRAY = true STRIP_NULL_VALUES = true REPLACE_INVALID_CHARACTERS = true IGNORE_UTF8_ERRORS = true SKIP_BYTE_ORDER_MARK = true COMPRESSION = avro_compression TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('s') TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('s') COMPRESSION = parquet_compression SNAPPY_COMPRESSION = true BINARY_AS_TEXT = true TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('s') COMPRESSION = xml_compression IGNORE_UTF8_ERRORS = true PRESERVE_SPACE = true STRIP_OUTER_ELEMENT = true DISABLE_SNOWFLAKE_DATA = true DISABLE_AUTO_CONVERT = true REPLACE_INVALID_CHARACTERS = true SKIP_BYTE_ORDER_MARK = true) COPY_OPTIONS = (ON_ERROR = CONTINUE SIZE_LIMIT = 1 PURGE = true RETURN_FAILED_ONLY = true MATCH_BY_COLUMN_NAME = match_by_column_name ENFORCE_LENGTH = true TRUNCATECOLUMNS = true FORCE = true) COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE STAGE "database"."schema"."name" URL = 'url' ENDPOINT = 'endpoint'
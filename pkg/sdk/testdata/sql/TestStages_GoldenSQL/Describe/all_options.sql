@@ -0,0 +1 @@
+DESCRIBE STAGE "database"."schema"."name"
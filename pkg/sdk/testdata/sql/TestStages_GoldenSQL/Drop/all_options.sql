@@ -0,0 +1 @@
+DROP STAGE IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP STAGE "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW STAGES LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema"
@@ -0,0 +1 @@
+ALTER STORAGE INTEGRATION "name" SET STORAGE_AWS_ROLE_ARN = 'storage_aws_role_arn' STORAGE_AWS_OBJECT_ACL = 'storage_aws_object_acl' AZURE_TENANT_ID = 'azure_tenant_id' ENABLED = true STORAGE_ALLOWED_LOCATIONS = ('path') STORAGE_BLOCKED_LOCATIONS = ('path') COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER STORAGE INTEGRATION "name" SET TAG "name" = 'value'
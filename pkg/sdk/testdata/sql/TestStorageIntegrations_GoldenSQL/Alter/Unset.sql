@@ -0,0 +1 @@
+ALTER STORAGE INTEGRATION "name" UNSET STORAGE_AWS_OBJECT_ACL, ENABLED, STORAGE_BLOCKED_LOCATIONS, COMMENT
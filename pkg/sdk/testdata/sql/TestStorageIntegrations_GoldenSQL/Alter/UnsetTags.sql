@@ -0,0 +1 @@
+ALTER STORAGE INTEGRATION "name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE STORAGE INTEGRATION IF NOT EXISTS "name" TYPE = EXTERNAL_STAGE STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'storage_aws_role_arn' STORAGE_AWS_OBJECT_ACL = 'storage_aws_object_acl' ENABLED = true STORAGE_ALLOWED_LOCATIONS = ('path') STORAGE_BLOCKED_LOCATIONS = ('path') COMMENT = 'comment'
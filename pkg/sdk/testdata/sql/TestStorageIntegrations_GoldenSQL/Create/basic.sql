@@ -0,0 +1 @@
+CREATE STORAGE INTEGRATION "name" TYPE = EXTERNAL_STAGE STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'storage_aws_role_arn' ENABLED = true STORAGE_ALLOWED_LOCATIONS = ('path')
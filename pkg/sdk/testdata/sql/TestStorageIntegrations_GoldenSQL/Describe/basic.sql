@@ -0,0 +1 @@
+DESCRIBE STORAGE INTEGRATION "name"
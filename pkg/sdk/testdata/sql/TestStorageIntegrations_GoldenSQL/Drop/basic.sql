@@ -0,0 +1 @@
+DROP STORAGE INTEGRATION "name"
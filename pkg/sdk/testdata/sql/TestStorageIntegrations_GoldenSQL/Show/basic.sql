@@ -0,0 +1 @@
+SHOW STORAGE INTEGRATIONS
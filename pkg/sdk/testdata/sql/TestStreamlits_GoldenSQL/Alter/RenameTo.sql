@@ -0,0 +1 @@
+ALTER STREAMLIT "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER STREAMLIT "database"."schema"."name" SET ROOT_LOCATION = 'root_location' MAIN_FILE = 'main_file' QUERY_WAREHOUSE = "warehouse" COMMENT = 'comment' TITLE = 'title'
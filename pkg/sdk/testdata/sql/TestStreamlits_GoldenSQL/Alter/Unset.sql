@@ -0,0 +1 @@
+ALTER STREAMLIT "database"."schema"."name" UNSET QUERY_WAREHOUSE, COMMENT, TITLE
@@ -0,0 +1 @@
+CREATE STREAMLIT IF NOT EXISTS "database"."schema"."name" ROOT_LOCATION = 'root_location' MAIN_FILE = 'main_file' QUERY_WAREHOUSE = "warehouse" COMMENT = 'comment' TITLE = 'title'
@@ -0,0 +1 @@
+CREATE STREAMLIT "database"."schema"."name" ROOT_LOCATION = 'root_location' MAIN_FILE = 'main_file'
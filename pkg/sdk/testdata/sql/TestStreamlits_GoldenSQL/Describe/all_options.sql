@@ -0,0 +1 @@
+DESCRIBE STREAMLIT "database"."schema"."name"
@@ -0,0 +1 @@
+DROP STREAMLIT IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP STREAMLIT "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW TERSE STREAMLITS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema" LIMIT 1 FROM 'from'
@@ -0,0 +1 @@
+ALTER STREAM "database"."schema"."name" SET COMMENT = 'set_comment'
@@ -0,0 +1 @@
+ALTER STREAM "database"."schema"."name" SET TAG "name" = 'value'
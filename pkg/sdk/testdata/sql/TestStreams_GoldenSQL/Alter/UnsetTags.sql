@@ -0,0 +1 @@
+ALTER STREAM "database"."schema"."name" UNSET TAG "unset_tags"
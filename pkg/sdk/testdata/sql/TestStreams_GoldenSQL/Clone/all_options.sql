@@ -0,0 +1 @@
+CREATE OR REPLACE STREAM "database"."schema"."name" CLONE "database"."schema"."source_stream" COPY GRANTS
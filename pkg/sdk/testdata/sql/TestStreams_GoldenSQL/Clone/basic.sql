@@ -0,0 +1 @@
+CREATE STREAM "database"."schema"."name" CLONE "database"."schema"."source_stream"
@@ -0,0 +1 @@
+CREATE STREAM IF NOT EXISTS "database"."schema"."name" COPY GRANTS ON STAGE "database"."schema"."stage_id" COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE STREAM "database"."schema"."name" ON STAGE "database"."schema"."stage_id"
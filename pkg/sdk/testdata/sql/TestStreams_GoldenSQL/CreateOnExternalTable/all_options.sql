@@ -0,0 +1 @@
+CREATE STREAM IF NOT EXISTS "database"."schema"."name" COPY GRANTS ON EXTERNAL TABLE "database"."schema"."external_table_id" AT (TIMESTAMP => timestamp) INSERT_ONLY = true COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE STREAM "database"."schema"."name" ON EXTERNAL TABLE "database"."schema"."external_table_id"
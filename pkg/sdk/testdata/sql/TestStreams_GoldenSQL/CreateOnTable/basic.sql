@@ -0,0 +1 @@
+CREATE STREAM "database"."schema"."name" ON TABLE "database"."schema"."table_id"
@@ -0,0 +1 @@
+CREATE STREAM IF NOT EXISTS "database"."schema"."name" COPY GRANTS ON VIEW "database"."schema"."view_id" AT (TIMESTAMP => timestamp) APPEND_ONLY = true SHOW_INITIAL_ROWS = true COMMENT = 'comment'
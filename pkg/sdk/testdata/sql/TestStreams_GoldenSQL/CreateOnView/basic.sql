@@ -0,0 +1 @@
+CREATE STREAM "database"."schema"."name" ON VIEW "database"."schema"."view_id"
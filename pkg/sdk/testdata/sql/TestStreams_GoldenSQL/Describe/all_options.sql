@@ -0,0 +1 @@
+DESCRIBE STREAM "database"."schema"."name"
@@ -0,0 +1 @@
+DROP STREAM IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP STREAM "database"."schema"."name"
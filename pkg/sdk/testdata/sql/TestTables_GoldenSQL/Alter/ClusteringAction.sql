@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" CLUSTER BY (column)
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" ADD COLUMN IF NOT EXISTS column VARCHAR COMMENT 'column comment'
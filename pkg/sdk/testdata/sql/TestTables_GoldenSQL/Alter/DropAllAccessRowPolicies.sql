@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" DROP ALL ROW ACCESS POLICIES
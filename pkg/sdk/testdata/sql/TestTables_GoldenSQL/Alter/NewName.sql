@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS "database"."schema"."name" RENAME TO "database"."schema"."new_name"
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" ADD SEARCH OPTIMIZATION ON EQUALITY(column)
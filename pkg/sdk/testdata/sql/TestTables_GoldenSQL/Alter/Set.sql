@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" SET ENABLE_SCHEMA_EVOLUTION = true DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' COMMENT = 'comment'
@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" SWAP WITH "database"."schema"."swap_with"
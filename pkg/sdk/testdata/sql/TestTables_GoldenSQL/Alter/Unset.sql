@@ -0,0 +1 @@
+ALTER TABLE "database"."schema"."name" UNSET DATA_RETENTION_TIME_IN_DAYS COMMENT
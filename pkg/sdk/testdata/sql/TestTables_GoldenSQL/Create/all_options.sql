@@ -0,0 +1 @@
+CREATE OR REPLACE GLOBAL TRANSIENT TABLE "database"."schema"."name" (column VARCHAR CONSTRAINT inline_constraint UNIQUE NOT NULL COLLATE 'de' DEFAULT 'default' MASKING POLICY "database"."schema"."masking_policy" USING (column) TAG ("database"."schema"."tag" = 'value') COMMENT 'column comment', CONSTRAINT out_of_line_constraint PRIMARY KEY (column)) CLUSTER BY (column) ENABLE_SCHEMA_EVOLUTION = true STAGE_FILE_FORMAT = (FORMAT_NAME = 'format_name') DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' COPY GRANTS ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (column) TAG ("database"."schema"."tag" = 'value') COMMENT = 'comment'
@@ -0,0 +1 @@
+CREATE TABLE "database"."schema"."name" (column VARCHAR)
@@ -0,0 +1 @@
+CREATE OR REPLACE TABLE "database"."schema"."name" (column VARCHAR MASKING POLICY "database"."schema"."masking_policy") CLUSTER BY (column) COPY GRANTS ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (column) AS SELECT column FROM source_table
@@ -0,0 +1 @@
+CREATE TABLE "database"."schema"."name" (column) AS SELECT column FROM source_table
@@ -0,0 +1 @@
+CREATE OR REPLACE TABLE "database"."schema"."name" CLONE "database"."schema"."source_table" AT (OFFSET => -60) COPY GRANTS
@@ -0,0 +1 @@
+CREATE OR REPLACE TABLE "database"."schema"."name" LIKE "database"."schema"."source_table" CLUSTER BY (column) COPY GRANTS
@@ -0,0 +1 @@
+CREATE OR REPLACE TABLE "database"."schema"."name" COPY GRANTS USING TEMPLATE (SELECT ARRAY_AGG(OBJECT_CONSTRUCT(*)) FROM TABLE(INFER_SCHEMA(LOCATION => '@stage', FILE_FORMAT => 'format')))
@@ -0,0 +1 @@
+DESCRIBE TABLE "database"."schema"."name" TYPE = COLUMNS
@@ -0,0 +1 @@
+DROP TABLE "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW TERSE TABLES HISTORY LIKE 'pattern' IN SCHEMA "database"."schema" STARTS WITH 'starts_with' LIMIT 10 FROM 'from'
@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" ADD AFTER "database"."schema"."add_after"
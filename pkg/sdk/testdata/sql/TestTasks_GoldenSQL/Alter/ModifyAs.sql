@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" MODIFY AS SELECT 1
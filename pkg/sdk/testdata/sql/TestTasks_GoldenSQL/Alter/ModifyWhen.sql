@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" MODIFY WHEN SYSTEM$STREAM_HAS_DATA('stream')
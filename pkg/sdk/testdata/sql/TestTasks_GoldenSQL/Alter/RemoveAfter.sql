@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" REMOVE AFTER "database"."schema"."remove_after"
@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" RESUME
@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER TASK "database"."schema"."name" UNSET TAG "unset_tags"
@@ -0,0 +1 @@
+CREATE OR REPLACE TASK "database"."schema"."name" CLONE "database"."schema"."source_task" COPY GRANTS
@@ -0,0 +1 @@
+CREATE TASK "database"."schema"."name" CLONE "database"."schema"."source_task"
@@ -0,0 +1 @@
+CREATE OR REPLACE TASK "database"."schema"."name" WAREHOUSE = "warehouse" SCHEDULE = 'schedule' CONFIG = $${"output_dir": "/temp/test_directory/"}$$ ALLOW_OVERLAPPING_EXECUTION = true ABORT_DETACHED_QUERY = true, AUTOCOMMIT = true, BINARY_INPUT_FORMAT = 'binary_input_format', BINARY_OUTPUT_FORMAT = 'binary_output_format', CLIENT_METADATA_REQUEST_USE_CONNECTION_CTX = true, CLIENT_METADATA_USE_SESSION_DATABASE = true, CLIENT_RESULT_COLUMN_CASE_INSENSITIVE = true, DATE_INPUT_FORMAT = 'date_input_format', DATE_OUTPUT_FORMAT = 'date_output_format', ERROR_ON_NONDETERMINISTIC_MERGE = true, ERROR_ON_NONDETERMINISTIC_UPDATE = true, GEOGRAPHY_OUTPUT_FORMAT = 'geography_output_format', JSON_INDENT = 1, LOCK_TIMEOUT = 1, MULTI_STATEMENT_COUNT = 1, QUERY_TAG = 'query_tag', QUOTED_IDENTIFIERS_IGNORE_CASE = true, ROWS_PER_RESULTSET = 1, SIMULATED_DATA_SHARING_CONSUMER = 'simulated_data_sharing_consumer', STATEMENT_TIMEOUT_IN_SECONDS = 1, STRICT_JSON_OUTPUT = true, TIMESTAMP_DAY_IS_ALWAYS_24H = true, TIMESTAMP_INPUT_FORMAT = 'timestamp_input_format', TIMESTAMP_LTZ_OUTPUT_FORMAT = 'timestamp_ltz_output_format', TIMESTAMP_NTZ_OUTPUT_FORMAT = 'timestamp_ntz_output_format', TIMESTAMP_OUTPUT_FORMAT = 'timestamp_output_format', TIMESTAMP_TYPE_MAPPING = 'timestamp_type_mapping', TIMESTAMP_TZ_OUTPUT_FORMAT = 'timestamp_tz_output_format', TIMEZONE = 'timezone', TIME_INPUT_FORMAT = 'time_input_format', TIME_OUTPUT_FORMAT = 'time_output_format', TRANSACTION_ABORT_ON_ERROR = true, TRANSACTION_DEFAULT_ISOLATION_LEVEL = 'transaction_default_isolation_level', TWO_DIGIT_CENTURY_START = 1970, UNSUPPORTED_DDL_ACTION = 'unsupported_ddl_action', USE_CACHED_RESULT = true, WEEK_OF_YEAR_POLICY = 1, WEEK_START = 1 USER_TASK_TIMEOUT_MS = 1 SUSPEND_TASK_AFTER_NUM_FAILURES = 1 ERROR_INTEGRATION = error_integration COPY GRANTS COMMENT = 'comment' AFTER "database"."schema"."after" TAG ("name" = 'value') WHEN SYSTEM$STREAM_HAS_DATA('stream') AS SELECT 1
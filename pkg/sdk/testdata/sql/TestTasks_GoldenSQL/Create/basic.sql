@@ -0,0 +1 @@
+CREATE TASK "database"."schema"."name" AS SELECT 1
@@ -0,0 +1 @@
+DESCRIBE TASK "database"."schema"."name"
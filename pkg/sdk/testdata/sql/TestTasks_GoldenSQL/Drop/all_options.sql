@@ -0,0 +1 @@
+DROP TASK IF EXISTS "database"."schema"."name"
@@ -0,0 +1 @@
+DROP TASK "database"."schema"."name"
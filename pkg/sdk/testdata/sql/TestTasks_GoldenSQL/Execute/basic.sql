@@ -0,0 +1 @@
+EXECUTE TASK "database"."schema"."name"
@@ -0,0 +1 @@
+SHOW TERSE TASKS LIKE 'pattern' IN ACCOUNT DATABASE "database" SCHEMA "database"."schema" STARTS WITH 'starts_with' ROOT ONLY LIMIT 1 FROM 'from'
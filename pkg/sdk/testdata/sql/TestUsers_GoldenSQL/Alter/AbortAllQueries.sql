@@ -0,0 +1 @@
+ALTER USER "name" ABORT ALL QUERIES
@@ -0,0 +1 @@
+ALTER USER "name" ADD DELEGATED AUTHORIZATION OF ROLE role TO SECURITY INTEGRATION integration
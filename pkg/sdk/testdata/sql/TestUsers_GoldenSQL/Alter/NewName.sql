@@ -0,0 +1 @@
+ALTER USER "name" RENAME TO "new_name"
@@ -0,0 +1 @@
+ALTER USER "name" REMOVE DELEGATED AUTHORIZATION OF ROLE role FROM SECURITY INTEGRATION integration
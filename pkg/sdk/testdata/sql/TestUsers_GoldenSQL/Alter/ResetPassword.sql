@@ -0,0 +1 @@
+ALTER USER "name" RESET PASSWORD
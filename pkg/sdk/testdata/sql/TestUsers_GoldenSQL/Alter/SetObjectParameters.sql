@@ -0,0 +1 @@
+ALTER USER "name" SET ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = true NETWORK_POLICY = 'network_policy'
@@ -0,0 +1 @@
+ALTER USER "name" SET PASSWORD = 'password' LOGIN_NAME = 'login_name' DISPLAY_NAME = 'display_name' FIRST_NAME = 'first_name' MIDDLE_NAME = 'middle_name' LAST_NAME = 'last_name' EMAIL = 'email' MUST_CHANGE_PASSWORD = true DISABLED = true DAYS_TO_EXPIRY = '1' MINS_TO_UNLOCK = '1' DEFAULT_WAREHOUSE = 'default_warehosue' DEFAULT_NAMESPACE = 'default_namespace' DEFAULT_ROLE = default_role DEFAULT_SECONDARY_ROLES = ( 'value' ) MINS_TO_BYPASS_MFA = '1' RSA_PUBLIC_KEY = 'rsa_public_key' RSA_PUBLIC_KEY_2 = 'rsa_public_key2' COMMENT = 'comment'
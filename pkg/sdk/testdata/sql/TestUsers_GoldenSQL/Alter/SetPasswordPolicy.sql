@@ -0,0 +1 @@
+ALTER USER "name" SET PASSWORD POLICY "database"."schema"."password_policy"
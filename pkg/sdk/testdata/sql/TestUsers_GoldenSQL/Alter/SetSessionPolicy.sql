@@ -0,0 +1 @@
+ALTER USER "name" SET SESSION POLICY = session_policy
@@ -0,0 +1 @@
+ALTER USER "name" SET TAG "name" = 'value'
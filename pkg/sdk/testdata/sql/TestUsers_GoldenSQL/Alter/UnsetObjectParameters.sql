@@ -0,0 +1 @@
+ALTER USER "name" UNSET ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR, NETWORK_POLICY
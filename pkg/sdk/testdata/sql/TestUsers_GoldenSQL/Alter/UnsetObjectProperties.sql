@@ -0,0 +1 @@
+ALTER USER "name" UNSET PASSWORD, LOGIN_NAME, DISPLAY_NAME, FIRST_NAME, MIDDLE_NAME, LAST_NAME, EMAIL, MUST_CHANGE_PASSWORD, DISABLED, DAYS_TO_EXPIRY, MINS_TO_UNLOCK, DEFAULT_WAREHOUSE, DEFAULT_NAMESPACE, DEFAULT_ROLE, DEFAULT_SECONDARY_ROLES, MINS_TO_BYPASS_MFA, RSA_PUBLIC_KEY, RSA_PUBLIC_KEY_2, COMMENT
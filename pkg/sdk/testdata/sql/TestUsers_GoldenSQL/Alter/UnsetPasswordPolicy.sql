@@ -0,0 +1 @@
+ALTER USER "name" UNSET PASSWORD POLICY
@@ -0,0 +1 @@
+ALTER USER "name" UNSET ABORT_DETACHED_QUERY, AUTOCOMMIT, BINARY_INPUT_FORMAT, BINARY_OUTPUT_FORMAT, CLIENT_METADATA_REQUEST_USE_CONNECTION_CTX, CLIENT_METADATA_USE_SESSION_DATABASE, CLIENT_RESULT_COLUMN_CASE_INSENSITIVE, DATE_INPUT_FORMAT, DATE_OUTPUT_FORMAT, ERROR_ON_NONDETERMINISTIC_MERGE, ERROR_ON_NONDETERMINISTIC_UPDATE, GEOGRAPHY_OUTPUT_FORMAT, JSON_INDENT, LOCK_TIMEOUT, MULTI_STATEMENT_COUNT, QUERY_TAG, QUOTED_IDENTIFIERS_IGNORE_CASE, ROWS_PER_RESULTSET, SIMULATED_DATA_SHARING_CONSUMER, STATEMENT_TIMEOUT_IN_SECONDS, STRICT_JSON_OUTPUT, TIMESTAMP_DAY_IS_ALWAYS_24H, TIMESTAMP_INPUT_FORMAT, TIMESTAMP_LTZ_OUTPUT_FORMAT, TIMESTAMP_NTZ_OUTPUT_FORMAT, TIMESTAMP_OUTPUT_FORMAT, TIMESTAMP_TYPE_MAPPING, TIMESTAMP_TZ_OUTPUT_FORMAT, TIMEZONE, TIME_INPUT_FORMAT, TIME_OUTPUT_FORMAT, TRANSACTION_DEFAULT_ISOLATION_LEVEL, TWO_DIGIT_CENTURY_START, UNSUPPORTED_DDL_ACTION, USE_CACHED_RESULT, WEEK_OF_YEAR_POLICY, WEEK_START
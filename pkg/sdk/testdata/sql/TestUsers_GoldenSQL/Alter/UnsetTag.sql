@@ -0,0 +1 @@
+ALTER USER "name" UNSET TAG "unset_tag"
@@ -0,0 +1 @@
+CREATE USER "name"
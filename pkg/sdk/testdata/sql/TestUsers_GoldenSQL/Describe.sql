@@ -0,0 +1 @@
+DESCRIBE USER "name"
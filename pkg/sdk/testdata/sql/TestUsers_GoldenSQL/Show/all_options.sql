@@ -0,0 +1 @@
+SHOW TERSE USERS LIKE 'pattern' STARTS WITH 'starts_with' LIMIT 1 FROM 'from'
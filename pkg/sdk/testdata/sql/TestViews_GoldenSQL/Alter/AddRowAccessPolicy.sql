@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" ADD ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (on)
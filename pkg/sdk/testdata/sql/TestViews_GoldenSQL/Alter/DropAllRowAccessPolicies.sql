@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" DROP ALL ROW ACCESS POLICIES
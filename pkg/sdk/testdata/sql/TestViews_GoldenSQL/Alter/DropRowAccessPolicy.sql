@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" DROP ROW ACCESS POLICY "database"."schema"."row_access_policy"
@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" RENAME TO "database"."schema"."rename_to"
@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" SET COMMENT = 'set_comment'
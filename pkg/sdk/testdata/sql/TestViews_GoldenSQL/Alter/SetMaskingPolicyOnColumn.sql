@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" ALTER COLUMN name SET MASKING POLICY "database"."schema"."masking_policy" USING (using) FORCE
@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" UNSET COMMENT
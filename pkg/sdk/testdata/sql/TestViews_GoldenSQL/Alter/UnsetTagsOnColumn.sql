@@ -0,0 +1 @@
+ALTER VIEW "database"."schema"."name" ALTER COLUMN name UNSET TAG "unset_tags"
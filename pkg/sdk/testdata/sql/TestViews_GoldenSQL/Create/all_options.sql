@@ -0,0 +1 @@
+CREATE OR REPLACE SECURE TEMPORARY RECURSIVE VIEW "database"."schema"."name" ("name" COMMENT 'comment') name MASKING POLICY "database"."schema"."masking_policy" USING (using) TAG ("name" = 'value') COPY GRANTS COMMENT = 'comment' ROW ACCESS POLICY "database"."schema"."row_access_policy" ON (on) TAG ("name" = 'value') AS SELECT 1
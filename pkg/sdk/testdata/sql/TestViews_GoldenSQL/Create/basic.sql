@@ -0,0 +1 @@
+CREATE VIEW "database"."schema"."name" AS SELECT 1
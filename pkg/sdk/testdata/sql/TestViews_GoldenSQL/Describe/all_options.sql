@@ -0,0 +1 @@
+DESCRIBE VIEW "database"."schema"."name"
@@ -0,0 +1 @@
+DROP VIEW "database"."schema"."name"
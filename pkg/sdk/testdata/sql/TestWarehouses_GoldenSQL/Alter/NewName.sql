@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" RENAME TO "new_name"
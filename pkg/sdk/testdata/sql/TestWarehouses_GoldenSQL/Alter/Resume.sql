@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" RESUME IF SUSPENDED
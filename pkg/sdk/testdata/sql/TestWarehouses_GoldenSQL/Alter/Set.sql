@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" SET WAREHOUSE_TYPE = 'STANDARD' WAREHOUSE_SIZE = 'XSMALL' WAIT_FOR_COMPLETION = true MAX_CLUSTER_COUNT = 1 MIN_CLUSTER_COUNT = 1 SCALING_POLICY = 'STANDARD' AUTO_SUSPEND = 1 AUTO_RESUME = true RESOURCE_MONITOR = "resource_monitor" COMMENT = 'comment' ENABLE_QUERY_ACCELERATION = true QUERY_ACCELERATION_MAX_SCALE_FACTOR = 1 MAX_CONCURRENCY_LEVEL = 1 STATEMENT_QUEUED_TIMEOUT_IN_SECONDS = 1 STATEMENT_TIMEOUT_IN_SECONDS = 1
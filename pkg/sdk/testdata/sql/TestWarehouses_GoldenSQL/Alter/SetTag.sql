@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" SET TAG "name" = 'value'
@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" SUSPEND
@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" UNSET WAREHOUSE_TYPE, WAIT_FOR_COMPLETION, MAX_CLUSTER_COUNT, MIN_CLUSTER_COUNT, SCALING_POLICY, AUTO_SUSPEND, AUTO_RESUME, RESOURCE_MONITOR, COMMENT, ENABLE_QUERY_ACCELERATION, QUERY_ACCELERATION_MAX_SCALE_FACTOR, MAX_CONCURRENCY_LEVEL, STATEMENT_QUEUED_TIMEOUT_IN_SECONDS, STATEMENT_TIMEOUT_IN_SECONDS
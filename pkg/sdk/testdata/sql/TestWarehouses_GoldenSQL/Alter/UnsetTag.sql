@@ -0,0 +1 @@
+ALTER WAREHOUSE "name" UNSET TAG "unset_tag"
@@ -0,0 +1 @@
+CREATE WAREHOUSE "name"
@@ -0,0 +1 @@
+DESCRIBE WAREHOUSE "name"
@@ -0,0 +1 @@
+DROP WAREHOUSE IF EXISTS "name"
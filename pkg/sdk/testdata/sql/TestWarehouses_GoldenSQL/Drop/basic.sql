@@ -0,0 +1 @@
+DROP WAREHOUSE "name"
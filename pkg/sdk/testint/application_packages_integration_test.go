@@ -184,11 +184,6 @@ type StagedFile struct {
 	Size int    `json:"size"`
 }
 
-type ApplicationPackageVersion struct {
-	Version string `json:"version"`
-	Patch   int    `json:"patch"`
-}
-
 func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -258,11 +253,10 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		})
 	}
 
-	showApplicationPackageVersion := func(t *testing.T, name string) []ApplicationPackageVersion {
+	showApplicationPackageVersion := func(t *testing.T, name string) []sdk.ApplicationPackageVersion {
 		t.Helper()
 
-		var versions []ApplicationPackageVersion
-		err := client.QueryForTests(ctx, &versions, fmt.Sprintf(`SHOW VERSIONS IN APPLICATION PACKAGE "%s"`, name))
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, sdk.NewShowVersionsApplicationPackageRequest(sdk.NewAccountObjectIdentifier(name)))
 		require.NoError(t, err)
 		return versions
 	}
@@ -330,5 +324,12 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		r2 := sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(rr)
 		err = client.ApplicationPackages.Alter(ctx, r2)
 		require.NoError(t, err)
+
+		releaseDirectives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, sdk.NewShowReleaseDirectivesApplicationPackageRequest(id).WithLike(&sdk.Like{Pattern: sdk.String("DEFAULT")}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(releaseDirectives))
+		require.Equal(t, "DEFAULT", releaseDirectives[0].Name)
+		require.Equal(t, version, releaseDirectives[0].Version)
+		require.Equal(t, 0, releaseDirectives[0].Patch)
 	})
 }
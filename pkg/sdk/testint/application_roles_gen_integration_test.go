@@ -79,4 +79,39 @@ func TestInt_ApplicationRoles(t *testing.T) {
 		assertApplicationRoles(t, appRoles, "app_role_1", "some comment")
 		assertApplicationRoles(t, appRoles, "app_role_2", "some comment2")
 	})
+	t.Run("Grant and revoke to account role", func(t *testing.T) {
+		ctx := context.Background()
+		id := sdk.NewDatabaseObjectIdentifier(appName, "app_role_1")
+		role, cleanupRole := createRole(t, client)
+		t.Cleanup(cleanupRole)
+		roleId := role.ID()
+
+		err := client.ApplicationRoles.Grant(ctx, sdk.NewGrantApplicationRoleRequest(id, *sdk.NewKindOfRoleRequest().WithRoleName(&roleId)))
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			Of: &sdk.ShowGrantsOf{
+				ApplicationRole: id,
+			},
+		})
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == roleId.Name()
+		})
+		require.NoError(t, err)
+
+		err = client.ApplicationRoles.Revoke(ctx, sdk.NewRevokeApplicationRoleRequest(id, *sdk.NewKindOfRoleRequest().WithRoleName(&roleId)))
+		require.NoError(t, err)
+
+		grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			Of: &sdk.ShowGrantsOf{
+				ApplicationRole: id,
+			},
+		})
+		require.NoError(t, err)
+		_, err = collections.FindOne(grants, func(grant sdk.Grant) bool {
+			return grant.GrantedTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == roleId.Name()
+		})
+		require.Error(t, err)
+	})
 }
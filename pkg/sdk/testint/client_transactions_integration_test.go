@@ -0,0 +1,80 @@
+package testint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Client_InTransaction(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	table, tableCleanup := createTable(t, client, testDb(t), testSchema(t))
+	t.Cleanup(tableCleanup)
+
+	countRows := func(t *testing.T) any {
+		t.Helper()
+		rows, err := client.QueryUnsafe(ctx, fmt.Sprintf("SELECT COUNT(*) AS C FROM %s", table.ID().FullyQualifiedName()))
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		return *rows[0]["C"]
+	}
+
+	t.Run("committed", func(t *testing.T) {
+		err := client.InTransaction(ctx, func(tx *sdk.Client) error {
+			_, err := tx.ExecUnsafe(ctx, fmt.Sprintf("INSERT INTO %s VALUES (1)", table.ID().FullyQualifiedName()))
+			return err
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "1", countRows(t))
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		failure := errors.New("failure")
+		err := client.InTransaction(ctx, func(tx *sdk.Client) error {
+			if _, err := tx.ExecUnsafe(ctx, fmt.Sprintf("INSERT INTO %s VALUES (2)", table.ID().FullyQualifiedName())); err != nil {
+				return err
+			}
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		assert.Equal(t, "1", countRows(t))
+	})
+
+	t.Run("statements run in one session", func(t *testing.T) {
+		err := client.InTransaction(ctx, func(tx *sdk.Client) error {
+			first, err := tx.ContextFunctions.CurrentSession(context.Background())
+			require.NoError(t, err)
+			second, err := tx.ContextFunctions.CurrentSession(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, first, second)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_Client_QueryMultiStatementUnsafe(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	resultSets, err := client.QueryMultiStatementUnsafe(ctx,
+		fmt.Sprintf("SHOW DATABASES LIKE '%s'", testDb(t).Name),
+		"SELECT 1 AS A",
+	)
+	require.NoError(t, err)
+
+	require.Len(t, resultSets, 2)
+	require.Len(t, resultSets[0], 1)
+	assert.Equal(t, testDb(t).Name, *resultSets[0][0]["name"])
+	require.Len(t, resultSets[1], 1)
+	assert.Equal(t, "1", *resultSets[1][0]["A"])
+}
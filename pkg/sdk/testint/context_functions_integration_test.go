@@ -129,19 +129,30 @@ func TestInt_RolesUse(t *testing.T) {
 	err = client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(role.ID(), sdk.GrantRole{Role: &currentRoleID}))
 	require.NoError(t, err)
 
-	err = client.Sessions.UseRole(ctx, role.ID())
-	require.NoError(t, err)
+	err = client.InSession(ctx, func(session *sdk.Client) error {
+		err := session.Sessions.UseRole(ctx, role.ID())
+		require.NoError(t, err)
 
-	activeRole, err := client.ContextFunctions.CurrentRole(ctx)
+		// every statement run in the session sees the role set before
+		for i := 0; i < 5; i++ {
+			activeRole, err := session.ContextFunctions.CurrentRole(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, role.Name, activeRole)
+		}
+		return nil
+	})
 	require.NoError(t, err)
 
-	assert.Equal(t, activeRole, role.Name)
-
-	err = client.Sessions.UseRole(ctx, currentRoleID)
-	require.NoError(t, err)
+	// the role set in the session does not leak to the statements run outside of it
+	for i := 0; i < 5; i++ {
+		activeRole, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, currentRole, activeRole)
+	}
 }
 
 func TestInt_RolesUseSecondaryRoles(t *testing.T) {
+	skipOnFakeBackend(t, "the user of the session is not stored")
 	client := testClient(t)
 	ctx := testContext(t)
 	currentRole, err := client.ContextFunctions.CurrentRole(ctx)
@@ -11,6 +11,7 @@ import (
 )
 
 func TestInt_DatabaseRoles(t *testing.T) {
+	skipOnFakeBackend(t, "database roles are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 
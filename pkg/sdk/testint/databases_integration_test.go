@@ -158,7 +158,39 @@ func TestInt_CreateShared(t *testing.T) {
 }
 
 func TestInt_DatabasesCreateSecondary(t *testing.T) {
-	// todo: once ReplicationGroups are supported.
+	skipOnFakeBackend(t, "replication is not supported")
+	client := testClient(t)
+	secondaryClient := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	err := client.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+		EnableReplication: &sdk.EnableReplication{
+			ToAccounts:         []sdk.AccountIdentifier{getAccountIdentifier(t, secondaryClient)},
+			IgnoreEditionCheck: sdk.Bool(true),
+		},
+	})
+	require.NoError(t, err)
+
+	primaryDatabaseID := sdk.NewExternalObjectIdentifier(getAccountIdentifier(t, client), databaseTest.ID())
+	err = secondaryClient.Databases.CreateSecondary(ctx, databaseTest.ID(), primaryDatabaseID, &sdk.CreateSecondaryDatabaseOptions{
+		DataRetentionTimeInDays: sdk.Int(1),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := secondaryClient.Databases.Drop(ctx, databaseTest.ID(), nil)
+		require.NoError(t, err)
+	})
+
+	database, err := secondaryClient.Databases.ShowByID(ctx, databaseTest.ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, databaseTest.ID().Name(), database.Name)
+	assert.Equal(t, 1, database.RetentionTime)
+	// the origin of a secondary database is its primary database
+	assert.Contains(t, database.Origin, databaseTest.ID().Name())
 }
 
 func TestInt_DatabasesDrop(t *testing.T) {
@@ -271,7 +303,64 @@ func TestInt_DatabasesAlter(t *testing.T) {
 }
 
 func TestInt_AlterReplication(t *testing.T) {
-	// todo: once ReplicationGroups are supported.
+	client := testClient(t)
+	secondaryClient := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	toAccounts := []sdk.AccountIdentifier{
+		getAccountIdentifier(t, secondaryClient),
+	}
+
+	t.Run("enable and disable replication", func(t *testing.T) {
+		err := client.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+			EnableReplication: &sdk.EnableReplication{
+				ToAccounts:         toAccounts,
+				IgnoreEditionCheck: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		err = client.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+			DisableReplication: &sdk.DisableReplication{
+				ToAccounts: toAccounts,
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("refresh secondary database", func(t *testing.T) {
+		err := client.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+			EnableReplication: &sdk.EnableReplication{
+				ToAccounts:         toAccounts,
+				IgnoreEditionCheck: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+				DisableReplication: &sdk.DisableReplication{
+					ToAccounts: toAccounts,
+				},
+			})
+			require.NoError(t, err)
+		})
+
+		primaryDatabaseID := sdk.NewExternalObjectIdentifier(getAccountIdentifier(t, client), databaseTest.ID())
+		err = secondaryClient.Databases.CreateSecondary(ctx, databaseTest.ID(), primaryDatabaseID, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := secondaryClient.Databases.Drop(ctx, databaseTest.ID(), nil)
+			require.NoError(t, err)
+		})
+
+		err = secondaryClient.Databases.AlterReplication(ctx, databaseTest.ID(), &sdk.AlterDatabaseReplicationOptions{
+			Refresh: sdk.Bool(true),
+		})
+		require.NoError(t, err)
+	})
 }
 
 func TestInt_AlterFailover(t *testing.T) {
@@ -0,0 +1,105 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	networkRuleId := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+	err := client.NetworkRules.Create(ctx, sdk.NewCreateNetworkRuleRequest(networkRuleId, sdk.NetworkRuleTypeHostPort, []sdk.NetworkRuleValue{{Value: "example.com"}}, sdk.NetworkRuleModeEgress))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(networkRuleId))
+		require.NoError(t, err)
+	})
+
+	createExternalAccessIntegration := func(t *testing.T) sdk.AccountObjectIdentifier {
+		t.Helper()
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.ExternalAccessIntegrations.Create(ctx, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRuleId}, true))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	assertExternalAccessIntegration := func(t *testing.T, id sdk.AccountObjectIdentifier, enabled bool, comment string) {
+		t.Helper()
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), integration.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", integration.Type)
+		assert.Equal(t, "SECURITY", integration.Category)
+		assert.Equal(t, enabled, integration.Enabled)
+		assert.Equal(t, comment, integration.Comment)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := createExternalAccessIntegration(t)
+
+		assertExternalAccessIntegration(t, id, true, "")
+	})
+
+	t.Run("Alter: set and unset", func(t *testing.T) {
+		id := createExternalAccessIntegration(t)
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(
+			sdk.NewExternalAccessIntegrationSetRequest().
+				WithEnabled(sdk.Bool(false)).
+				WithComment(sdk.String("comment")),
+		))
+		require.NoError(t, err)
+		assertExternalAccessIntegration(t, id, false, "comment")
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(
+			sdk.NewExternalAccessIntegrationUnsetRequest().WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+		assertExternalAccessIntegration(t, id, false, "")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createExternalAccessIntegration(t)
+
+		err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := createExternalAccessIntegration(t)
+
+		integrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().WithLike(&sdk.Like{
+			Pattern: sdk.String(id.Name()),
+		}))
+		require.NoError(t, err)
+		require.Len(t, integrations, 1)
+		assert.Equal(t, id.Name(), integrations[0].Name)
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id := createExternalAccessIntegration(t)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		names := make([]string, len(properties))
+		for i, p := range properties {
+			names[i] = p.Name
+		}
+		assert.Contains(t, names, "ALLOWED_NETWORK_RULES")
+		assert.Contains(t, names, "ENABLED")
+	})
+}
@@ -28,7 +28,7 @@ func TestInt_ExternalFunctions(t *testing.T) {
 	assertExternalFunction := func(t *testing.T, id sdk.SchemaObjectIdentifier, secure bool, dts []sdk.DataType) {
 		t.Helper()
 
-		e, err := client.ExternalFunctions.ShowByID(ctx, id, dts)
+		e, err := client.ExternalFunctions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), dts))
 		require.NoError(t, err)
 
 		require.NotEmpty(t, e.CreatedOn)
@@ -84,7 +84,7 @@ func TestInt_ExternalFunctions(t *testing.T) {
 		require.NoError(t, err)
 		t.Cleanup(cleanupExternalFuncionHandle(id, []sdk.DataType{sdk.DataTypeVariant}))
 
-		e, err := client.ExternalFunctions.ShowByID(ctx, id, defaultDataTypes)
+		e, err := client.ExternalFunctions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), defaultDataTypes))
 		require.NoError(t, err)
 		return e
 	}
@@ -272,20 +272,19 @@ func TestInt_ExternalFunctions(t *testing.T) {
 		e := createExternalFunction(t, sdk.DataTypeVARCHAR)
 
 		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, e.Name)
-		es, err := client.ExternalFunctions.ShowByID(ctx, id, []sdk.DataType{sdk.DataTypeVARCHAR})
+		es, err := client.ExternalFunctions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		require.Equal(t, *e, *es)
 
-		_, err = client.ExternalFunctions.ShowByID(ctx, id, nil)
+		_, err = client.ExternalFunctions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), nil))
 		require.Error(t, err, sdk.ErrObjectNotExistOrAuthorized)
 	})
 
 	t.Run("describe external function", func(t *testing.T) {
 		e := createExternalFunction(t, sdk.DataTypeVARCHAR)
-		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, e.Name)
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, e.Name, []sdk.DataType{sdk.DataTypeVARCHAR})
 
-		request := sdk.NewDescribeExternalFunctionRequest(id, []sdk.DataType{sdk.DataTypeVARCHAR})
-		details, err := client.ExternalFunctions.Describe(ctx, request)
+		details, err := client.ExternalFunctions.Describe(ctx, id)
 		require.NoError(t, err)
 		pairs := make(map[string]string)
 		for _, detail := range details {
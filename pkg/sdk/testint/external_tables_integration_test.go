@@ -19,7 +19,8 @@ func TestInt_ExternalTables(t *testing.T) {
 	stageLocation := fmt.Sprintf("@%s", stageID.FullyQualifiedName())
 	_, _ = createStageWithURL(t, client, stageID, nycWeatherDataURL)
 
-	tag, _ := createTag(t, client, testDb(t), testSchema(t))
+	tag, tagCleanup := createTag(t, client, testDb(t), testSchema(t))
+	t.Cleanup(tagCleanup)
 
 	defaultColumns := func() []*sdk.ExternalTableColumnRequest {
 		return []*sdk.ExternalTableColumnRequest{
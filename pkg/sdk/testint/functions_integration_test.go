@@ -60,7 +60,7 @@ func TestInt_CreateFunctions(t *testing.T) {
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, []sdk.DataType{"VARCHAR"}))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "JAVA", function.Language)
@@ -92,7 +92,7 @@ func TestInt_CreateFunctions(t *testing.T) {
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, []sdk.DataType{sdk.DataTypeFloat}))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeFloat}))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "JAVASCRIPT", function.Language)
@@ -116,7 +116,7 @@ def dump(i):
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, []sdk.DataType{"int"}))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeNumber}))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "PYTHON", function.Language)
@@ -143,7 +143,7 @@ def dump(i):
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, []sdk.DataType{sdk.DataTypeVARCHAR}))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "SCALA", function.Language)
@@ -166,7 +166,7 @@ def dump(i):
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, []sdk.DataType{sdk.DataTypeFloat}))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeFloat}))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "SQL", function.Language)
@@ -187,7 +187,7 @@ def dump(i):
 		require.NoError(t, err)
 		t.Cleanup(cleanupFunctionHandle(id, nil))
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), nil))
 		require.NoError(t, err)
 		require.Equal(t, id.Name(), function.Name)
 		require.Equal(t, "SQL", function.Language)
@@ -202,10 +202,17 @@ func TestInt_OtherFunctions(t *testing.T) {
 	tagTest, tagCleanup := createTag(t, client, databaseTest, schemaTest)
 	t.Cleanup(tagCleanup)
 
+	argumentDataTypes := func(withArguments bool) []sdk.DataType {
+		if withArguments {
+			return []sdk.DataType{sdk.DataTypeFloat}
+		}
+		return nil
+	}
+
 	assertFunction := func(t *testing.T, id sdk.SchemaObjectIdentifier, secure bool, withArguments bool) {
 		t.Helper()
 
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), argumentDataTypes(withArguments)))
 		require.NoError(t, err)
 
 		assert.NotEmpty(t, function.CreatedOn)
@@ -264,7 +271,7 @@ func TestInt_OtherFunctions(t *testing.T) {
 				t.Cleanup(cleanupFunctionHandle(id, nil))
 			}
 		}
-		function, err := client.Functions.ShowByID(ctx, id)
+		function, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), argumentDataTypes(withArguments)))
 		require.NoError(t, err)
 		return function
 	}
@@ -286,10 +293,10 @@ func TestInt_OtherFunctions(t *testing.T) {
 		}
 		require.NoError(t, err)
 
-		_, err = client.Functions.ShowByID(ctx, id)
+		_, err = client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeFloat}))
 		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
 
-		e, err := client.Functions.ShowByID(ctx, nid)
+		e, err := client.Functions.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(nid.DatabaseName(), nid.SchemaName(), nid.Name(), []sdk.DataType{sdk.DataTypeFloat}))
 		require.NoError(t, err)
 		require.Equal(t, nid.Name(), e.Name)
 	})
@@ -427,10 +434,9 @@ func TestInt_OtherFunctions(t *testing.T) {
 
 	t.Run("describe function for SQL", func(t *testing.T) {
 		f := createFunctionForSQLHandle(t, true, true)
-		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, f.Name)
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, f.Name, []sdk.DataType{sdk.DataTypeFloat})
 
-		request := sdk.NewDescribeFunctionRequest(id, []sdk.DataType{sdk.DataTypeFloat})
-		details, err := client.Functions.Describe(ctx, request)
+		details, err := client.Functions.Describe(ctx, id)
 		require.NoError(t, err)
 		pairs := make(map[string]string)
 		for _, detail := range details {
@@ -444,10 +450,9 @@ func TestInt_OtherFunctions(t *testing.T) {
 
 	t.Run("describe function for SQL: no arguments", func(t *testing.T) {
 		f := createFunctionForSQLHandle(t, true, false)
-		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, f.Name)
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, f.Name, nil)
 
-		request := sdk.NewDescribeFunctionRequest(id, nil)
-		details, err := client.Functions.Describe(ctx, request)
+		details, err := client.Functions.Describe(ctx, id)
 		require.NoError(t, err)
 		pairs := make(map[string]string)
 		for _, detail := range details {
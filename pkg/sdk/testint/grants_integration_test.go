@@ -86,6 +86,7 @@ func TestInt_GrantAndRevokePrivilegesToAccountRole(t *testing.T) {
 	})
 
 	t.Run("on account object", func(t *testing.T) {
+		skipOnFakeBackend(t, "resource monitors are not supported")
 		roleTest, roleCleanup := createRole(t, client)
 		t.Cleanup(roleCleanup)
 		resourceMonitorTest, resourceMonitorCleanup := createResourceMonitor(t, client)
@@ -234,6 +235,7 @@ func TestInt_GrantAndRevokePrivilegesToAccountRole(t *testing.T) {
 	})
 
 	t.Run("grant and revoke on all pipes", func(t *testing.T) {
+		skipOnFakeBackend(t, "stages and pipes are not supported")
 		schema, schemaCleanup := createSchemaWithIdentifier(t, itc.client, testDb(t), random.AlphaN(20))
 		t.Cleanup(schemaCleanup)
 
@@ -294,6 +296,7 @@ func TestInt_GrantAndRevokePrivilegesToAccountRole(t *testing.T) {
 	})
 
 	t.Run("grant and revoke on all pipes with multiple errors", func(t *testing.T) {
+		skipOnFakeBackend(t, "stages and pipes are not supported")
 		schema, schemaCleanup := createSchemaWithIdentifier(t, itc.client, testDb(t), random.AlphaN(20))
 		t.Cleanup(schemaCleanup)
 
@@ -357,6 +360,7 @@ func TestInt_GrantAndRevokePrivilegesToAccountRole(t *testing.T) {
 }
 
 func TestInt_GrantAndRevokePrivilegesToDatabaseRole(t *testing.T) {
+	skipOnFakeBackend(t, "database roles are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 
@@ -579,6 +583,7 @@ func TestInt_GrantAndRevokePrivilegesToDatabaseRole(t *testing.T) {
 	})
 
 	t.Run("grant and revoke on all pipes", func(t *testing.T) {
+		skipOnFakeBackend(t, "stages and pipes are not supported")
 		schema, schemaCleanup := createSchemaWithIdentifier(t, itc.client, testDb(t), random.AlphaN(20))
 		t.Cleanup(schemaCleanup)
 
@@ -639,6 +644,7 @@ func TestInt_GrantAndRevokePrivilegesToDatabaseRole(t *testing.T) {
 	})
 
 	t.Run("grant and revoke on all pipes with multiple errors", func(t *testing.T) {
+		skipOnFakeBackend(t, "stages and pipes are not supported")
 		schema, schemaCleanup := createSchemaWithIdentifier(t, itc.client, testDb(t), random.AlphaN(20))
 		t.Cleanup(schemaCleanup)
 
@@ -702,6 +708,7 @@ func TestInt_GrantAndRevokePrivilegesToDatabaseRole(t *testing.T) {
 }
 
 func TestInt_GrantPrivilegeToShare(t *testing.T) {
+	skipOnFakeBackend(t, "shares are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 	shareTest, shareCleanup := createShare(t, client)
@@ -766,6 +773,7 @@ func TestInt_GrantPrivilegeToShare(t *testing.T) {
 }
 
 func TestInt_RevokePrivilegeToShare(t *testing.T) {
+	skipOnFakeBackend(t, "shares are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 	shareTest, shareCleanup := createShare(t, client)
@@ -791,6 +799,7 @@ func TestInt_GrantOwnership(t *testing.T) {
 	ctx := testContext(t)
 
 	t.Run("on schema object to database role", func(t *testing.T) {
+		skipOnFakeBackend(t, "database roles are not supported")
 		databaseRole, _ := createDatabaseRole(t, client, testDb(t))
 		databaseRoleId := sdk.NewDatabaseObjectIdentifier(testDb(t).Name, databaseRole.Name)
 		table, _ := createTable(t, client, testDb(t), testSchema(t))
@@ -901,6 +910,7 @@ func TestInt_GrantOwnership(t *testing.T) {
 }
 
 func TestInt_ShowGrants(t *testing.T) {
+	skipOnFakeBackend(t, "shares are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 	shareTest, shareCleanup := createShare(t, client)
@@ -112,7 +112,7 @@ func TestInt_MaskingPolicyCreate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, name, maskingPolicyDetails.Name)
 		assert.Equal(t, signature, maskingPolicyDetails.Signature)
-		assert.Equal(t, sdk.DataTypeVARCHAR, maskingPolicyDetails.ReturnType)
+		assert.True(t, sdk.AreDataTypesEqual(string(sdk.DataTypeVARCHAR), string(maskingPolicyDetails.ReturnType)))
 		assert.Equal(t, expression, maskingPolicyDetails.Body)
 
 		maskingPolicy, err := client.MaskingPolicies.Show(ctx, &sdk.ShowMaskingPolicyOptions{
@@ -156,7 +156,7 @@ func TestInt_MaskingPolicyCreate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, name, maskingPolicyDetails.Name)
 		assert.Equal(t, signature, maskingPolicyDetails.Signature)
-		assert.Equal(t, sdk.DataTypeVARCHAR, maskingPolicyDetails.ReturnType)
+		assert.True(t, sdk.AreDataTypesEqual(string(sdk.DataTypeVARCHAR), string(maskingPolicyDetails.ReturnType)))
 		assert.Equal(t, expression, maskingPolicyDetails.Body)
 
 		maskingPolicy, err := client.MaskingPolicies.Show(ctx, &sdk.ShowMaskingPolicyOptions{
@@ -190,7 +190,7 @@ func TestInt_MaskingPolicyCreate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, name, maskingPolicyDetails.Name)
 		assert.Equal(t, signature, maskingPolicyDetails.Signature)
-		assert.Equal(t, sdk.DataTypeVARCHAR, maskingPolicyDetails.ReturnType)
+		assert.True(t, sdk.AreDataTypesEqual(string(sdk.DataTypeVARCHAR), string(maskingPolicyDetails.ReturnType)))
 		assert.Equal(t, expression, maskingPolicyDetails.Body)
 
 		maskingPolicy, err := client.MaskingPolicies.Show(ctx, &sdk.ShowMaskingPolicyOptions{
@@ -233,7 +233,7 @@ func TestInt_MaskingPolicyCreate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, name, maskingPolicyDetails.Name)
 		assert.Equal(t, signature, maskingPolicyDetails.Signature)
-		assert.Equal(t, sdk.DataTypeVARCHAR, maskingPolicyDetails.ReturnType)
+		assert.True(t, sdk.AreDataTypesEqual(string(sdk.DataTypeVARCHAR), string(maskingPolicyDetails.ReturnType)))
 		assert.Equal(t, strings.TrimSpace(expression), maskingPolicyDetails.Body)
 	})
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -155,6 +156,49 @@ func TestInt_NetworkPolicies(t *testing.T) {
 		assert.Equal(t, len(req.BlockedIpList), np.EntriesInBlockedIpList)
 	})
 
+	t.Run("Alter - set and clear network rule lists", func(t *testing.T) {
+		allowedRuleId := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		blockedRuleId := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		for _, ruleId := range []sdk.SchemaObjectIdentifier{allowedRuleId, blockedRuleId} {
+			ruleId := ruleId
+			err := client.NetworkRules.Create(ctx, sdk.NewCreateNetworkRuleRequest(ruleId, sdk.NetworkRuleTypeIpv4, []sdk.NetworkRuleValue{{Value: "0.0.0.0/0"}}, sdk.NetworkRuleModeIngress))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(ruleId))
+				require.NoError(t, err)
+			})
+		}
+
+		req := defaultCreateRequest().WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{allowedRuleId})
+		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
+		require.NoError(t, err)
+		t.Cleanup(dropNetworkPolicy)
+
+		np, err := client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 1, np.EntriesInAllowedNetworkRules)
+		assert.Equal(t, 0, np.EntriesInBlockedNetworkRules)
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).
+			WithSet(sdk.NewNetworkPolicySetRequest().
+				WithAllowedNetworkRuleList(sdk.NewAllowedNetworkRuleListRequest()).
+				WithBlockedNetworkRuleList(sdk.NewBlockedNetworkRuleListRequest().WithBlockedNetworkRuleList([]sdk.SchemaObjectIdentifier{blockedRuleId}))))
+		require.NoError(t, err)
+
+		np, err = client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 0, np.EntriesInAllowedNetworkRules)
+		assert.Equal(t, 1, np.EntriesInBlockedNetworkRules)
+
+		desc, err := client.NetworkPolicies.Describe(ctx, req.GetName())
+		require.NoError(t, err)
+		blockedRuleList, err := collections.FindOne(desc, func(d sdk.NetworkPolicyDescription) bool { return d.Name == "BLOCKED_NETWORK_RULE_LIST" })
+		require.NoError(t, err)
+		blockedRuleIds, err := sdk.ParseNetworkRuleList(blockedRuleList.Value)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.SchemaObjectIdentifier{blockedRuleId}, blockedRuleIds)
+	})
+
 	t.Run("Describe", func(t *testing.T) {
 		req := defaultCreateRequest()
 		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
@@ -352,7 +352,7 @@ func TestInt_OtherProcedureFunctions(t *testing.T) {
 	assertProcedure := func(t *testing.T, id sdk.SchemaObjectIdentifier, secure bool) {
 		t.Helper()
 
-		procedure, err := client.Procedures.ShowByID(ctx, id)
+		procedure, err := client.Procedures.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 
 		assert.NotEmpty(t, procedure.CreatedOn)
@@ -401,7 +401,7 @@ func TestInt_OtherProcedureFunctions(t *testing.T) {
 		if cleanup {
 			t.Cleanup(cleanupProcedureHandle(id, []sdk.DataType{sdk.DataTypeVARCHAR}))
 		}
-		procedure, err := client.Procedures.ShowByID(ctx, id)
+		procedure, err := client.Procedures.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		return procedure
 	}
@@ -423,10 +423,10 @@ func TestInt_OtherProcedureFunctions(t *testing.T) {
 		}
 		require.NoError(t, err)
 
-		_, err = client.Procedures.ShowByID(ctx, id)
+		_, err = client.Procedures.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
 
-		e, err := client.Procedures.ShowByID(ctx, nid)
+		e, err := client.Procedures.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(nid.DatabaseName(), nid.SchemaName(), nid.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		require.Equal(t, nid.Name(), e.Name)
 	})
@@ -530,10 +530,9 @@ func TestInt_OtherProcedureFunctions(t *testing.T) {
 
 	t.Run("describe function for SQL", func(t *testing.T) {
 		f := createProcedureForSQLHandle(t, true)
-		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, f.Name)
+		id := sdk.NewSchemaObjectIdentifierWithArguments(databaseTest.Name, schemaTest.Name, f.Name, []sdk.DataType{sdk.DataTypeString})
 
-		request := sdk.NewDescribeProcedureRequest(id, []sdk.DataType{sdk.DataTypeString})
-		details, err := client.Procedures.Describe(ctx, request)
+		details, err := client.Procedures.Describe(ctx, id)
 		require.NoError(t, err)
 		pairs := make(map[string]string)
 		for _, detail := range details {
@@ -619,7 +618,7 @@ func TestInt_CallProcedure(t *testing.T) {
 		if cleanup {
 			t.Cleanup(cleanupProcedureHandle(id, []sdk.DataType{sdk.DataTypeVARCHAR}))
 		}
-		procedure, err := client.Procedures.ShowByID(ctx, id)
+		procedure, err := client.Procedures.ShowByID(ctx, sdk.NewSchemaObjectIdentifierWithArguments(id.DatabaseName(), id.SchemaName(), id.Name(), []sdk.DataType{sdk.DataTypeVARCHAR}))
 		require.NoError(t, err)
 		return procedure
 	}
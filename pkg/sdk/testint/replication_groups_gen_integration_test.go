@@ -0,0 +1,216 @@
+package testint
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ReplicationGroups(t *testing.T) {
+	client := testClient(t)
+	secondaryClient := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	secondaryAccountId := getAccountIdentifier(t, secondaryClient)
+
+	createReplicationGroup := func(t *testing.T, objectTypes []sdk.PluralObjectType) sdk.AccountObjectIdentifier {
+		t.Helper()
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.ReplicationGroups.Create(ctx, sdk.NewCreateReplicationGroupRequest(id, objectTypes, []sdk.AccountIdentifier{secondaryAccountId}))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		databaseTest, databaseCleanup := createDatabase(t, client)
+		t.Cleanup(databaseCleanup)
+
+		id := sdk.RandomAccountObjectIdentifier()
+		objectTypes := []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeIntegrations}
+		request := sdk.NewCreateReplicationGroupRequest(id, objectTypes, []sdk.AccountIdentifier{secondaryAccountId}).
+			WithIfNotExists(sdk.Bool(true)).
+			WithAllowedDatabases([]sdk.AccountObjectIdentifier{databaseTest.ID()}).
+			WithAllowedIntegrationTypes([]sdk.IntegrationType{sdk.IntegrationTypeSecurityIntegrations}).
+			WithReplicationSchedule(sdk.String("10 MINUTE"))
+		err := client.ReplicationGroups.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id))
+			require.NoError(t, err)
+		})
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, id.Name(), replicationGroup.Name)
+		assert.Equal(t, "REPLICATION", replicationGroup.Type)
+		assert.True(t, replicationGroup.IsPrimary)
+		slices.Sort(objectTypes)
+		slices.Sort(replicationGroup.ObjectTypes)
+		assert.Equal(t, objectTypes, replicationGroup.ObjectTypes)
+		assert.Equal(t, []sdk.IntegrationType{sdk.IntegrationTypeSecurityIntegrations}, replicationGroup.AllowedIntegrationTypes)
+		// the current account is always added to the allowed accounts
+		assert.Contains(t, replicationGroup.AllowedAccounts, secondaryAccountId)
+		assert.Equal(t, "10 MINUTE", replicationGroup.ReplicationSchedule)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(id))
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{databaseTest.ID()}, databases)
+	})
+
+	t.Run("CreateSecondary, Refresh, Suspend and Resume", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+		primaryGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		err = secondaryClient.ReplicationGroups.CreateSecondary(ctx, sdk.NewCreateSecondaryReplicationGroupRequest(id, primaryGroup.ExternalID()))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := secondaryClient.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(id))
+			require.NoError(t, err)
+		})
+
+		secondaryGroup, err := secondaryClient.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, secondaryGroup.IsPrimary)
+		assert.Equal(t, primaryGroup.ExternalID().FullyQualifiedName(), secondaryGroup.Primary.FullyQualifiedName())
+
+		err = secondaryClient.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRefresh(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		err = secondaryClient.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithSuspend(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		secondaryGroup, err = secondaryClient.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ReplicationGroupSecondaryStateSuspended, secondaryGroup.SecondaryState)
+
+		err = secondaryClient.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithResume(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		secondaryGroup, err = secondaryClient.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ReplicationGroupSecondaryStateStarted, secondaryGroup.SecondaryState)
+	})
+
+	t.Run("Alter: rename", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+		newId := sdk.RandomAccountObjectIdentifier()
+
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRenameTo(&newId))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ReplicationGroups.Drop(ctx, sdk.NewDropReplicationGroupRequest(newId).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+
+		_, err = client.ReplicationGroups.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.Equal(t, newId.Name(), replicationGroup.Name)
+	})
+
+	t.Run("Alter: set", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithSet(
+			sdk.NewReplicationGroupSetRequest().
+				WithObjectTypes([]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares}).
+				WithReplicationSchedule(sdk.String("20 MINUTE")),
+		))
+		require.NoError(t, err)
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares}, replicationGroup.ObjectTypes)
+		assert.Equal(t, "20 MINUTE", replicationGroup.ReplicationSchedule)
+	})
+
+	t.Run("Alter: add, move and remove databases", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+		otherId := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+		databaseTest, databaseCleanup := createDatabase(t, client)
+		t.Cleanup(databaseCleanup)
+
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(
+			sdk.NewReplicationGroupAddRequest().WithAllowedDatabases([]sdk.AccountObjectIdentifier{databaseTest.ID()}),
+		))
+		require.NoError(t, err)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(id))
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{databaseTest.ID()}, databases)
+
+		err = client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithMove(
+			sdk.NewReplicationGroupMoveRequest(otherId).WithDatabases([]sdk.AccountObjectIdentifier{databaseTest.ID()}),
+		))
+		require.NoError(t, err)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(id))
+		require.NoError(t, err)
+		assert.Empty(t, databases)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(otherId))
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{databaseTest.ID()}, databases)
+
+		err = client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(otherId).WithRemove(
+			sdk.NewReplicationGroupRemoveRequest().WithAllowedDatabases([]sdk.AccountObjectIdentifier{databaseTest.ID()}),
+		))
+		require.NoError(t, err)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, sdk.NewShowDatabasesReplicationGroupRequest(otherId))
+		require.NoError(t, err)
+		assert.Empty(t, databases)
+	})
+
+	t.Run("Alter: add and remove shares", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeShares})
+		shareTest, shareCleanup := createShare(t, client)
+		t.Cleanup(shareCleanup)
+
+		err := client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithAdd(
+			sdk.NewReplicationGroupAddRequest().WithAllowedShares([]sdk.AccountObjectIdentifier{shareTest.ID()}),
+		))
+		require.NoError(t, err)
+
+		shares, err := client.ReplicationGroups.ShowShares(ctx, sdk.NewShowSharesReplicationGroupRequest(id))
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{shareTest.ID()}, shares)
+
+		err = client.ReplicationGroups.Alter(ctx, sdk.NewAlterReplicationGroupRequest(id).WithRemove(
+			sdk.NewReplicationGroupRemoveRequest().WithAllowedShares([]sdk.AccountObjectIdentifier{shareTest.ID()}),
+		))
+		require.NoError(t, err)
+
+		shares, err = client.ReplicationGroups.ShowShares(ctx, sdk.NewShowSharesReplicationGroupRequest(id))
+		require.NoError(t, err)
+		assert.Empty(t, shares)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := createReplicationGroup(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases})
+
+		replicationGroups, err := client.ReplicationGroups.Show(ctx, sdk.NewShowReplicationGroupRequest())
+		require.NoError(t, err)
+
+		found := slices.ContainsFunc(replicationGroups, func(rg sdk.ReplicationGroup) bool {
+			return rg.ID() == id
+		})
+		assert.True(t, found)
+	})
+
+	t.Run("ShowByID: not existing", func(t *testing.T) {
+		_, err := client.ReplicationGroups.ShowByID(ctx, sdk.RandomAccountObjectIdentifier())
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
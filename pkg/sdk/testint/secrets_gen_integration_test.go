@@ -0,0 +1,132 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Secrets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	cleanupSecret := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createGenericStringSecret := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		err := client.Secrets.CreateWithGenericString(ctx, sdk.NewCreateWithGenericStringSecretRequest(id, "secret"))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSecret(id))
+		return id
+	}
+
+	assertSecret := func(t *testing.T, s *sdk.Secret, id sdk.SchemaObjectIdentifier, secretType sdk.SecretType, comment string) {
+		t.Helper()
+		assert.Equal(t, id.Name(), s.Name)
+		assert.Equal(t, id.DatabaseName(), s.DatabaseName)
+		assert.Equal(t, id.SchemaName(), s.SchemaName)
+		assert.Equal(t, string(secretType), s.SecretType)
+		assert.NotEmpty(t, s.Owner)
+		assert.NotEmpty(t, s.CreatedOn)
+		if comment == "" {
+			assert.Nil(t, s.Comment)
+		} else {
+			require.NotNil(t, s.Comment)
+			assert.Equal(t, comment, *s.Comment)
+		}
+	}
+
+	t.Run("CreateWithBasicAuthentication", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(20))
+		err := client.Secrets.CreateWithBasicAuthentication(ctx, sdk.NewCreateWithBasicAuthenticationSecretRequest(id, "user", "pass").WithComment(sdk.String("a")))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSecret(id))
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSecret(t, secret, id, sdk.SecretTypePassword, "a")
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, details.Username)
+		assert.Equal(t, "user", *details.Username)
+	})
+
+	t.Run("CreateWithGenericString", func(t *testing.T) {
+		id := createGenericStringSecret(t)
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSecret(t, secret, id, sdk.SecretTypeGenericString, "")
+	})
+
+	t.Run("Alter: set and unset", func(t *testing.T) {
+		id := createGenericStringSecret(t)
+
+		err := client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithSet(
+			sdk.NewSecretSetRequest().
+				WithSecretString(sdk.String("new secret")).
+				WithComment(sdk.String("comment")),
+		))
+		require.NoError(t, err)
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSecret(t, secret, id, sdk.SecretTypeGenericString, "comment")
+
+		err = client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithUnset(
+			sdk.NewSecretUnsetRequest().WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+
+		secret, err = client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertSecret(t, secret, id, sdk.SecretTypeGenericString, "")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createGenericStringSecret(t)
+
+		err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.Secrets.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id1 := createGenericStringSecret(t)
+		id2 := createGenericStringSecret(t)
+
+		secrets, err := client.Secrets.Show(ctx, sdk.NewShowSecretRequest().WithIn(&sdk.In{
+			Schema: sdk.NewDatabaseObjectIdentifier(TestDatabaseName, TestSchemaName),
+		}))
+		require.NoError(t, err)
+
+		names := make([]string, len(secrets))
+		for i, s := range secrets {
+			names[i] = s.Name
+		}
+		assert.Contains(t, names, id1.Name())
+		assert.Contains(t, names, id2.Name())
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id := createGenericStringSecret(t)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, string(sdk.SecretTypeGenericString), details.SecretType)
+		assert.Nil(t, details.Username)
+	})
+}
@@ -0,0 +1,199 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSaml2X509Cert is a self-signed certificate accepted by Snowflake as SAML2_X509_CERT.
+const testSaml2X509Cert = "MIIERTCCAq2gAwIBAgIJAKmtzjCD1+tqMA0GCSqGSIb3DQEBCwUAMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDAeFw0xODA4MTgyMzI0MjNaFw0yODA4MTUyMzI0MjNaMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDCCAaIwDQYJKoZIhvcNAQEBBQADggGPADCCAYoCggGBALhUlY3SkIOze+l8y6dBzM6p7B8OykJWlwizszU16Lih8D7KLhNJfahoVxbPxB3YFM/81PJLOeK2krvJ5zY6CJyQY3sPQAkZKI7I8qq9lmZ2g4QPqybNstXS6YUXJNUt/ixbbK/N97+LKTiSutbD1J7AoFnouMuLjlhN5VRZ43jez4xLSHVZaYuUFKn01Y9oLKbj46LQnZnJCAGpTgPqEQJr6GpVGw43bKyUpGoaPrdDRgRgtPMUWgFDkgcI3QiV1lsKfBs1t1E2UA7ACFnlJZpEuBtwgivzo3VeitiSaF3Jxh25EY5/vABpcgQQRz3RH2l8MMKdRsxb8VT3yh2S+CX55s+cN67LiCPr6f2u+KS1iKfB9mWN6o2S4lcmo82HIBbsuXJV0oA1HrGMyyc4Y9nng/I8iuAp8or1JrWRHQ+8NzO85DWK0rtvtLPxkvw0HK32glyuOP/9F05Z7+tiVIgn67buC0EdoUm1RSpibqmB1ST2PikslOlVbJuy4Ah93wIDAQABo1gwVjA1BgNVHREELjAsgippcC0xNzItMzEtMjgtNjQudXMtd2VzdC0yLmNvbXB1dGUuaW50ZXJuYWwwHQYDVR0OBBYEFAdsTxYfulJ5yunYtgYJHC9IcevzMA0GCSqGSIb3DQEBCwUAA4IBgQB3J6i7KreiHL8NPMglfWLHk1PZOgvIEEpKL+GRebvcbyqgcuc3VVPylq70VvGqhJxp1q/mzLfraUiypzfWFGm9zfwIg0H5TqRZYEPTvgIhIICjaDWRwZBDJG8D5G/KoV60DlUG0crPBlIuCCr/SRa5ZoDQqvucTfr3Rx4Ha6koXFSjoSXllR+jn4GnInhm/WH137a+v35PUcffNxfuehoGn6i4YeXF3cwJK4e35cOFW+dLbnaLk+Ty7HOGvpw86h979C6mJ9qEHYgq9rQyzlSPbLZGZSgVcIezunOaOsWm81BsXRNNJjzHGCqKf8RMhd8oZP55+2/SVRBwnkGyUNCuDPrJcymC95ZT2NW/KeWkz28HF2i31xQmecT2r3lQRSM8acvOXQsNEDCDvJvCzJT9c2AnsnO24r6arPXs/UWAxOI+MjclXPLkLD6uTHV+Oo8XZ7bOjegD5hL6/bKUWnNMurQNGrmi/jvqsCFLDKftl7ajuxKjtodnSuwhoY7NQy8="
+
+func TestInt_SecurityIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	cleanupSecurityIntegration := func(t *testing.T, id sdk.AccountObjectIdentifier) {
+		t.Helper()
+		t.Cleanup(func() {
+			err := client.SecurityIntegrations.Drop(ctx, sdk.NewDropSecurityIntegrationRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+	}
+
+	createSaml2 := func(t *testing.T) sdk.AccountObjectIdentifier {
+		t.Helper()
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.SecurityIntegrations.CreateSaml2(ctx, sdk.NewCreateSaml2SecurityIntegrationRequest(id, false, "issuer", "https://example.com", sdk.Saml2SecurityIntegrationSaml2ProviderCustom, testSaml2X509Cert))
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+		return id
+	}
+
+	createScim := func(t *testing.T) sdk.AccountObjectIdentifier {
+		t.Helper()
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.SecurityIntegrations.CreateScim(ctx, sdk.NewCreateScimSecurityIntegrationRequest(id, sdk.ScimSecurityIntegrationScimClientGeneric, sdk.ScimSecurityIntegrationRunAsRoleGenericScimProvisioner))
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+		return id
+	}
+
+	assertSecurityIntegration := func(t *testing.T, id sdk.AccountObjectIdentifier, integrationType string, enabled bool, comment string) {
+		t.Helper()
+		integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), integration.Name)
+		assert.Equal(t, integrationType, integration.IntegrationType)
+		assert.Equal(t, "SECURITY", integration.Category)
+		assert.Equal(t, enabled, integration.Enabled)
+		assert.Equal(t, comment, integration.Comment)
+	}
+
+	describeProperty := func(t *testing.T, id sdk.AccountObjectIdentifier, name string) string {
+		t.Helper()
+		properties, err := client.SecurityIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		for _, p := range properties {
+			if p.Name == name {
+				return p.Value
+			}
+		}
+		t.Fatalf("property %s not found in the description of %s", name, id.FullyQualifiedName())
+		return ""
+	}
+
+	t.Run("CreateSaml2", func(t *testing.T) {
+		id := createSaml2(t)
+
+		assertSecurityIntegration(t, id, "SAML2", false, "")
+		assert.Equal(t, testSaml2X509Cert, describeProperty(t, id, "SAML2_X509_CERT"))
+		assert.Equal(t, "issuer", describeProperty(t, id, "SAML2_ISSUER"))
+	})
+
+	t.Run("CreateScim", func(t *testing.T) {
+		id := createScim(t)
+
+		assertSecurityIntegration(t, id, "SCIM - GENERIC", false, "")
+		assert.Equal(t, "GENERIC_SCIM_PROVISIONER", describeProperty(t, id, "RUN_AS_ROLE"))
+	})
+
+	t.Run("CreateOauthForPartnerApplications", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.SecurityIntegrations.CreateOauthForPartnerApplications(ctx, sdk.NewCreateOauthForPartnerApplicationsSecurityIntegrationRequest(id, sdk.OauthSecurityIntegrationClientLooker).
+			WithOauthRedirectUri(sdk.String("https://example.com")).
+			WithBlockedRolesList(&sdk.BlockedRolesList{}).
+			WithComment(sdk.String("a")))
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+
+		assertSecurityIntegration(t, id, "OAUTH - LOOKER", false, "a")
+		assert.Equal(t, "https://example.com", describeProperty(t, id, "OAUTH_REDIRECT_URI"))
+	})
+
+	t.Run("CreateOauthForCustomClients", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.SecurityIntegrations.CreateOauthForCustomClients(ctx, sdk.NewCreateOauthForCustomClientsSecurityIntegrationRequest(id, sdk.OauthSecurityIntegrationClientTypeConfidential, "https://example.com").
+			WithEnabled(sdk.Bool(true)))
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+
+		assertSecurityIntegration(t, id, "OAUTH - CUSTOM", true, "")
+		assert.Equal(t, "CONFIDENTIAL", describeProperty(t, id, "OAUTH_CLIENT_TYPE"))
+	})
+
+	t.Run("CreateExternalOauth", func(t *testing.T) {
+		id := sdk.RandomAccountObjectIdentifier()
+		err := client.SecurityIntegrations.CreateExternalOauth(ctx, sdk.NewCreateExternalOauthSecurityIntegrationRequest(id, true, sdk.ExternalOauthSecurityIntegrationTypeCustom, "issuer",
+			[]sdk.TokenUserMappingClaim{{Claim: "upn"}}, sdk.ExternalOauthSecurityIntegrationSnowflakeUserMappingAttributeLoginName).
+			WithExternalOauthJwsKeysUrl([]sdk.JwsKeysUrl{{JwsKeyUrl: "https://example.com"}}))
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+
+		assertSecurityIntegration(t, id, "EXTERNAL_OAUTH - CUSTOM", true, "")
+		assert.Equal(t, "issuer", describeProperty(t, id, "EXTERNAL_OAUTH_ISSUER"))
+	})
+
+	t.Run("AlterSaml2: set and unset", func(t *testing.T) {
+		id := createSaml2(t)
+
+		err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithSet(
+			sdk.NewSaml2IntegrationSetRequest().
+				WithSaml2Issuer(sdk.String("new_issuer")).
+				WithSaml2ForceAuthn(sdk.Bool(true)).
+				WithComment(sdk.String("a")),
+		))
+		require.NoError(t, err)
+		assertSecurityIntegration(t, id, "SAML2", false, "a")
+		assert.Equal(t, "new_issuer", describeProperty(t, id, "SAML2_ISSUER"))
+		assert.Equal(t, "true", describeProperty(t, id, "SAML2_FORCE_AUTHN"))
+
+		err = client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithUnset(
+			sdk.NewSaml2IntegrationUnsetRequest().
+				WithSaml2ForceAuthn(sdk.Bool(true)).
+				WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+		assertSecurityIntegration(t, id, "SAML2", false, "")
+		assert.Equal(t, "false", describeProperty(t, id, "SAML2_FORCE_AUTHN"))
+	})
+
+	t.Run("AlterSaml2: refresh SAML2_SNOWFLAKE_PRIVATE_KEY", func(t *testing.T) {
+		id := createSaml2(t)
+
+		err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithRefreshSaml2SnowflakePrivateKey(sdk.Bool(true)))
+		require.NoError(t, err)
+	})
+
+	t.Run("AlterScim: set and unset", func(t *testing.T) {
+		id := createScim(t)
+
+		err := client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithSet(
+			sdk.NewScimIntegrationSetRequest().
+				WithEnabled(sdk.Bool(true)).
+				WithComment(sdk.String("a")),
+		))
+		require.NoError(t, err)
+		assertSecurityIntegration(t, id, "SCIM - GENERIC", true, "a")
+
+		err = client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithUnset(
+			sdk.NewScimIntegrationUnsetRequest().
+				WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+		assertSecurityIntegration(t, id, "SCIM - GENERIC", true, "")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createScim(t)
+
+		err := client.SecurityIntegrations.Drop(ctx, sdk.NewDropSecurityIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.SecurityIntegrations.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		samlID := createSaml2(t)
+		scimID := createScim(t)
+
+		integrations, err := client.SecurityIntegrations.Show(ctx, sdk.NewShowSecurityIntegrationRequest().WithLike(&sdk.Like{
+			Pattern: sdk.String(samlID.Name()),
+		}))
+		require.NoError(t, err)
+		require.Len(t, integrations, 1)
+		assert.Equal(t, samlID.Name(), integrations[0].Name)
+
+		integrations, err = client.SecurityIntegrations.Show(ctx, sdk.NewShowSecurityIntegrationRequest())
+		require.NoError(t, err)
+		names := make([]string, len(integrations))
+		for i, integration := range integrations {
+			names[i] = integration.Name
+		}
+		assert.Contains(t, names, samlID.Name())
+		assert.Contains(t, names, scimID.Name())
+	})
+}
@@ -113,12 +113,7 @@ func (itc *integrationTestContext) initialize() error {
 	itc.warehouse = wh
 	itc.warehouseCleanup = whCleanup
 
-	config, err := sdk.ProfileConfig(testprofiles.Secondary)
-	if err != nil {
-		return err
-	}
-
-	secondaryClient, err := sdk.NewClient(config)
+	secondaryClient, err := newSecondaryClient()
 	if err != nil {
 		return err
 	}
@@ -245,3 +240,23 @@ func testSecondaryWarehouse(t *testing.T) *sdk.Warehouse {
 	t.Helper()
 	return itc.secondaryWarehouse
 }
+
+// skipOnFakeBackend skips tests relying on statements the fake backend does not interpret (see pkg/sdk/internal/fake).
+func skipOnFakeBackend(t *testing.T, reason string) {
+	t.Helper()
+	if sdk.UsesFakeBackend() {
+		t.Skipf("Skipping with the fake backend: %s", reason)
+	}
+}
+
+// newSecondaryClient connects to the secondary test account. With the fake backend it's a separate fake account.
+func newSecondaryClient() (*sdk.Client, error) {
+	if sdk.UsesFakeBackend() {
+		return sdk.NewFakeClient(testprofiles.Secondary)
+	}
+	config, err := sdk.ProfileConfig(testprofiles.Secondary)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewClient(config)
+}
@@ -83,6 +83,23 @@ func TestInt_Streamlits(t *testing.T) {
 		assertStreamlit(t, id, comment, "")
 	})
 
+	t.Run("alter streamlit: unset", func(t *testing.T) {
+		stage, cleanupStage := createStage(t, client, sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(4)))
+		t.Cleanup(cleanupStage)
+		manifest := "manifest.yml"
+		e := createStreamlitHandle(t, stage, manifest)
+
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, e.Name)
+		set := sdk.NewStreamlitSetRequest(sdk.String(stage.Location()), &manifest).WithComment(sdk.String(random.StringN(4)))
+		err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		unset := sdk.NewStreamlitUnsetRequest().WithComment(sdk.Bool(true))
+		err = client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+		assertStreamlit(t, id, "", "")
+	})
+
 	t.Run("alter function: rename", func(t *testing.T) {
 		stage, cleanupStage := createStage(t, client, sdk.NewSchemaObjectIdentifier(TestDatabaseName, TestSchemaName, random.AlphaN(4)))
 		t.Cleanup(cleanupStage)
@@ -20,6 +20,7 @@ type expectedColumn struct {
 }
 
 func TestInt_Table(t *testing.T) {
+	skipOnFakeBackend(t, "masking policies, file formats, stages, INFORMATION_SCHEMA and table constraints are not supported")
 	client := testClient(t)
 	ctx := testContext(t)
 
@@ -32,8 +33,10 @@ func TestInt_Table(t *testing.T) {
 			require.NoError(t, err)
 		}
 	}
-	tag1, _ := createTag(t, client, database, schema)
-	tag2, _ := createTag(t, client, database, schema)
+	tag1, tag1Cleanup := createTag(t, client, database, schema)
+	t.Cleanup(tag1Cleanup)
+	tag2, tag2Cleanup := createTag(t, client, database, schema)
+	t.Cleanup(tag2Cleanup)
 
 	assertColumns := func(t *testing.T, expectedColumns []expectedColumn, createdColumns []informationSchemaColumns) {
 		t.Helper()
@@ -388,6 +388,7 @@ func TestInt_WarehouseAlter(t *testing.T) {
 	})
 
 	t.Run("abort all queries", func(t *testing.T) {
+		skipOnFakeBackend(t, "queries are not run")
 		// new warehouse created on purpose
 		warehouse, warehouseCleanup := createWarehouse(t, client)
 		t.Cleanup(warehouseCleanup)
@@ -0,0 +1,80 @@
+package sdk
+
+import "testing"
+
+func TestUsers_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateUserOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateUserOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		for _, action := range []string{"NewName", "ResetPassword", "AbortAllQueries", "AddDelegatedAuthorization", "SetTag", "UnsetTag"} {
+			t.Run(action, func(t *testing.T) {
+				opts := &AlterUserOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, action)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+		t.Run("RemoveDelegatedAuthorization", func(t *testing.T) {
+			opts := &AlterUserOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "RemoveDelegatedAuthorization")
+			clearSQLFixtureField(opts, "RemoveDelegatedAuthorization.Authorizations")
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		for _, set := range []string{"PasswordPolicy", "SessionPolicy", "ObjectProperties", "ObjectParameters", "SessionParameters"} {
+			t.Run("Set"+set, func(t *testing.T) {
+				opts := &AlterUserOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, "Set."+set)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+			t.Run("Unset"+set, func(t *testing.T) {
+				opts := &AlterUserOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, "Unset."+set)
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+	})
+	t.Run("Drop", func(t *testing.T) {
+		opts := &DropUserOptions{
+			name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowUserOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowUserOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		opts := &describeUserOptions{
+			name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+}
@@ -26,10 +26,6 @@ type CreateViewRequest struct {
 	sql                    string // required
 }
 
-func (r *CreateViewRequest) GetName() SchemaObjectIdentifier {
-	return r.name
-}
-
 type ViewColumnRequest struct {
 	Name    string // required
 	Comment *string
@@ -119,3 +115,10 @@ type ShowViewRequest struct {
 type DescribeViewRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+// generator:protected-begin declarations
+func (r *CreateViewRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// generator:protected-end
@@ -13,6 +13,8 @@ type Views interface {
 	Show(ctx context.Context, request *ShowViewRequest) ([]View, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]ViewDetails, error)
+	// generator:protected-begin Views methods
+	// generator:protected-end
 }
 
 // CreateViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-view.
@@ -139,7 +141,7 @@ type ShowViewOptions struct {
 	views      bool       `ddl:"static" sql:"VIEWS"`
 	Like       *Like      `ddl:"keyword" sql:"LIKE"`
 	In         *In        `ddl:"keyword" sql:"IN"`
-	StartsWith *string    `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
 	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
 
@@ -175,14 +177,6 @@ type View struct {
 	ChangeTracking string
 }
 
-func (v *View) ID() SchemaObjectIdentifier {
-	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
-}
-
-func (v *View) HasCopyGrants() bool {
-	return strings.Contains(v.Text, " COPY GRANTS ")
-}
-
 // DescribeViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-view.
 type DescribeViewOptions struct {
 	describe bool                   `ddl:"static" sql:"DESCRIBE"`
@@ -190,23 +184,20 @@ type DescribeViewOptions struct {
 	name     SchemaObjectIdentifier `ddl:"identifier"`
 }
 
-// TODO [SNOW-965322]: extract common type for describe
-// viewDetailsRow is a copy of externalTableColumnDetailsRow.
 type viewDetailsRow struct {
 	Name       string         `db:"name"`
 	Type       DataType       `db:"type"`
 	Kind       string         `db:"kind"`
-	IsNullable string         `db:"null?"`
+	Null       string         `db:"null?"`
 	Default    sql.NullString `db:"default"`
-	IsPrimary  string         `db:"primary key"`
-	IsUnique   string         `db:"unique key"`
+	PrimaryKey string         `db:"primary key"`
+	UniqueKey  string         `db:"unique key"`
 	Check      sql.NullString `db:"check"`
 	Expression sql.NullString `db:"expression"`
 	Comment    sql.NullString `db:"comment"`
 	PolicyName sql.NullString `db:"policy name"`
 }
 
-// ViewDetails is a copy of ExternalTableColumnDetails.
 type ViewDetails struct {
 	Name       string
 	Type       DataType
@@ -220,3 +211,14 @@ type ViewDetails struct {
 	Comment    *string
 	PolicyName *string
 }
+
+// generator:protected-begin declarations
+func (v *View) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *View) HasCopyGrants() bool {
+	return strings.Contains(v.Text, " COPY GRANTS ")
+}
+
+// generator:protected-end
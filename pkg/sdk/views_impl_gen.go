@@ -1,10 +1,6 @@
 package sdk
 
-import (
-	"context"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
-)
+import "context"
 
 var _ Views = (*views)(nil)
 
@@ -38,12 +34,14 @@ func (v *views) Show(ctx context.Context, request *ShowViewRequest) ([]View, err
 }
 
 func (v *views) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error) {
-	request := NewShowViewRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{String(id.Name())})
+	// generator:protected-begin views.ShowByID
+	request := NewShowViewRequest().WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).WithLike(&Like{Pattern: String(id.Name())})
 	views, err := v.Show(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(views, func(r View) bool { return r.Name == id.Name() })
+	return findOne(views, func(r View) bool { return r.Name == id.Name() })
+	// generator:protected-end
 }
 
 func (v *views) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]ViewDetails, error) {
@@ -65,24 +63,30 @@ func (r *CreateViewRequest) toOpts() *CreateViewOptions {
 		Recursive:   r.Recursive,
 		IfNotExists: r.IfNotExists,
 		name:        r.name,
-
-		CopyGrants: r.CopyGrants,
-		Comment:    r.Comment,
-
-		Tag: r.Tag,
-		sql: r.sql,
+		CopyGrants:  r.CopyGrants,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
+		sql:         r.sql,
 	}
 	if r.Columns != nil {
 		s := make([]ViewColumn, len(r.Columns))
 		for i, v := range r.Columns {
-			s[i] = ViewColumn(v)
+			s[i] = ViewColumn{
+				Name:    v.Name,
+				Comment: v.Comment,
+			}
 		}
 		opts.Columns = s
 	}
 	if r.ColumnsMaskingPolicies != nil {
 		s := make([]ViewColumnMaskingPolicy, len(r.ColumnsMaskingPolicies))
 		for i, v := range r.ColumnsMaskingPolicies {
-			s[i] = ViewColumnMaskingPolicy(v)
+			s[i] = ViewColumnMaskingPolicy{
+				Name:          v.Name,
+				MaskingPolicy: v.MaskingPolicy,
+				Using:         v.Using,
+				Tag:           v.Tag,
+			}
 		}
 		opts.ColumnsMaskingPolicies = s
 	}
@@ -178,6 +182,7 @@ func (r *ShowViewRequest) toOpts() *ShowViewOptions {
 }
 
 func (r viewDBRow) convert() *View {
+	// generator:protected-begin viewDBRow.convert
 	view := View{
 		CreatedOn:    r.CreatedOn,
 		Name:         r.Name,
@@ -212,6 +217,7 @@ func (r viewDBRow) convert() *View {
 		view.ChangeTracking = r.ChangeTracking.String
 	}
 	return &view
+	// generator:protected-end
 }
 
 func (r *DescribeViewRequest) toOpts() *DescribeViewOptions {
@@ -222,13 +228,14 @@ func (r *DescribeViewRequest) toOpts() *DescribeViewOptions {
 }
 
 func (r viewDetailsRow) convert() *ViewDetails {
+	// generator:protected-begin viewDetailsRow.convert
 	details := &ViewDetails{
 		Name:       r.Name,
 		Type:       r.Type,
 		Kind:       r.Kind,
-		IsNullable: r.IsNullable == "Y",
-		IsPrimary:  r.IsPrimary == "Y",
-		IsUnique:   r.IsUnique == "Y",
+		IsNullable: r.Null == "Y",
+		IsPrimary:  r.PrimaryKey == "Y",
+		IsUnique:   r.UniqueKey == "Y",
 	}
 	if r.Default.Valid {
 		details.Default = String(r.Default.String)
@@ -246,4 +253,8 @@ func (r viewDetailsRow) convert() *ViewDetails {
 		details.PolicyName = String(r.PolicyName.String)
 	}
 	return details
+	// generator:protected-end
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
@@ -27,6 +27,8 @@ func (opts *CreateViewOptions) validate() error {
 			errs = append(errs, errNotSet("CreateViewOptions.RowAccessPolicy", "On"))
 		}
 	}
+	// generator:protected-begin CreateViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -55,20 +57,18 @@ func (opts *AlterViewOptions) validate() error {
 		}
 	}
 	if valueSet(opts.DropAndAddRowAccessPolicy) {
-		if valueSet(opts.DropAndAddRowAccessPolicy.Drop) {
-			if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Drop.RowAccessPolicy) {
-				errs = append(errs, ErrInvalidObjectIdentifier)
-			}
+		if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Drop.RowAccessPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Add.RowAccessPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
-		if valueSet(opts.DropAndAddRowAccessPolicy.Add) {
-			if !ValidObjectIdentifier(opts.DropAndAddRowAccessPolicy.Add.RowAccessPolicy) {
-				errs = append(errs, ErrInvalidObjectIdentifier)
-			}
-			if !valueSet(opts.DropAndAddRowAccessPolicy.Add.On) {
-				errs = append(errs, errNotSet("AlterViewOptions.DropAndAddRowAccessPolicy.Add", "On"))
-			}
+		if !valueSet(opts.DropAndAddRowAccessPolicy.Add.On) {
+			errs = append(errs, errNotSet("AlterViewOptions.DropAndAddRowAccessPolicy.Add", "On"))
 		}
 	}
+	// generator:protected-begin AlterViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -80,6 +80,8 @@ func (opts *DropViewOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DropViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -88,6 +90,8 @@ func (opts *ShowViewOptions) validate() error {
 		return ErrNilOptions
 	}
 	var errs []error
+	// generator:protected-begin ShowViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
 
@@ -99,5 +103,10 @@ func (opts *DescribeViewOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
+	// generator:protected-begin DescribeViewOptions.validate
+	// generator:protected-end
 	return JoinErrors(errs...)
 }
+
+// generator:protected-begin declarations
+// generator:protected-end
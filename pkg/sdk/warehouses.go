@@ -149,6 +149,7 @@ func (c *warehouses) Create(ctx context.Context, id AccountObjectIdentifier, opt
 		return err
 	}
 	_, err = c.client.exec(ctx, stmt)
+	c.client.showCache.invalidate(ObjectTypeWarehouse, id)
 	return err
 }
 
@@ -290,6 +291,11 @@ func (c *warehouses) Alter(ctx context.Context, id AccountObjectIdentifier, opts
 		return err
 	}
 	_, err = c.client.exec(ctx, sql)
+	if opts.NewName != nil {
+		c.client.showCache.invalidate(ObjectTypeWarehouse, id, *opts.NewName)
+	} else {
+		c.client.showCache.invalidate(ObjectTypeWarehouse, id)
+	}
 	return err
 }
 
@@ -326,6 +332,7 @@ func (c *warehouses) Drop(ctx context.Context, id AccountObjectIdentifier, opts
 		return err
 	}
 	_, err = c.client.exec(ctx, sql)
+	c.client.showCache.invalidate(ObjectTypeWarehouse, id)
 	if err != nil {
 		return err
 	}
@@ -472,21 +479,15 @@ func (c *warehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]Wa
 }
 
 func (c *warehouses) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
-	warehouses, err := c.Show(ctx, &ShowWarehouseOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+	return showByIDWithCache(c.client, ObjectTypeWarehouse, id, func(warehouse Warehouse) bool { return warehouse.ID().name == id.Name() }, func() ([]Warehouse, error) {
+		return c.Show(ctx, &ShowWarehouseOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+		})
+	}, func() ([]Warehouse, error) {
+		return c.Show(ctx, nil)
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	for _, warehouse := range warehouses {
-		if warehouse.ID().name == id.Name() {
-			return &warehouse, nil
-		}
-	}
-	return nil, ErrObjectNotExistOrAuthorized
 }
 
 // describeWarehouseOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-warehouse.
@@ -0,0 +1,81 @@
+package sdk
+
+import "testing"
+
+func TestWarehouses_GoldenSQL(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &CreateWarehouseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &CreateWarehouseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			clearSQLFixtureField(opts, "IfNotExists")
+			setSQLFixtureField(opts, "WarehouseType", WarehouseTypeStandard)
+			setSQLFixtureField(opts, "WarehouseSize", WarehouseSizeXSmall)
+			setSQLFixtureField(opts, "ScalingPolicy", ScalingPolicyStandard)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Alter", func(t *testing.T) {
+		for _, action := range []string{"Suspend", "Resume", "AbortAllQueries", "NewName", "Unset", "SetTag", "UnsetTag"} {
+			t.Run(action, func(t *testing.T) {
+				opts := &AlterWarehouseOptions{
+					name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+				}
+				fillSQLFixtureField(opts, action)
+				if action == "Resume" {
+					fillSQLFixtureField(opts, "IfSuspended")
+				}
+				assertSQLMatchesGoldenFile(t, opts)
+			})
+		}
+		t.Run("Set", func(t *testing.T) {
+			opts := &AlterWarehouseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixtureField(opts, "Set")
+			setSQLFixtureField(opts, "Set.WarehouseType", WarehouseTypeStandard)
+			setSQLFixtureField(opts, "Set.WarehouseSize", WarehouseSizeXSmall)
+			setSQLFixtureField(opts, "Set.ScalingPolicy", ScalingPolicyStandard)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Drop", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &DropWarehouseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &DropWarehouseOptions{
+				name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+			}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Show", func(t *testing.T) {
+		t.Run("basic", func(t *testing.T) {
+			opts := &ShowWarehouseOptions{}
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+		t.Run("all options", func(t *testing.T) {
+			opts := &ShowWarehouseOptions{}
+			fillSQLFixture(opts)
+			assertSQLMatchesGoldenFile(t, opts)
+		})
+	})
+	t.Run("Describe", func(t *testing.T) {
+		opts := &describeWarehouseOptions{
+			name: sqlFixtureIdentifier[AccountObjectIdentifier](),
+		}
+		assertSQLMatchesGoldenFile(t, opts)
+	})
+}
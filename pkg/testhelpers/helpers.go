@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,3 +25,8 @@ func WithMockDb(t *testing.T, f func(*sql.DB, sqlmock.Sqlmock)) {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 	}
 }
+
+// MockProviderContext wraps the mocked db in the provider context that resources expect as their meta.
+func MockProviderContext(db *sql.DB) *provider.Context {
+	return provider.NewContext(sdk.NewClientFromDB(db))
+}